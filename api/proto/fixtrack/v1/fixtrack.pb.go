@@ -0,0 +1,1586 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.28.3
+// source: fixtrack/v1/fixtrack.proto
+
+package fixtrackv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Priority int32
+
+const (
+	Priority_PRIORITY_UNSPECIFIED Priority = 0
+	Priority_PRIORITY_LOW         Priority = 1
+	Priority_PRIORITY_MEDIUM      Priority = 2
+	Priority_PRIORITY_HIGH        Priority = 3
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_UNSPECIFIED",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_MEDIUM",
+		3: "PRIORITY_HIGH",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"PRIORITY_MEDIUM":      2,
+		"PRIORITY_HIGH":        3,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_fixtrack_v1_fixtrack_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_fixtrack_v1_fixtrack_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{0}
+}
+
+type Issue struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key              string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	ProjectId        string                 `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ReporterId       string                 `protobuf:"bytes,4,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Title            string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Priority         Priority               `protobuf:"varint,9,opt,name=priority,proto3,enum=fixtrack.v1.Priority" json:"priority,omitempty"`
+	Source           string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	ResolutionCause  string                 `protobuf:"bytes,11,opt,name=resolution_cause,json=resolutionCause,proto3" json:"resolution_cause,omitempty"`
+	ResolutionAction string                 `protobuf:"bytes,12,opt,name=resolution_action,json=resolutionAction,proto3" json:"resolution_action,omitempty"`
+	DueDate          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ClosedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Issue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Issue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Issue) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Issue) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *Issue) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Issue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Issue) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Issue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Issue) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *Issue) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Issue) GetResolutionCause() string {
+	if x != nil {
+		return x.ResolutionCause
+	}
+	return ""
+}
+
+func (x *Issue) GetResolutionAction() string {
+	if x != nil {
+		return x.ResolutionAction
+	}
+	return ""
+}
+
+func (x *Issue) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Issue) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Issue) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Issue) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type IssueRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueRef) Reset() {
+	*x = IssueRef{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueRef) ProtoMessage() {}
+
+func (x *IssueRef) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueRef.ProtoReflect.Descriptor instead.
+func (*IssueRef) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IssueRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIssueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Ref:
+	//
+	//	*GetIssueRequest_Id
+	//	*GetIssueRequest_Key
+	Ref           isGetIssueRequest_Ref `protobuf_oneof:"ref"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueRequest) Reset() {
+	*x = GetIssueRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueRequest) ProtoMessage() {}
+
+func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetIssueRequest) GetRef() isGetIssueRequest_Ref {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *GetIssueRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Ref.(*GetIssueRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetIssueRequest) GetKey() string {
+	if x != nil {
+		if x, ok := x.Ref.(*GetIssueRequest_Key); ok {
+			return x.Key
+		}
+	}
+	return ""
+}
+
+type isGetIssueRequest_Ref interface {
+	isGetIssueRequest_Ref()
+}
+
+type GetIssueRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type GetIssueRequest_Key struct {
+	Key string `protobuf:"bytes,2,opt,name=key,proto3,oneof"`
+}
+
+func (*GetIssueRequest_Id) isGetIssueRequest_Ref() {}
+
+func (*GetIssueRequest_Key) isGetIssueRequest_Ref() {}
+
+type ListIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Priority      Priority               `protobuf:"varint,4,opt,name=priority,proto3,enum=fixtrack.v1.Priority" json:"priority,omitempty"`
+	AssigneeId    string                 `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Offset        int32                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesRequest) Reset() {
+	*x = ListIssuesRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesRequest) ProtoMessage() {}
+
+func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListIssuesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+func (x *ListIssuesRequest) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListIssuesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListIssuesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesResponse) Reset() {
+	*x = ListIssuesResponse{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesResponse) ProtoMessage() {}
+
+func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListIssuesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type CreateIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueRequest) Reset() {
+	*x = CreateIssueRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueRequest) ProtoMessage() {}
+
+func (x *CreateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateIssueRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type UpdateIssueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIssueStatusRequest) Reset() {
+	*x = UpdateIssueStatusRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssueStatusRequest) ProtoMessage() {}
+
+func (x *UpdateIssueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssueStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateIssueStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIssueStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateIssuePriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Priority      Priority               `protobuf:"varint,2,opt,name=priority,proto3,enum=fixtrack.v1.Priority" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIssuePriorityRequest) Reset() {
+	*x = UpdateIssuePriorityRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssuePriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssuePriorityRequest) ProtoMessage() {}
+
+func (x *UpdateIssuePriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssuePriorityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssuePriorityRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateIssuePriorityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIssuePriorityRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_UNSPECIFIED
+}
+
+type ResolveIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cause         string                 `protobuf:"bytes,2,opt,name=cause,proto3" json:"cause,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIssueRequest) Reset() {
+	*x = ResolveIssueRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIssueRequest) ProtoMessage() {}
+
+func (x *ResolveIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIssueRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIssueRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResolveIssueRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveIssueRequest) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *ResolveIssueRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type WatchStatusChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStatusChangesRequest) Reset() {
+	*x = WatchStatusChangesRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStatusChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStatusChangesRequest) ProtoMessage() {}
+
+func (x *WatchStatusChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStatusChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchStatusChangesRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchStatusChangesRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *WatchStatusChangesRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type StatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	OldStatus     string                 `protobuf:"bytes,2,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,3,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	ActorId       string                 `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusChange) Reset() {
+	*x = StatusChange{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusChange) ProtoMessage() {}
+
+func (x *StatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusChange.ProtoReflect.Descriptor instead.
+func (*StatusChange) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StatusChange) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *StatusChange) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *StatusChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *StatusChange) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type Channel struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DiscordChannelId string                 `protobuf:"bytes,2,opt,name=discord_channel_id,json=discordChannelId,proto3" json:"discord_channel_id,omitempty"`
+	GuildId          string                 `protobuf:"bytes,3,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	ProjectId        string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ExtraProjectIds  []string               `protobuf:"bytes,5,rep,name=extra_project_ids,json=extraProjectIds,proto3" json:"extra_project_ids,omitempty"`
+	Active           bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	ChannelType      string                 `protobuf:"bytes,7,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Channel) ProtoMessage() {}
+
+func (x *Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Channel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Channel) GetDiscordChannelId() string {
+	if x != nil {
+		return x.DiscordChannelId
+	}
+	return ""
+}
+
+func (x *Channel) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *Channel) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Channel) GetExtraProjectIds() []string {
+	if x != nil {
+		return x.ExtraProjectIds
+	}
+	return nil
+}
+
+func (x *Channel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Channel) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *Channel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ChannelRef struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DiscordChannelId string                 `protobuf:"bytes,1,opt,name=discord_channel_id,json=discordChannelId,proto3" json:"discord_channel_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ChannelRef) Reset() {
+	*x = ChannelRef{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRef) ProtoMessage() {}
+
+func (x *ChannelRef) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRef.ProtoReflect.Descriptor instead.
+func (*ChannelRef) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChannelRef) GetDiscordChannelId() string {
+	if x != nil {
+		return x.DiscordChannelId
+	}
+	return ""
+}
+
+type ListChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuildId       string                 `protobuf:"bytes,1,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsRequest) ProtoMessage() {}
+
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListChannelsRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+type ListChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*Channel             `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsResponse) ProtoMessage() {}
+
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListChannelsResponse) GetChannels() []*Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type RegisterChannelRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DiscordChannelId   string                 `protobuf:"bytes,1,opt,name=discord_channel_id,json=discordChannelId,proto3" json:"discord_channel_id,omitempty"`
+	GuildId            string                 `protobuf:"bytes,2,opt,name=guild_id,json=guildId,proto3" json:"guild_id,omitempty"`
+	CustomerName       string                 `protobuf:"bytes,3,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CustomerEmail      string                 `protobuf:"bytes,4,opt,name=customer_email,json=customerEmail,proto3" json:"customer_email,omitempty"`
+	ProjectName        string                 `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ProjectDescription string                 `protobuf:"bytes,6,opt,name=project_description,json=projectDescription,proto3" json:"project_description,omitempty"`
+	RegisteredBy       string                 `protobuf:"bytes,7,opt,name=registered_by,json=registeredBy,proto3" json:"registered_by,omitempty"`
+	ChannelType        string                 `protobuf:"bytes,8,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RegisterChannelRequest) Reset() {
+	*x = RegisterChannelRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterChannelRequest) ProtoMessage() {}
+
+func (x *RegisterChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterChannelRequest.ProtoReflect.Descriptor instead.
+func (*RegisterChannelRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RegisterChannelRequest) GetDiscordChannelId() string {
+	if x != nil {
+		return x.DiscordChannelId
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetGuildId() string {
+	if x != nil {
+		return x.GuildId
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetCustomerEmail() string {
+	if x != nil {
+		return x.CustomerEmail
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetProjectDescription() string {
+	if x != nil {
+		return x.ProjectDescription
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetRegisteredBy() string {
+	if x != nil {
+		return x.RegisteredBy
+	}
+	return ""
+}
+
+func (x *RegisterChannelRequest) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+type SetChannelActiveRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DiscordChannelId string                 `protobuf:"bytes,1,opt,name=discord_channel_id,json=discordChannelId,proto3" json:"discord_channel_id,omitempty"`
+	Active           bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetChannelActiveRequest) Reset() {
+	*x = SetChannelActiveRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelActiveRequest) ProtoMessage() {}
+
+func (x *SetChannelActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelActiveRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetChannelActiveRequest) GetDiscordChannelId() string {
+	if x != nil {
+		return x.DiscordChannelId
+	}
+	return ""
+}
+
+func (x *SetChannelActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type TransferChannelRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DiscordChannelId string                 `protobuf:"bytes,1,opt,name=discord_channel_id,json=discordChannelId,proto3" json:"discord_channel_id,omitempty"`
+	ProjectKey       string                 `protobuf:"bytes,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TransferChannelRequest) Reset() {
+	*x = TransferChannelRequest{}
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferChannelRequest) ProtoMessage() {}
+
+func (x *TransferChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fixtrack_v1_fixtrack_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferChannelRequest.ProtoReflect.Descriptor instead.
+func (*TransferChannelRequest) Descriptor() ([]byte, []int) {
+	return file_fixtrack_v1_fixtrack_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransferChannelRequest) GetDiscordChannelId() string {
+	if x != nil {
+		return x.DiscordChannelId
+	}
+	return ""
+}
+
+func (x *TransferChannelRequest) GetProjectKey() string {
+	if x != nil {
+		return x.ProjectKey
+	}
+	return ""
+}
+
+var File_fixtrack_v1_fixtrack_proto protoreflect.FileDescriptor
+
+const file_fixtrack_v1_fixtrack_proto_rawDesc = "" +
+	"\n" +
+	"\x1afixtrack/v1/fixtrack.proto\x12\vfixtrack.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdf\x04\n" +
+	"\x05Issue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vreporter_id\x18\x04 \x01(\tR\n" +
+	"reporterId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x121\n" +
+	"\bpriority\x18\t \x01(\x0e2\x15.fixtrack.v1.PriorityR\bpriority\x12\x16\n" +
+	"\x06source\x18\n" +
+	" \x01(\tR\x06source\x12)\n" +
+	"\x10resolution_cause\x18\v \x01(\tR\x0fresolutionCause\x12+\n" +
+	"\x11resolution_action\x18\f \x01(\tR\x10resolutionAction\x125\n" +
+	"\bdue_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\tclosed_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\x1a\n" +
+	"\bIssueRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x0fGetIssueRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x12\n" +
+	"\x03key\x18\x02 \x01(\tH\x00R\x03keyB\x05\n" +
+	"\x03ref\"\xf1\x02\n" +
+	"\x11ListIssuesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x121\n" +
+	"\bpriority\x18\x04 \x01(\x0e2\x15.fixtrack.v1.PriorityR\bpriority\x12\x1f\n" +
+	"\vassignee_id\x18\x05 \x01(\tR\n" +
+	"assigneeId\x12?\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limit\"a\n" +
+	"\x12ListIssuesResponse\x12*\n" +
+	"\x06issues\x18\x01 \x03(\v2\x12.fixtrack.v1.IssueR\x06issues\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"\xa9\x01\n" +
+	"\x12CreateIssueRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"B\n" +
+	"\x18UpdateIssueStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"_\n" +
+	"\x1aUpdateIssuePriorityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x15.fixtrack.v1.PriorityR\bpriority\"S\n" +
+	"\x13ResolveIssueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05cause\x18\x02 \x01(\tR\x05cause\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"[\n" +
+	"\x19WatchStatusChangesRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\"\xce\x01\n" +
+	"\fStatusChange\x12(\n" +
+	"\x05issue\x18\x01 \x01(\v2\x12.fixtrack.v1.IssueR\x05issue\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x02 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x03 \x01(\tR\tnewStatus\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\tR\aactorId\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xa3\x02\n" +
+	"\aChannel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12discord_channel_id\x18\x02 \x01(\tR\x10discordChannelId\x12\x19\n" +
+	"\bguild_id\x18\x03 \x01(\tR\aguildId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x04 \x01(\tR\tprojectId\x12*\n" +
+	"\x11extra_project_ids\x18\x05 \x03(\tR\x0fextraProjectIds\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12!\n" +
+	"\fchannel_type\x18\a \x01(\tR\vchannelType\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\":\n" +
+	"\n" +
+	"ChannelRef\x12,\n" +
+	"\x12discord_channel_id\x18\x01 \x01(\tR\x10discordChannelId\"0\n" +
+	"\x13ListChannelsRequest\x12\x19\n" +
+	"\bguild_id\x18\x01 \x01(\tR\aguildId\"H\n" +
+	"\x14ListChannelsResponse\x120\n" +
+	"\bchannels\x18\x01 \x03(\v2\x14.fixtrack.v1.ChannelR\bchannels\"\xc9\x02\n" +
+	"\x16RegisterChannelRequest\x12,\n" +
+	"\x12discord_channel_id\x18\x01 \x01(\tR\x10discordChannelId\x12\x19\n" +
+	"\bguild_id\x18\x02 \x01(\tR\aguildId\x12#\n" +
+	"\rcustomer_name\x18\x03 \x01(\tR\fcustomerName\x12%\n" +
+	"\x0ecustomer_email\x18\x04 \x01(\tR\rcustomerEmail\x12!\n" +
+	"\fproject_name\x18\x05 \x01(\tR\vprojectName\x12/\n" +
+	"\x13project_description\x18\x06 \x01(\tR\x12projectDescription\x12#\n" +
+	"\rregistered_by\x18\a \x01(\tR\fregisteredBy\x12!\n" +
+	"\fchannel_type\x18\b \x01(\tR\vchannelType\"_\n" +
+	"\x17SetChannelActiveRequest\x12,\n" +
+	"\x12discord_channel_id\x18\x01 \x01(\tR\x10discordChannelId\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"g\n" +
+	"\x16TransferChannelRequest\x12,\n" +
+	"\x12discord_channel_id\x18\x01 \x01(\tR\x10discordChannelId\x12\x1f\n" +
+	"\vproject_key\x18\x02 \x01(\tR\n" +
+	"projectKey*^\n" +
+	"\bPriority\x12\x18\n" +
+	"\x14PRIORITY_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
+	"\x0fPRIORITY_MEDIUM\x10\x02\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x032\x9d\x05\n" +
+	"\fIssueService\x12<\n" +
+	"\bGetIssue\x12\x1c.fixtrack.v1.GetIssueRequest\x1a\x12.fixtrack.v1.Issue\x12M\n" +
+	"\n" +
+	"ListIssues\x12\x1e.fixtrack.v1.ListIssuesRequest\x1a\x1f.fixtrack.v1.ListIssuesResponse\x12B\n" +
+	"\vCreateIssue\x12\x1f.fixtrack.v1.CreateIssueRequest\x1a\x12.fixtrack.v1.Issue\x12N\n" +
+	"\x11UpdateIssueStatus\x12%.fixtrack.v1.UpdateIssueStatusRequest\x1a\x12.fixtrack.v1.Issue\x12R\n" +
+	"\x13UpdateIssuePriority\x12'.fixtrack.v1.UpdateIssuePriorityRequest\x1a\x12.fixtrack.v1.Issue\x12D\n" +
+	"\fResolveIssue\x12 .fixtrack.v1.ResolveIssueRequest\x1a\x12.fixtrack.v1.Issue\x12<\n" +
+	"\vDeleteIssue\x12\x15.fixtrack.v1.IssueRef\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\fRestoreIssue\x12\x15.fixtrack.v1.IssueRef\x1a\x12.fixtrack.v1.Issue\x12Y\n" +
+	"\x12WatchStatusChanges\x12&.fixtrack.v1.WatchStatusChangesRequest\x1a\x19.fixtrack.v1.StatusChange0\x012\x8e\x03\n" +
+	"\x0eChannelService\x12;\n" +
+	"\n" +
+	"GetChannel\x12\x17.fixtrack.v1.ChannelRef\x1a\x14.fixtrack.v1.Channel\x12S\n" +
+	"\fListChannels\x12 .fixtrack.v1.ListChannelsRequest\x1a!.fixtrack.v1.ListChannelsResponse\x12L\n" +
+	"\x0fRegisterChannel\x12#.fixtrack.v1.RegisterChannelRequest\x1a\x14.fixtrack.v1.Channel\x12N\n" +
+	"\x10SetChannelActive\x12$.fixtrack.v1.SetChannelActiveRequest\x1a\x14.fixtrack.v1.Channel\x12L\n" +
+	"\x0fTransferChannel\x12#.fixtrack.v1.TransferChannelRequest\x1a\x14.fixtrack.v1.ChannelB0Z.fix-track-bot/api/proto/fixtrack/v1;fixtrackv1b\x06proto3"
+
+var (
+	file_fixtrack_v1_fixtrack_proto_rawDescOnce sync.Once
+	file_fixtrack_v1_fixtrack_proto_rawDescData []byte
+)
+
+func file_fixtrack_v1_fixtrack_proto_rawDescGZIP() []byte {
+	file_fixtrack_v1_fixtrack_proto_rawDescOnce.Do(func() {
+		file_fixtrack_v1_fixtrack_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fixtrack_v1_fixtrack_proto_rawDesc), len(file_fixtrack_v1_fixtrack_proto_rawDesc)))
+	})
+	return file_fixtrack_v1_fixtrack_proto_rawDescData
+}
+
+var file_fixtrack_v1_fixtrack_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_fixtrack_v1_fixtrack_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_fixtrack_v1_fixtrack_proto_goTypes = []any{
+	(Priority)(0),                      // 0: fixtrack.v1.Priority
+	(*Issue)(nil),                      // 1: fixtrack.v1.Issue
+	(*IssueRef)(nil),                   // 2: fixtrack.v1.IssueRef
+	(*GetIssueRequest)(nil),            // 3: fixtrack.v1.GetIssueRequest
+	(*ListIssuesRequest)(nil),          // 4: fixtrack.v1.ListIssuesRequest
+	(*ListIssuesResponse)(nil),         // 5: fixtrack.v1.ListIssuesResponse
+	(*CreateIssueRequest)(nil),         // 6: fixtrack.v1.CreateIssueRequest
+	(*UpdateIssueStatusRequest)(nil),   // 7: fixtrack.v1.UpdateIssueStatusRequest
+	(*UpdateIssuePriorityRequest)(nil), // 8: fixtrack.v1.UpdateIssuePriorityRequest
+	(*ResolveIssueRequest)(nil),        // 9: fixtrack.v1.ResolveIssueRequest
+	(*WatchStatusChangesRequest)(nil),  // 10: fixtrack.v1.WatchStatusChangesRequest
+	(*StatusChange)(nil),               // 11: fixtrack.v1.StatusChange
+	(*Channel)(nil),                    // 12: fixtrack.v1.Channel
+	(*ChannelRef)(nil),                 // 13: fixtrack.v1.ChannelRef
+	(*ListChannelsRequest)(nil),        // 14: fixtrack.v1.ListChannelsRequest
+	(*ListChannelsResponse)(nil),       // 15: fixtrack.v1.ListChannelsResponse
+	(*RegisterChannelRequest)(nil),     // 16: fixtrack.v1.RegisterChannelRequest
+	(*SetChannelActiveRequest)(nil),    // 17: fixtrack.v1.SetChannelActiveRequest
+	(*TransferChannelRequest)(nil),     // 18: fixtrack.v1.TransferChannelRequest
+	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 20: google.protobuf.Empty
+}
+var file_fixtrack_v1_fixtrack_proto_depIdxs = []int32{
+	0,  // 0: fixtrack.v1.Issue.priority:type_name -> fixtrack.v1.Priority
+	19, // 1: fixtrack.v1.Issue.due_date:type_name -> google.protobuf.Timestamp
+	19, // 2: fixtrack.v1.Issue.created_at:type_name -> google.protobuf.Timestamp
+	19, // 3: fixtrack.v1.Issue.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 4: fixtrack.v1.Issue.closed_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: fixtrack.v1.ListIssuesRequest.priority:type_name -> fixtrack.v1.Priority
+	19, // 6: fixtrack.v1.ListIssuesRequest.created_after:type_name -> google.protobuf.Timestamp
+	19, // 7: fixtrack.v1.ListIssuesRequest.created_before:type_name -> google.protobuf.Timestamp
+	1,  // 8: fixtrack.v1.ListIssuesResponse.issues:type_name -> fixtrack.v1.Issue
+	0,  // 9: fixtrack.v1.UpdateIssuePriorityRequest.priority:type_name -> fixtrack.v1.Priority
+	1,  // 10: fixtrack.v1.StatusChange.issue:type_name -> fixtrack.v1.Issue
+	19, // 11: fixtrack.v1.StatusChange.occurred_at:type_name -> google.protobuf.Timestamp
+	19, // 12: fixtrack.v1.Channel.created_at:type_name -> google.protobuf.Timestamp
+	12, // 13: fixtrack.v1.ListChannelsResponse.channels:type_name -> fixtrack.v1.Channel
+	3,  // 14: fixtrack.v1.IssueService.GetIssue:input_type -> fixtrack.v1.GetIssueRequest
+	4,  // 15: fixtrack.v1.IssueService.ListIssues:input_type -> fixtrack.v1.ListIssuesRequest
+	6,  // 16: fixtrack.v1.IssueService.CreateIssue:input_type -> fixtrack.v1.CreateIssueRequest
+	7,  // 17: fixtrack.v1.IssueService.UpdateIssueStatus:input_type -> fixtrack.v1.UpdateIssueStatusRequest
+	8,  // 18: fixtrack.v1.IssueService.UpdateIssuePriority:input_type -> fixtrack.v1.UpdateIssuePriorityRequest
+	9,  // 19: fixtrack.v1.IssueService.ResolveIssue:input_type -> fixtrack.v1.ResolveIssueRequest
+	2,  // 20: fixtrack.v1.IssueService.DeleteIssue:input_type -> fixtrack.v1.IssueRef
+	2,  // 21: fixtrack.v1.IssueService.RestoreIssue:input_type -> fixtrack.v1.IssueRef
+	10, // 22: fixtrack.v1.IssueService.WatchStatusChanges:input_type -> fixtrack.v1.WatchStatusChangesRequest
+	13, // 23: fixtrack.v1.ChannelService.GetChannel:input_type -> fixtrack.v1.ChannelRef
+	14, // 24: fixtrack.v1.ChannelService.ListChannels:input_type -> fixtrack.v1.ListChannelsRequest
+	16, // 25: fixtrack.v1.ChannelService.RegisterChannel:input_type -> fixtrack.v1.RegisterChannelRequest
+	17, // 26: fixtrack.v1.ChannelService.SetChannelActive:input_type -> fixtrack.v1.SetChannelActiveRequest
+	18, // 27: fixtrack.v1.ChannelService.TransferChannel:input_type -> fixtrack.v1.TransferChannelRequest
+	1,  // 28: fixtrack.v1.IssueService.GetIssue:output_type -> fixtrack.v1.Issue
+	5,  // 29: fixtrack.v1.IssueService.ListIssues:output_type -> fixtrack.v1.ListIssuesResponse
+	1,  // 30: fixtrack.v1.IssueService.CreateIssue:output_type -> fixtrack.v1.Issue
+	1,  // 31: fixtrack.v1.IssueService.UpdateIssueStatus:output_type -> fixtrack.v1.Issue
+	1,  // 32: fixtrack.v1.IssueService.UpdateIssuePriority:output_type -> fixtrack.v1.Issue
+	1,  // 33: fixtrack.v1.IssueService.ResolveIssue:output_type -> fixtrack.v1.Issue
+	20, // 34: fixtrack.v1.IssueService.DeleteIssue:output_type -> google.protobuf.Empty
+	1,  // 35: fixtrack.v1.IssueService.RestoreIssue:output_type -> fixtrack.v1.Issue
+	11, // 36: fixtrack.v1.IssueService.WatchStatusChanges:output_type -> fixtrack.v1.StatusChange
+	12, // 37: fixtrack.v1.ChannelService.GetChannel:output_type -> fixtrack.v1.Channel
+	15, // 38: fixtrack.v1.ChannelService.ListChannels:output_type -> fixtrack.v1.ListChannelsResponse
+	12, // 39: fixtrack.v1.ChannelService.RegisterChannel:output_type -> fixtrack.v1.Channel
+	12, // 40: fixtrack.v1.ChannelService.SetChannelActive:output_type -> fixtrack.v1.Channel
+	12, // 41: fixtrack.v1.ChannelService.TransferChannel:output_type -> fixtrack.v1.Channel
+	28, // [28:42] is the sub-list for method output_type
+	14, // [14:28] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_fixtrack_v1_fixtrack_proto_init() }
+func file_fixtrack_v1_fixtrack_proto_init() {
+	if File_fixtrack_v1_fixtrack_proto != nil {
+		return
+	}
+	file_fixtrack_v1_fixtrack_proto_msgTypes[2].OneofWrappers = []any{
+		(*GetIssueRequest_Id)(nil),
+		(*GetIssueRequest_Key)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fixtrack_v1_fixtrack_proto_rawDesc), len(file_fixtrack_v1_fixtrack_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_fixtrack_v1_fixtrack_proto_goTypes,
+		DependencyIndexes: file_fixtrack_v1_fixtrack_proto_depIdxs,
+		EnumInfos:         file_fixtrack_v1_fixtrack_proto_enumTypes,
+		MessageInfos:      file_fixtrack_v1_fixtrack_proto_msgTypes,
+	}.Build()
+	File_fixtrack_v1_fixtrack_proto = out.File
+	file_fixtrack_v1_fixtrack_proto_goTypes = nil
+	file_fixtrack_v1_fixtrack_proto_depIdxs = nil
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/repository"
+	"fix-track-bot/internal/service"
+	"fix-track-bot/internal/transport/discord"
+	"fix-track-bot/pkg/logger"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// cliTimeout bounds the work of the one-off administration commands
+const cliTimeout = 2 * time.Minute
+
+// runFunc runs a command with the configuration and logger it was started with
+type runFunc func(cfg *config.Config, logger *zap.Logger) error
+
+// newRootCommand builds the command line of the bot binary. Without a subcommand the bot
+// runs, as before subcommands existed.
+func newRootCommand() *cobra.Command {
+	root := &cobra.Command{
+		Use:   filepath.Base(os.Args[0]),
+		Short: "Discord bot tracking issues reported by customers",
+		Args:  cobra.NoArgs,
+		RunE:  withConfig(runBot),
+	}
+	root.CompletionOptions.DisableDefaultCmd = true
+
+	root.AddCommand(
+		&cobra.Command{
+			Use:   "run",
+			Short: "Run the bot (default)",
+			Args:  cobra.NoArgs,
+			RunE:  withConfig(runBot),
+		},
+		newMigrateCommand(),
+		newExportCommand(),
+		newCreateAPIKeyCommand(),
+		newRegisterCommandsCommand(),
+		newCleanupCommandsCommand(),
+	)
+	return root
+}
+
+// withConfig loads the configuration and logger before running a command. Once they are
+// loaded a failure is reported through the logger rather than with the usage.
+func withConfig(run runFunc) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		// The arguments were accepted, so failures from here on are not usage errors
+		cmd.SilenceUsage = true
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load configuration: %w", err)
+		}
+
+		log, err := logger.NewLogger(cfg.Logger)
+		if err != nil {
+			return fmt.Errorf("failed to initialize logger: %w", err)
+		}
+		defer log.Sync()
+
+		if err := run(cfg, log); err != nil {
+			cmd.SilenceErrors = true
+			log.Error("Command failed", zap.String("command", cmd.CommandPath()), zap.Error(err))
+			return err
+		}
+		return nil
+	}
+}
+
+// runBot runs the bot until it receives a shutdown signal
+func runBot(cfg *config.Config, log *zap.Logger) error {
+	log.Info("Starting Fix Track Bot",
+		zap.String("version", cfg.App.Version),
+		zap.String("environment", cfg.App.Environment),
+	)
+
+	// Create and run application
+	app, err := NewApp(cfg, log)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+
+	if err := app.Run(); err != nil {
+		return fmt.Errorf("application failed to run: %w", err)
+	}
+	return nil
+}
+
+// newMigrateCommand builds the migrate command, which applies or reverts database
+// migrations without starting the bot
+func newMigrateCommand() *cobra.Command {
+	migrate := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply pending database migrations or revert the latest ones",
+	}
+	migrate.AddCommand(
+		&cobra.Command{
+			Use:   "up",
+			Short: "Apply pending database migrations",
+			Args:  cobra.NoArgs,
+			RunE: withConfig(func(cfg *config.Config, logger *zap.Logger) error {
+				return withDatabase(cfg, logger, func(dbManager *repository.DatabaseManager) error {
+					return dbManager.Migrate()
+				})
+			}),
+		},
+		&cobra.Command{
+			Use:   "down [steps]",
+			Short: "Revert the latest database migration, or the given number of them",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				steps := 1
+				if len(args) > 0 {
+					var err error
+					if steps, err = strconv.Atoi(args[0]); err != nil || steps < 1 {
+						return fmt.Errorf("invalid number of steps %q", args[0])
+					}
+				}
+				return withConfig(func(cfg *config.Config, logger *zap.Logger) error {
+					return withDatabase(cfg, logger, func(dbManager *repository.DatabaseManager) error {
+						return dbManager.Rollback(steps)
+					})
+				})(cmd, args)
+			},
+		},
+	)
+	return migrate
+}
+
+// withDatabase opens the database for the duration of fn
+func withDatabase(cfg *config.Config, logger *zap.Logger, fn func(dbManager *repository.DatabaseManager) error) error {
+	dbManager, err := repository.NewDatabaseManager(&cfg.Database, logger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize database: %w", err)
+	}
+	defer dbManager.Close()
+
+	return fn(dbManager)
+}
+
+// newExportCommand builds the export command, which writes the issues of a project to a file
+func newExportCommand() *cobra.Command {
+	var project, format, milestone, output string
+	cmd := &cobra.Command{
+		Use:   "export --project ID",
+		Short: "Export the issues of a project",
+		Args:  cobra.NoArgs,
+		RunE: withConfig(func(cfg *config.Config, logger *zap.Logger) error {
+			projectID, err := uuid.Parse(project)
+			if err != nil {
+				return fmt.Errorf("--project must be a project ID: %w", err)
+			}
+
+			return withDatabase(cfg, logger, func(dbManager *repository.DatabaseManager) error {
+				db := dbManager.GetDB()
+				exportService := service.NewExportService(
+					repository.NewChannelRepository(db, logger),
+					repository.NewProjectRepository(db, logger),
+					repository.NewIssueRepository(db, logger),
+					repository.NewCustomFieldRepository(db, logger),
+					repository.NewMilestoneRepository(db, logger),
+					repository.NewGuildSettingsRepository(db, logger),
+					service.NewAuditService(repository.NewAuditLogRepository(db, logger), repository.NewUnitOfWork(db, logger), logger),
+					logger,
+				)
+
+				ctx, cancel := context.WithTimeout(context.Background(), cliTimeout)
+				defer cancel()
+
+				file, err := exportService.ExportProjectIssues(ctx, projectID, domain.ExportFormat(format), milestone)
+				if err != nil {
+					return err
+				}
+
+				path := output
+				if path == "" {
+					path = file.Name
+				}
+				if path == "-" {
+					_, err = os.Stdout.Write(file.Data)
+					return err
+				}
+				if err := os.WriteFile(path, file.Data, 0644); err != nil {
+					return fmt.Errorf("failed to write export: %w", err)
+				}
+
+				logger.Info("Export written", zap.String("path", path), zap.Int("issues", file.IssueCount))
+				return nil
+			})
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&project, "project", "", "ID of the project to export")
+	flags.StringVar(&format, "format", string(domain.ExportFormatCSV), "file format, csv or xlsx")
+	flags.StringVar(&milestone, "milestone", "", "only export the issues of this milestone")
+	flags.StringVar(&output, "output", "", "file to write, '-' for stdout (default: the generated file name)")
+	cmd.MarkFlagRequired("project")
+	return cmd
+}
+
+// newCreateAPIKeyCommand builds the create-api-key command, which issues a REST API key
+// and prints it once
+func newCreateAPIKeyCommand() *cobra.Command {
+	var name, scopes, customer string
+	cmd := &cobra.Command{
+		Use:   "create-api-key --name NAME",
+		Short: "Issue a REST API key for one customer or, without --customer, all of them",
+		Args:  cobra.NoArgs,
+		RunE: withConfig(func(cfg *config.Config, logger *zap.Logger) error {
+			var customerID *uuid.UUID
+			if customer != "" {
+				id, err := uuid.Parse(customer)
+				if err != nil {
+					return fmt.Errorf("--customer must be a customer ID: %w", err)
+				}
+				customerID = &id
+			}
+
+			return withDatabase(cfg, logger, func(dbManager *repository.DatabaseManager) error {
+				db := dbManager.GetDB()
+				apiKeyService := service.NewAPIKeyService(
+					repository.NewChannelRepository(db, logger),
+					repository.NewCustomerRepository(db, logger),
+					repository.NewAPIKeyRepository(db, logger),
+					service.NewAuditService(repository.NewAuditLogRepository(db, logger), repository.NewUnitOfWork(db, logger), logger),
+					logger,
+				)
+
+				ctx, cancel := context.WithTimeout(context.Background(), cliTimeout)
+				defer cancel()
+
+				key, token, err := apiKeyService.CreateOperatorKey(ctx, customerID, name, scopes)
+				if err != nil {
+					return err
+				}
+
+				// Only the hash is stored, so this is the one chance to copy the key
+				fmt.Println(token)
+				logger.Info("API key issued", zap.String("prefix", key.Prefix), zap.String("scopes", key.Scopes))
+				return nil
+			})
+		}),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&name, "name", "", "what the key is for, e.g. the integration using it")
+	flags.StringVar(&scopes, "scopes", "all", "all, or comma-separated scopes such as issues:read,projects:read")
+	flags.StringVar(&customer, "customer", "", "ID of the customer the key is restricted to (default: all customers)")
+	cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+// newRegisterCommandsCommand builds the register-commands command
+func newRegisterCommandsCommand() *cobra.Command {
+	var guildID string
+	cmd := &cobra.Command{
+		Use:   "register-commands",
+		Short: "Bring the slash commands registered globally or in one guild up to date",
+		Args:  cobra.NoArgs,
+		RunE: withConfig(func(cfg *config.Config, logger *zap.Logger) error {
+			return withCommandManager(cfg, logger, func(cmdMgr *discord.CommandManager) error {
+				return cmdMgr.SyncCommands(guildID)
+			})
+		}),
+	}
+	cmd.Flags().StringVar(&guildID, "guild", "", "ID of the guild to register the commands in (default: global)")
+	return cmd
+}
+
+// newCleanupCommandsCommand builds the cleanup-commands command
+func newCleanupCommandsCommand() *cobra.Command {
+	var guildID string
+	cmd := &cobra.Command{
+		Use:   "cleanup-commands",
+		Short: "Remove the registered slash commands globally or from one guild",
+		Args:  cobra.NoArgs,
+		RunE: withConfig(func(cfg *config.Config, logger *zap.Logger) error {
+			return withCommandManager(cfg, logger, func(cmdMgr *discord.CommandManager) error {
+				return cmdMgr.CleanupGuildCommands(guildID)
+			})
+		}),
+	}
+	cmd.Flags().StringVar(&guildID, "guild", "", "ID of the guild to remove the commands from (default: global)")
+	return cmd
+}
+
+// withCommandManager opens a Discord session for the duration of fn
+func withCommandManager(cfg *config.Config, logger *zap.Logger, fn func(cmdMgr *discord.CommandManager) error) error {
+	session, err := discordgo.New("Bot " + cfg.Discord.Token)
+	if err != nil {
+		return fmt.Errorf("failed to create Discord session: %w", err)
+	}
+	session.Identify.Intents = discordgo.IntentsGuilds
+
+	if err := session.Open(); err != nil {
+		return fmt.Errorf("failed to open Discord connection: %w", err)
+	}
+	defer session.Close()
+
+	return fn(discord.NewCommandManager(session, cfg.Discord.CommandScope, logger))
+}
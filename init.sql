@@ -4,8 +4,5 @@
 -- Create extensions if needed
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
--- Create indexes for better performance (GORM will create the tables)
--- These will be applied after GORM auto-migration
-
--- Note: GORM will handle table creation through auto-migration
+-- Tables and indexes are created by the versioned migrations the bot applies on startup
 -- This file is mainly for any additional setup or extensions
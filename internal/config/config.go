@@ -1,22 +1,61 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"net/mail"
+	"reflect"
 	"strings"
+	"time"
 
+	"fix-track-bot/internal/secrets"
 	"fix-track-bot/pkg/logger"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	App      AppConfig      `mapstructure:"app"`
-	Discord  DiscordConfig  `mapstructure:"discord"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Logger   logger.Config  `mapstructure:"logger"`
+	App         AppConfig         `mapstructure:"app"`
+	Discord     DiscordConfig     `mapstructure:"discord"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	HTTP        HTTPConfig        `mapstructure:"http"`
+	GRPC        GRPCConfig        `mapstructure:"grpc"`
+	SLA         SLAConfig         `mapstructure:"sla"`
+	GitHub      GitHubConfig      `mapstructure:"github"`
+	Jira        JiraConfig        `mapstructure:"jira"`
+	Slack       SlackConfig       `mapstructure:"slack"`
+	Email       EmailConfig       `mapstructure:"email"`
+	InboundMail InboundMailConfig `mapstructure:"inbound_email"`
+	Digest      DigestConfig      `mapstructure:"digest"`
+	Webhooks    WebhooksConfig    `mapstructure:"webhooks"`
+	OnCall      OnCallConfig      `mapstructure:"oncall"`
+	Recurring   RecurringConfig   `mapstructure:"recurring"`
+	Stale       StaleConfig       `mapstructure:"stale"`
+	DueDates    DueDatesConfig    `mapstructure:"due_dates"`
+	Escalation  EscalationConfig  `mapstructure:"escalation"`
+	Incidents   IncidentsConfig   `mapstructure:"incidents"`
+	RateLimit   RateLimitConfig   `mapstructure:"rate_limit"`
+	Permissions PermissionsConfig `mapstructure:"permissions"`
+	Features    FeaturesConfig    `mapstructure:"features"`
+	Cluster     ClusterConfig     `mapstructure:"cluster"`
+	Redis       RedisConfig       `mapstructure:"redis"`
+	Secrets     SecretsConfig     `mapstructure:"secrets"`
+	Logger      logger.Config     `mapstructure:"logger"`
+
+	secretValues map[string]string // Loaded from the secrets provider, keyed by configuration key
 }
 
+// secretsFetchTimeout bounds loading secrets at startup
+const secretsFetchTimeout = 30 * time.Second
+
+// Where slash commands are registered
+const (
+	CommandScopeGlobal = "global" // Once for every server; Discord may take a while to show changes
+	CommandScopeGuild  = "guild"  // In each server the bot is in; changes show right away
+)
+
 // AppConfig holds application-specific configuration
 type AppConfig struct {
 	Name        string `mapstructure:"name"`
@@ -27,8 +66,20 @@ type AppConfig struct {
 
 // DiscordConfig holds Discord bot configuration
 type DiscordConfig struct {
-	Token  string `mapstructure:"token"`
-	Prefix string `mapstructure:"prefix"`
+	Token   string   `mapstructure:"token"`
+	Prefix  string   `mapstructure:"prefix"`
+	Intents []string `mapstructure:"intents"` // Gateway intents, e.g. guild_messages; see discord.ParseIntents
+
+	ShardCount int   `mapstructure:"shard_count"` // Gateway shards of the bot; 0 uses the count Discord recommends
+	ShardIDs   []int `mapstructure:"shard_ids"`   // Shards run by this process; empty runs all of them
+
+	CommandScope string `mapstructure:"command_scope"` // Where slash commands are registered: global or guild
+
+	// Retries of message sends and edits that fail with a rate limit, a 5xx response or a network error
+	APIMaxAttempts      int           `mapstructure:"api_max_attempts"`      // Attempts per call, including the first
+	APIRetryBackoff     time.Duration `mapstructure:"api_retry_backoff"`     // Wait before the first retry; doubled after each retry
+	APICircuitThreshold int           `mapstructure:"api_circuit_threshold"` // Calls failing in a row that pause calls to Discord; 0 never pauses them
+	APICircuitCooldown  time.Duration `mapstructure:"api_circuit_cooldown"`  // How long calls are paused
 }
 
 // DatabaseConfig holds database configuration
@@ -41,6 +92,239 @@ type DatabaseConfig struct {
 	Database string `mapstructure:"database"`
 	SSLMode  string `mapstructure:"ssl_mode"`
 	FilePath string `mapstructure:"file_path"` // For SQLite
+
+	// Connection pool
+	MaxOpenConns    int           `mapstructure:"max_open_conns"` // 0 means unlimited
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`  // 0 keeps connections forever
+	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"` // 0 keeps idle connections forever
+
+	ChannelCacheTTL time.Duration `mapstructure:"channel_cache_ttl"` // How long channel registrations are cached; 0 disables the cache
+}
+
+// HTTPConfig holds REST API server configuration
+type HTTPConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Address         string        `mapstructure:"address"`
+	ReadTimeout     time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout    time.Duration `mapstructure:"write_timeout"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+}
+
+// GRPCConfig holds gRPC API server configuration
+type GRPCConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Address         string        `mapstructure:"address"`
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"` // How long in-flight calls may finish before they are cancelled
+}
+
+// SLAConfig holds service level tracking configuration
+type SLAConfig struct {
+	Enabled             bool                       `mapstructure:"enabled"`
+	CheckInterval       time.Duration              `mapstructure:"check_interval"`
+	WarningThreshold    float64                    `mapstructure:"warning_threshold"`     // Fraction of a target after which a warning is sent
+	EscalationChannelID string                     `mapstructure:"escalation_channel_id"` // Discord channel for breach alerts (optional)
+	Targets             map[string]SLATargetConfig `mapstructure:"targets"`               // Keyed by priority: low, medium, high
+	SeverityTargets     map[string]SLATargetConfig `mapstructure:"severity_targets"`      // Keyed by severity: s1 to s4; used instead of Targets for issues with a severity
+}
+
+// SLATargetConfig holds the SLA targets for one priority or severity
+type SLATargetConfig struct {
+	Response   time.Duration `mapstructure:"response"`
+	Resolution time.Duration `mapstructure:"resolution"`
+}
+
+// GitHubConfig holds GitHub Issues sync configuration
+type GitHubConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	Token         string `mapstructure:"token"`          // Token with issues read/write access
+	WebhookSecret string `mapstructure:"webhook_secret"` // Secret used to verify webhook signatures
+	APIBaseURL    string `mapstructure:"api_base_url"`   // Override for GitHub Enterprise
+}
+
+// JiraConfig holds Jira ticket sync configuration
+type JiraConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	BaseURL          string        `mapstructure:"base_url"`          // e.g. https://your-team.atlassian.net
+	Email            string        `mapstructure:"email"`             // Account the API token belongs to
+	APIToken         string        `mapstructure:"api_token"`         // Token with browse and create issue permissions
+	IssueType        string        `mapstructure:"issue_type"`        // Issue type of created tickets
+	PollInterval     time.Duration `mapstructure:"poll_interval"`     // How often Jira statuses are checked
+	ResolvedStatuses []string      `mapstructure:"resolved_statuses"` // Jira statuses that resolve the bot issue
+	ClosedStatuses   []string      `mapstructure:"closed_statuses"`   // Jira statuses that close the bot issue
+}
+
+// SlackConfig holds Slack notification configuration. Webhook URLs are set per project.
+type SlackConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// EmailConfig holds the SMTP server used to email customer contacts about their issues
+type EmailConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	Host          string `mapstructure:"host"`
+	Port          int    `mapstructure:"port"`
+	Username      string `mapstructure:"username"` // Leave empty for servers without authentication
+	Password      string `mapstructure:"password"`
+	From          string `mapstructure:"from"`            // Sender address, e.g. "Support <support@example.com>"
+	StatusPageURL string `mapstructure:"status_page_url"` // Base URL of the public status pages linked in emails (optional)
+}
+
+// InboundMailConfig holds configuration for filing emails sent to project support addresses
+// as issues. A mail provider forwards them to POST /webhooks/email.
+type InboundMailConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Secret  string `mapstructure:"secret"` // Shared secret the provider sends as bearer token or token query parameter
+}
+
+// DigestConfig holds periodic digest report configuration
+type DigestConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	Schedule   string        `mapstructure:"schedule"`    // Five-field cron expression
+	Timezone   string        `mapstructure:"timezone"`    // IANA time zone the schedule is evaluated in
+	Period     time.Duration `mapstructure:"period"`      // Reporting window ending at each run
+	StaleAfter time.Duration `mapstructure:"stale_after"` // Unresolved issues untouched this long are listed as stale
+}
+
+// WebhooksConfig holds outbound webhook delivery configuration
+type WebhooksConfig struct {
+	Timeout      time.Duration `mapstructure:"timeout"`       // Per-attempt request timeout
+	MaxAttempts  int           `mapstructure:"max_attempts"`  // Attempts per delivery, including the first
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"` // Wait before the first retry; doubled after each retry
+}
+
+// OnCallConfig holds on-call rotation and auto-assignment configuration
+type OnCallConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	RotationPeriod time.Duration `mapstructure:"rotation_period"` // How long each member stays on call; 0 rotates only on handoff
+	CheckInterval  time.Duration `mapstructure:"check_interval"`  // How often due rotations are advanced
+	PresenceAware  bool          `mapstructure:"presence_aware"`  // Skip offline members when auto-assigning; needs the Presence intent
+}
+
+// RecurringConfig holds configuration for issues filed on a schedule with /recurring
+type RecurringConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Timezone      string        `mapstructure:"timezone"`       // IANA time zone schedules are evaluated in
+	CheckInterval time.Duration `mapstructure:"check_interval"` // How often due recurring issues are filed
+}
+
+// StaleConfig holds stale issue nudging configuration. Projects can override the
+// thresholds with /stale.
+type StaleConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	After         time.Duration `mapstructure:"after"`          // Time without a status change or thread comment before assignees are nudged
+	CloseAfter    time.Duration `mapstructure:"close_after"`    // Further idle time after the nudge before the issue is closed; 0 never closes
+	CheckInterval time.Duration `mapstructure:"check_interval"` // How often issues are checked
+}
+
+// DueDatesConfig holds configuration for issue due dates set with /due and their reminders
+type DueDatesConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`        // Remind assignees of due dates
+	Timezone      string        `mapstructure:"timezone"`       // IANA time zone due dates are entered in
+	RemindBefore  time.Duration `mapstructure:"remind_before"`  // How long before the due date assignees are reminded
+	CheckInterval time.Duration `mapstructure:"check_interval"` // How often due dates are checked
+}
+
+// EscalationConfig holds configuration for escalating issues with the rules set per
+// project with /escalation
+type EscalationConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	CheckInterval time.Duration `mapstructure:"check_interval"` // How often open issues are checked against the rules
+}
+
+// IncidentsConfig holds configuration for the status update reminders of incidents
+// declared with /incident
+type IncidentsConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	UpdateInterval time.Duration `mapstructure:"update_interval"` // How often the commander of an incident is expected to post a status update
+	CheckInterval  time.Duration `mapstructure:"check_interval"`  // How often incidents are checked for a status update due
+}
+
+// RateLimitConfig holds abuse protection configuration
+type RateLimitConfig struct {
+	MaxIssues   int           `mapstructure:"max_issues"`   // Issues one user may create in one channel per window; 0 disables the limit
+	IssueWindow time.Duration `mapstructure:"issue_window"` // Sliding window the issue limit applies to
+}
+
+// PermissionsConfig holds role-based permission configuration
+type PermissionsConfig struct {
+	RoleMappings map[string]string `mapstructure:"role_mappings"` // Discord role ID or name -> customer, support or admin
+}
+
+// FeaturesConfig holds the default state of experimental features; guilds override it
+// with /feature
+type FeaturesConfig struct {
+	Board        bool `mapstructure:"board"`        // /board and its pinned issue boards
+	Autocomplete bool `mapstructure:"autocomplete"` // Suggestions for issue, project and milestone options
+	ForumMode    bool `mapstructure:"forum_mode"`   // Registering forum channels
+	AutoAssign   bool `mapstructure:"auto_assign"`  // Assigning opened issues to the best-matching team member
+}
+
+// ClusterConfig holds configuration for running several replicas of the bot against one
+// PostgreSQL database
+type ClusterConfig struct {
+	Enabled bool `mapstructure:"enabled"` // Run scheduled jobs on one replica and share handled events between them
+}
+
+// RedisConfig holds the optional Redis server replicas of the bot share state through:
+// the channel cache, the issue rate limit, handled events and submissions held back
+// by the duplicate check
+type RedisConfig struct {
+	Enabled   bool   `mapstructure:"enabled"`
+	Address   string `mapstructure:"address"`  // host:port
+	Password  string `mapstructure:"password"` // Leave empty for servers without authentication
+	DB        int    `mapstructure:"db"`
+	KeyPrefix string `mapstructure:"key_prefix"` // Starts every key, so several bots can share a server
+}
+
+// SecretsConfig holds the external store secrets are loaded from at startup. Its
+// secrets override the configuration file and environment variables.
+type SecretsConfig struct {
+	Provider        string        `mapstructure:"provider"`         // vault, aws or sops; empty loads no secrets
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"` // How often secrets are fetched again to apply rotations; 0 never
+	Vault           VaultConfig   `mapstructure:"vault"`
+	AWS             AWSConfig     `mapstructure:"aws"`
+	SOPS            SOPSConfig    `mapstructure:"sops"`
+}
+
+// VaultConfig holds the HashiCorp Vault KV version 2 secret the bot reads
+type VaultConfig struct {
+	Address string `mapstructure:"address"` // e.g. https://vault.example.com:8200; VAULT_ADDR works too
+	Token   string `mapstructure:"token"`   // VAULT_TOKEN works too
+	Mount   string `mapstructure:"mount"`   // Mount path of the KV engine
+	Path    string `mapstructure:"path"`    // Path of the secret in the engine
+}
+
+// AWSConfig holds the AWS Secrets Manager secret the bot reads
+type AWSConfig struct {
+	Region   string `mapstructure:"region"`    // AWS_REGION works too
+	SecretID string `mapstructure:"secret_id"` // Name or ARN of the secret
+}
+
+// SOPSConfig holds the SOPS-encrypted file the bot reads
+type SOPSConfig struct {
+	File string `mapstructure:"file"`
+}
+
+// NewProvider creates the configured secrets provider, or returns nil if none is configured
+func (c *SecretsConfig) NewProvider() (secrets.Provider, error) {
+	switch c.Provider {
+	case "":
+		return nil, nil
+	case "vault":
+		return secrets.NewVaultProvider(c.Vault.Address, c.Vault.Token, c.Vault.Mount, c.Vault.Path), nil
+	case "aws":
+		return secrets.NewAWSProvider(c.AWS.Region, c.AWS.SecretID)
+	case "sops":
+		return secrets.NewSOPSProvider(c.SOPS.File)
+	default:
+		return nil, fmt.Errorf("unsupported secrets provider: %s", c.Provider)
+	}
+}
+
+// SecretValues returns the secrets loaded from the secrets provider, keyed by configuration key
+func (c *Config) SecretValues() map[string]string {
+	return c.secretValues
 }
 
 // Load loads configuration from environment variables and config files
@@ -71,6 +355,11 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	// Secrets from an external store override the config file and environment variables
+	if err := loadSecrets(&config); err != nil {
+		return nil, err
+	}
+
 	// Validate configuration
 	if err := validate(&config); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
@@ -79,6 +368,87 @@ func Load() (*Config, error) {
 	return &config, nil
 }
 
+// Watch reloads the configuration whenever the config file changes and passes it to
+// onChange. A changed file that does not load or validate is passed to onError and
+// otherwise ignored. Watch reports whether a config file is in use to be watched.
+func Watch(onChange func(*Config), onError func(error)) bool {
+	if viper.ConfigFileUsed() == "" {
+		return false
+	}
+
+	viper.OnConfigChange(func(fsnotify.Event) {
+		config, err := reload()
+		if err != nil {
+			onError(err)
+			return
+		}
+		onChange(config)
+	})
+	viper.WatchConfig()
+	return true
+}
+
+// reload unmarshals the configuration viper read again; the secrets loaded at startup
+// are kept, as viper holds them as overrides
+func reload() (*Config, error) {
+	var config Config
+	if err := viper.Unmarshal(&config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if err := validate(&config); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return &config, nil
+}
+
+// ChangedSections returns the keys of the top-level sections that differ between two
+// configurations, e.g. "sla"
+func ChangedSections(old, new *Config) []string {
+	oldValue, newValue := reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem()
+
+	var keys []string
+	for i := range oldValue.NumField() {
+		key := oldValue.Type().Field(i).Tag.Get("mapstructure")
+		if key == "" {
+			continue // Not loaded from the configuration
+		}
+		if !reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// loadSecrets fetches the secrets of the configured provider and sets the configuration
+// keys they are stored under
+func loadSecrets(config *Config) error {
+	if err := validateSecrets(&config.Secrets); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	provider, err := config.Secrets.NewProvider()
+	if err != nil || provider == nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretsFetchTimeout)
+	defer cancel()
+	values, err := provider.Fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load secrets from %s: %w", provider.Name(), err)
+	}
+
+	for key, value := range values {
+		viper.Set(key, value)
+	}
+	var loaded Config
+	if err := viper.Unmarshal(&loaded); err != nil {
+		return fmt.Errorf("failed to unmarshal config with secrets: %w", err)
+	}
+	loaded.secretValues = values
+	*config = loaded
+	return nil
+}
+
 // setDefaults sets default configuration values
 func setDefaults() {
 	// App defaults
@@ -89,6 +459,13 @@ func setDefaults() {
 
 	// Discord defaults
 	viper.SetDefault("discord.prefix", "!")
+	viper.SetDefault("discord.intents", []string{"guilds", "guild_messages", "guild_message_reactions", "message_content"})
+	viper.SetDefault("discord.shard_count", 0)
+	viper.SetDefault("discord.command_scope", CommandScopeGlobal)
+	viper.SetDefault("discord.api_max_attempts", 3)
+	viper.SetDefault("discord.api_retry_backoff", "500ms")
+	viper.SetDefault("discord.api_circuit_threshold", 5)
+	viper.SetDefault("discord.api_circuit_cooldown", "30s")
 
 	// Database defaults
 	viper.SetDefault("database.driver", "sqlite")
@@ -96,6 +473,140 @@ func setDefaults() {
 	viper.SetDefault("database.port", 5432)
 	viper.SetDefault("database.ssl_mode", "disable")
 	viper.SetDefault("database.file_path", "./data/fix-track.db")
+	viper.SetDefault("database.max_open_conns", 25)
+	viper.SetDefault("database.max_idle_conns", 10)
+	viper.SetDefault("database.conn_max_lifetime", "30m")
+	viper.SetDefault("database.conn_max_idle_time", "5m")
+	viper.SetDefault("database.channel_cache_ttl", "1m")
+
+	// HTTP defaults
+	viper.SetDefault("http.enabled", false)
+	viper.SetDefault("http.address", ":8080")
+	viper.SetDefault("http.read_timeout", "10s")
+	viper.SetDefault("http.write_timeout", "10s")
+	viper.SetDefault("http.shutdown_timeout", "5s")
+
+	// gRPC defaults
+	viper.SetDefault("grpc.enabled", false)
+	viper.SetDefault("grpc.address", ":9090")
+	viper.SetDefault("grpc.shutdown_timeout", "5s")
+
+	// SLA defaults
+	viper.SetDefault("sla.enabled", false)
+	viper.SetDefault("sla.check_interval", "5m")
+	viper.SetDefault("sla.warning_threshold", 0.8)
+	viper.SetDefault("sla.targets.high.response", "1h")
+	viper.SetDefault("sla.targets.high.resolution", "8h")
+	viper.SetDefault("sla.targets.medium.response", "4h")
+	viper.SetDefault("sla.targets.medium.resolution", "72h")
+	viper.SetDefault("sla.targets.low.response", "24h")
+	viper.SetDefault("sla.targets.low.resolution", "168h")
+	viper.SetDefault("sla.severity_targets.s1.response", "15m")
+	viper.SetDefault("sla.severity_targets.s1.resolution", "4h")
+	viper.SetDefault("sla.severity_targets.s2.response", "1h")
+	viper.SetDefault("sla.severity_targets.s2.resolution", "24h")
+	viper.SetDefault("sla.severity_targets.s3.response", "8h")
+	viper.SetDefault("sla.severity_targets.s3.resolution", "120h")
+	viper.SetDefault("sla.severity_targets.s4.response", "24h")
+	viper.SetDefault("sla.severity_targets.s4.resolution", "336h")
+
+	// GitHub defaults
+	viper.SetDefault("github.enabled", false)
+	viper.SetDefault("github.token", "")
+	viper.SetDefault("github.webhook_secret", "")
+	viper.SetDefault("github.api_base_url", "https://api.github.com")
+
+	// Jira defaults
+	viper.SetDefault("jira.enabled", false)
+	viper.SetDefault("jira.base_url", "")
+	viper.SetDefault("jira.email", "")
+	viper.SetDefault("jira.api_token", "")
+	viper.SetDefault("jira.issue_type", "Task")
+	viper.SetDefault("jira.poll_interval", "5m")
+	viper.SetDefault("jira.resolved_statuses", []string{"Resolved"})
+	viper.SetDefault("jira.closed_statuses", []string{"Closed", "Done"})
+
+	// Slack defaults
+	viper.SetDefault("slack.enabled", false)
+
+	// Email defaults
+	viper.SetDefault("email.enabled", false)
+	viper.SetDefault("email.port", 587)
+
+	// Inbound email defaults
+	viper.SetDefault("inbound_email.enabled", false)
+
+	// Digest defaults
+	viper.SetDefault("digest.enabled", false)
+	viper.SetDefault("digest.schedule", "0 9 * * 1")
+	viper.SetDefault("digest.timezone", "UTC")
+	viper.SetDefault("digest.period", "168h")
+	viper.SetDefault("digest.stale_after", "168h")
+
+	// Webhook defaults
+	viper.SetDefault("webhooks.timeout", "10s")
+	viper.SetDefault("webhooks.max_attempts", 5)
+	viper.SetDefault("webhooks.retry_backoff", "2s")
+
+	// On-call defaults
+	viper.SetDefault("oncall.enabled", false)
+	viper.SetDefault("oncall.rotation_period", "168h")
+	viper.SetDefault("oncall.check_interval", "5m")
+	viper.SetDefault("oncall.presence_aware", false)
+
+	// Recurring issue defaults
+	viper.SetDefault("recurring.enabled", true)
+	viper.SetDefault("recurring.timezone", "UTC")
+	viper.SetDefault("recurring.check_interval", "1m")
+
+	// Stale issue defaults
+	viper.SetDefault("stale.enabled", false)
+	viper.SetDefault("stale.after", "336h")
+	viper.SetDefault("stale.close_after", "0")
+	viper.SetDefault("stale.check_interval", "1h")
+
+	// Due date defaults
+	viper.SetDefault("due_dates.enabled", true)
+	viper.SetDefault("due_dates.timezone", "UTC")
+	viper.SetDefault("due_dates.remind_before", "24h")
+	viper.SetDefault("due_dates.check_interval", "5m")
+
+	// Escalation defaults
+	viper.SetDefault("escalation.enabled", true)
+	viper.SetDefault("escalation.check_interval", "5m")
+
+	// Incident defaults
+	viper.SetDefault("incidents.enabled", true)
+	viper.SetDefault("incidents.update_interval", "30m")
+	viper.SetDefault("incidents.check_interval", "1m")
+
+	// Rate limit defaults
+	viper.SetDefault("rate_limit.max_issues", 5)
+	viper.SetDefault("rate_limit.issue_window", "10m")
+
+	// Feature flag defaults; features released before flags existed stay on
+	viper.SetDefault("features.board", true)
+	viper.SetDefault("features.autocomplete", true)
+	viper.SetDefault("features.forum_mode", true)
+	viper.SetDefault("features.auto_assign", false)
+
+	// Cluster defaults
+	viper.SetDefault("cluster.enabled", false)
+
+	// Redis defaults
+	viper.SetDefault("redis.enabled", false)
+	viper.SetDefault("redis.address", "localhost:6379")
+	viper.SetDefault("redis.db", 0)
+	viper.SetDefault("redis.key_prefix", "fix-track-bot:")
+
+	// Secrets defaults; the standard Vault and AWS variables are read too
+	viper.SetDefault("secrets.provider", "")
+	viper.SetDefault("secrets.refresh_interval", "0")
+	viper.SetDefault("secrets.vault.mount", "secret")
+	viper.SetDefault("secrets.vault.path", "fix-track-bot")
+	_ = viper.BindEnv("secrets.vault.address", "SECRETS_VAULT_ADDRESS", "VAULT_ADDR")
+	_ = viper.BindEnv("secrets.vault.token", "SECRETS_VAULT_TOKEN", "VAULT_TOKEN")
+	_ = viper.BindEnv("secrets.aws.region", "SECRETS_AWS_REGION", "AWS_REGION")
 
 	// Logger defaults
 	viper.SetDefault("logger.level", "info")
@@ -109,6 +620,37 @@ func validate(config *Config) error {
 	if strings.TrimSpace(config.Discord.Token) == "" {
 		return fmt.Errorf("discord token is required")
 	}
+	if len(config.Discord.Intents) == 0 {
+		return fmt.Errorf("at least one discord intent is required")
+	}
+	if config.Discord.ShardCount < 0 {
+		return fmt.Errorf("discord shard count cannot be negative")
+	}
+	seenShards := make(map[int]bool, len(config.Discord.ShardIDs))
+	for _, id := range config.Discord.ShardIDs {
+		if id < 0 || (config.Discord.ShardCount > 0 && id >= config.Discord.ShardCount) {
+			return fmt.Errorf("discord shard ID %d must be between 0 and the shard count", id)
+		}
+		if seenShards[id] {
+			return fmt.Errorf("discord shard ID %d is listed twice", id)
+		}
+		seenShards[id] = true
+	}
+	if config.Discord.CommandScope != CommandScopeGlobal && config.Discord.CommandScope != CommandScopeGuild {
+		return fmt.Errorf("unsupported discord command scope: %s", config.Discord.CommandScope)
+	}
+	if config.Discord.APIMaxAttempts < 1 {
+		return fmt.Errorf("discord api_max_attempts must be at least 1")
+	}
+	if config.Discord.APIRetryBackoff < 0 {
+		return fmt.Errorf("discord api_retry_backoff cannot be negative")
+	}
+	if config.Discord.APICircuitThreshold < 0 {
+		return fmt.Errorf("discord api_circuit_threshold cannot be negative")
+	}
+	if config.Discord.APICircuitThreshold > 0 && config.Discord.APICircuitCooldown <= 0 {
+		return fmt.Errorf("discord api_circuit_cooldown must be positive")
+	}
 
 	// Validate database configuration
 	if config.Database.Driver == "" {
@@ -134,6 +676,255 @@ func validate(config *Config) error {
 		}
 	}
 
+	if config.Database.MaxOpenConns < 0 || config.Database.MaxIdleConns < 0 {
+		return fmt.Errorf("database connection limits cannot be negative")
+	}
+	if config.Database.MaxOpenConns > 0 && config.Database.MaxIdleConns > config.Database.MaxOpenConns {
+		return fmt.Errorf("database max idle connections cannot exceed max open connections")
+	}
+	if config.Database.ConnMaxLifetime < 0 || config.Database.ConnMaxIdleTime < 0 {
+		return fmt.Errorf("database connection lifetimes cannot be negative")
+	}
+	if config.Database.ChannelCacheTTL < 0 {
+		return fmt.Errorf("database channel cache ttl cannot be negative")
+	}
+
+	// Validate HTTP configuration
+	if config.HTTP.Enabled && strings.TrimSpace(config.HTTP.Address) == "" {
+		return fmt.Errorf("http address is required when the HTTP server is enabled")
+	}
+
+	// Validate gRPC configuration
+	if config.GRPC.Enabled && strings.TrimSpace(config.GRPC.Address) == "" {
+		return fmt.Errorf("grpc address is required when the gRPC server is enabled")
+	}
+
+	// Validate SLA configuration
+	if config.SLA.Enabled {
+		if config.SLA.CheckInterval <= 0 {
+			return fmt.Errorf("sla check interval must be positive")
+		}
+		if config.SLA.WarningThreshold <= 0 || config.SLA.WarningThreshold >= 1 {
+			return fmt.Errorf("sla warning threshold must be between 0 and 1")
+		}
+		for priority, target := range config.SLA.Targets {
+			if priority != "low" && priority != "medium" && priority != "high" {
+				return fmt.Errorf("unsupported sla target priority: %s", priority)
+			}
+			if target.Response < 0 || target.Resolution < 0 {
+				return fmt.Errorf("sla targets for %s priority cannot be negative", priority)
+			}
+		}
+		for severity, target := range config.SLA.SeverityTargets {
+			if severity != "s1" && severity != "s2" && severity != "s3" && severity != "s4" {
+				return fmt.Errorf("unsupported sla target severity: %s", severity)
+			}
+			if target.Response < 0 || target.Resolution < 0 {
+				return fmt.Errorf("sla targets for %s severity cannot be negative", severity)
+			}
+		}
+	}
+
+	// Validate GitHub configuration
+	if config.GitHub.Enabled {
+		if strings.TrimSpace(config.GitHub.Token) == "" {
+			return fmt.Errorf("github token is required when GitHub sync is enabled")
+		}
+		if strings.TrimSpace(config.GitHub.WebhookSecret) == "" {
+			return fmt.Errorf("github webhook secret is required when GitHub sync is enabled")
+		}
+		if !config.HTTP.Enabled {
+			return fmt.Errorf("the HTTP server must be enabled to receive GitHub webhooks")
+		}
+	}
+
+	// Validate Jira configuration
+	if config.Jira.Enabled {
+		if strings.TrimSpace(config.Jira.BaseURL) == "" {
+			return fmt.Errorf("jira base url is required when Jira sync is enabled")
+		}
+		if strings.TrimSpace(config.Jira.Email) == "" || strings.TrimSpace(config.Jira.APIToken) == "" {
+			return fmt.Errorf("jira email and api token are required when Jira sync is enabled")
+		}
+		if strings.TrimSpace(config.Jira.IssueType) == "" {
+			return fmt.Errorf("jira issue type is required when Jira sync is enabled")
+		}
+		if config.Jira.PollInterval <= 0 {
+			return fmt.Errorf("jira poll interval must be positive")
+		}
+	}
+
+	// Validate email configuration
+	if config.Email.Enabled {
+		if strings.TrimSpace(config.Email.Host) == "" {
+			return fmt.Errorf("email host is required when email notifications are enabled")
+		}
+		if config.Email.Port <= 0 || config.Email.Port > 65535 {
+			return fmt.Errorf("email port must be between 1 and 65535")
+		}
+		if _, err := mail.ParseAddress(config.Email.From); err != nil {
+			return fmt.Errorf("email from must be a valid address: %w", err)
+		}
+	}
+
+	// Validate inbound email configuration
+	if config.InboundMail.Enabled {
+		if strings.TrimSpace(config.InboundMail.Secret) == "" {
+			return fmt.Errorf("inbound email secret is required when inbound email is enabled")
+		}
+		if !config.HTTP.Enabled {
+			return fmt.Errorf("the HTTP server must be enabled to receive inbound email")
+		}
+	}
+
+	// Validate digest configuration
+	if config.Digest.Enabled {
+		if strings.TrimSpace(config.Digest.Schedule) == "" {
+			return fmt.Errorf("digest schedule is required when digests are enabled")
+		}
+		if _, err := time.LoadLocation(config.Digest.Timezone); err != nil {
+			return fmt.Errorf("invalid digest timezone %q: %w", config.Digest.Timezone, err)
+		}
+		if config.Digest.Period <= 0 {
+			return fmt.Errorf("digest period must be positive")
+		}
+		if config.Digest.StaleAfter <= 0 {
+			return fmt.Errorf("digest stale_after must be positive")
+		}
+	}
+
+	// Validate webhook configuration
+	if config.Webhooks.Timeout <= 0 {
+		return fmt.Errorf("webhook timeout must be positive")
+	}
+	if config.Webhooks.MaxAttempts < 1 {
+		return fmt.Errorf("webhook max_attempts must be at least 1")
+	}
+	if config.Webhooks.RetryBackoff < 0 {
+		return fmt.Errorf("webhook retry_backoff cannot be negative")
+	}
+
+	// Validate on-call configuration
+	if config.OnCall.Enabled {
+		if config.OnCall.RotationPeriod < 0 {
+			return fmt.Errorf("oncall rotation_period cannot be negative")
+		}
+		if config.OnCall.CheckInterval <= 0 {
+			return fmt.Errorf("oncall check interval must be positive")
+		}
+	}
+
+	// Validate recurring issue configuration; /recurring add uses the time zone even when
+	// issues are not filed
+	if _, err := time.LoadLocation(config.Recurring.Timezone); err != nil {
+		return fmt.Errorf("invalid recurring timezone %q: %w", config.Recurring.Timezone, err)
+	}
+	if config.Recurring.Enabled && config.Recurring.CheckInterval <= 0 {
+		return fmt.Errorf("recurring check interval must be positive")
+	}
+
+	// Validate stale issue configuration
+	if config.Stale.Enabled {
+		if config.Stale.After < 0 || config.Stale.CloseAfter < 0 {
+			return fmt.Errorf("stale after and close_after cannot be negative")
+		}
+		if config.Stale.CheckInterval <= 0 {
+			return fmt.Errorf("stale check interval must be positive")
+		}
+	}
+
+	// Validate due date configuration; /due uses the time zone even when reminders are off
+	if _, err := time.LoadLocation(config.DueDates.Timezone); err != nil {
+		return fmt.Errorf("invalid due_dates timezone %q: %w", config.DueDates.Timezone, err)
+	}
+	if config.DueDates.Enabled {
+		if config.DueDates.RemindBefore < 0 {
+			return fmt.Errorf("due_dates remind_before cannot be negative")
+		}
+		if config.DueDates.CheckInterval <= 0 {
+			return fmt.Errorf("due_dates check interval must be positive")
+		}
+	}
+
+	// Validate escalation configuration
+	if config.Escalation.Enabled && config.Escalation.CheckInterval <= 0 {
+		return fmt.Errorf("escalation check interval must be positive")
+	}
+
+	// Validate incident configuration
+	if config.Incidents.Enabled {
+		if config.Incidents.UpdateInterval <= 0 {
+			return fmt.Errorf("incidents update interval must be positive")
+		}
+		if config.Incidents.CheckInterval <= 0 {
+			return fmt.Errorf("incidents check interval must be positive")
+		}
+	}
+
+	// Validate rate limit configuration
+	if config.RateLimit.MaxIssues < 0 {
+		return fmt.Errorf("rate_limit max_issues cannot be negative")
+	}
+	if config.RateLimit.MaxIssues > 0 && config.RateLimit.IssueWindow <= 0 {
+		return fmt.Errorf("rate_limit issue_window must be positive")
+	}
+
+	// Validate permission configuration
+	for discordRole, role := range config.Permissions.RoleMappings {
+		if role != "customer" && role != "support" && role != "admin" {
+			return fmt.Errorf("unsupported role %q mapped to Discord role %q", role, discordRole)
+		}
+	}
+
+	// Validate cluster configuration; replicas coordinate through PostgreSQL advisory locks
+	if config.Cluster.Enabled && config.Database.Driver != "postgres" {
+		return fmt.Errorf("cluster mode needs the postgres database driver")
+	}
+
+	if err := validateSecrets(&config.Secrets); err != nil {
+		return err
+	}
+
+	// Validate Redis configuration
+	if config.Redis.Enabled {
+		if strings.TrimSpace(config.Redis.Address) == "" {
+			return fmt.Errorf("redis address is required when Redis is enabled")
+		}
+		if config.Redis.DB < 0 {
+			return fmt.Errorf("redis db cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+// validateSecrets validates the secrets provider configuration, which is needed before
+// the rest of the configuration is complete
+func validateSecrets(config *SecretsConfig) error {
+	if config.RefreshInterval < 0 {
+		return fmt.Errorf("secrets refresh interval cannot be negative")
+	}
+
+	switch config.Provider {
+	case "":
+	case "vault":
+		if strings.TrimSpace(config.Vault.Address) == "" || strings.TrimSpace(config.Vault.Token) == "" {
+			return fmt.Errorf("vault address and token are required for the vault secrets provider")
+		}
+		if strings.TrimSpace(config.Vault.Mount) == "" || strings.TrimSpace(config.Vault.Path) == "" {
+			return fmt.Errorf("vault mount and path are required for the vault secrets provider")
+		}
+	case "aws":
+		if strings.TrimSpace(config.AWS.Region) == "" || strings.TrimSpace(config.AWS.SecretID) == "" {
+			return fmt.Errorf("aws region and secret_id are required for the aws secrets provider")
+		}
+	case "sops":
+		if strings.TrimSpace(config.SOPS.File) == "" {
+			return fmt.Errorf("sops file is required for the sops secrets provider")
+		}
+	default:
+		return fmt.Errorf("unsupported secrets provider: %s", config.Provider)
+	}
 	return nil
 }
 
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyScope is a part of the REST API an API key may use
+type APIKeyScope string
+
+const (
+	ScopeIssuesRead     APIKeyScope = "issues:read"
+	ScopeIssuesWrite    APIKeyScope = "issues:write"
+	ScopeProjectsRead   APIKeyScope = "projects:read"
+	ScopeProjectsWrite  APIKeyScope = "projects:write"
+	ScopeCustomersRead  APIKeyScope = "customers:read"
+	ScopeCustomersWrite APIKeyScope = "customers:write"
+)
+
+// AllAPIKeyScopes lists every scope in the order they are displayed
+var AllAPIKeyScopes = []APIKeyScope{
+	ScopeIssuesRead, ScopeIssuesWrite,
+	ScopeProjectsRead, ScopeProjectsWrite,
+	ScopeCustomersRead, ScopeCustomersWrite,
+}
+
+const (
+	// APIKeyTokenPrefix starts every API key so leaked keys are easy to recognize
+	APIKeyTokenPrefix = "stb_"
+	// apiKeyDisplayLength is how much of a key is kept to tell keys apart
+	apiKeyDisplayLength = len(APIKeyTokenPrefix) + 8
+	// MaxAPIKeyNameLength limits key names to the size of their column
+	MaxAPIKeyNameLength = 100
+	// APIKeyTouchInterval throttles how often the last use of a key is recorded
+	APIKeyTouchInterval = time.Minute
+)
+
+// APIKey grants access to the REST API. Keys of a customer only reach that customer's
+// projects and issues; keys without a customer are issued to operators from the CLI and
+// reach everything. Only the SHA-256 hash of the key is stored.
+type APIKey struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CustomerID *uuid.UUID `json:"customer_id,omitempty" gorm:"type:uuid;index"`
+	Name       string     `json:"name" gorm:"size:100;not null"`
+	Prefix     string     `json:"prefix" gorm:"size:20;not null;index"` // Start of the key, shown to tell keys apart
+	Hash       string     `json:"-" gorm:"size:64;not null;uniqueIndex"`
+	Scopes     string     `json:"scopes" gorm:"size:255;not null"`      // Comma-separated APIKeyScope values
+	CreatedBy  string     `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the admin who issued it; empty for the CLI
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" gorm:"type:timestamptz"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" gorm:"type:timestamptz"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for APIKey
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// IsRevoked checks if the key was revoked
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// HasScope checks if the key grants the scope. A write scope grants reading too.
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.ScopeList() {
+		if s == scope || s == writeScope(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopeList returns the scopes the key grants
+func (k *APIKey) ScopeList() []APIKeyScope {
+	var scopes []APIKeyScope
+	for _, s := range strings.Split(k.Scopes, ",") {
+		if s != "" {
+			scopes = append(scopes, APIKeyScope(s))
+		}
+	}
+	return scopes
+}
+
+// CanAccessCustomer checks if the key may reach the data of a customer
+func (k *APIKey) CanAccessCustomer(customerID uuid.UUID) bool {
+	return k.CustomerID == nil || *k.CustomerID == customerID
+}
+
+// NeedsTouch checks if the last use of the key is due to be recorded again
+func (k *APIKey) NeedsTouch(now time.Time) bool {
+	return k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= APIKeyTouchInterval
+}
+
+// writeScope returns the write scope that grants a read scope
+func writeScope(scope APIKeyScope) APIKeyScope {
+	resource, _, _ := strings.Cut(string(scope), ":")
+	return APIKeyScope(resource + ":write")
+}
+
+// ParseAPIKeyScopes parses comma- or space-separated scopes. "all" grants every scope.
+// Duplicates are dropped and the scopes are returned in display order.
+func ParseAPIKeyScopes(input string) ([]APIKeyScope, error) {
+	fields := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, ErrInvalidAPIKeyScope
+	}
+
+	requested := make(map[APIKeyScope]bool)
+	for _, field := range fields {
+		if field == "all" {
+			return AllAPIKeyScopes, nil
+		}
+		if !IsValidAPIKeyScope(APIKeyScope(field)) {
+			return nil, ErrInvalidAPIKeyScope
+		}
+		requested[APIKeyScope(field)] = true
+	}
+
+	var scopes []APIKeyScope
+	for _, scope := range AllAPIKeyScopes {
+		if requested[scope] {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// IsValidAPIKeyScope checks if a scope is known
+func IsValidAPIKeyScope(scope APIKeyScope) bool {
+	for _, s := range AllAPIKeyScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinAPIKeyScopes stores scopes in the comma-separated form of APIKey.Scopes
+func JoinAPIKeyScopes(scopes []APIKeyScope) string {
+	parts := make([]string, len(scopes))
+	for i, s := range scopes {
+		parts[i] = string(s)
+	}
+	return strings.Join(parts, ",")
+}
+
+// NormalizeAPIKeyName trims an API key name
+func NormalizeAPIKeyName(name string) string {
+	return strings.TrimSpace(name)
+}
+
+// IsValidAPIKeyName checks that an API key name is present and fits its column
+func IsValidAPIKeyName(name string) bool {
+	return name != "" && len([]rune(name)) <= MaxAPIKeyNameLength
+}
+
+// GenerateAPIKey returns a new random API key and the prefix shown to tell it apart
+func GenerateAPIKey() (token, prefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = APIKeyTokenPrefix + hex.EncodeToString(b)
+	return token, token[:apiKeyDisplayLength], nil
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 hash an API key is stored and looked up by
+func HashAPIKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
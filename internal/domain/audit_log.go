@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction represents a kind of administrative action recorded in the audit log
+type AuditAction string
+
+const (
+	AuditChannelRegistered     AuditAction = "channel_registered"
+	AuditChannelUpdated        AuditAction = "channel_updated"
+	AuditChannelDeactivated    AuditAction = "channel_deactivated"
+	AuditChannelActivated      AuditAction = "channel_activated"
+	AuditChannelTransferred    AuditAction = "channel_transferred"
+	AuditChannelProjectAdded   AuditAction = "channel_project_added"
+	AuditChannelProjectRemoved AuditAction = "channel_project_removed"
+	AuditCustomerCreated       AuditAction = "customer_created"
+	AuditCustomerRenamed       AuditAction = "customer_renamed"
+	AuditCustomerMerged        AuditAction = "customer_merged"
+	AuditProjectCreated        AuditAction = "project_created"
+	AuditProjectRenamed        AuditAction = "project_renamed"
+	AuditProjectMerged         AuditAction = "project_merged"
+	AuditIssueClosed           AuditAction = "issue_closed"
+	AuditIssueAutoClosed       AuditAction = "issue_auto_closed"
+	AuditIssueReopened         AuditAction = "issue_reopened"
+	AuditIssuePriorityChanged  AuditAction = "issue_priority_changed"
+	AuditIssueSeverityChanged  AuditAction = "issue_severity_changed"
+	AuditIssueDeleted          AuditAction = "issue_deleted"
+	AuditIssueRestored         AuditAction = "issue_restored"
+	AuditIssueMoved            AuditAction = "issue_moved"
+	AuditIssuesExported        AuditAction = "issues_exported"
+	AuditAPIKeyCreated         AuditAction = "api_key_created"
+	AuditAPIKeyRevoked         AuditAction = "api_key_revoked"
+)
+
+// GetDisplayName returns a human-readable name for the audit action
+func (a AuditAction) GetDisplayName() string {
+	switch a {
+	case AuditChannelRegistered:
+		return "Registered channel"
+	case AuditChannelUpdated:
+		return "Updated channel registration"
+	case AuditChannelDeactivated:
+		return "Deactivated channel"
+	case AuditChannelActivated:
+		return "Activated channel"
+	case AuditChannelTransferred:
+		return "Moved channel to another project"
+	case AuditChannelProjectAdded:
+		return "Added project to channel"
+	case AuditChannelProjectRemoved:
+		return "Removed project from channel"
+	case AuditCustomerCreated:
+		return "Created customer"
+	case AuditCustomerRenamed:
+		return "Renamed customer"
+	case AuditCustomerMerged:
+		return "Merged customers"
+	case AuditProjectCreated:
+		return "Created project"
+	case AuditProjectRenamed:
+		return "Renamed project"
+	case AuditProjectMerged:
+		return "Merged projects"
+	case AuditIssueClosed:
+		return "Closed issue"
+	case AuditIssueAutoClosed:
+		return "Closed stale issue"
+	case AuditIssueReopened:
+		return "Reopened issue"
+	case AuditIssuePriorityChanged:
+		return "Changed priority"
+	case AuditIssueSeverityChanged:
+		return "Changed severity"
+	case AuditIssueDeleted:
+		return "Deleted issue"
+	case AuditIssueRestored:
+		return "Restored issue"
+	case AuditIssueMoved:
+		return "Moved issue"
+	case AuditIssuesExported:
+		return "Exported issues"
+	case AuditAPIKeyCreated:
+		return "Issued API key"
+	case AuditAPIKeyRevoked:
+		return "Revoked API key"
+	default:
+		return string(a)
+	}
+}
+
+// AuditLog records who performed an administrative action, on what, and what changed
+type AuditLog struct {
+	ID         uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	GuildID    string      `json:"guild_id,omitempty" gorm:"size:100;index:idx_audit_logs_guild_created,priority:1"` // Server the action concerns; empty for actions outside Discord
+	ActorID    string      `json:"actor_id,omitempty" gorm:"size:100"`                                               // Discord ID of the acting user; empty for the REST API, the CLI and the bot itself
+	Action     AuditAction `json:"action" gorm:"size:40;not null"`
+	TargetType string      `json:"target_type" gorm:"size:20;not null"` // "channel", "issue", "customer", "project" or "api_key"
+	TargetID   string      `json:"target_id" gorm:"size:100;not null"`  // Discord channel ID, issue ID, customer ID, project ID or API key ID
+	TargetName string      `json:"target_name,omitempty" gorm:"size:255"`
+	Before     string      `json:"before,omitempty" gorm:"type:text"` // JSON of the changed values before the action
+	After      string      `json:"after,omitempty" gorm:"type:text"`  // JSON of the changed values after the action
+	CreatedAt  time.Time   `json:"created_at" gorm:"type:timestamptz;default:now();index:idx_audit_logs_guild_created,priority:2"`
+}
+
+// TableName specifies the table name for AuditLog
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// Audit log target types
+const (
+	AuditTargetChannel  = "channel"
+	AuditTargetIssue    = "issue"
+	AuditTargetCustomer = "customer"
+	AuditTargetProject  = "project"
+	AuditTargetAPIKey   = "api_key"
+)
+
+// AuditEntry describes an action for an Auditor to record. Before and After hold the
+// changed values and are stored as JSON.
+type AuditEntry struct {
+	Action     AuditAction
+	GuildID    string // Defaults to the guild of the actor in the context
+	TargetType string
+	TargetID   string
+	TargetName string
+	Before     any
+	After      any
+}
+
+// actorKey is the context key of the actor performing a request
+type actorKey struct{}
+
+// ContextWithActor returns a context carrying the actor performing a request, so the
+// services it reaches can attribute their actions
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor performing a request, if known
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
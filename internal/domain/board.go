@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Board is a pinned message in a registered channel that shows the channel's issues in
+// status columns. It is edited whenever one of the issues changes.
+type Board struct {
+	ChannelID        uuid.UUID `json:"channel_id" gorm:"type:uuid;primaryKey"` // Registration the board belongs to (channels.id)
+	DiscordChannelID string    `json:"discord_channel_id" gorm:"size:100;not null"`
+	MessageID        string    `json:"message_id" gorm:"size:100;not null"`
+	CreatedBy        string    `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the member who posted it
+	CreatedAt        time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt        time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for Board
+func (Board) TableName() string {
+	return "boards"
+}
+
+// BoardColumn is a column of a board and the issues in it
+type BoardColumn struct {
+	Name     string
+	Statuses []Status
+	Issues   []*Issue
+}
+
+// NewBoardColumns sorts issues into the Open, In Progress, Resolved and Verified columns
+// of a board. Reopened issues are open again and rejected fixes go back to in progress;
+// drafts, closed issues and custom statuses are left out.
+func NewBoardColumns(issues []*Issue) []*BoardColumn {
+	columns := []*BoardColumn{
+		{Name: "Open", Statuses: []Status{StatusOpen, StatusReopened}},
+		{Name: "In Progress", Statuses: []Status{StatusInProgress, StatusRejected}},
+		{Name: "Resolved", Statuses: []Status{StatusResolved}},
+		{Name: "Verified", Statuses: []Status{StatusVerified}},
+	}
+
+	for _, issue := range issues {
+		for _, column := range columns {
+			if column.Has(issue.Status) {
+				column.Issues = append(column.Issues, issue)
+				break
+			}
+		}
+	}
+	return columns
+}
+
+// Has checks if issues with the status belong in the column
+func (c *BoardColumn) Has(status Status) bool {
+	for _, s := range c.Statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
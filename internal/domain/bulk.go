@@ -0,0 +1,69 @@
+package domain
+
+// MaxBulkIssues limits how many issues one bulk operation can change
+const MaxBulkIssues = 50
+
+// BulkAction is the change a bulk operation makes to every issue it selects
+type BulkAction string
+
+const (
+	BulkActionClose  BulkAction = "close"
+	BulkActionAssign BulkAction = "assign"
+	BulkActionLabel  BulkAction = "label"
+)
+
+// IsValid checks if the bulk action is known
+func (a BulkAction) IsValid() bool {
+	return a == BulkActionClose || a == BulkActionAssign || a == BulkActionLabel
+}
+
+// BulkRequest describes a bulk operation on the issues of a registered channel. Issues
+// are picked by IssueIDs when given, otherwise by Status.
+type BulkRequest struct {
+	Action    BulkAction
+	ChannelID string   // Discord channel the issues belong to
+	IssueIDs  []string // Issue keys, full IDs or ID prefixes
+	Status    Status   // Selects every issue of the channel with this status
+
+	AssigneeID   string       // Discord ID of the user to assign, for BulkActionAssign
+	AssigneeRole AssigneeRole // Role to assign the user with, for BulkActionAssign
+	LabelName    string       // Label to add, for BulkActionLabel
+
+	ActorID string // Discord ID of the user making the change
+	DryRun  bool   // Report what would change without changing anything
+}
+
+// BulkOutcome is what a bulk operation did, or would do, to one issue
+type BulkOutcome string
+
+const (
+	BulkOutcomeChanged   BulkOutcome = "changed"
+	BulkOutcomeUnchanged BulkOutcome = "unchanged" // Already closed, assigned or labelled
+	BulkOutcomeFailed    BulkOutcome = "failed"
+)
+
+// BulkItem is the outcome of a bulk operation for one selected issue
+type BulkItem struct {
+	IssueID string // The key or ID the issue was picked by; empty when picked by status
+	Issue   *Issue // Nil when IssueID matched no issue
+	Outcome BulkOutcome
+	Err     error // Why the change was refused, for BulkOutcomeFailed
+}
+
+// BulkResult is the outcome of a bulk operation. Changes are applied all together or
+// not at all: a single failed issue leaves every issue as it was.
+type BulkResult struct {
+	Items   []BulkItem
+	Applied bool // The changes were committed; false for dry runs and refused operations
+}
+
+// Count returns how many issues had the given outcome
+func (r *BulkResult) Count(outcome BulkOutcome) int {
+	count := 0
+	for _, item := range r.Items {
+		if item.Outcome == outcome {
+			count++
+		}
+	}
+	return count
+}
@@ -4,23 +4,32 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Channel types a channel can be registered as
+const (
+	ChannelTypeText  = "text"  // Issue cards are posted as messages and discussed in threads
+	ChannelTypeForum = "forum" // Each issue is a forum post tagged with its status
 )
 
 // Channel represents a registered Discord channel with customer and project information
 type Channel struct {
-	ID               uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	ProjectID        uuid.UUID `json:"project_id" gorm:"type:uuid;not null"`
-	DiscordChannelID string    `json:"discord_channel_id" gorm:"column:discord_channel_id;not null;size:100;uniqueIndex:unique_channel"`
-	GuildID          string    `json:"guild_id" gorm:"not null;size:100"`
-	RegisteredBy     uuid.UUID `json:"registered_by" gorm:"type:uuid;not null"`
-	IsActive         bool      `json:"is_active" gorm:"default:true"`
-	ChannelType      string    `json:"channel_type" gorm:"size:100"`
-	CreatedAt        time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
-	UpdatedAt        time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	ID               uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID        uuid.UUID      `json:"project_id" gorm:"type:uuid;not null"`
+	DiscordChannelID string         `json:"discord_channel_id" gorm:"column:discord_channel_id;not null;size:100;uniqueIndex:unique_channel"`
+	GuildID          string         `json:"guild_id" gorm:"not null;size:100"`
+	RegisteredBy     uuid.UUID      `json:"registered_by" gorm:"type:uuid;not null"`
+	IsActive         bool           `json:"is_active" gorm:"default:true"`
+	ChannelType      string         `json:"channel_type" gorm:"size:100"`
+	CreatedAt        time.Time      `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt        time.Time      `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	DeletedAt        gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamptz;index"`
 
 	// Relationships
-	Project          Project `json:"project,omitempty" gorm:"foreignKey:ProjectID"`
-	RegisteredByUser User    `json:"registered_by_user,omitempty" gorm:"foreignKey:RegisteredBy"`
+	Project          Project   `json:"project,omitempty" gorm:"foreignKey:ProjectID"`
+	Projects         []Project `json:"projects,omitempty" gorm:"many2many:channel_projects"` // Further projects reported in the channel besides Project
+	RegisteredByUser User      `json:"registered_by_user,omitempty" gorm:"foreignKey:RegisteredBy"`
 }
 
 // TableName specifies the table name for Channel
@@ -28,11 +37,29 @@ func (Channel) TableName() string {
 	return "channels"
 }
 
+// ChannelProject registers a further project in a channel, so reporters there choose
+// the project of each issue
+type ChannelProject struct {
+	ChannelID uuid.UUID `json:"channel_id" gorm:"type:uuid;primaryKey"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for ChannelProject
+func (ChannelProject) TableName() string {
+	return "channel_projects"
+}
+
 // IsValidChannelRegistration validates the channel registration data
 func IsValidChannelRegistration(projectID uuid.UUID, discordChannelID, guildID string, registeredBy uuid.UUID) bool {
 	return projectID != uuid.Nil && discordChannelID != "" && guildID != "" && registeredBy != uuid.Nil
 }
 
+// IsForum checks if the channel is a forum whose posts are its issues
+func (c *Channel) IsForum() bool {
+	return c.ChannelType == ChannelTypeForum
+}
+
 // Deactivate marks the channel registration as inactive
 func (c *Channel) Deactivate() {
 	c.IsActive = false
@@ -43,7 +70,24 @@ func (c *Channel) Activate() {
 	c.IsActive = true
 }
 
-// Update updates the channel registration information
-func (c *Channel) Update(projectID uuid.UUID) {
-	c.ProjectID = projectID
+// Update moves the channel registration to a project. The loaded project is replaced
+// too, since saving the channel takes the project ID from it.
+func (c *Channel) Update(project *Project) {
+	c.ProjectID = project.ID
+	c.Project = *project
+}
+
+// AllProjects returns the projects reported in the channel, its main project first
+func (c *Channel) AllProjects() []Project {
+	return append([]Project{c.Project}, c.Projects...)
+}
+
+// HasProject checks if issues of a project can be reported in the channel
+func (c *Channel) HasProject(projectID uuid.UUID) bool {
+	for _, project := range c.AllProjects() {
+		if project.ID == projectID {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// EventClaim records that a replica of the bot handles a Discord event. Every replica
+// connected to a shard receives its events, so they claim each one before handling it.
+type EventClaim struct {
+	Key       string    `json:"key" gorm:"primaryKey;size:191"` // e.g. "interaction:<id>"
+	ExpiresAt time.Time `json:"expires_at" gorm:"type:timestamptz;not null;index"`
+}
+
+// TableName specifies the table name for EventClaim
+func (EventClaim) TableName() string {
+	return "event_claims"
+}
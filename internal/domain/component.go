@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxComponents limits how many components a project can have, as many as a select menu offers
+	MaxComponents = 25
+	// maxComponentNameLength keeps names within a team member skill, which they are matched against
+	maxComponentNameLength = 50
+)
+
+// Component is an area of a project such as "API", "Mobile" or "Billing" that its issues
+// are filed under. Issues of a component are routed to its lead before other team members.
+type Component struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID  `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_component_project_name"`
+	Name      string     `json:"name" gorm:"size:50;not null;uniqueIndex:idx_component_project_name"`
+	LeadID    *uuid.UUID `json:"lead_id,omitempty" gorm:"type:uuid"`   // Team member suggested first for its issues (optional)
+	CreatedBy string     `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the member who created it
+	CreatedAt time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Lead *User `json:"lead,omitempty" gorm:"foreignKey:LeadID"`
+}
+
+// TableName specifies the table name for Component
+func (Component) TableName() string {
+	return "components"
+}
+
+// NormalizeComponentName trims a component name; names are compared case-insensitively
+func NormalizeComponentName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// IsValidComponentName checks that a normalized component name is non-empty and not too long
+func IsValidComponentName(name string) bool {
+	return name != "" && len([]rune(name)) <= maxComponentNameLength
+}
+
+// IsComponentLead reports whether a user leads the component of an issue
+func (i *Issue) IsComponentLead(userID uuid.UUID) bool {
+	return i.Component != nil && i.Component.LeadID != nil && *i.Component.LeadID == userID
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomFieldType represents the kind of value a custom field holds
+type CustomFieldType string
+
+const (
+	CustomFieldText   CustomFieldType = "text"   // Free text
+	CustomFieldNumber CustomFieldType = "number" // A decimal number
+	CustomFieldSelect CustomFieldType = "select" // One of the field's options
+)
+
+const (
+	// MaxCustomFields limits how many custom fields a project can define
+	MaxCustomFields = 10
+	// MaxCustomFieldOptions limits the options of a select field
+	MaxCustomFieldOptions = 25
+	// maxCustomFieldNameLength keeps field names within a Discord form label
+	maxCustomFieldNameLength = 45
+	// MaxCustomFieldValueLength limits a custom field value
+	MaxCustomFieldValueLength = 500
+)
+
+// CustomFieldDefinition is a structured field a project adds to its issues, such as
+// the environment or app version an issue was found in
+type CustomFieldDefinition struct {
+	ID        uuid.UUID       `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID       `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_custom_field_project_name"`
+	Name      string          `json:"name" gorm:"size:50;not null;uniqueIndex:idx_custom_field_project_name"`
+	Type      CustomFieldType `json:"type" gorm:"size:20;not null"`
+	Options   []string        `json:"options,omitempty" gorm:"type:text;serializer:json"` // Allowed values of a select field
+	Required  bool            `json:"required" gorm:"default:false"`                      // Must be filled in when reporting an issue
+	Position  int             `json:"position" gorm:"not null;default:0"`                 // Order of the field in forms and on the issue card
+	CreatedBy string          `json:"created_by,omitempty" gorm:"size:100"`               // Discord ID of the admin who added it
+	CreatedAt time.Time       `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for CustomFieldDefinition
+func (CustomFieldDefinition) TableName() string {
+	return "custom_field_definitions"
+}
+
+// IssueCustomFieldValue holds the value of a custom field on an issue
+type IssueCustomFieldValue struct {
+	IssueID   uuid.UUID `json:"issue_id" gorm:"type:uuid;primaryKey"`
+	FieldID   uuid.UUID `json:"field_id" gorm:"type:uuid;primaryKey"`
+	Value     string    `json:"value" gorm:"type:text;not null"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Field CustomFieldDefinition `json:"field" gorm:"foreignKey:FieldID"`
+}
+
+// TableName specifies the table name for IssueCustomFieldValue
+func (IssueCustomFieldValue) TableName() string {
+	return "issue_custom_field_values"
+}
+
+// IsValidCustomFieldType checks if the given custom field type is valid
+func IsValidCustomFieldType(t CustomFieldType) bool {
+	return t == CustomFieldText || t == CustomFieldNumber || t == CustomFieldSelect
+}
+
+// NormalizeCustomFieldName collapses the whitespace of a field name; names are compared
+// case-insensitively
+func NormalizeCustomFieldName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// IsValidCustomFieldName checks that a normalized field name is non-empty and fits a form label
+func IsValidCustomFieldName(name string) bool {
+	return name != "" && len([]rune(name)) <= maxCustomFieldNameLength
+}
+
+// ParseCustomFieldOptions splits the comma-separated options of a select field, dropping
+// empty and repeated ones
+func ParseCustomFieldOptions(input string) []string {
+	var options []string
+	seen := make(map[string]bool)
+	for _, option := range strings.Split(input, ",") {
+		option = strings.Join(strings.Fields(option), " ")
+		if option == "" || seen[strings.ToLower(option)] {
+			continue
+		}
+		seen[strings.ToLower(option)] = true
+		options = append(options, option)
+	}
+	return options
+}
+
+// NormalizeValue checks a value entered for the field and returns it as stored: trimmed,
+// and for select fields spelled like the matching option
+func (d *CustomFieldDefinition) NormalizeValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || len([]rune(value)) > MaxCustomFieldValueLength {
+		return "", ErrInvalidCustomFieldValue
+	}
+
+	switch d.Type {
+	case CustomFieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", ErrInvalidCustomFieldValue
+		}
+	case CustomFieldSelect:
+		for _, option := range d.Options {
+			if strings.EqualFold(option, value) {
+				return option, nil
+			}
+		}
+		return "", ErrInvalidCustomFieldValue
+	}
+	return value, nil
+}
+
+// Describe summarizes the field's type for admins and reporters, e.g. "one of: dev, staging"
+func (d *CustomFieldDefinition) Describe() string {
+	switch d.Type {
+	case CustomFieldNumber:
+		return "number"
+	case CustomFieldSelect:
+		return "one of: " + strings.Join(d.Options, ", ")
+	default:
+		return "text"
+	}
+}
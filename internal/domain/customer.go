@@ -4,15 +4,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Customer represents a customer organization
 type Customer struct {
-	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Name         string    `json:"name" gorm:"not null;size:255"`
-	ContactEmail string    `json:"contact_email,omitempty" gorm:"size:255"`
-	CreatedAt    time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
-	UpdatedAt    time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	ID           uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name         string         `json:"name" gorm:"not null;size:255"`
+	ContactEmail string         `json:"contact_email,omitempty" gorm:"size:255"`
+	EmailOptOut  bool           `json:"email_opt_out" gorm:"not null;default:false"` // Stops issue emails to the contact
+	GuildID      string         `json:"guild_id,omitempty" gorm:"size:100;index"`    // Server the customer was added in; empty for customers added through the REST API
+	CreatedAt    time.Time      `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt    time.Time      `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	DeletedAt    gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamptz;index"`
 
 	// Relationships
 	Projects []Project `json:"projects,omitempty" gorm:"foreignKey:CustomerID"`
@@ -24,6 +28,11 @@ func (Customer) TableName() string {
 	return "customers"
 }
 
+// WantsEmail reports whether issue notifications should be emailed to the customer contact
+func (c *Customer) WantsEmail() bool {
+	return c.ContactEmail != "" && !c.EmailOptOut
+}
+
 // IsValidCustomer validates customer data
 func IsValidCustomer(name string) bool {
 	return name != ""
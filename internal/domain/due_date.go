@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Layouts accepted for due dates. A date without a time is due at the end of that day.
+const (
+	dueDateLayout     = "2006-01-02"
+	dueDateTimeLayout = "2006-01-02 15:04"
+)
+
+// DueReminder is a reminder sent to an issue's assignees about its due date
+type DueReminder string
+
+const (
+	DueReminderNone    DueReminder = ""
+	DueReminderSoon    DueReminder = "due_soon" // The due date is close
+	DueReminderOverdue DueReminder = "overdue"  // The due date has passed
+)
+
+// ParseDueDate parses a due date such as "2025-03-14" or "2025-03-14 17:00" in loc.
+// "clear" and "none" return nil to remove the due date.
+func ParseDueDate(value string, loc *time.Location) (*time.Time, error) {
+	value = strings.Join(strings.Fields(value), " ")
+	switch strings.ToLower(value) {
+	case "", "clear", "none":
+		return nil, nil
+	}
+
+	if due, err := time.ParseInLocation(dueDateTimeLayout, value, loc); err == nil {
+		return &due, nil
+	}
+	day, err := time.ParseInLocation(dueDateLayout, value, loc)
+	if err != nil {
+		return nil, ErrInvalidDueDate
+	}
+	due := time.Date(day.Year(), day.Month(), day.Day(), 23, 59, 0, 0, loc)
+	return &due, nil
+}
+
+// DueReminder tells which reminder the assignees of an issue should have received by now:
+// none, a reminder once the due date is within remindBefore, or an overdue notice.
+// Issues without a due date or that are resolved get no reminders.
+func (i *Issue) DueReminder(remindBefore time.Duration, now time.Time) DueReminder {
+	if i.DueDate == nil || !i.IsUnresolved() {
+		return DueReminderNone
+	}
+	if !now.Before(*i.DueDate) {
+		return DueReminderOverdue
+	}
+	if !now.Add(remindBefore).Before(*i.DueDate) {
+		return DueReminderSoon
+	}
+	return DueReminderNone
+}
+
+// IsOverdue checks if an unresolved issue is past its due date
+func (i *Issue) IsOverdue(now time.Time) bool {
+	return i.DueReminder(0, now) == DueReminderOverdue
+}
+
+// IsUnresolved checks if the issue still awaits a fix, see UnresolvedStatuses
+func (i *Issue) IsUnresolved() bool {
+	for _, s := range UnresolvedStatuses() {
+		if s == i.Status {
+			return true
+		}
+	}
+	return false
+}
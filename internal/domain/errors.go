@@ -1,90 +1,588 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	// ErrIssueNotFound is returned when an issue is not found
-	ErrIssueNotFound = errors.New("issue not found")
+	ErrIssueNotFound = newError(KindNotFound, "issue not found")
 
 	// ErrInvalidPriority is returned when an invalid priority is provided
-	ErrInvalidPriority = errors.New("invalid priority level")
+	ErrInvalidPriority = newError(KindInvalid, "invalid priority level")
+
+	// ErrInvalidSeverity is returned when an invalid severity is provided
+	ErrInvalidSeverity = newError(KindInvalid, "invalid severity level, use s1, s2, s3 or s4")
 
 	// ErrInvalidStatus is returned when an invalid status is provided
-	ErrInvalidStatus = errors.New("invalid status")
+	ErrInvalidStatus = newError(KindInvalid, "invalid status")
+
+	// ErrInvalidIssueSort is returned when an issue sort order is unknown
+	ErrInvalidIssueSort = newError(KindInvalid, "sort must be newest, oldest, updated, stale, priority or due_date")
 
 	// ErrEmptyTitle is returned when an empty title is provided
-	ErrEmptyTitle = errors.New("issue title cannot be empty")
+	ErrEmptyTitle = newError(KindInvalid, "issue title cannot be empty")
 
 	// ErrEmptyDescription is returned when an empty description is provided
-	ErrEmptyDescription = errors.New("issue description cannot be empty")
+	ErrEmptyDescription = newError(KindInvalid, "issue description cannot be empty")
 
 	// ErrEmptyReporterID is returned when an empty reporter ID is provided
-	ErrEmptyReporterID = errors.New("reporter ID cannot be empty")
+	ErrEmptyReporterID = newError(KindInvalid, "reporter ID cannot be empty")
 
 	// ErrEmptyChannelID is returned when an empty channel ID is provided
-	ErrEmptyChannelID = errors.New("channel ID cannot be empty")
+	ErrEmptyChannelID = newError(KindInvalid, "channel ID cannot be empty")
 
 	// ErrIssueAlreadyClosed is returned when trying to close an already closed issue
-	ErrIssueAlreadyClosed = errors.New("issue is already closed")
+	ErrIssueAlreadyClosed = newError(KindConflict, "issue is already closed")
 
 	// ErrIssueAlreadyOpen is returned when trying to reopen an already open issue
-	ErrIssueAlreadyOpen = errors.New("issue is already open")
+	ErrIssueAlreadyOpen = newError(KindConflict, "issue is already open")
+
+	// ErrInvalidStatusTransition is returned when a status change is not allowed by the workflow
+	ErrInvalidStatusTransition = newError(KindConflict, "invalid status transition")
+
+	// ErrOpenSubIssues is returned when closing an issue whose sub-tasks are not all closed
+	ErrOpenSubIssues = newError(KindConflict, "issue has sub-tasks that are not closed")
+
+	// ErrNestedSubIssue is returned when creating a sub-task of an issue that is itself a sub-task
+	ErrNestedSubIssue = newError(KindConflict, "sub-tasks cannot have sub-tasks of their own")
+
+	// ErrStatusLogNotFound is returned when a status log entry is not found
+	ErrStatusLogNotFound = newError(KindNotFound, "status log not found")
+
+	// ErrAttachmentNotFound is returned when an issue attachment is not found
+	ErrAttachmentNotFound = newError(KindNotFound, "attachment not found")
+
+	// ErrCommentNotFound is returned when an issue comment is not found
+	ErrCommentNotFound = newError(KindNotFound, "comment not found")
+
+	// Label-related errors
+
+	// ErrLabelNotFound is returned when a label is not found
+	ErrLabelNotFound = newError(KindNotFound, "label not found")
+
+	// ErrInvalidLabelName is returned when a label name is empty or too long
+	ErrInvalidLabelName = newError(KindInvalid, "label name must be between 1 and 50 characters")
+
+	// ErrInvalidLabelColor is returned when a label color is not a hex value
+	ErrInvalidLabelColor = newError(KindInvalid, "label color must be a hex value such as #e74c3c")
+
+	// Link-related errors
+
+	// ErrIssueLinkNotFound is returned when two issues are not linked
+	ErrIssueLinkNotFound = newError(KindNotFound, "issue link not found")
+
+	// ErrIssueLinkExists is returned when two issues are already linked with the same relation
+	ErrIssueLinkExists = newError(KindConflict, "issues are already linked")
+
+	// ErrInvalidLinkType is returned when a link type is not duplicate_of, blocks or relates_to
+	ErrInvalidLinkType = newError(KindInvalid, "link type must be duplicate_of, blocks or relates_to")
+
+	// ErrSelfLink is returned when an issue is linked to itself
+	ErrSelfLink = newError(KindInvalid, "an issue cannot be linked to itself")
+
+	// Workflow-related errors
+
+	// ErrWorkflowNotFound is returned when a project has no workflow definition
+	ErrWorkflowNotFound = newError(KindNotFound, "workflow not found")
+
+	// ErrInvalidWorkflowStatus is returned when a custom status name does not make a valid key
+	ErrInvalidWorkflowStatus = newError(KindInvalid, "status names must be 2-30 letters, digits, spaces or underscores starting with a letter")
+
+	// ErrWorkflowStatusExists is returned when a workflow already has a status with the same key
+	ErrWorkflowStatusExists = newError(KindConflict, "status already exists in this workflow")
+
+	// ErrWorkflowStatusNotFound is returned when a status is neither built-in nor added by the workflow
+	ErrWorkflowStatusNotFound = newError(KindNotFound, "status not found in this workflow")
+
+	// ErrBuiltInWorkflowStatus is returned when trying to add or remove a built-in status
+	ErrBuiltInWorkflowStatus = newError(KindInvalid, "built-in statuses cannot be added or removed")
+
+	// ErrWorkflowStatusInUse is returned when removing custom statuses that issues still have
+	ErrWorkflowStatusInUse = newError(KindConflict, "issues still have this status")
+
+	// ErrTooManyWorkflowStatuses is returned when a workflow would exceed MaxCustomStatuses
+	ErrTooManyWorkflowStatuses = newError(KindConflict, "a workflow can add at most 10 statuses")
+
+	// ErrWorkflowTransitionExists is returned when a transition is already allowed
+	ErrWorkflowTransitionExists = newError(KindConflict, "transition is already allowed")
+
+	// ErrWorkflowTransitionNotFound is returned when a custom transition does not exist
+	ErrWorkflowTransitionNotFound = newError(KindNotFound, "transition not found in this workflow")
+
+	// ErrInvalidWorkflowTransition is returned when a transition starts and ends at the same status
+	ErrInvalidWorkflowTransition = newError(KindInvalid, "a transition must change the status")
+
+	// Custom field errors
+
+	// ErrCustomFieldNotFound is returned when a project has no custom field with the given name
+	ErrCustomFieldNotFound = newError(KindNotFound, "custom field not found")
+
+	// ErrCustomFieldExists is returned when a project already has a custom field with the same name
+	ErrCustomFieldExists = newError(KindConflict, "custom field already exists in this project")
+
+	// ErrInvalidCustomFieldName is returned when a custom field name is empty or too long
+	ErrInvalidCustomFieldName = newError(KindInvalid, "custom field names must be between 1 and 45 characters")
+
+	// ErrInvalidCustomFieldType is returned when a custom field type is unknown
+	ErrInvalidCustomFieldType = newError(KindInvalid, "custom field type must be text, number or select")
+
+	// ErrInvalidCustomFieldOptions is returned when a select field has no options or too many
+	ErrInvalidCustomFieldOptions = newError(KindInvalid, "select fields need between 1 and 25 comma-separated options")
+
+	// ErrInvalidCustomFieldValue is returned when a value does not fit the field's type
+	ErrInvalidCustomFieldValue = newError(KindInvalid, "invalid custom field value")
+
+	// ErrTooManyCustomFields is returned when a project would exceed MaxCustomFields
+	ErrTooManyCustomFields = newError(KindConflict, "a project can have at most 10 custom fields")
+
+	// Recurring issue errors
+
+	// ErrRecurringIssueNotFound is returned when a project has no recurring issue with the given title
+	ErrRecurringIssueNotFound = newError(KindNotFound, "recurring issue not found")
+
+	// ErrRecurringIssueExists is returned when a project already has a recurring issue with the same title
+	ErrRecurringIssueExists = newError(KindConflict, "a recurring issue with this title already exists in this project")
+
+	// ErrInvalidRecurringIssueTitle is returned when a recurring issue title is empty or too long
+	ErrInvalidRecurringIssueTitle = newError(KindInvalid, "recurring issue titles must be between 1 and 100 characters")
+
+	// ErrInvalidRecurringSchedule is returned when a schedule is not a valid cron expression
+	ErrInvalidRecurringSchedule = newError(KindInvalid, "schedule must be a five-field cron expression such as \"0 9 * * 1\" (minute hour day-of-month month day-of-week)")
+
+	// ErrTooManyRecurringIssues is returned when a project would exceed MaxRecurringIssues
+	ErrTooManyRecurringIssues = newError(KindConflict, "a project can have at most 25 recurring issues")
+
+	// Stale issue errors
+
+	// ErrInvalidStaleDays is returned when a stale threshold is negative or above MaxStaleDays
+	ErrInvalidStaleDays = newError(KindInvalid, "stale thresholds must be between 0 and 365 days")
+
+	// Due date errors
+
+	// ErrInvalidDueDate is returned when a due date is not in a supported format
+	ErrInvalidDueDate = newError(KindInvalid, "due dates must look like 2025-03-14 or 2025-03-14 17:00, or clear to remove them")
+
+	// ErrDueDateInPast is returned when setting a due date that has already passed
+	ErrDueDateInPast = newError(KindInvalid, "due date is in the past")
+
+	// Milestone errors
+
+	// ErrMilestoneNotFound is returned when a project has no milestone with the given name
+	ErrMilestoneNotFound = newError(KindNotFound, "milestone not found")
+
+	// ErrMilestoneExists is returned when a project already has a milestone with the same name
+	ErrMilestoneExists = newError(KindConflict, "a milestone with this name already exists in this project")
+
+	// ErrInvalidMilestoneName is returned when a milestone name is empty or too long
+	ErrInvalidMilestoneName = newError(KindInvalid, "milestone names must be between 1 and 100 characters")
+
+	// ErrInvalidMilestoneDate is returned when a target date is not a date such as 2025-03-14
+	ErrInvalidMilestoneDate = newError(KindInvalid, "target dates must look like 2025-03-14")
+
+	// ErrTooManyMilestones is returned when a project would exceed MaxMilestones
+	ErrTooManyMilestones = newError(KindConflict, "a project can have at most 25 milestones")
+
+	// Component errors
+
+	// ErrComponentNotFound is returned when a project has no component with the given name
+	ErrComponentNotFound = newError(KindNotFound, "component not found")
+
+	// ErrComponentExists is returned when a project already has a component with the same name
+	ErrComponentExists = newError(KindConflict, "a component with this name already exists in this project")
+
+	// ErrInvalidComponentName is returned when a component name is empty or too long
+	ErrInvalidComponentName = newError(KindInvalid, "component names must be between 1 and 50 characters")
+
+	// ErrTooManyComponents is returned when a project would exceed MaxComponents
+	ErrTooManyComponents = newError(KindConflict, "a project can have at most 25 components")
+
+	// Incident errors
+
+	// ErrIncidentNotFound is returned when an issue was not declared as an incident
+	ErrIncidentNotFound = newError(KindNotFound, "this issue is not an incident")
+
+	// ErrInvalidIncidentSeverity is returned when an incident is declared below severity S2
+	ErrInvalidIncidentSeverity = newError(KindInvalid, "incidents are declared at severity s1 or s2")
+
+	// ErrInvalidIncidentUpdate is returned when a status update is empty or too long
+	ErrInvalidIncidentUpdate = newError(KindInvalid, "status updates must be between 1 and 1000 characters")
+
+	// ErrIncidentClosed is returned when a status update is posted for a closed incident
+	ErrIncidentClosed = newError(KindConflict, "this incident is closed")
+
+	// Release notes errors
+
+	// ErrReleaseNotesScopeRequired is returned when release notes are asked for without a milestone or dates
+	ErrReleaseNotesScopeRequired = newError(KindInvalid, "pick a milestone, a date range or both for the release notes")
+
+	// ErrInvalidReleaseNotesRange is returned when the dates of release notes are malformed or out of order
+	ErrInvalidReleaseNotesRange = newError(KindInvalid, "release note dates must look like 2025-03-14, with from on or before to")
+
+	// Feedback errors
+
+	// ErrFeedbackNotFound is returned when an issue has not been rated yet
+	ErrFeedbackNotFound = newError(KindNotFound, "feedback not found")
+
+	// ErrInvalidFeedbackRating is returned when a rating is not between 1 and 5
+	ErrInvalidFeedbackRating = newError(KindInvalid, "ratings must be between 1 and 5")
+
+	// ErrInvalidFeedbackComment is returned when a feedback comment is empty or too long
+	ErrInvalidFeedbackComment = newError(KindInvalid, "comments must be between 1 and 1000 characters")
+
+	// ErrNotIssueReporter is returned when someone other than the reporter rates an issue
+	ErrNotIssueReporter = newError(KindForbidden, "only the reporter can rate this issue")
+
+	// Escalation errors
+
+	// ErrEscalationRuleNotFound is returned when a project has no escalation rule for a priority
+	ErrEscalationRuleNotFound = newError(KindNotFound, "escalation rule not found")
+
+	// ErrInvalidEscalationHours is returned when an escalation delay is out of range
+	ErrInvalidEscalationHours = newError(KindInvalid, "issues must be escalated after 1 to 720 hours")
+
+	// ErrCannotBumpPriority is returned when a rule would raise the highest priority
+	ErrCannotBumpPriority = newError(KindConflict, "high priority issues cannot be bumped to a higher priority")
+
+	// Board errors
+
+	// ErrBoardNotFound is returned when a channel has no board
+	ErrBoardNotFound = newError(KindNotFound, "board not found")
+
+	// Watcher errors
+
+	// ErrAlreadyWatching is returned when a user watches an issue twice
+	ErrAlreadyWatching = newError(KindConflict, "you are already watching this issue")
+
+	// ErrNotWatching is returned when a user unwatches an issue they do not watch
+	ErrNotWatching = newError(KindConflict, "you are not watching this issue")
+
+	// Bulk operation errors
+
+	// ErrNoBulkIssues is returned when a bulk operation names no issues and no status
+	ErrNoBulkIssues = newError(KindInvalid, "list the issues to change or pick a status")
+
+	// ErrTooManyBulkIssues is returned when a bulk operation selects more than MaxBulkIssues issues
+	ErrTooManyBulkIssues = newError(KindInvalid, "a bulk operation can change at most 50 issues at once")
+
+	// ErrInvalidBulkAction is returned when a bulk action is not close, assign or label
+	ErrInvalidBulkAction = newError(KindInvalid, "bulk action must be close, assign or label")
+
+	// ErrAmbiguousIssueID is returned when an ID prefix matches more than one issue
+	ErrAmbiguousIssueID = newError(KindInvalid, "ID prefix matches more than one issue; use the issue key")
+
+	// Worklog errors
+
+	// ErrTimerRunning is returned when starting a timer while the user already runs one
+	ErrTimerRunning = newError(KindConflict, "you already have a timer running; stop it with /track stop first")
+
+	// ErrTimerOnClosedIssue is returned when starting a timer on a closed issue
+	ErrTimerOnClosedIssue = newError(KindConflict, "timers cannot be started on closed issues")
+
+	// ErrNoTimerRunning is returned when stopping a timer while the user runs none
+	ErrNoTimerRunning = newError(KindConflict, "you have no timer running")
+
+	// ErrInvalidWorklogDuration is returned when logged time is not between MinWorklogDuration and MaxWorklogDuration
+	ErrInvalidWorklogDuration = newError(KindInvalid, "logged time must be a duration between 1m and 24h such as 45m or 1h30m")
+
+	// ErrInvalidWorklogNote is returned when a worklog note is too long
+	ErrInvalidWorklogNote = newError(KindInvalid, "worklog notes can be at most 200 characters")
+
+	// Guild settings errors
+
+	// ErrGuildSettingsNotFound is returned when a guild has no stored settings
+	ErrGuildSettingsNotFound = newError(KindNotFound, "guild settings not found")
+
+	// ErrInvalidLocale is returned when a locale is not a language code such as "en" or "en-US"
+	ErrInvalidLocale = newError(KindInvalid, "locale must be a language code such as en, th or en-US")
+
+	// ErrUnsupportedLocale is returned for a locale the bot has no translations for
+	ErrUnsupportedLocale = newError(KindInvalid, "the bot does not speak that language yet")
+
+	// ErrInvalidTimezone is returned when a time zone is not an IANA name such as "Asia/Bangkok"
+	ErrInvalidTimezone = newError(KindInvalid, "time zone must be an IANA name such as Asia/Bangkok, Europe/Berlin or UTC")
+
+	// ErrInvalidSLATarget is returned when an SLA target is negative
+	ErrInvalidSLATarget = newError(KindInvalid, "SLA targets must be durations such as 4h or 90m, or 0 to disable")
+
+	// ErrAdminRoleExists is returned when a role already grants the admin role
+	ErrAdminRoleExists = newError(KindConflict, "role already grants the admin role")
+
+	// ErrAdminRoleNotFound is returned when removing a role that does not grant the admin role
+	ErrAdminRoleNotFound = newError(KindNotFound, "role does not grant the admin role")
+
+	// ErrInvalidReportEmoji is returned when a report emoji is not a single emoji
+	ErrInvalidReportEmoji = newError(KindInvalid, "report emoji must be an emoji such as 🐞, a custom emoji of this server, or off")
+
+	// ErrInvalidFeature is returned for a feature flag that does not exist
+	ErrInvalidFeature = newError(KindInvalid, "unknown feature")
+
+	// On-call errors
+
+	// ErrOnCallScheduleNotFound is returned when a project has no on-call rotation
+	ErrOnCallScheduleNotFound = newError(KindNotFound, "on-call rotation not found")
+
+	// ErrOnCallMemberExists is returned when adding a user who is already in the rotation
+	ErrOnCallMemberExists = newError(KindConflict, "user is already in the on-call rotation")
+
+	// ErrOnCallMemberNotFound is returned when removing a user who is not in the rotation
+	ErrOnCallMemberNotFound = newError(KindNotFound, "user is not in the on-call rotation")
+
+	// ErrTeamMemberNotFound is returned when a user has no team member profile in the guild
+	ErrTeamMemberNotFound = newError(KindNotFound, "user has no team member profile")
+
+	// ErrInvalidCapacity is returned when a team member's capacity is negative
+	ErrInvalidCapacity = newError(KindInvalid, "capacity must be zero (unlimited) or more")
+
+	// ErrInvalidSkills is returned when a team member profile lists too many skills or one too long
+	ErrInvalidSkills = newError(KindInvalid, "invalid skills")
+
+	// ErrTooManyOnCallMembers is returned when a rotation already has MaxOnCallMembers members
+	ErrTooManyOnCallMembers = newError(KindConflict, "the on-call rotation can have at most 25 members")
+
+	// Report-related errors
+
+	// ErrInvalidStatsRange is returned when a stats range is not one of the supported ranges
+	ErrInvalidStatsRange = newError(KindInvalid, "stats range must be 7, 30 or 90 days")
+
+	// ErrInvalidExportFormat is returned when an export format is not supported
+	ErrInvalidExportFormat = newError(KindInvalid, "export format must be csv or xlsx")
+
+	// API key errors
+
+	// ErrAPIKeyNotFound is returned when an API key is not found
+	ErrAPIKeyNotFound = newError(KindNotFound, "api key not found")
+
+	// ErrInvalidAPIKey is returned when a request carries an unknown or revoked API key
+	ErrInvalidAPIKey = newError(KindForbidden, "invalid or revoked api key")
+
+	// ErrInvalidAPIKeyName is returned when an API key name is empty or too long
+	ErrInvalidAPIKeyName = newError(KindInvalid, "api key names must be between 1 and 100 characters")
+
+	// ErrInvalidAPIKeyScope is returned when API key scopes are missing or unknown
+	ErrInvalidAPIKeyScope = newError(KindInvalid, "scopes must be all or a list of issues:read, issues:write, projects:read, projects:write, customers:read and customers:write")
+
+	// Webhook-related errors
+
+	// ErrWebhookNotFound is returned when a project webhook is not found
+	ErrWebhookNotFound = newError(KindNotFound, "webhook not found")
+
+	// ErrWebhookAlreadyExists is returned when a project already has a webhook for the URL
+	ErrWebhookAlreadyExists = newError(KindConflict, "webhook already exists for this project")
+
+	// ErrInvalidWebhookURL is returned when a webhook URL is not an absolute https URL
+	ErrInvalidWebhookURL = newError(KindInvalid, "webhook URL must be an https URL of at most 500 characters")
+
+	// ErrWebhookHostNotPublic is returned when a webhook host does not resolve to public addresses only
+	ErrWebhookHostNotPublic = newError(KindInvalid, "webhook URL must point to a public host, not a private, loopback or link-local address")
 
 	// Channel-related errors
 
 	// ErrChannelNotFound is returned when a channel registration is not found
-	ErrChannelNotFound = errors.New("channel registration not found")
+	ErrChannelNotFound = newError(KindNotFound, "channel registration not found")
 
 	// ErrChannelAlreadyRegistered is returned when trying to register an already registered channel
-	ErrChannelAlreadyRegistered = errors.New("channel is already registered")
+	ErrChannelAlreadyRegistered = newError(KindConflict, "channel is already registered")
+
+	// ErrChannelInactive is returned when creating an issue in a deactivated channel
+	ErrChannelInactive = newError(KindConflict, "this channel has been deactivated and does not accept new issues")
+
+	// ErrChannelAlreadyInProject is returned when moving a channel to the project it already belongs to
+	ErrChannelAlreadyInProject = newError(KindConflict, "channel already belongs to this project")
+
+	// ErrIssueAlreadyInChannel is returned when moving an issue to the channel and project it is in
+	ErrIssueAlreadyInChannel = newError(KindConflict, "issue is already in this channel and project")
+
+	// ErrIssueStatusNotInProject is returned when moving an issue to a project whose workflow lacks its status
+	ErrIssueStatusNotInProject = newError(KindConflict, "the workflow of the new project has no such status; change the issue's status first")
+
+	// ErrProjectNotInChannel is returned when a project is not registered in a channel
+	ErrProjectNotInChannel = newError(KindNotFound, "project is not registered in this channel")
+
+	// ErrChannelMainProject is returned when removing the main project of a channel
+	ErrChannelMainProject = newError(KindConflict, "the channel's main project cannot be removed; move the channel to another project instead")
 
 	// ErrInvalidChannelRegistration is returned when channel registration data is invalid
-	ErrInvalidChannelRegistration = errors.New("invalid channel registration data")
+	ErrInvalidChannelRegistration = newError(KindInvalid, "invalid channel registration data")
 
 	// ErrEmptyCustomerName is returned when an empty customer name is provided
-	ErrEmptyCustomerName = errors.New("customer name cannot be empty")
+	ErrEmptyCustomerName = newError(KindInvalid, "customer name cannot be empty")
 
 	// ErrEmptyProjectName is returned when an empty project name is provided
-	ErrEmptyProjectName = errors.New("project name cannot be empty")
+	ErrEmptyProjectName = newError(KindInvalid, "project name cannot be empty")
 
 	// ErrEmptyGuildID is returned when an empty guild ID is provided
-	ErrEmptyGuildID = errors.New("guild ID cannot be empty")
+	ErrEmptyGuildID = newError(KindInvalid, "guild ID cannot be empty")
 
 	// Customer-related errors
 
 	// ErrCustomerNotFound is returned when a customer is not found
-	ErrCustomerNotFound = errors.New("customer not found")
+	ErrCustomerNotFound = newError(KindNotFound, "customer not found")
 
 	// ErrCustomerAlreadyExists is returned when trying to create a duplicate customer
-	ErrCustomerAlreadyExists = errors.New("customer already exists")
+	ErrCustomerAlreadyExists = newError(KindConflict, "customer already exists")
+
+	// ErrMergeCustomerIntoItself is returned when merging a customer into itself
+	ErrMergeCustomerIntoItself = newError(KindInvalid, "a customer cannot be merged into itself")
+
+	// ErrCustomerProjectNameClash is returned when merging customers that both have a project of the same name
+	ErrCustomerProjectNameClash = newError(KindConflict, "both customers have a project with this name; merge the two projects first")
 
 	// Project-related errors
 
 	// ErrProjectNotFound is returned when a project is not found
-	ErrProjectNotFound = errors.New("project not found")
+	ErrProjectNotFound = newError(KindNotFound, "project not found")
 
 	// ErrProjectAlreadyExists is returned when trying to create a duplicate project
-	ErrProjectAlreadyExists = errors.New("project already exists")
+	ErrProjectAlreadyExists = newError(KindConflict, "project already exists")
+
+	// ErrMergeProjectIntoItself is returned when merging a project into itself
+	ErrMergeProjectIntoItself = newError(KindInvalid, "a project cannot be merged into itself")
+
+	// ErrProjectWorkflowClash is returned when merging projects that both customize their workflow
+	ErrProjectWorkflowClash = newError(KindConflict, "both projects have a custom workflow; reset one of them with /workflow-config reset first")
+
+	// ErrInvalidGitHubRepo is returned when a GitHub repository is not in "owner/name" form
+	ErrInvalidGitHubRepo = newError(KindInvalid, "github repository must be in owner/name form")
+
+	// ErrInvalidSlackWebhookURL is returned when a Slack webhook URL is not an https URL
+	ErrInvalidSlackWebhookURL = newError(KindInvalid, "slack webhook URL must be an https URL of at most 500 characters")
+
+	// ErrInvalidJiraProjectKey is returned when a Jira project key is malformed
+	ErrInvalidJiraProjectKey = newError(KindInvalid, "jira project key must be 2-50 upper case letters, digits or underscores starting with a letter")
+
+	// ErrInvalidInboundEmail is returned when a project's support address is not an email address
+	ErrInvalidInboundEmail = newError(KindInvalid, "support address must be an email address of at most 255 characters")
+
+	// ErrInboundEmailTaken is returned when another project already receives email at an address
+	ErrInboundEmailTaken = newError(KindConflict, "another project already receives email at this address")
+
+	// ErrNoInboundProject is returned when an email was not sent to the support address of any project
+	ErrNoInboundProject = newError(KindNotFound, "no project receives email at these addresses")
 
 	// User-related errors
 
 	// ErrUserNotFound is returned when a user is not found
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound = newError(KindNotFound, "user not found")
+
+	// ErrPermissionDenied is returned when a user's role does not allow an action
+	ErrPermissionDenied = newError(KindForbidden, "permission denied")
 
 	// ErrUserAlreadyExists is returned when trying to create a duplicate user
-	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserAlreadyExists = newError(KindConflict, "user already exists")
 
 	// ErrInvalidUserRole is returned when an invalid user role is provided
-	ErrInvalidUserRole = errors.New("invalid user role")
+	ErrInvalidUserRole = newError(KindInvalid, "invalid user role")
 
 	// ErrInvalidDiscordID is returned when an invalid Discord ID is provided
-	ErrInvalidDiscordID = errors.New("invalid Discord ID")
+	ErrInvalidDiscordID = newError(KindInvalid, "invalid Discord ID")
 
 	// ErrUnauthorized is returned when a user lacks permission for an action
-	ErrUnauthorized = errors.New("unauthorized access")
+	ErrUnauthorized = newError(KindForbidden, "unauthorized access")
+
+	// ErrRateLimited is matched by errors returned when a user acts too often
+	ErrRateLimited = newError(KindRateLimited, "rate limited")
+
+	// ErrInvalidNotificationEvent is returned when a notification event is unknown
+	ErrInvalidNotificationEvent = newError(KindInvalid, "notification event must be assigned, status_change, mention, sla_breach or watching")
 
 	// Issue assignee errors
-	ErrAssigneeNotFound      = errors.New("assignee not found")
-	ErrAssigneeAlreadyExists = errors.New("assignee already exists")
-	ErrInvalidAssigneeRole   = errors.New("invalid assignee role")
+	ErrAssigneeNotFound      = newError(KindNotFound, "assignee not found")
+	ErrAssigneeAlreadyExists = newError(KindConflict, "assignee already exists")
+	ErrInvalidAssigneeRole   = newError(KindInvalid, "invalid assignee role")
+)
+
+// ErrorKind classifies errors, so transports can pick a response and a log level
+// without listing every error
+type ErrorKind string
+
+const (
+	KindInternal    ErrorKind = "internal"     // Infrastructure failures and bugs; the kind of errors that are not domain errors
+	KindInvalid     ErrorKind = "invalid"      // The input was rejected; the message says why
+	KindNotFound    ErrorKind = "not_found"    // Something the request refers to does not exist
+	KindConflict    ErrorKind = "conflict"     // The request clashes with the current state
+	KindForbidden   ErrorKind = "forbidden"    // The user may not do this
+	KindRateLimited ErrorKind = "rate_limited" // The user acts too often
 )
+
+// Error is a domain error of a kind. Its message is shown to users and translated by the
+// transports, so it holds no values; WithDetail adds those.
+type Error struct {
+	kind    ErrorKind
+	message string
+}
+
+// newError creates a domain error of a kind
+func newError(kind ErrorKind, message string) *Error {
+	return &Error{kind: kind, message: message}
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Kind returns the kind of the error
+func (e *Error) Kind() ErrorKind {
+	return e.kind
+}
+
+// KindOf returns the kind of the first domain error in err's chain, or KindInternal
+func KindOf(err error) ErrorKind {
+	var kinded interface{ Kind() ErrorKind }
+	if errors.As(err, &kinded) {
+		return kinded.Kind()
+	}
+	return KindInternal
+}
+
+// DetailError adds values, such as the input that was rejected, to a domain error. The
+// detail is formatted like fmt.Sprintf so transports can translate Format.
+type DetailError struct {
+	Err    error
+	Format string
+	Args   []any
+}
+
+// WithDetail adds a detail formatted like fmt.Sprintf to err, e.g. the issues still in a
+// status that is being removed
+func WithDetail(err error, format string, args ...any) error {
+	return &DetailError{Err: err, Format: format, Args: args}
+}
+
+// Error implements the error interface
+func (e *DetailError) Error() string {
+	return e.Err.Error() + ": " + e.Detail()
+}
+
+// Detail returns the formatted detail
+func (e *DetailError) Detail() string {
+	return fmt.Sprintf(e.Format, e.Args...)
+}
+
+// Unwrap returns the domain error
+func (e *DetailError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimitError is returned when a user acts too often; it matches ErrRateLimited
+type RateLimitError struct {
+	RetryAt time.Time // When the user may act again
+}
+
+// Error implements the error interface
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limited until %s", e.RetryAt.Format(time.RFC3339))
+}
+
+// Is makes errors.Is(err, ErrRateLimited) match a RateLimitError
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// Kind returns KindRateLimited
+func (e *RateLimitError) Kind() ErrorKind {
+	return KindRateLimited
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxEscalationHours is the longest an issue may stay open before a rule escalates it
+const MaxEscalationHours = 720
+
+// EscalationRule escalates the issues of a project that stay open at a priority for too
+// long: a Discord role is pinged in the issue thread and the priority is optionally
+// raised. A project has at most one rule per priority.
+type EscalationRule struct {
+	ID           uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID    uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_escalation_rule_project_priority"`
+	Priority     Priority  `json:"priority" gorm:"size:10;not null;uniqueIndex:idx_escalation_rule_project_priority"`
+	AfterHours   int       `json:"after_hours" gorm:"not null"`          // Hours an issue may stay open before it is escalated
+	RoleID       string    `json:"role_id" gorm:"size:100;not null"`     // Discord role pinged on escalation
+	BumpPriority bool      `json:"bump_priority" gorm:"default:false"`   // Raise the priority one level on escalation
+	CreatedBy    string    `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the member who set the rule
+	CreatedAt    time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for EscalationRule
+func (EscalationRule) TableName() string {
+	return "escalation_rules"
+}
+
+// After returns how long an issue may stay open before the rule escalates it
+func (r *EscalationRule) After() time.Duration {
+	return time.Duration(r.AfterHours) * time.Hour
+}
+
+// IsDue checks if an issue stayed open at the rule's priority for longer than the rule
+// allows. Open issues were never started, so they have been open since they were created.
+func (r *EscalationRule) IsDue(issue *Issue, now time.Time) bool {
+	return issue.Status == StatusOpen && issue.Priority == r.Priority && !now.Before(issue.CreatedAt.Add(r.After()))
+}
+
+// IssueEscalation records that an issue was escalated at a priority, so each rule
+// escalates an issue only once
+type IssueEscalation struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID     uuid.UUID `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_escalation_issue_priority"`
+	Priority    Priority  `json:"priority" gorm:"size:10;not null;uniqueIndex:idx_issue_escalation_issue_priority"` // Priority the issue had when escalated
+	RoleID      string    `json:"role_id" gorm:"size:100;not null"`
+	EscalatedAt time.Time `json:"escalated_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for IssueEscalation
+func (IssueEscalation) TableName() string {
+	return "issue_escalations"
+}
+
+// IsValidEscalationHours checks that an escalation delay is between 1 hour and MaxEscalationHours
+func IsValidEscalationHours(hours int) bool {
+	return hours >= 1 && hours <= MaxEscalationHours
+}
+
+// NextPriority returns the priority one level above p. High is the highest priority, so
+// it has none.
+func NextPriority(p Priority) (Priority, bool) {
+	switch p {
+	case PriorityLow:
+		return PriorityMedium, true
+	case PriorityMedium:
+		return PriorityHigh, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// EventType identifies a domain event
+type EventType string
+
+// Issue events published by the services
+const (
+	EventIssueCreated          EventType = "issue.created"
+	EventIssueStatusChanged    EventType = "issue.status_changed"
+	EventIssuePriorityChanged  EventType = "issue.priority_changed"
+	EventIssueSeverityChanged  EventType = "issue.severity_changed"
+	EventIssueEdited           EventType = "issue.edited"
+	EventIssueComponentChanged EventType = "issue.component_changed"
+	EventIssueDeleted          EventType = "issue.deleted"
+	EventIssueMoved            EventType = "issue.moved"
+	EventAssigneeAdded         EventType = "issue.assignee_added"
+	EventAssigneeRemoved       EventType = "issue.assignee_removed"
+	EventIssueCommented        EventType = "issue.commented"
+	EventIssueAutoAssigned     EventType = "issue.auto_assigned"
+	EventAssigneesSuggested    EventType = "issue.assignees_suggested"
+	EventIssueRouted           EventType = "issue.routed"
+	EventSLABreached           EventType = "issue.sla_breached"
+	EventIssueEscalated        EventType = "issue.escalated"
+	EventIssueEmailReply       EventType = "issue.email_reply"
+)
+
+// Event describes a change to an issue. Only the fields relevant to Type are set.
+type Event struct {
+	Type       EventType
+	Issue      *Issue
+	ActorID    string    // Discord ID of the acting user; empty for API, integration and system changes
+	OccurredAt time.Time // Set by the bus when empty
+
+	OldStatus   Status           // EventIssueStatusChanged
+	OldPriority Priority         // EventIssuePriorityChanged, and EventIssueEscalated when the priority was raised
+	OldSeverity Severity         // EventIssueSeverityChanged; empty when the issue had none
+	Assignee    *IssueAssignee   // EventAssigneeAdded, EventAssigneeRemoved, EventIssueAutoAssigned and EventIssueRouted; User is populated
+	AuthorName  string           // EventIssueCommented and EventIssueEmailReply
+	Content     string           // EventIssueCommented and EventIssueEmailReply
+	SLAAlert    *SLAAlert        // EventSLABreached
+	Escalation  *IssueEscalation // EventIssueEscalated
+	OldChannel  *Channel         // EventIssueMoved; nil when the issue had no channel
+}
+
+// EventHandler handles a published event. Handlers run synchronously in the
+// publishing goroutine and must return quickly; slow work such as network calls
+// should be done asynchronously.
+type EventHandler func(ctx context.Context, event Event)
+
+// EventPublisher publishes domain events to interested subscribers
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+// EventBus lets transports and integrations subscribe to the events services publish
+type EventBus interface {
+	EventPublisher
+
+	// Subscribe registers handler for the given event types
+	Subscribe(handler EventHandler, types ...EventType)
+
+	// SubscribeInTransaction registers handler for the given event types, called as soon
+	// as an event is published: within the publisher's transaction, if any, so what the
+	// handler writes with ctx is committed or rolled back with the change. It must not
+	// call out of the process.
+	SubscribeInTransaction(handler EventHandler, types ...EventType)
+}
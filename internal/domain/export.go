@@ -0,0 +1,22 @@
+package domain
+
+// ExportFormat represents the file format of an issue export
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+// ExportFile is a generated export ready to be uploaded
+type ExportFile struct {
+	Name        string
+	ContentType string
+	Data        []byte
+	IssueCount  int
+}
+
+// IsValidExportFormat checks if the export format is supported
+func IsValidExportFormat(f ExportFormat) bool {
+	return f == ExportFormatCSV || f == ExportFormatXLSX
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "slices"
+
+// Feature names an experimental feature that is rolled out per guild with /feature
+type Feature string
+
+const (
+	FeatureBoard        Feature = "board"        // /board and the pinned issue boards it posts
+	FeatureAutocomplete Feature = "autocomplete" // Suggestions for issue, project and milestone options
+	FeatureForumMode    Feature = "forum_mode"   // Registering forum channels, whose posts are issues
+	FeatureAutoAssign   Feature = "auto_assign"  // Assigning opened issues to the best-matching team member
+)
+
+// Features lists every feature, in the order they are shown
+func Features() []Feature {
+	return []Feature{FeatureBoard, FeatureAutocomplete, FeatureForumMode, FeatureAutoAssign}
+}
+
+// IsValidFeature checks if a feature exists
+func IsValidFeature(feature Feature) bool {
+	return slices.Contains(Features(), feature)
+}
+
+// FeatureState tells whether a feature is on in a guild
+type FeatureState struct {
+	Feature    Feature
+	Enabled    bool
+	Overridden bool // Set in the guild with /feature rather than by the bot's config
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MinFeedbackRating and MaxFeedbackRating bound the satisfaction ratings reporters give
+	MinFeedbackRating = 1
+	MaxFeedbackRating = 5
+	// MaxFeedbackCommentLength keeps comments within a Discord text input
+	MaxFeedbackCommentLength = 1000
+)
+
+// IssueFeedback is the reporter's satisfaction rating of how a closed issue was handled,
+// asked for in a survey sent when the issue is closed. An issue has at most one; rating
+// it again replaces the rating.
+type IssueFeedback struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID   uuid.UUID `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null"` // Reporter who answered
+	Rating    int       `json:"rating" gorm:"not null"`            // From MinFeedbackRating to MaxFeedbackRating
+	Comment   string    `json:"comment,omitempty" gorm:"type:text"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for IssueFeedback
+func (IssueFeedback) TableName() string {
+	return "issue_feedback"
+}
+
+// IsValidFeedbackRating checks that a rating is between MinFeedbackRating and MaxFeedbackRating
+func IsValidFeedbackRating(rating int) bool {
+	return rating >= MinFeedbackRating && rating <= MaxFeedbackRating
+}
+
+// NormalizeFeedbackComment trims a feedback comment
+func NormalizeFeedbackComment(comment string) string {
+	return strings.TrimSpace(comment)
+}
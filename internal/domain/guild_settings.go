@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLocale is the locale of guilds that have not chosen one
+const DefaultLocale = "en"
+
+// DefaultReportEmoji is the reaction that reports a message as an issue in guilds that have not chosen one
+const DefaultReportEmoji = "🐞"
+
+// ReportEmojiOff turns reporting messages by reaction off
+const ReportEmojiOff = "off"
+
+// maxReportEmojiLength limits a stored report emoji, a Unicode emoji or a custom emoji's name:id
+const maxReportEmojiLength = 64
+
+// maxTimezoneLength limits a stored time zone name
+const maxTimezoneLength = 64
+
+// customEmojiPattern matches a custom emoji as Discord sends it in a message, such as <:bug:123>
+var customEmojiPattern = regexp.MustCompile(`^<a?:(\w{2,32}):(\d+)>$`)
+
+// localePattern matches locales such as "en", "th" and "en-US"
+var localePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+// GuildSettings holds the configuration of one Discord server, so a single bot instance
+// can serve several servers independently. Zero values fall back to the bot's config.
+type GuildSettings struct {
+	ID                  uuid.UUID              `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	GuildID             string                 `json:"guild_id" gorm:"size:100;not null;uniqueIndex"`
+	Locale              string                 `json:"locale,omitempty" gorm:"size:10"`                           // Default locale of bot responses
+	Timezone            string                 `json:"timezone,omitempty" gorm:"size:64"`                         // IANA time zone of digests, stats and exports
+	AdminRoleIDs        []string               `json:"admin_role_ids,omitempty" gorm:"type:text;serializer:json"` // Discord roles granting the admin role
+	EscalationChannelID string                 `json:"escalation_channel_id,omitempty" gorm:"size:100"`           // Receives SLA breach alerts instead of the configured channel
+	ReportEmoji         string                 `json:"report_emoji,omitempty" gorm:"size:64"`                     // Reaction reporting a message as an issue, or ReportEmojiOff
+	SLATargets          map[Priority]SLAPolicy `json:"sla_targets,omitempty" gorm:"type:text;serializer:json"`    // Overrides the configured SLA targets per priority
+	Features            map[Feature]bool       `json:"features,omitempty" gorm:"type:text;serializer:json"`       // Overrides the configured feature flags
+	UpdatedBy           string                 `json:"updated_by,omitempty" gorm:"size:100"`                      // Discord ID of the admin who last changed them
+	CreatedAt           time.Time              `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt           time.Time              `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for GuildSettings
+func (GuildSettings) TableName() string {
+	return "guild_settings"
+}
+
+// IsValidLocale checks if the locale is a language code, optionally with a region
+func IsValidLocale(locale string) bool {
+	return localePattern.MatchString(locale)
+}
+
+// GetLocale returns the guild's locale, or DefaultLocale if none is set
+func (g *GuildSettings) GetLocale() string {
+	if g == nil || g.Locale == "" {
+		return DefaultLocale
+	}
+	return g.Locale
+}
+
+// NormalizeTimezone checks that a time zone is an IANA name such as "Asia/Bangkok" and
+// returns it as stored. "Local" is rejected because it depends on the bot's host.
+func NormalizeTimezone(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" || input == "Local" || len(input) > maxTimezoneLength {
+		return "", ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(input); err != nil {
+		return "", ErrInvalidTimezone
+	}
+	return input, nil
+}
+
+// Location returns the guild's time zone, or fallback if none is set
+func (g *GuildSettings) Location(fallback *time.Location) *time.Location {
+	if g == nil || g.Timezone == "" {
+		return fallback
+	}
+	loc, err := time.LoadLocation(g.Timezone)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+// GetReportEmoji returns the reaction that reports a message as an issue in the guild,
+// DefaultReportEmoji if none is set, or an empty string if reporting by reaction is off.
+// Custom emojis are returned as name:id, like discordgo's Emoji.APIName.
+func (g *GuildSettings) GetReportEmoji() string {
+	if g == nil || g.ReportEmoji == "" {
+		return DefaultReportEmoji
+	}
+	if g.ReportEmoji == ReportEmojiOff {
+		return ""
+	}
+	return g.ReportEmoji
+}
+
+// NormalizeReportEmoji turns an emoji entered by an admin into the form reactions are
+// compared with: Unicode emojis unchanged, custom emojis such as <:bug:123> as bug:123.
+// "off" turns reporting by reaction off.
+func NormalizeReportEmoji(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if strings.EqualFold(input, ReportEmojiOff) {
+		return ReportEmojiOff, nil
+	}
+	if match := customEmojiPattern.FindStringSubmatch(input); match != nil {
+		return match[1] + ":" + match[2], nil
+	}
+
+	if input == "" || len(input) > maxReportEmojiLength {
+		return "", ErrInvalidReportEmoji
+	}
+	for _, r := range input {
+		// Emojis are outside ASCII apart from keycaps such as 1️⃣ and #️⃣
+		if unicode.IsSpace(r) || r < unicode.MaxASCII && !strings.ContainsRune("0123456789#*", r) {
+			return "", ErrInvalidReportEmoji
+		}
+	}
+	return input, nil
+}
+
+// IsAdminRole checks if a Discord role grants the admin role in the guild
+func (g *GuildSettings) IsAdminRole(roleID string) bool {
+	if g == nil {
+		return false
+	}
+	for _, id := range g.AdminRoleIDs {
+		if id == roleID {
+			return true
+		}
+	}
+	return false
+}
+
+// SLAPolicy returns the guild's SLA targets for a priority, falling back to the given default
+func (g *GuildSettings) SLAPolicy(priority Priority, fallback SLAPolicy) SLAPolicy {
+	if g == nil {
+		return fallback
+	}
+	if policy, ok := g.SLATargets[priority]; ok {
+		return policy
+	}
+	return fallback
+}
+
+// FeatureEnabled returns whether a feature is on in the guild, and whether the guild
+// overrides it. Features the guild does not override return fallback.
+func (g *GuildSettings) FeatureEnabled(feature Feature, fallback bool) (enabled, overridden bool) {
+	if g == nil {
+		return fallback, false
+	}
+	if enabled, ok := g.Features[feature]; ok {
+		return enabled, true
+	}
+	return fallback, false
+}
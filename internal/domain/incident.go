@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxIncidentUpdateLength keeps a status update within the issue history and a Discord embed
+const MaxIncidentUpdateLength = 1000
+
+// Incident is an issue declared with /incident declare: an outage or other urgent problem
+// run by an incident commander, who is expected to post a status update in its thread
+// every update interval until it is resolved. Closing it posts its timeline.
+type Incident struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID         uuid.UUID  `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex"`
+	CommanderID     string     `json:"commander_id" gorm:"size:100;not null"`         // Discord ID of the member running the incident
+	BridgeChannelID string     `json:"bridge_channel_id,omitempty" gorm:"size:100"`   // Voice or stage channel the incident is worked in (optional)
+	BridgeStage     bool       `json:"bridge_stage" gorm:"default:false"`             // The bridge is a stage channel, whose stage lasts as long as the incident
+	LastUpdateAt    time.Time  `json:"last_update_at" gorm:"type:timestamptz"`        // Latest status update, or the declaration before the first one
+	RemindedAt      *time.Time `json:"reminded_at,omitempty" gorm:"type:timestamptz"` // Latest reminder that a status update is due
+	DeclaredAt      time.Time  `json:"declared_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Issue Issue `json:"issue,omitempty" gorm:"foreignKey:IssueID"`
+}
+
+// TableName specifies the table name for Incident
+func (Incident) TableName() string {
+	return "incidents"
+}
+
+// IncidentDeclaration describes an incident to declare
+type IncidentDeclaration struct {
+	Title           string
+	Description     string
+	Severity        Severity // S1 or S2; empty declares an S1 incident
+	CommanderID     string   // Discord ID of the member declaring and running the incident
+	BridgeChannelID string   // Voice or stage channel to work the incident in; may be empty
+	BridgeStage     bool     // The bridge is a stage channel
+}
+
+// IsIncidentSeverity checks that a severity is high enough to declare an incident
+func IsIncidentSeverity(s Severity) bool {
+	return s == SeverityS1 || s == SeverityS2
+}
+
+// NormalizeIncidentUpdate trims a status update and checks that it is not empty or too long
+func NormalizeIncidentUpdate(message string) (string, error) {
+	message = strings.TrimSpace(message)
+	if message == "" || len([]rune(message)) > MaxIncidentUpdateLength {
+		return "", ErrInvalidIncidentUpdate
+	}
+	return message, nil
+}
+
+// UpdateDue checks if the commander owes a status update: neither an update nor a reminder
+// was posted for interval. Reminders repeat every interval until an update is posted.
+func (inc *Incident) UpdateDue(interval time.Duration, now time.Time) bool {
+	if interval <= 0 {
+		return false
+	}
+	last := inc.LastUpdateAt
+	if inc.RemindedAt != nil && inc.RemindedAt.After(last) {
+		last = *inc.RemindedAt
+	}
+	return !now.Before(last.Add(interval))
+}
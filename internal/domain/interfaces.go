@@ -7,6 +7,18 @@ import (
 	"github.com/google/uuid"
 )
 
+// UnitOfWork runs writes to several repositories atomically
+type UnitOfWork interface {
+	// Do runs fn in a transaction that is committed if fn returns nil and rolled back
+	// otherwise. Repository calls made with the context passed to fn take part in it.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// AfterCommit runs f once the transaction running in ctx is committed, or right away
+	// outside a transaction. f is dropped if the transaction is rolled back, and the
+	// context it gets no longer carries the transaction.
+	AfterCommit(ctx context.Context, f func(ctx context.Context))
+}
+
 // IssueRepository defines the interface for issue data operations
 type IssueRepository interface {
 	// Create creates a new issue in the repository
@@ -15,54 +27,163 @@ type IssueRepository interface {
 	// GetByID retrieves an issue by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*Issue, error)
 
-	// GetByChannelID retrieves all issues for a specific channel by UUID
-	GetByChannelID(ctx context.Context, channelID uuid.UUID) ([]*Issue, error)
+	// GetByIssueKey retrieves an issue by its key, e.g. "ACME-42"
+	GetByIssueKey(ctx context.Context, key string) (*Issue, error)
+
+	// SearchByPartialID retrieves the issues whose ID or key starts with prefix, newest first.
+	// A non-empty channelID limits the search to that Discord channel's issues.
+	SearchByPartialID(ctx context.Context, prefix, channelID string) ([]*Issue, error)
 
 	// GetByDiscordChannelID retrieves all issues for a specific Discord channel by string ID
 	GetByDiscordChannelID(ctx context.Context, discordChannelID string) ([]*Issue, error)
 
+	// Query retrieves the issues matching a query in its order, with their project, reporter
+	// and the requested details, together with the total number of matching issues
+	Query(ctx context.Context, query IssueQuery) ([]*Issue, int64, error)
+
+	// GetOpenByProjectID retrieves the issues of a project that are not closed, without relationships
+	GetOpenByProjectID(ctx context.Context, projectID uuid.UUID) ([]*Issue, error)
+
 	// GetByStatus retrieves all issues with a specific status
 	GetByStatus(ctx context.Context, status Status) ([]*Issue, error)
 
+	// GetByPublicHash retrieves an issue by its public share hash
+	GetByPublicHash(ctx context.Context, hash string) (*Issue, error)
+
+	// GetByStatuses retrieves all issues whose status is one of the given statuses
+	GetByStatuses(ctx context.Context, statuses []Status) ([]*Issue, error)
+
+	// GetByThreadID retrieves an issue by its Discord thread ID
+	GetByThreadID(ctx context.Context, threadID string) (*Issue, error)
+
+	// GetByMessageID retrieves an issue by the Discord message ID of its card
+	GetByMessageID(ctx context.Context, messageID string) (*Issue, error)
+
+	// CountOpenSubIssues counts the sub-tasks of an issue that are not closed
+	CountOpenSubIssues(ctx context.Context, parentID uuid.UUID) (int64, error)
+
+	// CountByProjectIDAndStatuses counts a project's issues that have one of the statuses
+	CountByProjectIDAndStatuses(ctx context.Context, projectID uuid.UUID, statuses []Status) (int64, error)
+
+	// GetByGitHubIssue retrieves an issue mirrored to the given GitHub repository and issue number
+	GetByGitHubIssue(ctx context.Context, repo string, number int) (*Issue, error)
+
+	// SetGitHubIssueNumber stores the number of the GitHub issue mirroring an issue
+	SetGitHubIssueNumber(ctx context.Context, id uuid.UUID, number int) error
+
+	// GetOpenJiraIssues retrieves the issues mirrored to Jira that are not closed
+	GetOpenJiraIssues(ctx context.Context) ([]*Issue, error)
+
+	// SetJiraKey stores the key of the Jira ticket mirroring an issue
+	SetJiraKey(ctx context.Context, id uuid.UUID, key string) error
+
+	// SetJiraStatus stores the last Jira status seen for an issue
+	SetJiraStatus(ctx context.Context, id uuid.UUID, status string) error
+
+	// GetLastActivity retrieves the time of the latest status change or thread comment of
+	// each of the issues; issues with neither are left out
+	GetLastActivity(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]time.Time, error)
+
+	// SetStaleNudgedAt stores when an issue was last nudged for having no activity
+	SetStaleNudgedAt(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// SetDueDate stores the due date of an issue, or removes it for nil, and forgets the
+	// reminders sent for the previous one
+	SetDueDate(ctx context.Context, id uuid.UUID, due *time.Time) error
+
+	// GetDueBefore retrieves unresolved issues due before the given time with their
+	// channels and assignees
+	GetDueBefore(ctx context.Context, before time.Time) ([]*Issue, error)
+
+	// SetDueReminderSent stores the last due date reminder sent for an issue
+	SetDueReminderSent(ctx context.Context, id uuid.UUID, reminder DueReminder) error
+
+	// SetMilestone adds an issue to a milestone, or removes it from its milestone for nil
+	SetMilestone(ctx context.Context, id uuid.UUID, milestoneID *uuid.UUID) error
+
+	// SetComponent files an issue under a component, or removes it from its component for nil
+	SetComponent(ctx context.Context, id uuid.UUID, componentID *uuid.UUID) error
+
+	// Move files an issue under another channel registration and project, with the given
+	// key, and forgets its card and thread. When the project changes, its labels, milestone,
+	// component and custom field values go to those of the same name in the new project, if any.
+	Move(ctx context.Context, id uuid.UUID, channelID, projectID uuid.UUID, number int, key string) error
+
 	// Update updates an existing issue
 	Update(ctx context.Context, issue *Issue) error
 
-	// Delete removes an issue from the repository
+	// Delete soft-deletes an issue so it no longer appears in queries
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// List retrieves all issues with pagination
-	List(ctx context.Context, offset, limit int) ([]*Issue, error)
+	// Restore reverses the soft deletion of an issue
+	Restore(ctx context.Context, id uuid.UUID) error
 }
 
 // IssueService defines the interface for issue business logic
 type IssueService interface {
-	// CreateIssue creates a new issue with validation
-	CreateIssue(ctx context.Context, title, description, imageURL, reporterID, channelID string) (*Issue, error)
+	// CreateIssue creates a new issue with validation in one of the projects of a Discord
+	// channel; uuid.Nil picks the channel's main project
+	CreateIssue(ctx context.Context, title, description, imageURL, reporterID, channelID string, projectID uuid.UUID) (*Issue, error)
 
 	// GetIssue retrieves an issue by ID
 	GetIssue(ctx context.Context, id uuid.UUID) (*Issue, error)
 
+	// CreateSubIssue creates a sub-task of a parent issue in the parent's project and channel.
+	// Closing the parent is refused while it has sub-tasks that are not closed.
+	CreateSubIssue(ctx context.Context, parentID uuid.UUID, title, description, reporterID string) (*Issue, error)
+
+	// GetIssueByThreadID retrieves the issue discussed in a Discord thread
+	GetIssueByThreadID(ctx context.Context, threadID string) (*Issue, error)
+
+	// GetIssueByMessageID retrieves the issue whose card is the given Discord message
+	GetIssueByMessageID(ctx context.Context, messageID string) (*Issue, error)
+
+	// GetIssueByPublicHash retrieves an issue by its public share hash
+	GetIssueByPublicHash(ctx context.Context, hash string) (*Issue, error)
+
+	// GetIssueByKey retrieves an issue by its key, e.g. "ACME-42"; the key is case-insensitive
+	GetIssueByKey(ctx context.Context, key string) (*Issue, error)
+
+	// FindSimilarIssues retrieves the open issues of a project of a Discord channel whose
+	// title resembles title, most similar first; uuid.Nil picks the channel's main project
+	FindSimilarIssues(ctx context.Context, channelID string, projectID uuid.UUID, title string) ([]SimilarIssue, error)
+
+	// SearchIssuesByPartialID retrieves the issues of a Discord channel whose ID or key starts with prefix, newest first
+	SearchIssuesByPartialID(ctx context.Context, prefix, channelID string) ([]*Issue, error)
+
 	// UpdateIssuePriority updates the priority of an issue
 	UpdateIssuePriority(ctx context.Context, id uuid.UUID, priority Priority) error
 
+	// UpdateIssueSeverity sets the severity of an issue, or removes it for an empty severity
+	UpdateIssueSeverity(ctx context.Context, id uuid.UUID, severity Severity) error
+
+	// UpdateIssueContent updates the title, description and image URL of an issue and logs the edit
+	UpdateIssueContent(ctx context.Context, id uuid.UUID, title, description, imageURL, editedBy string) (*Issue, error)
+
 	// CloseIssue closes an issue
-	CloseIssue(ctx context.Context, id uuid.UUID) error
+	CloseIssue(ctx context.Context, id uuid.UUID, changedBy string) error
 
 	// OpenIssue opens an issue
-	OpenIssue(ctx context.Context, id uuid.UUID) error
+	OpenIssue(ctx context.Context, id uuid.UUID, changedBy string) error
 
 	// InProgressIssue starts working on an issue
-	InProgressIssue(ctx context.Context, id uuid.UUID) error
+	InProgressIssue(ctx context.Context, id uuid.UUID, changedBy string) error
 
 	// VerifiedIssue verifies an issue
-	VerifiedIssue(ctx context.Context, id uuid.UUID) error
+	VerifiedIssue(ctx context.Context, id uuid.UUID, changedBy string) error
+
+	// RejectIssue marks a verified fix as rejected by QA
+	RejectIssue(ctx context.Context, id uuid.UUID, changedBy string) error
 
 	// ReopenIssue reopens a closed issue
-	ReopenIssue(ctx context.Context, id uuid.UUID) error
+	ReopenIssue(ctx context.Context, id uuid.UUID, changedBy string) error
 
 	// ListIssuesByChannel lists all issues for a specific channel
 	ListIssuesByChannel(ctx context.Context, channelID string) ([]*Issue, error)
 
+	// ListIssuesByChannelPage lists one page of a channel's issues matching the filter and returns the total count
+	ListIssuesByChannelPage(ctx context.Context, channelID string, filter IssueFilter, offset, limit int) ([]*Issue, int64, error)
+
 	// ListOpenIssues lists all open issues
 	ListOpenIssues(ctx context.Context) ([]*Issue, error)
 
@@ -73,7 +194,49 @@ type IssueService interface {
 	UpdateIssueMessageID(ctx context.Context, id uuid.UUID, messageID string) error
 
 	// UpdateIssueResolved updates the resolved information for an issue
-	UpdateIssueResolved(ctx context.Context, id uuid.UUID, cause string, action string) error
+	UpdateIssueResolved(ctx context.Context, id uuid.UUID, cause string, action string, changedBy string) error
+
+	// CreateWebIssue creates a new issue submitted through the web portal
+	CreateWebIssue(ctx context.Context, projectID uuid.UUID, title, description, imageURL string, reporterID uuid.UUID) (*Issue, error)
+
+	// CreateEmailIssue files an issue sent to a project's support address. In a registered
+	// channel it is a draft opened from its card; without one it is open right away.
+	CreateEmailIssue(ctx context.Context, projectID uuid.UUID, channelID *uuid.UUID, title, description string, reporterID uuid.UUID) (*Issue, error)
+
+	// AddEmailComment stores a reply to an issue's emails as a comment of the issue
+	AddEmailComment(ctx context.Context, issueID uuid.UUID, author *User, content string) (*IssueComment, error)
+
+	// CreateRecurringIssue files a draft issue from a recurring issue template. It is not
+	// rate limited and is reported by the member who added the template.
+	CreateRecurringIssue(ctx context.Context, recurring *RecurringIssue) (*Issue, error)
+
+	// UpdateIssueStatus updates the status of an issue and records the change.
+	// changedBy is the Discord ID of the acting user, or empty for system/web changes.
+	UpdateIssueStatus(ctx context.Context, id uuid.UUID, status Status, changedBy string) error
+
+	// CloseStaleIssue closes an issue left without activity on behalf of the bot
+	CloseStaleIssue(ctx context.Context, id uuid.UUID) error
+
+	// SearchIssues lists the issues matching a query and returns the total count
+	SearchIssues(ctx context.Context, query IssueQuery) ([]*Issue, int64, error)
+
+	// DeleteIssue soft-deletes an issue
+	DeleteIssue(ctx context.Context, id uuid.UUID) error
+
+	// RestoreIssue brings back a soft-deleted issue
+	RestoreIssue(ctx context.Context, id uuid.UUID) error
+
+	// MoveIssue moves an issue to the registration of a Discord channel and one of its
+	// projects. uuid.Nil keeps the issue's project if the channel tracks it and picks the
+	// channel's main project otherwise. An issue moving to another project gets its next key.
+	MoveIssue(ctx context.Context, id uuid.UUID, channelID string, projectID uuid.UUID, movedBy string) (*Issue, error)
+
+	// AddThreadComment stores a message posted in an issue's discussion thread.
+	// It returns ErrIssueNotFound if the thread does not belong to an issue.
+	AddThreadComment(ctx context.Context, threadID, messageID, authorDiscordID, authorName, content string) (*IssueComment, error)
+
+	// GetIssueComments retrieves the thread comments of an issue, oldest first
+	GetIssueComments(ctx context.Context, issueID uuid.UUID) ([]*IssueComment, error)
 }
 
 // DiscordHandler defines the interface for Discord interaction handling
@@ -99,15 +262,27 @@ type ChannelRepository interface {
 	// GetByChannelID retrieves a channel registration by its Discord channel ID
 	GetByChannelID(ctx context.Context, channelID string) (*Channel, error)
 
+	// GetDeletedByChannelID retrieves the soft-deleted registration of a Discord channel
+	GetDeletedByChannelID(ctx context.Context, channelID string) (*Channel, error)
+
 	// GetByGuildID retrieves all channel registrations for a specific guild
 	GetByGuildID(ctx context.Context, guildID string) ([]*Channel, error)
 
-	// Update updates an existing channel registration
+	// Update updates an existing channel registration, leaving its further projects as they are
 	Update(ctx context.Context, channel *Channel) error
 
-	// Delete removes a channel registration from the repository
+	// AddProject registers a further project in a channel
+	AddProject(ctx context.Context, id, projectID uuid.UUID) error
+
+	// RemoveProject removes a further project from a channel
+	RemoveProject(ctx context.Context, id, projectID uuid.UUID) error
+
+	// Delete soft-deletes a channel registration so it no longer appears in queries
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// Restore reverses the soft deletion of a channel registration
+	Restore(ctx context.Context, id uuid.UUID) error
+
 	// List retrieves all channel registrations with pagination
 	List(ctx context.Context, offset, limit int) ([]*Channel, error)
 
@@ -118,7 +293,7 @@ type ChannelRepository interface {
 // ChannelService defines the interface for channel registration business logic
 type ChannelService interface {
 	// RegisterChannel registers a new channel with customer and project information
-	RegisterChannel(ctx context.Context, channelID, customerName, customerEmail, projectName, projectDescription, registeredBy, userName, guildID string) (*Channel, error)
+	RegisterChannel(ctx context.Context, channelID, customerName, customerEmail, projectName, projectDescription, registeredBy, userName, guildID, channelType string) (*Channel, error)
 
 	// GetChannelRegistration retrieves a channel registration by channel ID
 	GetChannelRegistration(ctx context.Context, channelID string) (*Channel, error)
@@ -132,6 +307,17 @@ type ChannelService interface {
 	// ActivateChannel activates a channel registration
 	ActivateChannel(ctx context.Context, channelID string) error
 
+	// AddChannelProject registers a further project in a channel, creating the customer and
+	// project if they do not exist
+	AddChannelProject(ctx context.Context, channelID, customerName, projectName string) (*Project, error)
+
+	// RemoveChannelProject removes a further project, identified by its issue key prefix, from a channel
+	RemoveChannelProject(ctx context.Context, channelID, projectKey string) (*Project, error)
+
+	// TransferChannel moves a channel registration to another project registered in the
+	// same guild, identified by its issue key prefix
+	TransferChannel(ctx context.Context, channelID, projectKey string) (*Channel, error)
+
 	// ListChannelsForGuild lists all channel registrations for a specific guild
 	ListChannelsForGuild(ctx context.Context, guildID string) ([]*Channel, error)
 
@@ -150,14 +336,26 @@ type CustomerRepository interface {
 	// GetByName retrieves a customer by name
 	GetByName(ctx context.Context, name string) (*Customer, error)
 
+	// GetByNameInGuild retrieves a customer by name among the customers of a guild
+	GetByNameInGuild(ctx context.Context, guildID, name string) (*Customer, error)
+
 	// Update updates an existing customer
 	Update(ctx context.Context, customer *Customer) error
 
-	// Delete removes a customer from the repository
+	// Delete soft-deletes a customer so it no longer appears in queries
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// Restore reverses the soft deletion of a customer
+	Restore(ctx context.Context, id uuid.UUID) error
+
 	// List retrieves all customers with pagination
 	List(ctx context.Context, offset, limit int) ([]*Customer, error)
+
+	// ListByGuild retrieves the customers added in a guild or tracked in its channels, by name
+	ListByGuild(ctx context.Context, guildID string) ([]*Customer, error)
+
+	// Merge moves the projects, users and API keys of a customer to another and soft-deletes it
+	Merge(ctx context.Context, fromID, intoID uuid.UUID) error
 }
 
 // ProjectRepository defines the interface for project data operations
@@ -174,14 +372,30 @@ type ProjectRepository interface {
 	// GetByName retrieves a project by name and customer ID
 	GetByName(ctx context.Context, customerID uuid.UUID, name string) (*Project, error)
 
+	// GetByInboundEmail retrieves the project receiving email at a support address
+	GetByInboundEmail(ctx context.Context, address string) (*Project, error)
+
 	// Update updates an existing project
 	Update(ctx context.Context, project *Project) error
 
-	// Delete removes a project from the repository
+	// Delete soft-deletes a project so it no longer appears in queries
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// Restore reverses the soft deletion of a project
+	Restore(ctx context.Context, id uuid.UUID) error
+
 	// List retrieves all projects with pagination
 	List(ctx context.Context, offset, limit int) ([]*Project, error)
+
+	// NextIssueNumber atomically hands out the next issue number of a project and
+	// returns it with the project's key prefix. Concurrent callers never get the same number.
+	NextIssueNumber(ctx context.Context, id uuid.UUID) (string, int, error)
+
+	// Merge moves the issues, channels, labels, milestones, components and settings of a
+	// project to another and soft-deletes it. Labels, milestones, components and custom
+	// fields are matched by name; settings the other project already has stay with the
+	// merged project.
+	Merge(ctx context.Context, fromID, intoID uuid.UUID) error
 }
 
 // UserRepository defines the interface for user data operations
@@ -195,23 +409,33 @@ type UserRepository interface {
 	// GetByDiscordID retrieves a user by Discord ID
 	GetByDiscordID(ctx context.Context, discordID string) (*User, error)
 
+	// GetOrCreateByDiscordID retrieves a user by Discord ID, creating them with a name and
+	// role when they are not known yet. A deleted user is restored with the role instead.
+	GetOrCreateByDiscordID(ctx context.Context, discordID, name string, role UserRole) (*User, error)
+
+	// GetByEmail retrieves a user by email address, case-insensitively
+	GetByEmail(ctx context.Context, email string) (*User, error)
+
 	// GetByCustomerID retrieves all users for a customer
 	GetByCustomerID(ctx context.Context, customerID uuid.UUID) ([]*User, error)
 
 	// Update updates an existing user
 	Update(ctx context.Context, user *User) error
 
-	// Delete removes a user from the repository
+	// Delete soft-deletes a user so it no longer appears in queries
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// Restore reverses the soft deletion of a user
+	Restore(ctx context.Context, id uuid.UUID) error
+
 	// List retrieves all users with pagination
 	List(ctx context.Context, offset, limit int) ([]*User, error)
 }
 
 // CustomerService defines the interface for customer business logic
 type CustomerService interface {
-	// CreateCustomer creates a new customer
-	CreateCustomer(ctx context.Context, name, contactEmail string) (*Customer, error)
+	// CreateCustomer creates a new customer, added in a guild if guildID is set
+	CreateCustomer(ctx context.Context, guildID, name, contactEmail string) (*Customer, error)
 
 	// GetCustomer retrieves a customer by ID
 	GetCustomer(ctx context.Context, id uuid.UUID) (*Customer, error)
@@ -219,11 +443,30 @@ type CustomerService interface {
 	// GetCustomerByName retrieves a customer by name
 	GetCustomerByName(ctx context.Context, name string) (*Customer, error)
 
+	// GetCustomerByNameInGuild retrieves a customer by name among the customers of a guild
+	GetCustomerByNameInGuild(ctx context.Context, guildID, name string) (*Customer, error)
+
 	// UpdateCustomer updates customer information
 	UpdateCustomer(ctx context.Context, id uuid.UUID, name, contactEmail string) error
 
+	// SetEmailOptOut stops (or resumes) issue emails to a customer's contact
+	SetEmailOptOut(ctx context.Context, id uuid.UUID, optOut bool) error
+
 	// ListCustomers lists all customers
 	ListCustomers(ctx context.Context, offset, limit int) ([]*Customer, error)
+
+	// ListGuildCustomers lists the customers added in a guild or tracked in its channels, by name
+	ListGuildCustomers(ctx context.Context, guildID string) ([]*Customer, error)
+
+	// MergeCustomers moves the projects, users and API keys of a customer to another and
+	// deletes it. Customers that both have a project of the same name cannot be merged.
+	MergeCustomers(ctx context.Context, fromID, intoID uuid.UUID) (*Customer, error)
+
+	// DeleteCustomer soft-deletes a customer
+	DeleteCustomer(ctx context.Context, id uuid.UUID) error
+
+	// RestoreCustomer brings back a soft-deleted customer
+	RestoreCustomer(ctx context.Context, id uuid.UUID) error
 }
 
 // ProjectService defines the interface for project business logic
@@ -242,6 +485,28 @@ type ProjectService interface {
 
 	// ListProjects lists all projects
 	ListProjects(ctx context.Context, offset, limit int) ([]*Project, error)
+
+	// MergeProjects moves the issues and channels of a project to another and deletes it.
+	// Projects that both have a custom workflow cannot be merged.
+	MergeProjects(ctx context.Context, fromID, intoID uuid.UUID) (*Project, error)
+
+	// DeleteProject soft-deletes a project
+	DeleteProject(ctx context.Context, id uuid.UUID) error
+
+	// RestoreProject brings back a soft-deleted project
+	RestoreProject(ctx context.Context, id uuid.UUID) error
+
+	// SetGitHubRepo sets (or clears, with an empty repo) the GitHub repository a project is mirrored to
+	SetGitHubRepo(ctx context.Context, id uuid.UUID, repo string) error
+
+	// SetJiraProject sets (or clears, with an empty key) the Jira project a project is mirrored to
+	SetJiraProject(ctx context.Context, id uuid.UUID, key string) error
+
+	// SetSlackWebhook sets (or clears, with an empty URL) the Slack incoming webhook a project notifies
+	SetSlackWebhook(ctx context.Context, id uuid.UUID, webhookURL string) error
+
+	// SetInboundEmail sets (or clears, with an empty address) the support address whose emails become issues of a project
+	SetInboundEmail(ctx context.Context, id uuid.UUID, address string) error
 }
 
 // UserService defines the interface for user business logic
@@ -289,11 +554,200 @@ type IssueAssigneeService interface {
 	UnassignAllUsersFromIssue(ctx context.Context, issueID uuid.UUID) error
 	GetIssueAssignees(ctx context.Context, issueID uuid.UUID) ([]*IssueAssignee, error)
 	GetUserAssignments(ctx context.Context, userID uuid.UUID) ([]*IssueAssignee, error)
+	GetUserAssignmentsByDiscordID(ctx context.Context, discordID string) ([]*IssueAssignee, error)
 	GetAssigneesByRole(ctx context.Context, issueID uuid.UUID, role AssigneeRole) ([]*IssueAssignee, error)
 	IsUserAssignedToIssue(ctx context.Context, issueID, userID uuid.UUID) (bool, error)
 	IsUserAssignedWithRole(ctx context.Context, issueID, userID uuid.UUID, role AssigneeRole) (bool, error)
 }
 
+// LabelRepository defines the interface for label data access
+type LabelRepository interface {
+	Create(ctx context.Context, label *Label) error
+	GetByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*Label, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*Label, error)
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*Label, error)
+	AddToIssue(ctx context.Context, issueID, labelID uuid.UUID) error
+	RemoveFromIssue(ctx context.Context, issueID, labelID uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// LabelService defines the interface for label business logic
+type LabelService interface {
+	// AddLabelToIssue tags an issue, creating the label in the issue's project if needed.
+	// color is only used when the label is created and may be empty.
+	AddLabelToIssue(ctx context.Context, issueID uuid.UUID, name, color string) (*Label, error)
+	RemoveLabelFromIssue(ctx context.Context, issueID uuid.UUID, name string) error
+	GetIssueLabels(ctx context.Context, issueID uuid.UUID) ([]*Label, error)
+	ListProjectLabels(ctx context.Context, projectID uuid.UUID) ([]*Label, error)
+}
+
+// IssueLinkRepository defines the interface for issue link data access
+type IssueLinkRepository interface {
+	Create(ctx context.Context, link *IssueLink) error
+	// GetBetween retrieves the links between two issues in either direction
+	GetBetween(ctx context.Context, issueID, otherIssueID uuid.UUID) ([]*IssueLink, error)
+	// DeleteBetween removes the links between two issues in either direction, only those of
+	// linkType when it is not empty, and returns the number removed
+	DeleteBetween(ctx context.Context, issueID, otherIssueID uuid.UUID, linkType LinkType) (int64, error)
+}
+
+// IssueLinkService defines the interface for issue link business logic
+type IssueLinkService interface {
+	// LinkIssues records that the source issue relates to the target issue as linkType
+	LinkIssues(ctx context.Context, sourceID, targetID uuid.UUID, linkType LinkType, createdBy string) (*IssueLink, error)
+
+	// UnlinkIssues removes the links between two issues, only those of linkType when it is not empty
+	UnlinkIssues(ctx context.Context, issueID, otherIssueID uuid.UUID, linkType LinkType) error
+}
+
+// WorkflowRepository defines the interface for project workflow data access
+type WorkflowRepository interface {
+	// GetByProjectID retrieves a project's workflow with its statuses and transitions
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) (*WorkflowDefinition, error)
+	Create(ctx context.Context, workflow *WorkflowDefinition) error
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	AddStatus(ctx context.Context, status *WorkflowStatus) error
+	// RemoveStatus removes a custom status and the transitions from or to it
+	RemoveStatus(ctx context.Context, workflowID uuid.UUID, status Status) error
+
+	AddTransition(ctx context.Context, transition *WorkflowTransition) error
+	// RemoveTransition removes a custom transition and returns the number removed
+	RemoveTransition(ctx context.Context, workflowID uuid.UUID, from, to Status) (int64, error)
+}
+
+// WorkflowService defines the interface for customizing the issue workflow of the
+// project registered to a Discord channel. Status names are normalized with
+// NormalizeWorkflowStatus, so "In Progress" and "in_progress" name the same status.
+type WorkflowService interface {
+	// GetWorkflow retrieves the project's workflow, or nil when it uses the built-in workflow
+	GetWorkflow(ctx context.Context, discordChannelID string) (*WorkflowDefinition, error)
+
+	// AddStatus adds a custom status. It is reachable once a transition leads to it.
+	AddStatus(ctx context.Context, discordChannelID, name, createdBy string) (*WorkflowStatus, error)
+
+	// RemoveStatus removes a custom status and its transitions. It is refused while issues have the status.
+	RemoveStatus(ctx context.Context, discordChannelID, name string) error
+
+	// AddTransition allows issues to move between two statuses, built-in or custom
+	AddTransition(ctx context.Context, discordChannelID, from, to, createdBy string) (*WorkflowTransition, error)
+
+	// RemoveTransition removes a custom transition. Built-in transitions cannot be removed.
+	RemoveTransition(ctx context.Context, discordChannelID, from, to string) error
+
+	// ResetWorkflow drops the project's customizations and returns it to the built-in workflow.
+	// It is refused while issues have a custom status.
+	ResetWorkflow(ctx context.Context, discordChannelID string) error
+}
+
+// CustomFieldRepository defines the interface for custom field data access
+type CustomFieldRepository interface {
+	Create(ctx context.Context, field *CustomFieldDefinition) error
+	// ListByProject retrieves a project's custom fields in form order
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*CustomFieldDefinition, error)
+	// Delete removes a custom field and its values on issues
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// SetValue stores the value of a custom field on an issue, replacing any previous value
+	SetValue(ctx context.Context, value *IssueCustomFieldValue) error
+	ClearValue(ctx context.Context, issueID, fieldID uuid.UUID) error
+}
+
+// CustomFieldService defines the interface for the custom fields of projects and their
+// values on issues. Field names are matched case-insensitively.
+type CustomFieldService interface {
+	// ListFields retrieves the custom fields of the project registered to a Discord channel
+	ListFields(ctx context.Context, discordChannelID string) ([]*CustomFieldDefinition, error)
+	ListProjectFields(ctx context.Context, projectID uuid.UUID) ([]*CustomFieldDefinition, error)
+
+	// AddField adds a custom field to the project registered to a Discord channel. options
+	// are only used by select fields.
+	AddField(ctx context.Context, discordChannelID, name string, fieldType CustomFieldType, options []string, required bool, createdBy string) (*CustomFieldDefinition, error)
+
+	// RemoveField removes a custom field and its values on issues
+	RemoveField(ctx context.Context, discordChannelID, name string) error
+
+	// SetIssueValue sets the named custom field of an issue and returns the stored value with
+	// its field; an empty value clears the field
+	SetIssueValue(ctx context.Context, issueID uuid.UUID, name, value string) (*IssueCustomFieldValue, error)
+
+	// SetIssueValues sets several custom fields of an issue by field ID, skipping empty values.
+	// All values are checked before any is stored.
+	SetIssueValues(ctx context.Context, issueID uuid.UUID, values map[uuid.UUID]string) error
+}
+
+// RecurringIssueRepository defines the interface for recurring issue data access
+type RecurringIssueRepository interface {
+	Create(ctx context.Context, recurring *RecurringIssue) error
+	// ListByProject retrieves a project's recurring issues in the order they were added
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*RecurringIssue, error)
+	// ListDue retrieves the recurring issues due at now with their channels
+	ListDue(ctx context.Context, now time.Time) ([]*RecurringIssue, error)
+	// UpdateRun stores the last and next run times of a recurring issue
+	UpdateRun(ctx context.Context, recurring *RecurringIssue) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// RecurringIssueService defines the interface for issues filed on a schedule
+type RecurringIssueService interface {
+	// List retrieves the recurring issues of the project registered to a Discord channel
+	List(ctx context.Context, discordChannelID string) ([]*RecurringIssue, error)
+
+	// Add schedules an issue to be filed into the project registered to a Discord channel
+	// whenever the cron schedule matches
+	Add(ctx context.Context, discordChannelID, schedule, title, description string, priority Priority, createdBy string) (*RecurringIssue, error)
+
+	// Remove stops a recurring issue of the project registered to a Discord channel. Issues
+	// already filed are kept.
+	Remove(ctx context.Context, discordChannelID, title string) (*RecurringIssue, error)
+
+	// FileDueIssues files an issue for every recurring issue whose time has come and
+	// schedules its next run. Runs missed while the bot was down are filed once.
+	FileDueIssues(ctx context.Context) error
+}
+
+// ProjectWebhookRepository defines the interface for project webhook data access
+type ProjectWebhookRepository interface {
+	Create(ctx context.Context, webhook *ProjectWebhook) error
+	GetByProjectAndURL(ctx context.Context, projectID uuid.UUID, url string) (*ProjectWebhook, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*ProjectWebhook, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// WebhookService defines the interface for managing outbound project webhooks
+type WebhookService interface {
+	// RegisterWebhook adds a webhook to the project registered to a Discord channel.
+	// The returned webhook carries the secret deliveries are signed with.
+	RegisterWebhook(ctx context.Context, discordChannelID, url, createdBy string) (*ProjectWebhook, error)
+
+	// RemoveWebhook removes a webhook from the project registered to a Discord channel
+	RemoveWebhook(ctx context.Context, discordChannelID, url string) error
+
+	// ListWebhooks lists the webhooks of the project registered to a Discord channel
+	ListWebhooks(ctx context.Context, discordChannelID string) ([]*ProjectWebhook, error)
+}
+
+// IssueCommentRepository defines the interface for issue comment data access
+type IssueCommentRepository interface {
+	Create(ctx context.Context, comment *IssueComment) error
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*IssueComment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// IssueAttachmentRepository defines the interface for issue attachment data access
+type IssueAttachmentRepository interface {
+	Create(ctx context.Context, attachment *IssueAttachment) error
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*IssueAttachment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// IssueAttachmentService defines the interface for issue attachment business logic
+type IssueAttachmentService interface {
+	// AddAttachments stores attachments for an issue. uploaderDiscordID may be empty.
+	AddAttachments(ctx context.Context, issueID uuid.UUID, uploaderDiscordID string, attachments []*IssueAttachment) error
+	GetIssueAttachments(ctx context.Context, issueID uuid.UUID) ([]*IssueAttachment, error)
+}
+
 // IssueStatusLogRepository defines the interface for issue status log data access
 type IssueStatusLogRepository interface {
 	Create(ctx context.Context, log *IssueStatusLog) error
@@ -308,8 +762,751 @@ type IssueStatusLogRepository interface {
 // IssueStatusLogService defines the interface for issue status log business logic
 type IssueStatusLogService interface {
 	LogStatusChange(ctx context.Context, issueID uuid.UUID, oldStatus *Status, newStatus Status, changedBy *uuid.UUID) (*IssueStatusLog, error)
+	LogIssueEdit(ctx context.Context, issueID uuid.UUID, status Status, changedBy *uuid.UUID, note string) (*IssueStatusLog, error)
 	GetIssueStatusHistory(ctx context.Context, issueID uuid.UUID) ([]*IssueStatusLog, error)
 	GetUserStatusChanges(ctx context.Context, userID uuid.UUID) ([]*IssueStatusLog, error)
 	GetRecentStatusChanges(ctx context.Context, limit int) ([]*IssueStatusLog, error)
 	ValidateStatusTransition(ctx context.Context, issueID uuid.UUID, newStatus Status) error
 }
+
+// SLAAlertRepository defines the interface for SLA alert data access
+type SLAAlertRepository interface {
+	Create(ctx context.Context, alert *SLAAlert) error
+	Exists(ctx context.Context, issueID uuid.UUID, kind SLAKind, level SLALevel) (bool, error)
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*SLAAlert, error)
+}
+
+// SLAService defines the interface for SLA tracking business logic
+type SLAService interface {
+	// CheckSLAs scans active issues and sends alerts for SLAs that are about to breach or have breached
+	CheckSLAs(ctx context.Context) error
+
+	// SetPolicies replaces the default policies per priority and per severity and the
+	// warning threshold used by the next checks
+	SetPolicies(policies map[Priority]SLAPolicy, severityPolicies map[Severity]SLAPolicy, warningThreshold float64)
+}
+
+// SLANotifier delivers SLA alerts to users
+type SLANotifier interface {
+	NotifySLA(ctx context.Context, issue *Issue, alert *SLAAlert) error
+}
+
+// StaleIssueService defines the interface for nudging and closing issues without activity
+type StaleIssueService interface {
+	// GetPolicy retrieves the stale thresholds of the main project of a Discord channel
+	GetPolicy(ctx context.Context, discordChannelID string) (StalePolicy, error)
+
+	// SetPolicy sets the stale thresholds in days of the main project of a Discord channel.
+	// A nil closeAfterDays keeps the current close threshold.
+	SetPolicy(ctx context.Context, discordChannelID string, afterDays int, closeAfterDays *int) (StalePolicy, error)
+
+	// ResetPolicy makes the main project of a Discord channel use the configured thresholds
+	ResetPolicy(ctx context.Context, discordChannelID string) (StalePolicy, error)
+
+	// CheckStaleIssues nudges the assignees of unresolved issues without a status change
+	// or thread comment for too long, and closes nudged issues that stay idle
+	CheckStaleIssues(ctx context.Context) error
+}
+
+// StaleIssueNotifier tells an issue's assignees that it went stale or was closed for it
+type StaleIssueNotifier interface {
+	NotifyStale(ctx context.Context, issue *Issue, policy StalePolicy) error
+	NotifyStaleClosed(ctx context.Context, issue *Issue, policy StalePolicy) error
+}
+
+// DueDateService defines the interface for issue due dates and their reminders
+type DueDateService interface {
+	// SetDueDate sets the due date of an issue from a date such as "2025-03-14" or
+	// "2025-03-14 17:00", or removes it for "clear"
+	SetDueDate(ctx context.Context, issueID uuid.UUID, value, changedBy string) (*Issue, error)
+
+	// CheckDueDates reminds the assignees of unresolved issues whose due date is close,
+	// and again once it has passed
+	CheckDueDates(ctx context.Context) error
+}
+
+// DueDateNotifier reminds an issue's assignees of its due date
+type DueDateNotifier interface {
+	NotifyDueSoon(ctx context.Context, issue *Issue) error
+	NotifyOverdue(ctx context.Context, issue *Issue) error
+}
+
+// MilestoneRepository defines the interface for milestone data access
+type MilestoneRepository interface {
+	Create(ctx context.Context, milestone *Milestone) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Milestone, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*Milestone, error)
+	// ListProgressByProject retrieves a project's milestones with how many of their issues are closed
+	ListProgressByProject(ctx context.Context, projectID uuid.UUID) ([]*MilestoneProgress, error)
+	// Delete removes a milestone. Its issues are kept without a milestone.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// MilestoneService defines the interface for grouping issues into milestones
+type MilestoneService interface {
+	// Create adds a milestone to the project registered to a Discord channel. The target
+	// date is a date such as "2025-03-14" and may be empty.
+	Create(ctx context.Context, discordChannelID, name, targetDate, createdBy string) (*Milestone, error)
+
+	// List retrieves the milestones of the project registered to a Discord channel with
+	// their progress, by target date
+	List(ctx context.Context, discordChannelID string) ([]*MilestoneProgress, error)
+
+	// GetByName retrieves a milestone of the project registered to a Discord channel
+	GetByName(ctx context.Context, discordChannelID, name string) (*Milestone, error)
+
+	// GetByID retrieves a milestone by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Milestone, error)
+
+	// ListIssues retrieves the issues of a milestone
+	ListIssues(ctx context.Context, milestoneID uuid.UUID) ([]*Issue, error)
+
+	// Delete removes a milestone of the project registered to a Discord channel. Its issues
+	// are kept without a milestone.
+	Delete(ctx context.Context, discordChannelID, name string) (*Milestone, error)
+
+	// Assign adds an issue to a milestone of the issue's project, replacing its previous one
+	Assign(ctx context.Context, issueID uuid.UUID, name, changedBy string) (*Issue, error)
+
+	// Unassign removes an issue from its milestone
+	Unassign(ctx context.Context, issueID uuid.UUID, changedBy string) (*Issue, error)
+}
+
+// ComponentRepository defines the interface for component data access
+type ComponentRepository interface {
+	Create(ctx context.Context, component *Component) error
+	// GetByID retrieves a component with its lead
+	GetByID(ctx context.Context, id uuid.UUID) (*Component, error)
+	// ListByProject retrieves a project's components with their leads, by name
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*Component, error)
+	// SetLead changes the lead of a component, or removes it for nil
+	SetLead(ctx context.Context, id uuid.UUID, leadID *uuid.UUID) error
+	// Delete removes a component. Its issues are kept without a component.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ComponentService defines the interface for filing issues under the components of their project
+type ComponentService interface {
+	// Create adds a component to the project registered to a Discord channel
+	Create(ctx context.Context, discordChannelID, name, createdBy string) (*Component, error)
+
+	// List retrieves the components of the project registered to a Discord channel, by name
+	List(ctx context.Context, discordChannelID string) ([]*Component, error)
+
+	// ListByProject retrieves the components of a project, by name
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]*Component, error)
+
+	// GetByName retrieves a component of the project registered to a Discord channel
+	GetByName(ctx context.Context, discordChannelID, name string) (*Component, error)
+
+	// GetByID retrieves a component by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Component, error)
+
+	// Delete removes a component of the project registered to a Discord channel. Its issues
+	// are kept without a component.
+	Delete(ctx context.Context, discordChannelID, name string) (*Component, error)
+
+	// SetLead makes a team member of the channel's guild the lead of a component of the
+	// project registered to a Discord channel, who is suggested first for its issues. An
+	// empty leadDiscordID removes the lead. Leads need a team member profile.
+	SetLead(ctx context.Context, discordChannelID, name, leadDiscordID string) (*Component, error)
+
+	// SetIssueComponent files an issue under a component of its project, replacing its
+	// previous one, or removes it from its component for an empty name. Changes publish
+	// EventIssueComponentChanged.
+	SetIssueComponent(ctx context.Context, issueID uuid.UUID, name, changedBy string) (*Issue, error)
+}
+
+// EscalationRepository defines the interface for escalation rule and escalation data access
+type EscalationRepository interface {
+	// SaveRule stores a project's rule for a priority, replacing its previous one
+	SaveRule(ctx context.Context, rule *EscalationRule) error
+	ListRulesByProject(ctx context.Context, projectID uuid.UUID) ([]*EscalationRule, error)
+	ListRules(ctx context.Context) ([]*EscalationRule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+
+	// HasEscalated checks whether an issue was already escalated at a priority
+	HasEscalated(ctx context.Context, issueID uuid.UUID, priority Priority) (bool, error)
+	RecordEscalation(ctx context.Context, escalation *IssueEscalation) error
+}
+
+// EscalationService defines the interface for escalating issues that stay open too long
+type EscalationService interface {
+	// SetRule sets the escalation rule for a priority of the main project of a Discord channel
+	SetRule(ctx context.Context, discordChannelID string, priority Priority, afterHours int, roleID string, bumpPriority bool, createdBy string) (*EscalationRule, error)
+
+	// ListRules retrieves the escalation rules of the main project of a Discord channel
+	ListRules(ctx context.Context, discordChannelID string) ([]*EscalationRule, error)
+
+	// RemoveRule removes the escalation rule for a priority of the main project of a Discord channel
+	RemoveRule(ctx context.Context, discordChannelID string, priority Priority) (*EscalationRule, error)
+
+	// CheckEscalations escalates the open issues that are past their project's rules
+	CheckEscalations(ctx context.Context) error
+}
+
+// EscalationNotifier pings the escalation role of an issue
+type EscalationNotifier interface {
+	NotifyEscalation(ctx context.Context, issue *Issue, rule *EscalationRule) error
+}
+
+// IncidentRepository defines the interface for incident data access
+type IncidentRepository interface {
+	Create(ctx context.Context, incident *Incident) error
+	// GetByIssueID retrieves the incident of an issue with the issue and its channel
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) (*Incident, error)
+	// ListUnresolved retrieves the incidents whose issue is not resolved, verified or closed,
+	// with the issue and its channel
+	ListUnresolved(ctx context.Context) ([]*Incident, error)
+	// RecordUpdate stores when the latest status update of an incident was posted
+	RecordUpdate(ctx context.Context, id uuid.UUID, at time.Time) error
+	// RecordReminder stores when the commander of an incident was last reminded of an update
+	RecordReminder(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// IncidentService defines the interface for declaring incidents and keeping their status
+// updates coming
+type IncidentService interface {
+	// Declare files an incident in the main project of a Discord channel: an open issue of
+	// high priority and the declared severity, run by the declaring member
+	Declare(ctx context.Context, discordChannelID string, declaration IncidentDeclaration) (*Incident, error)
+
+	// GetByIssueID retrieves the incident of an issue, or ErrIncidentNotFound
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) (*Incident, error)
+
+	// PostUpdate records a status update of an incident in its issue history and waits the
+	// update interval again before reminding its commander
+	PostUpdate(ctx context.Context, issueID uuid.UUID, message, postedBy string) (*Incident, error)
+
+	// CheckUpdates reminds the commanders of unresolved incidents without a status update
+	// for the update interval
+	CheckUpdates(ctx context.Context) error
+
+	// UpdateInterval returns how often commanders are expected to post a status update
+	UpdateInterval() time.Duration
+
+	// HandleIssueEvent posts the timeline of incidents when they are closed
+	HandleIssueEvent(ctx context.Context, event Event)
+}
+
+// IncidentNotifier posts the reminders and timeline of an incident in its thread
+type IncidentNotifier interface {
+	NotifyUpdateDue(ctx context.Context, incident *Incident, interval time.Duration) error
+	// NotifyClosed posts the timeline of a closed incident, built from its issue history,
+	// and ends the stage of its bridge channel
+	NotifyClosed(ctx context.Context, incident *Incident, history []*IssueStatusLog) error
+}
+
+// BoardRepository defines the interface for board data access
+type BoardRepository interface {
+	// Save stores the board of a channel, replacing its previous one
+	Save(ctx context.Context, board *Board) error
+	GetByChannelID(ctx context.Context, channelID uuid.UUID) (*Board, error)
+	Delete(ctx context.Context, channelID uuid.UUID) error
+}
+
+// BoardService defines the interface for the live issue boards of channels
+type BoardService interface {
+	// GetBoard retrieves the board of a channel registration
+	GetBoard(ctx context.Context, channelID uuid.UUID) (*Board, error)
+
+	// SaveBoard records the message showing the board of a channel registration and returns
+	// the board it replaces, if any
+	SaveBoard(ctx context.Context, channel *Channel, messageID, createdBy string) (previous *Board, err error)
+
+	// RemoveBoard forgets the board of a channel registration, e.g. when its message was deleted
+	RemoveBoard(ctx context.Context, channelID uuid.UUID) error
+
+	// GetColumns sorts the issues of a channel registration into board columns
+	GetColumns(ctx context.Context, channelID uuid.UUID) ([]*BoardColumn, error)
+}
+
+// IssueWorklogRepository defines the interface for issue worklog data access
+type IssueWorklogRepository interface {
+	Create(ctx context.Context, worklog *IssueWorklog) error
+	// GetRunningByUser retrieves the timer a user is running with its issue
+	GetRunningByUser(ctx context.Context, userID uuid.UUID) (*IssueWorklog, error)
+	// Stop stores the end and recorded time of a running timer
+	Stop(ctx context.Context, worklog *IssueWorklog) error
+}
+
+// WorklogService defines the interface for tracking time spent on issues
+type WorklogService interface {
+	// StartTimer starts a timer on an issue for a Discord user. A user runs one timer at a time.
+	StartTimer(ctx context.Context, issueID uuid.UUID, discordID string) (*IssueWorklog, error)
+
+	// StopTimer stops the timer a Discord user is running and records the time spent.
+	// The returned worklog carries its issue.
+	StopTimer(ctx context.Context, discordID string) (*IssueWorklog, error)
+
+	// LogTime records time a Discord user spent on an issue without a timer
+	LogTime(ctx context.Context, issueID uuid.UUID, discordID string, spent time.Duration, note string) (*IssueWorklog, error)
+}
+
+// ReportRepository defines aggregate queries used for reports
+type ReportRepository interface {
+	// GetPeriodSummary counts issues opened, closed and resolved between from and to
+	GetPeriodSummary(ctx context.Context, scope ReportScope, from, to time.Time) (*PeriodSummary, error)
+
+	// CountUnresolvedByPriority counts unresolved issues per priority
+	CountUnresolvedByPriority(ctx context.Context, scope ReportScope) ([]PriorityCount, error)
+
+	// CountByStatus counts issues created between from and to per status
+	CountByStatus(ctx context.Context, scope ReportScope, from, to time.Time) ([]StatusCount, error)
+
+	// CountByPriority counts issues created between from and to per priority
+	CountByPriority(ctx context.Context, scope ReportScope, from, to time.Time) ([]PriorityCount, error)
+
+	// GetMeanResolutionTime averages the time from creation to first resolution of issues created between from and to
+	GetMeanResolutionTime(ctx context.Context, scope ReportScope, from, to time.Time) (int64, time.Duration, error)
+
+	// CountCreatedPerDay counts issues created between from and to per day in loc, oldest first
+	CountCreatedPerDay(ctx context.Context, scope ReportScope, from, to time.Time, loc *time.Location) ([]DailyCount, error)
+
+	// GetAssigneeWorkload counts open and closed issues per assignee for issues created between from and to
+	GetAssigneeWorkload(ctx context.Context, scope ReportScope, from, to time.Time) ([]AssigneeWorkload, error)
+
+	// GetTimeSpentByUser sums the time each user logged on issues between from and to, most first
+	GetTimeSpentByUser(ctx context.Context, scope ReportScope, from, to time.Time) ([]UserTimeSpent, error)
+
+	// GetCSAT averages the satisfaction ratings given between from and to
+	GetCSAT(ctx context.Context, scope ReportScope, from, to time.Time) (*CSATSummary, error)
+}
+
+// GuildSettingsRepository defines the interface for guild settings data access
+type GuildSettingsRepository interface {
+	Create(ctx context.Context, settings *GuildSettings) error
+	Update(ctx context.Context, settings *GuildSettings) error
+	GetByGuildID(ctx context.Context, guildID string) (*GuildSettings, error)
+	List(ctx context.Context) ([]*GuildSettings, error)
+}
+
+// GuildSettingsService defines the interface for per-guild configuration
+type GuildSettingsService interface {
+	// GetSettings retrieves a guild's settings. Guilds without stored settings get empty
+	// settings, which fall back to the bot's config.
+	GetSettings(ctx context.Context, guildID string) (*GuildSettings, error)
+
+	// ListSettings retrieves the settings of every guild that has stored some
+	ListSettings(ctx context.Context) ([]*GuildSettings, error)
+
+	// InitSettings stores default settings for a guild the bot joined, which fall back to
+	// the bot's config. Settings kept from an earlier stay in the guild are left as they are.
+	InitSettings(ctx context.Context, guildID string) (*GuildSettings, error)
+
+	// SetLocale sets the default locale of bot responses in the guild
+	SetLocale(ctx context.Context, guildID, locale, updatedBy string) (*GuildSettings, error)
+
+	// SetTimezone sets the time zone digests, stats and exports of the guild use
+	SetTimezone(ctx context.Context, guildID, timezone, updatedBy string) (*GuildSettings, error)
+
+	// AddAdminRole makes a Discord role grant the admin role in the guild
+	AddAdminRole(ctx context.Context, guildID, roleID, updatedBy string) (*GuildSettings, error)
+
+	// RemoveAdminRole stops a Discord role from granting the admin role in the guild
+	RemoveAdminRole(ctx context.Context, guildID, roleID, updatedBy string) (*GuildSettings, error)
+
+	// SetEscalationChannel sets (or clears, with an empty ID) the channel receiving the guild's SLA breach alerts
+	SetEscalationChannel(ctx context.Context, guildID, channelID, updatedBy string) (*GuildSettings, error)
+
+	// SetReportEmoji sets the reaction that reports a message as an issue in the guild;
+	// an empty emoji restores DefaultReportEmoji and "off" turns reporting by reaction off
+	SetReportEmoji(ctx context.Context, guildID, emoji, updatedBy string) (*GuildSettings, error)
+
+	// SetSLATarget overrides the configured SLA targets for one priority in the guild
+	SetSLATarget(ctx context.Context, guildID string, priority Priority, policy SLAPolicy, updatedBy string) (*GuildSettings, error)
+
+	// ClearSLATarget returns one priority to the configured SLA targets
+	ClearSLATarget(ctx context.Context, guildID string, priority Priority, updatedBy string) (*GuildSettings, error)
+
+	// SetFeature turns a feature on or off in the guild, overriding the bot's config
+	SetFeature(ctx context.Context, guildID string, feature Feature, enabled bool, updatedBy string) (*GuildSettings, error)
+
+	// ClearFeature returns a feature to the bot's config in the guild
+	ClearFeature(ctx context.Context, guildID string, feature Feature, updatedBy string) (*GuildSettings, error)
+}
+
+// FeatureService defines the interface for feature flags: defaults from the bot's config,
+// overridden per guild
+type FeatureService interface {
+	// IsEnabled reports whether a feature is on in a guild. If the guild's settings
+	// cannot be read, the configured default applies.
+	IsEnabled(ctx context.Context, guildID string, feature Feature) bool
+
+	// List returns the state of every feature in a guild
+	List(ctx context.Context, guildID string) ([]FeatureState, error)
+
+	// SetEnabled turns a feature on or off in a guild
+	SetEnabled(ctx context.Context, guildID string, feature Feature, enabled bool, updatedBy string) error
+
+	// Reset returns a feature to its configured default in a guild
+	Reset(ctx context.Context, guildID string, feature Feature, updatedBy string) error
+
+	// SetDefaults replaces the configured defaults, e.g. when the configuration is reloaded
+	SetDefaults(defaults map[Feature]bool)
+}
+
+// OnCallRepository defines the interface for on-call rotation data access
+type OnCallRepository interface {
+	// GetByProjectID retrieves a project's rotation with its members in rotation order
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) (*OnCallSchedule, error)
+	// List retrieves every rotation with its project and members
+	List(ctx context.Context) ([]*OnCallSchedule, error)
+	Create(ctx context.Context, schedule *OnCallSchedule) error
+	// UpdateRotation stores the current position and next rotation time of a schedule
+	UpdateRotation(ctx context.Context, schedule *OnCallSchedule) error
+	AddMember(ctx context.Context, member *OnCallMember) error
+	RemoveMember(ctx context.Context, scheduleID, userID uuid.UUID) error
+}
+
+// OnCallService defines the interface for on-call rotations and auto-assignment
+type OnCallService interface {
+	// GetSchedule retrieves the rotation of the project registered to a Discord channel
+	GetSchedule(ctx context.Context, discordChannelID string) (*OnCallSchedule, error)
+
+	// AddMember appends a user to the project's rotation, creating the rotation with its first member
+	AddMember(ctx context.Context, discordChannelID, discordID, addedBy string) (*OnCallSchedule, error)
+
+	// RemoveMember removes a user from the project's rotation. If they were on call, the next member takes over.
+	RemoveMember(ctx context.Context, discordChannelID, discordID string) (*OnCallSchedule, error)
+
+	// Handoff hands the project's rotation to the next member now
+	Handoff(ctx context.Context, discordChannelID string) (*OnCallSchedule, error)
+
+	// AdvanceRotations hands every rotation whose period has elapsed to its next member
+	AdvanceRotations(ctx context.Context) error
+
+	// HandleIssueEvent assigns new high-priority issues, and issues raised to high priority
+	// before anyone picked them up, to the project's on-call member and publishes
+	// EventIssueAutoAssigned. It is an EventHandler for EventIssueCreated and
+	// EventIssuePriorityChanged.
+	HandleIssueEvent(ctx context.Context, event Event)
+}
+
+// TeamRepository defines the interface for team member profile data access
+type TeamRepository interface {
+	// GetMember retrieves the profile of a user in a guild
+	GetMember(ctx context.Context, guildID string, userID uuid.UUID) (*TeamMember, error)
+	// ListMembers retrieves a guild's team members with their users, by name
+	ListMembers(ctx context.Context, guildID string) ([]*TeamMember, error)
+	// SaveMember creates or updates a team member profile
+	SaveMember(ctx context.Context, member *TeamMember) error
+	DeleteMember(ctx context.Context, guildID string, userID uuid.UUID) error
+	// CountOpenIssues counts the unresolved issues each of the users is assigned, in any role
+	CountOpenIssues(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	// ReplaceSuggestions replaces the suggested assignees of an issue
+	ReplaceSuggestions(ctx context.Context, issueID uuid.UUID, suggestions []IssueSuggestedAssignee) error
+}
+
+// TeamService defines the interface for team member profiles and routing issues to them
+type TeamService interface {
+	// ListMembers retrieves a guild's team members with their open issues
+	ListMembers(ctx context.Context, guildID string) ([]*TeamMember, error)
+
+	// SetMember creates or changes the profile of a member of a guild. A new profile
+	// assigns the member as a developer with unlimited capacity unless update says otherwise.
+	SetMember(ctx context.Context, guildID, discordID string, update TeamMemberUpdate, updatedBy string) (*TeamMember, error)
+
+	// RemoveMember deletes the profile of a member of a guild. Suggestions already on
+	// issue cards stay.
+	RemoveMember(ctx context.Context, guildID, discordID string) error
+
+	// SuggestAssignees ranks the team members of the issue's guild for it and stores the
+	// best MaxSuggestedAssignees of them as its suggested assignees, publishing
+	// EventAssigneesSuggested. Members already assigned or at capacity are left out.
+	SuggestAssignees(ctx context.Context, issueID uuid.UUID) ([]IssueSuggestedAssignee, error)
+
+	// HandleIssueEvent suggests assignees for issues opened without a developer, and in
+	// guilds with FeatureAutoAssign assigns the best of them, publishing EventIssueRouted.
+	// Open issues filed under another component are routed again. It is an EventHandler
+	// for EventIssueCreated, EventIssueStatusChanged and EventIssueComponentChanged.
+	HandleIssueEvent(ctx context.Context, event Event)
+}
+
+// OnCallNotifier tells users their on-call turn has started. Auto-assignments are
+// published as EventIssueAutoAssigned.
+type OnCallNotifier interface {
+	NotifyOnCallStarted(ctx context.Context, schedule *OnCallSchedule, user *User) error
+}
+
+// PresenceChecker reports whether members are around to pick up work
+type PresenceChecker interface {
+	// IsAway reports whether a member of a guild is known to be offline. Members whose
+	// presence is unknown are not away.
+	IsAway(guildID, discordID string) bool
+}
+
+// NotificationService defines the interface for direct message notifications
+type NotificationService interface {
+	// HandleIssueEvent DMs the reporter when their issue is resolved or closed, the
+	// assignee when they are assigned, the developers when QA rejects the fix, users
+	// mentioned in the issue thread, the assignees when an SLA is breached and the
+	// watchers of an issue when its status changes or it is commented on. Each user gets
+	// at most one message per event. Users who opted out, turned the event off, or made
+	// the change are skipped. It is an EventHandler for EventIssueStatusChanged,
+	// EventAssigneeAdded, EventIssueCommented and EventSLABreached.
+	HandleIssueEvent(ctx context.Context, event Event)
+
+	// IsOptedOut checks if a user turned direct message notifications off
+	IsOptedOut(ctx context.Context, discordID string) (bool, error)
+
+	// SetOptOut turns direct message notifications off or back on for a user
+	SetOptOut(ctx context.Context, discordID string, optOut bool) error
+
+	// GetPreferences retrieves a user's choices per notification event, with defaults for events they have not chosen
+	GetPreferences(ctx context.Context, discordID string) (NotificationPreferences, error)
+
+	// SetPreference turns the notifications of one event on or off for a user
+	SetPreference(ctx context.Context, discordID string, event NotificationEvent, enabled bool) (NotificationPreferences, error)
+}
+
+// IssueFeedbackRepository defines the interface for issue feedback data access
+type IssueFeedbackRepository interface {
+	// SaveRating stores the rating of an issue, replacing its previous one
+	SaveRating(ctx context.Context, feedback *IssueFeedback) error
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) (*IssueFeedback, error)
+	UpdateComment(ctx context.Context, issueID uuid.UUID, comment string) error
+}
+
+// FeedbackService defines the interface for the satisfaction surveys sent when issues are closed
+type FeedbackService interface {
+	// HandleIssueEvent sends the reporter of a closed issue a satisfaction survey. Reporters
+	// who opted out of direct messages or already rated the issue are skipped. It is an
+	// EventHandler for EventIssueStatusChanged.
+	HandleIssueEvent(ctx context.Context, event Event)
+
+	// Rate stores the rating the reporter of an issue gave in its survey
+	Rate(ctx context.Context, issueID uuid.UUID, discordID string, rating int) (*IssueFeedback, error)
+
+	// Comment adds the reporter's comment to the rating of an issue
+	Comment(ctx context.Context, issueID uuid.UUID, discordID, comment string) (*IssueFeedback, error)
+}
+
+// FeedbackSurveyor asks the reporter of a closed issue to rate how it was handled
+type FeedbackSurveyor interface {
+	SendSurvey(ctx context.Context, user *User, issue *Issue) error
+}
+
+// UserNotificationPreferenceRepository defines the interface for notification preference data access
+type UserNotificationPreferenceRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*UserNotificationPreference, error)
+	// Upsert creates the preference or updates the stored one for the same user and event
+	Upsert(ctx context.Context, pref *UserNotificationPreference) error
+}
+
+// UserNotifier delivers notifications to users
+type UserNotifier interface {
+	NotifyUser(ctx context.Context, user *User, notification Notification) error
+}
+
+// IssueWatcherRepository defines the interface for issue watcher data access
+type IssueWatcherRepository interface {
+	// Create adds a watcher; it returns ErrAlreadyWatching when the user already watches the issue
+	Create(ctx context.Context, watcher *IssueWatcher) error
+
+	// Delete removes a user from the watchers of an issue; it returns ErrNotWatching when the user does not watch it
+	Delete(ctx context.Context, issueID, userID uuid.UUID) error
+
+	// GetByIssueID retrieves the watchers of an issue with their users, oldest first
+	GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*IssueWatcher, error)
+}
+
+// WatcherService defines the interface for users watching issues
+type WatcherService interface {
+	// Watch subscribes a Discord user to the status changes and comments of an issue
+	Watch(ctx context.Context, issueID uuid.UUID, discordID string) error
+
+	// Unwatch ends a Discord user's subscription to an issue
+	Unwatch(ctx context.Context, issueID uuid.UUID, discordID string) error
+}
+
+// PermissionService defines the interface for role-based authorization
+type PermissionService interface {
+	// ResolveRole returns the most privileged role held by the actor, combining the stored
+	// user role, configured Discord role mappings, the guild's admin roles and guild
+	// administrator rights
+	ResolveRole(ctx context.Context, actor Actor) (UserRole, error)
+
+	// Authorize returns ErrPermissionDenied if the actor may not use the permission
+	Authorize(ctx context.Context, actor Actor, permission Permission) error
+}
+
+// ExportService defines the interface for issue exports
+type ExportService interface {
+	// ExportChannelProjectIssues exports every issue of the project registered to a Discord
+	// channel, or only those of the named milestone when milestone is not empty
+	ExportChannelProjectIssues(ctx context.Context, discordChannelID string, format ExportFormat, milestone string) (*ExportFile, error)
+
+	// ExportProjectIssues exports every issue of a project, or only those of the named
+	// milestone when milestone is not empty
+	ExportProjectIssues(ctx context.Context, projectID uuid.UUID, format ExportFormat, milestone string) (*ExportFile, error)
+}
+
+// ReleaseNotesService defines the interface for compiling release notes from closed issues
+type ReleaseNotesService interface {
+	// Compile gathers the closed issues of the project registered to a Discord channel that
+	// the scope picks, with the notes as a Markdown file, in the time zone of the channel's guild
+	Compile(ctx context.Context, discordChannelID string, scope ReleaseNotesScope) (*ReleaseNotes, error)
+}
+
+// StatsService defines the interface for project metrics
+type StatsService interface {
+	// GetChannelProjectStats computes metrics for the project registered to a Discord channel
+	// over the last days, only counting the issues of a component of the project unless
+	// componentID is uuid.Nil
+	GetChannelProjectStats(ctx context.Context, discordChannelID string, days int, componentID uuid.UUID) (*ProjectStats, error)
+}
+
+// DigestService defines the interface for periodic digest reports
+type DigestService interface {
+	// BuildDigest computes the digest for one channel covering the period ending at now
+	BuildDigest(ctx context.Context, channel *Channel, now time.Time) (*Digest, error)
+
+	// SendDigests builds and delivers a digest to every active registered channel
+	SendDigests(ctx context.Context) error
+}
+
+// DigestNotifier delivers digest reports to channels
+type DigestNotifier interface {
+	NotifyDigest(ctx context.Context, digest *Digest) error
+}
+
+// AuditLogRepository defines the interface for audit log data operations
+type AuditLogRepository interface {
+	// Create stores an audit log entry
+	Create(ctx context.Context, entry *AuditLog) error
+
+	// ListByGuildID retrieves the latest audit log entries of a guild, newest first
+	ListByGuildID(ctx context.Context, guildID string, limit int) ([]*AuditLog, error)
+}
+
+// Auditor records administrative actions in the audit log
+type Auditor interface {
+	// Record stores an audit log entry attributed to the actor in ctx. Failures are
+	// logged rather than returned, so auditing never undoes the recorded action; inside
+	// a unit of work the entry is stored once it commits.
+	Record(ctx context.Context, entry AuditEntry)
+}
+
+// AuditLogService defines the interface for the audit log
+type AuditLogService interface {
+	Auditor
+
+	// ListRecent retrieves the latest audit log entries of a guild, newest first
+	ListRecent(ctx context.Context, guildID string, limit int) ([]*AuditLog, error)
+}
+
+// IssueEmailRepository defines the interface for the Message-IDs of issue emails
+type IssueEmailRepository interface {
+	// Create records the Message-ID of an email of an issue
+	Create(ctx context.Context, email *IssueEmail) error
+
+	// GetIssueID retrieves the issue of the first of the Message-IDs that belongs to one.
+	// It returns ErrIssueNotFound when none does.
+	GetIssueID(ctx context.Context, messageIDs []string) (uuid.UUID, error)
+}
+
+// InboundEmailService defines the interface for turning emails into issues
+type InboundEmailService interface {
+	// Receive files an email sent to a project's support address as an issue, or adds it
+	// to the issue it replies to as a comment. Emails received before are ignored.
+	Receive(ctx context.Context, email *InboundEmail) (*Issue, error)
+}
+
+// APIKeyRepository defines the interface for API key data operations
+type APIKeyRepository interface {
+	// Create stores a new API key
+	Create(ctx context.Context, key *APIKey) error
+
+	// GetByHash retrieves an API key by the hash of its token, revoked or not
+	GetByHash(ctx context.Context, hash string) (*APIKey, error)
+
+	// ListByCustomer retrieves the keys of a customer, or those without one for nil, newest first
+	ListByCustomer(ctx context.Context, customerID *uuid.UUID, includeRevoked bool) ([]*APIKey, error)
+
+	// Revoke marks a key as revoked at the given time
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// TouchLastUsed records when a key was last used
+	TouchLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// APIKeyService defines the interface for REST API keys
+type APIKeyService interface {
+	// CreateKey issues a key for the customer of the project registered to a Discord
+	// channel. The token is returned only here; just its hash is stored.
+	CreateKey(ctx context.Context, discordChannelID, name, scopes, createdBy string) (*APIKey, string, error)
+
+	// CreateOperatorKey issues a key from the CLI for a customer, or for all customers when nil
+	CreateOperatorKey(ctx context.Context, customerID *uuid.UUID, name, scopes string) (*APIKey, string, error)
+
+	// ListKeys lists the active keys of the customer of the project registered to a Discord channel
+	ListKeys(ctx context.Context, discordChannelID string) ([]*APIKey, error)
+
+	// RevokeKey revokes an active key of the channel's customer by its prefix
+	RevokeKey(ctx context.Context, discordChannelID, prefix string) (*APIKey, error)
+
+	// Authenticate returns the active key of a token and records its use. It returns
+	// ErrInvalidAPIKey for unknown and revoked keys.
+	Authenticate(ctx context.Context, token string) (*APIKey, error)
+}
+
+// BulkService defines the interface for changing several issues at once
+type BulkService interface {
+	// Apply makes the change of a bulk request to every issue it selects in one
+	// transaction. Issues that refuse the change are reported in the result and roll
+	// back the whole operation; dry runs are always rolled back.
+	Apply(ctx context.Context, req BulkRequest) (*BulkResult, error)
+}
+
+// EventClaimRepository defines the interface for replicas of the bot sharing which
+// Discord events they have handled
+type EventClaimRepository interface {
+	// Claim records the event with the given key as handled by the caller until ttl has
+	// passed. It reports false when another replica holds an unexpired claim on it.
+	Claim(ctx context.Context, key string, ttl time.Duration) (bool, error)
+
+	// DeleteExpired removes the claims that expired before the given time
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxRepository defines the interface for the Discord side effects of issue changes
+// that have not been done yet
+type OutboxRepository interface {
+	// Add records an action for an issue, due at the given time. An action of the same type
+	// already pending for the issue is postponed to that time instead, with its failed
+	// attempts forgotten.
+	Add(ctx context.Context, issueID uuid.UUID, actionType OutboxActionType, dueAt time.Time) error
+
+	// Due returns up to limit actions due at the given time, the longest due first
+	Due(ctx context.Context, now time.Time, limit int) ([]*OutboxAction, error)
+
+	// Complete removes an issue's pending action of a type if it was recorded before the
+	// given time. An action recorded later is for a newer change and stays.
+	Complete(ctx context.Context, issueID uuid.UUID, actionType OutboxActionType, recordedBefore time.Time) error
+
+	// Retry records a failed attempt of an action and makes it due again at the given time
+	Retry(ctx context.Context, id uuid.UUID, dueAt time.Time, lastError string) error
+}
+
+// RateLimiter defines the interface for throttling actions per key within a sliding window
+type RateLimiter interface {
+	// Take records an action for key if the key is below its limit and returns when it
+	// happened. Otherwise ok is false and at is when the next action will be allowed.
+	Take(ctx context.Context, key string) (at time.Time, ok bool, err error)
+
+	// Undo forgets the action recorded at at, e.g. because the action it allowed failed
+	Undo(ctx context.Context, key string, at time.Time) error
+
+	// SetLimit changes the actions allowed per key within window; 0 lets every action through
+	SetLimit(limit int, window time.Duration)
+}
+
+// StateStore defines the interface for short-lived state shared by the replicas of the bot
+type StateStore interface {
+	// Put stores a value under key until ttl has passed
+	Put(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Take removes and returns the value of key; ok is false when it is unknown or expired
+	Take(ctx context.Context, key string) (value []byte, ok bool, err error)
+}
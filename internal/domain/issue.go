@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Priority represents the priority level of an issue
@@ -15,6 +16,21 @@ const (
 	PriorityHigh   Priority = "high"
 )
 
+// Severity represents the impact of an incident-style issue, from S1 (critical) to S4 (low).
+// Unlike the priority, which says how soon an issue should be worked on, it says how badly
+// customers are affected. Issues without a severity are not incidents.
+type Severity string
+
+const (
+	SeverityS1 Severity = "s1" // Service down or data lost for many customers
+	SeverityS2 Severity = "s2" // Major feature broken without a workaround
+	SeverityS3 Severity = "s3" // Feature impaired, with a workaround
+	SeverityS4 Severity = "s4" // Cosmetic or minor inconvenience
+)
+
+// Severities lists the severities from the most to the least severe
+var Severities = []Severity{SeverityS1, SeverityS2, SeverityS3, SeverityS4}
+
 // Status represents the status of an issue
 type Status string
 
@@ -33,39 +49,173 @@ const (
 type Source string
 
 const (
-	SourceWeb     Source = "web"
-	SourceDiscord Source = "discord"
+	SourceWeb       Source = "web"
+	SourceDiscord   Source = "discord"
+	SourceRecurring Source = "recurring" // Filed on schedule from a RecurringIssue
+	SourceEmail     Source = "email"     // Sent to a project's support address
 )
 
 // Issue represents a bug report or feature request
 type Issue struct {
-	ID               uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	ProjectID        uuid.UUID  `json:"project_id" gorm:"type:uuid;not null"`  // Always required - main relationship
-	ChannelID        *uuid.UUID `json:"channel_id,omitempty" gorm:"type:uuid"` // Optional - only for Discord issues
-	ReporterID       uuid.UUID  `json:"reporter_id" gorm:"type:uuid;not null"`
-	AssigneeID       *uuid.UUID `json:"assignee_id,omitempty" gorm:"type:uuid"`
-	Title            string     `json:"title" gorm:"not null;size:255"`
-	Description      string     `json:"description" gorm:"not null;type:text"`
-	ImageURL         string     `json:"image_url,omitempty" gorm:"size:500"`
-	Priority         Priority   `json:"priority" gorm:"size:10;default:'medium'"`
-	Status           Status     `json:"status" gorm:"size:40;default:'open'"`
-	Source           string     `json:"source" gorm:"size:20;default:'web'"`               // 'discord' or 'web'
-	ThreadID         string     `json:"thread_id,omitempty" gorm:"size:100"`               // Discord thread ID (optional)
-	MessageID        string     `json:"message_id,omitempty" gorm:"size:100"`              // Discord message ID (optional)
-	PublicHash       string     `json:"public_hash,omitempty" gorm:"size:100;uniqueIndex"` // For public links
-	ResolutionCause  string     `json:"resolution_cause,omitempty" gorm:"type:text"`       // For resolution cause
-	ResolutionAction string     `json:"resolution_action,omitempty" gorm:"type:text"`      // For resolution action
-	CreatedAt        time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
-	UpdatedAt        time.Time  `json:"updated_at" gorm:"type:timestamptz;default:now()"`
-	ClosedAt         *time.Time `json:"closed_at,omitempty" gorm:"type:timestamptz"`
+	ID                uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID         uuid.UUID      `json:"project_id" gorm:"type:uuid;not null"`  // Always required - main relationship
+	ChannelID         *uuid.UUID     `json:"channel_id,omitempty" gorm:"type:uuid"` // Optional - only for Discord issues
+	ReporterID        uuid.UUID      `json:"reporter_id" gorm:"type:uuid;not null"`
+	AssigneeID        *uuid.UUID     `json:"assignee_id,omitempty" gorm:"type:uuid"`
+	Title             string         `json:"title" gorm:"not null;size:255"`
+	Description       string         `json:"description" gorm:"not null;type:text"`
+	ImageURL          string         `json:"image_url,omitempty" gorm:"size:500"`
+	Priority          Priority       `json:"priority" gorm:"size:10;default:'medium'"`
+	Severity          Severity       `json:"severity,omitempty" gorm:"size:10"` // Set for incident-style issues (optional)
+	Status            Status         `json:"status" gorm:"size:40;default:'open'"`
+	Source            string         `json:"source" gorm:"size:20;default:'web'"`                                   // 'discord', 'web', 'recurring' or 'email'
+	ThreadID          string         `json:"thread_id,omitempty" gorm:"size:100;index"`                             // Discord thread ID (optional)
+	MessageID         string         `json:"message_id,omitempty" gorm:"size:100;index"`                            // Discord message ID (optional)
+	PublicHash        string         `json:"public_hash,omitempty" gorm:"size:100;uniqueIndex"`                     // For public links
+	ResolutionCause   string         `json:"resolution_cause,omitempty" gorm:"type:text"`                           // For resolution cause
+	ResolutionAction  string         `json:"resolution_action,omitempty" gorm:"type:text"`                          // For resolution action
+	GitHubIssueNumber *int           `json:"github_issue_number,omitempty" gorm:"column:github_issue_number;index"` // Mirrored GitHub issue (optional)
+	JiraKey           string         `json:"jira_key,omitempty" gorm:"column:jira_key;size:50;index"`               // Mirrored Jira ticket (optional)
+	JiraStatus        string         `json:"jira_status,omitempty" gorm:"column:jira_status;size:100"`              // Last Jira status seen by the poller
+	CreatedAt         time.Time      `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt         time.Time      `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	ClosedAt          *time.Time     `json:"closed_at,omitempty" gorm:"type:timestamptz"`
+	StaleNudgedAt     *time.Time     `json:"stale_nudged_at,omitempty" gorm:"type:timestamptz"`  // Last time the issue was nudged for having no activity
+	DueDate           *time.Time     `json:"due_date,omitempty" gorm:"type:timestamptz;index"`   // When the issue should be resolved (optional)
+	DueReminderSent   DueReminder    `json:"due_reminder_sent,omitempty" gorm:"size:20"`         // Last reminder sent for the current due date
+	DeletedAt         gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamptz;index"` // Set when soft-deleted
+
+	// Per-project sequential key, e.g. "ACME-42"; Number is 42 and Key the full key
+	Number int    `json:"number,omitempty" gorm:"column:number;not null;default:0"`
+	Key    string `json:"key,omitempty" gorm:"column:issue_key;size:20;uniqueIndex:idx_issues_issue_key,where:issue_key <> ''"`
+
+	// Parent issue when this issue is a sub-task of another one
+	ParentIssueID *uuid.UUID `json:"parent_issue_id,omitempty" gorm:"type:uuid;index"`
+
+	// Milestone the issue is planned for (optional)
+	MilestoneID *uuid.UUID `json:"milestone_id,omitempty" gorm:"type:uuid;index"`
+
+	// Component of its project the issue is filed under (optional)
+	ComponentID *uuid.UUID `json:"component_id,omitempty" gorm:"type:uuid;index"`
 
 	// Relationships
-	Project    Project          `json:"project,omitempty" gorm:"foreignKey:ProjectID"` // Main relationship
-	Channel    *Channel         `json:"channel,omitempty" gorm:"foreignKey:ChannelID"` // Optional Discord channel (UUID → channels.id)
-	Reporter   User             `json:"reporter,omitempty" gorm:"foreignKey:ReporterID"`
-	Assignee   *User            `json:"assignee,omitempty" gorm:"foreignKey:AssigneeID"` // Legacy single assignee (deprecated)
-	Assignees  []IssueAssignee  `json:"assignees,omitempty" gorm:"foreignKey:IssueID"`   // New multi-assignee with roles
-	StatusLogs []IssueStatusLog `json:"status_logs,omitempty" gorm:"foreignKey:IssueID"` // Status change history
+	Project     Project           `json:"project,omitempty" gorm:"foreignKey:ProjectID"` // Main relationship
+	Channel     *Channel          `json:"channel,omitempty" gorm:"foreignKey:ChannelID"` // Optional Discord channel (UUID → channels.id)
+	Reporter    User              `json:"reporter,omitempty" gorm:"foreignKey:ReporterID"`
+	Assignee    *User             `json:"assignee,omitempty" gorm:"foreignKey:AssigneeID"` // Legacy single assignee (deprecated)
+	Assignees   []IssueAssignee   `json:"assignees,omitempty" gorm:"foreignKey:IssueID"`   // New multi-assignee with roles
+	StatusLogs  []IssueStatusLog  `json:"status_logs,omitempty" gorm:"foreignKey:IssueID"` // Status change history
+	Attachments []IssueAttachment `json:"attachments,omitempty" gorm:"foreignKey:IssueID"` // Attached files
+	Labels      []Label           `json:"labels,omitempty" gorm:"many2many:issue_labels"`  // Project-scoped tags
+	Worklogs    []IssueWorklog    `json:"worklogs,omitempty" gorm:"foreignKey:IssueID"`    // Time spent on the issue
+	Milestone   *Milestone        `json:"milestone,omitempty" gorm:"foreignKey:MilestoneID"`
+	Component   *Component        `json:"component,omitempty" gorm:"foreignKey:ComponentID"`
+
+	// Values of the project's custom fields
+	CustomFieldValues []IssueCustomFieldValue `json:"custom_field_values,omitempty" gorm:"foreignKey:IssueID"`
+
+	// Team members suggested to work on the issue, best first
+	SuggestedAssignees []IssueSuggestedAssignee `json:"suggested_assignees,omitempty" gorm:"foreignKey:IssueID"`
+
+	// Links to other issues, split by the side of the link this issue is on
+	OutgoingLinks []IssueLink `json:"outgoing_links,omitempty" gorm:"foreignKey:SourceIssueID"`
+	IncomingLinks []IssueLink `json:"incoming_links,omitempty" gorm:"foreignKey:TargetIssueID"`
+
+	// Sub-tasks and the issue they belong to
+	ParentIssue *Issue  `json:"parent_issue,omitempty" gorm:"foreignKey:ParentIssueID"`
+	SubIssues   []Issue `json:"sub_issues,omitempty" gorm:"foreignKey:ParentIssueID"`
+}
+
+// IssueFilter narrows issue listings. Zero values match any status, priority, milestone
+// or component.
+type IssueFilter struct {
+	Status      Status
+	Priority    Priority
+	MilestoneID uuid.UUID
+	ComponentID uuid.UUID
+}
+
+// IssueSort orders the issues of a query
+type IssueSort string
+
+const (
+	IssueSortNewest   IssueSort = "newest"   // Most recently created first; the default
+	IssueSortOldest   IssueSort = "oldest"   // Least recently created first
+	IssueSortUpdated  IssueSort = "updated"  // Most recently updated first
+	IssueSortStale    IssueSort = "stale"    // Least recently updated first
+	IssueSortPriority IssueSort = "priority" // Highest priority first, then newest
+	IssueSortDueDate  IssueSort = "due_date" // Soonest due first, issues without a due date last
+)
+
+// IsValidIssueSort checks if the sort order is known; empty stands for IssueSortNewest
+func IsValidIssueSort(s IssueSort) bool {
+	switch s {
+	case "", IssueSortNewest, IssueSortOldest, IssueSortUpdated, IssueSortStale, IssueSortPriority, IssueSortDueDate:
+		return true
+	default:
+		return false
+	}
+}
+
+// IssueDetails picks the relationships loaded with the issues of a query besides their
+// project and reporter
+type IssueDetails int
+
+const (
+	IssueDetailsAssignees IssueDetails = 1 << iota // Assignees and their users
+	IssueDetailsHistory                            // Labels, custom fields, worklogs, milestone and status history
+)
+
+// IssueQuery selects, orders and pages issues. Zero values match anything.
+type IssueQuery struct {
+	Statuses         []Status
+	Priority         Priority
+	CustomerID       uuid.UUID
+	ProjectID        uuid.UUID
+	ChannelID        uuid.UUID // Channel registration the issues were reported in
+	DiscordChannelID string    // Discord channel the issues were reported in
+	MilestoneID      uuid.UUID
+	ComponentID      uuid.UUID
+	AssigneeID       uuid.UUID // Matches issues the user is assigned to in any role
+	ReporterID       uuid.UUID
+	Source           Source
+	CreatedAfter     time.Time
+	CreatedBefore    time.Time
+	UpdatedBefore    time.Time
+	ClosedAfter      time.Time // Matches issues closed at or after this time
+	ClosedBefore     time.Time // Matches issues closed before this time
+	DueBefore        time.Time // Matches issues with a due date before this time
+	Text             string    // Case-insensitive match on the key, title or description
+
+	Sort    IssueSort
+	Offset  int
+	Limit   int // Zero returns every matching issue
+	Details IssueDetails
+}
+
+// Validate checks the statuses, priority and sort order of the query
+func (q IssueQuery) Validate() error {
+	for _, status := range q.Statuses {
+		if !IsValidStatus(status) && status != StatusDraft {
+			return ErrInvalidStatus
+		}
+	}
+	if q.Priority != "" && !IsValidPriority(q.Priority) {
+		return ErrInvalidPriority
+	}
+	if !IsValidIssueSort(q.Sort) {
+		return ErrInvalidIssueSort
+	}
+	return nil
+}
+
+// Query returns the query of the issues matching the filter
+func (f IssueFilter) Query() IssueQuery {
+	query := IssueQuery{Priority: f.Priority, MilestoneID: f.MilestoneID, ComponentID: f.ComponentID}
+	if f.Status != "" {
+		query.Statuses = []Status{f.Status}
+	}
+	return query
 }
 
 // TableName specifies the table name for Issue
@@ -78,14 +228,35 @@ func IsValidPriority(p Priority) bool {
 	return p == PriorityLow || p == PriorityMedium || p == PriorityHigh
 }
 
+// IsValidSeverity checks if the given severity is valid
+func IsValidSeverity(s Severity) bool {
+	return s == SeverityS1 || s == SeverityS2 || s == SeverityS3 || s == SeverityS4
+}
+
+// GetDisplayName returns a human-readable name for the severity, e.g. "S1 - Critical"
+func (s Severity) GetDisplayName() string {
+	switch s {
+	case SeverityS1:
+		return "S1 - Critical"
+	case SeverityS2:
+		return "S2 - Major"
+	case SeverityS3:
+		return "S3 - Minor"
+	case SeverityS4:
+		return "S4 - Low"
+	default:
+		return string(s)
+	}
+}
+
 // IsValidStatus checks if the given status is valid
 func IsValidStatus(s Status) bool {
-	return s == StatusOpen || s == StatusClosed || s == StatusInProgress || s == StatusResolved || s == StatusVerified || s == StatusClosed || s == StatusRejected || s == StatusReopened
+	return s == StatusOpen || s == StatusInProgress || s == StatusResolved || s == StatusVerified || s == StatusClosed || s == StatusRejected || s == StatusReopened
 }
 
 // IsValidSource checks if the given source is valid
 func IsValidSource(s Source) bool {
-	return s == SourceWeb || s == SourceDiscord
+	return s == SourceWeb || s == SourceDiscord || s == SourceRecurring || s == SourceEmail
 }
 
 // IsDiscordIssue checks if the issue was created from Discord
@@ -111,10 +282,27 @@ func (i *Issue) Reopen() {
 	i.ClosedAt = nil
 }
 
-// GenerateIssueID generates a human-readable issue ID
-func GenerateIssueID() string {
-	now := time.Now()
-	return "ISS-" + now.Format("2006") + "-" + uuid.New().String()[:8]
+// IsSubIssue checks if the issue is a sub-task of another issue
+func (i *Issue) IsSubIssue() bool {
+	return i.ParentIssueID != nil
+}
+
+// GetSubIssueProgress returns how many of the issue's sub-tasks are closed and how many it has
+func (i *Issue) GetSubIssueProgress() (closed, total int) {
+	for _, sub := range i.SubIssues {
+		if sub.IsClosed() {
+			closed++
+		}
+	}
+	return closed, len(i.SubIssues)
+}
+
+// ShortID returns the issue key, or the first 8 characters of the ID for issues without one
+func (i *Issue) ShortID() string {
+	if i.Key != "" {
+		return i.Key
+	}
+	return i.ID.String()[:8]
 }
 
 // GetAssigneesByRole returns assignees with a specific role
@@ -201,13 +389,19 @@ func (i *Issue) ChangeStatus(newStatus Status, changedBy *uuid.UUID) *IssueStatu
 	return NewIssueStatusLog(i.ID, oldStatus, newStatus, changedBy)
 }
 
+// Workflow returns the workflow definition of the issue's project, or nil for the
+// built-in workflow. It is only set when the project was loaded with its workflow.
+func (i *Issue) Workflow() *WorkflowDefinition {
+	return i.Project.Workflow
+}
+
 // CanTransitionTo checks if the issue can transition to the given status
 func (i *Issue) CanTransitionTo(newStatus Status) bool {
 	currentStatus := &i.Status
 	if i.Status == "" {
 		currentStatus = nil
 	}
-	return IsStatusTransitionValid(currentStatus, newStatus)
+	return i.Workflow().CanTransition(currentStatus, newStatus)
 }
 
 // GetWorkflowStage returns the current workflow stage (1-7)
@@ -217,7 +411,7 @@ func (i *Issue) GetWorkflowStage() int {
 
 // GetNextPossibleStatuses returns possible next statuses for this issue
 func (i *Issue) GetNextPossibleStatuses() []Status {
-	return GetNextPossibleStatuses(i.Status)
+	return i.Workflow().NextStatuses(i.Status)
 }
 
 // IsInDevPhase checks if the issue is in development phase
@@ -242,7 +436,7 @@ func (i *Issue) IsClosed() bool {
 
 // GetStatusDisplayName returns human-readable status name
 func (i *Issue) GetStatusDisplayName() string {
-	return GetStatusDisplayName(i.Status)
+	return i.Workflow().StatusDisplayName(i.Status)
 }
 
 // GetStatusColor returns color code for UI display
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IssueAttachment represents a file attached to an issue, e.g. from the Discord message it was created from
+type IssueAttachment struct {
+	ID                  uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID             uuid.UUID  `json:"issue_id" gorm:"type:uuid;not null;index"`
+	UploadedByID        *uuid.UUID `json:"uploaded_by_id,omitempty" gorm:"type:uuid"`
+	DiscordAttachmentID string     `json:"discord_attachment_id,omitempty" gorm:"size:100"`
+	FileName            string     `json:"file_name" gorm:"not null;size:255"`
+	URL                 string     `json:"url" gorm:"not null;size:1000"`
+	ContentType         string     `json:"content_type,omitempty" gorm:"size:100"`
+	Size                int        `json:"size"` // Size in bytes
+	CreatedAt           time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Issue      Issue `json:"-" gorm:"foreignKey:IssueID"`
+	UploadedBy *User `json:"uploaded_by,omitempty" gorm:"foreignKey:UploadedByID"`
+}
+
+// TableName specifies the table name for IssueAttachment
+func (IssueAttachment) TableName() string {
+	return "issue_attachments"
+}
+
+// IsImage checks if the attachment can be rendered inline as an image
+func (a *IssueAttachment) IsImage() bool {
+	return strings.HasPrefix(a.ContentType, "image/")
+}
+
+// FirstImageURL returns the URL of the first image attachment, or an empty string
+func FirstImageURL(attachments []IssueAttachment) string {
+	for i := range attachments {
+		if attachments[i].IsImage() {
+			return attachments[i].URL
+		}
+	}
+	return ""
+}
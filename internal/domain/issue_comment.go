@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IssueComment represents a message posted in an issue's discussion thread.
+// Comments are kept in the database so they survive thread archiving.
+type IssueComment struct {
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID          uuid.UUID  `json:"issue_id" gorm:"type:uuid;not null;index"`
+	AuthorID         *uuid.UUID `json:"author_id,omitempty" gorm:"type:uuid"`
+	AuthorName       string     `json:"author_name" gorm:"size:255"`
+	DiscordMessageID string     `json:"discord_message_id,omitempty" gorm:"size:100;index"`
+	Content          string     `json:"content" gorm:"not null;type:text"`
+	CreatedAt        time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Issue  Issue `json:"-" gorm:"foreignKey:IssueID"`
+	Author *User `json:"author,omitempty" gorm:"foreignKey:AuthorID"`
+}
+
+// TableName specifies the table name for IssueComment
+func (IssueComment) TableName() string {
+	return "issue_comments"
+}
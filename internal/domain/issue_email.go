@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxEmailTitleLength is the longest issue title taken from an email subject
+const MaxEmailTitleLength = 200
+
+// InboundEmail is an email sent to a project's support address, as handed over by the
+// mail gateway
+type InboundEmail struct {
+	MessageID   string   // Message-ID without angle brackets
+	References  []string // Message IDs of In-Reply-To and References
+	FromName    string
+	FromAddress string   // Lower case
+	To          []string // Recipient addresses, lower case
+	Subject     string
+	Body        string // Plain text
+}
+
+// IssueEmail links the Message-ID of an email to the issue it created or was added to,
+// so replies to it are threaded into the issue
+type IssueEmail struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	IssueID   uuid.UUID `json:"issue_id" gorm:"type:uuid;not null;index"`
+	MessageID string    `json:"message_id" gorm:"size:255;not null;uniqueIndex"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for IssueEmail
+func (IssueEmail) TableName() string {
+	return "issue_emails"
+}
+
+// replyPrefixPattern matches the reply and forward markers mail clients put before a subject
+var replyPrefixPattern = regexp.MustCompile(`(?i)^\s*((re|fwd?|aw|wg|sv)\s*(\[\d+\])?\s*:\s*)+`)
+
+// quoteHeaderPattern matches the line mail clients put above the quoted email they reply to,
+// e.g. "On Mon, 3 Mar 2025 at 10:00, Jane <jane@example.com> wrote:"
+var quoteHeaderPattern = regexp.MustCompile(`(?i)^(on .+ wrote:|-+ ?original message ?-+|from: .+)$`)
+
+// subjectKeyPattern matches an issue key in square brackets, e.g. "[ACME-42]"
+var subjectKeyPattern = regexp.MustCompile(`\[([A-Za-z][A-Za-z0-9]{0,9}-[1-9][0-9]{0,9})\]`)
+
+// EmailIssueTitle derives an issue title from an email subject without its reply markers
+func EmailIssueTitle(subject string) string {
+	title := strings.TrimSpace(replyPrefixPattern.ReplaceAllString(subject, ""))
+	if title == "" {
+		return "(no subject)"
+	}
+	if runes := []rune(title); len(runes) > MaxEmailTitleLength {
+		title = string(runes[:MaxEmailTitleLength-1]) + "…"
+	}
+	return title
+}
+
+// IssueKeyInSubject finds an issue key in square brackets in an email subject, as the
+// emails sent to customers have
+func IssueKeyInSubject(subject string) (string, bool) {
+	match := subjectKeyPattern.FindStringSubmatch(subject)
+	if match == nil {
+		return "", false
+	}
+	return NormalizeIssueKey(match[1])
+}
+
+// StripQuotedReply cuts the quoted email a reply ends with, so only the new text becomes
+// a comment. A body that is nothing but a quote is kept whole.
+func StripQuotedReply(body string) string {
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if quoteHeaderPattern.MatchString(line) || (strings.HasPrefix(line, ">") && allQuoted(lines[i:])) {
+			if reply := strings.TrimSpace(strings.Join(lines[:i], "\n")); reply != "" {
+				return reply
+			}
+			break
+		}
+	}
+	return strings.TrimSpace(body)
+}
+
+// allQuoted reports whether every non-empty line is quoted
+func allQuoted(lines []string) bool {
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, ">") {
+			return false
+		}
+	}
+	return true
+}
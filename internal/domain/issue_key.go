@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// maxKeyPrefixLength is the longest derived key prefix, leaving room for a uniqueness suffix
+const maxKeyPrefixLength = 6
+
+// issueKeyPattern matches issue keys such as "ACME-42"
+var issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,9}-[1-9][0-9]{0,9}$`)
+
+// issueKeyStartPattern matches the beginning of an issue key as it is typed, e.g. "AC" or "ACME-4"
+var issueKeyStartPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,9}(-[0-9]{0,10})?$`)
+
+// FormatIssueKey builds the key of the number-th issue of a project, e.g. "ACME-42"
+func FormatIssueKey(prefix string, number int) string {
+	return fmt.Sprintf("%s-%d", prefix, number)
+}
+
+// NormalizeIssueKey trims and upper-cases s and reports whether it is a valid issue key
+func NormalizeIssueKey(s string) (string, bool) {
+	key := strings.ToUpper(strings.TrimSpace(s))
+	return key, issueKeyPattern.MatchString(key)
+}
+
+// NormalizeIssueKeyStart trims and upper-cases s and reports whether it can be
+// the beginning of an issue key
+func NormalizeIssueKeyStart(s string) (string, bool) {
+	start := strings.ToUpper(strings.TrimSpace(s))
+	return start, issueKeyStartPattern.MatchString(start)
+}
+
+// KeyPrefixFromName derives an issue key prefix from a project name: the initials
+// of a multi-word name ("Acme Web Shop" → "AWS") or the start of a single word
+// ("Acme" → "ACME"). Only ASCII letters and digits are kept.
+func KeyPrefixFromName(name string) string {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !isKeyRune(r)
+	})
+
+	var prefix string
+	switch {
+	case len(words) == 0:
+		prefix = "PRJ"
+	case len(words) == 1:
+		prefix = words[0]
+	default:
+		var initials strings.Builder
+		for _, word := range words {
+			initials.WriteByte(word[0])
+		}
+		prefix = initials.String()
+	}
+
+	if len(prefix) > maxKeyPrefixLength {
+		prefix = prefix[:maxKeyPrefixLength]
+	}
+	if prefix[0] < 'A' || prefix[0] > 'Z' {
+		prefix = "P" + prefix[:min(len(prefix), maxKeyPrefixLength-1)]
+	}
+	return prefix
+}
+
+// KeyPrefixCandidates returns the prefixes to try, in order, when a project needs
+// a prefix no other project uses: the derived prefix, then the prefix followed by 2, 3, ...
+func KeyPrefixCandidates(name string, n int) []string {
+	base := KeyPrefixFromName(name)
+	candidates := []string{base}
+	for i := 2; len(candidates) < n; i++ {
+		candidates = append(candidates, fmt.Sprintf("%s%d", base, i))
+	}
+	return candidates
+}
+
+// uuidTemplate shows where a canonical UUID string has dashes
+const uuidTemplate = "00000000-0000-0000-0000-000000000000"
+
+// IDPrefixRange returns the smallest and largest UUIDs starting with prefix, e.g.
+// "3f2a" → 3f2a0000-0000-… and 3f2affff-ffff-…, so a prefix search can use the
+// primary key index. ok is false if prefix cannot start a canonical UUID string.
+func IDPrefixRange(prefix string) (lower, upper uuid.UUID, ok bool) {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" || len(prefix) > len(uuidTemplate) {
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	for i, r := range prefix {
+		if uuidTemplate[i] == '-' {
+			if r != '-' {
+				return uuid.Nil, uuid.Nil, false
+			}
+		} else if !strings.ContainsRune("0123456789abcdef", r) {
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+
+	lower = uuid.MustParse(prefix + uuidTemplate[len(prefix):])
+	upper = uuid.MustParse(prefix + strings.ReplaceAll(uuidTemplate, "0", "f")[len(prefix):])
+	return lower, upper, true
+}
+
+// isKeyRune reports whether r may appear in a key prefix
+func isKeyRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsUpper(r) || unicode.IsDigit(r))
+}
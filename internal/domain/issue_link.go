@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkType is the relation an issue link expresses from its source to its target issue
+type LinkType string
+
+const (
+	LinkTypeDuplicateOf LinkType = "duplicate_of" // The source reports the same problem as the target
+	LinkTypeBlocks      LinkType = "blocks"       // The target cannot be finished before the source
+	LinkTypeRelatesTo   LinkType = "relates_to"   // The issues are related; the direction does not matter
+)
+
+// IssueLink relates two issues
+type IssueLink struct {
+	ID            uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	SourceIssueID uuid.UUID `json:"source_issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_link"`
+	TargetIssueID uuid.UUID `json:"target_issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_link;index"`
+	Type          LinkType  `json:"type" gorm:"size:20;not null;uniqueIndex:idx_issue_link"`
+	CreatedBy     string    `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the user who linked the issues
+	CreatedAt     time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	SourceIssue *Issue `json:"source_issue,omitempty" gorm:"foreignKey:SourceIssueID"`
+	TargetIssue *Issue `json:"target_issue,omitempty" gorm:"foreignKey:TargetIssueID"`
+}
+
+// TableName specifies the table name for IssueLink
+func (IssueLink) TableName() string {
+	return "issue_links"
+}
+
+// IsValidLinkType checks if the link type is valid
+func IsValidLinkType(t LinkType) bool {
+	return t == LinkTypeDuplicateOf || t == LinkTypeBlocks || t == LinkTypeRelatesTo
+}
+
+// GetLinkTypeDisplayName describes a link type as read from the source issue, or
+// from the target issue when inverse is true, e.g. "blocks" and "blocked by"
+func GetLinkTypeDisplayName(t LinkType, inverse bool) string {
+	switch t {
+	case LinkTypeDuplicateOf:
+		if inverse {
+			return "duplicated by"
+		}
+		return "duplicate of"
+	case LinkTypeBlocks:
+		if inverse {
+			return "blocked by"
+		}
+		return "blocks"
+	case LinkTypeRelatesTo:
+		return "relates to"
+	default:
+		return string(t)
+	}
+}
@@ -14,6 +14,7 @@ type IssueStatusLog struct {
 	NewStatus Status     `json:"new_status" gorm:"size:40;not null"`
 	ChangedBy *uuid.UUID `json:"changed_by,omitempty" gorm:"type:uuid"`
 	ChangedAt time.Time  `json:"changed_at" gorm:"type:timestamptz;default:now()"`
+	Note      string     `json:"note,omitempty" gorm:"type:text"` // Describes edit entries, which keep the status unchanged
 
 	// Relationships
 	Issue         Issue `json:"issue,omitempty" gorm:"foreignKey:IssueID"`
@@ -37,17 +38,35 @@ func NewIssueStatusLog(issueID uuid.UUID, oldStatus *Status, newStatus Status, c
 	}
 }
 
+// NewIssueEditLog creates a log entry recording a content edit. The status is unchanged.
+func NewIssueEditLog(issueID uuid.UUID, status Status, changedBy *uuid.UUID, note string) *IssueStatusLog {
+	log := NewIssueStatusLog(issueID, &status, status, changedBy)
+	log.Note = note
+	return log
+}
+
+// IsEdit checks if the entry records a content edit rather than a status change
+func (l *IssueStatusLog) IsEdit() bool {
+	return l.OldStatus != nil && *l.OldStatus == l.NewStatus
+}
+
 // IsStatusTransitionValid checks if a status transition is valid according to workflow
 func IsStatusTransitionValid(from *Status, to Status) bool {
-	// If no previous status (new issue), only allow "open"
+	// If no previous status (new issue), allow "draft" (Discord) or "open" (web)
 	if from == nil {
-		return to == StatusOpen
+		return to == StatusDraft || to == StatusOpen
 	}
 
-	// Define valid transitions based on workflow
+	// Define valid transitions based on workflow. This must stay a superset of
+	// GetNextPossibleStatuses, which drives the buttons on the issue card.
 	validTransitions := map[Status][]Status{
+		StatusDraft: {
+			StatusOpen,   // Confirm the draft
+			StatusClosed, // Discard the draft
+		},
 		StatusOpen: {
-			StatusClosed, // Close without assignment
+			StatusInProgress, // Developer starts working
+			StatusClosed,     // Close without assignment
 		},
 		StatusInProgress: {
 			StatusResolved, // Developer starts working
@@ -64,7 +83,6 @@ func IsStatusTransitionValid(from *Status, to Status) bool {
 			StatusResolved, // Unassign QA
 		},
 		StatusRejected: {
-			StatusInProgress, // Reassign to developer
 			StatusInProgress, // Developer continues
 			StatusOpen,       // Back to open
 		},
@@ -74,6 +92,7 @@ func IsStatusTransitionValid(from *Status, to Status) bool {
 		StatusReopened: {
 			StatusOpen,       // Back to open status
 			StatusInProgress, // Direct assign to dev
+			StatusClosed,     // Close again
 		},
 	}
 
@@ -94,6 +113,8 @@ func IsStatusTransitionValid(from *Status, to Status) bool {
 // GetStatusDisplayName returns a human-readable display name for the status
 func GetStatusDisplayName(status Status) string {
 	switch status {
+	case StatusDraft:
+		return "Draft"
 	case StatusOpen:
 		return "Open"
 	case StatusInProgress:
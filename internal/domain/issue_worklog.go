@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MinWorklogDuration and MaxWorklogDuration bound the time one worklog entry records.
+	// Timers left running past MaxWorklogDuration record MaxWorklogDuration.
+	MinWorklogDuration = time.Minute
+	MaxWorklogDuration = 24 * time.Hour
+	// maxWorklogNoteLength keeps notes within a Discord embed field
+	maxWorklogNoteLength = 200
+)
+
+// IssueWorklog is time a user spent on an issue, either logged directly or measured by a
+// timer. A running timer has no end yet; each user runs at most one timer at a time.
+type IssueWorklog struct {
+	ID        uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID   uuid.UUID  `json:"issue_id" gorm:"type:uuid;not null;index"`
+	UserID    uuid.UUID  `json:"user_id" gorm:"type:uuid;not null;index;uniqueIndex:idx_issue_worklogs_running,where:ended_at IS NULL"`
+	StartedAt time.Time  `json:"started_at" gorm:"type:timestamptz;not null"`
+	EndedAt   *time.Time `json:"ended_at,omitempty" gorm:"type:timestamptz;index"` // Nil while the timer runs
+	Seconds   int64      `json:"seconds" gorm:"not null;default:0"`                // Time spent, set once the entry ends
+	Note      string     `json:"note,omitempty" gorm:"size:255"`
+	CreatedAt time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Issue *Issue `json:"issue,omitempty" gorm:"foreignKey:IssueID"`
+	User  User   `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for IssueWorklog
+func (IssueWorklog) TableName() string {
+	return "issue_worklogs"
+}
+
+// IsRunning checks if the worklog is a timer that has not been stopped
+func (w *IssueWorklog) IsRunning() bool {
+	return w.EndedAt == nil
+}
+
+// Duration returns the time recorded by a finished worklog
+func (w *IssueWorklog) Duration() time.Duration {
+	return time.Duration(w.Seconds) * time.Second
+}
+
+// Stop ends a running timer at now, capping the recorded time at MaxWorklogDuration
+func (w *IssueWorklog) Stop(now time.Time) {
+	elapsed := now.Sub(w.StartedAt)
+	if elapsed > MaxWorklogDuration {
+		elapsed = MaxWorklogDuration
+	}
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	w.EndedAt = &now
+	w.Seconds = int64(elapsed / time.Second)
+}
+
+// IsValidWorklogDuration checks that logged time is between MinWorklogDuration and MaxWorklogDuration
+func IsValidWorklogDuration(d time.Duration) bool {
+	return d >= MinWorklogDuration && d <= MaxWorklogDuration
+}
+
+// NormalizeWorklogNote trims a worklog note
+func NormalizeWorklogNote(note string) string {
+	return strings.Join(strings.Fields(note), " ")
+}
+
+// IsValidWorklogNote checks that a normalized note is not too long
+func IsValidWorklogNote(note string) bool {
+	return len([]rune(note)) <= maxWorklogNoteLength
+}
+
+// TimeSpent sums the time of the issue's finished worklogs. Worklogs must be preloaded.
+func (i *Issue) TimeSpent() time.Duration {
+	var total time.Duration
+	for _, w := range i.Worklogs {
+		if !w.IsRunning() {
+			total += w.Duration()
+		}
+	}
+	return total
+}
+
+// RunningTimers counts the timers currently running on the issue. Worklogs must be preloaded.
+func (i *Issue) RunningTimers() int {
+	running := 0
+	for _, w := range i.Worklogs {
+		if w.IsRunning() {
+			running++
+		}
+	}
+	return running
+}
+
+// TimeSpentByUser sums the time of the issue's finished worklogs per user, in the order
+// users first logged time. Worklogs and their users must be preloaded.
+func (i *Issue) TimeSpentByUser() []UserTimeSpent {
+	var spent []UserTimeSpent
+	index := make(map[uuid.UUID]int)
+	for _, w := range i.Worklogs {
+		if w.IsRunning() {
+			continue
+		}
+		n, ok := index[w.UserID]
+		if !ok {
+			n = len(spent)
+			index[w.UserID] = n
+			spent = append(spent, UserTimeSpent{UserID: w.UserID, Name: w.User.Name, DiscordID: w.User.DiscordID})
+		}
+		spent[n].Seconds += w.Seconds
+	}
+	return spent
+}
+
+// UserTimeSpent is the time one user logged
+type UserTimeSpent struct {
+	UserID    uuid.UUID
+	Name      string
+	DiscordID string
+	Seconds   int64
+}
+
+// Duration returns the time the user logged
+func (u UserTimeSpent) Duration() time.Duration {
+	return time.Duration(u.Seconds) * time.Second
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLabelColor is used when a label is created without a color
+const DefaultLabelColor = "#95a5a6"
+
+// maxLabelNameLength limits label names so they fit on the issue card
+const maxLabelNameLength = 50
+
+// labelColorPattern matches hex colors such as "#e74c3c"
+var labelColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Label represents a project-scoped tag that can be attached to issues
+type Label struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_label_project_name"`
+	Name      string    `json:"name" gorm:"not null;size:50;uniqueIndex:idx_label_project_name"`
+	Color     string    `json:"color" gorm:"size:7;not null"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Project Project `json:"-" gorm:"foreignKey:ProjectID"`
+}
+
+// TableName specifies the table name for Label
+func (Label) TableName() string {
+	return "labels"
+}
+
+// IssueLabel links a label to an issue
+type IssueLabel struct {
+	IssueID   uuid.UUID `json:"issue_id" gorm:"type:uuid;primaryKey"`
+	LabelID   uuid.UUID `json:"label_id" gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for IssueLabel
+func (IssueLabel) TableName() string {
+	return "issue_labels"
+}
+
+// NormalizeLabelName trims and lowercases a label name so lookups are case-insensitive
+func NormalizeLabelName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// IsValidLabelName checks that a normalized label name is non-empty and short enough
+func IsValidLabelName(name string) bool {
+	return name != "" && len([]rune(name)) <= maxLabelNameLength
+}
+
+// IsValidLabelColor checks that a color is a hex value such as "#e74c3c"
+func IsValidLabelColor(color string) bool {
+	return labelColorPattern.MatchString(color)
+}
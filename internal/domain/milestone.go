@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxMilestones limits how many milestones a project can have
+	MaxMilestones = 25
+	// maxMilestoneNameLength keeps names within a Discord command option
+	maxMilestoneNameLength = 100
+	// milestoneDateLayout is the format of milestone target dates
+	milestoneDateLayout = "2006-01-02"
+)
+
+// Milestone groups the issues of a project planned for a release or deadline
+type Milestone struct {
+	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID  uuid.UUID  `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_milestone_project_name"`
+	Name       string     `json:"name" gorm:"size:100;not null;uniqueIndex:idx_milestone_project_name"`
+	TargetDate *time.Time `json:"target_date,omitempty" gorm:"type:date"` // Day the milestone should be done (optional)
+	CreatedBy  string     `json:"created_by,omitempty" gorm:"size:100"`   // Discord ID of the member who created it
+	CreatedAt  time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for Milestone
+func (Milestone) TableName() string {
+	return "milestones"
+}
+
+// IsPastTarget checks if the milestone's target date has passed
+func (m *Milestone) IsPastTarget(now time.Time) bool {
+	if m.TargetDate == nil {
+		return false
+	}
+	return !now.Before(m.TargetDate.AddDate(0, 0, 1))
+}
+
+// FormatTargetDate renders the target date such as "Mar 14, 2025", or an empty string without one
+func (m *Milestone) FormatTargetDate() string {
+	if m.TargetDate == nil {
+		return ""
+	}
+	return m.TargetDate.Format("Jan 2, 2006")
+}
+
+// MilestoneProgress tells how many of a milestone's issues are closed
+type MilestoneProgress struct {
+	Milestone *Milestone
+	Total     int
+	Closed    int
+}
+
+// NewMilestoneProgress computes the progress of a milestone from its issues
+func NewMilestoneProgress(milestone *Milestone, issues []*Issue) *MilestoneProgress {
+	progress := &MilestoneProgress{Milestone: milestone, Total: len(issues)}
+	for _, issue := range issues {
+		if issue.IsClosed() {
+			progress.Closed++
+		}
+	}
+	return progress
+}
+
+// Percent returns the share of closed issues from 0 to 100. Milestones without issues are at 0.
+func (p *MilestoneProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Closed * 100 / p.Total
+}
+
+// NormalizeMilestoneName trims a milestone name; names are compared case-insensitively
+func NormalizeMilestoneName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// IsValidMilestoneName checks that a normalized milestone name is non-empty and not too long
+func IsValidMilestoneName(name string) bool {
+	return name != "" && len([]rune(name)) <= maxMilestoneNameLength
+}
+
+// ParseMilestoneDate parses a target date such as "2025-03-14". An empty value returns nil.
+func ParseMilestoneDate(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(milestoneDateLayout, value)
+	if err != nil {
+		return nil, ErrInvalidMilestoneDate
+	}
+	return &date, nil
+}
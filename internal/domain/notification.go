@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationKind identifies why a user is notified about an issue
+type NotificationKind string
+
+const (
+	NotificationAssigned      NotificationKind = "assigned"     // Sent to the new assignee
+	NotificationIssueResolved NotificationKind = "resolved"     // Sent to the reporter
+	NotificationIssueClosed   NotificationKind = "closed"       // Sent to the reporter
+	NotificationIssueRejected NotificationKind = "rejected"     // Sent to the developers when QA rejects the fix
+	NotificationMentioned     NotificationKind = "mentioned"    // Sent to users mentioned in the issue thread
+	NotificationSLABreached   NotificationKind = "sla_breached" // Sent to the assignees when an SLA target passes
+
+	NotificationWatchedStatusChanged NotificationKind = "watched_status"  // Sent to the watchers when the status changes
+	NotificationWatchedCommented     NotificationKind = "watched_comment" // Sent to the watchers on a new thread comment
+)
+
+// Notification is a direct message about an issue
+type Notification struct {
+	Kind       NotificationKind
+	Issue      *Issue
+	Role       AssigneeRole // NotificationAssigned
+	ActorID    string       // Discord ID of the user who made the change, if known
+	Content    string       // NotificationMentioned and NotificationWatchedCommented: the message
+	AuthorName string       // NotificationWatchedCommented: who wrote the message
+	OldStatus  Status       // NotificationWatchedStatusChanged
+	Alert      *SLAAlert    // NotificationSLABreached
+}
+
+// NotificationEvent is a group of notifications users can turn on or off
+type NotificationEvent string
+
+const (
+	NotificationEventAssigned     NotificationEvent = "assigned"
+	NotificationEventStatusChange NotificationEvent = "status_change"
+	NotificationEventMention      NotificationEvent = "mention"
+	NotificationEventSLABreach    NotificationEvent = "sla_breach"
+	NotificationEventWatching     NotificationEvent = "watching"
+)
+
+// NotificationEvents lists every notification event in display order
+var NotificationEvents = []NotificationEvent{
+	NotificationEventAssigned,
+	NotificationEventStatusChange,
+	NotificationEventMention,
+	NotificationEventSLABreach,
+	NotificationEventWatching,
+}
+
+// IsValid checks if the notification event is known
+func (e NotificationEvent) IsValid() bool {
+	switch e {
+	case NotificationEventAssigned, NotificationEventStatusChange, NotificationEventMention, NotificationEventSLABreach, NotificationEventWatching:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetDisplayName returns a human-readable description of the notification event
+func (e NotificationEvent) GetDisplayName() string {
+	switch e {
+	case NotificationEventAssigned:
+		return "Assigned to an issue"
+	case NotificationEventStatusChange:
+		return "Your issue resolved or closed, or your fix rejected"
+	case NotificationEventMention:
+		return "Mentioned in an issue thread"
+	case NotificationEventSLABreach:
+		return "SLA breached on an issue assigned to you"
+	case NotificationEventWatching:
+		return "Status changes and comments on issues you watch"
+	default:
+		return string(e)
+	}
+}
+
+// DefaultEnabled reports whether users who have not chosen get the notifications.
+// Discord already notifies mentions, so mention DMs are opt-in.
+func (e NotificationEvent) DefaultEnabled() bool {
+	return e != NotificationEventMention
+}
+
+// Event returns the notification event controlling a notification kind
+func (k NotificationKind) Event() NotificationEvent {
+	switch k {
+	case NotificationAssigned:
+		return NotificationEventAssigned
+	case NotificationMentioned:
+		return NotificationEventMention
+	case NotificationSLABreached:
+		return NotificationEventSLABreach
+	case NotificationWatchedStatusChanged, NotificationWatchedCommented:
+		return NotificationEventWatching
+	default:
+		return NotificationEventStatusChange
+	}
+}
+
+// UserNotificationPreference records whether a user wants the notifications of one event
+type UserNotificationPreference struct {
+	ID        uuid.UUID         `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID    uuid.UUID         `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_user_notification_event"`
+	Event     NotificationEvent `json:"event" gorm:"size:30;not null;uniqueIndex:idx_user_notification_event"`
+	Enabled   bool              `json:"enabled" gorm:"not null"`
+	UpdatedAt time.Time         `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for UserNotificationPreference
+func (UserNotificationPreference) TableName() string {
+	return "user_notification_preferences"
+}
+
+// NotificationPreferences are a user's choices per notification event
+type NotificationPreferences map[NotificationEvent]bool
+
+// NewNotificationPreferences applies stored choices over the defaults
+func NewNotificationPreferences(stored []*UserNotificationPreference) NotificationPreferences {
+	prefs := make(NotificationPreferences, len(NotificationEvents))
+	for _, event := range NotificationEvents {
+		prefs[event] = event.DefaultEnabled()
+	}
+	for _, pref := range stored {
+		prefs[pref.Event] = pref.Enabled
+	}
+	return prefs
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxOnCallMembers limits how many members a project's on-call rotation can have
+const MaxOnCallMembers = 25
+
+// OnCallSchedule is a project's on-call rotation. Members take turns in order, each for
+// one rotation period, and new high-priority issues are assigned to the member on call.
+type OnCallSchedule struct {
+	ID              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID       uuid.UUID  `json:"project_id" gorm:"type:uuid;not null;uniqueIndex"`
+	CurrentPosition int        `json:"current_position" gorm:"not null;default:0"`         // Index of the member on call in Members
+	NextRotationAt  *time.Time `json:"next_rotation_at,omitempty" gorm:"type:timestamptz"` // When the next member takes over; nil rotates only on handoff
+	CreatedBy       string     `json:"created_by,omitempty" gorm:"size:100"`               // Discord ID of the member who added the first member
+	CreatedAt       time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt       time.Time  `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Project *Project       `json:"project,omitempty" gorm:"foreignKey:ProjectID"`
+	Members []OnCallMember `json:"members,omitempty" gorm:"foreignKey:ScheduleID"` // In rotation order
+}
+
+// TableName specifies the table name for OnCallSchedule
+func (OnCallSchedule) TableName() string {
+	return "oncall_schedules"
+}
+
+// OnCallMember is a user taking part in an on-call rotation
+type OnCallMember struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ScheduleID uuid.UUID `json:"schedule_id" gorm:"type:uuid;not null;uniqueIndex:idx_oncall_member"`
+	UserID     uuid.UUID `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_oncall_member"`
+	Position   int       `json:"position" gorm:"not null"` // Order in the rotation
+	CreatedAt  time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	User User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for OnCallMember
+func (OnCallMember) TableName() string {
+	return "oncall_members"
+}
+
+// Current returns the member on call, or nil if the rotation has no members
+func (s *OnCallSchedule) Current() *OnCallMember {
+	if s == nil || len(s.Members) == 0 {
+		return nil
+	}
+	return &s.Members[s.CurrentPosition%len(s.Members)]
+}
+
+// Member returns the member with the given Discord ID, or nil if they are not in the rotation
+func (s *OnCallSchedule) Member(discordID string) *OnCallMember {
+	if s == nil {
+		return nil
+	}
+	for i := range s.Members {
+		if s.Members[i].User.DiscordID == discordID {
+			return &s.Members[i]
+		}
+	}
+	return nil
+}
+
+// Rotation returns the members in the order they go on call, starting with the current one
+func (s *OnCallSchedule) Rotation() []*OnCallMember {
+	if s == nil || len(s.Members) == 0 {
+		return nil
+	}
+	rotation := make([]*OnCallMember, 0, len(s.Members))
+	for i := range s.Members {
+		rotation = append(rotation, &s.Members[(s.CurrentPosition+i)%len(s.Members)])
+	}
+	return rotation
+}
+
+// IsDue checks if it is time for the next member to take over
+func (s *OnCallSchedule) IsDue(now time.Time) bool {
+	return len(s.Members) > 1 && s.NextRotationAt != nil && !now.Before(*s.NextRotationAt)
+}
+
+// Advance hands the rotation to the next member, who stays on call for period.
+// A zero period keeps them on call until the next handoff.
+func (s *OnCallSchedule) Advance(now time.Time, period time.Duration) {
+	if len(s.Members) > 0 {
+		s.CurrentPosition = (s.CurrentPosition + 1) % len(s.Members)
+	}
+	s.StartTurn(now, period)
+}
+
+// StartTurn restarts the current member's turn at now
+func (s *OnCallSchedule) StartTurn(now time.Time, period time.Duration) {
+	s.NextRotationAt = nil
+	if period > 0 {
+		next := now.Add(period)
+		s.NextRotationAt = &next
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxActionType is a Discord side effect of an issue change
+type OutboxActionType string
+
+// Discord side effects recorded in the outbox
+const (
+	OutboxPostCard   OutboxActionType = "post_card"   // Post the card of a new issue
+	OutboxUpdateCard OutboxActionType = "update_card" // Bring the issue card up to date
+	OutboxSyncThread OutboxActionType = "sync_thread" // Rename, archive or reopen the issue's thread to match the issue
+)
+
+// OutboxAction is a Discord side effect of an issue change that has not been done yet. It
+// is recorded with the change and removed once done, so a crash or a Discord outage in
+// between leaves it for the outbox worker to retry. Actions are done from the issue's
+// current state, so an issue has at most one pending action of each type.
+type OutboxAction struct {
+	ID        uuid.UUID        `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID   uuid.UUID        `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_discord_outbox_issue_type"`
+	Type      OutboxActionType `json:"type" gorm:"size:20;not null;uniqueIndex:idx_discord_outbox_issue_type"`
+	Attempts  int              `json:"attempts" gorm:"not null;default:0"`            // Failed attempts of the worker
+	LastError string           `json:"last_error" gorm:"size:500"`                    // Why the last attempt failed
+	DueAt     time.Time        `json:"due_at" gorm:"type:timestamptz;not null;index"` // When the worker does the action
+	CreatedAt time.Time        `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt time.Time        `json:"updated_at" gorm:"type:timestamptz;default:now()"` // When the action was last recorded
+}
+
+// TableName specifies the table name for OutboxAction
+func (OutboxAction) TableName() string {
+	return "discord_outbox"
+}
@@ -0,0 +1,179 @@
+package domain
+
+// Permission represents an action that requires a minimum user role
+type Permission string
+
+const (
+	PermissionCloseIssue       Permission = "close_issue"
+	PermissionReopenIssue      Permission = "reopen_issue"
+	PermissionResolveIssue     Permission = "resolve_issue"
+	PermissionSetPriority      Permission = "set_priority"
+	PermissionSetSeverity      Permission = "set_severity"
+	PermissionEditIssue        Permission = "edit_issue"
+	PermissionDeleteIssue      Permission = "delete_issue"
+	PermissionMoveIssue        Permission = "move_issue"
+	PermissionManageWebhooks   Permission = "manage_webhooks"
+	PermissionManageWorkflow   Permission = "manage_workflow"
+	PermissionManageSettings   Permission = "manage_settings"
+	PermissionManageOnCall     Permission = "manage_oncall"
+	PermissionExportIssues     Permission = "export_issues"
+	PermissionViewAuditLog     Permission = "view_audit_log"
+	PermissionManageChannels   Permission = "manage_channels"
+	PermissionManageFields     Permission = "manage_custom_fields"
+	PermissionManageRecurring  Permission = "manage_recurring"
+	PermissionManageStale      Permission = "manage_stale"
+	PermissionTrackTime        Permission = "track_time"
+	PermissionSetDueDate       Permission = "set_due_date"
+	PermissionManageMilestone  Permission = "manage_milestones"
+	PermissionPostBoard        Permission = "post_board"
+	PermissionManageEscalation Permission = "manage_escalation"
+	PermissionManageAPIKeys    Permission = "manage_api_keys"
+	PermissionBulkEdit         Permission = "bulk_edit"
+	PermissionResyncChannel    Permission = "resync_channel"
+	PermissionManageCustomers  Permission = "manage_customers"
+	PermissionManageTeam       Permission = "manage_team"
+	PermissionManageComponents Permission = "manage_components"
+	PermissionManageIncidents  Permission = "manage_incidents"
+	PermissionReleaseNotes     Permission = "release_notes"
+)
+
+// permissionMinRoles maps each permission to the lowest role allowed to use it
+var permissionMinRoles = map[Permission]UserRole{
+	PermissionCloseIssue:       UserRoleSupport,
+	PermissionReopenIssue:      UserRoleSupport,
+	PermissionResolveIssue:     UserRoleSupport,
+	PermissionSetPriority:      UserRoleSupport,
+	PermissionSetSeverity:      UserRoleSupport,
+	PermissionEditIssue:        UserRoleSupport,
+	PermissionDeleteIssue:      UserRoleAdmin,
+	PermissionMoveIssue:        UserRoleSupport,
+	PermissionManageWebhooks:   UserRoleAdmin,
+	PermissionManageWorkflow:   UserRoleAdmin,
+	PermissionManageSettings:   UserRoleAdmin,
+	PermissionManageOnCall:     UserRoleSupport,
+	PermissionExportIssues:     UserRoleAdmin,
+	PermissionViewAuditLog:     UserRoleAdmin,
+	PermissionManageChannels:   UserRoleAdmin,
+	PermissionManageFields:     UserRoleAdmin,
+	PermissionManageRecurring:  UserRoleAdmin,
+	PermissionManageStale:      UserRoleAdmin,
+	PermissionTrackTime:        UserRoleSupport,
+	PermissionSetDueDate:       UserRoleSupport,
+	PermissionManageMilestone:  UserRoleSupport,
+	PermissionPostBoard:        UserRoleSupport,
+	PermissionManageEscalation: UserRoleAdmin,
+	PermissionManageAPIKeys:    UserRoleAdmin,
+	PermissionBulkEdit:         UserRoleSupport,
+	PermissionResyncChannel:    UserRoleAdmin,
+	PermissionManageCustomers:  UserRoleAdmin,
+	PermissionManageTeam:       UserRoleSupport,
+	PermissionManageComponents: UserRoleSupport,
+	PermissionManageIncidents:  UserRoleSupport,
+	PermissionReleaseNotes:     UserRoleSupport,
+}
+
+// Actor identifies a Discord member performing an action
+type Actor struct {
+	DiscordID    string
+	GuildID      string   // Server the action happens in, for its admin roles
+	DiscordRoles []string // Role IDs and names held in the guild
+	GuildAdmin   bool     // Has the Administrator or Manage Server permission
+}
+
+// Rank orders roles from least to most privileged. Unknown roles rank lowest.
+func (r UserRole) Rank() int {
+	switch r {
+	case UserRoleAdmin:
+		return 3
+	case UserRoleSupport:
+		return 2
+	case UserRoleCustomer:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Can checks if the role grants the permission. Unknown permissions are denied.
+func (r UserRole) Can(p Permission) bool {
+	minRole, ok := permissionMinRoles[p]
+	if !ok {
+		return false
+	}
+	return r.Rank() >= minRole.Rank()
+}
+
+// GetDisplayName returns a human-readable name for the permission
+func (p Permission) GetDisplayName() string {
+	switch p {
+	case PermissionCloseIssue:
+		return "close issues"
+	case PermissionReopenIssue:
+		return "reopen issues"
+	case PermissionResolveIssue:
+		return "resolve issues without a resolution note"
+	case PermissionSetPriority:
+		return "change issue priority"
+	case PermissionSetSeverity:
+		return "change issue severity"
+	case PermissionEditIssue:
+		return "edit other people's issues"
+	case PermissionDeleteIssue:
+		return "delete issues"
+	case PermissionMoveIssue:
+		return "move issues to another channel"
+	case PermissionManageWebhooks:
+		return "manage webhooks"
+	case PermissionManageWorkflow:
+		return "configure the issue workflow"
+	case PermissionManageSettings:
+		return "change server settings"
+	case PermissionManageOnCall:
+		return "manage the on-call rotation"
+	case PermissionExportIssues:
+		return "export issues"
+	case PermissionViewAuditLog:
+		return "view the audit log"
+	case PermissionManageChannels:
+		return "manage channel registrations"
+	case PermissionManageFields:
+		return "manage custom fields"
+	case PermissionManageRecurring:
+		return "manage recurring issues"
+	case PermissionManageStale:
+		return "manage stale issue settings"
+	case PermissionTrackTime:
+		return "track time on issues"
+	case PermissionSetDueDate:
+		return "set due dates"
+	case PermissionManageMilestone:
+		return "manage milestones"
+	case PermissionPostBoard:
+		return "post issue boards"
+	case PermissionManageEscalation:
+		return "manage escalation rules"
+	case PermissionManageAPIKeys:
+		return "manage API keys"
+	case PermissionBulkEdit:
+		return "change issues in bulk"
+	case PermissionResyncChannel:
+		return "rebuild the issue messages of a channel"
+	case PermissionManageCustomers:
+		return "manage customers and projects"
+	case PermissionManageTeam:
+		return "manage the team and assign suggested members"
+	case PermissionManageComponents:
+		return "manage components and file issues under them"
+	case PermissionManageIncidents:
+		return "declare incidents and post their status updates"
+	case PermissionReleaseNotes:
+		return "post release notes"
+	default:
+		return string(p)
+	}
+}
+
+// RequiredRole returns the lowest role granting the permission
+func (p Permission) RequiredRole() UserRole {
+	return permissionMinRoles[p]
+}
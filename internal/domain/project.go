@@ -1,24 +1,46 @@
 package domain
 
 import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // Project represents a customer project
 type Project struct {
-	ID          uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	CustomerID  uuid.UUID `json:"customer_id" gorm:"type:uuid;not null"`
-	Name        string    `json:"name" gorm:"not null;size:255"`
-	Description string    `json:"description,omitempty" gorm:"type:text"`
-	CreatedAt   time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
-	UpdatedAt   time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	ID              uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CustomerID      uuid.UUID      `json:"customer_id" gorm:"type:uuid;not null"`
+	Name            string         `json:"name" gorm:"not null;size:255"`
+	Description     string         `json:"description,omitempty" gorm:"type:text"`
+	GitHubRepo      string         `json:"github_repo,omitempty" gorm:"column:github_repo;size:200"`  // Mirrored GitHub repository ("owner/name")
+	JiraProject     string         `json:"jira_project,omitempty" gorm:"column:jira_project;size:50"` // Mirrored Jira project key
+	SlackWebhookURL string         `json:"-" gorm:"column:slack_webhook_url;size:500"`                // Slack incoming webhook for notifications; a secret, so never serialized
+	CreatedAt       time.Time      `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt       time.Time      `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+	DeletedAt       gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamptz;index"`
+
+	// Issue keys: KeyPrefix is unique across projects and IssueCounter is the number of the last key handed out
+	KeyPrefix    string `json:"key_prefix,omitempty" gorm:"column:key_prefix;size:10;uniqueIndex:idx_projects_key_prefix,where:key_prefix <> ''"`
+	IssueCounter int    `json:"-" gorm:"column:issue_counter;not null;default:0"`
+
+	// Support address whose emails become issues of the project; unique across projects
+	InboundEmail string `json:"inbound_email,omitempty" gorm:"column:inbound_email;size:255;uniqueIndex:idx_projects_inbound_email,where:inbound_email <> ''"`
+
+	// Stale issue thresholds in days, overriding the configured ones when set; 0 turns nudges
+	// or closing off for the project
+	StaleAfterDays      *int `json:"stale_after_days,omitempty" gorm:"column:stale_after_days"`
+	StaleCloseAfterDays *int `json:"stale_close_after_days,omitempty" gorm:"column:stale_close_after_days"`
 
 	// Relationships
-	Customer Customer  `json:"customer,omitempty" gorm:"foreignKey:CustomerID"`
-	Channels []Channel `json:"channels,omitempty" gorm:"foreignKey:ProjectID"`
-	Issues   []Issue   `json:"issues,omitempty" gorm:"foreignKey:ProjectID"`
+	Customer Customer            `json:"customer,omitempty" gorm:"foreignKey:CustomerID"`
+	Channels []Channel           `json:"channels,omitempty" gorm:"foreignKey:ProjectID"`
+	Issues   []Issue             `json:"issues,omitempty" gorm:"foreignKey:ProjectID"`
+	Workflow *WorkflowDefinition `json:"workflow,omitempty" gorm:"foreignKey:ProjectID"` // Custom statuses and transitions, if any
 }
 
 // TableName specifies the table name for Project
@@ -26,6 +48,52 @@ func (Project) TableName() string {
 	return "projects"
 }
 
+// HasGitHubRepo checks if the project is mirrored to a GitHub repository
+func (p *Project) HasGitHubRepo() bool {
+	return p.GitHubRepo != ""
+}
+
+// HasJiraProject checks if the project is mirrored to a Jira project
+func (p *Project) HasJiraProject() bool {
+	return p.JiraProject != ""
+}
+
+// HasSlackWebhook checks if the project posts notifications to Slack
+func (p *Project) HasSlackWebhook() bool {
+	return p.SlackWebhookURL != ""
+}
+
+// IsValidSlackWebhookURL checks that a Slack incoming webhook URL is an https URL of at most 500 characters
+func IsValidSlackWebhookURL(rawURL string) bool {
+	if len(rawURL) > 500 {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
+
+// NormalizeInboundEmail trims and lower-cases a support address and reports whether it
+// is a bare email address of at most 255 characters
+func NormalizeInboundEmail(address string) (string, bool) {
+	address = strings.ToLower(strings.TrimSpace(address))
+	parsed, err := mail.ParseAddress(address)
+	return address, err == nil && parsed.Address == address && len(address) <= 255
+}
+
+// jiraProjectKeyPattern matches Jira project keys such as "OPS" or "WEB2"
+var jiraProjectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,49}$`)
+
+// IsValidJiraProjectKey checks that a Jira project key is upper case, starts with a letter and has 2-50 characters
+func IsValidJiraProjectKey(key string) bool {
+	return jiraProjectKeyPattern.MatchString(key)
+}
+
+// IsValidGitHubRepo checks that a repository reference has the "owner/name" form
+func IsValidGitHubRepo(repo string) bool {
+	parts := strings.Split(repo, "/")
+	return len(parts) == 2 && parts[0] != "" && parts[1] != "" && !strings.ContainsAny(repo, " \t")
+}
+
 // IsValidProject validates project data
 func IsValidProject(name string, customerID uuid.UUID) bool {
 	return name != "" && customerID != uuid.Nil
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxRecurringIssues limits how many recurring issues a project can have
+	MaxRecurringIssues = 25
+	// maxRecurringIssueTitleLength keeps titles within a Discord command option
+	maxRecurringIssueTitleLength = 100
+)
+
+// RecurringIssue is a template for an issue filed automatically on a cron schedule, such
+// as a weekly certificate check. Issues are filed into the project the channel was
+// registered for and their cards are posted in that channel.
+type RecurringIssue struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID   uuid.UUID  `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_recurring_issue_project_title"`
+	ChannelID   uuid.UUID  `json:"channel_id" gorm:"type:uuid;not null"` // Channel the issues are reported in (channels.id)
+	Schedule    string     `json:"schedule" gorm:"size:100;not null"`    // Five-field cron expression
+	Title       string     `json:"title" gorm:"size:255;not null;uniqueIndex:idx_recurring_issue_project_title"`
+	Description string     `json:"description" gorm:"type:text;not null"`
+	Priority    Priority   `json:"priority" gorm:"size:10;default:'medium'"`
+	NextRunAt   time.Time  `json:"next_run_at" gorm:"type:timestamptz;not null;index"` // When the next issue is filed
+	LastRunAt   *time.Time `json:"last_run_at,omitempty" gorm:"type:timestamptz"`
+	CreatedBy   string     `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the member who added it; reporter of the filed issues
+	CreatedAt   time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Channel *Channel `json:"channel,omitempty" gorm:"foreignKey:ChannelID"`
+}
+
+// TableName specifies the table name for RecurringIssue
+func (RecurringIssue) TableName() string {
+	return "recurring_issues"
+}
+
+// NormalizeRecurringIssueTitle trims a recurring issue title; titles are compared
+// case-insensitively
+func NormalizeRecurringIssueTitle(title string) string {
+	return strings.Join(strings.Fields(title), " ")
+}
+
+// IsValidRecurringIssueTitle checks that a normalized title is non-empty and not too long
+func IsValidRecurringIssueTitle(title string) bool {
+	return title != "" && len([]rune(title)) <= maxRecurringIssueTitleLength
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// releaseNotesDateLayout is the format of the dates bounding release notes
+const releaseNotesDateLayout = "2006-01-02"
+
+// ReleaseNotesScope picks the closed issues release notes are compiled from: those of a
+// milestone, those closed between two dates, or those of a milestone closed between them.
+// Dates look like "2025-03-14"; an empty from starts at the beginning and an empty to ends now.
+type ReleaseNotesScope struct {
+	Milestone string
+	From      string
+	To        string
+}
+
+// IsEmpty checks if the scope picks neither a milestone nor dates
+func (s ReleaseNotesScope) IsEmpty() bool {
+	return strings.TrimSpace(s.Milestone) == "" && strings.TrimSpace(s.From) == "" && strings.TrimSpace(s.To) == ""
+}
+
+// ParseRange parses the dates of the scope in loc into the start of from and the end of
+// to. Missing dates are returned as zero times.
+func (s ReleaseNotesScope) ParseRange(loc *time.Location) (from, to time.Time, err error) {
+	if value := strings.TrimSpace(s.From); value != "" {
+		if from, err = time.ParseInLocation(releaseNotesDateLayout, value, loc); err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidReleaseNotesRange
+		}
+	}
+	if value := strings.TrimSpace(s.To); value != "" {
+		day, err := time.ParseInLocation(releaseNotesDateLayout, value, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, ErrInvalidReleaseNotesRange
+		}
+		to = day.AddDate(0, 0, 1)
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return time.Time{}, time.Time{}, ErrInvalidReleaseNotesRange
+	}
+	return from, to, nil
+}
+
+// ReleaseNotes are the closed issues of a project shipped in a release, oldest closed first
+type ReleaseNotes struct {
+	Project   *Project
+	Milestone *Milestone // Milestone the notes are for; nil for a date range only
+	From      time.Time  // Start of the dates the issues were closed in; zero when unbounded
+	To        time.Time  // End of the dates the issues were closed in, exclusive; zero when unbounded
+	Location  *time.Location
+	Issues    []*Issue
+	File      *ExportFile // The notes as a Markdown file
+}
+
+// Title names the release: the milestone, the date range, or both, such as
+// "v2.1 (Mar 1, 2025 – Mar 14, 2025)"
+func (n *ReleaseNotes) Title() string {
+	dates := n.FormatRange()
+	switch {
+	case n.Milestone == nil:
+		return dates
+	case dates == "":
+		return n.Milestone.Name
+	default:
+		return n.Milestone.Name + " (" + dates + ")"
+	}
+}
+
+// FormatRange renders the dates the issues were closed in, such as "Mar 1, 2025 – Mar 14,
+// 2025", "Mar 1, 2025 –" or "– Mar 14, 2025", or an empty string without dates
+func (n *ReleaseNotes) FormatRange() string {
+	const layout = "Jan 2, 2006"
+	switch {
+	case n.From.IsZero() && n.To.IsZero():
+		return ""
+	case n.To.IsZero():
+		return n.From.In(n.Location).Format(layout) + " –"
+	case n.From.IsZero():
+		return "– " + n.To.AddDate(0, 0, -1).In(n.Location).Format(layout)
+	default:
+		return n.From.In(n.Location).Format(layout) + " – " + n.To.AddDate(0, 0, -1).In(n.Location).Format(layout)
+	}
+}
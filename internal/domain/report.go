@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReportScope limits report queries to a project and/or a registered channel, and
+// optionally to a component of the project. Nil fields are not filtered on.
+type ReportScope struct {
+	ProjectID   *uuid.UUID
+	ChannelID   *uuid.UUID
+	ComponentID *uuid.UUID
+}
+
+// PeriodSummary holds issue activity counts for a time window
+type PeriodSummary struct {
+	Opened         int64         // Issues created in the window
+	Closed         int64         // Issues closed in the window
+	Resolved       int64         // Issues moved to resolved in the window
+	AvgTimeToClose time.Duration // Mean creation-to-close time of issues closed in the window
+}
+
+// PriorityCount is the number of issues with one priority
+type PriorityCount struct {
+	Priority Priority
+	Count    int64
+}
+
+// StatusCount is the number of issues with one status
+type StatusCount struct {
+	Status Status
+	Count  int64
+}
+
+// DailyCount is the number of issues on one day
+type DailyCount struct {
+	Date  time.Time // Midnight UTC of the day
+	Count int64
+}
+
+// BusiestDay returns the day with the most issues, the earliest on ties. It returns
+// false when counts is empty.
+func BusiestDay(counts []DailyCount) (DailyCount, bool) {
+	if len(counts) == 0 {
+		return DailyCount{}, false
+	}
+	busiest := counts[0]
+	for _, dc := range counts[1:] {
+		if dc.Count > busiest.Count {
+			busiest = dc
+		}
+	}
+	return busiest, true
+}
+
+// AssigneeWorkload is the number of issues assigned to one user
+type AssigneeWorkload struct {
+	UserID       uuid.UUID
+	Name         string
+	DiscordID    string
+	OpenIssues   int64 // Assigned issues that are not closed
+	ClosedIssues int64 // Assigned issues that are closed
+}
+
+// CSATSummary is the customer satisfaction of the issues rated in a time window
+type CSATSummary struct {
+	Responses int64   // Issues rated in the window
+	Average   float64 // Mean rating from MinFeedbackRating to MaxFeedbackRating; 0 without responses
+}
+
+// StatsRanges are the supported /stats ranges in days
+var StatsRanges = []int{7, 30, 90}
+
+// DefaultStatsRangeDays is the range used when none is selected
+const DefaultStatsRangeDays = 30
+
+// IsValidStatsRange checks if days is one of the supported stats ranges
+func IsValidStatsRange(days int) bool {
+	for _, d := range StatsRanges {
+		if d == days {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectStats holds metrics for issues of one project created within a date range
+type ProjectStats struct {
+	Project            *Project
+	Component          *Component // Set when only the issues of a component are counted
+	Days               int
+	From               time.Time
+	To                 time.Time
+	Open               int64 // Issues in the range that are not closed
+	Closed             int64 // Issues in the range that are closed
+	Resolved           int64 // Issues in the range that reached resolved or closed
+	MeanResolutionTime time.Duration
+	ByPriority         []PriorityCount    // Highest priority first
+	CreatedPerDay      []DailyCount       // Issues created per day, oldest first; days without issues are left out
+	Workload           []AssigneeWorkload // Busiest assignee first
+	TimeSpent          time.Duration      // Time logged on the project's issues in the range
+	TimeByUser         []UserTimeSpent    // Time logged in the range per user, most first
+	CSAT               CSATSummary        // Ratings reporters gave in the range
+}
+
+// Digest is a periodic activity report for one registered channel
+type Digest struct {
+	Channel              *Channel
+	From                 time.Time
+	To                   time.Time
+	Summary              PeriodSummary
+	OpenedPerDay         []DailyCount    // Issues opened per day, oldest first; days without issues are left out
+	UnresolvedByPriority []PriorityCount // Unresolved issues per priority, highest first
+	StaleIssues          []*Issue        // Unresolved issues not updated since StaleBefore, oldest first
+	StaleBefore          time.Time
+	OverdueIssues        []*Issue    // Unresolved issues past their due date, longest overdue first
+	CSAT                 CSATSummary // Ratings reporters of the channel's main project gave in the window
+}
+
+// HasActivity checks if anything worth reporting happened or is pending
+func (d *Digest) HasActivity() bool {
+	if d.Summary.Opened > 0 || d.Summary.Closed > 0 || d.Summary.Resolved > 0 || len(d.StaleIssues) > 0 || len(d.OverdueIssues) > 0 || d.CSAT.Responses > 0 {
+		return true
+	}
+	for _, pc := range d.UnresolvedByPriority {
+		if pc.Count > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// UnresolvedStatuses returns the statuses of issues still awaiting a fix
+func UnresolvedStatuses() []Status {
+	return []Status{
+		StatusDraft,
+		StatusOpen,
+		StatusReopened,
+		StatusInProgress,
+		StatusRejected,
+	}
+}
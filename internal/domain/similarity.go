@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DuplicateSimilarityThreshold is the title similarity from which an open issue is
+// shown as a possible duplicate of a new one
+const DuplicateSimilarityThreshold = 0.5
+
+// SimilarIssue is an open issue whose title resembles the title of a new issue
+type SimilarIssue struct {
+	Issue *Issue
+	// Score is the title similarity between 0 (nothing in common) and 1 (same title)
+	Score float64
+}
+
+// NormalizeTitle lower-cases a title and reduces it to words separated by single spaces
+func NormalizeTitle(title string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+// TitleSimilarity compares two titles by the trigrams of their normalized forms
+// (Jaccard index), so reordered words and small typos still score high
+func TitleSimilarity(a, b string) float64 {
+	a, b = NormalizeTitle(a), NormalizeTitle(b)
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+
+	ta, tb := trigrams(a), trigrams(b)
+	shared := 0
+	for t := range ta {
+		if tb[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(ta)+len(tb)-shared)
+}
+
+// trigrams returns the set of three-rune sequences of each word, padded like
+// PostgreSQL's pg_trgm so short words still produce trigrams
+func trigrams(s string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			set[string(runes[i:i+3])] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SLAKind represents which service level target is being tracked
+type SLAKind string
+
+const (
+	SLAKindResponse   SLAKind = "response"   // Time until someone starts working on the issue
+	SLAKindResolution SLAKind = "resolution" // Time until the issue is resolved
+)
+
+// SLALevel represents how close an issue is to breaching an SLA
+type SLALevel string
+
+const (
+	SLALevelWarning  SLALevel = "warning"  // SLA is about to breach
+	SLALevelBreached SLALevel = "breached" // SLA target has passed
+)
+
+// SLAPolicy holds the response and resolution targets for one priority.
+// A zero duration disables tracking of that target.
+type SLAPolicy struct {
+	Response   time.Duration `json:"response"`
+	Resolution time.Duration `json:"resolution"`
+}
+
+// SLAAlert records that an SLA notification was sent, so each alert fires only once per issue
+type SLAAlert struct {
+	ID      uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID uuid.UUID `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_sla_alert_issue_kind_level"`
+	Kind    SLAKind   `json:"kind" gorm:"size:20;not null;uniqueIndex:idx_sla_alert_issue_kind_level"`
+	Level   SLALevel  `json:"level" gorm:"size:20;not null;uniqueIndex:idx_sla_alert_issue_kind_level"`
+	DueAt   time.Time `json:"due_at" gorm:"type:timestamptz;not null"`
+	SentAt  time.Time `json:"sent_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Issue Issue `json:"issue,omitempty" gorm:"foreignKey:IssueID"`
+}
+
+// TableName specifies the table name for SLAAlert
+func (SLAAlert) TableName() string {
+	return "sla_alerts"
+}
+
+// NewSLAAlert creates a new SLA alert record
+func NewSLAAlert(issueID uuid.UUID, kind SLAKind, level SLALevel, dueAt time.Time) *SLAAlert {
+	return &SLAAlert{
+		ID:      uuid.New(),
+		IssueID: issueID,
+		Kind:    kind,
+		Level:   level,
+		DueAt:   dueAt,
+		SentAt:  time.Now(),
+	}
+}
+
+// GetDisplayName returns a human-readable name for the SLA kind
+func (k SLAKind) GetDisplayName() string {
+	switch k {
+	case SLAKindResponse:
+		return "Response"
+	case SLAKindResolution:
+		return "Resolution"
+	default:
+		return string(k)
+	}
+}
+
+// IsAwaitingResponse checks if nobody has started working on the issue yet
+func IsAwaitingResponse(status Status) bool {
+	return status == StatusDraft || status == StatusOpen || status == StatusReopened
+}
+
+// IsAwaitingResolution checks if the issue still needs a fix
+func IsAwaitingResolution(status Status) bool {
+	return status != StatusResolved && status != StatusVerified && status != StatusClosed
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"time"
+)
+
+// MaxStaleDays limits the thresholds a project can choose for stale issues
+const MaxStaleDays = 365
+
+// StalePolicy holds when an unresolved issue without activity is nudged and when it is
+// closed. A zero After disables stale detection and a zero CloseAfter never closes.
+type StalePolicy struct {
+	After      time.Duration // Time without a status change or thread comment before the nudge
+	CloseAfter time.Duration // Time after the nudge, still without activity, before the issue is closed
+}
+
+// IsEnabled checks if issues are nudged under the policy
+func (p StalePolicy) IsEnabled() bool {
+	return p.After > 0
+}
+
+// StalePolicy returns the project's stale thresholds, falling back to the given default
+// for thresholds the project did not set
+func (p *Project) StalePolicy(fallback StalePolicy) StalePolicy {
+	if p == nil {
+		return fallback
+	}
+	policy := fallback
+	if p.StaleAfterDays != nil {
+		policy.After = days(*p.StaleAfterDays)
+	}
+	if p.StaleCloseAfterDays != nil {
+		policy.CloseAfter = days(*p.StaleCloseAfterDays)
+	}
+	return policy
+}
+
+// IsValidStaleDays checks that a stale threshold in days is between 0 and MaxStaleDays
+func IsValidStaleDays(n int) bool {
+	return n >= 0 && n <= MaxStaleDays
+}
+
+// StaleState is where an issue stands under a stale policy
+type StaleState int
+
+const (
+	StaleStateActive StaleState = iota // Had activity recently enough, or the policy is off
+	StaleStateNudge                    // Idle long enough to be nudged
+	StaleStateClose                    // Still idle long enough after its nudge to be closed
+	StaleStateNudged                   // Nudged and waiting for activity or the close threshold
+)
+
+// StaleState tells what the policy asks for an issue whose last status change or thread
+// comment was at lastActivity. Activity after a nudge starts the wait over.
+func (i *Issue) StaleState(policy StalePolicy, lastActivity, now time.Time) StaleState {
+	if !policy.IsEnabled() {
+		return StaleStateActive
+	}
+	if i.StaleNudgedAt == nil || lastActivity.After(*i.StaleNudgedAt) {
+		if now.Sub(lastActivity) >= policy.After {
+			return StaleStateNudge
+		}
+		return StaleStateActive
+	}
+	if policy.CloseAfter > 0 && now.Sub(*i.StaleNudgedAt) >= policy.CloseAfter {
+		return StaleStateClose
+	}
+	return StaleStateNudged
+}
+
+// days converts a number of days to a duration
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxTeamMemberSkills limits how many skills a team member profile lists
+const MaxTeamMemberSkills = 25
+
+// maxSkillLength limits one skill, as long as a label name
+const maxSkillLength = 50
+
+// MaxSuggestedAssignees limits how many team members are suggested for an issue
+const MaxSuggestedAssignees = 3
+
+// TeamMember is the routing profile of a member of a guild: the role they are assigned
+// with, the skills or components they cover and how many open issues they take on at
+// once. The assignees suggested for new issues are picked among a guild's team members.
+type TeamMember struct {
+	ID          uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	GuildID     string       `json:"guild_id" gorm:"size:100;not null;uniqueIndex:idx_team_member"`
+	UserID      uuid.UUID    `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_team_member"`
+	Name        string       `json:"name" gorm:"size:100;not null"`                     // Discord display name, shown on suggestion buttons
+	DefaultRole AssigneeRole `json:"default_role" gorm:"size:20;not null"`              // Role they are assigned with when routed an issue
+	Skills      []string     `json:"skills,omitempty" gorm:"type:text;serializer:json"` // Lower-case component and label names and custom field values they cover
+	Capacity    int          `json:"capacity" gorm:"not null;default:0"`                // Most open issues they are routed; 0 is unlimited
+	UpdatedBy   string       `json:"updated_by,omitempty" gorm:"size:100"`              // Discord ID of the member who last changed the profile
+	CreatedAt   time.Time    `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt   time.Time    `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+
+	// Open issues assigned to them in any server; filled in when members are listed
+	OpenIssues int `json:"open_issues" gorm:"-"`
+
+	// Relationships
+	User User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for TeamMember
+func (TeamMember) TableName() string {
+	return "team_members"
+}
+
+// TeamMemberUpdate changes a team member profile; nil fields keep their current value
+type TeamMemberUpdate struct {
+	Name        string // Refreshed on every change
+	DefaultRole *AssigneeRole
+	Skills      *string // Comma-separated; empty clears them
+	Capacity    *int
+}
+
+// ParseSkills splits a comma-separated list of skills, lower-cased and without duplicates
+func ParseSkills(input string) ([]string, error) {
+	var skills []string
+	for _, skill := range strings.Split(input, ",") {
+		skill = strings.ToLower(strings.TrimSpace(skill))
+		if skill == "" || slices.Contains(skills, skill) {
+			continue
+		}
+		if len(skill) > maxSkillLength {
+			return nil, WithDetail(ErrInvalidSkills, "%q is longer than %d characters", skill, maxSkillLength)
+		}
+		skills = append(skills, skill)
+	}
+	if len(skills) > MaxTeamMemberSkills {
+		return nil, WithDetail(ErrInvalidSkills, "at most %d skills", MaxTeamMemberSkills)
+	}
+	return skills, nil
+}
+
+// HasCapacity reports whether the member takes on another issue besides their open ones
+func (m *TeamMember) HasCapacity() bool {
+	return m.Capacity == 0 || m.OpenIssues < m.Capacity
+}
+
+// MatchedSkills returns the member's skills an issue calls for (see IssueSkills)
+func (m *TeamMember) MatchedSkills(issueSkills []string) []string {
+	var matched []string
+	for _, skill := range m.Skills {
+		if slices.Contains(issueSkills, skill) {
+			matched = append(matched, skill)
+		}
+	}
+	return matched
+}
+
+// IssueSkills returns what an issue calls for, matched against team member skills: the
+// lower-case names of its component, its labels and values of its custom fields
+func IssueSkills(issue *Issue) []string {
+	var skills []string
+	if issue.Component != nil {
+		skills = append(skills, strings.ToLower(issue.Component.Name))
+	}
+	for _, label := range issue.Labels {
+		skills = append(skills, strings.ToLower(label.Name))
+	}
+	for _, value := range issue.CustomFieldValues {
+		if value.Value != "" {
+			skills = append(skills, strings.ToLower(strings.TrimSpace(value.Value)))
+		}
+	}
+	return skills
+}
+
+// AssigneeCandidate is a team member weighed for an issue
+type AssigneeCandidate struct {
+	Member  *TeamMember
+	Matched []string // Skills the issue calls for
+	Lead    bool     // Leads the component of the issue
+}
+
+// RankCandidates orders candidates for an issue: the lead of its component first, then
+// those matching the most skills, then those with the fewest open issues, then by name
+func RankCandidates(candidates []AssigneeCandidate) {
+	slices.SortStableFunc(candidates, func(a, b AssigneeCandidate) int {
+		if a.Lead != b.Lead {
+			if a.Lead {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(len(b.Matched), len(a.Matched)); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.Member.OpenIssues, b.Member.OpenIssues); c != 0 {
+			return c
+		}
+		return strings.Compare(strings.ToLower(a.Member.Name), strings.ToLower(b.Member.Name))
+	})
+}
+
+// IssueSuggestedAssignee is a team member suggested to work on an issue. Suggestions are
+// shown as buttons on the issue card until the issue has a developer.
+type IssueSuggestedAssignee struct {
+	ID        uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID   uuid.UUID    `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_suggested_assignee"`
+	UserID    uuid.UUID    `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_suggested_assignee"`
+	Name      string       `json:"name" gorm:"size:100;not null"`                      // Team member name when suggested
+	Role      AssigneeRole `json:"role" gorm:"size:20;not null"`                       // Role they are assigned with from the suggestion
+	Position  int          `json:"position" gorm:"not null"`                           // Rank, best first
+	Matched   []string     `json:"matched,omitempty" gorm:"type:text;serializer:json"` // Skills the issue called for
+	CreatedAt time.Time    `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	User User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for IssueSuggestedAssignee
+func (IssueSuggestedAssignee) TableName() string {
+	return "issue_suggested_assignees"
+}
+
+// PendingSuggestions returns the suggested assignees still offered on an issue, leaving
+// out those already assigned: none for a draft, a resolved issue or one with a developer
+func (i *Issue) PendingSuggestions() []IssueSuggestedAssignee {
+	if i.Status == StatusDraft || !IsAwaitingResolution(i.Status) || len(i.GetDevelopers()) > 0 {
+		return nil
+	}
+
+	var pending []IssueSuggestedAssignee
+	for _, suggestion := range i.SuggestedAssignees {
+		assigned := slices.ContainsFunc(i.Assignees, func(a IssueAssignee) bool {
+			return a.UserID == suggestion.UserID
+		})
+		if !assigned {
+			pending = append(pending, suggestion)
+		}
+	}
+	return pending
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // UserRole represents the role of a user
@@ -17,14 +18,16 @@ const (
 
 // User represents a system user
 type User struct {
-	ID         uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	CustomerID *uuid.UUID `json:"customer_id,omitempty" gorm:"type:uuid"`
-	Name       string     `json:"name,omitempty" gorm:"size:255"`
-	Email      string     `json:"email,omitempty" gorm:"size:255"`
-	DiscordID  string     `json:"discord_id,omitempty" gorm:"size:100;uniqueIndex"`
-	Role       UserRole   `json:"role" gorm:"size:20;default:'customer'"`
-	IsInternal bool       `json:"is_internal" gorm:"default:false"`
-	CreatedAt  time.Time  `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	ID         uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CustomerID *uuid.UUID     `json:"customer_id,omitempty" gorm:"type:uuid"`
+	Name       string         `json:"name,omitempty" gorm:"size:255"`
+	Email      string         `json:"email,omitempty" gorm:"size:255"`
+	DiscordID  string         `json:"discord_id,omitempty" gorm:"size:100;uniqueIndex:idx_users_discord_id,where:discord_id <> ''"` // Empty for users who only reach us by email
+	Role       UserRole       `json:"role" gorm:"size:20;default:'customer'"`
+	IsInternal bool           `json:"is_internal" gorm:"default:false"`
+	DMOptOut   bool           `json:"dm_opt_out" gorm:"not null;default:false"` // Turned off direct message notifications
+	CreatedAt  time.Time      `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	DeletedAt  gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:timestamptz;index"`
 
 	// Relationships
 	Customer           *Customer `json:"customer,omitempty" gorm:"foreignKey:CustomerID"`
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IssueWatcher subscribes a user to the status changes and comments of an issue,
+// whether or not they are assigned to it
+type IssueWatcher struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	IssueID   uuid.UUID `json:"issue_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_watcher"`
+	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;not null;uniqueIndex:idx_issue_watcher;index"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	User User `json:"user,omitempty" gorm:"foreignKey:UserID"`
+}
+
+// TableName specifies the table name for IssueWatcher
+func (IssueWatcher) TableName() string {
+	return "issue_watchers"
+}
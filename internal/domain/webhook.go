@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEvent identifies the kind of issue event delivered to outbound webhooks
+type WebhookEvent string
+
+const (
+	WebhookEventIssueCreated       WebhookEvent = "issue.created"
+	WebhookEventIssueStatusChanged WebhookEvent = "issue.status_changed"
+	WebhookEventIssueAssigned      WebhookEvent = "issue.assigned"
+)
+
+// maxWebhookURLLength limits webhook URLs to the size of their column
+const maxWebhookURLLength = 500
+
+// ProjectWebhook is an external URL that receives a project's issue events
+type ProjectWebhook struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex:idx_project_webhook_url"`
+	URL       string    `json:"url" gorm:"not null;size:500;uniqueIndex:idx_project_webhook_url"`
+	Secret    string    `json:"-" gorm:"size:64;not null"`            // Used to sign deliveries
+	CreatedBy string    `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the admin who added it
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Project Project `json:"-" gorm:"foreignKey:ProjectID"`
+}
+
+// TableName specifies the table name for ProjectWebhook
+func (ProjectWebhook) TableName() string {
+	return "project_webhooks"
+}
+
+// WebhookPayload is the JSON body POSTed to webhooks
+type WebhookPayload struct {
+	Event      WebhookEvent     `json:"event"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Issue      WebhookIssue     `json:"issue"`
+	OldStatus  Status           `json:"old_status,omitempty"`
+	Assignee   *WebhookAssignee `json:"assignee,omitempty"`
+}
+
+// WebhookIssue is the issue summary included in webhook payloads
+type WebhookIssue struct {
+	ID          uuid.UUID `json:"id"`
+	Key         string    `json:"key,omitempty"`
+	ProjectID   uuid.UUID `json:"project_id"`
+	ProjectName string    `json:"project_name"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Status      Status    `json:"status"`
+	Priority    Priority  `json:"priority"`
+	Severity    Severity  `json:"severity,omitempty"`
+	Source      string    `json:"source"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhookAssignee describes the assigned user in issue.assigned payloads
+type WebhookAssignee struct {
+	UserID    uuid.UUID    `json:"user_id"`
+	Name      string       `json:"name,omitempty"`
+	DiscordID string       `json:"discord_id,omitempty"`
+	Role      AssigneeRole `json:"role"`
+}
+
+// NewWebhookIssue builds the payload representation of an issue
+func NewWebhookIssue(issue *Issue) WebhookIssue {
+	return WebhookIssue{
+		ID:          issue.ID,
+		Key:         issue.Key,
+		ProjectID:   issue.ProjectID,
+		ProjectName: issue.Project.Name,
+		Title:       issue.Title,
+		Description: issue.Description,
+		Status:      issue.Status,
+		Priority:    issue.Priority,
+		Severity:    issue.Severity,
+		Source:      issue.Source,
+		CreatedAt:   issue.CreatedAt,
+		UpdatedAt:   issue.UpdatedAt,
+	}
+}
+
+// nonPublicPrefixes are the ranges outside those the netip checks cover that webhooks may
+// not be delivered to: "this network" and the carrier-grade NAT shared address space
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// IsValidWebhookURL checks that a webhook URL is an absolute https URL whose host is not
+// a local name or a non-public IP address. Host names are resolved by the caller.
+func IsValidWebhookURL(rawURL string) bool {
+	if rawURL == "" || len(rawURL) > maxWebhookURLLength {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if !strings.EqualFold(u.Scheme, "https") || host == "" || u.User != nil {
+		return false
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return IsPublicWebhookAddr(addr)
+	}
+	return true
+}
+
+// IsPublicWebhookAddr checks that webhooks may be delivered to an IP address. Loopback,
+// private, link-local and other non-public addresses would reach the bot's own network.
+func IsPublicWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCustomStatuses limits how many statuses a project workflow can add
+const MaxCustomStatuses = 10
+
+// workflowStatusPattern matches status keys: lower case letters, digits and underscores starting with a letter
+var workflowStatusPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,29}$`)
+
+// WorkflowDefinition customizes the issue workflow of a project. It adds statuses and
+// transitions to the built-in workflow, which applies unchanged to projects without one.
+// Its methods can be called on a nil definition to get the built-in workflow.
+type WorkflowDefinition struct {
+	ID        uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	ProjectID uuid.UUID `json:"project_id" gorm:"type:uuid;not null;uniqueIndex"`
+	CreatedAt time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"type:timestamptz;default:now()"`
+
+	// Relationships
+	Statuses    []WorkflowStatus     `json:"statuses,omitempty" gorm:"foreignKey:WorkflowID"`
+	Transitions []WorkflowTransition `json:"transitions,omitempty" gorm:"foreignKey:WorkflowID"`
+}
+
+// TableName specifies the table name for WorkflowDefinition
+func (WorkflowDefinition) TableName() string {
+	return "workflow_definitions"
+}
+
+// WorkflowStatus is a status a project adds to the built-in workflow
+type WorkflowStatus struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	WorkflowID uuid.UUID `json:"workflow_id" gorm:"type:uuid;not null;uniqueIndex:idx_workflow_status"`
+	Status     Status    `json:"status" gorm:"size:40;not null;uniqueIndex:idx_workflow_status"`
+	Name       string    `json:"name" gorm:"size:50;not null"`         // Display name as entered by the admin
+	CreatedBy  string    `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the admin who added it
+	CreatedAt  time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for WorkflowStatus
+func (WorkflowStatus) TableName() string {
+	return "workflow_statuses"
+}
+
+// WorkflowTransition is a status change a project allows on top of the built-in workflow
+type WorkflowTransition struct {
+	ID         uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	WorkflowID uuid.UUID `json:"workflow_id" gorm:"type:uuid;not null;uniqueIndex:idx_workflow_transition"`
+	FromStatus Status    `json:"from_status" gorm:"size:40;not null;uniqueIndex:idx_workflow_transition"`
+	ToStatus   Status    `json:"to_status" gorm:"size:40;not null;uniqueIndex:idx_workflow_transition"`
+	CreatedBy  string    `json:"created_by,omitempty" gorm:"size:100"` // Discord ID of the admin who added it
+	CreatedAt  time.Time `json:"created_at" gorm:"type:timestamptz;default:now()"`
+}
+
+// TableName specifies the table name for WorkflowTransition
+func (WorkflowTransition) TableName() string {
+	return "workflow_transitions"
+}
+
+// NormalizeWorkflowStatus turns a status name such as "Waiting for Customer" into its
+// key, "waiting_for_customer"
+func NormalizeWorkflowStatus(name string) (Status, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.Join(strings.FieldsFunc(key, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "_")
+	if !workflowStatusPattern.MatchString(key) {
+		return "", ErrInvalidWorkflowStatus
+	}
+	return Status(key), nil
+}
+
+// IsBuiltInStatus checks if the status is part of the built-in workflow
+func IsBuiltInStatus(s Status) bool {
+	return s == StatusDraft || IsValidStatus(s)
+}
+
+// CustomStatus returns the custom status with the given key, or nil
+func (w *WorkflowDefinition) CustomStatus(s Status) *WorkflowStatus {
+	if w == nil {
+		return nil
+	}
+	for i := range w.Statuses {
+		if w.Statuses[i].Status == s {
+			return &w.Statuses[i]
+		}
+	}
+	return nil
+}
+
+// HasStatus checks if issues can have the status, either built-in or added by the workflow
+func (w *WorkflowDefinition) HasStatus(s Status) bool {
+	return IsValidStatus(s) || w.CustomStatus(s) != nil
+}
+
+// HasCustomTransition checks if the workflow adds the transition
+func (w *WorkflowDefinition) HasCustomTransition(from, to Status) bool {
+	if w == nil {
+		return false
+	}
+	for _, t := range w.Transitions {
+		if t.FromStatus == from && t.ToStatus == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransition checks if an issue can move from one status to another, following the
+// built-in workflow and the transitions the workflow adds
+func (w *WorkflowDefinition) CanTransition(from *Status, to Status) bool {
+	if IsStatusTransitionValid(from, to) {
+		return true
+	}
+	return from != nil && w.HasCustomTransition(*from, to)
+}
+
+// NextStatuses returns the statuses offered as next steps from the current status: the
+// built-in ones followed by those of the workflow's transitions
+func (w *WorkflowDefinition) NextStatuses(current Status) []Status {
+	next := GetNextPossibleStatuses(current)
+	if w == nil {
+		return next
+	}
+
+	next = append([]Status(nil), next...)
+	for _, t := range w.Transitions {
+		if t.FromStatus != current {
+			continue
+		}
+		seen := false
+		for _, s := range next {
+			seen = seen || s == t.ToStatus
+		}
+		if !seen {
+			next = append(next, t.ToStatus)
+		}
+	}
+	return next
+}
+
+// StatusDisplayName returns a human-readable display name for a built-in or custom status
+func (w *WorkflowDefinition) StatusDisplayName(s Status) string {
+	if custom := w.CustomStatus(s); custom != nil {
+		return custom.Name
+	}
+	return GetStatusDisplayName(s)
+}
@@ -0,0 +1,107 @@
+// Package event provides the in-process bus services publish domain events to.
+// Transports and integrations subscribe to it, so new notifiers can be added
+// without touching service code.
+package event
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// Bus is an in-process, synchronous domain.EventBus. A panicking handler is
+// logged and does not affect the publisher or the other handlers. Events published
+// in a transaction reach the handlers once it is committed and are dropped if it is
+// rolled back, so notifiers never report changes that did not happen. Handlers
+// subscribed in the transaction are called right away instead.
+type Bus struct {
+	mu         sync.RWMutex
+	handlers   map[domain.EventType][]domain.EventHandler
+	txHandlers map[domain.EventType][]domain.EventHandler
+	uow        domain.UnitOfWork
+	now        func() time.Time
+	logger     *zap.Logger
+}
+
+// NewBus creates a new event bus
+func NewBus(uow domain.UnitOfWork, logger *zap.Logger) *Bus {
+	return &Bus{
+		handlers:   make(map[domain.EventType][]domain.EventHandler),
+		txHandlers: make(map[domain.EventType][]domain.EventHandler),
+		uow:        uow,
+		now:        time.Now,
+		logger:     logger,
+	}
+}
+
+// Subscribe registers handler for the given event types
+func (b *Bus) Subscribe(handler domain.EventHandler, types ...domain.EventType) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, eventType := range types {
+		b.handlers[eventType] = append(b.handlers[eventType], handler)
+	}
+}
+
+// SubscribeInTransaction registers handler for the given event types, called as soon
+// as an event is published, within the publisher's transaction
+func (b *Bus) SubscribeInTransaction(handler domain.EventHandler, types ...domain.EventType) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, eventType := range types {
+		b.txHandlers[eventType] = append(b.txHandlers[eventType], handler)
+	}
+}
+
+// Publish calls every handler subscribed to the event's type, in subscription order.
+// Inside a transaction the handlers are called after it is committed, and the handlers
+// subscribed in the transaction before that.
+func (b *Bus) Publish(ctx context.Context, event domain.Event) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = b.now().UTC()
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	txHandlers := b.txHandlers[event.Type]
+	b.mu.RUnlock()
+
+	b.logger.Debug("Publishing event",
+		zap.String("event", string(event.Type)),
+		zap.Int("handlers", len(handlers)),
+	)
+
+	for _, handler := range txHandlers {
+		b.call(ctx, handler, event)
+	}
+
+	b.uow.AfterCommit(ctx, func(ctx context.Context) {
+		for _, handler := range handlers {
+			b.call(ctx, handler, event)
+		}
+	})
+}
+
+// call runs a single handler, recovering from panics
+func (b *Bus) call(ctx context.Context, handler domain.EventHandler, event domain.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			fields := []zap.Field{
+				zap.Any("panic", r),
+				zap.String("event", string(event.Type)),
+			}
+			if event.Issue != nil {
+				fields = append(fields, zap.String("issue_id", event.Issue.ID.String()))
+			}
+			b.logger.Error("Event handler panicked", fields...)
+		}
+	}()
+
+	handler(ctx, event)
+}
@@ -0,0 +1,118 @@
+// Package i18n translates the text the bot shows to people. English is the source
+// language: messages are looked up by their English text, and the catalogs in locales/
+// map it to other languages. Text missing from a catalog stays English.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// DefaultLocale is the language the bot's messages are written in
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var files embed.FS
+
+// catalogs maps a locale to the translations of its messages, keyed by their English text
+var catalogs = loadCatalogs()
+
+// loadCatalogs reads the embedded catalogs. They are part of the binary, so a malformed
+// one is a build mistake.
+func loadCatalogs() map[string]map[string]string {
+	entries, err := files.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: failed to list catalogs: %v", err))
+	}
+
+	catalogs := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		data, err := files.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: failed to read %s: %v", entry.Name(), err))
+		}
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("i18n: malformed catalog %s: %v", entry.Name(), err))
+		}
+		catalogs[strings.TrimSuffix(entry.Name(), ".json")] = messages
+	}
+	return catalogs
+}
+
+// Locales returns the supported locales, DefaultLocale first
+func Locales() []string {
+	locales := []string{DefaultLocale}
+	for locale := range catalogs {
+		if locale != DefaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+	slices.Sort(locales[1:])
+	return locales
+}
+
+// Match returns the supported locale for a locale such as "th" or "en-US": the locale
+// itself, or else its language. It returns an empty string if neither is supported.
+func Match(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	for _, candidate := range []string{locale, language} {
+		if candidate == DefaultLocale {
+			return DefaultLocale
+		}
+		if _, ok := catalogs[candidate]; ok {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// contextKey is the context key of the locale
+type contextKey struct{}
+
+// WithLocale returns a context whose messages are translated to locale
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// LocaleFrom returns the locale of a context, or DefaultLocale if it has none
+func LocaleFrom(ctx context.Context) string {
+	if locale, ok := ctx.Value(contextKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// T translates a message to the locale of ctx and formats it with args like fmt.Sprintf
+func T(ctx context.Context, format string, args ...any) string {
+	return Translate(LocaleFrom(ctx), format, args...)
+}
+
+// Translate translates a message to locale and formats it with args like fmt.Sprintf.
+// Without args the message is returned as it is.
+func Translate(locale, format string, args ...any) string {
+	if translated, ok := catalogs[locale][format]; ok {
+		format = translated
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Translations returns the translations of a message keyed by locale, leaving out
+// locales that have none
+func Translations(message string) map[string]string {
+	translations := make(map[string]string)
+	for locale, messages := range catalogs {
+		if translated, ok := messages[message]; ok {
+			translations[locale] = translated
+		}
+	}
+	return translations
+}
@@ -0,0 +1,137 @@
+// Package github mirrors bot issues to GitHub Issues and applies changes made
+// on GitHub back to the bot through a webhook receiver.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Issue states used by the GitHub REST API
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+)
+
+// Client is a minimal GitHub REST API client covering the calls needed for issue sync
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+// NewClient creates a new GitHub API client
+func NewClient(baseURL, token string, logger *zap.Logger) *Client {
+	return &Client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// createIssueRequest is the body sent when creating a GitHub issue
+type createIssueRequest struct {
+	Title  string   `json:"title"`
+	Body   string   `json:"body"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// issueResponse holds the fields read back from a created GitHub issue
+type issueResponse struct {
+	Number int `json:"number"`
+}
+
+// CreateIssue opens a new issue in repo ("owner/name") and returns its number
+func (c *Client) CreateIssue(ctx context.Context, repo, title, body string, labels []string) (int, error) {
+	var resp issueResponse
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/issues", repo), createIssueRequest{
+		Title:  title,
+		Body:   body,
+		Labels: labels,
+	}, &resp); err != nil {
+		return 0, fmt.Errorf("failed to create GitHub issue: %w", err)
+	}
+
+	c.logger.Debug("GitHub issue created",
+		zap.String("repo", repo),
+		zap.Int("number", resp.Number),
+	)
+
+	return resp.Number, nil
+}
+
+// UpdateIssueState opens or closes an issue
+func (c *Client) UpdateIssueState(ctx context.Context, repo string, number int, state string) error {
+	if err := c.do(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/issues/%d", repo, number), map[string]string{
+		"state": state,
+	}, nil); err != nil {
+		return fmt.Errorf("failed to update GitHub issue state: %w", err)
+	}
+
+	return nil
+}
+
+// CreateComment adds a comment to an issue
+func (c *Client) CreateComment(ctx context.Context, repo string, number int, body string) error {
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/repos/%s/issues/%d/comments", repo, number), map[string]string{
+		"body": body,
+	}, nil); err != nil {
+		return fmt.Errorf("failed to create GitHub comment: %w", err)
+	}
+
+	return nil
+}
+
+// do sends a JSON request and decodes the JSON response into out when it is not nil
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// botMarker is appended to everything the bot writes to GitHub so the webhook
+// receiver can ignore the bot's own comments
+const botMarker = "<!-- sentinel-track-bot -->"
+
+// syncTimeout bounds a single background sync call to GitHub
+const syncTimeout = 30 * time.Second
+
+// Syncer mirrors issue lifecycle events to GitHub
+type Syncer struct {
+	client    *Client
+	issueRepo domain.IssueRepository
+	logger    *zap.Logger
+}
+
+// NewSyncer creates a new GitHub syncer
+func NewSyncer(client *Client, issueRepo domain.IssueRepository, logger *zap.Logger) *Syncer {
+	return &Syncer{
+		client:    client,
+		issueRepo: issueRepo,
+		logger:    logger,
+	}
+}
+
+// Subscribe registers the syncer for the events mirrored to GitHub
+func (s *Syncer) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(s.onIssueCreated, domain.EventIssueCreated)
+	bus.Subscribe(s.onIssueStatusChanged, domain.EventIssueStatusChanged)
+	bus.Subscribe(s.onIssueCommented, domain.EventIssueCommented)
+}
+
+// onIssueCreated opens a GitHub issue when the issue's project is mapped to a repository
+func (s *Syncer) onIssueCreated(_ context.Context, event domain.Event) {
+	s.async(event.Issue.ID, "create", func(ctx context.Context, issue *domain.Issue) error {
+		if issue.GitHubIssueNumber != nil {
+			return nil
+		}
+
+		number, err := s.client.CreateIssue(ctx, issue.Project.GitHubRepo, issue.Title, issueBody(issue), []string{
+			"priority:" + string(issue.Priority),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := s.issueRepo.SetGitHubIssueNumber(ctx, issue.ID, number); err != nil {
+			return err
+		}
+
+		s.logger.Info("Issue mirrored to GitHub",
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("repo", issue.Project.GitHubRepo),
+			zap.Int("number", number),
+		)
+		return nil
+	})
+}
+
+// onIssueStatusChanged closes or reopens the GitHub issue and notes other transitions as comments
+func (s *Syncer) onIssueStatusChanged(_ context.Context, event domain.Event) {
+	oldStatus, newStatus := event.OldStatus, event.Issue.Status
+	s.async(event.Issue.ID, "status", func(ctx context.Context, issue *domain.Issue) error {
+		if issue.GitHubIssueNumber == nil {
+			return nil
+		}
+		repo, number := issue.Project.GitHubRepo, *issue.GitHubIssueNumber
+
+		switch {
+		case newStatus == domain.StatusClosed:
+			return s.client.UpdateIssueState(ctx, repo, number, StateClosed)
+		case oldStatus == domain.StatusClosed:
+			return s.client.UpdateIssueState(ctx, repo, number, StateOpen)
+		}
+
+		comment := fmt.Sprintf("Status changed: **%s** → **%s**", oldStatus, newStatus)
+		if newStatus == domain.StatusResolved {
+			comment += fmt.Sprintf("\n\n**Root cause:** %s\n\n**Corrective action:** %s", issue.ResolutionCause, issue.ResolutionAction)
+		}
+		return s.client.CreateComment(ctx, repo, number, comment+"\n\n"+botMarker)
+	})
+}
+
+// onIssueCommented copies a Discord thread message to the GitHub issue
+func (s *Syncer) onIssueCommented(_ context.Context, event domain.Event) {
+	authorName, content := event.AuthorName, event.Content
+	s.async(event.Issue.ID, "comment", func(ctx context.Context, issue *domain.Issue) error {
+		if issue.GitHubIssueNumber == nil {
+			return nil
+		}
+
+		body := fmt.Sprintf("**%s** on Discord:\n\n%s\n\n%s", authorName, content, botMarker)
+		return s.client.CreateComment(ctx, issue.Project.GitHubRepo, *issue.GitHubIssueNumber, body)
+	})
+}
+
+// async reloads the issue and runs fn in the background so Discord interactions
+// are never delayed by GitHub. Issues of projects without a repository are skipped.
+func (s *Syncer) async(issueID uuid.UUID, action string, fn func(ctx context.Context, issue *domain.Issue) error) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+		defer cancel()
+
+		issue, err := s.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			s.logger.Error("Failed to load issue for GitHub sync",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("action", action),
+			)
+			return
+		}
+
+		if !issue.Project.HasGitHubRepo() {
+			return
+		}
+
+		if err := fn(ctx, issue); err != nil {
+			s.logger.Error("GitHub sync failed",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("action", action),
+			)
+		}
+	}()
+}
+
+// issueBody renders the GitHub issue description
+func issueBody(issue *domain.Issue) string {
+	var b strings.Builder
+	b.WriteString(issue.Description)
+	if issue.ImageURL != "" {
+		fmt.Fprintf(&b, "\n\n![screenshot](%s)", issue.ImageURL)
+	}
+	fmt.Fprintf(&b, "\n\n---\n**Priority:** %s · **Source:** %s · **Issue ID:** `%s`\n\n%s",
+		issue.Priority, issue.Source, issue.ID, botMarker)
+	return b.String()
+}
@@ -0,0 +1,215 @@
+package github
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxWebhookBody limits the size of accepted webhook payloads
+const maxWebhookBody = 1 << 20
+
+// webhookPayload holds the fields read from "issues" and "issue_comment" events
+type webhookPayload struct {
+	Action string `json:"action"`
+	Issue  struct {
+		Number int `json:"number"`
+	} `json:"issue"`
+	Comment struct {
+		Body    string `json:"body"`
+		HTMLURL string `json:"html_url"`
+	} `json:"comment"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Sender struct {
+		Login string `json:"login"`
+	} `json:"sender"`
+}
+
+// WebhookHandler receives GitHub webhooks and applies issue state changes and
+// comments back to the bot
+type WebhookHandler struct {
+	secret       []byte
+	issueRepo    domain.IssueRepository
+	issueService domain.IssueService
+	session      *discordgo.Session
+	logger       *zap.Logger
+}
+
+// NewWebhookHandler creates a new GitHub webhook handler
+func NewWebhookHandler(
+	secret string,
+	issueRepo domain.IssueRepository,
+	issueService domain.IssueService,
+	session *discordgo.Session,
+	logger *zap.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		secret:       []byte(secret),
+		issueRepo:    issueRepo,
+		issueService: issueService,
+		session:      session,
+		logger:       logger,
+	}
+}
+
+// ServeHTTP verifies the webhook signature and dispatches the event
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if !h.validSignature(r.Header.Get("X-Hub-Signature-256"), body) {
+		h.logger.Warn("Rejected GitHub webhook with invalid signature")
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	event := r.Header.Get("X-GitHub-Event")
+	if event == "ping" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case event == "issues" && (payload.Action == "closed" || payload.Action == "reopened"):
+		err = h.handleIssueState(r.Context(), &payload)
+	case event == "issue_comment" && payload.Action == "created":
+		err = h.handleIssueComment(r.Context(), &payload)
+	}
+
+	if err != nil && !errors.Is(err, domain.ErrIssueNotFound) {
+		h.logger.Error("Failed to handle GitHub webhook",
+			zap.Error(err),
+			zap.String("event", event),
+			zap.String("action", payload.Action),
+			zap.String("repo", payload.Repository.FullName),
+			zap.Int("number", payload.Issue.Number),
+		)
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validSignature checks the HMAC-SHA256 signature GitHub sends with every delivery
+func (h *WebhookHandler) validSignature(header string, body []byte) bool {
+	signature, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// handleIssueState closes or reopens the bot issue mirrored by a GitHub issue
+func (h *WebhookHandler) handleIssueState(ctx context.Context, payload *webhookPayload) error {
+	issue, err := h.issueRepo.GetByGitHubIssue(ctx, payload.Repository.FullName, payload.Issue.Number)
+	if err != nil {
+		return err
+	}
+
+	closing := payload.Action == "closed"
+
+	// Skip echoes of changes the bot itself pushed to GitHub
+	if closing == (issue.Status == domain.StatusClosed) {
+		return nil
+	}
+
+	if closing {
+		err = h.issueService.CloseIssue(ctx, issue.ID, "")
+	} else {
+		err = h.issueService.ReopenIssue(ctx, issue.ID, "")
+	}
+
+	if errors.Is(err, domain.ErrOpenSubIssues) {
+		h.postToThread(issue, fmt.Sprintf("⚠️ GitHub issue #%d was closed by **%s**, but the issue still has open sub-tasks.",
+			payload.Issue.Number, payload.Sender.Login))
+		return nil
+	}
+	if errors.Is(err, domain.ErrInvalidStatusTransition) {
+		h.postToThread(issue, fmt.Sprintf("⚠️ GitHub issue #%d was %s by **%s**, but the issue cannot move from **%s** here.",
+			payload.Issue.Number, payload.Action, payload.Sender.Login, issue.GetStatusDisplayName()))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to apply GitHub issue state: %w", err)
+	}
+
+	h.logger.Info("Applied GitHub issue state",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("action", payload.Action),
+	)
+
+	h.postToThread(issue, fmt.Sprintf("🐙 GitHub issue #%d was %s by **%s**.",
+		payload.Issue.Number, payload.Action, payload.Sender.Login))
+	return nil
+}
+
+// handleIssueComment posts a GitHub comment to the issue's Discord thread
+func (h *WebhookHandler) handleIssueComment(ctx context.Context, payload *webhookPayload) error {
+	if strings.Contains(payload.Comment.Body, botMarker) {
+		return nil
+	}
+
+	issue, err := h.issueRepo.GetByGitHubIssue(ctx, payload.Repository.FullName, payload.Issue.Number)
+	if err != nil {
+		return err
+	}
+
+	h.postToThread(issue, fmt.Sprintf("🐙 **%s** commented on GitHub:\n%s\n<%s>",
+		payload.Sender.Login, truncate(payload.Comment.Body, 1500), payload.Comment.HTMLURL))
+	return nil
+}
+
+// postToThread sends a message to the issue's Discord thread, if it has one
+func (h *WebhookHandler) postToThread(issue *domain.Issue, content string) {
+	if issue.ThreadID == "" {
+		return
+	}
+
+	if _, err := h.session.ChannelMessageSend(issue.ThreadID, content); err != nil {
+		h.logger.Error("Failed to post GitHub update to thread",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
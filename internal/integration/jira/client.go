@@ -0,0 +1,177 @@
+// Package jira mirrors bot issues to Jira tickets and applies resolved and
+// closed transitions made in Jira back to the bot through a polling worker.
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxSearchKeys bounds the number of ticket keys looked up in one search request
+const maxSearchKeys = 50
+
+// Client is a minimal Jira REST API (v2) client covering the calls needed for issue sync
+type Client struct {
+	baseURL    string
+	email      string
+	apiToken   string
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+// NewClient creates a new Jira API client authenticating with an account email and API token
+func NewClient(baseURL, email, apiToken string, logger *zap.Logger) *Client {
+	return &Client{
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		email:    email,
+		apiToken: apiToken,
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// createIssueRequest is the body sent when creating a Jira ticket
+type createIssueRequest struct {
+	Fields createIssueFields `json:"fields"`
+}
+
+// createIssueFields holds the fields of a new Jira ticket
+type createIssueFields struct {
+	Project     keyRef   `json:"project"`
+	IssueType   nameRef  `json:"issuetype"`
+	Summary     string   `json:"summary"`
+	Description string   `json:"description"`
+	Labels      []string `json:"labels,omitempty"`
+}
+
+// keyRef references a Jira object by key
+type keyRef struct {
+	Key string `json:"key"`
+}
+
+// nameRef references a Jira object by name
+type nameRef struct {
+	Name string `json:"name"`
+}
+
+// createIssueResponse holds the fields read back from a created Jira ticket
+type createIssueResponse struct {
+	Key string `json:"key"`
+}
+
+// searchRequest is the body sent to the JQL search endpoint
+type searchRequest struct {
+	JQL        string   `json:"jql"`
+	Fields     []string `json:"fields"`
+	MaxResults int      `json:"maxResults"`
+}
+
+// searchResponse holds the tickets returned by a JQL search
+type searchResponse struct {
+	Issues []struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Status nameRef `json:"status"`
+		} `json:"fields"`
+	} `json:"issues"`
+}
+
+// CreateIssue opens a new ticket in the Jira project and returns its key, e.g. "OPS-42"
+func (c *Client) CreateIssue(ctx context.Context, projectKey, issueType, summary, description string, labels []string) (string, error) {
+	var resp createIssueResponse
+	if err := c.do(ctx, http.MethodPost, "/rest/api/2/issue", createIssueRequest{
+		Fields: createIssueFields{
+			Project:     keyRef{Key: projectKey},
+			IssueType:   nameRef{Name: issueType},
+			Summary:     summary,
+			Description: description,
+			Labels:      labels,
+		},
+	}, &resp); err != nil {
+		return "", fmt.Errorf("failed to create Jira issue: %w", err)
+	}
+
+	c.logger.Debug("Jira issue created",
+		zap.String("project", projectKey),
+		zap.String("key", resp.Key),
+	)
+
+	return resp.Key, nil
+}
+
+// GetStatuses returns the current status name of each ticket, keyed by ticket key.
+// Tickets that no longer exist or are not visible are missing from the result.
+func (c *Client) GetStatuses(ctx context.Context, keys []string) (map[string]string, error) {
+	statuses := make(map[string]string, len(keys))
+
+	for start := 0; start < len(keys); start += maxSearchKeys {
+		end := min(start+maxSearchKeys, len(keys))
+		batch := keys[start:end]
+
+		var resp searchResponse
+		if err := c.do(ctx, http.MethodPost, "/rest/api/2/search", searchRequest{
+			JQL:        fmt.Sprintf("key in (%s)", strings.Join(batch, ",")),
+			Fields:     []string{"status"},
+			MaxResults: len(batch),
+		}, &resp); err != nil {
+			return nil, fmt.Errorf("failed to search Jira issues: %w", err)
+		}
+
+		for _, issue := range resp.Issues {
+			statuses[issue.Key] = issue.Fields.Status.Name
+		}
+	}
+
+	return statuses, nil
+}
+
+// do sends a JSON request and decodes the JSON response into out when it is not nil
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.email, c.apiToken)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
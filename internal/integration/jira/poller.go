@@ -0,0 +1,158 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// Poller applies Jira status transitions to mirrored bot issues. Tickets moving
+// into a resolved status resolve the bot issue and tickets moving into a closed
+// status close it; other statuses are only remembered.
+type Poller struct {
+	client           *Client
+	issueRepo        domain.IssueRepository
+	issueService     domain.IssueService
+	session          *discordgo.Session
+	resolvedStatuses map[string]bool
+	closedStatuses   map[string]bool
+	logger           *zap.Logger
+}
+
+// NewPoller creates a new Jira poller. Status names are matched case-insensitively.
+func NewPoller(
+	client *Client,
+	issueRepo domain.IssueRepository,
+	issueService domain.IssueService,
+	session *discordgo.Session,
+	resolvedStatuses, closedStatuses []string,
+	logger *zap.Logger,
+) *Poller {
+	return &Poller{
+		client:           client,
+		issueRepo:        issueRepo,
+		issueService:     issueService,
+		session:          session,
+		resolvedStatuses: statusSet(resolvedStatuses),
+		closedStatuses:   statusSet(closedStatuses),
+		logger:           logger,
+	}
+}
+
+// Poll fetches the Jira status of every open mirrored issue and applies changes.
+// It is meant to run as a scheduler job.
+func (p *Poller) Poll(ctx context.Context) error {
+	issues, err := p.issueRepo.GetOpenJiraIssues(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get Jira issues: %w", err)
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		keys = append(keys, issue.JiraKey)
+	}
+
+	statuses, err := p.client.GetStatuses(ctx, keys)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		status, ok := statuses[issue.JiraKey]
+		if !ok || status == issue.JiraStatus {
+			continue
+		}
+
+		if err := p.applyStatus(ctx, issue, status); err != nil {
+			p.logger.Error("Failed to apply Jira status",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+				zap.String("jira_key", issue.JiraKey),
+				zap.String("jira_status", status),
+			)
+		}
+	}
+
+	return nil
+}
+
+// applyStatus records a new Jira status and moves the bot issue along when it maps to resolved or closed
+func (p *Poller) applyStatus(ctx context.Context, issue *domain.Issue, status string) error {
+	// Remember the status first so a transition the bot refuses is not retried on every poll
+	if err := p.issueRepo.SetJiraStatus(ctx, issue.ID, status); err != nil {
+		return err
+	}
+
+	var err error
+	switch normalized := strings.ToLower(status); {
+	case p.closedStatuses[normalized]:
+		if issue.Status == domain.StatusClosed {
+			return nil
+		}
+		err = p.issueService.CloseIssue(ctx, issue.ID, "")
+	case p.resolvedStatuses[normalized]:
+		if issue.Status == domain.StatusResolved {
+			return nil
+		}
+		err = p.issueService.UpdateIssueResolved(ctx, issue.ID,
+			fmt.Sprintf("Resolved in Jira (%s)", issue.JiraKey),
+			fmt.Sprintf("See Jira ticket %s", issue.JiraKey), "")
+	default:
+		return nil
+	}
+
+	if errors.Is(err, domain.ErrOpenSubIssues) {
+		p.postToThread(issue, fmt.Sprintf("⚠️ Jira ticket %s moved to **%s**, but the issue still has open sub-tasks.",
+			issue.JiraKey, status))
+		return nil
+	}
+	if errors.Is(err, domain.ErrInvalidStatusTransition) {
+		p.postToThread(issue, fmt.Sprintf("⚠️ Jira ticket %s moved to **%s**, but the issue cannot move from **%s** here.",
+			issue.JiraKey, status, issue.GetStatusDisplayName()))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	p.logger.Info("Applied Jira status",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("jira_key", issue.JiraKey),
+		zap.String("jira_status", status),
+	)
+
+	p.postToThread(issue, fmt.Sprintf("🧩 Jira ticket %s moved to **%s**.", issue.JiraKey, status))
+	return nil
+}
+
+// postToThread sends a message to the issue's Discord thread, if it has one
+func (p *Poller) postToThread(issue *domain.Issue, content string) {
+	if issue.ThreadID == "" {
+		return
+	}
+
+	if _, err := p.session.ChannelMessageSend(issue.ThreadID, content); err != nil {
+		p.logger.Error("Failed to post Jira update to thread",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+}
+
+// statusSet builds a lookup of lower-cased status names
+func statusSet(statuses []string) map[string]bool {
+	set := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		set[strings.ToLower(strings.TrimSpace(status))] = true
+	}
+	return set
+}
@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// syncTimeout bounds a single background sync call to Jira
+const syncTimeout = 30 * time.Second
+
+// Syncer creates a Jira ticket for every issue filed in a project mapped to Jira.
+// Status flows from Jira to the bot through the Poller.
+type Syncer struct {
+	client    *Client
+	issueRepo domain.IssueRepository
+	issueType string
+	logger    *zap.Logger
+}
+
+// NewSyncer creates a new Jira syncer. issueType is the Jira issue type of created tickets, e.g. "Task".
+func NewSyncer(client *Client, issueRepo domain.IssueRepository, issueType string, logger *zap.Logger) *Syncer {
+	return &Syncer{
+		client:    client,
+		issueRepo: issueRepo,
+		issueType: issueType,
+		logger:    logger,
+	}
+}
+
+// Subscribe registers the syncer for new issues
+func (s *Syncer) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(s.onIssueCreated, domain.EventIssueCreated)
+}
+
+// onIssueCreated opens a Jira ticket when the issue's project is mapped to a Jira project
+func (s *Syncer) onIssueCreated(_ context.Context, event domain.Event) {
+	issueID := event.Issue.ID
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+		defer cancel()
+
+		issue, err := s.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			s.logger.Error("Failed to load issue for Jira sync",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+			return
+		}
+
+		if !issue.Project.HasJiraProject() || issue.JiraKey != "" {
+			return
+		}
+
+		if err := s.createTicket(ctx, issue); err != nil {
+			s.logger.Error("Jira sync failed",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+		}
+	}()
+}
+
+// createTicket creates the Jira ticket for an issue and stores its key
+func (s *Syncer) createTicket(ctx context.Context, issue *domain.Issue) error {
+	key, err := s.client.CreateIssue(ctx, issue.Project.JiraProject, s.issueType, issue.Title, ticketDescription(issue), []string{
+		"priority-" + string(issue.Priority),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.issueRepo.SetJiraKey(ctx, issue.ID, key); err != nil {
+		return err
+	}
+
+	s.logger.Info("Issue mirrored to Jira",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("jira_project", issue.Project.JiraProject),
+		zap.String("jira_key", key),
+	)
+	return nil
+}
+
+// ticketDescription renders the Jira ticket description in Jira wiki markup
+func ticketDescription(issue *domain.Issue) string {
+	var b strings.Builder
+	b.WriteString(issue.Description)
+	if issue.ImageURL != "" {
+		fmt.Fprintf(&b, "\n\n!%s!", issue.ImageURL)
+	}
+	fmt.Fprintf(&b, "\n\n----\n*Priority:* %s · *Source:* %s · *Issue ID:* {{%s}}", issue.Priority, issue.Source, issue.ID)
+	return b.String()
+}
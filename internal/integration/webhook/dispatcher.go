@@ -0,0 +1,259 @@
+// Package webhook delivers issue events to external systems through the
+// outbound webhooks registered for each project.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Headers sent with every delivery
+const (
+	HeaderEvent     = "X-Sentinel-Event"
+	HeaderDelivery  = "X-Sentinel-Delivery"
+	HeaderSignature = "X-Sentinel-Signature" // "sha256=" + hex HMAC-SHA256 of the body keyed with the webhook secret
+)
+
+// Dispatcher POSTs issue events to project webhooks, retrying failed deliveries
+// with exponential backoff.
+type Dispatcher struct {
+	issueRepo   domain.IssueRepository
+	webhookRepo domain.ProjectWebhookRepository
+	httpClient  *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	logger      *zap.Logger
+}
+
+// NewDispatcher creates a new webhook dispatcher. Each delivery is attempted up to
+// maxAttempts times, waiting backoff before the first retry and doubling it after that.
+func NewDispatcher(
+	issueRepo domain.IssueRepository,
+	webhookRepo domain.ProjectWebhookRepository,
+	timeout time.Duration,
+	maxAttempts int,
+	backoff time.Duration,
+	logger *zap.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		issueRepo:   issueRepo,
+		webhookRepo: webhookRepo,
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: publicTransport(),
+			// Receivers answer deliveries themselves; following a redirect could lead anywhere
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		logger:      logger,
+	}
+}
+
+// errNonPublicAddress is returned when a webhook host resolves to an address deliveries
+// may not be sent to
+var errNonPublicAddress = errors.New("webhook host resolves to a non-public address")
+
+// publicTransport returns an HTTP transport that only connects to public addresses. The
+// check runs on the address actually dialed, after DNS resolution, so a host that
+// resolves differently than when the webhook was added cannot reach the bot's network.
+// Proxies are not used, as they would be dialed instead of the receiver.
+func publicTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("failed to parse dialed address %q: %w", address, err)
+			}
+			if !domain.IsPublicWebhookAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", errNonPublicAddress, addrPort.Addr())
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// Subscribe registers the dispatcher for the events webhooks receive
+func (d *Dispatcher) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(d.handleEvent,
+		domain.EventIssueCreated,
+		domain.EventIssueStatusChanged,
+		domain.EventAssigneeAdded,
+	)
+}
+
+// handleEvent maps a domain event to its webhook event and dispatches it
+func (d *Dispatcher) handleEvent(_ context.Context, event domain.Event) {
+	switch event.Type {
+	case domain.EventIssueCreated:
+		d.dispatch(event, domain.WebhookEventIssueCreated, func(payload *domain.WebhookPayload) {})
+	case domain.EventIssueStatusChanged:
+		newStatus := event.Issue.Status
+		d.dispatch(event, domain.WebhookEventIssueStatusChanged, func(payload *domain.WebhookPayload) {
+			// The issue may have moved on by the time it is reloaded
+			payload.Issue.Status = newStatus
+			payload.OldStatus = event.OldStatus
+		})
+	case domain.EventAssigneeAdded:
+		webhookAssignee := &domain.WebhookAssignee{
+			UserID:    event.Assignee.UserID,
+			Name:      event.Assignee.User.Name,
+			DiscordID: event.Assignee.User.DiscordID,
+			Role:      event.Assignee.Role,
+		}
+		d.dispatch(event, domain.WebhookEventIssueAssigned, func(payload *domain.WebhookPayload) {
+			payload.Assignee = webhookAssignee
+		})
+	}
+}
+
+// dispatch reloads the issue and delivers the event to every webhook of its project
+// in the background, so issue changes are never delayed by slow receivers
+func (d *Dispatcher) dispatch(source domain.Event, event domain.WebhookEvent, fill func(payload *domain.WebhookPayload)) {
+	issueID := source.Issue.ID
+	occurredAt := source.OccurredAt
+
+	go func() {
+		ctx := context.Background()
+
+		issue, err := d.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			d.logger.Error("Failed to load issue for webhook dispatch",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("event", string(event)),
+			)
+			return
+		}
+
+		webhooks, err := d.webhookRepo.ListByProject(ctx, issue.ProjectID)
+		if err != nil {
+			d.logger.Error("Failed to list project webhooks",
+				zap.Error(err),
+				zap.String("project_id", issue.ProjectID.String()),
+			)
+			return
+		}
+		if len(webhooks) == 0 {
+			return
+		}
+
+		payload := &domain.WebhookPayload{
+			Event:      event,
+			OccurredAt: occurredAt,
+			Issue:      domain.NewWebhookIssue(issue),
+		}
+		fill(payload)
+
+		body, err := json.Marshal(payload)
+		if err != nil {
+			d.logger.Error("Failed to encode webhook payload", zap.Error(err))
+			return
+		}
+
+		for _, webhook := range webhooks {
+			go d.deliver(ctx, webhook, event, body)
+		}
+	}()
+}
+
+// deliver POSTs one event to one webhook, retrying transient failures
+func (d *Dispatcher) deliver(ctx context.Context, webhook *domain.ProjectWebhook, event domain.WebhookEvent, body []byte) {
+	deliveryID := uuid.New().String()
+	wait := d.backoff
+
+	for attempt := 1; ; attempt++ {
+		retryable, err := d.post(ctx, webhook, event, deliveryID, body)
+		if err == nil {
+			d.logger.Debug("Webhook delivered",
+				zap.String("webhook_id", webhook.ID.String()),
+				zap.String("event", string(event)),
+				zap.Int("attempt", attempt),
+			)
+			return
+		}
+
+		if !retryable || attempt >= d.maxAttempts {
+			d.logger.Error("Webhook delivery failed",
+				zap.Error(err),
+				zap.String("webhook_id", webhook.ID.String()),
+				zap.String("event", string(event)),
+				zap.Int("attempts", attempt),
+			)
+			return
+		}
+
+		d.logger.Warn("Webhook delivery failed, retrying",
+			zap.Error(err),
+			zap.String("webhook_id", webhook.ID.String()),
+			zap.Int("attempt", attempt),
+			zap.Duration("retry_in", wait),
+		)
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// post sends a single delivery attempt and reports whether a failure is worth retrying
+func (d *Dispatcher) post(ctx context.Context, webhook *domain.ProjectWebhook, event domain.WebhookEvent, deliveryID string, body []byte) (bool, error) {
+	// Webhooks added before https was required are not delivered to
+	if !domain.IsValidWebhookURL(webhook.URL) {
+		return false, domain.ErrInvalidWebhookURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sentinel-track-bot")
+	req.Header.Set(HeaderEvent, string(event))
+	req.Header.Set(HeaderDelivery, deliveryID)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(webhook.Secret, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return !errors.Is(err, errNonPublicAddress), fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return true, fmt.Errorf("receiver returned %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("receiver returned %d", resp.StatusCode)
+	}
+}
+
+// Sign returns the hex HMAC-SHA256 of body keyed with secret, as sent in HeaderSignature
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
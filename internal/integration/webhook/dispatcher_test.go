@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+func TestDeliveriesRefuseNonPublicAddresses(t *testing.T) {
+	received := false
+	receiver := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer receiver.Close()
+
+	d := NewDispatcher(nil, nil, time.Second, 1, 0, zap.NewNop())
+
+	// The address is checked when it is dialed, whatever the URL looked like when saved
+	resp, err := d.httpClient.Post(receiver.URL, "application/json", nil)
+	if err == nil {
+		resp.Body.Close()
+	}
+	if !errors.Is(err, errNonPublicAddress) {
+		t.Errorf("dialing %s: err = %v, want %v", receiver.URL, err, errNonPublicAddress)
+	}
+	if received {
+		t.Error("the receiver on a loopback address got a delivery")
+	}
+
+	// Webhooks saved before https was required are not delivered to
+	webhook := &domain.ProjectWebhook{URL: "http://hooks.example.com/", Secret: "secret"}
+	retryable, err := d.post(context.Background(), webhook, domain.WebhookEventIssueCreated, "delivery", []byte("{}"))
+	if !errors.Is(err, domain.ErrInvalidWebhookURL) || retryable {
+		t.Errorf("posting to plain http: err = %v, retryable = %v; want %v, not retryable", err, retryable, domain.ErrInvalidWebhookURL)
+	}
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// apiKeyRepository implements the APIKeyRepository interface
+type apiKeyRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewAPIKeyRepository creates a new instance of API key repository
+func NewAPIKeyRepository(db *gorm.DB, logger *zap.Logger) domain.APIKeyRepository {
+	return &apiKeyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new API key
+func (r *apiKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	r.logger.Debug("Creating API key",
+		zap.String("name", key.Name),
+		zap.String("prefix", key.Prefix),
+	)
+
+	if err := conn(ctx, r.db).Create(key).Error; err != nil {
+		r.logger.Error("Failed to create API key",
+			zap.Error(err),
+			zap.String("prefix", key.Prefix),
+		)
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	r.logger.Info("API key created successfully",
+		zap.String("api_key_id", key.ID.String()),
+		zap.String("prefix", key.Prefix),
+	)
+
+	return nil
+}
+
+// GetByHash retrieves an API key by the hash of its token, revoked or not
+func (r *apiKeyRepository) GetByHash(ctx context.Context, hash string) (*domain.APIKey, error) {
+	var key domain.APIKey
+	if err := conn(ctx, r.db).Where("hash = ?", hash).First(&key).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		r.logger.Error("Failed to retrieve API key by hash", zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve api key by hash: %w", err)
+	}
+
+	return &key, nil
+}
+
+// ListByCustomer retrieves the keys of a customer, or those without one for nil, newest first
+func (r *apiKeyRepository) ListByCustomer(ctx context.Context, customerID *uuid.UUID, includeRevoked bool) ([]*domain.APIKey, error) {
+	query := conn(ctx, r.db)
+	if customerID != nil {
+		r.logger.Debug("Listing API keys by customer", zap.String("customer_id", customerID.String()))
+		query = query.Where("customer_id = ?", *customerID)
+	} else {
+		r.logger.Debug("Listing API keys without customer")
+		query = query.Where("customer_id IS NULL")
+	}
+	if !includeRevoked {
+		query = query.Where("revoked_at IS NULL")
+	}
+
+	var keys []*domain.APIKey
+	if err := query.Order("created_at DESC").Find(&keys).Error; err != nil {
+		r.logger.Error("Failed to list API keys", zap.Error(err))
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Revoke marks a key as revoked at the given time
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.logger.Debug("Revoking API key", zap.String("api_key_id", id.String()))
+
+	result := conn(ctx, r.db).Model(&domain.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		UpdateColumn("revoked_at", at)
+	if result.Error != nil {
+		r.logger.Error("Failed to revoke API key",
+			zap.Error(result.Error),
+			zap.String("api_key_id", id.String()),
+		)
+		return fmt.Errorf("failed to revoke api key: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	r.logger.Info("API key revoked successfully", zap.String("api_key_id", id.String()))
+	return nil
+}
+
+// TouchLastUsed records when a key was last used
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	if err := conn(ctx, r.db).Model(&domain.APIKey{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", at).Error; err != nil {
+		r.logger.Error("Failed to record API key use",
+			zap.Error(err),
+			zap.String("api_key_id", id.String()),
+		)
+		return fmt.Errorf("failed to record api key use: %w", err)
+	}
+
+	return nil
+}
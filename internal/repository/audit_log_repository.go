@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// auditLogRepository implements the AuditLogRepository interface
+type auditLogRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewAuditLogRepository creates a new instance of audit log repository
+func NewAuditLogRepository(db *gorm.DB, logger *zap.Logger) domain.AuditLogRepository {
+	return &auditLogRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores an audit log entry in the database
+func (r *auditLogRepository) Create(ctx context.Context, entry *domain.AuditLog) error {
+	r.logger.Debug("Creating audit log entry",
+		zap.String("action", string(entry.Action)),
+		zap.String("target_id", entry.TargetID),
+	)
+
+	if err := conn(ctx, r.db).Create(entry).Error; err != nil {
+		r.logger.Error("Failed to create audit log entry",
+			zap.Error(err),
+			zap.String("action", string(entry.Action)),
+			zap.String("target_id", entry.TargetID),
+		)
+		return fmt.Errorf("failed to create audit log entry: %w", err)
+	}
+
+	return nil
+}
+
+// ListByGuildID retrieves the latest audit log entries of a guild, newest first
+func (r *auditLogRepository) ListByGuildID(ctx context.Context, guildID string, limit int) ([]*domain.AuditLog, error) {
+	r.logger.Debug("Listing audit log entries", zap.String("guild_id", guildID), zap.Int("limit", limit))
+
+	var entries []*domain.AuditLog
+	if err := conn(ctx, r.db).
+		Where("guild_id = ?", guildID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&entries).Error; err != nil {
+		r.logger.Error("Failed to list audit log entries",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+		)
+		return nil, fmt.Errorf("failed to list audit log entries: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// boardRepository implements the BoardRepository interface
+type boardRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewBoardRepository creates a new instance of board repository
+func NewBoardRepository(db *gorm.DB, logger *zap.Logger) domain.BoardRepository {
+	return &boardRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Save creates the board of a channel or points the stored one at a new message
+func (r *boardRepository) Save(ctx context.Context, board *domain.Board) error {
+	r.logger.Debug("Saving board",
+		zap.String("channel_id", board.ChannelID.String()),
+		zap.String("message_id", board.MessageID),
+	)
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "channel_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"discord_channel_id", "message_id", "created_by", "updated_at"}),
+		}).
+		Create(board).Error; err != nil {
+		r.logger.Error("Failed to save board",
+			zap.Error(err),
+			zap.String("channel_id", board.ChannelID.String()),
+		)
+		return fmt.Errorf("failed to save board: %w", err)
+	}
+
+	return nil
+}
+
+// GetByChannelID retrieves the board of a channel registration
+func (r *boardRepository) GetByChannelID(ctx context.Context, channelID uuid.UUID) (*domain.Board, error) {
+	r.logger.Debug("Retrieving board by channel ID", zap.String("channel_id", channelID.String()))
+
+	var board domain.Board
+	if err := conn(ctx, r.db).Where("channel_id = ?", channelID).First(&board).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrBoardNotFound
+		}
+		r.logger.Error("Failed to retrieve board by channel ID",
+			zap.Error(err),
+			zap.String("channel_id", channelID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve board by channel ID: %w", err)
+	}
+
+	return &board, nil
+}
+
+// Delete removes the board of a channel registration
+func (r *boardRepository) Delete(ctx context.Context, channelID uuid.UUID) error {
+	r.logger.Debug("Deleting board", zap.String("channel_id", channelID.String()))
+
+	result := conn(ctx, r.db).Where("channel_id = ?", channelID).Delete(&domain.Board{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete board",
+			zap.Error(result.Error),
+			zap.String("channel_id", channelID.String()),
+		)
+		return fmt.Errorf("failed to delete board: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrBoardNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ChannelCacheStats reports how well the channel cache is doing
+type ChannelCacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// channelCacheEntry is a cached lookup: a registration or the fact there is none
+type channelCacheEntry struct {
+	channel   *domain.Channel // nil if the channel is not registered
+	expiresAt time.Time
+}
+
+// channelLookupCache stores the channel lookups of the cached channel, project and customer repositories
+type channelLookupCache interface {
+	// get returns the cached lookup of a Discord channel, if there is a fresh one, and the
+	// generation of the cache to pass to put
+	get(ctx context.Context, channelID string) (*domain.Channel, bool, uint64)
+
+	// put caches a lookup unless the cache was invalidated since gen was read
+	put(ctx context.Context, channelID string, channel *domain.Channel, gen uint64)
+
+	// invalidate drops the cached lookups matching drop, now and once the transaction in ctx commits
+	invalidate(ctx context.Context, drop func(channelID string, channel *domain.Channel) bool)
+}
+
+// ChannelCache keeps channel registrations, looked up on nearly every interaction, in
+// memory for a short time. Entries are dropped when the registration or its project
+// changes through the repositories returned by Channels, Projects and Customers.
+type ChannelCache struct {
+	ttl    time.Duration
+	now    func() time.Time
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	entries map[string]channelCacheEntry
+	// gen is bumped on every invalidation so lookups started before it do not store stale results
+	gen       uint64
+	nextSweep time.Time
+	hits      uint64
+	misses    uint64
+}
+
+// NewChannelCache creates a new channel cache keeping entries for ttl
+func NewChannelCache(ttl time.Duration, logger *zap.Logger) *ChannelCache {
+	return &ChannelCache{
+		ttl:     ttl,
+		now:     time.Now,
+		logger:  logger,
+		entries: make(map[string]channelCacheEntry),
+	}
+}
+
+// Channels wraps a channel repository so it reads through the cache
+func (c *ChannelCache) Channels(repo domain.ChannelRepository) domain.ChannelRepository {
+	return &cachedChannelRepository{ChannelRepository: repo, cache: c}
+}
+
+// Projects wraps a project repository so changes to a project drop its cached channels
+func (c *ChannelCache) Projects(repo domain.ProjectRepository) domain.ProjectRepository {
+	return &cachedProjectRepository{ProjectRepository: repo, cache: c}
+}
+
+// Customers wraps a customer repository so changes to a customer drop the cached channels
+// of its projects
+func (c *ChannelCache) Customers(repo domain.CustomerRepository) domain.CustomerRepository {
+	return &cachedCustomerRepository{CustomerRepository: repo, cache: c}
+}
+
+// Stats returns the cache statistics
+func (c *ChannelCache) Stats() ChannelCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return ChannelCacheStats{Hits: c.hits, Misses: c.misses, Entries: len(c.entries)}
+}
+
+// get returns a copy of the cached lookup of a Discord channel, if there is a fresh one
+func (c *ChannelCache) get(_ context.Context, channelID string) (*domain.Channel, bool, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[channelID]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		delete(c.entries, channelID)
+		c.misses++
+		return nil, false, c.gen
+	}
+
+	c.hits++
+	if entry.channel == nil {
+		return nil, true, c.gen
+	}
+	channel := *entry.channel
+	return &channel, true, c.gen
+}
+
+// put caches a lookup unless the cache was invalidated since gen was read
+func (c *ChannelCache) put(_ context.Context, channelID string, channel *domain.Channel, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	// Drop expired lookups of channels nobody asked about again once per ttl
+	now := c.now()
+	if now.After(c.nextSweep) {
+		for channelID, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, channelID)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+
+	if channel != nil {
+		stored := *channel
+		channel = &stored
+	}
+	c.entries[channelID] = channelCacheEntry{channel: channel, expiresAt: now.Add(c.ttl)}
+}
+
+// invalidate drops the cached lookups matching drop, now and again once the transaction
+// in ctx commits, so lookups made while it runs do not outlive it
+func (c *ChannelCache) invalidate(ctx context.Context, drop func(channelID string, channel *domain.Channel) bool) {
+	purge := func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.gen++
+		dropped := 0
+		for channelID, entry := range c.entries {
+			if drop(channelID, entry.channel) {
+				delete(c.entries, channelID)
+				dropped++
+			}
+		}
+		if dropped > 0 {
+			c.logger.Debug("Dropped cached channels", zap.Int("count", dropped))
+		}
+	}
+
+	purge()
+	afterCommit(ctx, purge)
+}
+
+// cachedChannelRepository serves channel lookups from a ChannelCache
+type cachedChannelRepository struct {
+	domain.ChannelRepository
+	cache channelLookupCache
+}
+
+// GetByChannelID retrieves a channel registration from the cache, or from the repository on a miss
+func (r *cachedChannelRepository) GetByChannelID(ctx context.Context, channelID string) (*domain.Channel, error) {
+	// A transaction may see its own uncommitted changes, which must not be cached
+	if inTransaction(ctx) {
+		return r.ChannelRepository.GetByChannelID(ctx, channelID)
+	}
+
+	channel, ok, gen := r.cache.get(ctx, channelID)
+	if ok {
+		if channel == nil {
+			return nil, domain.ErrChannelNotFound
+		}
+		return channel, nil
+	}
+
+	channel, err := r.ChannelRepository.GetByChannelID(ctx, channelID)
+	switch {
+	case errors.Is(err, domain.ErrChannelNotFound):
+		r.cache.put(ctx, channelID, nil, gen)
+	case err == nil:
+		r.cache.put(ctx, channelID, channel, gen)
+	}
+	return channel, err
+}
+
+// Create creates a new channel registration, dropping the cached lookup saying it does not exist
+func (r *cachedChannelRepository) Create(ctx context.Context, channel *domain.Channel) error {
+	if err := r.ChannelRepository.Create(ctx, channel); err != nil {
+		return err
+	}
+	r.cache.invalidate(ctx, func(channelID string, _ *domain.Channel) bool {
+		return channelID == channel.DiscordChannelID
+	})
+	return nil
+}
+
+// Update updates an existing channel registration and drops it from the cache
+func (r *cachedChannelRepository) Update(ctx context.Context, channel *domain.Channel) error {
+	if err := r.ChannelRepository.Update(ctx, channel); err != nil {
+		return err
+	}
+	r.cache.invalidate(ctx, func(channelID string, _ *domain.Channel) bool {
+		return channelID == channel.DiscordChannelID
+	})
+	return nil
+}
+
+// AddProject registers a further project in a channel and drops the channel from the cache
+func (r *cachedChannelRepository) AddProject(ctx context.Context, id, projectID uuid.UUID) error {
+	if err := r.ChannelRepository.AddProject(ctx, id, projectID); err != nil {
+		return err
+	}
+	r.invalidateChannel(ctx, id)
+	return nil
+}
+
+// RemoveProject removes a further project from a channel and drops the channel from the cache
+func (r *cachedChannelRepository) RemoveProject(ctx context.Context, id, projectID uuid.UUID) error {
+	if err := r.ChannelRepository.RemoveProject(ctx, id, projectID); err != nil {
+		return err
+	}
+	r.invalidateChannel(ctx, id)
+	return nil
+}
+
+// Delete soft-deletes a channel registration and drops it from the cache
+func (r *cachedChannelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.ChannelRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateChannel(ctx, id)
+	return nil
+}
+
+// Restore reverses the soft deletion of a channel registration. Its Discord channel ID
+// is unknown here, so every cached "not registered" lookup is dropped.
+func (r *cachedChannelRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := r.ChannelRepository.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.cache.invalidate(ctx, func(_ string, cached *domain.Channel) bool {
+		return cached == nil
+	})
+	return nil
+}
+
+// invalidateChannel drops a cached channel registration by its ID
+func (r *cachedChannelRepository) invalidateChannel(ctx context.Context, id uuid.UUID) {
+	r.cache.invalidate(ctx, func(_ string, cached *domain.Channel) bool {
+		return cached != nil && cached.ID == id
+	})
+}
+
+// cachedProjectRepository drops the cached channels of projects that change
+type cachedProjectRepository struct {
+	domain.ProjectRepository
+	cache channelLookupCache
+}
+
+// Update updates an existing project and drops its cached channels
+func (r *cachedProjectRepository) Update(ctx context.Context, project *domain.Project) error {
+	if err := r.ProjectRepository.Update(ctx, project); err != nil {
+		return err
+	}
+	r.invalidateProject(ctx, project.ID)
+	return nil
+}
+
+// Delete soft-deletes a project and drops its cached channels
+func (r *cachedProjectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.ProjectRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateProject(ctx, id)
+	return nil
+}
+
+// Restore reverses the soft deletion of a project, whose channels were cached as not registered
+func (r *cachedProjectRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := r.ProjectRepository.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.cache.invalidate(ctx, func(_ string, cached *domain.Channel) bool {
+		return cached == nil
+	})
+	return nil
+}
+
+// Merge merges a project into another and drops the cached channels of both
+func (r *cachedProjectRepository) Merge(ctx context.Context, fromID, intoID uuid.UUID) error {
+	if err := r.ProjectRepository.Merge(ctx, fromID, intoID); err != nil {
+		return err
+	}
+	r.cache.invalidate(ctx, func(_ string, cached *domain.Channel) bool {
+		return cached != nil && (cached.HasProject(fromID) || cached.HasProject(intoID))
+	})
+	return nil
+}
+
+// invalidateProject drops the cached channels of a project
+func (r *cachedProjectRepository) invalidateProject(ctx context.Context, projectID uuid.UUID) {
+	r.cache.invalidate(ctx, func(_ string, cached *domain.Channel) bool {
+		return cached != nil && cached.HasProject(projectID)
+	})
+}
+
+// cachedCustomerRepository drops the cached channels of customers that change, as
+// channels are cached with the customers of their projects
+type cachedCustomerRepository struct {
+	domain.CustomerRepository
+	cache channelLookupCache
+}
+
+// Update updates an existing customer and drops the cached channels of its projects
+func (r *cachedCustomerRepository) Update(ctx context.Context, customer *domain.Customer) error {
+	if err := r.CustomerRepository.Update(ctx, customer); err != nil {
+		return err
+	}
+	r.invalidateCustomer(ctx, customer.ID)
+	return nil
+}
+
+// Delete soft-deletes a customer and drops the cached channels of its projects
+func (r *cachedCustomerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.CustomerRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateCustomer(ctx, id)
+	return nil
+}
+
+// Restore reverses the soft deletion of a customer and drops the cached channels of its
+// projects, which were cached without it
+func (r *cachedCustomerRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	if err := r.CustomerRepository.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateCustomer(ctx, id)
+	return nil
+}
+
+// Merge merges a customer into another and drops the cached channels of its projects
+func (r *cachedCustomerRepository) Merge(ctx context.Context, fromID, intoID uuid.UUID) error {
+	if err := r.CustomerRepository.Merge(ctx, fromID, intoID); err != nil {
+		return err
+	}
+	r.invalidateCustomer(ctx, fromID)
+	return nil
+}
+
+// invalidateCustomer drops the cached channels tracking a project of a customer
+func (r *cachedCustomerRepository) invalidateCustomer(ctx context.Context, customerID uuid.UUID) {
+	r.cache.invalidate(ctx, func(_ string, cached *domain.Channel) bool {
+		if cached == nil {
+			return false
+		}
+		for _, project := range cached.AllProjects() {
+			if project.CustomerID == customerID {
+				return true
+			}
+		}
+		return false
+	})
+}
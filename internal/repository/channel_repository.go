@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // channelRepository implements the ChannelRepository interface
@@ -33,7 +34,7 @@ func (r *channelRepository) Create(ctx context.Context, channel *domain.Channel)
 		zap.String("registered_by", channel.RegisteredBy.String()),
 	)
 
-	if err := r.db.WithContext(ctx).Create(channel).Error; err != nil {
+	if err := conn(ctx, r.db).Create(channel).Error; err != nil {
 		r.logger.Error("Failed to create channel registration",
 			zap.Error(err),
 			zap.String("channel_id", channel.DiscordChannelID),
@@ -55,9 +56,10 @@ func (r *channelRepository) GetByChannelID(ctx context.Context, channelID string
 	r.logger.Debug("Retrieving channel registration by channel ID", zap.String("channel_id", channelID))
 
 	var channel domain.Channel
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Project").
 		Preload("Project.Customer").
+		Preload("Projects", func(db *gorm.DB) *gorm.DB { return db.Order("name") }).
 		Preload("RegisteredByUser").
 		Where("discord_channel_id = ?", channelID).
 		First(&channel).Error; err != nil {
@@ -76,14 +78,39 @@ func (r *channelRepository) GetByChannelID(ctx context.Context, channelID string
 	return &channel, nil
 }
 
+// GetDeletedByChannelID retrieves the soft-deleted registration of a Discord channel. It
+// still holds the channel ID, so registering the channel again restores it.
+func (r *channelRepository) GetDeletedByChannelID(ctx context.Context, channelID string) (*domain.Channel, error) {
+	r.logger.Debug("Retrieving deleted channel registration", zap.String("channel_id", channelID))
+
+	var channel domain.Channel
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Preload("Projects").
+		Where("discord_channel_id = ? AND deleted_at IS NOT NULL", channelID).
+		First(&channel).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrChannelNotFound
+		}
+		r.logger.Error("Failed to retrieve deleted channel registration",
+			zap.Error(err),
+			zap.String("channel_id", channelID),
+		)
+		return nil, fmt.Errorf("failed to retrieve deleted channel registration: %w", err)
+	}
+
+	return &channel, nil
+}
+
 // GetByGuildID retrieves all channel registrations for a specific guild
 func (r *channelRepository) GetByGuildID(ctx context.Context, guildID string) ([]*domain.Channel, error) {
 	r.logger.Debug("Retrieving channel registrations by guild ID", zap.String("guild_id", guildID))
 
 	var channels []*domain.Channel
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Project").
 		Preload("Project.Customer").
+		Preload("Projects", func(db *gorm.DB) *gorm.DB { return db.Order("name") }).
 		Preload("RegisteredByUser").
 		Where("guild_id = ?", guildID).
 		Order("created_at DESC").
@@ -107,7 +134,8 @@ func (r *channelRepository) GetByGuildID(ctx context.Context, guildID string) ([
 func (r *channelRepository) Update(ctx context.Context, channel *domain.Channel) error {
 	r.logger.Debug("Updating channel registration", zap.String("registration_id", channel.ID.String()))
 
-	result := r.db.WithContext(ctx).Save(channel)
+	// Further projects are changed with AddProject and RemoveProject only
+	result := conn(ctx, r.db).Omit("Projects").Save(channel)
 	if result.Error != nil {
 		r.logger.Error("Failed to update channel registration",
 			zap.Error(result.Error),
@@ -129,11 +157,58 @@ func (r *channelRepository) Update(ctx context.Context, channel *domain.Channel)
 	return nil
 }
 
-// Delete removes a channel registration from the database
+// AddProject registers a further project in a channel. Adding a project twice is a no-op.
+func (r *channelRepository) AddProject(ctx context.Context, id, projectID uuid.UUID) error {
+	r.logger.Debug("Adding project to channel registration",
+		zap.String("registration_id", id.String()),
+		zap.String("project_id", projectID.String()),
+	)
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.ChannelProject{ChannelID: id, ProjectID: projectID}).Error; err != nil {
+		r.logger.Error("Failed to add project to channel registration",
+			zap.Error(err),
+			zap.String("registration_id", id.String()),
+			zap.String("project_id", projectID.String()),
+		)
+		return fmt.Errorf("failed to add project to channel registration: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveProject removes a further project from a channel
+func (r *channelRepository) RemoveProject(ctx context.Context, id, projectID uuid.UUID) error {
+	r.logger.Debug("Removing project from channel registration",
+		zap.String("registration_id", id.String()),
+		zap.String("project_id", projectID.String()),
+	)
+
+	result := conn(ctx, r.db).
+		Where("channel_id = ? AND project_id = ?", id, projectID).
+		Delete(&domain.ChannelProject{})
+	if result.Error != nil {
+		r.logger.Error("Failed to remove project from channel registration",
+			zap.Error(result.Error),
+			zap.String("registration_id", id.String()),
+			zap.String("project_id", projectID.String()),
+		)
+		return fmt.Errorf("failed to remove project from channel registration: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrProjectNotInChannel
+	}
+
+	return nil
+}
+
+// Delete soft-deletes a channel registration; it can be brought back with Restore
 func (r *channelRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.logger.Debug("Deleting channel registration", zap.String("registration_id", id.String()))
 
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Channel{})
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.Channel{})
 	if result.Error != nil {
 		r.logger.Error("Failed to delete channel registration",
 			zap.Error(result.Error),
@@ -151,6 +226,32 @@ func (r *channelRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore clears the deletion mark of a soft-deleted channel registration
+func (r *channelRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Restoring channel registration", zap.String("registration_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&domain.Channel{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		r.logger.Error("Failed to restore channel registration",
+			zap.Error(result.Error),
+			zap.String("registration_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore channel registration: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Deleted channel registration not found for restore", zap.String("registration_id", id.String()))
+		return domain.ErrChannelNotFound
+	}
+
+	r.logger.Info("Channel registration restored successfully", zap.String("registration_id", id.String()))
+	return nil
+}
+
 // List retrieves all channel registrations with pagination
 func (r *channelRepository) List(ctx context.Context, offset, limit int) ([]*domain.Channel, error) {
 	r.logger.Debug("Listing channel registrations",
@@ -159,7 +260,7 @@ func (r *channelRepository) List(ctx context.Context, offset, limit int) ([]*dom
 	)
 
 	var channels []*domain.Channel
-	if err := r.db.WithContext(ctx).Offset(offset).Limit(limit).Order("created_at DESC").Find(&channels).Error; err != nil {
+	if err := conn(ctx, r.db).Offset(offset).Limit(limit).Order("created_at DESC").Find(&channels).Error; err != nil {
 		r.logger.Error("Failed to list channel registrations",
 			zap.Error(err),
 			zap.Int("offset", offset),
@@ -182,7 +283,7 @@ func (r *channelRepository) GetActiveChannels(ctx context.Context) ([]*domain.Ch
 	r.logger.Debug("Retrieving active channel registrations")
 
 	var channels []*domain.Channel
-	if err := r.db.WithContext(ctx).Where("is_active = ?", true).Order("created_at DESC").Find(&channels).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Project").Where("is_active = ?", true).Order("created_at DESC").Find(&channels).Error; err != nil {
 		r.logger.Error("Failed to retrieve active channel registrations", zap.Error(err))
 		return nil, fmt.Errorf("failed to retrieve active channel registrations: %w", err)
 	}
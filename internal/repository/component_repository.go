@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// componentRepository implements the ComponentRepository interface
+type componentRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewComponentRepository creates a new instance of component repository
+func NewComponentRepository(db *gorm.DB, logger *zap.Logger) domain.ComponentRepository {
+	return &componentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new component in the database
+func (r *componentRepository) Create(ctx context.Context, component *domain.Component) error {
+	r.logger.Debug("Creating component",
+		zap.String("project_id", component.ProjectID.String()),
+		zap.String("name", component.Name),
+	)
+
+	if err := conn(ctx, r.db).Omit("Lead").Create(component).Error; err != nil {
+		r.logger.Error("Failed to create component",
+			zap.Error(err),
+			zap.String("project_id", component.ProjectID.String()),
+			zap.String("name", component.Name),
+		)
+		return fmt.Errorf("failed to create component: %w", err)
+	}
+
+	r.logger.Info("Component created successfully",
+		zap.String("component_id", component.ID.String()),
+		zap.String("name", component.Name),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a component with its lead by its ID
+func (r *componentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Component, error) {
+	r.logger.Debug("Retrieving component by ID", zap.String("component_id", id.String()))
+
+	var component domain.Component
+	if err := conn(ctx, r.db).Preload("Lead").Where("id = ?", id).First(&component).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrComponentNotFound
+		}
+		r.logger.Error("Failed to retrieve component by ID",
+			zap.Error(err),
+			zap.String("component_id", id.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve component by ID: %w", err)
+	}
+
+	return &component, nil
+}
+
+// ListByProject retrieves a project's components with their leads, by name
+func (r *componentRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.Component, error) {
+	r.logger.Debug("Listing components by project", zap.String("project_id", projectID.String()))
+
+	var components []*domain.Component
+	if err := conn(ctx, r.db).
+		Preload("Lead").
+		Where("project_id = ?", projectID).
+		Order("LOWER(name) ASC").
+		Find(&components).Error; err != nil {
+		r.logger.Error("Failed to list components by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list components by project: %w", err)
+	}
+
+	return components, nil
+}
+
+// SetLead changes the lead of a component, or removes it for nil
+func (r *componentRepository) SetLead(ctx context.Context, id uuid.UUID, leadID *uuid.UUID) error {
+	r.logger.Debug("Setting component lead", zap.String("component_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Model(&domain.Component{}).
+		Where("id = ?", id).
+		UpdateColumn("lead_id", leadID)
+	if result.Error != nil {
+		r.logger.Error("Failed to set component lead",
+			zap.Error(result.Error),
+			zap.String("component_id", id.String()),
+		)
+		return fmt.Errorf("failed to set component lead: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrComponentNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a component. Its issues are kept without a component.
+func (r *componentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting component", zap.String("component_id", id.String()))
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Deleted issues are detached too so restoring them does not bring back the component
+		if err := tx.Unscoped().Model(&domain.Issue{}).
+			Where("component_id = ?", id).
+			UpdateColumn("component_id", nil).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&domain.Component{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrComponentNotFound
+		}
+		return nil
+	})
+	if err == domain.ErrComponentNotFound {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to delete component",
+			zap.Error(err),
+			zap.String("component_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete component: %w", err)
+	}
+
+	r.logger.Info("Component deleted successfully", zap.String("component_id", id.String()))
+	return nil
+}
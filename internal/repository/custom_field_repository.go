@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// customFieldRepository implements the CustomFieldRepository interface
+type customFieldRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewCustomFieldRepository creates a new instance of custom field repository
+func NewCustomFieldRepository(db *gorm.DB, logger *zap.Logger) domain.CustomFieldRepository {
+	return &customFieldRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new custom field in the database
+func (r *customFieldRepository) Create(ctx context.Context, field *domain.CustomFieldDefinition) error {
+	r.logger.Debug("Creating custom field",
+		zap.String("project_id", field.ProjectID.String()),
+		zap.String("name", field.Name),
+	)
+
+	if err := conn(ctx, r.db).Create(field).Error; err != nil {
+		r.logger.Error("Failed to create custom field",
+			zap.Error(err),
+			zap.String("project_id", field.ProjectID.String()),
+			zap.String("name", field.Name),
+		)
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	r.logger.Info("Custom field created successfully",
+		zap.String("field_id", field.ID.String()),
+		zap.String("name", field.Name),
+	)
+
+	return nil
+}
+
+// ListByProject retrieves a project's custom fields in form order
+func (r *customFieldRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+	r.logger.Debug("Listing custom fields by project", zap.String("project_id", projectID.String()))
+
+	var fields []*domain.CustomFieldDefinition
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("position ASC, created_at ASC").
+		Find(&fields).Error; err != nil {
+		r.logger.Error("Failed to list custom fields by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list custom fields by project: %w", err)
+	}
+
+	return fields, nil
+}
+
+// Delete removes a custom field and its values on issues
+func (r *customFieldRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting custom field", zap.String("field_id", id.String()))
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("field_id = ?", id).Delete(&domain.IssueCustomFieldValue{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&domain.CustomFieldDefinition{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrCustomFieldNotFound
+		}
+		return nil
+	})
+	if err == domain.ErrCustomFieldNotFound {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to delete custom field",
+			zap.Error(err),
+			zap.String("field_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+
+	r.logger.Info("Custom field deleted successfully", zap.String("field_id", id.String()))
+	return nil
+}
+
+// SetValue stores the value of a custom field on an issue, replacing any previous value
+func (r *customFieldRepository) SetValue(ctx context.Context, value *domain.IssueCustomFieldValue) error {
+	r.logger.Debug("Setting custom field value",
+		zap.String("issue_id", value.IssueID.String()),
+		zap.String("field_id", value.FieldID.String()),
+	)
+
+	value.UpdatedAt = time.Now()
+	if err := conn(ctx, r.db).
+		Omit("Field").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "issue_id"}, {Name: "field_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).
+		Create(value).Error; err != nil {
+		r.logger.Error("Failed to set custom field value",
+			zap.Error(err),
+			zap.String("issue_id", value.IssueID.String()),
+			zap.String("field_id", value.FieldID.String()),
+		)
+		return fmt.Errorf("failed to set custom field value: %w", err)
+	}
+
+	return nil
+}
+
+// ClearValue removes the value of a custom field from an issue. Clearing an unset value is a no-op.
+func (r *customFieldRepository) ClearValue(ctx context.Context, issueID, fieldID uuid.UUID) error {
+	r.logger.Debug("Clearing custom field value",
+		zap.String("issue_id", issueID.String()),
+		zap.String("field_id", fieldID.String()),
+	)
+
+	if err := conn(ctx, r.db).
+		Where("issue_id = ? AND field_id = ?", issueID, fieldID).
+		Delete(&domain.IssueCustomFieldValue{}).Error; err != nil {
+		r.logger.Error("Failed to clear custom field value",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("field_id", fieldID.String()),
+		)
+		return fmt.Errorf("failed to clear custom field value: %w", err)
+	}
+
+	return nil
+}
@@ -32,7 +32,7 @@ func (r *customerRepository) Create(ctx context.Context, customer *domain.Custom
 		zap.String("contact_email", customer.ContactEmail),
 	)
 
-	if err := r.db.WithContext(ctx).Create(customer).Error; err != nil {
+	if err := conn(ctx, r.db).Create(customer).Error; err != nil {
 		r.logger.Error("Failed to create customer",
 			zap.Error(err),
 			zap.String("name", customer.Name),
@@ -53,7 +53,7 @@ func (r *customerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	r.logger.Debug("Retrieving customer by ID", zap.String("customer_id", id.String()))
 
 	var customer domain.Customer
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&customer).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&customer).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Debug("Customer not found", zap.String("customer_id", id.String()))
 			return nil, domain.ErrCustomerNotFound
@@ -74,7 +74,7 @@ func (r *customerRepository) GetByName(ctx context.Context, name string) (*domai
 	r.logger.Debug("Retrieving customer by name", zap.String("name", name))
 
 	var customer domain.Customer
-	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&customer).Error; err != nil {
+	if err := conn(ctx, r.db).Where("name = ?", name).First(&customer).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Debug("Customer not found", zap.String("name", name))
 			return nil, domain.ErrCustomerNotFound
@@ -94,7 +94,7 @@ func (r *customerRepository) GetByName(ctx context.Context, name string) (*domai
 func (r *customerRepository) Update(ctx context.Context, customer *domain.Customer) error {
 	r.logger.Debug("Updating customer", zap.String("customer_id", customer.ID.String()))
 
-	result := r.db.WithContext(ctx).Save(customer)
+	result := conn(ctx, r.db).Save(customer)
 	if result.Error != nil {
 		r.logger.Error("Failed to update customer",
 			zap.Error(result.Error),
@@ -116,11 +116,11 @@ func (r *customerRepository) Update(ctx context.Context, customer *domain.Custom
 	return nil
 }
 
-// Delete removes a customer from the database
+// Delete soft-deletes a customer; it can be brought back with Restore
 func (r *customerRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.logger.Debug("Deleting customer", zap.String("customer_id", id.String()))
 
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Customer{})
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.Customer{})
 	if result.Error != nil {
 		r.logger.Error("Failed to delete customer",
 			zap.Error(result.Error),
@@ -138,6 +138,32 @@ func (r *customerRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore clears the deletion mark of a soft-deleted customer
+func (r *customerRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Restoring customer", zap.String("customer_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&domain.Customer{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		r.logger.Error("Failed to restore customer",
+			zap.Error(result.Error),
+			zap.String("customer_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore customer: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Deleted customer not found for restore", zap.String("customer_id", id.String()))
+		return domain.ErrCustomerNotFound
+	}
+
+	r.logger.Info("Customer restored successfully", zap.String("customer_id", id.String()))
+	return nil
+}
+
 // List retrieves all customers with pagination
 func (r *customerRepository) List(ctx context.Context, offset, limit int) ([]*domain.Customer, error) {
 	r.logger.Debug("Listing customers",
@@ -146,7 +172,7 @@ func (r *customerRepository) List(ctx context.Context, offset, limit int) ([]*do
 	)
 
 	var customers []*domain.Customer
-	if err := r.db.WithContext(ctx).Offset(offset).Limit(limit).Order("created_at DESC").Find(&customers).Error; err != nil {
+	if err := conn(ctx, r.db).Offset(offset).Limit(limit).Order("created_at DESC").Find(&customers).Error; err != nil {
 		r.logger.Error("Failed to list customers",
 			zap.Error(err),
 			zap.Int("offset", offset),
@@ -163,3 +189,125 @@ func (r *customerRepository) List(ctx context.Context, offset, limit int) ([]*do
 
 	return customers, nil
 }
+
+// ListByGuild retrieves the customers added in a guild, or whose projects are registered
+// in one of its channels, by name
+func (r *customerRepository) ListByGuild(ctx context.Context, guildID string) ([]*domain.Customer, error) {
+	r.logger.Debug("Listing customers of guild", zap.String("guild_id", guildID))
+
+	var customers []*domain.Customer
+	if err := guildCustomers(conn(ctx, r.db), guildID).
+		Order("name").
+		Find(&customers).Error; err != nil {
+		r.logger.Error("Failed to list customers of guild",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+		)
+		return nil, fmt.Errorf("failed to list customers of guild: %w", err)
+	}
+
+	r.logger.Debug("Customers of guild listed successfully",
+		zap.String("guild_id", guildID),
+		zap.Int("count", len(customers)),
+	)
+
+	return customers, nil
+}
+
+// GetByNameInGuild retrieves a customer by name among the customers of a guild, as
+// listed by ListByGuild
+func (r *customerRepository) GetByNameInGuild(ctx context.Context, guildID, name string) (*domain.Customer, error) {
+	r.logger.Debug("Retrieving customer of guild by name",
+		zap.String("guild_id", guildID),
+		zap.String("name", name),
+	)
+
+	var customer domain.Customer
+	if err := guildCustomers(conn(ctx, r.db), guildID).
+		Where("name = ?", name).
+		Order("created_at").
+		First(&customer).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Customer not found in guild",
+				zap.String("guild_id", guildID),
+				zap.String("name", name),
+			)
+			return nil, domain.ErrCustomerNotFound
+		}
+		r.logger.Error("Failed to retrieve customer of guild by name",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+			zap.String("name", name),
+		)
+		return nil, fmt.Errorf("failed to retrieve customer of guild by name: %w", err)
+	}
+
+	r.logger.Debug("Customer retrieved successfully",
+		zap.String("guild_id", guildID),
+		zap.String("name", name),
+	)
+	return &customer, nil
+}
+
+// guildCustomers scopes a query to the customers added in a guild or whose projects are
+// registered in one of its channels
+func guildCustomers(db *gorm.DB, guildID string) *gorm.DB {
+	mainProjects := db.Model(&domain.Project{}).
+		Select("projects.customer_id").
+		Joins("JOIN channels ON channels.project_id = projects.id AND channels.deleted_at IS NULL").
+		Where("channels.guild_id = ?", guildID)
+	furtherProjects := db.Model(&domain.Project{}).
+		Select("projects.customer_id").
+		Joins("JOIN channel_projects ON channel_projects.project_id = projects.id").
+		Joins("JOIN channels ON channels.id = channel_projects.channel_id AND channels.deleted_at IS NULL").
+		Where("channels.guild_id = ?", guildID)
+
+	return db.Where("guild_id = ? OR id IN (?) OR id IN (?)", guildID, mainProjects, furtherProjects)
+}
+
+// Merge moves the projects, users and API keys of a customer to another and soft-deletes
+// it. Deleted projects move too, so restoring them brings them back under the customer
+// merged into.
+func (r *customerRepository) Merge(ctx context.Context, fromID, intoID uuid.UUID) error {
+	r.logger.Debug("Merging customers",
+		zap.String("from_customer_id", fromID.String()),
+		zap.String("into_customer_id", intoID.String()),
+	)
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{&domain.Project{}, &domain.User{}, &domain.APIKey{}} {
+			if err := tx.Unscoped().Model(model).
+				Where("customer_id = ?", fromID).
+				UpdateColumn("customer_id", intoID).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Where("id = ?", fromID).Delete(&domain.Customer{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrCustomerNotFound
+		}
+		return nil
+	})
+	if err == domain.ErrCustomerNotFound {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to merge customers",
+			zap.Error(err),
+			zap.String("from_customer_id", fromID.String()),
+			zap.String("into_customer_id", intoID.String()),
+		)
+		return fmt.Errorf("failed to merge customers: %w", err)
+	}
+
+	r.logger.Info("Customers merged successfully",
+		zap.String("from_customer_id", fromID.String()),
+		zap.String("into_customer_id", intoID.String()),
+	)
+
+	return nil
+}
@@ -1,13 +1,18 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync/atomic"
 
 	"fix-track-bot/internal/config"
 	"fix-track-bot/internal/domain"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
@@ -17,9 +22,10 @@ import (
 
 // DatabaseManager manages database connections and migrations
 type DatabaseManager struct {
-	db     *gorm.DB
-	config *config.DatabaseConfig
-	logger *zap.Logger
+	db       *gorm.DB
+	config   *config.DatabaseConfig
+	password *atomic.Pointer[string] // Rotated PostgreSQL password, used by new connections
+	logger   *zap.Logger
 }
 
 // NewDatabaseManager creates a new database manager
@@ -32,6 +38,7 @@ func NewDatabaseManager(config *config.DatabaseConfig, zapLogger *zap.Logger) (*
 
 	var db *gorm.DB
 	var err error
+	password := &atomic.Pointer[string]{}
 
 	switch config.Driver {
 	case "sqlite":
@@ -50,8 +57,20 @@ func NewDatabaseManager(config *config.DatabaseConfig, zapLogger *zap.Logger) (*
 		zapLogger.Info("Connected to SQLite database", zap.String("path", config.FilePath))
 
 	case "postgres":
-		dsn := config.GetDSN()
-		db, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+		pgxConfig, err := pgx.ParseConfig(config.GetDSN())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PostgreSQL connection settings: %w", err)
+		}
+
+		// New connections authenticate with the latest password, so it can be rotated
+		// without reopening the pool; open connections stay authenticated
+		conn := stdlib.OpenDB(*pgxConfig, stdlib.OptionBeforeConnect(func(ctx context.Context, cc *pgx.ConnConfig) error {
+			if p := password.Load(); p != nil {
+				cc.Password = *p
+			}
+			return nil
+		}))
+		db, err = gorm.Open(postgres.New(postgres.Config{Conn: conn}), &gorm.Config{
 			Logger: gormLogger,
 		})
 		if err != nil {
@@ -68,48 +87,156 @@ func NewDatabaseManager(config *config.DatabaseConfig, zapLogger *zap.Logger) (*
 		return nil, fmt.Errorf("unsupported database driver: %s", config.Driver)
 	}
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+
+	zapLogger.Debug("Configured database connection pool",
+		zap.Int("max_open_conns", config.MaxOpenConns),
+		zap.Int("max_idle_conns", config.MaxIdleConns),
+		zap.Duration("conn_max_lifetime", config.ConnMaxLifetime),
+		zap.Duration("conn_max_idle_time", config.ConnMaxIdleTime),
+	)
+
 	return &DatabaseManager{
-		db:     db,
-		config: config,
-		logger: zapLogger,
+		db:       db,
+		config:   config,
+		password: password,
+		logger:   zapLogger,
 	}, nil
 }
 
+// SetPassword sets the password new PostgreSQL connections authenticate with, e.g.
+// after it was rotated in the secrets store. SQLite databases have no password.
+func (dm *DatabaseManager) SetPassword(password string) {
+	if dm.config.Driver != "postgres" {
+		return
+	}
+	dm.password.Store(&password)
+	dm.logger.Info("Database password rotated; new connections use it")
+}
+
 // GetDB returns the database connection
 func (dm *DatabaseManager) GetDB() *gorm.DB {
 	return dm.db
 }
 
-// Migrate runs database migrations
+// Migrate brings the database schema up to date. PostgreSQL databases get the pending
+// versioned migrations; SQLite databases, used for development, are auto-migrated.
 func (dm *DatabaseManager) Migrate() error {
 	dm.logger.Info("Running database migrations")
 
-	// For PostgreSQL, we expect the schema to be created manually using the DDL script
-	// This prevents GORM from misinterpreting relationships and creating wrong foreign keys
-	// if dm.config.Driver == "postgres" {
-	// 	dm.logger.Info("PostgreSQL detected - skipping AutoMigrate. Please ensure schema is created using DDL script.")
-	// 	return nil
-	// }
+	// Issue labels use a custom join table with a creation timestamp
+	if err := dm.db.SetupJoinTable(&domain.Issue{}, "Labels", &domain.IssueLabel{}); err != nil {
+		dm.logger.Error("Failed to set up issue labels join table", zap.Error(err))
+		return fmt.Errorf("failed to set up issue labels join table: %w", err)
+	}
+
+	// Channels list their further projects through a join table with a creation timestamp
+	if err := dm.db.SetupJoinTable(&domain.Channel{}, "Projects", &domain.ChannelProject{}); err != nil {
+		dm.logger.Error("Failed to set up channel projects join table", zap.Error(err))
+		return fmt.Errorf("failed to set up channel projects join table: %w", err)
+	}
+
+	var err error
+	if dm.config.Driver == "postgres" {
+		err = migrateUp(dm.db, dm.logger)
+	} else {
+		err = dm.autoMigrate()
+	}
+	if err != nil {
+		dm.logger.Error("Failed to run migrations", zap.Error(err))
+		return fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	if err := backfillIssueKeys(context.Background(), dm.db, dm.logger); err != nil {
+		dm.logger.Error("Failed to backfill issue keys", zap.Error(err))
+		return fmt.Errorf("failed to backfill issue keys: %w", err)
+	}
 
-	// For SQLite, continue using AutoMigrate for development
+	dm.logger.Info("Database migrations completed successfully")
+	return nil
+}
+
+// Rollback reverts the latest steps versioned migrations. It is only supported for
+// PostgreSQL, as SQLite databases are not versioned.
+func (dm *DatabaseManager) Rollback(steps int) error {
+	if dm.config.Driver != "postgres" {
+		return fmt.Errorf("rollback is not supported for the %s driver", dm.config.Driver)
+	}
+	if steps < 1 {
+		return fmt.Errorf("rollback needs at least one step, got %d", steps)
+	}
+
+	dm.logger.Info("Rolling back database migrations", zap.Int("steps", steps))
+
+	if err := migrateDown(dm.db, dm.logger, steps); err != nil {
+		dm.logger.Error("Failed to roll back migrations", zap.Error(err))
+		return fmt.Errorf("failed to roll back migrations: %w", err)
+	}
+
+	dm.logger.Info("Database rollback completed successfully")
+	return nil
+}
+
+// autoMigrate creates and updates the schema from the models
+func (dm *DatabaseManager) autoMigrate() error {
+	// New models must also get a versioned migration for PostgreSQL
 	models := []interface{}{
 		&domain.Customer{},
 		&domain.Project{},
 		&domain.User{},
 		&domain.Channel{},
+		&domain.Label{},     // Before issues, which reference labels through issue_labels
+		&domain.Milestone{}, // Before issues, which reference their milestone
+		&domain.Component{}, // Before issues, which reference their component
 		&domain.Issue{},
 		&domain.IssueAssignee{},
 		&domain.IssueStatusLog{},
+		&domain.SLAAlert{},
+		&domain.IssueAttachment{},
+		&domain.IssueComment{},
+		&domain.IssueLabel{},
+		&domain.IssueLink{},
+		&domain.ProjectWebhook{},
+		&domain.WorkflowDefinition{},
+		&domain.WorkflowStatus{},
+		&domain.WorkflowTransition{},
+		&domain.GuildSettings{},
+		&domain.OnCallSchedule{},
+		&domain.OnCallMember{},
+		&domain.UserNotificationPreference{},
+		&domain.AuditLog{},
+		&domain.ChannelProject{},
+		&domain.CustomFieldDefinition{},
+		&domain.IssueCustomFieldValue{},
+		&domain.RecurringIssue{},
+		&domain.IssueWorklog{},
+		&domain.Board{},
+		&domain.EscalationRule{},
+		&domain.IssueEscalation{},
+		&domain.IssueFeedback{},
+		&domain.IssueEmail{},
+		&domain.APIKey{},
+		&domain.IssueWatcher{},
+		&domain.EventClaim{},
+		&domain.OutboxAction{},
+		&domain.TeamMember{},
+		&domain.IssueSuggestedAssignee{},
+		&domain.Incident{},
 	}
 
 	for _, model := range models {
 		if err := dm.db.AutoMigrate(model); err != nil {
-			dm.logger.Error("Failed to run migrations", zap.Error(err))
-			return fmt.Errorf("failed to run migrations: %w", err)
+			return err
 		}
 	}
 
-	dm.logger.Info("Database migrations completed successfully")
 	return nil
 }
 
@@ -130,14 +257,23 @@ func (dm *DatabaseManager) Close() error {
 	return nil
 }
 
+// Stats returns the connection pool statistics
+func (dm *DatabaseManager) Stats() sql.DBStats {
+	sqlDB, err := dm.db.DB()
+	if err != nil {
+		return sql.DBStats{}
+	}
+	return sqlDB.Stats()
+}
+
 // Health checks if the database connection is healthy
-func (dm *DatabaseManager) Health() error {
+func (dm *DatabaseManager) Health(ctx context.Context) error {
 	sqlDB, err := dm.db.DB()
 	if err != nil {
 		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
 	}
 
-	if err := sqlDB.Ping(); err != nil {
+	if err := sqlDB.PingContext(ctx); err != nil {
 		return fmt.Errorf("database ping failed: %w", err)
 	}
 
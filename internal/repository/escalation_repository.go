@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// escalationRepository implements the EscalationRepository interface
+type escalationRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewEscalationRepository creates a new instance of escalation repository
+func NewEscalationRepository(db *gorm.DB, logger *zap.Logger) domain.EscalationRepository {
+	return &escalationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// escalationRuleOrder lists rules from the highest priority down
+const escalationRuleOrder = "CASE priority WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END"
+
+// SaveRule creates a project's rule for a priority or updates the stored one
+func (r *escalationRepository) SaveRule(ctx context.Context, rule *domain.EscalationRule) error {
+	r.logger.Debug("Saving escalation rule",
+		zap.String("project_id", rule.ProjectID.String()),
+		zap.String("priority", string(rule.Priority)),
+		zap.Int("after_hours", rule.AfterHours),
+	)
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "priority"}},
+			DoUpdates: clause.AssignmentColumns([]string{"after_hours", "role_id", "bump_priority", "created_by"}),
+		}).
+		Create(rule).Error; err != nil {
+		r.logger.Error("Failed to save escalation rule",
+			zap.Error(err),
+			zap.String("project_id", rule.ProjectID.String()),
+			zap.String("priority", string(rule.Priority)),
+		)
+		return fmt.Errorf("failed to save escalation rule: %w", err)
+	}
+
+	return nil
+}
+
+// ListRulesByProject retrieves a project's escalation rules, highest priority first
+func (r *escalationRepository) ListRulesByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.EscalationRule, error) {
+	r.logger.Debug("Listing escalation rules by project", zap.String("project_id", projectID.String()))
+
+	var rules []*domain.EscalationRule
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order(escalationRuleOrder).
+		Find(&rules).Error; err != nil {
+		r.logger.Error("Failed to list escalation rules by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list escalation rules by project: %w", err)
+	}
+
+	return rules, nil
+}
+
+// ListRules retrieves the escalation rules of every project
+func (r *escalationRepository) ListRules(ctx context.Context) ([]*domain.EscalationRule, error) {
+	var rules []*domain.EscalationRule
+	if err := conn(ctx, r.db).Find(&rules).Error; err != nil {
+		r.logger.Error("Failed to list escalation rules", zap.Error(err))
+		return nil, fmt.Errorf("failed to list escalation rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// DeleteRule removes an escalation rule
+func (r *escalationRepository) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting escalation rule", zap.String("rule_id", id.String()))
+
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.EscalationRule{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete escalation rule",
+			zap.Error(result.Error),
+			zap.String("rule_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete escalation rule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrEscalationRuleNotFound
+	}
+
+	return nil
+}
+
+// HasEscalated checks whether an issue was already escalated at a priority
+func (r *escalationRepository) HasEscalated(ctx context.Context, issueID uuid.UUID, priority domain.Priority) (bool, error) {
+	var count int64
+	if err := conn(ctx, r.db).
+		Model(&domain.IssueEscalation{}).
+		Where("issue_id = ? AND priority = ?", issueID, priority).
+		Count(&count).Error; err != nil {
+		r.logger.Error("Failed to check issue escalation",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return false, fmt.Errorf("failed to check issue escalation: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// RecordEscalation records that an issue was escalated
+func (r *escalationRepository) RecordEscalation(ctx context.Context, escalation *domain.IssueEscalation) error {
+	if err := conn(ctx, r.db).Create(escalation).Error; err != nil {
+		r.logger.Error("Failed to record issue escalation",
+			zap.Error(err),
+			zap.String("issue_id", escalation.IssueID.String()),
+		)
+		return fmt.Errorf("failed to record issue escalation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// eventClaimRepository implements the EventClaimRepository interface
+type eventClaimRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewEventClaimRepository creates a new instance of event claim repository
+func NewEventClaimRepository(db *gorm.DB, logger *zap.Logger) domain.EventClaimRepository {
+	return &eventClaimRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Claim records the event with the given key as handled until ttl has passed. The insert
+// takes over an expired claim and leaves an unexpired one alone, so of several replicas
+// claiming the same event exactly one affects a row.
+func (r *eventClaimRepository) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	claim := &domain.EventClaim{Key: key, ExpiresAt: now.Add(ttl)}
+
+	result := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"expires_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Lt{Column: clause.Column{Table: claim.TableName(), Name: "expires_at"}, Value: now},
+			}},
+		}).
+		Create(claim)
+	if result.Error != nil {
+		r.logger.Error("Failed to claim event",
+			zap.Error(result.Error),
+			zap.String("key", key),
+		)
+		return false, fmt.Errorf("failed to claim event: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteExpired removes the claims that expired before the given time
+func (r *eventClaimRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result := conn(ctx, r.db).
+		Where("expires_at < ?", before).
+		Delete(&domain.EventClaim{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete expired event claims", zap.Error(result.Error))
+		return 0, fmt.Errorf("failed to delete expired event claims: %w", result.Error)
+	}
+
+	if result.RowsAffected > 0 {
+		r.logger.Debug("Deleted expired event claims", zap.Int64("count", result.RowsAffected))
+	}
+	return result.RowsAffected, nil
+}
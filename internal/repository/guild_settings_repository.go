@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// guildSettingsRepository implements the GuildSettingsRepository interface
+type guildSettingsRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewGuildSettingsRepository creates a new instance of guild settings repository
+func NewGuildSettingsRepository(db *gorm.DB, logger *zap.Logger) domain.GuildSettingsRepository {
+	return &guildSettingsRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates the settings of a guild in the database
+func (r *guildSettingsRepository) Create(ctx context.Context, settings *domain.GuildSettings) error {
+	r.logger.Debug("Creating guild settings", zap.String("guild_id", settings.GuildID))
+
+	if err := conn(ctx, r.db).Create(settings).Error; err != nil {
+		r.logger.Error("Failed to create guild settings",
+			zap.Error(err),
+			zap.String("guild_id", settings.GuildID),
+		)
+		return fmt.Errorf("failed to create guild settings: %w", err)
+	}
+
+	r.logger.Info("Guild settings created successfully", zap.String("guild_id", settings.GuildID))
+	return nil
+}
+
+// Update updates the settings of a guild in the database
+func (r *guildSettingsRepository) Update(ctx context.Context, settings *domain.GuildSettings) error {
+	r.logger.Debug("Updating guild settings", zap.String("guild_id", settings.GuildID))
+
+	if err := conn(ctx, r.db).Save(settings).Error; err != nil {
+		r.logger.Error("Failed to update guild settings",
+			zap.Error(err),
+			zap.String("guild_id", settings.GuildID),
+		)
+		return fmt.Errorf("failed to update guild settings: %w", err)
+	}
+
+	r.logger.Info("Guild settings updated successfully", zap.String("guild_id", settings.GuildID))
+	return nil
+}
+
+// GetByGuildID retrieves the settings of a guild
+func (r *guildSettingsRepository) GetByGuildID(ctx context.Context, guildID string) (*domain.GuildSettings, error) {
+	r.logger.Debug("Retrieving guild settings", zap.String("guild_id", guildID))
+
+	var settings domain.GuildSettings
+	if err := conn(ctx, r.db).
+		Where("guild_id = ?", guildID).
+		First(&settings).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrGuildSettingsNotFound
+		}
+		r.logger.Error("Failed to retrieve guild settings",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+		)
+		return nil, fmt.Errorf("failed to retrieve guild settings: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// List retrieves the settings of every guild that has stored some
+func (r *guildSettingsRepository) List(ctx context.Context) ([]*domain.GuildSettings, error) {
+	r.logger.Debug("Listing guild settings")
+
+	var settings []*domain.GuildSettings
+	if err := conn(ctx, r.db).Order("created_at ASC").Find(&settings).Error; err != nil {
+		r.logger.Error("Failed to list guild settings", zap.Error(err))
+		return nil, fmt.Errorf("failed to list guild settings: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// incidentRepository implements the IncidentRepository interface
+type incidentRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIncidentRepository creates a new instance of incident repository
+func NewIncidentRepository(db *gorm.DB, logger *zap.Logger) domain.IncidentRepository {
+	return &incidentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new incident in the database
+func (r *incidentRepository) Create(ctx context.Context, incident *domain.Incident) error {
+	r.logger.Debug("Creating incident", zap.String("issue_id", incident.IssueID.String()))
+
+	if err := conn(ctx, r.db).Omit("Issue").Create(incident).Error; err != nil {
+		r.logger.Error("Failed to create incident",
+			zap.Error(err),
+			zap.String("issue_id", incident.IssueID.String()),
+		)
+		return fmt.Errorf("failed to create incident: %w", err)
+	}
+
+	r.logger.Info("Incident created successfully",
+		zap.String("incident_id", incident.ID.String()),
+		zap.String("issue_id", incident.IssueID.String()),
+	)
+
+	return nil
+}
+
+// GetByIssueID retrieves the incident of an issue with the issue and its channel
+func (r *incidentRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) (*domain.Incident, error) {
+	r.logger.Debug("Retrieving incident by issue ID", zap.String("issue_id", issueID.String()))
+
+	var incident domain.Incident
+	if err := conn(ctx, r.db).
+		Preload("Issue").
+		Preload("Issue.Channel").
+		Where("issue_id = ?", issueID).
+		First(&incident).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrIncidentNotFound
+		}
+		r.logger.Error("Failed to retrieve incident by issue ID",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve incident by issue ID: %w", err)
+	}
+
+	return &incident, nil
+}
+
+// ListUnresolved retrieves the incidents of issues that are not deleted, resolved, verified
+// or closed, with the issue and its channel
+func (r *incidentRepository) ListUnresolved(ctx context.Context) ([]*domain.Incident, error) {
+	var incidents []*domain.Incident
+	if err := conn(ctx, r.db).
+		Joins("JOIN issues ON issues.id = incidents.issue_id AND issues.deleted_at IS NULL").
+		Where("issues.status NOT IN ?", []domain.Status{domain.StatusResolved, domain.StatusVerified, domain.StatusClosed}).
+		Preload("Issue").
+		Preload("Issue.Channel").
+		Order("incidents.declared_at ASC").
+		Find(&incidents).Error; err != nil {
+		r.logger.Error("Failed to list unresolved incidents", zap.Error(err))
+		return nil, fmt.Errorf("failed to list unresolved incidents: %w", err)
+	}
+
+	return incidents, nil
+}
+
+// RecordUpdate stores when the latest status update of an incident was posted
+func (r *incidentRepository) RecordUpdate(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.setColumn(ctx, id, "last_update_at", at)
+}
+
+// RecordReminder stores when the commander of an incident was last reminded of an update
+func (r *incidentRepository) RecordReminder(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return r.setColumn(ctx, id, "reminded_at", at)
+}
+
+// setColumn sets a column of an incident
+func (r *incidentRepository) setColumn(ctx context.Context, id uuid.UUID, column string, value any) error {
+	result := conn(ctx, r.db).
+		Model(&domain.Incident{}).
+		Where("id = ?", id).
+		UpdateColumn(column, value)
+	if result.Error != nil {
+		r.logger.Error("Failed to update incident",
+			zap.Error(result.Error),
+			zap.String("incident_id", id.String()),
+			zap.String("column", column),
+		)
+		return fmt.Errorf("failed to update incident %s: %w", column, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIncidentNotFound
+	}
+
+	return nil
+}
@@ -33,7 +33,7 @@ func (r *issueAssigneeRepository) Create(ctx context.Context, assignee *domain.I
 		zap.String("role", assignee.Role.String()),
 	)
 
-	if err := r.db.WithContext(ctx).Create(assignee).Error; err != nil {
+	if err := conn(ctx, r.db).Create(assignee).Error; err != nil {
 		r.logger.Error("Failed to create issue assignee",
 			zap.Error(err),
 			zap.String("issue_id", assignee.IssueID.String()),
@@ -58,7 +58,7 @@ func (r *issueAssigneeRepository) GetByID(ctx context.Context, id uuid.UUID) (*d
 	r.logger.Debug("Retrieving issue assignee by ID", zap.String("assignee_id", id.String()))
 
 	var assignee domain.IssueAssignee
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Issue").
 		Preload("User").
 		First(&assignee, "id = ?", id).Error; err != nil {
@@ -82,7 +82,7 @@ func (r *issueAssigneeRepository) GetByIssueID(ctx context.Context, issueID uuid
 	r.logger.Debug("Retrieving assignees by issue ID", zap.String("issue_id", issueID.String()))
 
 	var assignees []*domain.IssueAssignee
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Issue").
 		Preload("User").
 		Where("issue_id = ?", issueID).
@@ -108,9 +108,10 @@ func (r *issueAssigneeRepository) GetByUserID(ctx context.Context, userID uuid.U
 	r.logger.Debug("Retrieving assignments by user ID", zap.String("user_id", userID.String()))
 
 	var assignees []*domain.IssueAssignee
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Issue").
 		Preload("Issue.Project").
+		Preload("Issue.Channel").
 		Preload("User").
 		Where("user_id = ?", userID).
 		Order("assigned_at DESC").
@@ -138,7 +139,7 @@ func (r *issueAssigneeRepository) GetByIssueAndUser(ctx context.Context, issueID
 	)
 
 	var assignees []*domain.IssueAssignee
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Issue").
 		Preload("User").
 		Where("issue_id = ? AND user_id = ?", issueID, userID).
@@ -169,7 +170,7 @@ func (r *issueAssigneeRepository) GetByIssueAndRole(ctx context.Context, issueID
 	)
 
 	var assignees []*domain.IssueAssignee
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Issue").
 		Preload("User").
 		Where("issue_id = ? AND role = ?", issueID, role).
@@ -199,7 +200,7 @@ func (r *issueAssigneeRepository) Update(ctx context.Context, assignee *domain.I
 		zap.String("role", assignee.Role.String()),
 	)
 
-	result := r.db.WithContext(ctx).Save(assignee)
+	result := conn(ctx, r.db).Save(assignee)
 	if result.Error != nil {
 		r.logger.Error("Failed to update issue assignee",
 			zap.Error(result.Error),
@@ -225,7 +226,7 @@ func (r *issueAssigneeRepository) Update(ctx context.Context, assignee *domain.I
 func (r *issueAssigneeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.logger.Debug("Deleting issue assignee", zap.String("assignee_id", id.String()))
 
-	result := r.db.WithContext(ctx).Delete(&domain.IssueAssignee{}, "id = ?", id)
+	result := conn(ctx, r.db).Delete(&domain.IssueAssignee{}, "id = ?", id)
 	if result.Error != nil {
 		r.logger.Error("Failed to delete issue assignee",
 			zap.Error(result.Error),
@@ -250,7 +251,7 @@ func (r *issueAssigneeRepository) DeleteByIssueAndUser(ctx context.Context, issu
 		zap.String("user_id", userID.String()),
 	)
 
-	result := r.db.WithContext(ctx).Delete(&domain.IssueAssignee{}, "issue_id = ? AND user_id = ?", issueID, userID)
+	result := conn(ctx, r.db).Delete(&domain.IssueAssignee{}, "issue_id = ? AND user_id = ?", issueID, userID)
 	if result.Error != nil {
 		r.logger.Error("Failed to delete assignments by issue and user",
 			zap.Error(result.Error),
@@ -277,7 +278,7 @@ func (r *issueAssigneeRepository) DeleteByIssueAndUserAndRole(ctx context.Contex
 		zap.String("role", role.String()),
 	)
 
-	result := r.db.WithContext(ctx).Delete(&domain.IssueAssignee{}, "issue_id = ? AND user_id = ? AND role = ?", issueID, userID, role)
+	result := conn(ctx, r.db).Delete(&domain.IssueAssignee{}, "issue_id = ? AND user_id = ? AND role = ?", issueID, userID, role)
 	if result.Error != nil {
 		r.logger.Error("Failed to delete assignment by issue, user, and role",
 			zap.Error(result.Error),
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// issueAttachmentRepository implements the IssueAttachmentRepository interface
+type issueAttachmentRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueAttachmentRepository creates a new instance of issue attachment repository
+func NewIssueAttachmentRepository(db *gorm.DB, logger *zap.Logger) domain.IssueAttachmentRepository {
+	return &issueAttachmentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new attachment in the database
+func (r *issueAttachmentRepository) Create(ctx context.Context, attachment *domain.IssueAttachment) error {
+	r.logger.Debug("Creating issue attachment",
+		zap.String("issue_id", attachment.IssueID.String()),
+		zap.String("file_name", attachment.FileName),
+	)
+
+	if err := conn(ctx, r.db).Create(attachment).Error; err != nil {
+		r.logger.Error("Failed to create issue attachment",
+			zap.Error(err),
+			zap.String("issue_id", attachment.IssueID.String()),
+		)
+		return fmt.Errorf("failed to create issue attachment: %w", err)
+	}
+
+	r.logger.Debug("Issue attachment created successfully",
+		zap.String("attachment_id", attachment.ID.String()),
+		zap.String("issue_id", attachment.IssueID.String()),
+	)
+
+	return nil
+}
+
+// GetByIssueID retrieves all attachments of an issue, oldest first
+func (r *issueAttachmentRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueAttachment, error) {
+	r.logger.Debug("Retrieving attachments by issue ID", zap.String("issue_id", issueID.String()))
+
+	var attachments []*domain.IssueAttachment
+	if err := conn(ctx, r.db).
+		Preload("UploadedBy").
+		Where("issue_id = ?", issueID).
+		Order("created_at ASC").
+		Find(&attachments).Error; err != nil {
+		r.logger.Error("Failed to retrieve issue attachments",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue attachments: %w", err)
+	}
+
+	r.logger.Debug("Issue attachments retrieved successfully", zap.Int("count", len(attachments)))
+	return attachments, nil
+}
+
+// Delete removes an attachment from the database
+func (r *issueAttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting issue attachment", zap.String("attachment_id", id.String()))
+
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.IssueAttachment{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete issue attachment",
+			zap.Error(result.Error),
+			zap.String("attachment_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete issue attachment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Issue attachment not found for deletion", zap.String("attachment_id", id.String()))
+		return domain.ErrAttachmentNotFound
+	}
+
+	r.logger.Info("Issue attachment deleted successfully", zap.String("attachment_id", id.String()))
+	return nil
+}
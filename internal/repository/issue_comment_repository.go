@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// issueCommentRepository implements the IssueCommentRepository interface
+type issueCommentRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueCommentRepository creates a new instance of issue comment repository
+func NewIssueCommentRepository(db *gorm.DB, logger *zap.Logger) domain.IssueCommentRepository {
+	return &issueCommentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new comment in the database
+func (r *issueCommentRepository) Create(ctx context.Context, comment *domain.IssueComment) error {
+	r.logger.Debug("Creating issue comment",
+		zap.String("issue_id", comment.IssueID.String()),
+		zap.String("discord_message_id", comment.DiscordMessageID),
+	)
+
+	if err := conn(ctx, r.db).Create(comment).Error; err != nil {
+		r.logger.Error("Failed to create issue comment",
+			zap.Error(err),
+			zap.String("issue_id", comment.IssueID.String()),
+		)
+		return fmt.Errorf("failed to create issue comment: %w", err)
+	}
+
+	r.logger.Debug("Issue comment created successfully",
+		zap.String("comment_id", comment.ID.String()),
+		zap.String("issue_id", comment.IssueID.String()),
+	)
+
+	return nil
+}
+
+// GetByIssueID retrieves all comments of an issue, oldest first
+func (r *issueCommentRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueComment, error) {
+	r.logger.Debug("Retrieving comments by issue ID", zap.String("issue_id", issueID.String()))
+
+	var comments []*domain.IssueComment
+	if err := conn(ctx, r.db).
+		Preload("Author").
+		Where("issue_id = ?", issueID).
+		Order("created_at ASC").
+		Find(&comments).Error; err != nil {
+		r.logger.Error("Failed to retrieve issue comments",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue comments: %w", err)
+	}
+
+	r.logger.Debug("Issue comments retrieved successfully", zap.Int("count", len(comments)))
+	return comments, nil
+}
+
+// Delete removes a comment from the database
+func (r *issueCommentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting issue comment", zap.String("comment_id", id.String()))
+
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.IssueComment{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete issue comment",
+			zap.Error(result.Error),
+			zap.String("comment_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete issue comment: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Issue comment not found for deletion", zap.String("comment_id", id.String()))
+		return domain.ErrCommentNotFound
+	}
+
+	r.logger.Info("Issue comment deleted successfully", zap.String("comment_id", id.String()))
+	return nil
+}
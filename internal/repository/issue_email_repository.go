@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// issueEmailRepository implements the IssueEmailRepository interface
+type issueEmailRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueEmailRepository creates a new instance of issue email repository
+func NewIssueEmailRepository(db *gorm.DB, logger *zap.Logger) domain.IssueEmailRepository {
+	return &issueEmailRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create records the Message-ID of an email of an issue
+func (r *issueEmailRepository) Create(ctx context.Context, email *domain.IssueEmail) error {
+	r.logger.Debug("Recording issue email",
+		zap.String("issue_id", email.IssueID.String()),
+		zap.String("message_id", email.MessageID),
+	)
+
+	if err := conn(ctx, r.db).Create(email).Error; err != nil {
+		r.logger.Error("Failed to record issue email",
+			zap.Error(err),
+			zap.String("issue_id", email.IssueID.String()),
+			zap.String("message_id", email.MessageID),
+		)
+		return fmt.Errorf("failed to record issue email: %w", err)
+	}
+
+	return nil
+}
+
+// GetIssueID retrieves the issue of the first of the Message-IDs that belongs to one
+func (r *issueEmailRepository) GetIssueID(ctx context.Context, messageIDs []string) (uuid.UUID, error) {
+	if len(messageIDs) == 0 {
+		return uuid.Nil, domain.ErrIssueNotFound
+	}
+
+	var emails []*domain.IssueEmail
+	if err := conn(ctx, r.db).Where("message_id IN ?", messageIDs).Find(&emails).Error; err != nil {
+		r.logger.Error("Failed to retrieve issue emails",
+			zap.Error(err),
+			zap.Int("message_ids", len(messageIDs)),
+		)
+		return uuid.Nil, fmt.Errorf("failed to retrieve issue emails: %w", err)
+	}
+
+	for _, id := range messageIDs {
+		for _, email := range emails {
+			if email.MessageID == id {
+				return email.IssueID, nil
+			}
+		}
+	}
+	return uuid.Nil, domain.ErrIssueNotFound
+}
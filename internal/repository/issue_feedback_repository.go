@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// issueFeedbackRepository implements the IssueFeedbackRepository interface
+type issueFeedbackRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueFeedbackRepository creates a new instance of issue feedback repository
+func NewIssueFeedbackRepository(db *gorm.DB, logger *zap.Logger) domain.IssueFeedbackRepository {
+	return &issueFeedbackRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// SaveRating creates the feedback of an issue or replaces the rating of the stored one.
+// A stored comment is kept.
+func (r *issueFeedbackRepository) SaveRating(ctx context.Context, feedback *domain.IssueFeedback) error {
+	r.logger.Debug("Saving issue feedback rating",
+		zap.String("issue_id", feedback.IssueID.String()),
+		zap.Int("rating", feedback.Rating),
+	)
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "issue_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "rating", "updated_at"}),
+		}).
+		Create(feedback).Error; err != nil {
+		r.logger.Error("Failed to save issue feedback rating",
+			zap.Error(err),
+			zap.String("issue_id", feedback.IssueID.String()),
+		)
+		return fmt.Errorf("failed to save issue feedback rating: %w", err)
+	}
+
+	return nil
+}
+
+// GetByIssueID retrieves the feedback of an issue
+func (r *issueFeedbackRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) (*domain.IssueFeedback, error) {
+	r.logger.Debug("Retrieving issue feedback", zap.String("issue_id", issueID.String()))
+
+	var feedback domain.IssueFeedback
+	if err := conn(ctx, r.db).Where("issue_id = ?", issueID).First(&feedback).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrFeedbackNotFound
+		}
+		r.logger.Error("Failed to retrieve issue feedback",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue feedback: %w", err)
+	}
+
+	return &feedback, nil
+}
+
+// UpdateComment stores the comment of an issue's feedback
+func (r *issueFeedbackRepository) UpdateComment(ctx context.Context, issueID uuid.UUID, comment string) error {
+	r.logger.Debug("Updating issue feedback comment", zap.String("issue_id", issueID.String()))
+
+	result := conn(ctx, r.db).Model(&domain.IssueFeedback{}).
+		Where("issue_id = ?", issueID).
+		Updates(map[string]interface{}{"comment": comment, "updated_at": time.Now()})
+	if result.Error != nil {
+		r.logger.Error("Failed to update issue feedback comment",
+			zap.Error(result.Error),
+			zap.String("issue_id", issueID.String()),
+		)
+		return fmt.Errorf("failed to update issue feedback comment: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrFeedbackNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// maxKeyPrefixCandidates bounds the search for an unused issue key prefix
+const maxKeyPrefixCandidates = 100
+
+// availableKeyPrefix returns the first key prefix derived from name that no
+// project uses, including soft-deleted projects whose keys may come back
+func availableKeyPrefix(ctx context.Context, db *gorm.DB, name string) (string, error) {
+	for _, candidate := range domain.KeyPrefixCandidates(name, maxKeyPrefixCandidates) {
+		var count int64
+		if err := db.WithContext(ctx).
+			Unscoped().
+			Model(&domain.Project{}).
+			Where("key_prefix = ?", candidate).
+			Count(&count).Error; err != nil {
+			return "", fmt.Errorf("failed to check key prefix: %w", err)
+		}
+		if count == 0 {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("no free key prefix for project %q", name)
+}
+
+// backfillIssueKeys gives every project without a key prefix one and numbers
+// its existing issues in creation order, so issues created before keys existed
+// can be looked up by key too
+func backfillIssueKeys(ctx context.Context, db *gorm.DB, logger *zap.Logger) error {
+	var projects []*domain.Project
+	if err := db.WithContext(ctx).Unscoped().Where("key_prefix = '' OR key_prefix IS NULL").Find(&projects).Error; err != nil {
+		return fmt.Errorf("failed to list projects without key prefix: %w", err)
+	}
+
+	for _, project := range projects {
+		var prefix string
+		var issues []*domain.Issue
+
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var err error
+			if prefix, err = availableKeyPrefix(ctx, tx, project.Name); err != nil {
+				return err
+			}
+
+			if err := tx.Unscoped().
+				Select("id").
+				Where("project_id = ?", project.ID).
+				Order("created_at ASC, id ASC").
+				Find(&issues).Error; err != nil {
+				return fmt.Errorf("failed to list project issues: %w", err)
+			}
+
+			for n, issue := range issues {
+				number := n + 1
+				if err := tx.Unscoped().Model(&domain.Issue{}).Where("id = ?", issue.ID).UpdateColumns(map[string]interface{}{
+					"number":    number,
+					"issue_key": domain.FormatIssueKey(prefix, number),
+				}).Error; err != nil {
+					return fmt.Errorf("failed to set issue key: %w", err)
+				}
+			}
+
+			return tx.Unscoped().Model(&domain.Project{}).Where("id = ?", project.ID).UpdateColumns(map[string]interface{}{
+				"key_prefix":    prefix,
+				"issue_counter": len(issues),
+			}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to backfill issue keys of project %s: %w", project.ID, err)
+		}
+
+		logger.Info("Backfilled issue keys",
+			zap.String("project_id", project.ID.String()),
+			zap.String("key_prefix", prefix),
+			zap.Int("issues", len(issues)),
+		)
+	}
+
+	return nil
+}
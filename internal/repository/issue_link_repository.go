@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// issueLinkRepository implements the IssueLinkRepository interface
+type issueLinkRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueLinkRepository creates a new instance of issue link repository
+func NewIssueLinkRepository(db *gorm.DB, logger *zap.Logger) domain.IssueLinkRepository {
+	return &issueLinkRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new issue link in the database
+func (r *issueLinkRepository) Create(ctx context.Context, link *domain.IssueLink) error {
+	r.logger.Debug("Creating issue link",
+		zap.String("source_issue_id", link.SourceIssueID.String()),
+		zap.String("target_issue_id", link.TargetIssueID.String()),
+		zap.String("type", string(link.Type)),
+	)
+
+	if err := conn(ctx, r.db).Create(link).Error; err != nil {
+		r.logger.Error("Failed to create issue link",
+			zap.Error(err),
+			zap.String("source_issue_id", link.SourceIssueID.String()),
+			zap.String("target_issue_id", link.TargetIssueID.String()),
+		)
+		return fmt.Errorf("failed to create issue link: %w", err)
+	}
+
+	r.logger.Info("Issue link created successfully",
+		zap.String("link_id", link.ID.String()),
+		zap.String("type", string(link.Type)),
+	)
+
+	return nil
+}
+
+// GetBetween retrieves the links between two issues in either direction
+func (r *issueLinkRepository) GetBetween(ctx context.Context, issueID, otherIssueID uuid.UUID) ([]*domain.IssueLink, error) {
+	r.logger.Debug("Retrieving issue links between issues",
+		zap.String("issue_id", issueID.String()),
+		zap.String("other_issue_id", otherIssueID.String()),
+	)
+
+	var links []*domain.IssueLink
+	if err := r.between(conn(ctx, r.db), issueID, otherIssueID).
+		Order("created_at ASC").
+		Find(&links).Error; err != nil {
+		r.logger.Error("Failed to retrieve issue links between issues",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("other_issue_id", otherIssueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue links: %w", err)
+	}
+
+	return links, nil
+}
+
+// DeleteBetween removes the links between two issues in either direction, only those of
+// linkType when it is not empty, and returns the number removed
+func (r *issueLinkRepository) DeleteBetween(ctx context.Context, issueID, otherIssueID uuid.UUID, linkType domain.LinkType) (int64, error) {
+	r.logger.Debug("Deleting issue links between issues",
+		zap.String("issue_id", issueID.String()),
+		zap.String("other_issue_id", otherIssueID.String()),
+		zap.String("type", string(linkType)),
+	)
+
+	query := r.between(conn(ctx, r.db), issueID, otherIssueID)
+	if linkType != "" {
+		query = query.Where("type = ?", linkType)
+	}
+
+	result := query.Delete(&domain.IssueLink{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete issue links between issues",
+			zap.Error(result.Error),
+			zap.String("issue_id", issueID.String()),
+			zap.String("other_issue_id", otherIssueID.String()),
+		)
+		return 0, fmt.Errorf("failed to delete issue links: %w", result.Error)
+	}
+
+	r.logger.Info("Issue links deleted successfully",
+		zap.String("issue_id", issueID.String()),
+		zap.String("other_issue_id", otherIssueID.String()),
+		zap.Int64("count", result.RowsAffected),
+	)
+
+	return result.RowsAffected, nil
+}
+
+// between scopes a query to the links between two issues in either direction
+func (r *issueLinkRepository) between(db *gorm.DB, issueID, otherIssueID uuid.UUID) *gorm.DB {
+	return db.Where("(source_issue_id = ? AND target_issue_id = ?) OR (source_issue_id = ? AND target_issue_id = ?)",
+		issueID, otherIssueID, otherIssueID, issueID)
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"fix-track-bot/internal/domain"
 
@@ -11,6 +13,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// maxPartialIDMatches bounds the number of issues returned by a partial ID search
+const maxPartialIDMatches = 10
+
 // issueRepository implements the IssueRepository interface
 type issueRepository struct {
 	db     *gorm.DB
@@ -34,7 +39,7 @@ func (r *issueRepository) Create(ctx context.Context, issue *domain.Issue) error
 		zap.String("source", issue.Source),
 	)
 
-	if err := r.db.WithContext(ctx).Create(issue).Error; err != nil {
+	if err := conn(ctx, r.db).Create(issue).Error; err != nil {
 		r.logger.Error("Failed to create issue",
 			zap.Error(err),
 			zap.String("title", issue.Title),
@@ -55,9 +60,15 @@ func (r *issueRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Is
 	r.logger.Debug("Retrieving issue by ID", zap.String("issue_id", id.String()))
 
 	var issue domain.Issue
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Project").
 		Preload("Project.Customer").
+		Preload("Project.Workflow.Statuses", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Project.Workflow.Transitions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
 		Preload("Channel").
 		Preload("Channel.Project").
 		Preload("Channel.Project.Customer").
@@ -65,6 +76,33 @@ func (r *issueRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Is
 		Preload("Assignee").
 		Preload("Assignees").
 		Preload("Assignees.User").
+		Preload("SuggestedAssignees", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("SuggestedAssignees.User").
+		Preload("Attachments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Worklogs").
+		Preload("Milestone").
+		Preload("Component").
+		Preload("Labels", func(db *gorm.DB) *gorm.DB {
+			return db.Order("labels.name ASC")
+		}).
+		Preload("CustomFieldValues").
+		Preload("CustomFieldValues.Field").
+		Preload("OutgoingLinks", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("OutgoingLinks.TargetIssue").
+		Preload("IncomingLinks", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("IncomingLinks.SourceIssue").
+		Preload("ParentIssue").
+		Preload("SubIssues", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
 		Where("id = ?", id).
 		First(&issue).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -82,12 +120,12 @@ func (r *issueRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Is
 	return &issue, nil
 }
 
-// GetByChannelID retrieves all issues for a specific channel by UUID
-func (r *issueRepository) GetByChannelID(ctx context.Context, channelID uuid.UUID) ([]*domain.Issue, error) {
-	r.logger.Debug("Retrieving issues by channel ID", zap.String("channel_id", channelID.String()))
+// GetByDiscordChannelID retrieves all issues for a specific Discord channel by string ID
+func (r *issueRepository) GetByDiscordChannelID(ctx context.Context, discordChannelID string) ([]*domain.Issue, error) {
+	r.logger.Debug("Retrieving issues by Discord channel ID", zap.String("discord_channel_id", discordChannelID))
 
 	var issues []*domain.Issue
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Project").
 		Preload("Project.Customer").
 		Preload("Channel").
@@ -95,50 +133,193 @@ func (r *issueRepository) GetByChannelID(ctx context.Context, channelID uuid.UUI
 		Preload("Channel.Project.Customer").
 		Preload("Reporter").
 		Preload("Assignee").
-		Where("channel_id = ?", channelID).
-		Order("created_at DESC").
+		Joins("JOIN channels ON issues.channel_id = channels.id AND channels.deleted_at IS NULL").
+		Where("channels.discord_channel_id = ?", discordChannelID).
+		Order("issues.created_at DESC").
 		Find(&issues).Error; err != nil {
-		r.logger.Error("Failed to retrieve issues by channel ID",
+		r.logger.Error("Failed to retrieve issues by Discord channel ID",
 			zap.Error(err),
-			zap.String("channel_id", channelID.String()),
+			zap.String("discord_channel_id", discordChannelID),
 		)
-		return nil, fmt.Errorf("failed to retrieve issues by channel ID: %w", err)
+		return nil, fmt.Errorf("failed to retrieve issues by Discord channel ID: %w", err)
 	}
 
 	r.logger.Debug("Issues retrieved successfully",
-		zap.String("channel_id", channelID.String()),
+		zap.String("discord_channel_id", discordChannelID),
 		zap.Int("count", len(issues)),
 	)
 
 	return issues, nil
 }
 
-// GetByDiscordChannelID retrieves all issues for a specific Discord channel by string ID
-func (r *issueRepository) GetByDiscordChannelID(ctx context.Context, discordChannelID string) ([]*domain.Issue, error) {
-	r.logger.Debug("Retrieving issues by Discord channel ID", zap.String("discord_channel_id", discordChannelID))
+// issueSortOrders maps each sort order to its ORDER BY clause; ties are broken by ID so
+// pages do not overlap
+var issueSortOrders = map[domain.IssueSort]string{
+	domain.IssueSortNewest:   "issues.created_at DESC, issues.id",
+	domain.IssueSortOldest:   "issues.created_at ASC, issues.id",
+	domain.IssueSortUpdated:  "issues.updated_at DESC, issues.id",
+	domain.IssueSortStale:    "issues.updated_at ASC, issues.id",
+	domain.IssueSortPriority: "CASE issues.priority WHEN 'high' THEN 0 WHEN 'medium' THEN 1 ELSE 2 END, issues.created_at DESC, issues.id",
+	domain.IssueSortDueDate:  "issues.due_date IS NULL, issues.due_date ASC, issues.id",
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern; patterns use \ as escape character
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Query retrieves the issues matching a query in its order, with their project and reporter
+// and the requested details, together with the total number of matching issues
+func (r *issueRepository) Query(ctx context.Context, q domain.IssueQuery) ([]*domain.Issue, int64, error) {
+	r.logger.Debug("Querying issues",
+		zap.Any("statuses", q.Statuses),
+		zap.String("priority", string(q.Priority)),
+		zap.String("customer_id", q.CustomerID.String()),
+		zap.String("project_id", q.ProjectID.String()),
+		zap.String("discord_channel_id", q.DiscordChannelID),
+		zap.String("sort", string(q.Sort)),
+		zap.Int("offset", q.Offset),
+		zap.Int("limit", q.Limit),
+	)
+
+	query := r.filter(conn(ctx, r.db).Model(&domain.Issue{}), q)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		r.logger.Error("Failed to count queried issues", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count queried issues: %w", err)
+	}
+
+	order, ok := issueSortOrders[q.Sort]
+	if !ok {
+		order = issueSortOrders[domain.IssueSortNewest]
+	}
+	query = preloadDetails(query.Preload("Project").Preload("Reporter"), q.Details).
+		Order(order).
+		Offset(q.Offset)
+	if q.Limit > 0 {
+		query = query.Limit(q.Limit)
+	}
 
 	var issues []*domain.Issue
-	if err := r.db.WithContext(ctx).
-		Preload("Project").
-		Preload("Project.Customer").
-		Preload("Channel").
-		Preload("Channel.Project").
-		Preload("Channel.Project.Customer").
-		Preload("Reporter").
-		Preload("Assignee").
-		Joins("JOIN channels ON issues.channel_id = channels.id").
-		Where("channels.discord_channel_id = ?", discordChannelID).
-		Order("issues.created_at DESC").
+	if err := query.Find(&issues).Error; err != nil {
+		r.logger.Error("Failed to query issues", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to query issues: %w", err)
+	}
+
+	r.logger.Debug("Issues queried successfully",
+		zap.Int("count", len(issues)),
+		zap.Int64("total", total),
+	)
+
+	return issues, total, nil
+}
+
+// filter narrows an issue query to the issues matching q
+func (r *issueRepository) filter(query *gorm.DB, q domain.IssueQuery) *gorm.DB {
+	if len(q.Statuses) > 0 {
+		query = query.Where("issues.status IN ?", q.Statuses)
+	}
+	if q.Priority != "" {
+		query = query.Where("issues.priority = ?", q.Priority)
+	}
+	if q.CustomerID != uuid.Nil {
+		query = query.Where("issues.project_id IN (?)", r.db.
+			Table("projects").
+			Select("id").
+			Where("customer_id = ?", q.CustomerID))
+	}
+	if q.ProjectID != uuid.Nil {
+		query = query.Where("issues.project_id = ?", q.ProjectID)
+	}
+	if q.ChannelID != uuid.Nil {
+		query = query.Where("issues.channel_id = ?", q.ChannelID)
+	}
+	if q.DiscordChannelID != "" {
+		query = query.
+			Joins("JOIN channels ON issues.channel_id = channels.id AND channels.deleted_at IS NULL").
+			Where("channels.discord_channel_id = ?", q.DiscordChannelID)
+	}
+	if q.MilestoneID != uuid.Nil {
+		query = query.Where("issues.milestone_id = ?", q.MilestoneID)
+	}
+	if q.ComponentID != uuid.Nil {
+		query = query.Where("issues.component_id = ?", q.ComponentID)
+	}
+	if q.AssigneeID != uuid.Nil {
+		query = query.Where("issues.id IN (?)", r.db.
+			Table("issue_assignees").
+			Select("issue_id").
+			Where("user_id = ?", q.AssigneeID))
+	}
+	if q.ReporterID != uuid.Nil {
+		query = query.Where("issues.reporter_id = ?", q.ReporterID)
+	}
+	if q.Source != "" {
+		query = query.Where("issues.source = ?", q.Source)
+	}
+	if !q.CreatedAfter.IsZero() {
+		query = query.Where("issues.created_at >= ?", q.CreatedAfter)
+	}
+	if !q.CreatedBefore.IsZero() {
+		query = query.Where("issues.created_at < ?", q.CreatedBefore)
+	}
+	if !q.UpdatedBefore.IsZero() {
+		query = query.Where("issues.updated_at < ?", q.UpdatedBefore)
+	}
+	if !q.ClosedAfter.IsZero() {
+		query = query.Where("issues.closed_at >= ?", q.ClosedAfter)
+	}
+	if !q.ClosedBefore.IsZero() {
+		query = query.Where("issues.closed_at < ?", q.ClosedBefore)
+	}
+	if !q.DueBefore.IsZero() {
+		query = query.Where("issues.due_date IS NOT NULL AND issues.due_date <= ?", q.DueBefore)
+	}
+	if text := strings.TrimSpace(q.Text); text != "" {
+		pattern := "%" + likeEscaper.Replace(strings.ToLower(text)) + "%"
+		query = query.Where(`(LOWER(issues.issue_key) LIKE ? ESCAPE '\' OR LOWER(issues.title) LIKE ? ESCAPE '\' OR LOWER(issues.description) LIKE ? ESCAPE '\')`,
+			pattern, pattern, pattern)
+	}
+	return query
+}
+
+// preloadDetails loads the relationships picked by details with the issues of a query
+func preloadDetails(query *gorm.DB, details domain.IssueDetails) *gorm.DB {
+	if details&domain.IssueDetailsAssignees != 0 {
+		query = query.Preload("Assignees").Preload("Assignees.User")
+	}
+	if details&domain.IssueDetailsHistory != 0 {
+		query = query.
+			Preload("Labels").
+			Preload("CustomFieldValues").
+			Preload("Worklogs").
+			Preload("Worklogs.User").
+			Preload("Milestone").
+			Preload("StatusLogs", func(db *gorm.DB) *gorm.DB {
+				return db.Order("changed_at ASC")
+			}).
+			Preload("StatusLogs.ChangedByUser")
+	}
+	return query
+}
+
+// GetOpenByProjectID retrieves the issues of a project that are not closed, without relationships, newest first
+func (r *issueRepository) GetOpenByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Issue, error) {
+	r.logger.Debug("Retrieving open issues by project ID", zap.String("project_id", projectID.String()))
+
+	var issues []*domain.Issue
+	if err := conn(ctx, r.db).
+		Where("project_id = ? AND status <> ?", projectID, domain.StatusClosed).
+		Order("created_at DESC").
 		Find(&issues).Error; err != nil {
-		r.logger.Error("Failed to retrieve issues by Discord channel ID",
+		r.logger.Error("Failed to retrieve open issues by project ID",
 			zap.Error(err),
-			zap.String("discord_channel_id", discordChannelID),
+			zap.String("project_id", projectID.String()),
 		)
-		return nil, fmt.Errorf("failed to retrieve issues by Discord channel ID: %w", err)
+		return nil, fmt.Errorf("failed to retrieve open issues by project ID: %w", err)
 	}
 
-	r.logger.Debug("Issues retrieved successfully",
-		zap.String("discord_channel_id", discordChannelID),
+	r.logger.Debug("Open issues retrieved successfully",
+		zap.String("project_id", projectID.String()),
 		zap.Int("count", len(issues)),
 	)
 
@@ -150,7 +331,7 @@ func (r *issueRepository) GetByStatus(ctx context.Context, status domain.Status)
 	r.logger.Debug("Retrieving issues by status", zap.String("status", string(status)))
 
 	var issues []*domain.Issue
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Project").
 		Preload("Project.Customer").
 		Preload("Channel").
@@ -176,11 +357,615 @@ func (r *issueRepository) GetByStatus(ctx context.Context, status domain.Status)
 	return issues, nil
 }
 
+// GetByStatuses retrieves all issues whose status is one of the given statuses
+func (r *issueRepository) GetByStatuses(ctx context.Context, statuses []domain.Status) ([]*domain.Issue, error) {
+	r.logger.Debug("Retrieving issues by statuses", zap.Int("status_count", len(statuses)))
+
+	var issues []*domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Channel").
+		Preload("Reporter").
+		Preload("Assignees").
+		Preload("Assignees.User").
+		Where("status IN ?", statuses).
+		Order("created_at ASC").
+		Find(&issues).Error; err != nil {
+		r.logger.Error("Failed to retrieve issues by statuses", zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve issues by statuses: %w", err)
+	}
+
+	r.logger.Debug("Issues retrieved successfully", zap.Int("count", len(issues)))
+	return issues, nil
+}
+
+// GetByPublicHash retrieves an issue by its public share hash
+func (r *issueRepository) GetByPublicHash(ctx context.Context, hash string) (*domain.Issue, error) {
+	r.logger.Debug("Retrieving issue by public hash", zap.String("public_hash", hash))
+
+	var issue domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Project.Customer").
+		Preload("StatusLogs", func(db *gorm.DB) *gorm.DB {
+			return db.Order("changed_at ASC")
+		}).
+		Where("public_hash = ?", hash).
+		First(&issue).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Issue not found by public hash", zap.String("public_hash", hash))
+			return nil, domain.ErrIssueNotFound
+		}
+		r.logger.Error("Failed to retrieve issue by public hash",
+			zap.Error(err),
+			zap.String("public_hash", hash),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue by public hash: %w", err)
+	}
+
+	r.logger.Debug("Issue retrieved successfully by public hash", zap.String("issue_id", issue.ID.String()))
+	return &issue, nil
+}
+
+// GetByIssueKey retrieves an issue by its key with the same relationships as GetByID
+func (r *issueRepository) GetByIssueKey(ctx context.Context, key string) (*domain.Issue, error) {
+	r.logger.Debug("Retrieving issue by key", zap.String("issue_key", key))
+
+	var issue domain.Issue
+	if err := conn(ctx, r.db).Select("id").Where("issue_key = ?", key).First(&issue).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Issue not found by key", zap.String("issue_key", key))
+			return nil, domain.ErrIssueNotFound
+		}
+		r.logger.Error("Failed to retrieve issue by key",
+			zap.Error(err),
+			zap.String("issue_key", key),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue by key: %w", err)
+	}
+
+	return r.GetByID(ctx, issue.ID)
+}
+
+// SearchByPartialID retrieves up to maxPartialIDMatches issues whose ID or key starts with prefix.
+// An ID prefix is turned into an ID range so the lookup uses the primary key index.
+func (r *issueRepository) SearchByPartialID(ctx context.Context, prefix, channelID string) ([]*domain.Issue, error) {
+	r.logger.Debug("Searching issues by partial ID",
+		zap.String("prefix", prefix),
+		zap.String("discord_channel_id", channelID),
+	)
+
+	match := conn(ctx, r.db)
+	matchable := false
+	if lower, upper, ok := domain.IDPrefixRange(prefix); ok {
+		match = match.Or("issues.id BETWEEN ? AND ?", lower, upper)
+		matchable = true
+	}
+	if keyStart, ok := domain.NormalizeIssueKeyStart(prefix); ok {
+		// Key starts only hold letters, digits and a dash, so they need no LIKE escaping
+		match = match.Or("issues.issue_key LIKE ?", keyStart+"%")
+		matchable = true
+	}
+	if !matchable {
+		return []*domain.Issue{}, nil
+	}
+
+	query := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Channel").
+		Where(match)
+	if channelID != "" {
+		query = query.
+			Joins("JOIN channels ON issues.channel_id = channels.id AND channels.deleted_at IS NULL").
+			Where("channels.discord_channel_id = ?", channelID)
+	}
+
+	var issues []*domain.Issue
+	if err := query.Order("issues.created_at DESC").Limit(maxPartialIDMatches).Find(&issues).Error; err != nil {
+		r.logger.Error("Failed to search issues by partial ID",
+			zap.Error(err),
+			zap.String("prefix", prefix),
+		)
+		return nil, fmt.Errorf("failed to search issues by partial ID: %w", err)
+	}
+
+	r.logger.Debug("Partial ID search completed",
+		zap.String("prefix", prefix),
+		zap.Int("count", len(issues)),
+	)
+
+	return issues, nil
+}
+
+// GetByThreadID retrieves an issue by its Discord thread ID
+func (r *issueRepository) GetByThreadID(ctx context.Context, threadID string) (*domain.Issue, error) {
+	r.logger.Debug("Retrieving issue by thread ID", zap.String("thread_id", threadID))
+
+	var issue domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Channel").
+		Preload("Reporter").
+		Preload("Assignees").
+		Preload("Assignees.User").
+		Where("thread_id = ?", threadID).
+		First(&issue).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Issue not found by thread ID", zap.String("thread_id", threadID))
+			return nil, domain.ErrIssueNotFound
+		}
+		r.logger.Error("Failed to retrieve issue by thread ID",
+			zap.Error(err),
+			zap.String("thread_id", threadID),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue by thread ID: %w", err)
+	}
+
+	r.logger.Debug("Issue retrieved successfully by thread ID", zap.String("issue_id", issue.ID.String()))
+	return &issue, nil
+}
+
+// GetByMessageID retrieves an issue by the Discord message ID of its card
+func (r *issueRepository) GetByMessageID(ctx context.Context, messageID string) (*domain.Issue, error) {
+	r.logger.Debug("Retrieving issue by message ID", zap.String("message_id", messageID))
+
+	var issue domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Channel").
+		Preload("Reporter").
+		Where("message_id = ?", messageID).
+		First(&issue).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Issue not found by message ID", zap.String("message_id", messageID))
+			return nil, domain.ErrIssueNotFound
+		}
+		r.logger.Error("Failed to retrieve issue by message ID",
+			zap.Error(err),
+			zap.String("message_id", messageID),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue by message ID: %w", err)
+	}
+
+	r.logger.Debug("Issue retrieved successfully by message ID", zap.String("issue_id", issue.ID.String()))
+	return &issue, nil
+}
+
+// CountOpenSubIssues counts the sub-tasks of an issue that are not closed
+func (r *issueRepository) CountOpenSubIssues(ctx context.Context, parentID uuid.UUID) (int64, error) {
+	var count int64
+	if err := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("parent_issue_id = ? AND status <> ?", parentID, domain.StatusClosed).
+		Count(&count).Error; err != nil {
+		r.logger.Error("Failed to count open sub-issues",
+			zap.Error(err),
+			zap.String("parent_issue_id", parentID.String()),
+		)
+		return 0, fmt.Errorf("failed to count open sub-issues: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountByProjectIDAndStatuses counts a project's issues that have one of the statuses
+func (r *issueRepository) CountByProjectIDAndStatuses(ctx context.Context, projectID uuid.UUID, statuses []domain.Status) (int64, error) {
+	var count int64
+	if err := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("project_id = ? AND status IN ?", projectID, statuses).
+		Count(&count).Error; err != nil {
+		r.logger.Error("Failed to count issues by status",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return 0, fmt.Errorf("failed to count issues by status: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByGitHubIssue retrieves an issue mirrored to the given GitHub repository and issue number
+func (r *issueRepository) GetByGitHubIssue(ctx context.Context, repo string, number int) (*domain.Issue, error) {
+	r.logger.Debug("Retrieving issue by GitHub issue",
+		zap.String("repo", repo),
+		zap.Int("number", number),
+	)
+
+	var issue domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Channel").
+		Joins("JOIN projects ON projects.id = issues.project_id AND projects.deleted_at IS NULL").
+		Where("projects.github_repo = ? AND issues.github_issue_number = ?", repo, number).
+		First(&issue).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Issue not found by GitHub issue",
+				zap.String("repo", repo),
+				zap.Int("number", number),
+			)
+			return nil, domain.ErrIssueNotFound
+		}
+		r.logger.Error("Failed to retrieve issue by GitHub issue",
+			zap.Error(err),
+			zap.String("repo", repo),
+			zap.Int("number", number),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue by GitHub issue: %w", err)
+	}
+
+	r.logger.Debug("Issue retrieved successfully by GitHub issue", zap.String("issue_id", issue.ID.String()))
+	return &issue, nil
+}
+
+// SetGitHubIssueNumber stores the number of the GitHub issue mirroring an issue
+func (r *issueRepository) SetGitHubIssueNumber(ctx context.Context, id uuid.UUID, number int) error {
+	r.logger.Debug("Setting GitHub issue number",
+		zap.String("issue_id", id.String()),
+		zap.Int("number", number),
+	)
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		UpdateColumn("github_issue_number", number)
+	if result.Error != nil {
+		r.logger.Error("Failed to set GitHub issue number",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to set GitHub issue number: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// GetOpenJiraIssues retrieves the issues mirrored to Jira that are not closed
+func (r *issueRepository) GetOpenJiraIssues(ctx context.Context) ([]*domain.Issue, error) {
+	r.logger.Debug("Retrieving open Jira issues")
+
+	var issues []*domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Where("jira_key <> '' AND status <> ?", domain.StatusClosed).
+		Order("created_at ASC").
+		Find(&issues).Error; err != nil {
+		r.logger.Error("Failed to retrieve open Jira issues", zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve open Jira issues: %w", err)
+	}
+
+	return issues, nil
+}
+
+// SetJiraKey stores the key of the Jira ticket mirroring an issue
+func (r *issueRepository) SetJiraKey(ctx context.Context, id uuid.UUID, key string) error {
+	return r.setJiraColumn(ctx, id, "jira_key", key)
+}
+
+// SetJiraStatus stores the last Jira status seen for an issue
+func (r *issueRepository) SetJiraStatus(ctx context.Context, id uuid.UUID, status string) error {
+	return r.setJiraColumn(ctx, id, "jira_status", status)
+}
+
+// setJiraColumn updates a single Jira mirroring column without touching updated_at
+func (r *issueRepository) setJiraColumn(ctx context.Context, id uuid.UUID, column, value string) error {
+	r.logger.Debug("Setting Jira field",
+		zap.String("issue_id", id.String()),
+		zap.String("column", column),
+		zap.String("value", value),
+	)
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		UpdateColumn(column, value)
+	if result.Error != nil {
+		r.logger.Error("Failed to set Jira field",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+			zap.String("column", column),
+		)
+		return fmt.Errorf("failed to set %s: %w", column, result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// GetLastActivity retrieves the time of the latest status change or thread comment of
+// each of the issues
+func (r *issueRepository) GetLastActivity(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	activity := make(map[uuid.UUID]time.Time, len(ids))
+	if len(ids) == 0 {
+		return activity, nil
+	}
+
+	sources := []struct {
+		table  string
+		column string
+	}{
+		{table: domain.IssueStatusLog{}.TableName(), column: "changed_at"},
+		{table: domain.IssueComment{}.TableName(), column: "created_at"},
+	}
+	for _, source := range sources {
+		var rows []struct {
+			IssueID uuid.UUID
+			Last    time.Time
+		}
+		if err := conn(ctx, r.db).
+			Table(source.table).
+			Select("issue_id, MAX("+source.column+") AS last").
+			Where("issue_id IN ?", ids).
+			Group("issue_id").
+			Scan(&rows).Error; err != nil {
+			r.logger.Error("Failed to retrieve last issue activity",
+				zap.Error(err),
+				zap.String("table", source.table),
+			)
+			return nil, fmt.Errorf("failed to retrieve last issue activity: %w", err)
+		}
+
+		for _, row := range rows {
+			if row.Last.After(activity[row.IssueID]) {
+				activity[row.IssueID] = row.Last
+			}
+		}
+	}
+
+	return activity, nil
+}
+
+// SetStaleNudgedAt stores when an issue was last nudged without touching updated_at
+func (r *issueRepository) SetStaleNudgedAt(ctx context.Context, id uuid.UUID, at time.Time) error {
+	r.logger.Debug("Setting stale nudge time",
+		zap.String("issue_id", id.String()),
+		zap.Time("stale_nudged_at", at),
+	)
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		UpdateColumn("stale_nudged_at", at)
+	if result.Error != nil {
+		r.logger.Error("Failed to set stale nudge time",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to set stale nudge time: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// SetDueDate stores the due date of an issue, or removes it for nil, and forgets the
+// reminders sent for the previous one
+func (r *issueRepository) SetDueDate(ctx context.Context, id uuid.UUID, due *time.Time) error {
+	r.logger.Debug("Setting issue due date", zap.String("issue_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"due_date":          due,
+			"due_reminder_sent": domain.DueReminderNone,
+		})
+	if result.Error != nil {
+		r.logger.Error("Failed to set issue due date",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to set issue due date: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// GetDueBefore retrieves unresolved issues due before the given time with their channels
+// and assignees, earliest due first
+func (r *issueRepository) GetDueBefore(ctx context.Context, before time.Time) ([]*domain.Issue, error) {
+	r.logger.Debug("Retrieving issues due before", zap.Time("before", before))
+
+	var issues []*domain.Issue
+	if err := conn(ctx, r.db).
+		Preload("Project").
+		Preload("Channel").
+		Preload("Assignees").
+		Preload("Assignees.User").
+		Where("due_date IS NOT NULL AND due_date <= ?", before).
+		Where("status IN ?", domain.UnresolvedStatuses()).
+		Order("due_date ASC").
+		Find(&issues).Error; err != nil {
+		r.logger.Error("Failed to retrieve issues due before", zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve issues due before: %w", err)
+	}
+
+	return issues, nil
+}
+
+// SetDueReminderSent stores the last due date reminder sent for an issue
+func (r *issueRepository) SetDueReminderSent(ctx context.Context, id uuid.UUID, reminder domain.DueReminder) error {
+	r.logger.Debug("Setting due date reminder",
+		zap.String("issue_id", id.String()),
+		zap.String("reminder", string(reminder)),
+	)
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		UpdateColumn("due_reminder_sent", reminder)
+	if result.Error != nil {
+		r.logger.Error("Failed to set due date reminder",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to set due date reminder: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// SetMilestone adds an issue to a milestone, or removes it from its milestone for nil
+func (r *issueRepository) SetMilestone(ctx context.Context, id uuid.UUID, milestoneID *uuid.UUID) error {
+	r.logger.Debug("Setting issue milestone", zap.String("issue_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		UpdateColumn("milestone_id", milestoneID)
+	if result.Error != nil {
+		r.logger.Error("Failed to set issue milestone",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to set issue milestone: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// SetComponent files an issue under a component, or removes it from its component for nil
+func (r *issueRepository) SetComponent(ctx context.Context, id uuid.UUID, componentID *uuid.UUID) error {
+	r.logger.Debug("Setting issue component", zap.String("issue_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Model(&domain.Issue{}).
+		Where("id = ?", id).
+		UpdateColumn("component_id", componentID)
+	if result.Error != nil {
+		r.logger.Error("Failed to set issue component",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to set issue component: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrIssueNotFound
+	}
+
+	return nil
+}
+
+// Move files an issue under another channel registration and project with a new key. Its
+// card and thread stay behind in the old channel, so their IDs are cleared. Labels,
+// milestones, components and custom fields belong to a project; moving to another one
+// keeps those the new project has one of the same name of and drops the rest.
+func (r *issueRepository) Move(ctx context.Context, id uuid.UUID, channelID, projectID uuid.UUID, number int, key string) error {
+	r.logger.Debug("Moving issue",
+		zap.String("issue_id", id.String()),
+		zap.String("channel_id", channelID.String()),
+		zap.String("project_id", projectID.String()),
+	)
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var issue domain.Issue
+		if err := tx.Select("id", "project_id").Where("id = ?", id).First(&issue).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return domain.ErrIssueNotFound
+			}
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
+
+		if issue.ProjectID != projectID {
+			if err := tx.Exec(`DELETE FROM issue_labels WHERE issue_id = ? AND label_id NOT IN (
+					SELECT moved.id FROM labels moved JOIN labels kept ON kept.name = moved.name WHERE kept.project_id = ?
+				)`, id, projectID).Error; err != nil {
+				return fmt.Errorf("failed to move issue labels: %w", err)
+			}
+			if err := tx.Exec(`UPDATE issue_labels SET label_id = (
+					SELECT kept.id FROM labels kept JOIN labels moved ON moved.name = kept.name
+					WHERE moved.id = issue_labels.label_id AND kept.project_id = ?
+				) WHERE issue_id = ?`, projectID, id).Error; err != nil {
+				return fmt.Errorf("failed to move issue labels: %w", err)
+			}
+
+			if err := tx.Exec(`DELETE FROM issue_custom_field_values WHERE issue_id = ? AND field_id NOT IN (
+					SELECT moved.id FROM custom_field_definitions moved JOIN custom_field_definitions kept ON kept.name = moved.name WHERE kept.project_id = ?
+				)`, id, projectID).Error; err != nil {
+				return fmt.Errorf("failed to move issue custom field values: %w", err)
+			}
+			if err := tx.Exec(`UPDATE issue_custom_field_values SET field_id = (
+					SELECT kept.id FROM custom_field_definitions kept JOIN custom_field_definitions moved ON moved.name = kept.name
+					WHERE moved.id = issue_custom_field_values.field_id AND kept.project_id = ?
+				) WHERE issue_id = ?`, projectID, id).Error; err != nil {
+				return fmt.Errorf("failed to move issue custom field values: %w", err)
+			}
+
+			// No milestone of the same name leaves the issue without one
+			if err := tx.Exec(`UPDATE issues SET milestone_id = (
+					SELECT kept.id FROM milestones kept JOIN milestones moved ON moved.name = kept.name
+					WHERE moved.id = issues.milestone_id AND kept.project_id = ?
+				) WHERE id = ?`, projectID, id).Error; err != nil {
+				return fmt.Errorf("failed to move issue milestone: %w", err)
+			}
+			if err := tx.Exec(`UPDATE issues SET component_id = (
+					SELECT kept.id FROM components kept JOIN components moved ON moved.name = kept.name
+					WHERE moved.id = issues.component_id AND kept.project_id = ?
+				) WHERE id = ?`, projectID, id).Error; err != nil {
+				return fmt.Errorf("failed to move issue component: %w", err)
+			}
+		}
+
+		return tx.Model(&domain.Issue{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+			"channel_id": channelID,
+			"project_id": projectID,
+			"number":     number,
+			"issue_key":  key,
+			"message_id": "",
+			"thread_id":  "",
+			"updated_at": time.Now(),
+		}).Error
+	})
+	if err != nil {
+		if err == domain.ErrIssueNotFound {
+			return err
+		}
+		r.logger.Error("Failed to move issue",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to move issue: %w", err)
+	}
+
+	r.logger.Info("Issue moved successfully",
+		zap.String("issue_id", id.String()),
+		zap.String("issue_key", key),
+	)
+	return nil
+}
+
 // Update updates an existing issue
 func (r *issueRepository) Update(ctx context.Context, issue *domain.Issue) error {
 	r.logger.Debug("Updating issue", zap.String("issue_id", issue.ID.String()))
 
-	result := r.db.WithContext(ctx).Save(issue)
+	// Custom field values, worklogs, milestones, components and suggested assignees are
+	// stored through their own repositories; saving stale preloaded values would bring back
+	// cleared ones, undo stopped timers, recreate deleted milestones or components or
+	// restore replaced suggestions
+	result := conn(ctx, r.db).Omit("CustomFieldValues", "Worklogs", "Milestone", "Component", "SuggestedAssignees").Save(issue)
 	if result.Error != nil {
 		r.logger.Error("Failed to update issue",
 			zap.Error(result.Error),
@@ -202,11 +987,11 @@ func (r *issueRepository) Update(ctx context.Context, issue *domain.Issue) error
 	return nil
 }
 
-// Delete removes an issue from the database
+// Delete soft-deletes an issue; it can be brought back with Restore
 func (r *issueRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.logger.Debug("Deleting issue", zap.String("issue_id", id.String()))
 
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Issue{})
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.Issue{})
 	if result.Error != nil {
 		r.logger.Error("Failed to delete issue",
 			zap.Error(result.Error),
@@ -224,30 +1009,28 @@ func (r *issueRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-// GetByDiscordChannelID implementation is already added above
+// Restore clears the deletion mark of a soft-deleted issue
+func (r *issueRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Restoring issue", zap.String("issue_id", id.String()))
 
-// List retrieves all issues with pagination
-func (r *issueRepository) List(ctx context.Context, offset, limit int) ([]*domain.Issue, error) {
-	r.logger.Debug("Listing issues",
-		zap.Int("offset", offset),
-		zap.Int("limit", limit),
-	)
-
-	var issues []*domain.Issue
-	if err := r.db.WithContext(ctx).Offset(offset).Limit(limit).Order("created_at DESC").Find(&issues).Error; err != nil {
-		r.logger.Error("Failed to list issues",
-			zap.Error(err),
-			zap.Int("offset", offset),
-			zap.Int("limit", limit),
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&domain.Issue{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		r.logger.Error("Failed to restore issue",
+			zap.Error(result.Error),
+			zap.String("issue_id", id.String()),
 		)
-		return nil, fmt.Errorf("failed to list issues: %w", err)
+		return fmt.Errorf("failed to restore issue: %w", result.Error)
 	}
 
-	r.logger.Debug("Issues listed successfully",
-		zap.Int("count", len(issues)),
-		zap.Int("offset", offset),
-		zap.Int("limit", limit),
-	)
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Deleted issue not found for restore", zap.String("issue_id", id.String()))
+		return domain.ErrIssueNotFound
+	}
 
-	return issues, nil
+	r.logger.Info("Issue restored successfully", zap.String("issue_id", id.String()))
+	return nil
 }
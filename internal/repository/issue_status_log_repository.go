@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// issueStatusLogRepository implements the IssueStatusLogRepository interface
+type issueStatusLogRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueStatusLogRepository creates a new instance of issue status log repository
+func NewIssueStatusLogRepository(db *gorm.DB, logger *zap.Logger) domain.IssueStatusLogRepository {
+	return &issueStatusLogRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new status log entry in the database
+func (r *issueStatusLogRepository) Create(ctx context.Context, log *domain.IssueStatusLog) error {
+	r.logger.Debug("Creating issue status log",
+		zap.String("issue_id", log.IssueID.String()),
+		zap.String("new_status", string(log.NewStatus)),
+	)
+
+	if err := conn(ctx, r.db).Create(log).Error; err != nil {
+		r.logger.Error("Failed to create issue status log",
+			zap.Error(err),
+			zap.String("issue_id", log.IssueID.String()),
+		)
+		return fmt.Errorf("failed to create issue status log: %w", err)
+	}
+
+	r.logger.Debug("Issue status log created successfully",
+		zap.String("log_id", log.ID.String()),
+		zap.String("issue_id", log.IssueID.String()),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a status log entry by its ID
+func (r *issueStatusLogRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.IssueStatusLog, error) {
+	r.logger.Debug("Retrieving issue status log by ID", zap.String("log_id", id.String()))
+
+	var log domain.IssueStatusLog
+	if err := conn(ctx, r.db).
+		Preload("ChangedByUser").
+		Where("id = ?", id).
+		First(&log).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			r.logger.Debug("Issue status log not found", zap.String("log_id", id.String()))
+			return nil, domain.ErrStatusLogNotFound
+		}
+		r.logger.Error("Failed to retrieve issue status log",
+			zap.Error(err),
+			zap.String("log_id", id.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue status log: %w", err)
+	}
+
+	return &log, nil
+}
+
+// GetByIssueID retrieves the status history of an issue, oldest first
+func (r *issueStatusLogRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueStatusLog, error) {
+	r.logger.Debug("Retrieving status logs by issue ID", zap.String("issue_id", issueID.String()))
+
+	var logs []*domain.IssueStatusLog
+	if err := conn(ctx, r.db).
+		Preload("ChangedByUser").
+		Where("issue_id = ?", issueID).
+		Order("changed_at ASC").
+		Find(&logs).Error; err != nil {
+		r.logger.Error("Failed to retrieve status logs by issue ID",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve status logs by issue ID: %w", err)
+	}
+
+	r.logger.Debug("Status logs retrieved successfully",
+		zap.String("issue_id", issueID.String()),
+		zap.Int("count", len(logs)),
+	)
+
+	return logs, nil
+}
+
+// GetByUserID retrieves all status changes made by a user, newest first
+func (r *issueStatusLogRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.IssueStatusLog, error) {
+	r.logger.Debug("Retrieving status logs by user ID", zap.String("user_id", userID.String()))
+
+	var logs []*domain.IssueStatusLog
+	if err := conn(ctx, r.db).
+		Preload("Issue").
+		Where("changed_by = ?", userID).
+		Order("changed_at DESC").
+		Find(&logs).Error; err != nil {
+		r.logger.Error("Failed to retrieve status logs by user ID",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve status logs by user ID: %w", err)
+	}
+
+	r.logger.Debug("Status logs retrieved successfully",
+		zap.String("user_id", userID.String()),
+		zap.Int("count", len(logs)),
+	)
+
+	return logs, nil
+}
+
+// GetRecentLogs retrieves the most recent status changes across all issues
+func (r *issueStatusLogRepository) GetRecentLogs(ctx context.Context, limit int) ([]*domain.IssueStatusLog, error) {
+	r.logger.Debug("Retrieving recent status logs", zap.Int("limit", limit))
+
+	var logs []*domain.IssueStatusLog
+	if err := conn(ctx, r.db).
+		Preload("Issue").
+		Preload("ChangedByUser").
+		Order("changed_at DESC").
+		Limit(limit).
+		Find(&logs).Error; err != nil {
+		r.logger.Error("Failed to retrieve recent status logs", zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve recent status logs: %w", err)
+	}
+
+	r.logger.Debug("Recent status logs retrieved successfully", zap.Int("count", len(logs)))
+	return logs, nil
+}
+
+// GetLogsByDateRange retrieves status changes within a date range, oldest first
+func (r *issueStatusLogRepository) GetLogsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]*domain.IssueStatusLog, error) {
+	r.logger.Debug("Retrieving status logs by date range",
+		zap.Time("start_date", startDate),
+		zap.Time("end_date", endDate),
+	)
+
+	var logs []*domain.IssueStatusLog
+	if err := conn(ctx, r.db).
+		Where("changed_at >= ? AND changed_at < ?", startDate, endDate).
+		Order("changed_at ASC").
+		Find(&logs).Error; err != nil {
+		r.logger.Error("Failed to retrieve status logs by date range", zap.Error(err))
+		return nil, fmt.Errorf("failed to retrieve status logs by date range: %w", err)
+	}
+
+	r.logger.Debug("Status logs retrieved successfully", zap.Int("count", len(logs)))
+	return logs, nil
+}
+
+// Delete removes a status log entry from the database
+func (r *issueStatusLogRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting issue status log", zap.String("log_id", id.String()))
+
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.IssueStatusLog{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete issue status log",
+			zap.Error(result.Error),
+			zap.String("log_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete issue status log: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Issue status log not found for deletion", zap.String("log_id", id.String()))
+		return domain.ErrStatusLogNotFound
+	}
+
+	r.logger.Info("Issue status log deleted successfully", zap.String("log_id", id.String()))
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// issueWatcherRepository implements the IssueWatcherRepository interface
+type issueWatcherRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueWatcherRepository creates a new instance of issue watcher repository
+func NewIssueWatcherRepository(db *gorm.DB, logger *zap.Logger) domain.IssueWatcherRepository {
+	return &issueWatcherRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create adds a watcher to an issue. It returns ErrAlreadyWatching when the user
+// already watches the issue.
+func (r *issueWatcherRepository) Create(ctx context.Context, watcher *domain.IssueWatcher) error {
+	r.logger.Debug("Creating issue watcher",
+		zap.String("issue_id", watcher.IssueID.String()),
+		zap.String("user_id", watcher.UserID.String()),
+	)
+
+	result := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(watcher)
+	if result.Error != nil {
+		r.logger.Error("Failed to create issue watcher",
+			zap.Error(result.Error),
+			zap.String("issue_id", watcher.IssueID.String()),
+			zap.String("user_id", watcher.UserID.String()),
+		)
+		return fmt.Errorf("failed to create issue watcher: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrAlreadyWatching
+	}
+
+	return nil
+}
+
+// Delete removes a user from the watchers of an issue. It returns ErrNotWatching when
+// the user does not watch the issue.
+func (r *issueWatcherRepository) Delete(ctx context.Context, issueID, userID uuid.UUID) error {
+	r.logger.Debug("Deleting issue watcher",
+		zap.String("issue_id", issueID.String()),
+		zap.String("user_id", userID.String()),
+	)
+
+	result := conn(ctx, r.db).
+		Where("issue_id = ? AND user_id = ?", issueID, userID).
+		Delete(&domain.IssueWatcher{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete issue watcher",
+			zap.Error(result.Error),
+			zap.String("issue_id", issueID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("failed to delete issue watcher: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrNotWatching
+	}
+
+	return nil
+}
+
+// GetByIssueID retrieves the watchers of an issue with their users, oldest first
+func (r *issueWatcherRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueWatcher, error) {
+	r.logger.Debug("Retrieving issue watchers", zap.String("issue_id", issueID.String()))
+
+	var watchers []*domain.IssueWatcher
+	if err := conn(ctx, r.db).
+		Preload("User").
+		Where("issue_id = ?", issueID).
+		Order("created_at ASC").
+		Find(&watchers).Error; err != nil {
+		r.logger.Error("Failed to retrieve issue watchers",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve issue watchers: %w", err)
+	}
+
+	return watchers, nil
+}
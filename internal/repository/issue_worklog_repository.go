@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// issueWorklogRepository implements the IssueWorklogRepository interface
+type issueWorklogRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewIssueWorklogRepository creates a new instance of issue worklog repository
+func NewIssueWorklogRepository(db *gorm.DB, logger *zap.Logger) domain.IssueWorklogRepository {
+	return &issueWorklogRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new worklog entry in the database
+func (r *issueWorklogRepository) Create(ctx context.Context, worklog *domain.IssueWorklog) error {
+	r.logger.Debug("Creating issue worklog",
+		zap.String("issue_id", worklog.IssueID.String()),
+		zap.String("user_id", worklog.UserID.String()),
+	)
+
+	if err := conn(ctx, r.db).Omit("Issue", "User").Create(worklog).Error; err != nil {
+		r.logger.Error("Failed to create issue worklog",
+			zap.Error(err),
+			zap.String("issue_id", worklog.IssueID.String()),
+			zap.String("user_id", worklog.UserID.String()),
+		)
+		return fmt.Errorf("failed to create issue worklog: %w", err)
+	}
+
+	r.logger.Info("Issue worklog created successfully",
+		zap.String("worklog_id", worklog.ID.String()),
+		zap.String("issue_id", worklog.IssueID.String()),
+		zap.Int64("seconds", worklog.Seconds),
+	)
+
+	return nil
+}
+
+// GetRunningByUser retrieves the timer a user is running with its issue
+func (r *issueWorklogRepository) GetRunningByUser(ctx context.Context, userID uuid.UUID) (*domain.IssueWorklog, error) {
+	r.logger.Debug("Retrieving running timer", zap.String("user_id", userID.String()))
+
+	var worklog domain.IssueWorklog
+	if err := conn(ctx, r.db).
+		Preload("Issue").
+		Where("user_id = ? AND ended_at IS NULL", userID).
+		First(&worklog).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrNoTimerRunning
+		}
+		r.logger.Error("Failed to retrieve running timer",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve running timer: %w", err)
+	}
+
+	return &worklog, nil
+}
+
+// Stop stores the end and recorded time of a running timer. Timers stopped in the
+// meantime are left as they are.
+func (r *issueWorklogRepository) Stop(ctx context.Context, worklog *domain.IssueWorklog) error {
+	r.logger.Debug("Stopping timer",
+		zap.String("worklog_id", worklog.ID.String()),
+		zap.Int64("seconds", worklog.Seconds),
+	)
+
+	result := conn(ctx, r.db).Model(&domain.IssueWorklog{}).
+		Where("id = ? AND ended_at IS NULL", worklog.ID).
+		Updates(map[string]interface{}{
+			"ended_at": worklog.EndedAt,
+			"seconds":  worklog.Seconds,
+		})
+	if result.Error != nil {
+		r.logger.Error("Failed to stop timer",
+			zap.Error(result.Error),
+			zap.String("worklog_id", worklog.ID.String()),
+		)
+		return fmt.Errorf("failed to stop timer: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNoTimerRunning
+	}
+
+	return nil
+}
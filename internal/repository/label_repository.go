@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// labelRepository implements the LabelRepository interface
+type labelRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewLabelRepository creates a new instance of label repository
+func NewLabelRepository(db *gorm.DB, logger *zap.Logger) domain.LabelRepository {
+	return &labelRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new label in the database
+func (r *labelRepository) Create(ctx context.Context, label *domain.Label) error {
+	r.logger.Debug("Creating label",
+		zap.String("project_id", label.ProjectID.String()),
+		zap.String("name", label.Name),
+	)
+
+	if err := conn(ctx, r.db).Create(label).Error; err != nil {
+		r.logger.Error("Failed to create label",
+			zap.Error(err),
+			zap.String("project_id", label.ProjectID.String()),
+			zap.String("name", label.Name),
+		)
+		return fmt.Errorf("failed to create label: %w", err)
+	}
+
+	r.logger.Info("Label created successfully",
+		zap.String("label_id", label.ID.String()),
+		zap.String("name", label.Name),
+	)
+
+	return nil
+}
+
+// GetByProjectAndName retrieves a project's label by its name
+func (r *labelRepository) GetByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error) {
+	r.logger.Debug("Retrieving label by name",
+		zap.String("project_id", projectID.String()),
+		zap.String("name", name),
+	)
+
+	var label domain.Label
+	if err := conn(ctx, r.db).
+		Where("project_id = ? AND name = ?", projectID, name).
+		First(&label).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrLabelNotFound
+		}
+		r.logger.Error("Failed to retrieve label by name",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+			zap.String("name", name),
+		)
+		return nil, fmt.Errorf("failed to retrieve label by name: %w", err)
+	}
+
+	return &label, nil
+}
+
+// ListByProject retrieves all labels of a project ordered by name
+func (r *labelRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+	r.logger.Debug("Listing labels by project", zap.String("project_id", projectID.String()))
+
+	var labels []*domain.Label
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Find(&labels).Error; err != nil {
+		r.logger.Error("Failed to list labels by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list labels by project: %w", err)
+	}
+
+	return labels, nil
+}
+
+// GetByIssueID retrieves the labels attached to an issue ordered by name
+func (r *labelRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*domain.Label, error) {
+	r.logger.Debug("Retrieving labels by issue ID", zap.String("issue_id", issueID.String()))
+
+	var labels []*domain.Label
+	if err := conn(ctx, r.db).
+		Joins("JOIN issue_labels ON issue_labels.label_id = labels.id").
+		Where("issue_labels.issue_id = ?", issueID).
+		Order("labels.name ASC").
+		Find(&labels).Error; err != nil {
+		r.logger.Error("Failed to retrieve labels by issue ID",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve labels by issue ID: %w", err)
+	}
+
+	return labels, nil
+}
+
+// AddToIssue attaches a label to an issue. Attaching a label twice is a no-op.
+func (r *labelRepository) AddToIssue(ctx context.Context, issueID, labelID uuid.UUID) error {
+	r.logger.Debug("Adding label to issue",
+		zap.String("issue_id", issueID.String()),
+		zap.String("label_id", labelID.String()),
+	)
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.IssueLabel{IssueID: issueID, LabelID: labelID}).Error; err != nil {
+		r.logger.Error("Failed to add label to issue",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("label_id", labelID.String()),
+		)
+		return fmt.Errorf("failed to add label to issue: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFromIssue detaches a label from an issue
+func (r *labelRepository) RemoveFromIssue(ctx context.Context, issueID, labelID uuid.UUID) error {
+	r.logger.Debug("Removing label from issue",
+		zap.String("issue_id", issueID.String()),
+		zap.String("label_id", labelID.String()),
+	)
+
+	result := conn(ctx, r.db).
+		Where("issue_id = ? AND label_id = ?", issueID, labelID).
+		Delete(&domain.IssueLabel{})
+	if result.Error != nil {
+		r.logger.Error("Failed to remove label from issue",
+			zap.Error(result.Error),
+			zap.String("issue_id", issueID.String()),
+			zap.String("label_id", labelID.String()),
+		)
+		return fmt.Errorf("failed to remove label from issue: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrLabelNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a label and detaches it from all issues
+func (r *labelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting label", zap.String("label_id", id.String()))
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("label_id = ?", id).Delete(&domain.IssueLabel{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&domain.Label{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrLabelNotFound
+		}
+		return nil
+	})
+	if err == domain.ErrLabelNotFound {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to delete label",
+			zap.Error(err),
+			zap.String("label_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	r.logger.Info("Label deleted successfully", zap.String("label_id", id.String()))
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// schedulerLockID is the advisory lock held by the replica running the scheduled jobs
+const schedulerLockID = 7306212
+
+// LeaderLock elects one of several replicas sharing a PostgreSQL database as leader.
+// The leader holds a session-level advisory lock on a connection taken out of the pool;
+// when it stops or loses the connection, PostgreSQL releases the lock and the next
+// replica asking becomes leader.
+type LeaderLock struct {
+	db     *gorm.DB
+	key    int64
+	logger *zap.Logger
+
+	mu   sync.Mutex
+	conn *sql.Conn // Holds the lock while this replica leads
+}
+
+// NewSchedulerLock creates the leader lock deciding which replica runs the scheduled jobs
+func NewSchedulerLock(db *gorm.DB, logger *zap.Logger) *LeaderLock {
+	return &LeaderLock{
+		db:     db,
+		key:    schedulerLockID,
+		logger: logger,
+	}
+}
+
+// IsLeader reports whether this replica leads, taking the lock if no replica holds it
+func (l *LeaderLock) IsLeader(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		// The lock went with the connection, so another replica may have taken it
+		l.logger.Warn("Lost the connection holding the leader lock")
+		l.discard()
+	}
+
+	sqlDB, err := l.db.DB()
+	if err != nil {
+		return false, fmt.Errorf("failed to get database handle: %w", err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get database connection: %w", err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("failed to take leader lock: %w", err)
+	}
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+
+	l.logger.Info("This replica is now the leader", zap.Int64("lock_id", l.key))
+	l.conn = conn
+	return true, nil
+}
+
+// Release gives up the lead, if held, so another replica can take over right away
+func (l *LeaderLock) Release() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+	if _, err := l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", l.key); err != nil {
+		l.discard()
+		return fmt.Errorf("failed to release leader lock: %w", err)
+	}
+	l.conn.Close()
+	l.conn = nil
+	return nil
+}
+
+// discard closes the connection of the lock instead of returning it to the pool, where
+// it would keep holding the lock if it still does
+func (l *LeaderLock) discard() {
+	l.conn.Raw(func(any) error { return driver.ErrBadConn })
+	l.conn.Close()
+	l.conn = nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// migrationFiles holds the versioned PostgreSQL migrations. Each schema change adds a
+// NNNN_name.up.sql file and a NNNN_name.down.sql file reverting it.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID is the advisory lock held while migrating, so replicas starting
+// at the same time do not apply the same migration twice
+const migrationLockID = 7306211
+
+// migration is one versioned schema change
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+}
+
+// schemaMigration records an applied migration
+type schemaMigration struct {
+	Version int64  `gorm:"primaryKey;autoIncrement:false"`
+	Name    string `gorm:"size:255;not null"`
+}
+
+// TableName specifies the table name for schema migrations
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// loadMigrations reads the embedded migrations, sorted by version
+func loadMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, entry := range entries {
+		file := entry.Name()
+		base, direction := strings.TrimSuffix(file, ".sql"), ""
+		switch {
+		case strings.HasSuffix(base, ".up"):
+			base, direction = strings.TrimSuffix(base, ".up"), "up"
+		case strings.HasSuffix(base, ".down"):
+			base, direction = strings.TrimSuffix(base, ".down"), "down"
+		default:
+			return nil, fmt.Errorf("migration %s is neither an up nor a down migration", file)
+		}
+
+		prefix, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %s has no name", file)
+		}
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has an invalid version: %w", file, err)
+		}
+
+		content, err := migrationFiles.ReadFile(path.Join("migrations", file))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", file, err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: name}
+			byVersion[version] = m
+		} else if m.name != name {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, m.name, name)
+		}
+		if direction == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", m.version, m.name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	return migrations, nil
+}
+
+// migrateUp applies the pending migrations in one transaction
+func migrateUp(db *gorm.DB, logger *zap.Logger) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	return lockedMigration(db, func(tx *gorm.DB, applied map[int64]bool) error {
+		pending := 0
+		for _, m := range migrations {
+			if applied[m.version] {
+				continue
+			}
+			logger.Info("Applying migration", zap.Int64("version", m.version), zap.String("name", m.name))
+			if err := tx.Exec(m.up).Error; err != nil {
+				return fmt.Errorf("failed to apply migration %04d_%s: %w", m.version, m.name, err)
+			}
+			if err := tx.Create(&schemaMigration{Version: m.version, Name: m.name}).Error; err != nil {
+				return fmt.Errorf("failed to record migration %04d_%s: %w", m.version, m.name, err)
+			}
+			pending++
+		}
+
+		if pending == 0 {
+			logger.Info("Database schema is up to date")
+		}
+		return nil
+	})
+}
+
+// migrateDown reverts the latest steps applied migrations in one transaction
+func migrateDown(db *gorm.DB, logger *zap.Logger, steps int) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	return lockedMigration(db, func(tx *gorm.DB, applied map[int64]bool) error {
+		for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+			m := migrations[i]
+			if !applied[m.version] {
+				continue
+			}
+			logger.Info("Reverting migration", zap.Int64("version", m.version), zap.String("name", m.name))
+			if err := tx.Exec(m.down).Error; err != nil {
+				return fmt.Errorf("failed to revert migration %04d_%s: %w", m.version, m.name, err)
+			}
+			if err := tx.Delete(&schemaMigration{}, m.version).Error; err != nil {
+				return fmt.Errorf("failed to unrecord migration %04d_%s: %w", m.version, m.name, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// lockedMigration runs fn in a transaction holding the migration lock, passing the
+// versions of the migrations applied so far
+func lockedMigration(db *gorm.DB, fn func(tx *gorm.DB, applied map[int64]bool) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockID).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if err := tx.Exec(`CREATE TABLE IF NOT EXISTS "schema_migrations" (
+			"version" bigint PRIMARY KEY,
+			"name" varchar(255) NOT NULL,
+			"applied_at" timestamptz NOT NULL DEFAULT now()
+		)`).Error; err != nil {
+			return fmt.Errorf("failed to create schema migrations table: %w", err)
+		}
+
+		var versions []int64
+		if err := tx.Model(&schemaMigration{}).Pluck("version", &versions).Error; err != nil {
+			return fmt.Errorf("failed to retrieve applied migrations: %w", err)
+		}
+		applied := make(map[int64]bool, len(versions))
+		for _, v := range versions {
+			applied[v] = true
+		}
+
+		return fn(tx, applied)
+	})
+}
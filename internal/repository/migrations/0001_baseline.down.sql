@@ -0,0 +1,23 @@
+-- Drops the baseline schema. This destroys all data.
+
+DROP TABLE IF EXISTS "user_notification_preferences";
+DROP TABLE IF EXISTS "oncall_members";
+DROP TABLE IF EXISTS "oncall_schedules";
+DROP TABLE IF EXISTS "guild_settings";
+DROP TABLE IF EXISTS "workflow_transitions";
+DROP TABLE IF EXISTS "workflow_statuses";
+DROP TABLE IF EXISTS "workflow_definitions";
+DROP TABLE IF EXISTS "project_webhooks";
+DROP TABLE IF EXISTS "issue_links";
+DROP TABLE IF EXISTS "issue_comments";
+DROP TABLE IF EXISTS "issue_attachments";
+DROP TABLE IF EXISTS "sla_alerts";
+DROP TABLE IF EXISTS "issue_status_logs";
+DROP TABLE IF EXISTS "issue_assignees";
+DROP TABLE IF EXISTS "issue_labels";
+DROP TABLE IF EXISTS "issues";
+DROP TABLE IF EXISTS "labels";
+DROP TABLE IF EXISTS "channels";
+DROP TABLE IF EXISTS "users";
+DROP TABLE IF EXISTS "projects";
+DROP TABLE IF EXISTS "customers";
@@ -0,0 +1,306 @@
+-- Baseline schema, matching what GORM AutoMigrate created before versioned migrations.
+-- Every statement is idempotent so databases created by AutoMigrate can adopt it.
+
+CREATE TABLE IF NOT EXISTS "customers" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "name" varchar(255) NOT NULL,
+    "contact_email" varchar(255),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_customers_deleted_at" ON "customers" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "projects" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "customer_id" uuid NOT NULL,
+    "name" varchar(255) NOT NULL,
+    "description" text,
+    "github_repo" varchar(200),
+    "jira_project" varchar(50),
+    "slack_webhook_url" varchar(500),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    "deleted_at" timestamptz,
+    "key_prefix" varchar(10),
+    "issue_counter" bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_customers_projects" FOREIGN KEY ("customer_id") REFERENCES "customers"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_projects_key_prefix" ON "projects" ("key_prefix") WHERE key_prefix <> '';
+CREATE INDEX IF NOT EXISTS "idx_projects_deleted_at" ON "projects" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "users" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "customer_id" uuid,
+    "name" varchar(255),
+    "email" varchar(255),
+    "discord_id" varchar(100),
+    "role" varchar(20) DEFAULT 'customer',
+    "is_internal" boolean DEFAULT false,
+    "dm_opt_out" boolean NOT NULL DEFAULT false,
+    "created_at" timestamptz DEFAULT now(),
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_customers_users" FOREIGN KEY ("customer_id") REFERENCES "customers"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_users_deleted_at" ON "users" ("deleted_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_discord_id" ON "users" ("discord_id");
+
+CREATE TABLE IF NOT EXISTS "channels" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "discord_channel_id" varchar(100) NOT NULL,
+    "guild_id" varchar(100) NOT NULL,
+    "registered_by" uuid NOT NULL,
+    "is_active" boolean DEFAULT true,
+    "channel_type" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_users_registered_channels" FOREIGN KEY ("registered_by") REFERENCES "users"("id"),
+    CONSTRAINT "fk_projects_channels" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_channels_deleted_at" ON "channels" ("deleted_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "unique_channel" ON "channels" ("discord_channel_id");
+
+CREATE TABLE IF NOT EXISTS "labels" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "color" varchar(7) NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_labels_project" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_label_project_name" ON "labels" ("project_id","name");
+
+CREATE TABLE IF NOT EXISTS "issues" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "channel_id" uuid,
+    "reporter_id" uuid NOT NULL,
+    "assignee_id" uuid,
+    "title" varchar(255) NOT NULL,
+    "description" text NOT NULL,
+    "image_url" varchar(500),
+    "priority" varchar(10) DEFAULT 'medium',
+    "status" varchar(40) DEFAULT 'open',
+    "source" varchar(20) DEFAULT 'web',
+    "thread_id" varchar(100),
+    "message_id" varchar(100),
+    "public_hash" varchar(100),
+    "resolution_cause" text,
+    "resolution_action" text,
+    "github_issue_number" bigint,
+    "jira_key" varchar(50),
+    "jira_status" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    "closed_at" timestamptz,
+    "deleted_at" timestamptz,
+    "number" bigint NOT NULL DEFAULT 0,
+    "issue_key" varchar(20),
+    "parent_issue_id" uuid,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_projects_issues" FOREIGN KEY ("project_id") REFERENCES "projects"("id"),
+    CONSTRAINT "fk_users_reported_issues" FOREIGN KEY ("reporter_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_users_assigned_issues" FOREIGN KEY ("assignee_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_issues_sub_issues" FOREIGN KEY ("parent_issue_id") REFERENCES "issues"("id"),
+    CONSTRAINT "fk_issues_channel" FOREIGN KEY ("channel_id") REFERENCES "channels"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_issues_parent_issue_id" ON "issues" ("parent_issue_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issues_issue_key" ON "issues" ("issue_key") WHERE issue_key <> '';
+CREATE INDEX IF NOT EXISTS "idx_issues_deleted_at" ON "issues" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_issues_jira_key" ON "issues" ("jira_key");
+CREATE INDEX IF NOT EXISTS "idx_issues_git_hub_issue_number" ON "issues" ("github_issue_number");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issues_public_hash" ON "issues" ("public_hash");
+CREATE INDEX IF NOT EXISTS "idx_issues_message_id" ON "issues" ("message_id");
+CREATE INDEX IF NOT EXISTS "idx_issues_thread_id" ON "issues" ("thread_id");
+
+CREATE TABLE IF NOT EXISTS "issue_labels" (
+    "issue_id" uuid,
+    "label_id" uuid,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("issue_id","label_id"),
+    CONSTRAINT "fk_issue_labels_issue" FOREIGN KEY ("issue_id") REFERENCES "issues"("id"),
+    CONSTRAINT "fk_issue_labels_label" FOREIGN KEY ("label_id") REFERENCES "labels"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "issue_assignees" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "role" varchar(20) NOT NULL,
+    "assigned_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_issue_assignees_user" FOREIGN KEY ("user_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_issues_assignees" FOREIGN KEY ("issue_id") REFERENCES "issues"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "issue_status_logs" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "old_status" varchar(40),
+    "new_status" varchar(40) NOT NULL,
+    "changed_by" uuid,
+    "changed_at" timestamptz DEFAULT now(),
+    "note" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_issue_status_logs_changed_by_user" FOREIGN KEY ("changed_by") REFERENCES "users"("id"),
+    CONSTRAINT "fk_issues_status_logs" FOREIGN KEY ("issue_id") REFERENCES "issues"("id")
+);
+
+CREATE TABLE IF NOT EXISTS "sla_alerts" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "kind" varchar(20) NOT NULL,
+    "level" varchar(20) NOT NULL,
+    "due_at" timestamptz NOT NULL,
+    "sent_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_sla_alerts_issue" FOREIGN KEY ("issue_id") REFERENCES "issues"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_sla_alert_issue_kind_level" ON "sla_alerts" ("issue_id","kind","level");
+
+CREATE TABLE IF NOT EXISTS "issue_attachments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "uploaded_by_id" uuid,
+    "discord_attachment_id" varchar(100),
+    "file_name" varchar(255) NOT NULL,
+    "url" varchar(1000) NOT NULL,
+    "content_type" varchar(100),
+    "size" bigint,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_issue_attachments_uploaded_by" FOREIGN KEY ("uploaded_by_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_issues_attachments" FOREIGN KEY ("issue_id") REFERENCES "issues"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_issue_attachments_issue_id" ON "issue_attachments" ("issue_id");
+
+CREATE TABLE IF NOT EXISTS "issue_comments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "author_id" uuid,
+    "author_name" varchar(255),
+    "discord_message_id" varchar(100),
+    "content" text NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_issue_comments_issue" FOREIGN KEY ("issue_id") REFERENCES "issues"("id"),
+    CONSTRAINT "fk_issue_comments_author" FOREIGN KEY ("author_id") REFERENCES "users"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_issue_comments_discord_message_id" ON "issue_comments" ("discord_message_id");
+CREATE INDEX IF NOT EXISTS "idx_issue_comments_issue_id" ON "issue_comments" ("issue_id");
+
+CREATE TABLE IF NOT EXISTS "issue_links" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "source_issue_id" uuid NOT NULL,
+    "target_issue_id" uuid NOT NULL,
+    "type" varchar(20) NOT NULL,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_issues_incoming_links" FOREIGN KEY ("target_issue_id") REFERENCES "issues"("id"),
+    CONSTRAINT "fk_issues_outgoing_links" FOREIGN KEY ("source_issue_id") REFERENCES "issues"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_issue_links_target_issue_id" ON "issue_links" ("target_issue_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_link" ON "issue_links" ("source_issue_id","target_issue_id","type");
+
+CREATE TABLE IF NOT EXISTS "project_webhooks" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "url" varchar(500) NOT NULL,
+    "secret" varchar(64) NOT NULL,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_project_webhooks_project" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_project_webhook_url" ON "project_webhooks" ("project_id","url");
+
+CREATE TABLE IF NOT EXISTS "workflow_definitions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_projects_workflow" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_workflow_definitions_project_id" ON "workflow_definitions" ("project_id");
+
+CREATE TABLE IF NOT EXISTS "workflow_statuses" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "workflow_id" uuid NOT NULL,
+    "status" varchar(40) NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_workflow_definitions_statuses" FOREIGN KEY ("workflow_id") REFERENCES "workflow_definitions"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_workflow_status" ON "workflow_statuses" ("workflow_id","status");
+
+CREATE TABLE IF NOT EXISTS "workflow_transitions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "workflow_id" uuid NOT NULL,
+    "from_status" varchar(40) NOT NULL,
+    "to_status" varchar(40) NOT NULL,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_workflow_definitions_transitions" FOREIGN KEY ("workflow_id") REFERENCES "workflow_definitions"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_workflow_transition" ON "workflow_transitions" ("workflow_id","from_status","to_status");
+
+CREATE TABLE IF NOT EXISTS "guild_settings" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "guild_id" varchar(100) NOT NULL,
+    "locale" varchar(10),
+    "admin_role_ids" text,
+    "escalation_channel_id" varchar(100),
+    "sla_targets" text,
+    "updated_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_guild_settings_guild_id" ON "guild_settings" ("guild_id");
+
+CREATE TABLE IF NOT EXISTS "oncall_schedules" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "current_position" bigint NOT NULL DEFAULT 0,
+    "next_rotation_at" timestamptz,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_oncall_schedules_project" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_oncall_schedules_project_id" ON "oncall_schedules" ("project_id");
+
+CREATE TABLE IF NOT EXISTS "oncall_members" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "schedule_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "position" bigint NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_oncall_members_user" FOREIGN KEY ("user_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_oncall_schedules_members" FOREIGN KEY ("schedule_id") REFERENCES "oncall_schedules"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_oncall_member" ON "oncall_members" ("schedule_id","user_id");
+
+CREATE TABLE IF NOT EXISTS "user_notification_preferences" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "user_id" uuid NOT NULL,
+    "event" varchar(30) NOT NULL,
+    "enabled" boolean NOT NULL,
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_notification_event" ON "user_notification_preferences" ("user_id","event");
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "audit_logs" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "guild_id" varchar(100),
+    "actor_id" varchar(100),
+    "action" varchar(40) NOT NULL,
+    "target_type" varchar(20) NOT NULL,
+    "target_id" varchar(100) NOT NULL,
+    "target_name" varchar(255),
+    "before" text,
+    "after" text,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_logs_guild_created" ON "audit_logs" ("guild_id","created_at");
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS "channel_projects" (
+    "channel_id" uuid,
+    "project_id" uuid,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("channel_id","project_id"),
+    CONSTRAINT "fk_channel_projects_channel" FOREIGN KEY ("channel_id") REFERENCES "channels"("id"),
+    CONSTRAINT "fk_channel_projects_project" FOREIGN KEY ("project_id") REFERENCES "projects"("id")
+);
@@ -0,0 +1 @@
+ALTER TABLE "guild_settings" ADD COLUMN IF NOT EXISTS "report_emoji" varchar(64);
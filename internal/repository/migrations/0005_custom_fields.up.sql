@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS "custom_field_definitions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "type" varchar(20) NOT NULL,
+    "options" text,
+    "required" boolean DEFAULT false,
+    "position" bigint NOT NULL DEFAULT 0,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_custom_field_project_name" ON "custom_field_definitions" ("project_id","name");
+
+CREATE TABLE IF NOT EXISTS "issue_custom_field_values" (
+    "issue_id" uuid,
+    "field_id" uuid,
+    "value" text NOT NULL,
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("issue_id","field_id"),
+    CONSTRAINT "fk_issues_custom_field_values" FOREIGN KEY ("issue_id") REFERENCES "issues"("id"),
+    CONSTRAINT "fk_issue_custom_field_values_field" FOREIGN KEY ("field_id") REFERENCES "custom_field_definitions"("id")
+);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "recurring_issues" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "channel_id" uuid NOT NULL,
+    "schedule" varchar(100) NOT NULL,
+    "title" varchar(255) NOT NULL,
+    "description" text NOT NULL,
+    "priority" varchar(10) DEFAULT 'medium',
+    "next_run_at" timestamptz NOT NULL,
+    "last_run_at" timestamptz,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_recurring_issues_channel" FOREIGN KEY ("channel_id") REFERENCES "channels"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_recurring_issue_project_title" ON "recurring_issues" ("project_id","title");
+CREATE INDEX IF NOT EXISTS "idx_recurring_issues_next_run_at" ON "recurring_issues" ("next_run_at");
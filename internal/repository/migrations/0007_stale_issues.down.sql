@@ -0,0 +1,3 @@
+ALTER TABLE "projects" DROP COLUMN IF EXISTS "stale_close_after_days";
+ALTER TABLE "projects" DROP COLUMN IF EXISTS "stale_after_days";
+ALTER TABLE "issues" DROP COLUMN IF EXISTS "stale_nudged_at";
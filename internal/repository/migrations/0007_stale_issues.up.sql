@@ -0,0 +1,3 @@
+ALTER TABLE "issues" ADD COLUMN IF NOT EXISTS "stale_nudged_at" timestamptz;
+ALTER TABLE "projects" ADD COLUMN IF NOT EXISTS "stale_after_days" bigint;
+ALTER TABLE "projects" ADD COLUMN IF NOT EXISTS "stale_close_after_days" bigint;
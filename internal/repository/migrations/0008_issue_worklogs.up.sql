@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS "issue_worklogs" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "started_at" timestamptz NOT NULL,
+    "ended_at" timestamptz,
+    "seconds" bigint NOT NULL DEFAULT 0,
+    "note" varchar(255),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_issues_worklogs" FOREIGN KEY ("issue_id") REFERENCES "issues"("id"),
+    CONSTRAINT "fk_issue_worklogs_user" FOREIGN KEY ("user_id") REFERENCES "users"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_issue_worklogs_issue_id" ON "issue_worklogs" ("issue_id");
+CREATE INDEX IF NOT EXISTS "idx_issue_worklogs_user_id" ON "issue_worklogs" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_issue_worklogs_ended_at" ON "issue_worklogs" ("ended_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_worklogs_running" ON "issue_worklogs" ("user_id") WHERE ended_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "idx_issues_due_date";
+ALTER TABLE "issues" DROP COLUMN IF EXISTS "due_reminder_sent";
+ALTER TABLE "issues" DROP COLUMN IF EXISTS "due_date";
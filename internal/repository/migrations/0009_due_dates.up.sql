@@ -0,0 +1,3 @@
+ALTER TABLE "issues" ADD COLUMN IF NOT EXISTS "due_date" timestamptz;
+ALTER TABLE "issues" ADD COLUMN IF NOT EXISTS "due_reminder_sent" varchar(20);
+CREATE INDEX IF NOT EXISTS "idx_issues_due_date" ON "issues" ("due_date");
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_issues_milestone_id";
+ALTER TABLE "issues" DROP CONSTRAINT IF EXISTS "fk_issues_milestone";
+ALTER TABLE "issues" DROP COLUMN IF EXISTS "milestone_id";
+DROP TABLE IF EXISTS "milestones";
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "milestones" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "target_date" date,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_milestone_project_name" ON "milestones" ("project_id","name");
+
+ALTER TABLE "issues" ADD COLUMN IF NOT EXISTS "milestone_id" uuid;
+ALTER TABLE "issues" ADD CONSTRAINT "fk_issues_milestone" FOREIGN KEY ("milestone_id") REFERENCES "milestones"("id");
+CREATE INDEX IF NOT EXISTS "idx_issues_milestone_id" ON "issues" ("milestone_id");
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "boards" (
+    "channel_id" uuid,
+    "discord_channel_id" varchar(100) NOT NULL,
+    "message_id" varchar(100) NOT NULL,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("channel_id")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "issue_escalations";
+DROP TABLE IF EXISTS "escalation_rules";
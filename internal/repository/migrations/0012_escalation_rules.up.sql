@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "escalation_rules" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "priority" varchar(10) NOT NULL,
+    "after_hours" bigint NOT NULL,
+    "role_id" varchar(100) NOT NULL,
+    "bump_priority" boolean DEFAULT false,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_escalation_rule_project_priority" ON "escalation_rules" ("project_id","priority");
+
+CREATE TABLE IF NOT EXISTS "issue_escalations" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "priority" varchar(10) NOT NULL,
+    "role_id" varchar(100) NOT NULL,
+    "escalated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_escalation_issue_priority" ON "issue_escalations" ("issue_id","priority");
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "issue_feedback" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "rating" bigint NOT NULL,
+    "comment" text,
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_feedback_issue_id" ON "issue_feedback" ("issue_id");
@@ -0,0 +1 @@
+ALTER TABLE "customers" DROP COLUMN IF EXISTS "email_opt_out";
@@ -0,0 +1 @@
+ALTER TABLE "customers" ADD COLUMN IF NOT EXISTS "email_opt_out" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "issue_emails";
+
+DROP INDEX IF EXISTS "idx_users_discord_id";
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_discord_id" ON "users" ("discord_id");
+
+DROP INDEX IF EXISTS "idx_projects_inbound_email";
+ALTER TABLE "projects" DROP COLUMN IF EXISTS "inbound_email";
@@ -0,0 +1,16 @@
+ALTER TABLE "projects" ADD COLUMN IF NOT EXISTS "inbound_email" varchar(255);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_projects_inbound_email" ON "projects" ("inbound_email") WHERE inbound_email <> '';
+
+-- Users who only reach us by email have no Discord ID
+DROP INDEX IF EXISTS "idx_users_discord_id";
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_discord_id" ON "users" ("discord_id") WHERE discord_id <> '';
+
+CREATE TABLE IF NOT EXISTS "issue_emails" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "message_id" varchar(255) NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_issue_emails_issue_id" ON "issue_emails" ("issue_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_emails_message_id" ON "issue_emails" ("message_id");
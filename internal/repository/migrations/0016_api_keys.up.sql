@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "api_keys" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "customer_id" uuid,
+    "name" varchar(100) NOT NULL,
+    "prefix" varchar(20) NOT NULL,
+    "hash" varchar(64) NOT NULL,
+    "scopes" varchar(255) NOT NULL,
+    "created_by" varchar(100),
+    "last_used_at" timestamptz,
+    "revoked_at" timestamptz,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_api_keys_customer_id" ON "api_keys" ("customer_id");
+CREATE INDEX IF NOT EXISTS "idx_api_keys_prefix" ON "api_keys" ("prefix");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_api_keys_hash" ON "api_keys" ("hash");
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "issue_watchers" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_watcher" ON "issue_watchers" ("issue_id", "user_id");
+CREATE INDEX IF NOT EXISTS "idx_issue_watchers_user_id" ON "issue_watchers" ("user_id");
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS "event_claims" (
+    "key" varchar(191) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    PRIMARY KEY ("key")
+);
+CREATE INDEX IF NOT EXISTS "idx_event_claims_expires_at" ON "event_claims" ("expires_at");
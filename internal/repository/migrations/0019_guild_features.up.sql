@@ -0,0 +1 @@
+ALTER TABLE "guild_settings" ADD COLUMN IF NOT EXISTS "features" text;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "discord_outbox" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "type" varchar(20) NOT NULL,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "last_error" varchar(500),
+    "due_at" timestamptz NOT NULL,
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_discord_outbox_issue_type" ON "discord_outbox" ("issue_id", "type");
+CREATE INDEX IF NOT EXISTS "idx_discord_outbox_due_at" ON "discord_outbox" ("due_at");
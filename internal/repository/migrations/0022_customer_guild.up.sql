@@ -0,0 +1,10 @@
+ALTER TABLE "customers" ADD COLUMN IF NOT EXISTS "guild_id" varchar(100);
+CREATE INDEX IF NOT EXISTS "idx_customers_guild_id" ON "customers" ("guild_id");
+-- Customers added before keep the server of the first channel registered for one of their projects
+UPDATE "customers" SET "guild_id" = (
+    SELECT "channels"."guild_id" FROM "channels"
+    JOIN "projects" ON "projects"."id" = "channels"."project_id"
+    WHERE "projects"."customer_id" = "customers"."id"
+    ORDER BY "channels"."created_at"
+    LIMIT 1
+) WHERE "guild_id" IS NULL;
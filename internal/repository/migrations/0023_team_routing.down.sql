@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "issue_suggested_assignees";
+DROP TABLE IF EXISTS "team_members";
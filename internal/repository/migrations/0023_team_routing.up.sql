@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS "team_members" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "guild_id" varchar(100) NOT NULL,
+    "user_id" uuid NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "default_role" varchar(20) NOT NULL,
+    "skills" text,
+    "capacity" bigint NOT NULL DEFAULT 0,
+    "updated_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    "updated_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_team_member" ON "team_members" ("guild_id", "user_id");
+
+CREATE TABLE IF NOT EXISTS "issue_suggested_assignees" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "user_id" uuid NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "role" varchar(20) NOT NULL,
+    "position" bigint NOT NULL,
+    "matched" text,
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issue_suggested_assignee" ON "issue_suggested_assignees" ("issue_id", "user_id");
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_issues_component_id";
+ALTER TABLE "issues" DROP CONSTRAINT IF EXISTS "fk_issues_component";
+ALTER TABLE "issues" DROP COLUMN IF EXISTS "component_id";
+DROP TABLE IF EXISTS "components";
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "components" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "project_id" uuid NOT NULL,
+    "name" varchar(50) NOT NULL,
+    "lead_id" uuid,
+    "created_by" varchar(100),
+    "created_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_components_lead" FOREIGN KEY ("lead_id") REFERENCES "users"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_component_project_name" ON "components" ("project_id","name");
+
+ALTER TABLE "issues" ADD COLUMN IF NOT EXISTS "component_id" uuid;
+ALTER TABLE "issues" ADD CONSTRAINT "fk_issues_component" FOREIGN KEY ("component_id") REFERENCES "components"("id");
+CREATE INDEX IF NOT EXISTS "idx_issues_component_id" ON "issues" ("component_id");
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "incidents" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "issue_id" uuid NOT NULL,
+    "commander_id" varchar(100) NOT NULL,
+    "bridge_channel_id" varchar(100),
+    "bridge_stage" boolean DEFAULT false,
+    "last_update_at" timestamptz,
+    "reminded_at" timestamptz,
+    "declared_at" timestamptz DEFAULT now(),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_incidents_issue" FOREIGN KEY ("issue_id") REFERENCES "issues"("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_incidents_issue_id" ON "incidents" ("issue_id");
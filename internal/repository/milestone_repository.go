@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// milestoneRepository implements the MilestoneRepository interface
+type milestoneRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewMilestoneRepository creates a new instance of milestone repository
+func NewMilestoneRepository(db *gorm.DB, logger *zap.Logger) domain.MilestoneRepository {
+	return &milestoneRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// milestoneOrder lists milestones by target date, those without one last
+const milestoneOrder = "milestones.target_date ASC NULLS LAST, milestones.name ASC"
+
+// Create creates a new milestone in the database
+func (r *milestoneRepository) Create(ctx context.Context, milestone *domain.Milestone) error {
+	r.logger.Debug("Creating milestone",
+		zap.String("project_id", milestone.ProjectID.String()),
+		zap.String("name", milestone.Name),
+	)
+
+	if err := conn(ctx, r.db).Create(milestone).Error; err != nil {
+		r.logger.Error("Failed to create milestone",
+			zap.Error(err),
+			zap.String("project_id", milestone.ProjectID.String()),
+			zap.String("name", milestone.Name),
+		)
+		return fmt.Errorf("failed to create milestone: %w", err)
+	}
+
+	r.logger.Info("Milestone created successfully",
+		zap.String("milestone_id", milestone.ID.String()),
+		zap.String("name", milestone.Name),
+	)
+
+	return nil
+}
+
+// GetByID retrieves a milestone by its ID
+func (r *milestoneRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Milestone, error) {
+	r.logger.Debug("Retrieving milestone by ID", zap.String("milestone_id", id.String()))
+
+	var milestone domain.Milestone
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&milestone).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrMilestoneNotFound
+		}
+		r.logger.Error("Failed to retrieve milestone by ID",
+			zap.Error(err),
+			zap.String("milestone_id", id.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve milestone by ID: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+// ListByProject retrieves a project's milestones by target date
+func (r *milestoneRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.Milestone, error) {
+	r.logger.Debug("Listing milestones by project", zap.String("project_id", projectID.String()))
+
+	var milestones []*domain.Milestone
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order(milestoneOrder).
+		Find(&milestones).Error; err != nil {
+		r.logger.Error("Failed to list milestones by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list milestones by project: %w", err)
+	}
+
+	return milestones, nil
+}
+
+// milestoneProgressRow is a milestone with the issue counts of ListProgressByProject
+type milestoneProgressRow struct {
+	domain.Milestone
+	Total  int
+	Closed int
+}
+
+// ListProgressByProject retrieves a project's milestones by target date with how many of
+// their issues are closed
+func (r *milestoneRepository) ListProgressByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.MilestoneProgress, error) {
+	r.logger.Debug("Listing milestone progress by project", zap.String("project_id", projectID.String()))
+
+	var rows []milestoneProgressRow
+	if err := conn(ctx, r.db).
+		Model(&domain.Milestone{}).
+		Select("milestones.*, COUNT(issues.id) AS total, COUNT(issues.id) FILTER (WHERE issues.status = ?) AS closed", domain.StatusClosed).
+		Joins("LEFT JOIN issues ON issues.milestone_id = milestones.id AND issues.deleted_at IS NULL").
+		Where("milestones.project_id = ?", projectID).
+		Group("milestones.id").
+		Order(milestoneOrder).
+		Scan(&rows).Error; err != nil {
+		r.logger.Error("Failed to list milestone progress by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list milestone progress by project: %w", err)
+	}
+
+	progress := make([]*domain.MilestoneProgress, 0, len(rows))
+	for i := range rows {
+		progress = append(progress, &domain.MilestoneProgress{
+			Milestone: &rows[i].Milestone,
+			Total:     rows[i].Total,
+			Closed:    rows[i].Closed,
+		})
+	}
+
+	return progress, nil
+}
+
+// Delete removes a milestone. Its issues are kept without a milestone.
+func (r *milestoneRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting milestone", zap.String("milestone_id", id.String()))
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Deleted issues are detached too so restoring them does not bring back the milestone
+		if err := tx.Unscoped().Model(&domain.Issue{}).
+			Where("milestone_id = ?", id).
+			UpdateColumn("milestone_id", nil).Error; err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", id).Delete(&domain.Milestone{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrMilestoneNotFound
+		}
+		return nil
+	})
+	if err == domain.ErrMilestoneNotFound {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to delete milestone",
+			zap.Error(err),
+			zap.String("milestone_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete milestone: %w", err)
+	}
+
+	r.logger.Info("Milestone deleted successfully", zap.String("milestone_id", id.String()))
+	return nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notificationPreferenceRepository implements the UserNotificationPreferenceRepository interface
+type notificationPreferenceRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewNotificationPreferenceRepository creates a new instance of notification preference repository
+func NewNotificationPreferenceRepository(db *gorm.DB, logger *zap.Logger) domain.UserNotificationPreferenceRepository {
+	return &notificationPreferenceRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetByUserID retrieves the notification preferences a user has stored
+func (r *notificationPreferenceRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.UserNotificationPreference, error) {
+	r.logger.Debug("Retrieving notification preferences", zap.String("user_id", userID.String()))
+
+	var prefs []*domain.UserNotificationPreference
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).Find(&prefs).Error; err != nil {
+		r.logger.Error("Failed to retrieve notification preferences",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve notification preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// Upsert creates the preference or updates the stored one for the same user and event
+func (r *notificationPreferenceRepository) Upsert(ctx context.Context, pref *domain.UserNotificationPreference) error {
+	r.logger.Debug("Storing notification preference",
+		zap.String("user_id", pref.UserID.String()),
+		zap.String("event", string(pref.Event)),
+		zap.Bool("enabled", pref.Enabled),
+	)
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "event"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+		}).
+		Create(pref).Error; err != nil {
+		r.logger.Error("Failed to store notification preference",
+			zap.Error(err),
+			zap.String("user_id", pref.UserID.String()),
+			zap.String("event", string(pref.Event)),
+		)
+		return fmt.Errorf("failed to store notification preference: %w", err)
+	}
+
+	return nil
+}
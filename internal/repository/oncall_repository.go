@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// onCallRepository implements the OnCallRepository interface
+type onCallRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewOnCallRepository creates a new instance of on-call repository
+func NewOnCallRepository(db *gorm.DB, logger *zap.Logger) domain.OnCallRepository {
+	return &onCallRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// preloadMembers loads a schedule's members and their users in rotation order
+func preloadMembers(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Members.User")
+}
+
+// GetByProjectID retrieves a project's rotation with its members in rotation order
+func (r *onCallRepository) GetByProjectID(ctx context.Context, projectID uuid.UUID) (*domain.OnCallSchedule, error) {
+	r.logger.Debug("Retrieving on-call schedule by project ID", zap.String("project_id", projectID.String()))
+
+	var schedule domain.OnCallSchedule
+	if err := preloadMembers(conn(ctx, r.db)).
+		Where("project_id = ?", projectID).
+		First(&schedule).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrOnCallScheduleNotFound
+		}
+		r.logger.Error("Failed to retrieve on-call schedule by project ID",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve on-call schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// List retrieves every rotation with its project and members
+func (r *onCallRepository) List(ctx context.Context) ([]*domain.OnCallSchedule, error) {
+	r.logger.Debug("Listing on-call schedules")
+
+	var schedules []*domain.OnCallSchedule
+	if err := preloadMembers(conn(ctx, r.db)).
+		Preload("Project").
+		Order("created_at ASC").
+		Find(&schedules).Error; err != nil {
+		r.logger.Error("Failed to list on-call schedules", zap.Error(err))
+		return nil, fmt.Errorf("failed to list on-call schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+// Create creates a new on-call schedule in the database
+func (r *onCallRepository) Create(ctx context.Context, schedule *domain.OnCallSchedule) error {
+	r.logger.Debug("Creating on-call schedule", zap.String("project_id", schedule.ProjectID.String()))
+
+	if err := conn(ctx, r.db).Omit("Project", "Members").Create(schedule).Error; err != nil {
+		r.logger.Error("Failed to create on-call schedule",
+			zap.Error(err),
+			zap.String("project_id", schedule.ProjectID.String()),
+		)
+		return fmt.Errorf("failed to create on-call schedule: %w", err)
+	}
+
+	r.logger.Info("On-call schedule created successfully",
+		zap.String("schedule_id", schedule.ID.String()),
+		zap.String("project_id", schedule.ProjectID.String()),
+	)
+
+	return nil
+}
+
+// UpdateRotation stores the current position and next rotation time of a schedule
+func (r *onCallRepository) UpdateRotation(ctx context.Context, schedule *domain.OnCallSchedule) error {
+	r.logger.Debug("Updating on-call rotation",
+		zap.String("schedule_id", schedule.ID.String()),
+		zap.Int("current_position", schedule.CurrentPosition),
+	)
+
+	if err := conn(ctx, r.db).Model(&domain.OnCallSchedule{}).
+		Where("id = ?", schedule.ID).
+		Updates(map[string]interface{}{
+			"current_position": schedule.CurrentPosition,
+			"next_rotation_at": schedule.NextRotationAt,
+		}).Error; err != nil {
+		r.logger.Error("Failed to update on-call rotation",
+			zap.Error(err),
+			zap.String("schedule_id", schedule.ID.String()),
+		)
+		return fmt.Errorf("failed to update on-call rotation: %w", err)
+	}
+
+	return nil
+}
+
+// AddMember adds a member to a rotation
+func (r *onCallRepository) AddMember(ctx context.Context, member *domain.OnCallMember) error {
+	r.logger.Debug("Adding on-call member",
+		zap.String("schedule_id", member.ScheduleID.String()),
+		zap.String("user_id", member.UserID.String()),
+	)
+
+	if err := conn(ctx, r.db).Omit("User").Create(member).Error; err != nil {
+		r.logger.Error("Failed to add on-call member",
+			zap.Error(err),
+			zap.String("schedule_id", member.ScheduleID.String()),
+			zap.String("user_id", member.UserID.String()),
+		)
+		return fmt.Errorf("failed to add on-call member: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveMember removes a member from a rotation
+func (r *onCallRepository) RemoveMember(ctx context.Context, scheduleID, userID uuid.UUID) error {
+	r.logger.Debug("Removing on-call member",
+		zap.String("schedule_id", scheduleID.String()),
+		zap.String("user_id", userID.String()),
+	)
+
+	if err := conn(ctx, r.db).
+		Where("schedule_id = ? AND user_id = ?", scheduleID, userID).
+		Delete(&domain.OnCallMember{}).Error; err != nil {
+		r.logger.Error("Failed to remove on-call member",
+			zap.Error(err),
+			zap.String("schedule_id", scheduleID.String()),
+			zap.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("failed to remove on-call member: %w", err)
+	}
+
+	return nil
+}
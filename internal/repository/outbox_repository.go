@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// outboxRepository implements the OutboxRepository interface
+type outboxRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewOutboxRepository creates a new instance of outbox repository
+func NewOutboxRepository(db *gorm.DB, logger *zap.Logger) domain.OutboxRepository {
+	return &outboxRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Add records an action for an issue, due at the given time, or postpones the action of
+// the same type already pending for the issue
+func (r *outboxRepository) Add(ctx context.Context, issueID uuid.UUID, actionType domain.OutboxActionType, dueAt time.Time) error {
+	now := time.Now()
+	action := &domain.OutboxAction{
+		ID:        uuid.New(),
+		IssueID:   issueID,
+		Type:      actionType,
+		DueAt:     dueAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "issue_id"}, {Name: "type"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"due_at":     dueAt,
+				"updated_at": now,
+				"attempts":   0,
+				"last_error": "",
+			}),
+		}).
+		Create(action).Error; err != nil {
+		r.logger.Error("Failed to record outbox action",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("type", string(actionType)),
+		)
+		return fmt.Errorf("failed to record outbox action: %w", err)
+	}
+	return nil
+}
+
+// Due returns up to limit actions due at the given time, the longest due first
+func (r *outboxRepository) Due(ctx context.Context, now time.Time, limit int) ([]*domain.OutboxAction, error) {
+	var actions []*domain.OutboxAction
+	if err := conn(ctx, r.db).
+		Where("due_at <= ?", now).
+		Order("due_at").
+		Limit(limit).
+		Find(&actions).Error; err != nil {
+		r.logger.Error("Failed to get due outbox actions", zap.Error(err))
+		return nil, fmt.Errorf("failed to get due outbox actions: %w", err)
+	}
+	return actions, nil
+}
+
+// Complete removes an issue's pending action of a type recorded before the given time
+func (r *outboxRepository) Complete(ctx context.Context, issueID uuid.UUID, actionType domain.OutboxActionType, recordedBefore time.Time) error {
+	if err := conn(ctx, r.db).
+		Where("issue_id = ? AND type = ? AND updated_at <= ?", issueID, actionType, recordedBefore).
+		Delete(&domain.OutboxAction{}).Error; err != nil {
+		r.logger.Error("Failed to complete outbox action",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("type", string(actionType)),
+		)
+		return fmt.Errorf("failed to complete outbox action: %w", err)
+	}
+	return nil
+}
+
+// Retry records a failed attempt of an action and makes it due again at the given time
+func (r *outboxRepository) Retry(ctx context.Context, id uuid.UUID, dueAt time.Time, lastError string) error {
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+
+	if err := conn(ctx, r.db).
+		Model(&domain.OutboxAction{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{ // Keeps updated_at, the time the action was recorded
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": lastError,
+			"due_at":     dueAt,
+		}).Error; err != nil {
+		r.logger.Error("Failed to reschedule outbox action", zap.Error(err), zap.String("id", id.String()))
+		return fmt.Errorf("failed to reschedule outbox action: %w", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // projectRepository implements the ProjectRepository interface
@@ -32,7 +33,19 @@ func (r *projectRepository) Create(ctx context.Context, project *domain.Project)
 		zap.String("customer_id", project.CustomerID.String()),
 	)
 
-	if err := r.db.WithContext(ctx).Create(project).Error; err != nil {
+	if project.KeyPrefix == "" {
+		prefix, err := availableKeyPrefix(ctx, conn(ctx, r.db), project.Name)
+		if err != nil {
+			r.logger.Error("Failed to choose issue key prefix",
+				zap.Error(err),
+				zap.String("name", project.Name),
+			)
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+		project.KeyPrefix = prefix
+	}
+
+	if err := conn(ctx, r.db).Create(project).Error; err != nil {
 		r.logger.Error("Failed to create project",
 			zap.Error(err),
 			zap.String("name", project.Name),
@@ -53,7 +66,7 @@ func (r *projectRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 	r.logger.Debug("Retrieving project by ID", zap.String("project_id", id.String()))
 
 	var project domain.Project
-	if err := r.db.WithContext(ctx).Preload("Customer").Where("id = ?", id).First(&project).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Where("id = ?", id).First(&project).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Debug("Project not found", zap.String("project_id", id.String()))
 			return nil, domain.ErrProjectNotFound
@@ -74,7 +87,7 @@ func (r *projectRepository) GetByCustomerID(ctx context.Context, customerID uuid
 	r.logger.Debug("Retrieving projects by customer ID", zap.String("customer_id", customerID.String()))
 
 	var projects []*domain.Project
-	if err := r.db.WithContext(ctx).Preload("Customer").Where("customer_id = ?", customerID).Order("created_at DESC").Find(&projects).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Where("customer_id = ?", customerID).Order("created_at DESC").Find(&projects).Error; err != nil {
 		r.logger.Error("Failed to retrieve projects by customer ID",
 			zap.Error(err),
 			zap.String("customer_id", customerID.String()),
@@ -98,7 +111,7 @@ func (r *projectRepository) GetByName(ctx context.Context, customerID uuid.UUID,
 	)
 
 	var project domain.Project
-	if err := r.db.WithContext(ctx).Preload("Customer").Where("customer_id = ? AND name = ?", customerID, name).First(&project).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Where("customer_id = ? AND name = ?", customerID, name).First(&project).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Debug("Project not found",
 				zap.String("customer_id", customerID.String()),
@@ -121,11 +134,31 @@ func (r *projectRepository) GetByName(ctx context.Context, customerID uuid.UUID,
 	return &project, nil
 }
 
+// GetByInboundEmail retrieves the project receiving email at a support address
+func (r *projectRepository) GetByInboundEmail(ctx context.Context, address string) (*domain.Project, error) {
+	r.logger.Debug("Retrieving project by inbound email", zap.String("address", address))
+
+	var project domain.Project
+	if err := conn(ctx, r.db).Preload("Customer").Where("inbound_email = ?", address).First(&project).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrProjectNotFound
+		}
+		r.logger.Error("Failed to retrieve project by inbound email",
+			zap.Error(err),
+			zap.String("address", address),
+		)
+		return nil, fmt.Errorf("failed to retrieve project by inbound email: %w", err)
+	}
+
+	return &project, nil
+}
+
 // Update updates an existing project
 func (r *projectRepository) Update(ctx context.Context, project *domain.Project) error {
 	r.logger.Debug("Updating project", zap.String("project_id", project.ID.String()))
 
-	result := r.db.WithContext(ctx).Save(project)
+	// The issue counter is only advanced by NextIssueNumber; saving a stale copy must not rewind it
+	result := conn(ctx, r.db).Omit("issue_counter").Save(project)
 	if result.Error != nil {
 		r.logger.Error("Failed to update project",
 			zap.Error(result.Error),
@@ -147,11 +180,11 @@ func (r *projectRepository) Update(ctx context.Context, project *domain.Project)
 	return nil
 }
 
-// Delete removes a project from the database
+// Delete soft-deletes a project; it can be brought back with Restore
 func (r *projectRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.logger.Debug("Deleting project", zap.String("project_id", id.String()))
 
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Project{})
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.Project{})
 	if result.Error != nil {
 		r.logger.Error("Failed to delete project",
 			zap.Error(result.Error),
@@ -169,6 +202,32 @@ func (r *projectRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore clears the deletion mark of a soft-deleted project
+func (r *projectRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Restoring project", zap.String("project_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&domain.Project{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		r.logger.Error("Failed to restore project",
+			zap.Error(result.Error),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore project: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Deleted project not found for restore", zap.String("project_id", id.String()))
+		return domain.ErrProjectNotFound
+	}
+
+	r.logger.Info("Project restored successfully", zap.String("project_id", id.String()))
+	return nil
+}
+
 // List retrieves all projects with pagination
 func (r *projectRepository) List(ctx context.Context, offset, limit int) ([]*domain.Project, error) {
 	r.logger.Debug("Listing projects",
@@ -177,7 +236,7 @@ func (r *projectRepository) List(ctx context.Context, offset, limit int) ([]*dom
 	)
 
 	var projects []*domain.Project
-	if err := r.db.WithContext(ctx).Preload("Customer").Offset(offset).Limit(limit).Order("created_at DESC").Find(&projects).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Offset(offset).Limit(limit).Order("created_at DESC").Find(&projects).Error; err != nil {
 		r.logger.Error("Failed to list projects",
 			zap.Error(err),
 			zap.Int("offset", offset),
@@ -194,3 +253,194 @@ func (r *projectRepository) List(ctx context.Context, offset, limit int) ([]*dom
 
 	return projects, nil
 }
+
+// NextIssueNumber increments the project's issue counter in a single UPDATE, so the
+// database serializes concurrent callers, and returns the key prefix and new number
+func (r *projectRepository) NextIssueNumber(ctx context.Context, id uuid.UUID) (string, int, error) {
+	var project domain.Project
+	result := conn(ctx, r.db).
+		Model(&project).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "key_prefix"}, {Name: "issue_counter"}}}).
+		Where("id = ?", id).
+		UpdateColumn("issue_counter", gorm.Expr("issue_counter + 1"))
+	if result.Error != nil {
+		r.logger.Error("Failed to advance issue counter",
+			zap.Error(result.Error),
+			zap.String("project_id", id.String()),
+		)
+		return "", 0, fmt.Errorf("failed to advance issue counter: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Project not found for issue number", zap.String("project_id", id.String()))
+		return "", 0, domain.ErrProjectNotFound
+	}
+
+	if project.KeyPrefix == "" {
+		return "", 0, fmt.Errorf("project %s has no issue key prefix", id)
+	}
+
+	return project.KeyPrefix, project.IssueCounter, nil
+}
+
+// Merge moves everything of a project to another and soft-deletes it, in one transaction.
+// Issues keep their keys. Labels, milestones, components and custom fields the other project
+// has by the same name are merged into its own. Webhooks, escalation rules, recurring issues, the
+// workflow and the on-call schedule move unless the other project has the same one;
+// recurring issues that stay behind are removed so they do not keep filing issues.
+func (r *projectRepository) Merge(ctx context.Context, fromID, intoID uuid.UUID) error {
+	r.logger.Debug("Merging projects",
+		zap.String("from_project_id", fromID.String()),
+		zap.String("into_project_id", intoID.String()),
+	)
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Issues of the merged project could be in statuses the other workflow lacks
+		var workflows int64
+		if err := tx.Model(&domain.WorkflowDefinition{}).
+			Where("project_id IN ?", []uuid.UUID{fromID, intoID}).
+			Count(&workflows).Error; err != nil {
+			return err
+		}
+		if workflows == 2 {
+			return domain.ErrProjectWorkflowClash
+		}
+
+		// Deleted issues move too, so restoring them brings them back in the project merged into
+		if err := tx.Unscoped().Model(&domain.Issue{}).
+			Where("project_id = ?", fromID).
+			UpdateColumn("project_id", intoID).Error; err != nil {
+			return err
+		}
+
+		if err := mergeNamed(tx, "labels", "issue_labels", "label_id", fromID, intoID); err != nil {
+			return err
+		}
+		if err := mergeNamed(tx, "milestones", "issues", "milestone_id", fromID, intoID); err != nil {
+			return err
+		}
+		if err := mergeNamed(tx, "components", "issues", "component_id", fromID, intoID); err != nil {
+			return err
+		}
+		if err := mergeNamed(tx, "custom_field_definitions", "issue_custom_field_values", "field_id", fromID, intoID); err != nil {
+			return err
+		}
+
+		for table, keys := range map[string][]string{
+			"project_webhooks":     {"url"},
+			"escalation_rules":     {"priority"},
+			"recurring_issues":     {"title"},
+			"workflow_definitions": nil,
+			"oncall_schedules":     nil,
+		} {
+			if err := moveUnlessTaken(tx, table, keys, fromID, intoID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("project_id = ?", fromID).Delete(&domain.RecurringIssue{}).Error; err != nil {
+			return err
+		}
+
+		if err := mergeChannels(tx, fromID, intoID); err != nil {
+			return err
+		}
+
+		result := tx.Where("id = ?", fromID).Delete(&domain.Project{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrProjectNotFound
+		}
+		return nil
+	})
+	if err == domain.ErrProjectNotFound || err == domain.ErrProjectWorkflowClash {
+		return err
+	}
+	if err != nil {
+		r.logger.Error("Failed to merge projects",
+			zap.Error(err),
+			zap.String("from_project_id", fromID.String()),
+			zap.String("into_project_id", intoID.String()),
+		)
+		return fmt.Errorf("failed to merge projects: %w", err)
+	}
+
+	r.logger.Info("Projects merged successfully",
+		zap.String("from_project_id", fromID.String()),
+		zap.String("into_project_id", intoID.String()),
+	)
+
+	return nil
+}
+
+// mergeNamed moves the rows of table, such as labels, from one project to another. Rows
+// the other project has by the same name are dropped and the references to them in
+// refColumn of refTable point to the other project's row instead.
+func mergeNamed(tx *gorm.DB, table, refTable, refColumn string, fromID, intoID uuid.UUID) error {
+	if err := tx.Exec(fmt.Sprintf(`UPDATE %[2]s SET %[3]s = (
+			SELECT kept.id FROM %[1]s kept JOIN %[1]s merged ON merged.name = kept.name
+			WHERE merged.id = %[2]s.%[3]s AND kept.project_id = ?
+		) WHERE %[3]s IN (
+			SELECT merged.id FROM %[1]s merged JOIN %[1]s kept ON kept.name = merged.name
+			WHERE merged.project_id = ? AND kept.project_id = ?
+		)`, table, refTable, refColumn), intoID, fromID, intoID).Error; err != nil {
+		return fmt.Errorf("failed to merge %s: %w", table, err)
+	}
+
+	if err := tx.Exec(fmt.Sprintf(`DELETE FROM %[1]s WHERE project_id = ? AND name IN (
+			SELECT kept.name FROM %[1]s kept WHERE kept.project_id = ?
+		)`, table), fromID, intoID).Error; err != nil {
+		return fmt.Errorf("failed to merge %s: %w", table, err)
+	}
+
+	if err := tx.Exec(fmt.Sprintf(`UPDATE %s SET project_id = ? WHERE project_id = ?`, table), intoID, fromID).Error; err != nil {
+		return fmt.Errorf("failed to merge %s: %w", table, err)
+	}
+	return nil
+}
+
+// moveUnlessTaken moves the rows of table from one project to another, except those the
+// other project already has a row with the same keys of. With no keys a project has at
+// most one row, which moves if the other project has none.
+func moveUnlessTaken(tx *gorm.DB, table string, keys []string, fromID, intoID uuid.UUID) error {
+	taken := fmt.Sprintf("SELECT 1 FROM %[1]s taken WHERE taken.project_id = ?", table)
+	for _, key := range keys {
+		taken += fmt.Sprintf(" AND taken.%[2]s = %[1]s.%[2]s", table, key)
+	}
+
+	if err := tx.Exec(fmt.Sprintf(`UPDATE %s SET project_id = ? WHERE project_id = ? AND NOT EXISTS (%s)`, table, taken),
+		intoID, fromID, intoID).Error; err != nil {
+		return fmt.Errorf("failed to move %s: %w", table, err)
+	}
+	return nil
+}
+
+// mergeChannels moves the channels of a project to another. A channel tracking both keeps
+// the other project once: as its main project if either was, else as a further project.
+func mergeChannels(tx *gorm.DB, fromID, intoID uuid.UUID) error {
+	if err := tx.Unscoped().Model(&domain.Channel{}).
+		Where("project_id = ?", fromID).
+		UpdateColumn("project_id", intoID).Error; err != nil {
+		return fmt.Errorf("failed to move channels: %w", err)
+	}
+
+	mainChannels := tx.Unscoped().Model(&domain.Channel{}).Select("id").Where("project_id = ?", intoID)
+	if err := tx.Where("project_id IN ? AND channel_id IN (?)", []uuid.UUID{fromID, intoID}, mainChannels).
+		Delete(&domain.ChannelProject{}).Error; err != nil {
+		return fmt.Errorf("failed to move channel projects: %w", err)
+	}
+
+	tracking := tx.Model(&domain.ChannelProject{}).Select("channel_id").Where("project_id = ?", intoID)
+	if err := tx.Where("project_id = ? AND channel_id IN (?)", fromID, tracking).
+		Delete(&domain.ChannelProject{}).Error; err != nil {
+		return fmt.Errorf("failed to move channel projects: %w", err)
+	}
+
+	if err := tx.Model(&domain.ChannelProject{}).
+		Where("project_id = ?", fromID).
+		UpdateColumn("project_id", intoID).Error; err != nil {
+		return fmt.Errorf("failed to move channel projects: %w", err)
+	}
+	return nil
+}
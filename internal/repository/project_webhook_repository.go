@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// projectWebhookRepository implements the ProjectWebhookRepository interface
+type projectWebhookRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewProjectWebhookRepository creates a new instance of project webhook repository
+func NewProjectWebhookRepository(db *gorm.DB, logger *zap.Logger) domain.ProjectWebhookRepository {
+	return &projectWebhookRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new project webhook in the database
+func (r *projectWebhookRepository) Create(ctx context.Context, webhook *domain.ProjectWebhook) error {
+	r.logger.Debug("Creating project webhook", zap.String("project_id", webhook.ProjectID.String()))
+
+	if err := conn(ctx, r.db).Create(webhook).Error; err != nil {
+		r.logger.Error("Failed to create project webhook",
+			zap.Error(err),
+			zap.String("project_id", webhook.ProjectID.String()),
+		)
+		return fmt.Errorf("failed to create project webhook: %w", err)
+	}
+
+	r.logger.Info("Project webhook created successfully",
+		zap.String("webhook_id", webhook.ID.String()),
+		zap.String("project_id", webhook.ProjectID.String()),
+	)
+
+	return nil
+}
+
+// GetByProjectAndURL retrieves a project's webhook by its URL
+func (r *projectWebhookRepository) GetByProjectAndURL(ctx context.Context, projectID uuid.UUID, url string) (*domain.ProjectWebhook, error) {
+	r.logger.Debug("Retrieving project webhook by URL", zap.String("project_id", projectID.String()))
+
+	var webhook domain.ProjectWebhook
+	if err := conn(ctx, r.db).
+		Where("project_id = ? AND url = ?", projectID, url).
+		First(&webhook).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrWebhookNotFound
+		}
+		r.logger.Error("Failed to retrieve project webhook by URL",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve project webhook by URL: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// ListByProject retrieves all webhooks of a project, oldest first
+func (r *projectWebhookRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectWebhook, error) {
+	r.logger.Debug("Listing project webhooks", zap.String("project_id", projectID.String()))
+
+	var webhooks []*domain.ProjectWebhook
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&webhooks).Error; err != nil {
+		r.logger.Error("Failed to list project webhooks",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list project webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Delete removes a project webhook from the database
+func (r *projectWebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting project webhook", zap.String("webhook_id", id.String()))
+
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.ProjectWebhook{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete project webhook",
+			zap.Error(result.Error),
+			zap.String("webhook_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete project webhook: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWebhookNotFound
+	}
+
+	r.logger.Info("Project webhook deleted successfully", zap.String("webhook_id", id.String()))
+	return nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// recurringIssueRepository implements the RecurringIssueRepository interface
+type recurringIssueRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewRecurringIssueRepository creates a new instance of recurring issue repository
+func NewRecurringIssueRepository(db *gorm.DB, logger *zap.Logger) domain.RecurringIssueRepository {
+	return &recurringIssueRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new recurring issue in the database
+func (r *recurringIssueRepository) Create(ctx context.Context, recurring *domain.RecurringIssue) error {
+	r.logger.Debug("Creating recurring issue",
+		zap.String("project_id", recurring.ProjectID.String()),
+		zap.String("title", recurring.Title),
+	)
+
+	if err := conn(ctx, r.db).Omit("Channel").Create(recurring).Error; err != nil {
+		r.logger.Error("Failed to create recurring issue",
+			zap.Error(err),
+			zap.String("project_id", recurring.ProjectID.String()),
+			zap.String("title", recurring.Title),
+		)
+		return fmt.Errorf("failed to create recurring issue: %w", err)
+	}
+
+	r.logger.Info("Recurring issue created successfully",
+		zap.String("recurring_issue_id", recurring.ID.String()),
+		zap.String("title", recurring.Title),
+	)
+
+	return nil
+}
+
+// ListByProject retrieves a project's recurring issues in the order they were added
+func (r *recurringIssueRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.RecurringIssue, error) {
+	r.logger.Debug("Listing recurring issues by project", zap.String("project_id", projectID.String()))
+
+	var recurring []*domain.RecurringIssue
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC").
+		Find(&recurring).Error; err != nil {
+		r.logger.Error("Failed to list recurring issues by project",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list recurring issues by project: %w", err)
+	}
+
+	return recurring, nil
+}
+
+// ListDue retrieves the recurring issues due at now with their channels, earliest first
+func (r *recurringIssueRepository) ListDue(ctx context.Context, now time.Time) ([]*domain.RecurringIssue, error) {
+	r.logger.Debug("Listing due recurring issues", zap.Time("now", now))
+
+	var recurring []*domain.RecurringIssue
+	if err := conn(ctx, r.db).
+		Preload("Channel").
+		Where("next_run_at <= ?", now).
+		Order("next_run_at ASC").
+		Find(&recurring).Error; err != nil {
+		r.logger.Error("Failed to list due recurring issues", zap.Error(err))
+		return nil, fmt.Errorf("failed to list due recurring issues: %w", err)
+	}
+
+	return recurring, nil
+}
+
+// UpdateRun stores the last and next run times of a recurring issue
+func (r *recurringIssueRepository) UpdateRun(ctx context.Context, recurring *domain.RecurringIssue) error {
+	r.logger.Debug("Updating recurring issue run",
+		zap.String("recurring_issue_id", recurring.ID.String()),
+		zap.Time("next_run_at", recurring.NextRunAt),
+	)
+
+	if err := conn(ctx, r.db).Model(&domain.RecurringIssue{}).
+		Where("id = ?", recurring.ID).
+		Updates(map[string]interface{}{
+			"last_run_at": recurring.LastRunAt,
+			"next_run_at": recurring.NextRunAt,
+		}).Error; err != nil {
+		r.logger.Error("Failed to update recurring issue run",
+			zap.Error(err),
+			zap.String("recurring_issue_id", recurring.ID.String()),
+		)
+		return fmt.Errorf("failed to update recurring issue run: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a recurring issue. Issues it filed are kept.
+func (r *recurringIssueRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting recurring issue", zap.String("recurring_issue_id", id.String()))
+
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.RecurringIssue{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete recurring issue",
+			zap.Error(result.Error),
+			zap.String("recurring_issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete recurring issue: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrRecurringIssueNotFound
+	}
+
+	r.logger.Info("Recurring issue deleted successfully", zap.String("recurring_issue_id", id.String()))
+	return nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// redisChannelGetScript reads the generation of the cache and the lookup cached in it
+var redisChannelGetScript = redis.NewScript(`
+local gen = redis.call('GET', KEYS[1]) or '0'
+return {gen, redis.call('GET', ARGV[1] .. gen .. ':' .. ARGV[2])}
+`)
+
+// RedisChannelCache keeps channel registrations in Redis, so the replicas of the bot
+// share the cache and see each other's changes at once. Lookups are stored under a
+// generation that every change bumps: Redis cannot tell which entries a change
+// affects, so it drops them all, which is fine as registrations rarely change.
+type RedisChannelCache struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+	logger *zap.Logger
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// NewRedisChannelCache creates a channel cache keeping entries in Redis for ttl, under
+// keys starting with prefix
+func NewRedisChannelCache(client *redis.Client, prefix string, ttl time.Duration, logger *zap.Logger) *RedisChannelCache {
+	return &RedisChannelCache{
+		client: client,
+		prefix: prefix + "channel:",
+		ttl:    ttl,
+		logger: logger,
+	}
+}
+
+// Channels wraps a channel repository so it reads through the cache
+func (c *RedisChannelCache) Channels(repo domain.ChannelRepository) domain.ChannelRepository {
+	return &cachedChannelRepository{ChannelRepository: repo, cache: c}
+}
+
+// Projects wraps a project repository so changes to a project drop the cached channels
+func (c *RedisChannelCache) Projects(repo domain.ProjectRepository) domain.ProjectRepository {
+	return &cachedProjectRepository{ProjectRepository: repo, cache: c}
+}
+
+// Customers wraps a customer repository so changes to a customer drop the cached channels
+func (c *RedisChannelCache) Customers(repo domain.CustomerRepository) domain.CustomerRepository {
+	return &cachedCustomerRepository{CustomerRepository: repo, cache: c}
+}
+
+// Stats returns the hits and misses of this replica. Entries are not counted.
+func (c *RedisChannelCache) Stats() ChannelCacheStats {
+	return ChannelCacheStats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+// get returns the cached lookup of a Discord channel. Redis errors count as misses, so
+// lookups fall back to the database.
+func (c *RedisChannelCache) get(ctx context.Context, channelID string) (*domain.Channel, bool, uint64) {
+	result, err := redisChannelGetScript.Run(ctx, c.client, []string{c.prefix + "gen"}, c.prefix, channelID).Slice()
+	if err != nil {
+		c.logger.Warn("Failed to read cached channel", zap.Error(err), zap.String("channel_id", channelID))
+		c.misses.Add(1)
+		return nil, false, 0
+	}
+
+	if len(result) != 2 {
+		c.misses.Add(1)
+		return nil, false, 0
+	}
+	genStr, _ := result[0].(string)
+	gen, _ := strconv.ParseUint(genStr, 10, 64)
+	if result[1] == nil {
+		c.misses.Add(1)
+		return nil, false, gen
+	}
+
+	// An empty entry caches that the channel is not registered
+	value, _ := result[1].(string)
+	if value == "" {
+		c.hits.Add(1)
+		return nil, true, gen
+	}
+
+	var channel domain.Channel
+	if err := gob.NewDecoder(bytes.NewBufferString(value)).Decode(&channel); err != nil {
+		c.logger.Warn("Failed to decode cached channel", zap.Error(err), zap.String("channel_id", channelID))
+		c.misses.Add(1)
+		return nil, false, gen
+	}
+	c.hits.Add(1)
+	return &channel, true, gen
+}
+
+// put caches a lookup in generation gen. Lookups of an outdated generation are never
+// read again and expire with the ttl.
+func (c *RedisChannelCache) put(ctx context.Context, channelID string, channel *domain.Channel, gen uint64) {
+	var value bytes.Buffer
+	if channel != nil {
+		if err := gob.NewEncoder(&value).Encode(channel); err != nil {
+			c.logger.Warn("Failed to encode channel for the cache", zap.Error(err), zap.String("channel_id", channelID))
+			return
+		}
+	}
+
+	key := c.prefix + strconv.FormatUint(gen, 10) + ":" + channelID
+	if err := c.client.Set(ctx, key, value.String(), c.ttl).Err(); err != nil {
+		c.logger.Warn("Failed to cache channel", zap.Error(err), zap.String("channel_id", channelID))
+	}
+}
+
+// invalidate drops every cached lookup by starting a new generation, now and again once
+// the transaction in ctx commits
+func (c *RedisChannelCache) invalidate(ctx context.Context, _ func(channelID string, channel *domain.Channel) bool) {
+	purge := func() {
+		// The transaction may have committed after ctx was cancelled; the purge must still happen
+		ctx := context.WithoutCancel(ctx)
+		if err := c.client.Incr(ctx, c.prefix+"gen").Err(); err != nil {
+			c.logger.Error("Failed to drop cached channels; other replicas may see stale registrations until they expire", zap.Error(err))
+		}
+	}
+
+	purge()
+	afterCommit(ctx, purge)
+}
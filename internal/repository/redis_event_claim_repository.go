@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// redisEventClaimRepository implements the EventClaimRepository interface on Redis
+type redisEventClaimRepository struct {
+	client *redis.Client
+	prefix string
+	logger *zap.Logger
+}
+
+// NewRedisEventClaimRepository creates a new instance of event claim repository keeping
+// the claims in Redis, under keys starting with prefix
+func NewRedisEventClaimRepository(client *redis.Client, prefix string, logger *zap.Logger) domain.EventClaimRepository {
+	return &redisEventClaimRepository{
+		client: client,
+		prefix: prefix + "claim:",
+		logger: logger,
+	}
+}
+
+// Claim records the event with the given key as handled until ttl has passed
+func (r *redisEventClaimRepository) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	claimed, err := r.client.SetNX(ctx, r.prefix+key, "1", ttl).Result()
+	if err != nil {
+		r.logger.Error("Failed to claim event",
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return false, fmt.Errorf("failed to claim event: %w", err)
+	}
+	return claimed, nil
+}
+
+// DeleteExpired does nothing, as Redis removes expired claims itself
+func (r *redisEventClaimRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// rateLimitScript takes a slot of a sliding window kept as a sorted set of event times.
+// It returns {1, now} when the event is recorded, or {0, oldest} when the key is at its limit.
+var rateLimitScript = redis.NewScript(`
+local now, window, limit = tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+if redis.call('ZCARD', KEYS[1]) >= limit then
+	local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+	return {0, tonumber(oldest[2])}
+end
+redis.call('ZADD', KEYS[1], now, ARGV[4])
+redis.call('PEXPIRE', KEYS[1], window)
+return {1, now}
+`)
+
+// redisRateLimiter implements the RateLimiter interface on Redis, so every replica of
+// the bot counts the same events
+type redisRateLimiter struct {
+	client *redis.Client
+	prefix string
+	logger *zap.Logger
+
+	mu     sync.RWMutex
+	limit  int
+	window time.Duration
+}
+
+// NewRedisRateLimiter creates a rate limiter allowing limit events per key within window,
+// kept in Redis under keys starting with prefix; a limit of 0 allows every event
+func NewRedisRateLimiter(client *redis.Client, prefix string, limit int, window time.Duration, logger *zap.Logger) domain.RateLimiter {
+	return &redisRateLimiter{
+		client: client,
+		prefix: prefix + "rate-limit:",
+		limit:  limit,
+		window: window,
+		logger: logger,
+	}
+}
+
+// Take records an event for key if the key is below its limit and returns the time of
+// the event. Otherwise ok is false and at is when the next event will be allowed.
+func (l *redisRateLimiter) Take(ctx context.Context, key string) (time.Time, bool, error) {
+	l.mu.RLock()
+	limit, window := l.limit, l.window
+	l.mu.RUnlock()
+	if limit <= 0 {
+		return time.Time{}, true, nil
+	}
+
+	now := time.Now()
+	result, err := rateLimitScript.Run(ctx, l.client, []string{l.prefix + key},
+		now.UnixMilli(), window.Milliseconds(), limit, eventMember(now)).Int64Slice()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to take rate limit slot: %w", err)
+	}
+	if len(result) != 2 {
+		return time.Time{}, false, fmt.Errorf("failed to take rate limit slot: unexpected reply %v", result)
+	}
+	if result[0] == 1 {
+		return now, true, nil
+	}
+	return time.UnixMilli(result[1]).Add(window), false, nil
+}
+
+// Undo forgets the event recorded at at, e.g. because the action it allowed failed
+func (l *redisRateLimiter) Undo(ctx context.Context, key string, at time.Time) error {
+	if err := l.client.ZRem(ctx, l.prefix+key, eventMember(at)).Err(); err != nil {
+		return fmt.Errorf("failed to give back rate limit slot: %w", err)
+	}
+	return nil
+}
+
+// SetLimit changes the events allowed per key within window. Events already recorded
+// count towards the new limit.
+func (l *redisRateLimiter) SetLimit(limit int, window time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limit = limit
+	l.window = window
+}
+
+// eventMember identifies an event in the sorted set of its key
+func eventMember(at time.Time) string {
+	return strconv.FormatInt(at.UnixNano(), 10)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// takeScript returns the value of a key and deletes it, so only one replica gets it
+var takeScript = redis.NewScript(`
+local value = redis.call('GET', KEYS[1])
+if value then
+	redis.call('DEL', KEYS[1])
+end
+return value
+`)
+
+// redisStateStore implements the StateStore interface on Redis
+type redisStateStore struct {
+	client *redis.Client
+	prefix string
+	logger *zap.Logger
+}
+
+// NewRedisStateStore creates a state store keeping values in Redis, under keys starting with prefix
+func NewRedisStateStore(client *redis.Client, prefix string, logger *zap.Logger) domain.StateStore {
+	return &redisStateStore{
+		client: client,
+		prefix: prefix + "state:",
+		logger: logger,
+	}
+}
+
+// Put stores a value under key until ttl has passed
+func (s *redisStateStore) Put(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.prefix+key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store state: %w", err)
+	}
+	return nil
+}
+
+// Take removes and returns the value of key
+func (s *redisStateStore) Take(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := takeScript.Run(ctx, s.client, []string{s.prefix + key}).Text()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to take state: %w", err)
+	}
+	return []byte(value), true, nil
+}
@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// reportRepository implements the ReportRepository interface
+type reportRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewReportRepository creates a new instance of report repository
+func NewReportRepository(db *gorm.DB, logger *zap.Logger) domain.ReportRepository {
+	return &reportRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// scoped restricts a query on the issues table to the report scope. Deleted issues are
+// excluded explicitly because queries on joined tables do not get gorm's soft delete filter.
+func scoped(query *gorm.DB, scope domain.ReportScope) *gorm.DB {
+	query = query.Where("issues.deleted_at IS NULL")
+	if scope.ProjectID != nil {
+		query = query.Where("issues.project_id = ?", *scope.ProjectID)
+	}
+	if scope.ChannelID != nil {
+		query = query.Where("issues.channel_id = ?", *scope.ChannelID)
+	}
+	if scope.ComponentID != nil {
+		query = query.Where("issues.component_id = ?", *scope.ComponentID)
+	}
+	return query
+}
+
+// secondsBetween returns the SQL expression of the seconds from one timestamp column
+// to another, which each dialect computes differently
+func secondsBetween(db *gorm.DB, from, to string) string {
+	if db.Dialector.Name() == "postgres" {
+		return fmt.Sprintf("EXTRACT(EPOCH FROM (%s - %s))", to, from)
+	}
+	return fmt.Sprintf("(JULIANDAY(%s) - JULIANDAY(%s)) * 86400", to, from)
+}
+
+// durationAggregate is a count of rows and the mean of their durations in seconds
+type durationAggregate struct {
+	Count      int64
+	AvgSeconds float64
+}
+
+// mean returns the mean duration
+func (a durationAggregate) mean() time.Duration {
+	return time.Duration(a.AvgSeconds * float64(time.Second))
+}
+
+// GetPeriodSummary counts issues opened, closed and resolved between from and to
+func (r *reportRepository) GetPeriodSummary(ctx context.Context, scope domain.ReportScope, from, to time.Time) (*domain.PeriodSummary, error) {
+	r.logger.Debug("Computing period summary",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	summary := &domain.PeriodSummary{}
+
+	if err := scoped(conn(ctx, r.db).Model(&domain.Issue{}), scope).
+		Where("issues.created_at >= ? AND issues.created_at < ?", from, to).
+		Count(&summary.Opened).Error; err != nil {
+		r.logger.Error("Failed to count opened issues", zap.Error(err))
+		return nil, fmt.Errorf("failed to count opened issues: %w", err)
+	}
+
+	var closed durationAggregate
+	if err := scoped(conn(ctx, r.db).Model(&domain.Issue{}), scope).
+		Select("COUNT(*) AS count, COALESCE(AVG("+secondsBetween(r.db, "issues.created_at", "issues.closed_at")+"), 0) AS avg_seconds").
+		Where("issues.closed_at >= ? AND issues.closed_at < ?", from, to).
+		Scan(&closed).Error; err != nil {
+		r.logger.Error("Failed to compute time to close", zap.Error(err))
+		return nil, fmt.Errorf("failed to compute time to close: %w", err)
+	}
+	summary.Closed = closed.Count
+	summary.AvgTimeToClose = closed.mean()
+
+	if err := scoped(conn(ctx, r.db).Model(&domain.IssueStatusLog{}), scope).
+		Joins("JOIN issues ON issues.id = issue_status_logs.issue_id").
+		Where("issue_status_logs.new_status = ?", domain.StatusResolved).
+		Where("issue_status_logs.old_status IS NULL OR issue_status_logs.old_status <> issue_status_logs.new_status").
+		Where("issue_status_logs.changed_at >= ? AND issue_status_logs.changed_at < ?", from, to).
+		Distinct("issue_status_logs.issue_id").
+		Count(&summary.Resolved).Error; err != nil {
+		r.logger.Error("Failed to count resolved issues", zap.Error(err))
+		return nil, fmt.Errorf("failed to count resolved issues: %w", err)
+	}
+
+	r.logger.Debug("Period summary computed",
+		zap.Int64("opened", summary.Opened),
+		zap.Int64("closed", summary.Closed),
+		zap.Int64("resolved", summary.Resolved),
+	)
+
+	return summary, nil
+}
+
+// CountUnresolvedByPriority counts unresolved issues per priority
+func (r *reportRepository) CountUnresolvedByPriority(ctx context.Context, scope domain.ReportScope) ([]domain.PriorityCount, error) {
+	r.logger.Debug("Counting unresolved issues by priority")
+
+	var counts []domain.PriorityCount
+	if err := scoped(conn(ctx, r.db).Model(&domain.Issue{}), scope).
+		Select("issues.priority AS priority, COUNT(*) AS count").
+		Where("issues.status IN ?", domain.UnresolvedStatuses()).
+		Group("issues.priority").
+		Scan(&counts).Error; err != nil {
+		r.logger.Error("Failed to count unresolved issues by priority", zap.Error(err))
+		return nil, fmt.Errorf("failed to count unresolved issues by priority: %w", err)
+	}
+
+	return counts, nil
+}
+
+// CountByStatus counts issues created between from and to per status
+func (r *reportRepository) CountByStatus(ctx context.Context, scope domain.ReportScope, from, to time.Time) ([]domain.StatusCount, error) {
+	r.logger.Debug("Counting issues by status",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	var counts []domain.StatusCount
+	if err := scoped(conn(ctx, r.db).Model(&domain.Issue{}), scope).
+		Select("issues.status AS status, COUNT(*) AS count").
+		Where("issues.created_at >= ? AND issues.created_at < ?", from, to).
+		Group("issues.status").
+		Scan(&counts).Error; err != nil {
+		r.logger.Error("Failed to count issues by status", zap.Error(err))
+		return nil, fmt.Errorf("failed to count issues by status: %w", err)
+	}
+
+	return counts, nil
+}
+
+// CountByPriority counts issues created between from and to per priority
+func (r *reportRepository) CountByPriority(ctx context.Context, scope domain.ReportScope, from, to time.Time) ([]domain.PriorityCount, error) {
+	r.logger.Debug("Counting issues by priority",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	var counts []domain.PriorityCount
+	if err := scoped(conn(ctx, r.db).Model(&domain.Issue{}), scope).
+		Select("issues.priority AS priority, COUNT(*) AS count").
+		Where("issues.created_at >= ? AND issues.created_at < ?", from, to).
+		Group("issues.priority").
+		Scan(&counts).Error; err != nil {
+		r.logger.Error("Failed to count issues by priority", zap.Error(err))
+		return nil, fmt.Errorf("failed to count issues by priority: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetMeanResolutionTime averages the time from creation to first resolution of issues
+// created between from and to. An issue counts as resolved when it first reaches
+// resolved, or closed if it skipped resolution. It returns the number of resolved issues.
+func (r *reportRepository) GetMeanResolutionTime(ctx context.Context, scope domain.ReportScope, from, to time.Time) (int64, time.Duration, error) {
+	r.logger.Debug("Computing mean resolution time",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	resolutions := scoped(conn(ctx, r.db).Model(&domain.IssueStatusLog{}), scope).
+		Select("issues.created_at AS created_at, MIN(issue_status_logs.changed_at) AS resolved_at").
+		Joins("JOIN issues ON issues.id = issue_status_logs.issue_id").
+		Where("issue_status_logs.new_status IN ?", []domain.Status{domain.StatusResolved, domain.StatusClosed}).
+		Where("issue_status_logs.old_status IS NULL OR issue_status_logs.old_status <> issue_status_logs.new_status").
+		Where("issues.created_at >= ? AND issues.created_at < ?", from, to).
+		Group("issues.id, issues.created_at")
+
+	var resolved durationAggregate
+	if err := conn(ctx, r.db).
+		Table("(?) AS resolutions", resolutions).
+		Select("COUNT(*) AS count, COALESCE(AVG(" + secondsBetween(r.db, "resolutions.created_at", "resolutions.resolved_at") + "), 0) AS avg_seconds").
+		Scan(&resolved).Error; err != nil {
+		r.logger.Error("Failed to compute mean resolution time", zap.Error(err))
+		return 0, 0, fmt.Errorf("failed to compute mean resolution time: %w", err)
+	}
+
+	return resolved.Count, resolved.mean(), nil
+}
+
+// CountCreatedPerDay counts issues created between from and to per day in loc, oldest first.
+// Days without issues are left out. Days are counted here rather than in SQL because the
+// databases disagree on time zone conversion.
+func (r *reportRepository) CountCreatedPerDay(ctx context.Context, scope domain.ReportScope, from, to time.Time, loc *time.Location) ([]domain.DailyCount, error) {
+	r.logger.Debug("Counting issues created per day",
+		zap.Time("from", from),
+		zap.Time("to", to),
+		zap.String("location", loc.String()),
+	)
+
+	var createdAt []time.Time
+	if err := scoped(conn(ctx, r.db).Model(&domain.Issue{}), scope).
+		Where("issues.created_at >= ? AND issues.created_at < ?", from, to).
+		Pluck("issues.created_at", &createdAt).Error; err != nil {
+		r.logger.Error("Failed to count issues created per day", zap.Error(err))
+		return nil, fmt.Errorf("failed to count issues created per day: %w", err)
+	}
+
+	perDay := make(map[time.Time]int64)
+	for _, t := range createdAt {
+		year, month, day := t.In(loc).Date()
+		perDay[time.Date(year, month, day, 0, 0, 0, 0, loc)]++
+	}
+
+	counts := make([]domain.DailyCount, 0, len(perDay))
+	for date, count := range perDay {
+		counts = append(counts, domain.DailyCount{Date: date, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Date.Before(counts[j].Date)
+	})
+
+	return counts, nil
+}
+
+// GetAssigneeWorkload counts open and closed issues per assignee for issues created
+// between from and to, busiest assignee first. Users holding several roles on one
+// issue are counted once.
+func (r *reportRepository) GetAssigneeWorkload(ctx context.Context, scope domain.ReportScope, from, to time.Time) ([]domain.AssigneeWorkload, error) {
+	r.logger.Debug("Computing assignee workload",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	var workload []domain.AssigneeWorkload
+	if err := scoped(conn(ctx, r.db).Model(&domain.IssueAssignee{}), scope).
+		Select("users.id AS user_id, users.name AS name, users.discord_id AS discord_id, "+
+			"COUNT(DISTINCT CASE WHEN issues.status <> ? THEN issues.id END) AS open_issues, "+
+			"COUNT(DISTINCT CASE WHEN issues.status = ? THEN issues.id END) AS closed_issues",
+			domain.StatusClosed, domain.StatusClosed).
+		Joins("JOIN issues ON issues.id = issue_assignees.issue_id").
+		Joins("JOIN users ON users.id = issue_assignees.user_id AND users.deleted_at IS NULL").
+		Where("issues.created_at >= ? AND issues.created_at < ?", from, to).
+		Group("users.id, users.name, users.discord_id").
+		Order("open_issues DESC, closed_issues DESC").
+		Scan(&workload).Error; err != nil {
+		r.logger.Error("Failed to compute assignee workload", zap.Error(err))
+		return nil, fmt.Errorf("failed to compute assignee workload: %w", err)
+	}
+
+	return workload, nil
+}
+
+// GetTimeSpentByUser sums the time each user logged on issues between from and to, most first.
+// Running timers are counted once they are stopped.
+func (r *reportRepository) GetTimeSpentByUser(ctx context.Context, scope domain.ReportScope, from, to time.Time) ([]domain.UserTimeSpent, error) {
+	r.logger.Debug("Computing time spent by user",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	var spent []domain.UserTimeSpent
+	if err := scoped(conn(ctx, r.db).Model(&domain.IssueWorklog{}), scope).
+		Select("users.id AS user_id, users.name AS name, users.discord_id AS discord_id, "+
+			"SUM(issue_worklogs.seconds) AS seconds").
+		Joins("JOIN issues ON issues.id = issue_worklogs.issue_id").
+		Joins("JOIN users ON users.id = issue_worklogs.user_id").
+		Where("issue_worklogs.ended_at >= ? AND issue_worklogs.ended_at < ?", from, to).
+		Group("users.id, users.name, users.discord_id").
+		Order("seconds DESC").
+		Scan(&spent).Error; err != nil {
+		r.logger.Error("Failed to compute time spent by user", zap.Error(err))
+		return nil, fmt.Errorf("failed to compute time spent by user: %w", err)
+	}
+
+	return spent, nil
+}
+
+// GetCSAT averages the satisfaction ratings given or changed between from and to
+func (r *reportRepository) GetCSAT(ctx context.Context, scope domain.ReportScope, from, to time.Time) (*domain.CSATSummary, error) {
+	r.logger.Debug("Computing CSAT",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	var csat domain.CSATSummary
+	if err := scoped(conn(ctx, r.db).Model(&domain.IssueFeedback{}), scope).
+		Select("COUNT(*) AS responses, COALESCE(AVG(issue_feedback.rating), 0) AS average").
+		Joins("JOIN issues ON issues.id = issue_feedback.issue_id").
+		Where("issue_feedback.updated_at >= ? AND issue_feedback.updated_at < ?", from, to).
+		Scan(&csat).Error; err != nil {
+		r.logger.Error("Failed to compute CSAT", zap.Error(err))
+		return nil, fmt.Errorf("failed to compute CSAT: %w", err)
+	}
+
+	return &csat, nil
+}
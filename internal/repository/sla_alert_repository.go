@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// slaAlertRepository implements the SLAAlertRepository interface
+type slaAlertRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewSLAAlertRepository creates a new instance of SLA alert repository
+func NewSLAAlertRepository(db *gorm.DB, logger *zap.Logger) domain.SLAAlertRepository {
+	return &slaAlertRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create records a sent SLA alert
+func (r *slaAlertRepository) Create(ctx context.Context, alert *domain.SLAAlert) error {
+	r.logger.Debug("Creating SLA alert",
+		zap.String("issue_id", alert.IssueID.String()),
+		zap.String("kind", string(alert.Kind)),
+		zap.String("level", string(alert.Level)),
+	)
+
+	if err := conn(ctx, r.db).Create(alert).Error; err != nil {
+		r.logger.Error("Failed to create SLA alert",
+			zap.Error(err),
+			zap.String("issue_id", alert.IssueID.String()),
+		)
+		return fmt.Errorf("failed to create SLA alert: %w", err)
+	}
+
+	return nil
+}
+
+// Exists checks whether an alert of the given kind and level was already sent for an issue
+func (r *slaAlertRepository) Exists(ctx context.Context, issueID uuid.UUID, kind domain.SLAKind, level domain.SLALevel) (bool, error) {
+	var count int64
+	if err := conn(ctx, r.db).
+		Model(&domain.SLAAlert{}).
+		Where("issue_id = ? AND kind = ? AND level = ?", issueID, kind, level).
+		Count(&count).Error; err != nil {
+		r.logger.Error("Failed to check SLA alert",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return false, fmt.Errorf("failed to check SLA alert: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// GetByIssueID retrieves all SLA alerts sent for an issue, oldest first
+func (r *slaAlertRepository) GetByIssueID(ctx context.Context, issueID uuid.UUID) ([]*domain.SLAAlert, error) {
+	r.logger.Debug("Retrieving SLA alerts by issue ID", zap.String("issue_id", issueID.String()))
+
+	var alerts []*domain.SLAAlert
+	if err := conn(ctx, r.db).
+		Where("issue_id = ?", issueID).
+		Order("sent_at ASC").
+		Find(&alerts).Error; err != nil {
+		r.logger.Error("Failed to retrieve SLA alerts",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve SLA alerts: %w", err)
+	}
+
+	return alerts, nil
+}
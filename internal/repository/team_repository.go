@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// teamRepository implements the TeamRepository interface
+type teamRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewTeamRepository creates a new instance of team repository
+func NewTeamRepository(db *gorm.DB, logger *zap.Logger) domain.TeamRepository {
+	return &teamRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetMember retrieves the profile of a user in a guild
+func (r *teamRepository) GetMember(ctx context.Context, guildID string, userID uuid.UUID) (*domain.TeamMember, error) {
+	var member domain.TeamMember
+	if err := conn(ctx, r.db).
+		Preload("User").
+		Where("guild_id = ? AND user_id = ?", guildID, userID).
+		First(&member).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrTeamMemberNotFound
+		}
+		r.logger.Error("Failed to retrieve team member",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve team member: %w", err)
+	}
+
+	return &member, nil
+}
+
+// ListMembers retrieves a guild's team members with their users, by name
+func (r *teamRepository) ListMembers(ctx context.Context, guildID string) ([]*domain.TeamMember, error) {
+	r.logger.Debug("Listing team members", zap.String("guild_id", guildID))
+
+	var members []*domain.TeamMember
+	if err := conn(ctx, r.db).
+		Preload("User").
+		Where("guild_id = ?", guildID).
+		Order("LOWER(name) ASC").
+		Find(&members).Error; err != nil {
+		r.logger.Error("Failed to list team members", zap.Error(err), zap.String("guild_id", guildID))
+		return nil, fmt.Errorf("failed to list team members: %w", err)
+	}
+
+	return members, nil
+}
+
+// SaveMember creates or updates a team member profile
+func (r *teamRepository) SaveMember(ctx context.Context, member *domain.TeamMember) error {
+	if err := conn(ctx, r.db).Omit("User").Save(member).Error; err != nil {
+		r.logger.Error("Failed to save team member",
+			zap.Error(err),
+			zap.String("guild_id", member.GuildID),
+			zap.String("user_id", member.UserID.String()),
+		)
+		return fmt.Errorf("failed to save team member: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteMember deletes the profile of a user in a guild
+func (r *teamRepository) DeleteMember(ctx context.Context, guildID string, userID uuid.UUID) error {
+	result := conn(ctx, r.db).
+		Where("guild_id = ? AND user_id = ?", guildID, userID).
+		Delete(&domain.TeamMember{})
+	if result.Error != nil {
+		r.logger.Error("Failed to delete team member",
+			zap.Error(result.Error),
+			zap.String("guild_id", guildID),
+			zap.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("failed to delete team member: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrTeamMemberNotFound
+	}
+
+	return nil
+}
+
+// CountOpenIssues counts the unresolved issues each of the users is assigned, in any role.
+// Users without any are left out of the map.
+func (r *teamRepository) CountOpenIssues(ctx context.Context, userIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		UserID uuid.UUID
+		Count  int
+	}
+	if err := conn(ctx, r.db).
+		Model(&domain.IssueAssignee{}).
+		Select("issue_assignees.user_id, COUNT(DISTINCT issues.id) AS count").
+		Joins("JOIN issues ON issues.id = issue_assignees.issue_id AND issues.deleted_at IS NULL").
+		Where("issue_assignees.user_id IN ?", userIDs).
+		Where("issues.status NOT IN ?", []domain.Status{domain.StatusResolved, domain.StatusVerified, domain.StatusClosed, domain.StatusRejected}).
+		Group("issue_assignees.user_id").
+		Scan(&rows).Error; err != nil {
+		r.logger.Error("Failed to count open issues of team members", zap.Error(err))
+		return nil, fmt.Errorf("failed to count open issues: %w", err)
+	}
+
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+// ReplaceSuggestions replaces the suggested assignees of an issue
+func (r *teamRepository) ReplaceSuggestions(ctx context.Context, issueID uuid.UUID, suggestions []domain.IssueSuggestedAssignee) error {
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("issue_id = ?", issueID).Delete(&domain.IssueSuggestedAssignee{}).Error; err != nil {
+			return err
+		}
+		if len(suggestions) == 0 {
+			return nil
+		}
+		return tx.Omit("User").Create(&suggestions).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to replace suggested assignees",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return fmt.Errorf("failed to replace suggested assignees: %w", err)
+	}
+
+	return nil
+}
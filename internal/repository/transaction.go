@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// txKey is the context key of the transaction started by a unit of work
+type txKey struct{}
+
+// txState is the transaction running in a context and the work waiting for its commit
+type txState struct {
+	tx          *gorm.DB
+	afterCommit []func()
+}
+
+// unitOfWork implements the UnitOfWork interface with gorm transactions
+type unitOfWork struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewUnitOfWork creates a new instance of unit of work
+func NewUnitOfWork(db *gorm.DB, logger *zap.Logger) domain.UnitOfWork {
+	return &unitOfWork{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Do runs fn in a transaction that is committed if fn returns nil and rolled back
+// otherwise. Repository calls made with the context passed to fn take part in the
+// transaction; calls nested in another Do join the outer transaction.
+func (u *unitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	state := &txState{}
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		state.tx = tx
+		return fn(context.WithValue(ctx, txKey{}, state))
+	})
+	if err != nil {
+		u.logger.Debug("Transaction rolled back", zap.Error(err))
+		return err
+	}
+
+	for _, f := range state.afterCommit {
+		f()
+	}
+
+	return nil
+}
+
+// conn returns the transaction running in ctx, or db outside a transaction, bound to ctx
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// inTransaction checks if ctx carries a running transaction
+func inTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*txState)
+	return ok
+}
+
+// afterCommit runs f once the transaction running in ctx is committed, or right away
+// outside a transaction. f is dropped if the transaction is rolled back.
+func afterCommit(ctx context.Context, f func()) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, f)
+		return
+	}
+	f()
+}
+
+// AfterCommit runs f once the transaction running in ctx is committed, or right away
+// outside a transaction. f gets ctx without the transaction, which is over by then.
+func (u *unitOfWork) AfterCommit(ctx context.Context, f func(ctx context.Context)) {
+	afterCommit(ctx, func() {
+		f(context.WithValue(ctx, txKey{}, nil))
+	})
+}
@@ -33,7 +33,7 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 		zap.String("role", string(user.Role)),
 	)
 
-	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
+	if err := conn(ctx, r.db).Create(user).Error; err != nil {
 		r.logger.Error("Failed to create user",
 			zap.Error(err),
 			zap.String("discord_id", user.DiscordID),
@@ -54,7 +54,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Use
 	r.logger.Debug("Retrieving user by ID", zap.String("user_id", id.String()))
 
 	var user domain.User
-	if err := r.db.WithContext(ctx).Preload("Customer").Where("id = ?", id).First(&user).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Where("id = ?", id).First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Debug("User not found", zap.String("user_id", id.String()))
 			return nil, domain.ErrUserNotFound
@@ -75,7 +75,7 @@ func (r *userRepository) GetByDiscordID(ctx context.Context, discordID string) (
 	r.logger.Debug("Retrieving user by Discord ID", zap.String("discord_id", discordID))
 
 	var user domain.User
-	if err := r.db.WithContext(ctx).Preload("Customer").Where("discord_id = ?", discordID).First(&user).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Where("discord_id = ?", discordID).First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			r.logger.Debug("User not found", zap.String("discord_id", discordID))
 			return nil, domain.ErrUserNotFound
@@ -91,12 +91,79 @@ func (r *userRepository) GetByDiscordID(ctx context.Context, discordID string) (
 	return &user, nil
 }
 
+// GetOrCreateByDiscordID retrieves a user by Discord ID, creating them with a name and role
+// when they are not known yet. Callers pass the role explicitly so that nobody is granted
+// support access just by being mentioned. A deleted user still holds the Discord ID, so
+// they are restored instead, with the role passed rather than the one they had.
+func (r *userRepository) GetOrCreateByDiscordID(ctx context.Context, discordID, name string, role domain.UserRole) (*domain.User, error) {
+	user, err := r.GetByDiscordID(ctx, discordID)
+	if err != domain.ErrUserNotFound {
+		return user, err
+	}
+
+	var deleted domain.User
+	err = conn(ctx, r.db).
+		Unscoped().
+		Where("discord_id = ? AND deleted_at IS NOT NULL", discordID).
+		First(&deleted).Error
+	switch {
+	case err == nil:
+		if err := r.Restore(ctx, deleted.ID); err != nil {
+			return nil, err
+		}
+		if err := conn(ctx, r.db).Model(&deleted).Update("role", role).Error; err != nil {
+			r.logger.Error("Failed to reset role of restored user",
+				zap.Error(err),
+				zap.String("user_id", deleted.ID.String()),
+			)
+			return nil, fmt.Errorf("failed to reset role of restored user: %w", err)
+		}
+		return r.GetByDiscordID(ctx, discordID)
+	case err != gorm.ErrRecordNotFound:
+		r.logger.Error("Failed to check deleted user",
+			zap.Error(err),
+			zap.String("discord_id", discordID),
+		)
+		return nil, fmt.Errorf("failed to check deleted user: %w", err)
+	}
+
+	user = &domain.User{
+		ID:        uuid.New(),
+		Name:      name,
+		DiscordID: discordID,
+		Role:      role,
+	}
+	if err := r.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetByEmail retrieves a user by email address, case-insensitively
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	r.logger.Debug("Retrieving user by email", zap.String("email", email))
+
+	var user domain.User
+	if err := conn(ctx, r.db).Preload("Customer").Where("LOWER(email) = LOWER(?)", email).Order("created_at ASC").First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrUserNotFound
+		}
+		r.logger.Error("Failed to retrieve user by email",
+			zap.Error(err),
+			zap.String("email", email),
+		)
+		return nil, fmt.Errorf("failed to retrieve user by email: %w", err)
+	}
+
+	return &user, nil
+}
+
 // GetByCustomerID retrieves all users for a customer
 func (r *userRepository) GetByCustomerID(ctx context.Context, customerID uuid.UUID) ([]*domain.User, error) {
 	r.logger.Debug("Retrieving users by customer ID", zap.String("customer_id", customerID.String()))
 
 	var users []*domain.User
-	if err := r.db.WithContext(ctx).Preload("Customer").Where("customer_id = ?", customerID).Order("created_at DESC").Find(&users).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Where("customer_id = ?", customerID).Order("created_at DESC").Find(&users).Error; err != nil {
 		r.logger.Error("Failed to retrieve users by customer ID",
 			zap.Error(err),
 			zap.String("customer_id", customerID.String()),
@@ -116,7 +183,7 @@ func (r *userRepository) GetByCustomerID(ctx context.Context, customerID uuid.UU
 func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 	r.logger.Debug("Updating user", zap.String("user_id", user.ID.String()))
 
-	result := r.db.WithContext(ctx).Save(user)
+	result := conn(ctx, r.db).Save(user)
 	if result.Error != nil {
 		r.logger.Error("Failed to update user",
 			zap.Error(result.Error),
@@ -138,11 +205,11 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
-// Delete removes a user from the database
+// Delete soft-deletes an user; it can be brought back with Restore
 func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	r.logger.Debug("Deleting user", zap.String("user_id", id.String()))
 
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&domain.User{})
+	result := conn(ctx, r.db).Where("id = ?", id).Delete(&domain.User{})
 	if result.Error != nil {
 		r.logger.Error("Failed to delete user",
 			zap.Error(result.Error),
@@ -160,6 +227,32 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore clears the deletion mark of a soft-deleted user
+func (r *userRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Restoring user", zap.String("user_id", id.String()))
+
+	result := conn(ctx, r.db).
+		Unscoped().
+		Model(&domain.User{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		r.logger.Error("Failed to restore user",
+			zap.Error(result.Error),
+			zap.String("user_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore user: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		r.logger.Debug("Deleted user not found for restore", zap.String("user_id", id.String()))
+		return domain.ErrUserNotFound
+	}
+
+	r.logger.Info("User restored successfully", zap.String("user_id", id.String()))
+	return nil
+}
+
 // List retrieves all users with pagination
 func (r *userRepository) List(ctx context.Context, offset, limit int) ([]*domain.User, error) {
 	r.logger.Debug("Listing users",
@@ -168,7 +261,7 @@ func (r *userRepository) List(ctx context.Context, offset, limit int) ([]*domain
 	)
 
 	var users []*domain.User
-	if err := r.db.WithContext(ctx).Preload("Customer").Offset(offset).Limit(limit).Order("created_at DESC").Find(&users).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Customer").Offset(offset).Limit(limit).Order("created_at DESC").Find(&users).Error; err != nil {
 		r.logger.Error("Failed to list users",
 			zap.Error(err),
 			zap.Int("offset", offset),
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// workflowRepository implements the WorkflowRepository interface
+type workflowRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+// NewWorkflowRepository creates a new instance of workflow repository
+func NewWorkflowRepository(db *gorm.DB, logger *zap.Logger) domain.WorkflowRepository {
+	return &workflowRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetByProjectID retrieves a project's workflow with its statuses and transitions
+func (r *workflowRepository) GetByProjectID(ctx context.Context, projectID uuid.UUID) (*domain.WorkflowDefinition, error) {
+	r.logger.Debug("Retrieving workflow by project ID", zap.String("project_id", projectID.String()))
+
+	var workflow domain.WorkflowDefinition
+	if err := conn(ctx, r.db).
+		Preload("Statuses", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Preload("Transitions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		}).
+		Where("project_id = ?", projectID).
+		First(&workflow).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, domain.ErrWorkflowNotFound
+		}
+		r.logger.Error("Failed to retrieve workflow by project ID",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to retrieve workflow: %w", err)
+	}
+
+	return &workflow, nil
+}
+
+// Create creates a new workflow definition in the database
+func (r *workflowRepository) Create(ctx context.Context, workflow *domain.WorkflowDefinition) error {
+	r.logger.Debug("Creating workflow", zap.String("project_id", workflow.ProjectID.String()))
+
+	if err := conn(ctx, r.db).Omit("Statuses", "Transitions").Create(workflow).Error; err != nil {
+		r.logger.Error("Failed to create workflow",
+			zap.Error(err),
+			zap.String("project_id", workflow.ProjectID.String()),
+		)
+		return fmt.Errorf("failed to create workflow: %w", err)
+	}
+
+	r.logger.Info("Workflow created successfully",
+		zap.String("workflow_id", workflow.ID.String()),
+		zap.String("project_id", workflow.ProjectID.String()),
+	)
+
+	return nil
+}
+
+// Delete removes a workflow definition with its statuses and transitions
+func (r *workflowRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug("Deleting workflow", zap.String("workflow_id", id.String()))
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workflow_id = ?", id).Delete(&domain.WorkflowTransition{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("workflow_id = ?", id).Delete(&domain.WorkflowStatus{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.WorkflowDefinition{}, "id = ?", id).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to delete workflow",
+			zap.Error(err),
+			zap.String("workflow_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete workflow: %w", err)
+	}
+
+	r.logger.Info("Workflow deleted successfully", zap.String("workflow_id", id.String()))
+	return nil
+}
+
+// AddStatus adds a custom status to a workflow
+func (r *workflowRepository) AddStatus(ctx context.Context, status *domain.WorkflowStatus) error {
+	r.logger.Debug("Adding workflow status",
+		zap.String("workflow_id", status.WorkflowID.String()),
+		zap.String("status", string(status.Status)),
+	)
+
+	if err := conn(ctx, r.db).Create(status).Error; err != nil {
+		r.logger.Error("Failed to add workflow status",
+			zap.Error(err),
+			zap.String("workflow_id", status.WorkflowID.String()),
+			zap.String("status", string(status.Status)),
+		)
+		return fmt.Errorf("failed to add workflow status: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveStatus removes a custom status and the transitions from or to it
+func (r *workflowRepository) RemoveStatus(ctx context.Context, workflowID uuid.UUID, status domain.Status) error {
+	r.logger.Debug("Removing workflow status",
+		zap.String("workflow_id", workflowID.String()),
+		zap.String("status", string(status)),
+	)
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("workflow_id = ? AND (from_status = ? OR to_status = ?)", workflowID, status, status).
+			Delete(&domain.WorkflowTransition{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("workflow_id = ? AND status = ?", workflowID, status).Delete(&domain.WorkflowStatus{}).Error
+	})
+	if err != nil {
+		r.logger.Error("Failed to remove workflow status",
+			zap.Error(err),
+			zap.String("workflow_id", workflowID.String()),
+			zap.String("status", string(status)),
+		)
+		return fmt.Errorf("failed to remove workflow status: %w", err)
+	}
+
+	return nil
+}
+
+// AddTransition adds a custom transition to a workflow
+func (r *workflowRepository) AddTransition(ctx context.Context, transition *domain.WorkflowTransition) error {
+	r.logger.Debug("Adding workflow transition",
+		zap.String("workflow_id", transition.WorkflowID.String()),
+		zap.String("from", string(transition.FromStatus)),
+		zap.String("to", string(transition.ToStatus)),
+	)
+
+	if err := conn(ctx, r.db).Create(transition).Error; err != nil {
+		r.logger.Error("Failed to add workflow transition",
+			zap.Error(err),
+			zap.String("workflow_id", transition.WorkflowID.String()),
+		)
+		return fmt.Errorf("failed to add workflow transition: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveTransition removes a custom transition and returns the number removed
+func (r *workflowRepository) RemoveTransition(ctx context.Context, workflowID uuid.UUID, from, to domain.Status) (int64, error) {
+	r.logger.Debug("Removing workflow transition",
+		zap.String("workflow_id", workflowID.String()),
+		zap.String("from", string(from)),
+		zap.String("to", string(to)),
+	)
+
+	result := conn(ctx, r.db).
+		Where("workflow_id = ? AND from_status = ? AND to_status = ?", workflowID, from, to).
+		Delete(&domain.WorkflowTransition{})
+	if result.Error != nil {
+		r.logger.Error("Failed to remove workflow transition",
+			zap.Error(result.Error),
+			zap.String("workflow_id", workflowID.String()),
+		)
+		return 0, fmt.Errorf("failed to remove workflow transition: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
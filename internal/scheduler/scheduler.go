@@ -0,0 +1,203 @@
+// Package scheduler runs periodic background jobs such as SLA checks and digest reports.
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// JobFunc is a unit of periodic work
+type JobFunc func(ctx context.Context) error
+
+// job is a registered periodic job. Interval jobs set interval; cron jobs set schedule.
+type job struct {
+	name     string
+	interval time.Duration
+	schedule *CronSchedule
+	run      JobFunc
+	paused   *atomic.Bool // Set while the job is turned off, e.g. by a configuration reload
+}
+
+// Elector decides which of several replicas of the bot runs the jobs
+type Elector interface {
+	// IsLeader reports whether this replica runs the jobs, becoming leader if no replica is
+	IsLeader(ctx context.Context) (bool, error)
+}
+
+// Scheduler runs registered jobs at fixed intervals or on cron schedules until its context is cancelled
+type Scheduler struct {
+	jobs    []job
+	elector Elector // nil runs every job in this process
+	wg      sync.WaitGroup
+	logger  *zap.Logger
+}
+
+// New creates a new scheduler. With an elector, the jobs of each run are skipped on the
+// replicas that are not the leader.
+func New(elector Elector, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		elector: elector,
+		logger:  logger,
+	}
+}
+
+// Add registers a job. Jobs must be added before Start is called.
+func (s *Scheduler) Add(name string, interval time.Duration, run JobFunc) {
+	s.jobs = append(s.jobs, job{
+		name:     name,
+		interval: interval,
+		run:      run,
+		paused:   &atomic.Bool{},
+	})
+}
+
+// AddCron registers a job that runs at the times matched by schedule.
+// Jobs must be added before Start is called.
+func (s *Scheduler) AddCron(name string, schedule *CronSchedule, run JobFunc) {
+	s.jobs = append(s.jobs, job{
+		name:     name,
+		schedule: schedule,
+		run:      run,
+		paused:   &atomic.Bool{},
+	})
+}
+
+// SetEnabled turns a registered job on or off without stopping its goroutine, and
+// reports whether a job with that name is registered. Jobs start enabled.
+func (s *Scheduler) SetEnabled(name string, enabled bool) bool {
+	found := false
+	for _, j := range s.jobs {
+		if j.name == name {
+			j.paused.Store(!enabled)
+			found = true
+		}
+	}
+	return found
+}
+
+// Start launches one goroutine per job. Interval jobs run once immediately and
+// then on every tick; cron jobs wait for their next scheduled time. All jobs
+// stop when ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		if j.schedule != nil {
+			go s.cronLoop(ctx, j)
+		} else {
+			go s.loop(ctx, j)
+		}
+	}
+}
+
+// Wait blocks until all job goroutines have stopped
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// loop runs a single job until ctx is cancelled
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	defer s.wg.Done()
+
+	s.logger.Info("Starting scheduled job",
+		zap.String("job", j.name),
+		zap.Duration("interval", j.interval),
+	)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Stopped scheduled job", zap.String("job", j.name))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// cronLoop runs a cron job at each scheduled time until ctx is cancelled
+func (s *Scheduler) cronLoop(ctx context.Context, j job) {
+	defer s.wg.Done()
+
+	s.logger.Info("Starting scheduled job",
+		zap.String("job", j.name),
+		zap.String("schedule", j.schedule.String()),
+	)
+
+	for {
+		next := j.schedule.Next(time.Now())
+		if next.IsZero() {
+			s.logger.Error("Scheduled job has no upcoming run", zap.String("job", j.name))
+			return
+		}
+
+		s.logger.Debug("Next scheduled run",
+			zap.String("job", j.name),
+			zap.Time("at", next),
+		)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.logger.Info("Stopped scheduled job", zap.String("job", j.name))
+			return
+		case <-timer.C:
+		}
+
+		s.runOnce(ctx, j)
+	}
+}
+
+// runOnce runs a job and logs failures and panics without stopping the scheduler
+func (s *Scheduler) runOnce(ctx context.Context, j job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error("Scheduled job panicked",
+				zap.String("job", j.name),
+				zap.Any("panic", r),
+			)
+		}
+	}()
+
+	if j.paused.Load() {
+		s.logger.Debug("Skipping disabled scheduled job", zap.String("job", j.name))
+		return
+	}
+
+	if s.elector != nil {
+		leader, err := s.elector.IsLeader(ctx)
+		if err != nil {
+			s.logger.Error("Failed to check if this replica runs scheduled jobs",
+				zap.String("job", j.name),
+				zap.Error(err),
+			)
+			return
+		}
+		if !leader {
+			s.logger.Debug("Skipping scheduled job run by another replica", zap.String("job", j.name))
+			return
+		}
+	}
+
+	start := time.Now()
+	if err := j.run(ctx); err != nil {
+		s.logger.Error("Scheduled job failed",
+			zap.String("job", j.name),
+			zap.Error(err),
+		)
+		return
+	}
+
+	s.logger.Debug("Scheduled job completed",
+		zap.String("job", j.name),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
@@ -0,0 +1,104 @@
+// Package secrets loads configuration secrets, such as the Discord token and the database
+// password, from an external store at startup and watches them for rotation.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Provider reads the secrets of the bot from an external store
+type Provider interface {
+	// Name identifies the store in logs, e.g. "vault"
+	Name() string
+
+	// Fetch returns the current secrets keyed by the configuration key they set, e.g.
+	// "discord.token"
+	Fetch(ctx context.Context) (map[string]string, error)
+}
+
+// Flatten turns a secret document into configuration keys. Nested objects are joined
+// with dots, so {"discord": {"token": "..."}} and {"discord.token": "..."} both set
+// discord.token. Keys are lower-cased, as configuration keys are.
+func Flatten(doc map[string]any) map[string]string {
+	values := make(map[string]string)
+	flatten("", doc, values)
+	return values
+}
+
+// flatten adds the values of doc to values, with their keys prefixed by prefix
+func flatten(prefix string, doc map[string]any, values map[string]string) {
+	for key, value := range doc {
+		key = strings.ToLower(prefix + key)
+		switch v := value.(type) {
+		case map[string]any:
+			flatten(key+".", v, values)
+		case string:
+			values[key] = v
+		case nil:
+		default:
+			// Numbers and booleans, e.g. a port, are kept in their JSON and YAML form
+			values[key] = fmt.Sprint(v)
+		}
+	}
+}
+
+// Watcher fetches the secrets of a provider periodically and reports the ones that changed
+type Watcher struct {
+	provider Provider
+	interval time.Duration
+	current  map[string]string
+	logger   *zap.Logger
+}
+
+// NewWatcher creates a watcher of the secrets of provider, which were initial when
+// the configuration was loaded
+func NewWatcher(provider Provider, initial map[string]string, interval time.Duration, logger *zap.Logger) *Watcher {
+	return &Watcher{
+		provider: provider,
+		interval: interval,
+		current:  maps.Clone(initial),
+		logger:   logger,
+	}
+}
+
+// Run fetches the secrets every interval until ctx is cancelled, calling onChange with
+// the new value of every secret that changed. A failed fetch is logged and retried at
+// the next interval; the secrets in use stay valid meanwhile.
+func (w *Watcher) Run(ctx context.Context, onChange func(key, value string)) {
+	w.logger.Info("Watching secrets for rotation",
+		zap.String("provider", w.provider.Name()),
+		zap.Duration("interval", w.interval),
+	)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		values, err := w.provider.Fetch(ctx)
+		if err != nil {
+			w.logger.Error("Failed to fetch secrets", zap.String("provider", w.provider.Name()), zap.Error(err))
+			continue
+		}
+
+		for key, value := range values {
+			if old, ok := w.current[key]; ok && old == value {
+				continue
+			}
+			w.logger.Info("Secret rotated", zap.String("key", key))
+			w.current[key] = value
+			onChange(key, value)
+		}
+	}
+}
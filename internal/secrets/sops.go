@@ -0,0 +1,47 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// sopsProvider reads a SOPS-encrypted YAML or JSON file. It runs the sops command,
+// which decrypts with whichever key the file was encrypted for (age, PGP or a cloud KMS).
+type sopsProvider struct {
+	file string
+}
+
+// NewSOPSProvider creates a provider decrypting the given file with sops, which must be
+// on the PATH
+func NewSOPSProvider(file string) (Provider, error) {
+	if _, err := exec.LookPath("sops"); err != nil {
+		return nil, fmt.Errorf("the sops command is needed to decrypt %s: %w", file, err)
+	}
+	return &sopsProvider{file: file}, nil
+}
+
+// Name identifies the store in logs
+func (p *sopsProvider) Name() string {
+	return "sops"
+}
+
+// Fetch decrypts the file, so secrets rotated by re-encrypting it are picked up
+func (p *sopsProvider) Fetch(ctx context.Context) (map[string]string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "sops", "--decrypt", "--output-type", "json", p.file)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w: %s", p.file, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(stdout.Bytes(), &doc); err != nil {
+		return nil, fmt.Errorf("decrypted %s is not an object of secrets: %w", p.file, err)
+	}
+	return Flatten(doc), nil
+}
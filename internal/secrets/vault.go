@@ -0,0 +1,72 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// vaultProvider reads a secret of a HashiCorp Vault KV version 2 secrets engine
+type vaultProvider struct {
+	address string
+	token   string
+	mount   string
+	path    string
+	client  *http.Client
+}
+
+// NewVaultProvider creates a provider reading the secret at path of the KV version 2
+// engine mounted at mount, e.g. "secret" and "fix-track-bot"
+func NewVaultProvider(address, token, mount, path string) Provider {
+	return &vaultProvider{
+		address: strings.TrimRight(address, "/"),
+		token:   token,
+		mount:   strings.Trim(mount, "/"),
+		path:    strings.Trim(path, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name identifies the store in logs
+func (p *vaultProvider) Name() string {
+	return "vault"
+}
+
+// Fetch reads the latest version of the secret
+func (p *vaultProvider) Fetch(ctx context.Context) (map[string]string, error) {
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", p.address, url.PathEscape(p.mount), p.path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret from Vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("vault answered %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var secret struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("failed to decode Vault secret: %w", err)
+	}
+	if secret.Data.Data == nil {
+		return nil, fmt.Errorf("vault secret %s/%s has no data; is it a KV version 2 secret?", p.mount, p.path)
+	}
+	return Flatten(secret.Data.Data), nil
+}
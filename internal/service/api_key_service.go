@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// apiKeyService implements the APIKeyService interface
+type apiKeyService struct {
+	channelRepo  domain.ChannelRepository
+	customerRepo domain.CustomerRepository
+	apiKeyRepo   domain.APIKeyRepository
+	auditor      domain.Auditor
+	now          func() time.Time
+	logger       *zap.Logger
+}
+
+// NewAPIKeyService creates a new instance of API key service
+func NewAPIKeyService(
+	channelRepo domain.ChannelRepository,
+	customerRepo domain.CustomerRepository,
+	apiKeyRepo domain.APIKeyRepository,
+	auditor domain.Auditor,
+	logger *zap.Logger,
+) domain.APIKeyService {
+	return &apiKeyService{
+		channelRepo:  channelRepo,
+		customerRepo: customerRepo,
+		apiKeyRepo:   apiKeyRepo,
+		auditor:      auditor,
+		now:          time.Now,
+		logger:       logger,
+	}
+}
+
+// CreateKey issues a key for the customer of the project registered to a Discord channel
+func (s *apiKeyService) CreateKey(ctx context.Context, discordChannelID, name, scopes, createdBy string) (*domain.APIKey, string, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	customerID := channel.Project.CustomerID
+	return s.create(ctx, &customerID, name, scopes, createdBy)
+}
+
+// CreateOperatorKey issues a key from the CLI for a customer, or for all customers when nil
+func (s *apiKeyService) CreateOperatorKey(ctx context.Context, customerID *uuid.UUID, name, scopes string) (*domain.APIKey, string, error) {
+	if customerID != nil {
+		if _, err := s.customerRepo.GetByID(ctx, *customerID); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return s.create(ctx, customerID, name, scopes, "")
+}
+
+// create validates and stores a new key and returns it with its token
+func (s *apiKeyService) create(ctx context.Context, customerID *uuid.UUID, name, scopes, createdBy string) (*domain.APIKey, string, error) {
+	name = domain.NormalizeAPIKeyName(name)
+	if !domain.IsValidAPIKeyName(name) {
+		return nil, "", domain.ErrInvalidAPIKeyName
+	}
+	parsed, err := domain.ParseAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	token, prefix, err := domain.GenerateAPIKey()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	key := &domain.APIKey{
+		ID:         uuid.New(),
+		CustomerID: customerID,
+		Name:       name,
+		Prefix:     prefix,
+		Hash:       domain.HashAPIKey(token),
+		Scopes:     domain.JoinAPIKeyScopes(parsed),
+		CreatedBy:  createdBy,
+	}
+	if err := s.apiKeyRepo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditAPIKeyCreated,
+		TargetType: domain.AuditTargetAPIKey,
+		TargetID:   key.ID.String(),
+		TargetName: fmt.Sprintf("%s (%s)", key.Name, key.Prefix),
+		After:      map[string]string{"scopes": key.Scopes},
+	})
+
+	s.logger.Info("API key issued",
+		zap.String("api_key_id", key.ID.String()),
+		zap.String("prefix", key.Prefix),
+		zap.String("scopes", key.Scopes),
+		zap.String("created_by", createdBy),
+	)
+
+	return key, token, nil
+}
+
+// ListKeys lists the active keys of the customer of the project registered to a Discord channel
+func (s *apiKeyService) ListKeys(ctx context.Context, discordChannelID string) ([]*domain.APIKey, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.apiKeyRepo.ListByCustomer(ctx, &channel.Project.CustomerID, false)
+}
+
+// RevokeKey revokes an active key of the channel's customer by its prefix
+func (s *apiKeyService) RevokeKey(ctx context.Context, discordChannelID, prefix string) (*domain.APIKey, error) {
+	keys, err := s.ListKeys(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix = strings.TrimSpace(prefix)
+	var key *domain.APIKey
+	for _, k := range keys {
+		if k.Prefix == prefix {
+			key = k
+			break
+		}
+	}
+	if key == nil {
+		return nil, domain.ErrAPIKeyNotFound
+	}
+
+	now := s.now()
+	if err := s.apiKeyRepo.Revoke(ctx, key.ID, now); err != nil {
+		return nil, err
+	}
+	key.RevokedAt = &now
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditAPIKeyRevoked,
+		TargetType: domain.AuditTargetAPIKey,
+		TargetID:   key.ID.String(),
+		TargetName: fmt.Sprintf("%s (%s)", key.Name, key.Prefix),
+	})
+
+	s.logger.Info("API key revoked",
+		zap.String("api_key_id", key.ID.String()),
+		zap.String("prefix", key.Prefix),
+	)
+
+	return key, nil
+}
+
+// Authenticate returns the active key of a token and records its use
+func (s *apiKeyService) Authenticate(ctx context.Context, token string) (*domain.APIKey, error) {
+	if !strings.HasPrefix(token, domain.APIKeyTokenPrefix) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	key, err := s.apiKeyRepo.GetByHash(ctx, domain.HashAPIKey(token))
+	if err == domain.ErrAPIKeyNotFound {
+		return nil, domain.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if key.IsRevoked() {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	// Recording every request would write on each read; a minute is precise enough
+	now := s.now()
+	if key.NeedsTouch(now) {
+		if err := s.apiKeyRepo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			s.logger.Warn("Failed to record API key use",
+				zap.Error(err),
+				zap.String("api_key_id", key.ID.String()),
+			)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxAuditLogEntries caps how many audit log entries one request returns
+const maxAuditLogEntries = 100
+
+// auditService implements the AuditLogService interface
+type auditService struct {
+	auditRepo domain.AuditLogRepository
+	uow       domain.UnitOfWork
+	logger    *zap.Logger
+}
+
+// NewAuditService creates a new instance of audit service
+func NewAuditService(auditRepo domain.AuditLogRepository, uow domain.UnitOfWork, logger *zap.Logger) domain.AuditLogService {
+	return &auditService{
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger,
+	}
+}
+
+// Record stores an audit log entry attributed to the actor in ctx. Inside a unit of work
+// the entry is stored once it commits: a failed write would otherwise abort the
+// transaction, and actions rolled back leave no entry.
+func (s *auditService) Record(ctx context.Context, entry domain.AuditEntry) {
+	log := &domain.AuditLog{
+		ID:         uuid.New(),
+		GuildID:    entry.GuildID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		TargetName: entry.TargetName,
+		Before:     s.encode(entry.Before),
+		After:      s.encode(entry.After),
+	}
+	if actor, ok := domain.ActorFromContext(ctx); ok {
+		log.ActorID = actor.DiscordID
+		if log.GuildID == "" {
+			log.GuildID = actor.GuildID
+		}
+	}
+
+	s.uow.AfterCommit(ctx, func(ctx context.Context) {
+		if err := s.auditRepo.Create(ctx, log); err != nil {
+			s.logger.Error("Failed to record audit log entry",
+				zap.Error(err),
+				zap.String("action", string(entry.Action)),
+				zap.String("target_id", entry.TargetID),
+				zap.String("actor_id", log.ActorID),
+			)
+			return
+		}
+
+		s.logger.Info("Audit log entry recorded",
+			zap.String("action", string(entry.Action)),
+			zap.String("target_id", entry.TargetID),
+			zap.String("actor_id", log.ActorID),
+			zap.String("guild_id", log.GuildID),
+		)
+	})
+}
+
+// encode renders changed values as JSON, or an empty string if there are none
+func (s *auditService) encode(values any) string {
+	if values == nil {
+		return ""
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		s.logger.Warn("Failed to encode audit log values", zap.Error(err))
+		return fmt.Sprint(values)
+	}
+	return string(data)
+}
+
+// ListRecent retrieves the latest audit log entries of a guild, newest first
+func (s *auditService) ListRecent(ctx context.Context, guildID string, limit int) ([]*domain.AuditLog, error) {
+	if limit <= 0 || limit > maxAuditLogEntries {
+		limit = maxAuditLogEntries
+	}
+	return s.auditRepo.ListByGuildID(ctx, guildID, limit)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// boardService implements the BoardService interface
+type boardService struct {
+	boardRepo domain.BoardRepository
+	issueRepo domain.IssueRepository
+	logger    *zap.Logger
+}
+
+// NewBoardService creates a new instance of board service
+func NewBoardService(
+	boardRepo domain.BoardRepository,
+	issueRepo domain.IssueRepository,
+	logger *zap.Logger,
+) domain.BoardService {
+	return &boardService{
+		boardRepo: boardRepo,
+		issueRepo: issueRepo,
+		logger:    logger,
+	}
+}
+
+// GetBoard retrieves the board of a channel registration
+func (s *boardService) GetBoard(ctx context.Context, channelID uuid.UUID) (*domain.Board, error) {
+	return s.boardRepo.GetByChannelID(ctx, channelID)
+}
+
+// SaveBoard records the message showing the board of a channel registration. A channel has
+// one board, so the board it replaces is returned for its message to be cleaned up.
+func (s *boardService) SaveBoard(ctx context.Context, channel *domain.Channel, messageID, createdBy string) (*domain.Board, error) {
+	previous, err := s.boardRepo.GetByChannelID(ctx, channel.ID)
+	if err != nil && !errors.Is(err, domain.ErrBoardNotFound) {
+		return nil, err
+	}
+
+	board := &domain.Board{
+		ChannelID:        channel.ID,
+		DiscordChannelID: channel.DiscordChannelID,
+		MessageID:        messageID,
+		CreatedBy:        createdBy,
+	}
+	if err := s.boardRepo.Save(ctx, board); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Board posted",
+		zap.String("channel_id", channel.ID.String()),
+		zap.String("message_id", messageID),
+		zap.String("created_by", createdBy),
+	)
+
+	return previous, nil
+}
+
+// RemoveBoard forgets the board of a channel registration
+func (s *boardService) RemoveBoard(ctx context.Context, channelID uuid.UUID) error {
+	if err := s.boardRepo.Delete(ctx, channelID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Board removed", zap.String("channel_id", channelID.String()))
+	return nil
+}
+
+// GetColumns sorts the issues of a channel registration into board columns
+func (s *boardService) GetColumns(ctx context.Context, channelID uuid.UUID) ([]*domain.BoardColumn, error) {
+	var statuses []domain.Status
+	for _, column := range domain.NewBoardColumns(nil) {
+		statuses = append(statuses, column.Statuses...)
+	}
+
+	issues, _, err := s.issueRepo.Query(ctx, domain.IssueQuery{ChannelID: channelID, Statuses: statuses})
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewBoardColumns(issues), nil
+}
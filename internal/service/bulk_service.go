@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// errBulkRolledBack rolls back the transaction of a dry run or of a bulk operation
+// that some issue refused
+var errBulkRolledBack = errors.New("bulk operation rolled back")
+
+// bulkService implements the BulkService interface
+type bulkService struct {
+	channelRepo          domain.ChannelRepository
+	issueService         domain.IssueService
+	issueAssigneeService domain.IssueAssigneeService
+	labelService         domain.LabelService
+	uow                  domain.UnitOfWork
+	logger               *zap.Logger
+}
+
+// NewBulkService creates a new instance of bulk service
+func NewBulkService(
+	channelRepo domain.ChannelRepository,
+	issueService domain.IssueService,
+	issueAssigneeService domain.IssueAssigneeService,
+	labelService domain.LabelService,
+	uow domain.UnitOfWork,
+	logger *zap.Logger,
+) domain.BulkService {
+	return &bulkService{
+		channelRepo:          channelRepo,
+		issueService:         issueService,
+		issueAssigneeService: issueAssigneeService,
+		labelService:         labelService,
+		uow:                  uow,
+		logger:               logger,
+	}
+}
+
+// Apply makes the change of a bulk request to every issue it selects in one transaction.
+// A dry run makes the changes too, to find out which issues refuse them, and then rolls
+// them back; events of the rolled back changes are never published.
+func (s *bulkService) Apply(ctx context.Context, req domain.BulkRequest) (*domain.BulkResult, error) {
+	s.logger.Debug("Applying bulk operation",
+		zap.String("action", string(req.Action)),
+		zap.String("discord_channel_id", req.ChannelID),
+		zap.Int("issue_ids", len(req.IssueIDs)),
+		zap.String("status", string(req.Status)),
+		zap.Bool("dry_run", req.DryRun),
+	)
+
+	if err := validateBulkRequest(&req); err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, req.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.BulkResult{}
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		items, err := s.selectIssues(ctx, channel, req)
+		if err != nil {
+			return err
+		}
+
+		failed := false
+		for i := range items {
+			item := &items[i]
+			if item.Outcome == domain.BulkOutcomeFailed {
+				failed = true
+				continue
+			}
+
+			outcome, err := s.apply(ctx, req, item.Issue)
+			if err != nil {
+				if !isBulkRefusal(err) {
+					return err
+				}
+				item.Outcome = domain.BulkOutcomeFailed
+				item.Err = err
+				failed = true
+				continue
+			}
+			item.Outcome = outcome
+		}
+		result.Items = items
+
+		if failed || req.DryRun {
+			return errBulkRolledBack
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errBulkRolledBack) {
+		s.logger.Error("Failed to apply bulk operation",
+			zap.Error(err),
+			zap.String("action", string(req.Action)),
+			zap.String("discord_channel_id", req.ChannelID),
+		)
+		return nil, err
+	}
+	result.Applied = err == nil
+
+	s.logger.Info("Bulk operation finished",
+		zap.String("action", string(req.Action)),
+		zap.String("discord_channel_id", req.ChannelID),
+		zap.Int("changed", result.Count(domain.BulkOutcomeChanged)),
+		zap.Int("failed", result.Count(domain.BulkOutcomeFailed)),
+		zap.Bool("applied", result.Applied),
+	)
+
+	return result, nil
+}
+
+// validateBulkRequest checks the options of a bulk request and normalizes them
+func validateBulkRequest(req *domain.BulkRequest) error {
+	if !req.Action.IsValid() {
+		return domain.ErrInvalidBulkAction
+	}
+
+	if len(req.IssueIDs) == 0 {
+		if req.Status == "" {
+			return domain.ErrNoBulkIssues
+		}
+		if !domain.IsValidStatus(req.Status) && req.Status != domain.StatusDraft {
+			return domain.ErrInvalidStatus
+		}
+	}
+	if len(req.IssueIDs) > domain.MaxBulkIssues {
+		return domain.ErrTooManyBulkIssues
+	}
+
+	switch req.Action {
+	case domain.BulkActionAssign:
+		if strings.TrimSpace(req.AssigneeID) == "" {
+			return domain.ErrInvalidDiscordID
+		}
+		if !req.AssigneeRole.IsValid() {
+			return domain.ErrInvalidAssigneeRole
+		}
+	case domain.BulkActionLabel:
+		req.LabelName = domain.NormalizeLabelName(req.LabelName)
+		if !domain.IsValidLabelName(req.LabelName) {
+			return domain.ErrInvalidLabelName
+		}
+	}
+	return nil
+}
+
+// selectIssues finds the issues of the channel a bulk request names, or those with
+// its status. IDs that match no issue of the channel are reported as failed items.
+func (s *bulkService) selectIssues(ctx context.Context, channel *domain.Channel, req domain.BulkRequest) ([]domain.BulkItem, error) {
+	if len(req.IssueIDs) == 0 {
+		issues, total, err := s.issueService.ListIssuesByChannelPage(ctx, channel.DiscordChannelID,
+			domain.IssueFilter{Status: req.Status}, 0, domain.MaxBulkIssues)
+		if err != nil {
+			return nil, err
+		}
+		if total > domain.MaxBulkIssues {
+			return nil, domain.ErrTooManyBulkIssues
+		}
+
+		items := make([]domain.BulkItem, 0, len(issues))
+		for _, issue := range issues {
+			// Reload to get the assignees and labels
+			issue, err := s.issueService.GetIssue(ctx, issue.ID)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, domain.BulkItem{Issue: issue})
+		}
+		return items, nil
+	}
+
+	items := make([]domain.BulkItem, 0, len(req.IssueIDs))
+	seen := make(map[uuid.UUID]bool, len(req.IssueIDs))
+	for _, id := range req.IssueIDs {
+		issue, err := s.findIssue(ctx, channel, id)
+		if err != nil {
+			if !isBulkRefusal(err) {
+				return nil, err
+			}
+			items = append(items, domain.BulkItem{IssueID: id, Outcome: domain.BulkOutcomeFailed, Err: err})
+			continue
+		}
+		if seen[issue.ID] {
+			continue
+		}
+		seen[issue.ID] = true
+		items = append(items, domain.BulkItem{IssueID: id, Issue: issue})
+	}
+	return items, nil
+}
+
+// findIssue finds an issue of the channel by its key, its full ID or an ID prefix
+// matching no other issue of the channel
+func (s *bulkService) findIssue(ctx context.Context, channel *domain.Channel, id string) (*domain.Issue, error) {
+	var (
+		issue *domain.Issue
+		err   error
+	)
+	if _, ok := domain.NormalizeIssueKey(id); ok {
+		issue, err = s.issueService.GetIssueByKey(ctx, id)
+	} else if issueID, parseErr := uuid.Parse(id); parseErr == nil {
+		issue, err = s.issueService.GetIssue(ctx, issueID)
+	} else {
+		var issues []*domain.Issue
+		issues, err = s.issueService.SearchIssuesByPartialID(ctx, id, channel.DiscordChannelID)
+		switch {
+		case err != nil:
+		case len(issues) == 0:
+			err = domain.ErrIssueNotFound
+		case len(issues) > 1:
+			err = domain.ErrAmbiguousIssueID
+		default:
+			issue, err = s.issueService.GetIssue(ctx, issues[0].ID)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.ChannelID == nil || *issue.ChannelID != channel.ID {
+		return nil, domain.ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+// apply makes the change of a bulk request to one issue. Issues that already are
+// closed, assigned or labelled are left unchanged.
+func (s *bulkService) apply(ctx context.Context, req domain.BulkRequest, issue *domain.Issue) (domain.BulkOutcome, error) {
+	switch req.Action {
+	case domain.BulkActionClose:
+		if issue.Status == domain.StatusClosed {
+			return domain.BulkOutcomeUnchanged, nil
+		}
+		if err := s.issueService.CloseIssue(ctx, issue.ID, req.ActorID); err != nil {
+			return "", err
+		}
+	case domain.BulkActionAssign:
+		for _, assignee := range issue.Assignees {
+			if assignee.User.DiscordID == req.AssigneeID && assignee.Role == req.AssigneeRole {
+				return domain.BulkOutcomeUnchanged, nil
+			}
+		}
+		if _, err := s.issueAssigneeService.AssignUserToIssue(ctx, issue.ID, req.AssigneeID, req.AssigneeRole); err != nil {
+			return "", err
+		}
+	case domain.BulkActionLabel:
+		for _, label := range issue.Labels {
+			if label.Name == req.LabelName {
+				return domain.BulkOutcomeUnchanged, nil
+			}
+		}
+		if _, err := s.labelService.AddLabelToIssue(ctx, issue.ID, req.LabelName, ""); err != nil {
+			return "", err
+		}
+	default:
+		return "", domain.WithDetail(domain.ErrInvalidBulkAction, "%s", req.Action)
+	}
+	return domain.BulkOutcomeChanged, nil
+}
+
+// isBulkRefusal checks if an error is an issue refusing a bulk change, which is reported
+// per issue, rather than a failure of the whole operation
+func isBulkRefusal(err error) bool {
+	return errors.Is(err, domain.ErrIssueNotFound) ||
+		errors.Is(err, domain.ErrAmbiguousIssueID) ||
+		errors.Is(err, domain.ErrInvalidStatus) ||
+		errors.Is(err, domain.ErrInvalidStatusTransition) ||
+		errors.Is(err, domain.ErrOpenSubIssues)
+}
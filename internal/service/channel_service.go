@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"fix-track-bot/internal/domain"
 
@@ -16,6 +18,8 @@ type channelService struct {
 	customerRepo domain.CustomerRepository
 	projectRepo  domain.ProjectRepository
 	userRepo     domain.UserRepository
+	uow          domain.UnitOfWork
+	auditor      domain.Auditor
 	logger       *zap.Logger
 }
 
@@ -25,6 +29,8 @@ func NewChannelService(
 	customerRepo domain.CustomerRepository,
 	projectRepo domain.ProjectRepository,
 	userRepo domain.UserRepository,
+	uow domain.UnitOfWork,
+	auditor domain.Auditor,
 	logger *zap.Logger,
 ) domain.ChannelService {
 	return &channelService{
@@ -32,13 +38,16 @@ func NewChannelService(
 		customerRepo: customerRepo,
 		projectRepo:  projectRepo,
 		userRepo:     userRepo,
+		uow:          uow,
+		auditor:      auditor,
 		logger:       logger,
 	}
 }
 
-// getOrCreateCustomer gets existing customer or creates a new one
-func (s *channelService) getOrCreateCustomer(ctx context.Context, name, email string) (*domain.Customer, error) {
-	customer, err := s.customerRepo.GetByName(ctx, name)
+// getOrCreateCustomer gets the guild's customer with a name or creates a new one in the
+// guild. Customers of other guilds are never reused, even when their name matches.
+func (s *channelService) getOrCreateCustomer(ctx context.Context, guildID, name, email string) (*domain.Customer, error) {
+	customer, err := s.customerRepo.GetByNameInGuild(ctx, guildID, name)
 	if err != nil && err != domain.ErrCustomerNotFound {
 		return nil, fmt.Errorf("failed to check existing customer: %w", err)
 	}
@@ -47,11 +56,25 @@ func (s *channelService) getOrCreateCustomer(ctx context.Context, name, email st
 		return customer, nil
 	}
 
+	// Customer names are unique, so a name taken by another guild's customer is rejected
+	existing, err := s.customerRepo.GetByName(ctx, name)
+	if err != nil && err != domain.ErrCustomerNotFound {
+		return nil, fmt.Errorf("failed to check existing customer: %w", err)
+	}
+	if existing != nil {
+		s.logger.Debug("Customer name taken in another guild",
+			zap.String("guild_id", guildID),
+			zap.String("name", name),
+		)
+		return nil, domain.ErrCustomerAlreadyExists
+	}
+
 	// Create new customer
 	customer = &domain.Customer{
 		ID:           uuid.New(),
 		Name:         name,
 		ContactEmail: email,
+		GuildID:      guildID,
 	}
 
 	if err := s.customerRepo.Create(ctx, customer); err != nil {
@@ -98,39 +121,8 @@ func (s *channelService) getOrCreateProject(ctx context.Context, customerID uuid
 	return project, nil
 }
 
-// getOrCreateUser gets existing user or creates a new one
-func (s *channelService) getOrCreateUser(ctx context.Context, discordID, name string) (*domain.User, error) {
-	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
-	if err != nil && err != domain.ErrUserNotFound {
-		return nil, fmt.Errorf("failed to check existing user: %w", err)
-	}
-
-	if user != nil {
-		return user, nil
-	}
-
-	// Create new user
-	user = &domain.User{
-		ID:        uuid.New(),
-		Name:      name,
-		DiscordID: discordID,
-		Role:      domain.UserRoleCustomer,
-	}
-
-	if err := s.userRepo.Create(ctx, user); err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
-	}
-
-	s.logger.Info("Created new user",
-		zap.String("user_id", user.ID.String()),
-		zap.String("discord_id", discordID),
-	)
-
-	return user, nil
-}
-
 // RegisterChannel registers a new channel with customer and project information
-func (s *channelService) RegisterChannel(ctx context.Context, channelID, customerName, customerEmail, projectName, projectDescription, registeredBy, userName, guildID string) (*domain.Channel, error) {
+func (s *channelService) RegisterChannel(ctx context.Context, channelID, customerName, customerEmail, projectName, projectDescription, registeredBy, userName, guildID, channelType string) (*domain.Channel, error) {
 	s.logger.Debug("Registering channel",
 		zap.String("channel_id", channelID),
 		zap.String("customer_name", customerName),
@@ -140,6 +132,7 @@ func (s *channelService) RegisterChannel(ctx context.Context, channelID, custome
 		zap.String("registered_by", registeredBy),
 		zap.String("user_name", userName),
 		zap.String("guild_id", guildID),
+		zap.String("channel_type", channelType),
 	)
 
 	// Basic validation
@@ -148,79 +141,148 @@ func (s *channelService) RegisterChannel(ctx context.Context, channelID, custome
 		return nil, domain.ErrInvalidChannelRegistration
 	}
 
-	// Get or create customer
-	customer, err := s.getOrCreateCustomer(ctx, customerName, customerEmail)
-	if err != nil {
-		s.logger.Error("Failed to get or create customer",
-			zap.Error(err),
-			zap.String("customer_name", customerName),
-		)
-		return nil, fmt.Errorf("failed to get or create customer: %w", err)
-	}
+	channel := &domain.Channel{
+		ID:               uuid.New(),
+		DiscordChannelID: channelID,
+		GuildID:          guildID,
+		IsActive:         true,
+		ChannelType:      channelType,
+	}
+	if channel.ChannelType == "" {
+		channel.ChannelType = domain.ChannelTypeText
+	}
+
+	// Create the customer, project, user and registration together so a failure
+	// leaves none of them behind
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		// Get or create customer
+		customer, err := s.getOrCreateCustomer(ctx, guildID, customerName, customerEmail)
+		if err != nil {
+			s.logger.Error("Failed to get or create customer",
+				zap.Error(err),
+				zap.String("customer_name", customerName),
+			)
+			return fmt.Errorf("failed to get or create customer: %w", err)
+		}
 
-	// Get or create project
-	project, err := s.getOrCreateProject(ctx, customer.ID, projectName, projectDescription)
-	if err != nil {
-		s.logger.Error("Failed to get or create project",
-			zap.Error(err),
-			zap.String("project_name", projectName),
-		)
-		return nil, fmt.Errorf("failed to get or create project: %w", err)
-	}
+		// Get or create project
+		project, err := s.getOrCreateProject(ctx, customer.ID, projectName, projectDescription)
+		if err != nil {
+			s.logger.Error("Failed to get or create project",
+				zap.Error(err),
+				zap.String("project_name", projectName),
+			)
+			return fmt.Errorf("failed to get or create project: %w", err)
+		}
+		channel.ProjectID = project.ID
+
+		// Get or create user
+		user, err := s.userRepo.GetOrCreateByDiscordID(ctx, registeredBy, userName, domain.UserRoleCustomer)
+		if err != nil {
+			s.logger.Error("Failed to get or create user",
+				zap.Error(err),
+				zap.String("registered_by", registeredBy),
+			)
+			return fmt.Errorf("failed to get or create user: %w", err)
+		}
+		channel.RegisteredBy = user.ID
+
+		// Check if channel is already registered
+		existingChannel, err := s.channelRepo.GetByChannelID(ctx, channelID)
+		if err != nil && err != domain.ErrChannelNotFound {
+			s.logger.Error("Failed to check existing channel registration",
+				zap.Error(err),
+				zap.String("channel_id", channelID),
+			)
+			return fmt.Errorf("failed to check existing channel registration: %w", err)
+		}
+
+		if existingChannel != nil {
+			s.logger.Debug("Channel already registered",
+				zap.String("channel_id", channelID),
+				zap.String("project_id", existingChannel.ProjectID.String()),
+			)
+			return domain.ErrChannelAlreadyRegistered
+		}
 
-	// Get or create user
-	user, err := s.getOrCreateUser(ctx, registeredBy, userName)
+		// A deleted registration still holds the channel ID, so it is restored with the new
+		// project instead of creating another one
+		deletedChannel, err := s.channelRepo.GetDeletedByChannelID(ctx, channelID)
+		if err != nil && err != domain.ErrChannelNotFound {
+			s.logger.Error("Failed to check deleted channel registration",
+				zap.Error(err),
+				zap.String("channel_id", channelID),
+			)
+			return fmt.Errorf("failed to check deleted channel registration: %w", err)
+		}
+		if deletedChannel != nil {
+			return s.restoreRegistration(ctx, deletedChannel, channel, project)
+		}
+
+		if err := s.channelRepo.Create(ctx, channel); err != nil {
+			s.logger.Error("Failed to create channel registration",
+				zap.Error(err),
+				zap.String("channel_id", channelID),
+			)
+			return fmt.Errorf("failed to create channel registration: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Failed to get or create user",
-			zap.Error(err),
-			zap.String("registered_by", registeredBy),
-		)
-		return nil, fmt.Errorf("failed to get or create user: %w", err)
+		return nil, err
 	}
 
-	// Check if channel is already registered
-	existingChannel, err := s.channelRepo.GetByChannelID(ctx, channelID)
-	if err != nil && err != domain.ErrChannelNotFound {
-		s.logger.Error("Failed to check existing channel registration",
-			zap.Error(err),
-			zap.String("channel_id", channelID),
-		)
-		return nil, fmt.Errorf("failed to check existing channel registration: %w", err)
-	}
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelRegistered,
+		GuildID:    guildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: projectName,
+		After:      map[string]string{"customer": customerName, "project": projectName},
+	})
 
-	if existingChannel != nil {
-		s.logger.Debug("Channel already registered",
-			zap.String("channel_id", channelID),
-			zap.String("project_id", existingChannel.ProjectID.String()),
+	s.logger.Info("Channel registered successfully",
+		zap.String("channel_id", channelID),
+		zap.String("customer_name", customerName),
+		zap.String("project_name", projectName),
+	)
+
+	return channel, nil
+}
+
+// restoreRegistration brings back a deleted channel registration as the registration
+// given, without the further projects it had before
+func (s *channelService) restoreRegistration(ctx context.Context, deleted, channel *domain.Channel, project *domain.Project) error {
+	if err := s.channelRepo.Restore(ctx, deleted.ID); err != nil {
+		s.logger.Error("Failed to restore channel registration",
+			zap.Error(err),
+			zap.String("channel_id", channel.DiscordChannelID),
 		)
-		return nil, domain.ErrChannelAlreadyRegistered
+		return fmt.Errorf("failed to restore channel registration: %w", err)
 	}
 
-	// Create new channel registration
-	channel := &domain.Channel{
-		ID:               uuid.New(),
-		ProjectID:        project.ID,
-		DiscordChannelID: channelID,
-		GuildID:          guildID,
-		RegisteredBy:     user.ID,
-		IsActive:         true,
+	for _, further := range deleted.Projects {
+		if err := s.channelRepo.RemoveProject(ctx, deleted.ID, further.ID); err != nil {
+			return fmt.Errorf("failed to remove project from restored channel registration: %w", err)
+		}
 	}
 
-	if err := s.channelRepo.Create(ctx, channel); err != nil {
-		s.logger.Error("Failed to create channel registration",
+	channel.ID = deleted.ID
+	channel.CreatedAt = deleted.CreatedAt
+	channel.Update(project)
+	if err := s.channelRepo.Update(ctx, channel); err != nil {
+		s.logger.Error("Failed to update restored channel registration",
 			zap.Error(err),
-			zap.String("channel_id", channelID),
+			zap.String("channel_id", channel.DiscordChannelID),
 		)
-		return nil, fmt.Errorf("failed to create channel registration: %w", err)
+		return fmt.Errorf("failed to update restored channel registration: %w", err)
 	}
 
-	s.logger.Info("Channel registered successfully",
-		zap.String("channel_id", channelID),
-		zap.String("customer_name", customerName),
-		zap.String("project_name", projectName),
+	s.logger.Info("Deleted channel registration restored",
+		zap.String("registration_id", channel.ID.String()),
+		zap.String("channel_id", channel.DiscordChannelID),
 	)
-
-	return channel, nil
+	return nil
 }
 
 // GetChannelRegistration retrieves a channel registration by channel ID
@@ -258,7 +320,7 @@ func (s *channelService) UpdateChannelRegistration(ctx context.Context, channelI
 	}
 
 	// Get or create customer (no email for update operation)
-	customer, err := s.getOrCreateCustomer(ctx, customerName, "")
+	customer, err := s.getOrCreateCustomer(ctx, channel.GuildID, customerName, "")
 	if err != nil {
 		s.logger.Error("Failed to get or create customer for update",
 			zap.Error(err),
@@ -278,7 +340,8 @@ func (s *channelService) UpdateChannelRegistration(ctx context.Context, channelI
 	}
 
 	// Update channel
-	channel.ProjectID = project.ID
+	before := map[string]string{"customer": channel.Project.Customer.Name, "project": channel.Project.Name}
+	channel.Update(project)
 
 	if err := s.channelRepo.Update(ctx, channel); err != nil {
 		s.logger.Error("Failed to update channel registration",
@@ -288,6 +351,16 @@ func (s *channelService) UpdateChannelRegistration(ctx context.Context, channelI
 		return fmt.Errorf("failed to update channel registration: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelUpdated,
+		GuildID:    channel.GuildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: projectName,
+		Before:     before,
+		After:      map[string]string{"customer": customerName, "project": projectName},
+	})
+
 	s.logger.Info("Channel registration updated successfully",
 		zap.String("channel_id", channelID),
 		zap.String("customer_name", customerName),
@@ -310,6 +383,7 @@ func (s *channelService) DeactivateChannel(ctx context.Context, channelID string
 		return fmt.Errorf("failed to get channel for deactivation: %w", err)
 	}
 
+	wasActive := channel.IsActive
 	channel.Deactivate()
 
 	if err := s.channelRepo.Update(ctx, channel); err != nil {
@@ -320,6 +394,16 @@ func (s *channelService) DeactivateChannel(ctx context.Context, channelID string
 		return fmt.Errorf("failed to deactivate channel: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelDeactivated,
+		GuildID:    channel.GuildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: channel.Project.Name,
+		Before:     map[string]bool{"active": wasActive},
+		After:      map[string]bool{"active": false},
+	})
+
 	s.logger.Info("Channel deactivated successfully", zap.String("channel_id", channelID))
 	return nil
 }
@@ -337,6 +421,7 @@ func (s *channelService) ActivateChannel(ctx context.Context, channelID string)
 		return fmt.Errorf("failed to get channel for activation: %w", err)
 	}
 
+	wasActive := channel.IsActive
 	channel.Activate()
 
 	if err := s.channelRepo.Update(ctx, channel); err != nil {
@@ -347,10 +432,212 @@ func (s *channelService) ActivateChannel(ctx context.Context, channelID string)
 		return fmt.Errorf("failed to activate channel: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelActivated,
+		GuildID:    channel.GuildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: channel.Project.Name,
+		Before:     map[string]bool{"active": wasActive},
+		After:      map[string]bool{"active": true},
+	})
+
 	s.logger.Info("Channel activated successfully", zap.String("channel_id", channelID))
 	return nil
 }
 
+// TransferChannel moves a channel registration to another project registered in the same guild
+func (s *channelService) TransferChannel(ctx context.Context, channelID, projectKey string) (*domain.Channel, error) {
+	s.logger.Debug("Transferring channel",
+		zap.String("channel_id", channelID),
+		zap.String("project_key", projectKey),
+	)
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID)
+	if err != nil {
+		s.logger.Error("Failed to get channel for transfer",
+			zap.Error(err),
+			zap.String("channel_id", channelID),
+		)
+		return nil, fmt.Errorf("failed to get channel for transfer: %w", err)
+	}
+
+	project, err := s.findGuildProject(ctx, channel.GuildID, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	if project.ID == channel.ProjectID {
+		return nil, domain.ErrChannelAlreadyInProject
+	}
+
+	before := map[string]string{"project": channel.Project.Name}
+	wasFurtherProject := channel.HasProject(project.ID)
+	channel.Update(project)
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		// A further project of the channel becomes its main project
+		if wasFurtherProject {
+			if err := s.channelRepo.RemoveProject(ctx, channel.ID, project.ID); err != nil {
+				return err
+			}
+		}
+		return s.channelRepo.Update(ctx, channel)
+	})
+	if err != nil {
+		s.logger.Error("Failed to transfer channel",
+			zap.Error(err),
+			zap.String("channel_id", channelID),
+		)
+		return nil, fmt.Errorf("failed to transfer channel: %w", err)
+	}
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelTransferred,
+		GuildID:    channel.GuildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: project.Name,
+		Before:     before,
+		After:      map[string]string{"project": project.Name},
+	})
+
+	s.logger.Info("Channel transferred successfully",
+		zap.String("channel_id", channelID),
+		zap.String("project_id", project.ID.String()),
+	)
+
+	return channel, nil
+}
+
+// findGuildProject looks up a project registered in a channel of the guild by its issue
+// key prefix. Only these qualify, so admins cannot reach into other servers' projects.
+func (s *channelService) findGuildProject(ctx context.Context, guildID, projectKey string) (*domain.Project, error) {
+	channels, err := s.channelRepo.GetByGuildID(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list channels of guild: %w", err)
+	}
+
+	projectKey = strings.TrimSpace(projectKey)
+	for _, channel := range channels {
+		for _, project := range channel.AllProjects() {
+			if project.KeyPrefix != "" && strings.EqualFold(project.KeyPrefix, projectKey) {
+				return &project, nil
+			}
+		}
+	}
+	return nil, domain.ErrProjectNotFound
+}
+
+// AddChannelProject registers a further project in a channel
+func (s *channelService) AddChannelProject(ctx context.Context, channelID, customerName, projectName string) (*domain.Project, error) {
+	s.logger.Debug("Adding project to channel",
+		zap.String("channel_id", channelID),
+		zap.String("customer_name", customerName),
+		zap.String("project_name", projectName),
+	)
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel for adding a project: %w", err)
+	}
+
+	var project *domain.Project
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		customer, err := s.getOrCreateCustomer(ctx, channel.GuildID, customerName, "")
+		if err != nil {
+			return fmt.Errorf("failed to get or create customer: %w", err)
+		}
+		project, err = s.getOrCreateProject(ctx, customer.ID, projectName, "")
+		if err != nil {
+			return fmt.Errorf("failed to get or create project: %w", err)
+		}
+		if channel.HasProject(project.ID) {
+			return domain.ErrChannelAlreadyInProject
+		}
+		return s.channelRepo.AddProject(ctx, channel.ID, project.ID)
+	})
+	if err != nil {
+		if !errors.Is(err, domain.ErrChannelAlreadyInProject) {
+			s.logger.Error("Failed to add project to channel",
+				zap.Error(err),
+				zap.String("channel_id", channelID),
+			)
+		}
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelProjectAdded,
+		GuildID:    channel.GuildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: channel.Project.Name,
+		After:      map[string]string{"customer": customerName, "project": projectName},
+	})
+
+	s.logger.Info("Project added to channel successfully",
+		zap.String("channel_id", channelID),
+		zap.String("project_id", project.ID.String()),
+	)
+
+	return project, nil
+}
+
+// RemoveChannelProject removes a further project from a channel
+func (s *channelService) RemoveChannelProject(ctx context.Context, channelID, projectKey string) (*domain.Project, error) {
+	s.logger.Debug("Removing project from channel",
+		zap.String("channel_id", channelID),
+		zap.String("project_key", projectKey),
+	)
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel for removing a project: %w", err)
+	}
+
+	projectKey = strings.TrimSpace(projectKey)
+	if strings.EqualFold(channel.Project.KeyPrefix, projectKey) {
+		return nil, domain.ErrChannelMainProject
+	}
+
+	var project *domain.Project
+	for i := range channel.Projects {
+		if strings.EqualFold(channel.Projects[i].KeyPrefix, projectKey) {
+			project = &channel.Projects[i]
+			break
+		}
+	}
+	if project == nil {
+		return nil, domain.ErrProjectNotInChannel
+	}
+
+	if err := s.channelRepo.RemoveProject(ctx, channel.ID, project.ID); err != nil {
+		if !errors.Is(err, domain.ErrProjectNotInChannel) {
+			s.logger.Error("Failed to remove project from channel",
+				zap.Error(err),
+				zap.String("channel_id", channelID),
+			)
+		}
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditChannelProjectRemoved,
+		GuildID:    channel.GuildID,
+		TargetType: domain.AuditTargetChannel,
+		TargetID:   channelID,
+		TargetName: channel.Project.Name,
+		Before:     map[string]string{"project": project.Name},
+	})
+
+	s.logger.Info("Project removed from channel successfully",
+		zap.String("channel_id", channelID),
+		zap.String("project_id", project.ID.String()),
+	)
+
+	return project, nil
+}
+
 // ListChannelsForGuild lists all channel registrations for a specific guild
 func (s *channelService) ListChannelsForGuild(ctx context.Context, guildID string) ([]*domain.Channel, error) {
 	s.logger.Debug("Listing channels for guild", zap.String("guild_id", guildID))
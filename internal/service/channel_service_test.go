@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// fakeUnitOfWork runs the work without a transaction
+type fakeUnitOfWork struct {
+	domain.UnitOfWork
+}
+
+func (fakeUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeAuditor drops audit entries
+type fakeAuditor struct{}
+
+func (fakeAuditor) Record(ctx context.Context, entry domain.AuditEntry) {}
+
+// fakeCustomerRepository keeps customers in memory; a guild's customers are those added in it
+type fakeCustomerRepository struct {
+	domain.CustomerRepository
+	customers []*domain.Customer
+}
+
+func (r *fakeCustomerRepository) Create(ctx context.Context, customer *domain.Customer) error {
+	r.customers = append(r.customers, customer)
+	return nil
+}
+
+func (r *fakeCustomerRepository) GetByName(ctx context.Context, name string) (*domain.Customer, error) {
+	for _, customer := range r.customers {
+		if customer.Name == name {
+			return customer, nil
+		}
+	}
+	return nil, domain.ErrCustomerNotFound
+}
+
+func (r *fakeCustomerRepository) GetByNameInGuild(ctx context.Context, guildID, name string) (*domain.Customer, error) {
+	for _, customer := range r.customers {
+		if customer.GuildID == guildID && customer.Name == name {
+			return customer, nil
+		}
+	}
+	return nil, domain.ErrCustomerNotFound
+}
+
+// fakeProjectRepository keeps projects in memory
+type fakeProjectRepository struct {
+	domain.ProjectRepository
+	projects []*domain.Project
+}
+
+func (r *fakeProjectRepository) Create(ctx context.Context, project *domain.Project) error {
+	r.projects = append(r.projects, project)
+	return nil
+}
+
+func (r *fakeProjectRepository) GetByName(ctx context.Context, customerID uuid.UUID, name string) (*domain.Project, error) {
+	for _, project := range r.projects {
+		if project.CustomerID == customerID && project.Name == name {
+			return project, nil
+		}
+	}
+	return nil, domain.ErrProjectNotFound
+}
+
+// fakeUserRepository keeps users in memory
+type fakeUserRepository struct {
+	domain.UserRepository
+	users []*domain.User
+}
+
+func (r *fakeUserRepository) GetOrCreateByDiscordID(ctx context.Context, discordID, name string, role domain.UserRole) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.DiscordID == discordID {
+			return user, nil
+		}
+	}
+	user := &domain.User{ID: uuid.New(), Name: name, DiscordID: discordID, Role: role}
+	r.users = append(r.users, user)
+	return user, nil
+}
+
+// fakeChannelRepository keeps channel registrations in memory. Like the unique index on
+// the Discord channel ID, it refuses a second registration even when the first is deleted.
+type fakeChannelRepository struct {
+	domain.ChannelRepository
+	channels []*domain.Channel
+}
+
+func (r *fakeChannelRepository) Create(ctx context.Context, channel *domain.Channel) error {
+	for _, existing := range r.channels {
+		if existing.DiscordChannelID == channel.DiscordChannelID {
+			return errors.New("duplicate key value violates unique constraint \"unique_channel\"")
+		}
+	}
+	r.channels = append(r.channels, channel)
+	return nil
+}
+
+func (r *fakeChannelRepository) GetByChannelID(ctx context.Context, channelID string) (*domain.Channel, error) {
+	for _, channel := range r.channels {
+		if channel.DiscordChannelID == channelID && !channel.DeletedAt.Valid {
+			return channel, nil
+		}
+	}
+	return nil, domain.ErrChannelNotFound
+}
+
+func (r *fakeChannelRepository) GetDeletedByChannelID(ctx context.Context, channelID string) (*domain.Channel, error) {
+	for _, channel := range r.channels {
+		if channel.DiscordChannelID == channelID && channel.DeletedAt.Valid {
+			return channel, nil
+		}
+	}
+	return nil, domain.ErrChannelNotFound
+}
+
+func (r *fakeChannelRepository) Update(ctx context.Context, channel *domain.Channel) error {
+	for i, existing := range r.channels {
+		if existing.ID == channel.ID && !existing.DeletedAt.Valid {
+			r.channels[i] = channel
+			return nil
+		}
+	}
+	return domain.ErrChannelNotFound
+}
+
+func (r *fakeChannelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.setDeleted(id, gorm.DeletedAt{Time: time.Now(), Valid: true})
+}
+
+func (r *fakeChannelRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.setDeleted(id, gorm.DeletedAt{})
+}
+
+func (r *fakeChannelRepository) RemoveProject(ctx context.Context, id, projectID uuid.UUID) error {
+	for _, channel := range r.channels {
+		if channel.ID == id {
+			channel.Projects = slices.DeleteFunc(channel.Projects, func(p domain.Project) bool { return p.ID == projectID })
+			return nil
+		}
+	}
+	return domain.ErrChannelNotFound
+}
+
+func (r *fakeChannelRepository) setDeleted(id uuid.UUID, deletedAt gorm.DeletedAt) error {
+	for _, channel := range r.channels {
+		if channel.ID == id && channel.DeletedAt.Valid != deletedAt.Valid {
+			channel.DeletedAt = deletedAt
+			return nil
+		}
+	}
+	return domain.ErrChannelNotFound
+}
+
+// channelServiceFixture is a channel service backed by in-memory repositories
+type channelServiceFixture struct {
+	domain.ChannelService
+	channels  *fakeChannelRepository
+	customers *fakeCustomerRepository
+	projects  *fakeProjectRepository
+	users     *fakeUserRepository
+}
+
+func newChannelServiceFixture() *channelServiceFixture {
+	f := &channelServiceFixture{
+		channels:  &fakeChannelRepository{},
+		customers: &fakeCustomerRepository{},
+		projects:  &fakeProjectRepository{},
+		users:     &fakeUserRepository{},
+	}
+	f.ChannelService = NewChannelService(f.channels, f.customers, f.projects, f.users, fakeUnitOfWork{}, fakeAuditor{}, zap.NewNop())
+	return f
+}
+
+func TestRegisterChannelSameCustomerNameInTwoGuilds(t *testing.T) {
+	f := newChannelServiceFixture()
+	ctx := context.Background()
+
+	first, err := f.RegisterChannel(ctx, "a-1", "Acme", "", "Portal", "", "u-1", "Ann", "guild-a", "")
+	if err != nil {
+		t.Fatalf("failed to register in guild A: %v", err)
+	}
+
+	// Guild B cannot attach to guild A's customer or its projects
+	if _, err := f.RegisterChannel(ctx, "b-1", "Acme", "", "Portal", "", "u-2", "Bob", "guild-b", ""); !errors.Is(err, domain.ErrCustomerAlreadyExists) {
+		t.Fatalf("registering in guild B: err = %v, want %v", err, domain.ErrCustomerAlreadyExists)
+	}
+	if len(f.customers.customers) != 1 || len(f.projects.projects) != 1 || len(f.channels.channels) != 1 {
+		t.Fatalf("guild B created %d customers, %d projects, %d channels; want none",
+			len(f.customers.customers)-1, len(f.projects.projects)-1, len(f.channels.channels)-1)
+	}
+
+	// Another channel of guild A reuses its customer and project
+	second, err := f.RegisterChannel(ctx, "a-2", "Acme", "", "Portal", "", "u-1", "Ann", "guild-a", "")
+	if err != nil {
+		t.Fatalf("failed to register a second channel in guild A: %v", err)
+	}
+	if second.ProjectID != first.ProjectID {
+		t.Errorf("second channel of guild A got project %s, want %s", second.ProjectID, first.ProjectID)
+	}
+}
+
+func TestRegisterChannelAfterDelete(t *testing.T) {
+	f := newChannelServiceFixture()
+	ctx := context.Background()
+
+	first, err := f.RegisterChannel(ctx, "a-1", "Acme", "", "Portal", "", "u-1", "Ann", "guild-a", "")
+	if err != nil {
+		t.Fatalf("failed to register: %v", err)
+	}
+	first.Projects = []domain.Project{{ID: uuid.New(), Name: "Shop"}}
+	if err := f.channels.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+
+	again, err := f.RegisterChannel(ctx, "a-1", "Acme", "", "Billing", "", "u-1", "Ann", "guild-a", "")
+	if err != nil {
+		t.Fatalf("failed to register again: %v", err)
+	}
+	if again.ID != first.ID {
+		t.Errorf("registered again as %s, want the deleted registration %s restored", again.ID, first.ID)
+	}
+	if len(f.channels.channels) != 1 {
+		t.Fatalf("got %d registrations, want 1", len(f.channels.channels))
+	}
+
+	restored, err := f.GetChannelRegistration(ctx, "a-1")
+	if err != nil {
+		t.Fatalf("failed to get the restored registration: %v", err)
+	}
+	if restored.Project.Name != "Billing" || len(restored.Projects) != 0 {
+		t.Errorf("restored registration has project %q and %d further projects, want Billing and none",
+			restored.Project.Name, len(restored.Projects))
+	}
+
+	if _, err := f.RegisterChannel(ctx, "a-1", "Acme", "", "Billing", "", "u-1", "Ann", "guild-a", ""); !errors.Is(err, domain.ErrChannelAlreadyRegistered) {
+		t.Errorf("registering a restored channel: err = %v, want %v", err, domain.ErrChannelAlreadyRegistered)
+	}
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// componentService implements the ComponentService interface
+type componentService struct {
+	channelRepo      domain.ChannelRepository
+	issueRepo        domain.IssueRepository
+	userRepo         domain.UserRepository
+	componentRepo    domain.ComponentRepository
+	teamRepo         domain.TeamRepository
+	statusLogService domain.IssueStatusLogService
+	events           domain.EventPublisher
+	logger           *zap.Logger
+}
+
+// NewComponentService creates a new instance of component service
+func NewComponentService(
+	channelRepo domain.ChannelRepository,
+	issueRepo domain.IssueRepository,
+	userRepo domain.UserRepository,
+	componentRepo domain.ComponentRepository,
+	teamRepo domain.TeamRepository,
+	statusLogService domain.IssueStatusLogService,
+	events domain.EventPublisher,
+	logger *zap.Logger,
+) domain.ComponentService {
+	return &componentService{
+		channelRepo:      channelRepo,
+		issueRepo:        issueRepo,
+		userRepo:         userRepo,
+		componentRepo:    componentRepo,
+		teamRepo:         teamRepo,
+		statusLogService: statusLogService,
+		events:           events,
+		logger:           logger,
+	}
+}
+
+// Create adds a component to the project registered to a Discord channel
+func (s *componentService) Create(ctx context.Context, discordChannelID, name, createdBy string) (*domain.Component, error) {
+	name = domain.NormalizeComponentName(name)
+	if !domain.IsValidComponentName(name) {
+		return nil, domain.ErrInvalidComponentName
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.componentRepo.ListByProject(ctx, channel.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if findComponent(existing, name) != nil {
+		return nil, domain.ErrComponentExists
+	}
+	if len(existing) >= domain.MaxComponents {
+		return nil, domain.ErrTooManyComponents
+	}
+
+	component := &domain.Component{
+		ID:        uuid.New(),
+		ProjectID: channel.ProjectID,
+		Name:      name,
+		CreatedBy: createdBy,
+	}
+	if err := s.componentRepo.Create(ctx, component); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Component created",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("name", name),
+		zap.String("created_by", createdBy),
+	)
+
+	return component, nil
+}
+
+// List retrieves the components of the project registered to a Discord channel
+func (s *componentService) List(ctx context.Context, discordChannelID string) ([]*domain.Component, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.componentRepo.ListByProject(ctx, channel.ProjectID)
+}
+
+// ListByProject retrieves the components of a project
+func (s *componentService) ListByProject(ctx context.Context, projectID uuid.UUID) ([]*domain.Component, error) {
+	return s.componentRepo.ListByProject(ctx, projectID)
+}
+
+// GetByName retrieves a component of the project registered to a Discord channel
+func (s *componentService) GetByName(ctx context.Context, discordChannelID, name string) (*domain.Component, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getProjectComponent(ctx, channel.ProjectID, name)
+}
+
+// GetByID retrieves a component by ID
+func (s *componentService) GetByID(ctx context.Context, id uuid.UUID) (*domain.Component, error) {
+	return s.componentRepo.GetByID(ctx, id)
+}
+
+// Delete removes a component of the project registered to a Discord channel
+func (s *componentService) Delete(ctx context.Context, discordChannelID, name string) (*domain.Component, error) {
+	component, err := s.GetByName(ctx, discordChannelID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.componentRepo.Delete(ctx, component.ID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Component deleted",
+		zap.String("project_id", component.ProjectID.String()),
+		zap.String("name", component.Name),
+	)
+
+	return component, nil
+}
+
+// SetLead changes the lead of a component of the project registered to a Discord channel
+// to a team member of the channel's guild, or removes it
+func (s *componentService) SetLead(ctx context.Context, discordChannelID, name, leadDiscordID string) (*domain.Component, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	component, err := s.getProjectComponent(ctx, channel.ProjectID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var lead *domain.User
+	if leadDiscordID != "" {
+		lead, err = s.userRepo.GetByDiscordID(ctx, leadDiscordID)
+		if err == domain.ErrUserNotFound {
+			return nil, domain.ErrTeamMemberNotFound
+		} else if err != nil {
+			return nil, err
+		}
+		// The lead is routed issues through the team, so they need a profile there
+		if _, err := s.teamRepo.GetMember(ctx, channel.GuildID, lead.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	var leadID *uuid.UUID
+	if lead != nil {
+		leadID = &lead.ID
+	}
+	if err := s.componentRepo.SetLead(ctx, component.ID, leadID); err != nil {
+		return nil, err
+	}
+	component.LeadID = leadID
+	component.Lead = lead
+
+	s.logger.Info("Component lead updated",
+		zap.String("project_id", component.ProjectID.String()),
+		zap.String("name", component.Name),
+		zap.String("lead_discord_id", leadDiscordID),
+	)
+
+	return component, nil
+}
+
+// SetIssueComponent files an issue under a component of its project, or removes it from
+// its component for an empty name, and records it in the issue history. Unchanged
+// components are not recorded again.
+func (s *componentService) SetIssueComponent(ctx context.Context, issueID uuid.UUID, name, changedBy string) (*domain.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	var component *domain.Component
+	var componentID *uuid.UUID
+	if strings.TrimSpace(name) != "" {
+		component, err = s.getProjectComponent(ctx, issue.ProjectID, name)
+		if err != nil {
+			return nil, err
+		}
+		componentID = &component.ID
+	}
+	if (issue.ComponentID == nil && componentID == nil) ||
+		(issue.ComponentID != nil && componentID != nil && *issue.ComponentID == *componentID) {
+		return issue, nil
+	}
+
+	if err := s.issueRepo.SetComponent(ctx, issue.ID, componentID); err != nil {
+		return nil, err
+	}
+
+	note := "Removed from its component"
+	switch {
+	case component != nil:
+		note = fmt.Sprintf("Filed under component %s", component.Name)
+	case issue.Component != nil:
+		note = fmt.Sprintf("Removed from component %s", issue.Component.Name)
+	}
+	issue.ComponentID = componentID
+	issue.Component = component
+
+	var changedByID *uuid.UUID
+	if user, err := s.userRepo.GetByDiscordID(ctx, changedBy); err == nil {
+		changedByID = &user.ID
+	}
+	if _, err := s.statusLogService.LogIssueEdit(ctx, issue.ID, issue.Status, changedByID, note); err != nil {
+		s.logger.Warn("Failed to record component change",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueComponentChanged, Issue: issue, ActorID: changedBy})
+
+	s.logger.Info("Issue component updated",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("note", note),
+		zap.String("changed_by", changedBy),
+	)
+
+	return issue, nil
+}
+
+// getProjectComponent retrieves a project's component by name
+func (s *componentService) getProjectComponent(ctx context.Context, projectID uuid.UUID, name string) (*domain.Component, error) {
+	components, err := s.componentRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	component := findComponent(components, domain.NormalizeComponentName(name))
+	if component == nil {
+		return nil, domain.ErrComponentNotFound
+	}
+	return component, nil
+}
+
+// findComponent returns the component with the given normalized name, or nil
+func findComponent(components []*domain.Component, name string) *domain.Component {
+	for _, c := range components {
+		if strings.EqualFold(c.Name, name) {
+			return c
+		}
+	}
+	return nil
+}
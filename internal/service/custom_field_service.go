@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// customFieldService implements the CustomFieldService interface
+type customFieldService struct {
+	channelRepo domain.ChannelRepository
+	fieldRepo   domain.CustomFieldRepository
+	issueRepo   domain.IssueRepository
+	uow         domain.UnitOfWork
+	logger      *zap.Logger
+}
+
+// NewCustomFieldService creates a new instance of custom field service
+func NewCustomFieldService(channelRepo domain.ChannelRepository, fieldRepo domain.CustomFieldRepository, issueRepo domain.IssueRepository, uow domain.UnitOfWork, logger *zap.Logger) domain.CustomFieldService {
+	return &customFieldService{
+		channelRepo: channelRepo,
+		fieldRepo:   fieldRepo,
+		issueRepo:   issueRepo,
+		uow:         uow,
+		logger:      logger,
+	}
+}
+
+// ListFields retrieves the custom fields of the project registered to a Discord channel
+func (s *customFieldService) ListFields(ctx context.Context, discordChannelID string) ([]*domain.CustomFieldDefinition, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fieldRepo.ListByProject(ctx, channel.ProjectID)
+}
+
+// ListProjectFields retrieves the custom fields of a project in form order
+func (s *customFieldService) ListProjectFields(ctx context.Context, projectID uuid.UUID) ([]*domain.CustomFieldDefinition, error) {
+	return s.fieldRepo.ListByProject(ctx, projectID)
+}
+
+// AddField adds a custom field to the project registered to a Discord channel, after its existing fields
+func (s *customFieldService) AddField(ctx context.Context, discordChannelID, name string, fieldType domain.CustomFieldType, options []string, required bool, createdBy string) (*domain.CustomFieldDefinition, error) {
+	name = domain.NormalizeCustomFieldName(name)
+	if !domain.IsValidCustomFieldName(name) {
+		return nil, domain.ErrInvalidCustomFieldName
+	}
+	if !domain.IsValidCustomFieldType(fieldType) {
+		return nil, domain.ErrInvalidCustomFieldType
+	}
+	if fieldType != domain.CustomFieldSelect {
+		options = nil
+	} else if len(options) == 0 || len(options) > domain.MaxCustomFieldOptions {
+		return nil, domain.ErrInvalidCustomFieldOptions
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.fieldRepo.ListByProject(ctx, channel.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if findCustomField(fields, name) != nil {
+		return nil, domain.ErrCustomFieldExists
+	}
+	if len(fields) >= domain.MaxCustomFields {
+		return nil, domain.ErrTooManyCustomFields
+	}
+
+	position := 0
+	for _, field := range fields {
+		if field.Position >= position {
+			position = field.Position + 1
+		}
+	}
+
+	field := &domain.CustomFieldDefinition{
+		ID:        uuid.New(),
+		ProjectID: channel.ProjectID,
+		Name:      name,
+		Type:      fieldType,
+		Options:   options,
+		Required:  required,
+		Position:  position,
+		CreatedBy: createdBy,
+	}
+	if err := s.fieldRepo.Create(ctx, field); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Custom field added",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("name", name),
+		zap.String("type", string(fieldType)),
+		zap.String("created_by", createdBy),
+	)
+
+	return field, nil
+}
+
+// RemoveField removes a custom field of the project registered to a Discord channel and its values on issues
+func (s *customFieldService) RemoveField(ctx context.Context, discordChannelID, name string) error {
+	fields, err := s.ListFields(ctx, discordChannelID)
+	if err != nil {
+		return err
+	}
+
+	field := findCustomField(fields, domain.NormalizeCustomFieldName(name))
+	if field == nil {
+		return domain.ErrCustomFieldNotFound
+	}
+
+	if err := s.fieldRepo.Delete(ctx, field.ID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Custom field removed",
+		zap.String("project_id", field.ProjectID.String()),
+		zap.String("name", field.Name),
+	)
+
+	return nil
+}
+
+// SetIssueValue sets the named custom field of an issue and returns the stored value with
+// its field; an empty value clears the field
+func (s *customFieldService) SetIssueValue(ctx context.Context, issueID uuid.UUID, name, value string) (*domain.IssueCustomFieldValue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.fieldRepo.ListByProject(ctx, issue.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	field := findCustomField(fields, domain.NormalizeCustomFieldName(name))
+	if field == nil {
+		return nil, domain.ErrCustomFieldNotFound
+	}
+
+	stored := &domain.IssueCustomFieldValue{
+		IssueID: issue.ID,
+		FieldID: field.ID,
+		Field:   *field,
+	}
+	if strings.TrimSpace(value) == "" {
+		return stored, s.fieldRepo.ClearValue(ctx, issue.ID, field.ID)
+	}
+
+	if stored.Value, err = field.NormalizeValue(value); err != nil {
+		return nil, invalidCustomFieldValue(field, err)
+	}
+	return stored, s.fieldRepo.SetValue(ctx, stored)
+}
+
+// SetIssueValues sets several custom fields of an issue by field ID, skipping empty values.
+// All values are checked before any is stored.
+func (s *customFieldService) SetIssueValues(ctx context.Context, issueID uuid.UUID, values map[uuid.UUID]string) error {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return err
+	}
+
+	fields, err := s.fieldRepo.ListByProject(ctx, issue.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	var stored []*domain.IssueCustomFieldValue
+	for _, field := range fields {
+		value, ok := values[field.ID]
+		if !ok || strings.TrimSpace(value) == "" {
+			continue
+		}
+		value, err := field.NormalizeValue(value)
+		if err != nil {
+			return invalidCustomFieldValue(field, err)
+		}
+		stored = append(stored, &domain.IssueCustomFieldValue{
+			IssueID: issue.ID,
+			FieldID: field.ID,
+			Value:   value,
+		})
+	}
+
+	return s.uow.Do(ctx, func(ctx context.Context) error {
+		for _, value := range stored {
+			if err := s.fieldRepo.SetValue(ctx, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// invalidCustomFieldValue explains which field a value did not fit and what it expects
+func invalidCustomFieldValue(field *domain.CustomFieldDefinition, err error) error {
+	return domain.WithDetail(err, "%s must be %s", field.Name, field.Describe())
+}
+
+// findCustomField returns the field with the given normalized name, or nil
+func findCustomField(fields []*domain.CustomFieldDefinition, name string) *domain.CustomFieldDefinition {
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field
+		}
+	}
+	return nil
+}
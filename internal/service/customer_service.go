@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"fix-track-bot/internal/domain"
 
@@ -13,20 +14,33 @@ import (
 // customerService implements the CustomerService interface
 type customerService struct {
 	customerRepo domain.CustomerRepository
+	projectRepo  domain.ProjectRepository
+	uow          domain.UnitOfWork
+	auditor      domain.Auditor
 	logger       *zap.Logger
 }
 
 // NewCustomerService creates a new instance of customer service
-func NewCustomerService(customerRepo domain.CustomerRepository, logger *zap.Logger) domain.CustomerService {
+func NewCustomerService(
+	customerRepo domain.CustomerRepository,
+	projectRepo domain.ProjectRepository,
+	uow domain.UnitOfWork,
+	auditor domain.Auditor,
+	logger *zap.Logger,
+) domain.CustomerService {
 	return &customerService{
 		customerRepo: customerRepo,
+		projectRepo:  projectRepo,
+		uow:          uow,
+		auditor:      auditor,
 		logger:       logger,
 	}
 }
 
-// CreateCustomer creates a new customer
-func (s *customerService) CreateCustomer(ctx context.Context, name, contactEmail string) (*domain.Customer, error) {
+// CreateCustomer creates a new customer, added in a guild if guildID is set
+func (s *customerService) CreateCustomer(ctx context.Context, guildID, name, contactEmail string) (*domain.Customer, error) {
 	s.logger.Debug("Creating customer",
+		zap.String("guild_id", guildID),
 		zap.String("name", name),
 		zap.String("contact_email", contactEmail),
 	)
@@ -57,6 +71,7 @@ func (s *customerService) CreateCustomer(ctx context.Context, name, contactEmail
 		ID:           uuid.New(),
 		Name:         name,
 		ContactEmail: contactEmail,
+		GuildID:      guildID,
 	}
 
 	if err := s.customerRepo.Create(ctx, customer); err != nil {
@@ -67,6 +82,15 @@ func (s *customerService) CreateCustomer(ctx context.Context, name, contactEmail
 		return nil, fmt.Errorf("failed to create customer: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditCustomerCreated,
+		GuildID:    guildID,
+		TargetType: domain.AuditTargetCustomer,
+		TargetID:   customer.ID.String(),
+		TargetName: customer.Name,
+		After:      map[string]string{"name": customer.Name, "contact_email": customer.ContactEmail},
+	})
+
 	s.logger.Info("Customer created successfully",
 		zap.String("customer_id", customer.ID.String()),
 		zap.String("name", name),
@@ -109,6 +133,28 @@ func (s *customerService) GetCustomerByName(ctx context.Context, name string) (*
 	return customer, nil
 }
 
+// GetCustomerByNameInGuild retrieves a customer by name among the customers of a guild
+func (s *customerService) GetCustomerByNameInGuild(ctx context.Context, guildID, name string) (*domain.Customer, error) {
+	s.logger.Debug("Retrieving customer of guild by name",
+		zap.String("guild_id", guildID),
+		zap.String("name", name),
+	)
+
+	customer, err := s.customerRepo.GetByNameInGuild(ctx, guildID, name)
+	if err != nil {
+		if err != domain.ErrCustomerNotFound {
+			s.logger.Error("Failed to retrieve customer of guild by name",
+				zap.Error(err),
+				zap.String("guild_id", guildID),
+				zap.String("name", name),
+			)
+		}
+		return nil, fmt.Errorf("failed to retrieve customer of guild by name: %w", err)
+	}
+
+	return customer, nil
+}
+
 // UpdateCustomer updates customer information
 func (s *customerService) UpdateCustomer(ctx context.Context, id uuid.UUID, name, contactEmail string) error {
 	s.logger.Debug("Updating customer",
@@ -132,7 +178,19 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id uuid.UUID, name
 		return fmt.Errorf("failed to retrieve customer for update: %w", err)
 	}
 
+	// Customers are looked up by name, so it must stay unique
+	if name != customer.Name {
+		existing, err := s.customerRepo.GetByName(ctx, name)
+		if err != nil && err != domain.ErrCustomerNotFound {
+			return fmt.Errorf("failed to check existing customer: %w", err)
+		}
+		if existing != nil {
+			return domain.ErrCustomerAlreadyExists
+		}
+	}
+
 	// Update fields
+	before := customer.Name
 	customer.Name = name
 	customer.ContactEmail = contactEmail
 
@@ -144,6 +202,17 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id uuid.UUID, name
 		return fmt.Errorf("failed to update customer: %w", err)
 	}
 
+	if name != before {
+		s.auditor.Record(ctx, domain.AuditEntry{
+			Action:     domain.AuditCustomerRenamed,
+			TargetType: domain.AuditTargetCustomer,
+			TargetID:   id.String(),
+			TargetName: name,
+			Before:     map[string]string{"name": before},
+			After:      map[string]string{"name": name},
+		})
+	}
+
 	s.logger.Info("Customer updated successfully",
 		zap.String("customer_id", id.String()),
 		zap.String("name", name),
@@ -152,6 +221,40 @@ func (s *customerService) UpdateCustomer(ctx context.Context, id uuid.UUID, name
 	return nil
 }
 
+// SetEmailOptOut stops (or resumes) issue emails to a customer's contact
+func (s *customerService) SetEmailOptOut(ctx context.Context, id uuid.UUID, optOut bool) error {
+	s.logger.Debug("Setting customer email opt-out",
+		zap.String("customer_id", id.String()),
+		zap.Bool("opt_out", optOut),
+	)
+
+	customer, err := s.customerRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve customer for email opt-out",
+			zap.Error(err),
+			zap.String("customer_id", id.String()),
+		)
+		return fmt.Errorf("failed to retrieve customer for email opt-out: %w", err)
+	}
+
+	customer.EmailOptOut = optOut
+
+	if err := s.customerRepo.Update(ctx, customer); err != nil {
+		s.logger.Error("Failed to update customer email opt-out",
+			zap.Error(err),
+			zap.String("customer_id", id.String()),
+		)
+		return fmt.Errorf("failed to update customer email opt-out: %w", err)
+	}
+
+	s.logger.Info("Customer email opt-out updated",
+		zap.String("customer_id", id.String()),
+		zap.Bool("opt_out", optOut),
+	)
+
+	return nil
+}
+
 // ListCustomers lists all customers
 func (s *customerService) ListCustomers(ctx context.Context, offset, limit int) ([]*domain.Customer, error) {
 	s.logger.Debug("Listing customers",
@@ -177,3 +280,130 @@ func (s *customerService) ListCustomers(ctx context.Context, offset, limit int)
 
 	return customers, nil
 }
+
+// ListGuildCustomers lists the customers added in a guild or tracked in its channels, by name
+func (s *customerService) ListGuildCustomers(ctx context.Context, guildID string) ([]*domain.Customer, error) {
+	s.logger.Debug("Listing customers of guild", zap.String("guild_id", guildID))
+
+	if guildID == "" {
+		return nil, domain.ErrEmptyGuildID
+	}
+
+	customers, err := s.customerRepo.ListByGuild(ctx, guildID)
+	if err != nil {
+		s.logger.Error("Failed to list customers of guild",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+		)
+		return nil, fmt.Errorf("failed to list customers of guild: %w", err)
+	}
+
+	return customers, nil
+}
+
+// MergeCustomers moves the projects, users and API keys of a customer to another and
+// deletes it. The customer merged into keeps its contact email, or takes over the merged
+// customer's if it has none. Customers that both have a project of the same name cannot
+// be merged, as project names are unique per customer; those projects are merged first.
+func (s *customerService) MergeCustomers(ctx context.Context, fromID, intoID uuid.UUID) (*domain.Customer, error) {
+	s.logger.Debug("Merging customers",
+		zap.String("from_customer_id", fromID.String()),
+		zap.String("into_customer_id", intoID.String()),
+	)
+
+	if fromID == intoID {
+		return nil, domain.ErrMergeCustomerIntoItself
+	}
+
+	from, err := s.customerRepo.GetByID(ctx, fromID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve customer to merge: %w", err)
+	}
+	into, err := s.customerRepo.GetByID(ctx, intoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve customer to merge into: %w", err)
+	}
+
+	fromProjects, err := s.projectRepo.GetByCustomerID(ctx, fromID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve projects to merge: %w", err)
+	}
+	intoProjects, err := s.projectRepo.GetByCustomerID(ctx, intoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve projects to merge into: %w", err)
+	}
+	for _, moved := range fromProjects {
+		for _, kept := range intoProjects {
+			if strings.EqualFold(moved.Name, kept.Name) {
+				return nil, domain.WithDetail(domain.ErrCustomerProjectNameClash, "%s", kept.Name)
+			}
+		}
+	}
+
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		if into.ContactEmail == "" && from.ContactEmail != "" {
+			into.ContactEmail = from.ContactEmail
+			if err := s.customerRepo.Update(ctx, into); err != nil {
+				return err
+			}
+		}
+		return s.customerRepo.Merge(ctx, fromID, intoID)
+	})
+	if err != nil {
+		s.logger.Error("Failed to merge customers",
+			zap.Error(err),
+			zap.String("from_customer_id", fromID.String()),
+			zap.String("into_customer_id", intoID.String()),
+		)
+		return nil, fmt.Errorf("failed to merge customers: %w", err)
+	}
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditCustomerMerged,
+		TargetType: domain.AuditTargetCustomer,
+		TargetID:   intoID.String(),
+		TargetName: into.Name,
+		Before:     map[string]string{"merged_customer": from.Name, "merged_customer_id": fromID.String()},
+		After:      map[string]int{"projects_moved": len(fromProjects)},
+	})
+
+	s.logger.Info("Customers merged successfully",
+		zap.String("from_customer_id", fromID.String()),
+		zap.String("into_customer_id", intoID.String()),
+		zap.Int("projects_moved", len(fromProjects)),
+	)
+
+	return into, nil
+}
+
+// DeleteCustomer soft-deletes a customer
+func (s *customerService) DeleteCustomer(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug("Deleting customer", zap.String("customer_id", id.String()))
+
+	if err := s.customerRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("Failed to delete customer",
+			zap.Error(err),
+			zap.String("customer_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete customer: %w", err)
+	}
+
+	s.logger.Info("Customer deleted successfully", zap.String("customer_id", id.String()))
+	return nil
+}
+
+// RestoreCustomer brings back a soft-deleted customer
+func (s *customerService) RestoreCustomer(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug("Restoring customer", zap.String("customer_id", id.String()))
+
+	if err := s.customerRepo.Restore(ctx, id); err != nil {
+		s.logger.Error("Failed to restore customer",
+			zap.Error(err),
+			zap.String("customer_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore customer: %w", err)
+	}
+
+	s.logger.Info("Customer restored successfully", zap.String("customer_id", id.String()))
+	return nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// maxDigestStaleIssues caps how many stale issues are listed in one digest
+const maxDigestStaleIssues = 5
+
+// maxDigestOverdueIssues caps how many overdue issues are listed in one digest
+const maxDigestOverdueIssues = 5
+
+// digestService implements the DigestService interface
+type digestService struct {
+	channelRepo  domain.ChannelRepository
+	issueRepo    domain.IssueRepository
+	reportRepo   domain.ReportRepository
+	settingsRepo domain.GuildSettingsRepository
+	notifier     domain.DigestNotifier
+	period       time.Duration
+	staleAfter   time.Duration
+	location     *time.Location
+	now          func() time.Time
+	logger       *zap.Logger
+}
+
+// NewDigestService creates a new instance of digest service.
+// period is the reporting window; issues untouched for staleAfter are listed as stale.
+// Days are counted in location unless the channel's guild has chosen a time zone.
+func NewDigestService(
+	channelRepo domain.ChannelRepository,
+	issueRepo domain.IssueRepository,
+	reportRepo domain.ReportRepository,
+	settingsRepo domain.GuildSettingsRepository,
+	notifier domain.DigestNotifier,
+	period time.Duration,
+	staleAfter time.Duration,
+	location *time.Location,
+	logger *zap.Logger,
+) domain.DigestService {
+	return &digestService{
+		channelRepo:  channelRepo,
+		issueRepo:    issueRepo,
+		reportRepo:   reportRepo,
+		settingsRepo: settingsRepo,
+		notifier:     notifier,
+		period:       period,
+		staleAfter:   staleAfter,
+		location:     location,
+		now:          time.Now,
+		logger:       logger,
+	}
+}
+
+// BuildDigest computes the digest for one channel covering the period ending at now
+func (s *digestService) BuildDigest(ctx context.Context, channel *domain.Channel, now time.Time) (*domain.Digest, error) {
+	scope := domain.ReportScope{ChannelID: &channel.ID}
+	digest := &domain.Digest{
+		Channel:     channel,
+		From:        now.Add(-s.period),
+		To:          now,
+		StaleBefore: now.Add(-s.staleAfter),
+	}
+
+	summary, err := s.reportRepo.GetPeriodSummary(ctx, scope, digest.From, digest.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get period summary: %w", err)
+	}
+	digest.Summary = *summary
+
+	loc, err := guildLocation(ctx, s.settingsRepo, channel.GuildID, s.location)
+	if err != nil {
+		return nil, err
+	}
+	digest.OpenedPerDay, err = s.reportRepo.CountCreatedPerDay(ctx, scope, digest.From, digest.To, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count issues per day: %w", err)
+	}
+
+	counts, err := s.reportRepo.CountUnresolvedByPriority(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unresolved issues: %w", err)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return priorityRank(counts[i].Priority) > priorityRank(counts[j].Priority)
+	})
+	digest.UnresolvedByPriority = counts
+
+	stale, _, err := s.issueRepo.Query(ctx, domain.IssueQuery{
+		Statuses:      domain.UnresolvedStatuses(),
+		ChannelID:     channel.ID,
+		UpdatedBefore: digest.StaleBefore,
+		Sort:          domain.IssueSortStale,
+		Limit:         maxDigestStaleIssues,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stale issues: %w", err)
+	}
+	digest.StaleIssues = stale
+
+	overdue, _, err := s.issueRepo.Query(ctx, domain.IssueQuery{
+		Statuses:  domain.UnresolvedStatuses(),
+		ChannelID: channel.ID,
+		DueBefore: now,
+		Sort:      domain.IssueSortDueDate,
+		Limit:     maxDigestOverdueIssues,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue issues: %w", err)
+	}
+	digest.OverdueIssues = overdue
+
+	// Ratings are reported for the channel's main project, like /stats
+	csat, err := s.reportRepo.GetCSAT(ctx, domain.ReportScope{ProjectID: &channel.ProjectID}, digest.From, digest.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute CSAT: %w", err)
+	}
+	digest.CSAT = *csat
+
+	return digest, nil
+}
+
+// SendDigests builds and delivers a digest to every active registered channel.
+// Channels without any activity are skipped.
+func (s *digestService) SendDigests(ctx context.Context) error {
+	channels, err := s.channelRepo.GetActiveChannels(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get active channels for digest", zap.Error(err))
+		return fmt.Errorf("failed to get active channels for digest: %w", err)
+	}
+
+	now := s.now()
+	sent, failed := 0, 0
+	for _, channel := range channels {
+		digest, err := s.BuildDigest(ctx, channel, now)
+		if err != nil {
+			s.logger.Error("Failed to build digest",
+				zap.Error(err),
+				zap.String("channel_id", channel.DiscordChannelID),
+			)
+			failed++
+			continue
+		}
+
+		if !digest.HasActivity() {
+			continue
+		}
+
+		if err := s.notifier.NotifyDigest(ctx, digest); err != nil {
+			s.logger.Error("Failed to send digest",
+				zap.Error(err),
+				zap.String("channel_id", channel.DiscordChannelID),
+			)
+			failed++
+			continue
+		}
+		sent++
+	}
+
+	s.logger.Info("Digests sent",
+		zap.Int("channels", len(channels)),
+		zap.Int("sent", sent),
+		zap.Int("failed", failed),
+	)
+
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d of %d digests", failed, len(channels))
+	}
+
+	return nil
+}
+
+// priorityRank orders priorities from low to high
+func priorityRank(p domain.Priority) int {
+	switch p {
+	case domain.PriorityHigh:
+		return 3
+	case domain.PriorityMedium:
+		return 2
+	case domain.PriorityLow:
+		return 1
+	default:
+		return 0
+	}
+}
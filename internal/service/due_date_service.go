@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// dueDateLogLayout formats due dates in the issue history
+const dueDateLogLayout = "2006-01-02 15:04 MST"
+
+// dueDateService implements the DueDateService interface
+type dueDateService struct {
+	issueRepo        domain.IssueRepository
+	userRepo         domain.UserRepository
+	statusLogService domain.IssueStatusLogService
+	notifier         domain.DueDateNotifier
+	events           domain.EventPublisher
+	location         *time.Location
+	remindBefore     time.Duration
+	now              func() time.Time
+	logger           *zap.Logger
+}
+
+// NewDueDateService creates a new due date service. Dates without a time zone are read in
+// location, and assignees are reminded remindBefore an issue is due.
+func NewDueDateService(
+	issueRepo domain.IssueRepository,
+	userRepo domain.UserRepository,
+	statusLogService domain.IssueStatusLogService,
+	notifier domain.DueDateNotifier,
+	events domain.EventPublisher,
+	location *time.Location,
+	remindBefore time.Duration,
+	logger *zap.Logger,
+) domain.DueDateService {
+	return &dueDateService{
+		issueRepo:        issueRepo,
+		userRepo:         userRepo,
+		statusLogService: statusLogService,
+		notifier:         notifier,
+		events:           events,
+		location:         location,
+		remindBefore:     remindBefore,
+		now:              time.Now,
+		logger:           logger,
+	}
+}
+
+// SetDueDate sets or removes the due date of an issue and records it in the issue history
+func (s *dueDateService) SetDueDate(ctx context.Context, issueID uuid.UUID, value, changedBy string) (*domain.Issue, error) {
+	due, err := domain.ParseDueDate(value, s.location)
+	if err != nil {
+		return nil, err
+	}
+	if due != nil && !due.After(s.now()) {
+		return nil, domain.ErrDueDateInPast
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if due != nil && issue.IsClosed() {
+		return nil, domain.ErrIssueAlreadyClosed
+	}
+
+	if err := s.issueRepo.SetDueDate(ctx, issue.ID, due); err != nil {
+		return nil, err
+	}
+	issue.DueDate = due
+	issue.DueReminderSent = domain.DueReminderNone
+
+	var changedByID *uuid.UUID
+	if user, err := s.userRepo.GetByDiscordID(ctx, changedBy); err == nil {
+		changedByID = &user.ID
+	}
+
+	note := "Cleared due date"
+	if due != nil {
+		note = fmt.Sprintf("Set due date to %s", due.Format(dueDateLogLayout))
+	}
+	if _, err := s.statusLogService.LogIssueEdit(ctx, issue.ID, issue.Status, changedByID, note); err != nil {
+		s.logger.Warn("Failed to record due date change",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueEdited, Issue: issue, ActorID: changedBy})
+
+	s.logger.Info("Issue due date updated",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("note", note),
+		zap.String("changed_by", changedBy),
+	)
+
+	return issue, nil
+}
+
+// CheckDueDates reminds the assignees of unresolved issues due within the reminder window,
+// and again once the due date has passed. Each reminder is sent once per due date.
+func (s *dueDateService) CheckDueDates(ctx context.Context) error {
+	now := s.now()
+	issues, err := s.issueRepo.GetDueBefore(ctx, now.Add(s.remindBefore))
+	if err != nil {
+		s.logger.Error("Failed to get issues for due date check", zap.Error(err))
+		return fmt.Errorf("failed to get issues for due date check: %w", err)
+	}
+
+	sent := 0
+	for _, issue := range issues {
+		// Issues of deactivated channels have nowhere to be reminded
+		if issue.Channel == nil || !issue.Channel.IsActive {
+			continue
+		}
+
+		reminder := issue.DueReminder(s.remindBefore, now)
+		if reminder == domain.DueReminderNone || reminder == issue.DueReminderSent {
+			continue
+		}
+		if s.remind(ctx, issue, reminder) {
+			sent++
+		}
+	}
+
+	s.logger.Debug("Due date check completed",
+		zap.Int("issues_checked", len(issues)),
+		zap.Int("reminders_sent", sent),
+	)
+
+	return nil
+}
+
+// remind sends a due date reminder and records it. It returns true if the reminder was sent.
+func (s *dueDateService) remind(ctx context.Context, issue *domain.Issue, reminder domain.DueReminder) bool {
+	var err error
+	switch reminder {
+	case domain.DueReminderSoon:
+		err = s.notifier.NotifyDueSoon(ctx, issue)
+	case domain.DueReminderOverdue:
+		err = s.notifier.NotifyOverdue(ctx, issue)
+	}
+	// Only record the reminder once it was delivered so failed reminders are retried on the next check
+	if err != nil {
+		s.logger.Error("Failed to send due date reminder",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("reminder", string(reminder)),
+		)
+		return false
+	}
+
+	if err := s.issueRepo.SetDueReminderSent(ctx, issue.ID, reminder); err != nil {
+		s.logger.Error("Failed to record due date reminder",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	s.logger.Info("Due date reminder sent",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("reminder", string(reminder)),
+	)
+	return true
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// escalationService implements the EscalationService interface
+type escalationService struct {
+	channelRepo    domain.ChannelRepository
+	issueRepo      domain.IssueRepository
+	escalationRepo domain.EscalationRepository
+	issueService   domain.IssueService
+	notifier       domain.EscalationNotifier
+	events         domain.EventPublisher
+	now            func() time.Time
+	logger         *zap.Logger
+}
+
+// NewEscalationService creates a new instance of escalation service
+func NewEscalationService(
+	channelRepo domain.ChannelRepository,
+	issueRepo domain.IssueRepository,
+	escalationRepo domain.EscalationRepository,
+	issueService domain.IssueService,
+	notifier domain.EscalationNotifier,
+	events domain.EventPublisher,
+	logger *zap.Logger,
+) domain.EscalationService {
+	return &escalationService{
+		channelRepo:    channelRepo,
+		issueRepo:      issueRepo,
+		escalationRepo: escalationRepo,
+		issueService:   issueService,
+		notifier:       notifier,
+		events:         events,
+		now:            time.Now,
+		logger:         logger,
+	}
+}
+
+// SetRule sets the escalation rule for a priority of the main project of a Discord channel,
+// replacing the priority's previous rule
+func (s *escalationService) SetRule(ctx context.Context, discordChannelID string, priority domain.Priority, afterHours int, roleID string, bumpPriority bool, createdBy string) (*domain.EscalationRule, error) {
+	if !domain.IsValidPriority(priority) {
+		return nil, domain.ErrInvalidPriority
+	}
+	if !domain.IsValidEscalationHours(afterHours) {
+		return nil, domain.ErrInvalidEscalationHours
+	}
+	if _, ok := domain.NextPriority(priority); bumpPriority && !ok {
+		return nil, domain.ErrCannotBumpPriority
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &domain.EscalationRule{
+		ID:           uuid.New(),
+		ProjectID:    channel.ProjectID,
+		Priority:     priority,
+		AfterHours:   afterHours,
+		RoleID:       strings.TrimSpace(roleID),
+		BumpPriority: bumpPriority,
+		CreatedBy:    createdBy,
+	}
+	if err := s.escalationRepo.SaveRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Escalation rule set",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("priority", string(priority)),
+		zap.Int("after_hours", afterHours),
+		zap.String("role_id", rule.RoleID),
+		zap.Bool("bump_priority", bumpPriority),
+		zap.String("created_by", createdBy),
+	)
+
+	return rule, nil
+}
+
+// ListRules retrieves the escalation rules of the main project of a Discord channel
+func (s *escalationService) ListRules(ctx context.Context, discordChannelID string) ([]*domain.EscalationRule, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.escalationRepo.ListRulesByProject(ctx, channel.ProjectID)
+}
+
+// RemoveRule removes the escalation rule for a priority of the main project of a Discord channel
+func (s *escalationService) RemoveRule(ctx context.Context, discordChannelID string, priority domain.Priority) (*domain.EscalationRule, error) {
+	rules, err := s.ListRules(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule *domain.EscalationRule
+	for _, r := range rules {
+		if r.Priority == priority {
+			rule = r
+			break
+		}
+	}
+	if rule == nil {
+		return nil, domain.ErrEscalationRuleNotFound
+	}
+
+	if err := s.escalationRepo.DeleteRule(ctx, rule.ID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Escalation rule removed",
+		zap.String("project_id", rule.ProjectID.String()),
+		zap.String("priority", string(priority)),
+	)
+
+	return rule, nil
+}
+
+// CheckEscalations escalates the open issues that are past their project's rules
+func (s *escalationService) CheckEscalations(ctx context.Context) error {
+	s.logger.Debug("Checking escalations")
+
+	rules, err := s.escalationRepo.ListRules(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	type ruleKey struct {
+		projectID uuid.UUID
+		priority  domain.Priority
+	}
+	byProject := make(map[ruleKey]*domain.EscalationRule, len(rules))
+	for _, rule := range rules {
+		byProject[ruleKey{rule.ProjectID, rule.Priority}] = rule
+	}
+
+	issues, err := s.issueRepo.GetByStatuses(ctx, []domain.Status{domain.StatusOpen})
+	if err != nil {
+		s.logger.Error("Failed to get open issues for escalation check", zap.Error(err))
+		return fmt.Errorf("failed to get open issues for escalation check: %w", err)
+	}
+
+	now := s.now()
+	escalated := 0
+	for _, issue := range issues {
+		// Issues of deactivated channels have nowhere to be escalated
+		if issue.Channel == nil || !issue.Channel.IsActive {
+			continue
+		}
+
+		rule := byProject[ruleKey{issue.ProjectID, issue.Priority}]
+		if rule == nil || !rule.IsDue(issue, now) {
+			continue
+		}
+		if s.escalate(ctx, issue, rule) {
+			escalated++
+		}
+	}
+
+	s.logger.Debug("Escalation check completed",
+		zap.Int("issues_checked", len(issues)),
+		zap.Int("escalated", escalated),
+	)
+
+	return nil
+}
+
+// escalate pings the rule's role about an issue and raises its priority if the rule says
+// so. It returns true if the issue was escalated.
+func (s *escalationService) escalate(ctx context.Context, issue *domain.Issue, rule *domain.EscalationRule) bool {
+	exists, err := s.escalationRepo.HasEscalated(ctx, issue.ID, issue.Priority)
+	if err != nil || exists {
+		return false
+	}
+
+	// Only record the escalation once it was delivered so failed pings are retried on the next check
+	if err := s.notifier.NotifyEscalation(ctx, issue, rule); err != nil {
+		s.logger.Error("Failed to send escalation",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return false
+	}
+
+	escalation := &domain.IssueEscalation{
+		ID:          uuid.New(),
+		IssueID:     issue.ID,
+		Priority:    issue.Priority,
+		RoleID:      rule.RoleID,
+		EscalatedAt: s.now(),
+	}
+	if err := s.escalationRepo.RecordEscalation(ctx, escalation); err != nil {
+		s.logger.Error("Failed to record escalation",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	event := domain.Event{Type: domain.EventIssueEscalated, Issue: issue, Escalation: escalation}
+	if next, ok := domain.NextPriority(issue.Priority); rule.BumpPriority && ok {
+		if err := s.issueService.UpdateIssuePriority(ctx, issue.ID, next); err != nil {
+			s.logger.Error("Failed to raise priority of escalated issue",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		} else {
+			event.OldPriority = issue.Priority
+			issue.Priority = next
+		}
+	}
+	s.events.Publish(ctx, event)
+
+	s.logger.Info("Issue escalated",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("priority", string(issue.Priority)),
+		zap.String("role_id", rule.RoleID),
+	)
+
+	return true
+}
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/pkg/spreadsheet"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// exportTimeLayout is used for all timestamps in exports
+const exportTimeLayout = "2006-01-02 15:04:05 MST"
+
+// exportHeaders are the columns of an issue export, followed by one column per custom field
+var exportHeaders = []string{
+	"ID",
+	"Title",
+	"Description",
+	"Status",
+	"Priority",
+	"Source",
+	"Reporter",
+	"Assignees",
+	"Labels",
+	"Created At",
+	"Updated At",
+	"Closed At",
+	"Due Date",
+	"Milestone",
+	"Status History",
+	"Time Spent (h)",
+	"Time Spent By User",
+}
+
+// exportService implements the ExportService interface
+type exportService struct {
+	channelRepo   domain.ChannelRepository
+	projectRepo   domain.ProjectRepository
+	issueRepo     domain.IssueRepository
+	fieldRepo     domain.CustomFieldRepository
+	milestoneRepo domain.MilestoneRepository
+	settingsRepo  domain.GuildSettingsRepository
+	auditor       domain.Auditor
+	now           func() time.Time
+	logger        *zap.Logger
+}
+
+// NewExportService creates a new instance of export service.
+// Timestamps are written in UTC unless the guild exporting has chosen a time zone.
+func NewExportService(
+	channelRepo domain.ChannelRepository,
+	projectRepo domain.ProjectRepository,
+	issueRepo domain.IssueRepository,
+	fieldRepo domain.CustomFieldRepository,
+	milestoneRepo domain.MilestoneRepository,
+	settingsRepo domain.GuildSettingsRepository,
+	auditor domain.Auditor,
+	logger *zap.Logger,
+) domain.ExportService {
+	return &exportService{
+		channelRepo:   channelRepo,
+		projectRepo:   projectRepo,
+		issueRepo:     issueRepo,
+		fieldRepo:     fieldRepo,
+		milestoneRepo: milestoneRepo,
+		settingsRepo:  settingsRepo,
+		auditor:       auditor,
+		now:           time.Now,
+		logger:        logger,
+	}
+}
+
+// ExportChannelProjectIssues exports every issue of the project registered to a Discord
+// channel, or only those of a milestone
+func (s *exportService) ExportChannelProjectIssues(ctx context.Context, discordChannelID string, format domain.ExportFormat, milestone string) (*domain.ExportFile, error) {
+	s.logger.Info("Exporting project issues",
+		zap.String("channel_id", discordChannelID),
+		zap.String("format", string(format)),
+		zap.String("milestone", milestone),
+	)
+
+	if !domain.IsValidExportFormat(format) {
+		return nil, domain.ErrInvalidExportFormat
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.exportProject(ctx, &channel.Project, channel.GuildID, format, milestone)
+}
+
+// ExportProjectIssues exports every issue of a project, or only those of a milestone
+func (s *exportService) ExportProjectIssues(ctx context.Context, projectID uuid.UUID, format domain.ExportFormat, milestone string) (*domain.ExportFile, error) {
+	s.logger.Info("Exporting project issues",
+		zap.String("project_id", projectID.String()),
+		zap.String("format", string(format)),
+		zap.String("milestone", milestone),
+	)
+
+	if !domain.IsValidExportFormat(format) {
+		return nil, domain.ErrInvalidExportFormat
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.exportProject(ctx, project, "", format, milestone)
+}
+
+// exportProject writes the issues of a project, or of one of its milestones when
+// milestoneName is not empty, in the given format and the time zone of guildID, and records
+// the export in the audit log of guildID
+func (s *exportService) exportProject(ctx context.Context, project *domain.Project, guildID string, format domain.ExportFormat, milestoneName string) (*domain.ExportFile, error) {
+	loc, err := guildLocation(ctx, s.settingsRepo, guildID, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+
+	var milestone *domain.Milestone
+	if milestoneName != "" {
+		milestones, err := s.milestoneRepo.ListByProject(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project milestones: %w", err)
+		}
+		if milestone = findMilestone(milestones, domain.NormalizeMilestoneName(milestoneName)); milestone == nil {
+			return nil, domain.ErrMilestoneNotFound
+		}
+	}
+
+	query := domain.IssueQuery{
+		ProjectID: project.ID,
+		Sort:      domain.IssueSortOldest,
+		Details:   domain.IssueDetailsAssignees | domain.IssueDetailsHistory,
+	}
+	if milestone != nil {
+		query.MilestoneID = milestone.ID
+	}
+	issues, _, err := s.issueRepo.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project issues: %w", err)
+	}
+
+	fields, err := s.fieldRepo.ListByProject(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project custom fields: %w", err)
+	}
+
+	headers := append([]string{}, exportHeaders...)
+	for _, field := range fields {
+		headers = append(headers, field.Name)
+	}
+
+	table := &spreadsheet.Table{
+		Name:    project.Name,
+		Headers: headers,
+		Rows:    make([][]string, 0, len(issues)),
+	}
+	for _, issue := range issues {
+		table.Rows = append(table.Rows, exportRow(issue, fields, loc))
+	}
+
+	var buf bytes.Buffer
+	slug := exportSlug(project.Name)
+	if milestone != nil {
+		slug += "-" + exportSlug(milestone.Name)
+	}
+	file := &domain.ExportFile{
+		Name:       fmt.Sprintf("%s-issues-%s.%s", slug, s.now().In(loc).Format("20060102"), format),
+		IssueCount: len(issues),
+	}
+
+	switch format {
+	case domain.ExportFormatCSV:
+		file.ContentType = "text/csv"
+		err = spreadsheet.WriteCSV(&buf, table)
+	case domain.ExportFormatXLSX:
+		file.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		err = spreadsheet.WriteXLSX(&buf, table)
+	}
+	if err != nil {
+		s.logger.Error("Failed to write export", zap.Error(err))
+		return nil, fmt.Errorf("failed to write export: %w", err)
+	}
+	file.Data = buf.Bytes()
+
+	after := map[string]any{"format": format, "issues": len(issues)}
+	if milestone != nil {
+		after["milestone"] = milestone.Name
+	}
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditIssuesExported,
+		GuildID:    guildID,
+		TargetType: domain.AuditTargetProject,
+		TargetID:   project.ID.String(),
+		TargetName: project.Name,
+		After:      after,
+	})
+
+	s.logger.Info("Project issues exported",
+		zap.String("project_id", project.ID.String()),
+		zap.Int("issues", len(issues)),
+		zap.Int("bytes", len(file.Data)),
+	)
+
+	return file, nil
+}
+
+// exportRow renders one issue as an export row matching exportHeaders and the project's
+// custom fields, with timestamps in loc
+func exportRow(issue *domain.Issue, fields []*domain.CustomFieldDefinition, loc *time.Location) []string {
+	assignees := make([]string, 0, len(issue.Assignees))
+	for _, a := range issue.Assignees {
+		assignees = append(assignees, fmt.Sprintf("%s (%s)", exportUserName(&a.User), a.Role))
+	}
+
+	labels := make([]string, 0, len(issue.Labels))
+	for _, l := range issue.Labels {
+		labels = append(labels, l.Name)
+	}
+
+	history := make([]string, 0, len(issue.StatusLogs))
+	for _, statusLog := range issue.StatusLogs {
+		changedAt := statusLog.ChangedAt.In(loc).Format(exportTimeLayout)
+		entry := fmt.Sprintf("%s %s", changedAt, statusLog.NewStatus)
+		switch {
+		case statusLog.IsEdit():
+			entry = fmt.Sprintf("%s %s", changedAt, statusLog.Note)
+		case statusLog.OldStatus != nil:
+			entry = fmt.Sprintf("%s %s → %s", changedAt, *statusLog.OldStatus, statusLog.NewStatus)
+		}
+		if statusLog.ChangedByUser != nil {
+			entry += " by " + exportUserName(statusLog.ChangedByUser)
+		}
+		history = append(history, entry)
+	}
+
+	timeByUser := make([]string, 0, len(issue.Worklogs))
+	for _, spent := range issue.TimeSpentByUser() {
+		user := domain.User{Name: spent.Name, DiscordID: spent.DiscordID}
+		timeByUser = append(timeByUser, fmt.Sprintf("%s (%s h)", exportUserName(&user), exportHours(spent.Duration())))
+	}
+
+	closedAt := ""
+	if issue.ClosedAt != nil {
+		closedAt = issue.ClosedAt.In(loc).Format(exportTimeLayout)
+	}
+	dueDate := ""
+	if issue.DueDate != nil {
+		dueDate = issue.DueDate.In(loc).Format(exportTimeLayout)
+	}
+	milestone := ""
+	if issue.Milestone != nil {
+		milestone = issue.Milestone.Name
+	}
+
+	row := []string{
+		issue.ID.String(),
+		issue.Title,
+		issue.Description,
+		string(issue.Status),
+		string(issue.Priority),
+		issue.Source,
+		exportUserName(&issue.Reporter),
+		strings.Join(assignees, "; "),
+		strings.Join(labels, "; "),
+		issue.CreatedAt.In(loc).Format(exportTimeLayout),
+		issue.UpdatedAt.In(loc).Format(exportTimeLayout),
+		closedAt,
+		dueDate,
+		milestone,
+		strings.Join(history, "\n"),
+		exportHours(issue.TimeSpent()),
+		strings.Join(timeByUser, "; "),
+	}
+
+	values := make(map[uuid.UUID]string, len(issue.CustomFieldValues))
+	for _, value := range issue.CustomFieldValues {
+		values[value.FieldID] = value.Value
+	}
+	for _, field := range fields {
+		row = append(row, values[field.ID])
+	}
+	return row
+}
+
+// exportHours formats time spent as decimal hours, which spreadsheets can sum
+func exportHours(d time.Duration) string {
+	return strconv.FormatFloat(d.Hours(), 'f', 2, 64)
+}
+
+// exportUserName returns the best available display name for a user
+func exportUserName(u *domain.User) string {
+	switch {
+	case u.Name != "":
+		return u.Name
+	case u.Email != "":
+		return u.Email
+	default:
+		return u.DiscordID
+	}
+}
+
+// exportSlug turns a project name into a file name friendly slug
+func exportSlug(name string) string {
+	slug := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, name)
+	slug = strings.Trim(slug, "-")
+	if slug == "" {
+		return "project"
+	}
+	return slug
+}
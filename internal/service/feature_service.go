@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// featureService implements the FeatureService interface
+type featureService struct {
+	settingsService domain.GuildSettingsService
+	logger          *zap.Logger
+
+	mu       sync.RWMutex
+	defaults map[domain.Feature]bool
+}
+
+// NewFeatureService creates a new instance of feature service. Features missing from
+// defaults are off unless a guild turns them on.
+func NewFeatureService(settingsService domain.GuildSettingsService, defaults map[domain.Feature]bool, logger *zap.Logger) domain.FeatureService {
+	return &featureService{
+		settingsService: settingsService,
+		defaults:        maps.Clone(defaults),
+		logger:          logger,
+	}
+}
+
+// IsEnabled reports whether a feature is on in a guild, falling back to the configured
+// default if the guild's settings cannot be read
+func (s *featureService) IsEnabled(ctx context.Context, guildID string, feature domain.Feature) bool {
+	fallback := s.defaultFor(feature)
+
+	settings, err := s.settingsService.GetSettings(ctx, guildID)
+	if err != nil {
+		s.logger.Warn("Failed to get guild settings for feature flag",
+			zap.Error(err),
+			zap.String("guild_id", guildID),
+			zap.String("feature", string(feature)),
+		)
+		return fallback
+	}
+
+	enabled, _ := settings.FeatureEnabled(feature, fallback)
+	return enabled
+}
+
+// List returns the state of every feature in a guild
+func (s *featureService) List(ctx context.Context, guildID string) ([]domain.FeatureState, error) {
+	settings, err := s.settingsService.GetSettings(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]domain.FeatureState, 0, len(domain.Features()))
+	for _, feature := range domain.Features() {
+		enabled, overridden := settings.FeatureEnabled(feature, s.defaultFor(feature))
+		states = append(states, domain.FeatureState{Feature: feature, Enabled: enabled, Overridden: overridden})
+	}
+	return states, nil
+}
+
+// SetEnabled turns a feature on or off in a guild
+func (s *featureService) SetEnabled(ctx context.Context, guildID string, feature domain.Feature, enabled bool, updatedBy string) error {
+	if _, err := s.settingsService.SetFeature(ctx, guildID, feature, enabled, updatedBy); err != nil {
+		return err
+	}
+
+	s.logger.Info("Feature flag set",
+		zap.String("guild_id", guildID),
+		zap.String("feature", string(feature)),
+		zap.Bool("enabled", enabled),
+	)
+	return nil
+}
+
+// Reset returns a feature to its configured default in a guild
+func (s *featureService) Reset(ctx context.Context, guildID string, feature domain.Feature, updatedBy string) error {
+	if _, err := s.settingsService.ClearFeature(ctx, guildID, feature, updatedBy); err != nil {
+		return err
+	}
+
+	s.logger.Info("Feature flag reset",
+		zap.String("guild_id", guildID),
+		zap.String("feature", string(feature)),
+	)
+	return nil
+}
+
+// SetDefaults replaces the configured defaults
+func (s *featureService) SetDefaults(defaults map[domain.Feature]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.defaults = maps.Clone(defaults)
+}
+
+// defaultFor returns the configured default of a feature
+func (s *featureService) defaultFor(feature domain.Feature) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.defaults[feature]
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// feedbackService implements the FeedbackService interface
+type feedbackService struct {
+	issueRepo    domain.IssueRepository
+	userRepo     domain.UserRepository
+	feedbackRepo domain.IssueFeedbackRepository
+	surveyor     domain.FeedbackSurveyor
+	logger       *zap.Logger
+}
+
+// NewFeedbackService creates a new feedback service sending surveys through surveyor
+func NewFeedbackService(
+	issueRepo domain.IssueRepository,
+	userRepo domain.UserRepository,
+	feedbackRepo domain.IssueFeedbackRepository,
+	surveyor domain.FeedbackSurveyor,
+	logger *zap.Logger,
+) domain.FeedbackService {
+	return &feedbackService{
+		issueRepo:    issueRepo,
+		userRepo:     userRepo,
+		feedbackRepo: feedbackRepo,
+		surveyor:     surveyor,
+		logger:       logger,
+	}
+}
+
+// HandleIssueEvent sends the reporter of a closed issue a satisfaction survey in the background
+func (s *feedbackService) HandleIssueEvent(_ context.Context, event domain.Event) {
+	// Recurring issues are filed by the bot; their reporter only scheduled them
+	if event.Type != domain.EventIssueStatusChanged || event.Issue.Status != domain.StatusClosed ||
+		event.Issue.Source == string(domain.SourceRecurring) {
+		return
+	}
+
+	issueID := event.Issue.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		s.survey(ctx, issueID)
+	}()
+}
+
+// survey sends the satisfaction survey of a closed issue to its reporter
+func (s *feedbackService) survey(ctx context.Context, issueID uuid.UUID) {
+	if _, err := s.feedbackRepo.GetByIssueID(ctx, issueID); !errors.Is(err, domain.ErrFeedbackNotFound) {
+		return
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to load issue for feedback survey",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return
+	}
+
+	reporter := &issue.Reporter
+	if reporter.DiscordID == "" || reporter.DMOptOut {
+		return
+	}
+
+	if err := s.surveyor.SendSurvey(ctx, reporter, issue); err != nil {
+		// Reporters may have closed their DMs; that is not worth more than a warning
+		s.logger.Warn("Failed to send feedback survey",
+			zap.Error(err),
+			zap.String("discord_id", reporter.DiscordID),
+			zap.String("issue_id", issueID.String()),
+		)
+		return
+	}
+
+	s.logger.Info("Feedback survey sent", zap.String("issue_id", issueID.String()))
+}
+
+// Rate stores the rating the reporter of an issue gave in its survey
+func (s *feedbackService) Rate(ctx context.Context, issueID uuid.UUID, discordID string, rating int) (*domain.IssueFeedback, error) {
+	if !domain.IsValidFeedbackRating(rating) {
+		return nil, domain.ErrInvalidFeedbackRating
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Reporter.DiscordID != discordID {
+		return nil, domain.ErrNotIssueReporter
+	}
+
+	now := time.Now()
+	feedback := &domain.IssueFeedback{
+		ID:        uuid.New(),
+		IssueID:   issue.ID,
+		UserID:    issue.ReporterID,
+		Rating:    rating,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.feedbackRepo.SaveRating(ctx, feedback); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Issue rated",
+		zap.String("issue_id", issue.ID.String()),
+		zap.Int("rating", rating),
+	)
+
+	return feedback, nil
+}
+
+// Comment adds the reporter's comment to the rating of an issue
+func (s *feedbackService) Comment(ctx context.Context, issueID uuid.UUID, discordID, comment string) (*domain.IssueFeedback, error) {
+	comment = domain.NormalizeFeedbackComment(comment)
+	if comment == "" || len([]rune(comment)) > domain.MaxFeedbackCommentLength {
+		return nil, domain.ErrInvalidFeedbackComment
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Reporter.DiscordID != discordID {
+		return nil, domain.ErrNotIssueReporter
+	}
+
+	if err := s.feedbackRepo.UpdateComment(ctx, issue.ID, comment); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Issue feedback commented", zap.String("issue_id", issue.ID.String()))
+
+	return s.feedbackRepo.GetByIssueID(ctx, issue.ID)
+}
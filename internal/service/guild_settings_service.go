@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// guildSettingsService implements the GuildSettingsService interface
+type guildSettingsService struct {
+	settingsRepo domain.GuildSettingsRepository
+	logger       *zap.Logger
+}
+
+// NewGuildSettingsService creates a new instance of guild settings service
+func NewGuildSettingsService(settingsRepo domain.GuildSettingsRepository, logger *zap.Logger) domain.GuildSettingsService {
+	return &guildSettingsService{
+		settingsRepo: settingsRepo,
+		logger:       logger,
+	}
+}
+
+// GetSettings retrieves a guild's settings, or empty settings if it has none stored
+func (s *guildSettingsService) GetSettings(ctx context.Context, guildID string) (*domain.GuildSettings, error) {
+	if guildID == "" {
+		return nil, domain.ErrEmptyGuildID
+	}
+
+	settings, err := s.settingsRepo.GetByGuildID(ctx, guildID)
+	if err == domain.ErrGuildSettingsNotFound {
+		return &domain.GuildSettings{GuildID: guildID}, nil
+	}
+	return settings, err
+}
+
+// ListSettings retrieves the settings of every guild that has stored some
+func (s *guildSettingsService) ListSettings(ctx context.Context) ([]*domain.GuildSettings, error) {
+	return s.settingsRepo.List(ctx)
+}
+
+// InitSettings stores default settings for a guild the bot joined, unless it has some
+func (s *guildSettingsService) InitSettings(ctx context.Context, guildID string) (*domain.GuildSettings, error) {
+	if guildID == "" {
+		return nil, domain.ErrEmptyGuildID
+	}
+
+	settings, err := s.settingsRepo.GetByGuildID(ctx, guildID)
+	if err != domain.ErrGuildSettingsNotFound {
+		return settings, err
+	}
+
+	settings = &domain.GuildSettings{ID: uuid.New(), GuildID: guildID}
+	if err := s.settingsRepo.Create(ctx, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SetLocale sets the default locale of bot responses in the guild
+func (s *guildSettingsService) SetLocale(ctx context.Context, guildID, locale, updatedBy string) (*domain.GuildSettings, error) {
+	locale = strings.TrimSpace(locale)
+	if !domain.IsValidLocale(locale) {
+		return nil, domain.ErrInvalidLocale
+	}
+	if i18n.Match(locale) == "" {
+		return nil, domain.ErrUnsupportedLocale
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		settings.Locale = locale
+		return nil
+	})
+}
+
+// SetTimezone sets the time zone digests, stats and exports of the guild use
+func (s *guildSettingsService) SetTimezone(ctx context.Context, guildID, timezone, updatedBy string) (*domain.GuildSettings, error) {
+	timezone, err := domain.NormalizeTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		settings.Timezone = timezone
+		return nil
+	})
+}
+
+// AddAdminRole makes a Discord role grant the admin role in the guild
+func (s *guildSettingsService) AddAdminRole(ctx context.Context, guildID, roleID, updatedBy string) (*domain.GuildSettings, error) {
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		if settings.IsAdminRole(roleID) {
+			return domain.ErrAdminRoleExists
+		}
+		settings.AdminRoleIDs = append(settings.AdminRoleIDs, roleID)
+		return nil
+	})
+}
+
+// RemoveAdminRole stops a Discord role from granting the admin role in the guild
+func (s *guildSettingsService) RemoveAdminRole(ctx context.Context, guildID, roleID, updatedBy string) (*domain.GuildSettings, error) {
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		if !settings.IsAdminRole(roleID) {
+			return domain.ErrAdminRoleNotFound
+		}
+		remaining := make([]string, 0, len(settings.AdminRoleIDs)-1)
+		for _, id := range settings.AdminRoleIDs {
+			if id != roleID {
+				remaining = append(remaining, id)
+			}
+		}
+		settings.AdminRoleIDs = remaining
+		return nil
+	})
+}
+
+// SetEscalationChannel sets (or clears, with an empty ID) the channel receiving the guild's SLA breach alerts
+func (s *guildSettingsService) SetEscalationChannel(ctx context.Context, guildID, channelID, updatedBy string) (*domain.GuildSettings, error) {
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		settings.EscalationChannelID = channelID
+		return nil
+	})
+}
+
+// SetReportEmoji sets the reaction that reports a message as an issue in the guild
+func (s *guildSettingsService) SetReportEmoji(ctx context.Context, guildID, emoji, updatedBy string) (*domain.GuildSettings, error) {
+	if strings.TrimSpace(emoji) != "" {
+		normalized, err := domain.NormalizeReportEmoji(emoji)
+		if err != nil {
+			return nil, err
+		}
+		emoji = normalized
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		settings.ReportEmoji = strings.TrimSpace(emoji)
+		return nil
+	})
+}
+
+// SetSLATarget overrides the configured SLA targets for one priority in the guild
+func (s *guildSettingsService) SetSLATarget(ctx context.Context, guildID string, priority domain.Priority, policy domain.SLAPolicy, updatedBy string) (*domain.GuildSettings, error) {
+	if !domain.IsValidPriority(priority) {
+		return nil, domain.ErrInvalidPriority
+	}
+	if policy.Response < 0 || policy.Resolution < 0 {
+		return nil, domain.ErrInvalidSLATarget
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		if settings.SLATargets == nil {
+			settings.SLATargets = make(map[domain.Priority]domain.SLAPolicy)
+		}
+		settings.SLATargets[priority] = policy
+		return nil
+	})
+}
+
+// ClearSLATarget returns one priority to the configured SLA targets
+func (s *guildSettingsService) ClearSLATarget(ctx context.Context, guildID string, priority domain.Priority, updatedBy string) (*domain.GuildSettings, error) {
+	if !domain.IsValidPriority(priority) {
+		return nil, domain.ErrInvalidPriority
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		delete(settings.SLATargets, priority)
+		return nil
+	})
+}
+
+// SetFeature turns a feature on or off in the guild, overriding the bot's config
+func (s *guildSettingsService) SetFeature(ctx context.Context, guildID string, feature domain.Feature, enabled bool, updatedBy string) (*domain.GuildSettings, error) {
+	if !domain.IsValidFeature(feature) {
+		return nil, domain.ErrInvalidFeature
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		if settings.Features == nil {
+			settings.Features = make(map[domain.Feature]bool)
+		}
+		settings.Features[feature] = enabled
+		return nil
+	})
+}
+
+// ClearFeature returns a feature to the bot's config in the guild
+func (s *guildSettingsService) ClearFeature(ctx context.Context, guildID string, feature domain.Feature, updatedBy string) (*domain.GuildSettings, error) {
+	if !domain.IsValidFeature(feature) {
+		return nil, domain.ErrInvalidFeature
+	}
+
+	return s.update(ctx, guildID, updatedBy, func(settings *domain.GuildSettings) error {
+		delete(settings.Features, feature)
+		return nil
+	})
+}
+
+// update loads a guild's settings, applies change and stores them, creating them on first use
+func (s *guildSettingsService) update(ctx context.Context, guildID, updatedBy string, change func(*domain.GuildSettings) error) (*domain.GuildSettings, error) {
+	if guildID == "" {
+		return nil, domain.ErrEmptyGuildID
+	}
+
+	settings, err := s.settingsRepo.GetByGuildID(ctx, guildID)
+	create := err == domain.ErrGuildSettingsNotFound
+	if create {
+		settings = &domain.GuildSettings{ID: uuid.New(), GuildID: guildID}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := change(settings); err != nil {
+		return nil, err
+	}
+	settings.UpdatedBy = updatedBy
+
+	if create {
+		err = s.settingsRepo.Create(ctx, settings)
+	} else {
+		err = s.settingsRepo.Update(ctx, settings)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Guild settings updated",
+		zap.String("guild_id", guildID),
+		zap.String("updated_by", updatedBy),
+	)
+
+	return settings, nil
+}
+
+// guildLocation returns the time zone a guild has chosen, or fallback if it has none
+func guildLocation(ctx context.Context, settingsRepo domain.GuildSettingsRepository, guildID string, fallback *time.Location) (*time.Location, error) {
+	if guildID == "" {
+		return fallback, nil
+	}
+
+	settings, err := settingsRepo.GetByGuildID(ctx, guildID)
+	if err == domain.ErrGuildSettingsNotFound {
+		return fallback, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild settings: %w", err)
+	}
+	return settings.Location(fallback), nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// inboundEmailService implements the InboundEmailService interface
+type inboundEmailService struct {
+	projectRepo    domain.ProjectRepository
+	channelRepo    domain.ChannelRepository
+	userRepo       domain.UserRepository
+	issueRepo      domain.IssueRepository
+	issueEmailRepo domain.IssueEmailRepository
+	issueService   domain.IssueService
+	logger         *zap.Logger
+}
+
+// NewInboundEmailService creates a new instance of inbound email service
+func NewInboundEmailService(
+	projectRepo domain.ProjectRepository,
+	channelRepo domain.ChannelRepository,
+	userRepo domain.UserRepository,
+	issueRepo domain.IssueRepository,
+	issueEmailRepo domain.IssueEmailRepository,
+	issueService domain.IssueService,
+	logger *zap.Logger,
+) domain.InboundEmailService {
+	return &inboundEmailService{
+		projectRepo:    projectRepo,
+		channelRepo:    channelRepo,
+		userRepo:       userRepo,
+		issueRepo:      issueRepo,
+		issueEmailRepo: issueEmailRepo,
+		issueService:   issueService,
+		logger:         logger,
+	}
+}
+
+// Receive files an email sent to a project's support address as an issue, or adds it to
+// the issue it replies to as a comment. Replies are found by the Message-IDs they
+// reference, or else by the issue key in their subject. Emails received before, e.g.
+// retried deliveries, are ignored.
+func (s *inboundEmailService) Receive(ctx context.Context, email *domain.InboundEmail) (*domain.Issue, error) {
+	if email.MessageID != "" {
+		issueID, err := s.issueEmailRepo.GetIssueID(ctx, []string{email.MessageID})
+		if err == nil {
+			s.logger.Debug("Ignored email received before", zap.String("message_id", email.MessageID))
+			return s.issueRepo.GetByID(ctx, issueID)
+		}
+		if !errors.Is(err, domain.ErrIssueNotFound) {
+			return nil, err
+		}
+	}
+
+	project, err := s.findProject(ctx, email.To)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := s.getOrCreateSender(ctx, email, project)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.findRepliedIssue(ctx, email, project)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue != nil {
+		if _, err := s.issueService.AddEmailComment(ctx, issue.ID, sender, domain.StripQuotedReply(email.Body)); err != nil {
+			return nil, err
+		}
+		s.logger.Info("Email reply added to issue",
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("from", email.FromAddress),
+		)
+	} else {
+		channelID, err := s.findChannel(ctx, project.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		issue, err = s.issueService.CreateEmailIssue(ctx, project.ID, channelID, domain.EmailIssueTitle(email.Subject), email.Body, sender.ID)
+		if err != nil {
+			return nil, err
+		}
+		s.logger.Info("Issue created from email",
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("project_id", project.ID.String()),
+			zap.String("from", email.FromAddress),
+		)
+	}
+
+	if email.MessageID != "" {
+		if err := s.issueEmailRepo.Create(ctx, &domain.IssueEmail{
+			ID:        uuid.New(),
+			IssueID:   issue.ID,
+			MessageID: email.MessageID,
+		}); err != nil {
+			// The email was filed; only threading replies to this very message is lost
+			s.logger.Warn("Failed to record email Message-ID",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+				zap.String("message_id", email.MessageID),
+			)
+		}
+	}
+
+	return issue, nil
+}
+
+// findProject returns the project receiving email at the first recipient address that is a support address
+func (s *inboundEmailService) findProject(ctx context.Context, recipients []string) (*domain.Project, error) {
+	for _, address := range recipients {
+		project, err := s.projectRepo.GetByInboundEmail(ctx, address)
+		if err == nil {
+			return project, nil
+		}
+		if !errors.Is(err, domain.ErrProjectNotFound) {
+			return nil, err
+		}
+	}
+	return nil, domain.ErrNoInboundProject
+}
+
+// getOrCreateSender returns the user with the sender's email address, creating a customer
+// user of the project's customer for new senders
+func (s *inboundEmailService) getOrCreateSender(ctx context.Context, email *domain.InboundEmail, project *domain.Project) (*domain.User, error) {
+	user, err := s.userRepo.GetByEmail(ctx, email.FromAddress)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	customerID := project.CustomerID
+	user = &domain.User{
+		ID:         uuid.New(),
+		CustomerID: &customerID,
+		Name:       email.FromName,
+		Email:      email.FromAddress,
+		Role:       domain.UserRoleCustomer,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Created user for email sender",
+		zap.String("user_id", user.ID.String()),
+		zap.String("email", email.FromAddress),
+	)
+	return user, nil
+}
+
+// findRepliedIssue returns the issue of the project an email replies to, or nil for a new issue
+func (s *inboundEmailService) findRepliedIssue(ctx context.Context, email *domain.InboundEmail, project *domain.Project) (*domain.Issue, error) {
+	issueID, err := s.issueEmailRepo.GetIssueID(ctx, email.References)
+	switch {
+	case err == nil:
+		issue, err := s.issueRepo.GetByID(ctx, issueID)
+		if err == nil && issue.ProjectID == project.ID {
+			return issue, nil
+		}
+		if err != nil && !errors.Is(err, domain.ErrIssueNotFound) {
+			return nil, err
+		}
+	case !errors.Is(err, domain.ErrIssueNotFound):
+		return nil, err
+	}
+
+	key, ok := domain.IssueKeyInSubject(email.Subject)
+	if !ok {
+		return nil, nil
+	}
+	issue, err := s.issueRepo.GetByIssueKey(ctx, key)
+	if errors.Is(err, domain.ErrIssueNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if issue.ProjectID != project.ID {
+		return nil, nil
+	}
+	return issue, nil
+}
+
+// findChannel returns the active registration whose main project is the given one, or nil
+// when the project has none
+func (s *inboundEmailService) findChannel(ctx context.Context, projectID uuid.UUID) (*uuid.UUID, error) {
+	channels, err := s.channelRepo.GetActiveChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range channels {
+		if channel.ProjectID == projectID {
+			return &channel.ID, nil
+		}
+	}
+	return nil, nil
+}
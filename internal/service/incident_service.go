@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// incidentService implements the IncidentService interface
+type incidentService struct {
+	userRepo         domain.UserRepository
+	incidentRepo     domain.IncidentRepository
+	issueService     domain.IssueService
+	statusLogService domain.IssueStatusLogService
+	uow              domain.UnitOfWork
+	notifier         domain.IncidentNotifier
+	updateInterval   time.Duration
+	now              func() time.Time
+	logger           *zap.Logger
+}
+
+// NewIncidentService creates a new incident service. Commanders are expected to post a
+// status update every updateInterval.
+func NewIncidentService(
+	userRepo domain.UserRepository,
+	incidentRepo domain.IncidentRepository,
+	issueService domain.IssueService,
+	statusLogService domain.IssueStatusLogService,
+	uow domain.UnitOfWork,
+	notifier domain.IncidentNotifier,
+	updateInterval time.Duration,
+	logger *zap.Logger,
+) domain.IncidentService {
+	return &incidentService{
+		userRepo:         userRepo,
+		incidentRepo:     incidentRepo,
+		issueService:     issueService,
+		statusLogService: statusLogService,
+		uow:              uow,
+		notifier:         notifier,
+		updateInterval:   updateInterval,
+		now:              time.Now,
+		logger:           logger,
+	}
+}
+
+// Declare files an incident in the main project of a Discord channel. The issue skips the
+// draft stage and is raised to high priority, which pages the member on call.
+func (s *incidentService) Declare(ctx context.Context, discordChannelID string, declaration domain.IncidentDeclaration) (*domain.Incident, error) {
+	severity := declaration.Severity
+	if severity == "" {
+		severity = domain.SeverityS1
+	}
+	if !domain.IsIncidentSeverity(severity) {
+		return nil, domain.ErrInvalidIncidentSeverity
+	}
+
+	commanderID := declaration.CommanderID
+	var incident *domain.Incident
+
+	// File the issue and the incident together so a failure leaves no half-declared
+	// incident behind; the issue's events are published once both are stored
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		issue, err := s.issueService.CreateIssue(ctx, declaration.Title, declaration.Description, "", commanderID, discordChannelID, uuid.Nil)
+		if err != nil {
+			return err
+		}
+		if err := s.issueService.OpenIssue(ctx, issue.ID, commanderID); err != nil {
+			return err
+		}
+		if err := s.issueService.UpdateIssuePriority(ctx, issue.ID, domain.PriorityHigh); err != nil {
+			return err
+		}
+		if err := s.issueService.UpdateIssueSeverity(ctx, issue.ID, severity); err != nil {
+			return err
+		}
+
+		now := s.now()
+		incident = &domain.Incident{
+			ID:              uuid.New(),
+			IssueID:         issue.ID,
+			CommanderID:     commanderID,
+			BridgeChannelID: declaration.BridgeChannelID,
+			BridgeStage:     declaration.BridgeChannelID != "" && declaration.BridgeStage,
+			LastUpdateAt:    now,
+			DeclaredAt:      now,
+		}
+		if err := s.incidentRepo.Create(ctx, incident); err != nil {
+			return err
+		}
+
+		// The declaration starts the incident timeline, so it is stored with the incident
+		return s.logEdit(ctx, issue.ID, domain.StatusOpen, commanderID, fmt.Sprintf("Declared a %s incident", severity.GetDisplayName()))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Incident declared",
+		zap.String("issue_id", incident.IssueID.String()),
+		zap.String("severity", string(severity)),
+		zap.String("commander_id", commanderID),
+		zap.String("bridge_channel_id", incident.BridgeChannelID),
+	)
+
+	return s.incidentRepo.GetByIssueID(ctx, incident.IssueID)
+}
+
+// GetByIssueID retrieves the incident of an issue
+func (s *incidentService) GetByIssueID(ctx context.Context, issueID uuid.UUID) (*domain.Incident, error) {
+	return s.incidentRepo.GetByIssueID(ctx, issueID)
+}
+
+// PostUpdate records a status update of an incident in its issue history, where its
+// timeline is built from, and waits the update interval again before reminding its commander
+func (s *incidentService) PostUpdate(ctx context.Context, issueID uuid.UUID, message, postedBy string) (*domain.Incident, error) {
+	message, err := domain.NormalizeIncidentUpdate(message)
+	if err != nil {
+		return nil, err
+	}
+
+	incident, err := s.incidentRepo.GetByIssueID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if incident.Issue.IsClosed() {
+		return nil, domain.ErrIncidentClosed
+	}
+
+	now := s.now()
+	if err := s.incidentRepo.RecordUpdate(ctx, incident.ID, now); err != nil {
+		return nil, err
+	}
+	incident.LastUpdateAt = now
+
+	if err := s.logEdit(ctx, issueID, incident.Issue.Status, postedBy, "Status update: "+message); err != nil {
+		s.logger.Warn("Failed to record incident status update",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+	}
+
+	s.logger.Info("Incident status update posted",
+		zap.String("issue_id", issueID.String()),
+		zap.String("posted_by", postedBy),
+	)
+
+	return incident, nil
+}
+
+// CheckUpdates reminds the commanders of unresolved incidents without a status update for
+// the update interval, and again every interval until one is posted
+func (s *incidentService) CheckUpdates(ctx context.Context) error {
+	incidents, err := s.incidentRepo.ListUnresolved(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get incidents for status update check", zap.Error(err))
+		return fmt.Errorf("failed to get incidents for status update check: %w", err)
+	}
+
+	now := s.now()
+	sent := 0
+	for _, incident := range incidents {
+		// Incidents of deactivated channels have nowhere to be reminded
+		if incident.Issue.Channel == nil || !incident.Issue.Channel.IsActive {
+			continue
+		}
+		if !incident.UpdateDue(s.updateInterval, now) {
+			continue
+		}
+
+		// Only record the reminder once it was delivered so failed reminders are retried on the next check
+		if err := s.notifier.NotifyUpdateDue(ctx, incident, s.updateInterval); err != nil {
+			s.logger.Error("Failed to send status update reminder",
+				zap.Error(err),
+				zap.String("issue_id", incident.IssueID.String()),
+			)
+			continue
+		}
+		if err := s.incidentRepo.RecordReminder(ctx, incident.ID, now); err != nil {
+			s.logger.Error("Failed to record status update reminder",
+				zap.Error(err),
+				zap.String("issue_id", incident.IssueID.String()),
+			)
+		}
+		sent++
+	}
+
+	s.logger.Debug("Incident status update check completed",
+		zap.Int("incidents_checked", len(incidents)),
+		zap.Int("reminders_sent", sent),
+	)
+
+	return nil
+}
+
+// UpdateInterval returns how often commanders are expected to post a status update
+func (s *incidentService) UpdateInterval() time.Duration {
+	return s.updateInterval
+}
+
+// HandleIssueEvent posts the timeline of an incident in the background when its issue is closed
+func (s *incidentService) HandleIssueEvent(_ context.Context, event domain.Event) {
+	if event.Type != domain.EventIssueStatusChanged || !event.Issue.IsClosed() {
+		return
+	}
+
+	issueID := event.Issue.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		s.postTimeline(ctx, issueID)
+	}()
+}
+
+// postTimeline posts the timeline of the incident of a closed issue, if it is one
+func (s *incidentService) postTimeline(ctx context.Context, issueID uuid.UUID) {
+	incident, err := s.incidentRepo.GetByIssueID(ctx, issueID)
+	if err == domain.ErrIncidentNotFound {
+		return
+	}
+	if err != nil {
+		s.logger.Error("Failed to load incident of closed issue",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return
+	}
+
+	history, err := s.statusLogService.GetIssueStatusHistory(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to load incident history",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return
+	}
+
+	if err := s.notifier.NotifyClosed(ctx, incident, history); err != nil {
+		s.logger.Error("Failed to post incident timeline",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return
+	}
+
+	s.logger.Info("Incident timeline posted",
+		zap.String("issue_id", issueID.String()),
+		zap.Int("entries", len(history)),
+	)
+}
+
+// logEdit records a change of an incident in the history of its issue, which has status
+func (s *incidentService) logEdit(ctx context.Context, issueID uuid.UUID, status domain.Status, changedBy, note string) error {
+	var changedByID *uuid.UUID
+	user, err := s.userRepo.GetByDiscordID(ctx, changedBy)
+	switch {
+	case err == nil:
+		changedByID = &user.ID
+	case err != domain.ErrUserNotFound:
+		return fmt.Errorf("failed to resolve user of incident change: %w", err)
+	}
+
+	if _, err := s.statusLogService.LogIssueEdit(ctx, issueID, status, changedByID, note); err != nil {
+		return fmt.Errorf("failed to record incident change: %w", err)
+	}
+	return nil
+}
@@ -11,14 +11,25 @@ import (
 type issueAssigneeService struct {
 	issueAssigneeRepo domain.IssueAssigneeRepository
 	userRepo          domain.UserRepository
+	issueRepo         domain.IssueRepository
+	events            domain.EventPublisher
 	logger            *zap.Logger
 }
 
-// NewIssueAssigneeService creates a new issue assignee service
-func NewIssueAssigneeService(issueAssigneeRepo domain.IssueAssigneeRepository, userRepo domain.UserRepository, logger *zap.Logger) domain.IssueAssigneeService {
+// NewIssueAssigneeService creates a new issue assignee service.
+// Assignment changes are published to events.
+func NewIssueAssigneeService(
+	issueAssigneeRepo domain.IssueAssigneeRepository,
+	userRepo domain.UserRepository,
+	issueRepo domain.IssueRepository,
+	events domain.EventPublisher,
+	logger *zap.Logger,
+) domain.IssueAssigneeService {
 	return &issueAssigneeService{
 		issueAssigneeRepo: issueAssigneeRepo,
 		userRepo:          userRepo,
+		issueRepo:         issueRepo,
+		events:            events,
 		logger:            logger,
 	}
 }
@@ -39,27 +50,14 @@ func (s *issueAssigneeService) AssignUserToIssue(ctx context.Context, issueID uu
 		return nil, domain.ErrInvalidAssigneeRole
 	}
 
-	// Get user by Discord ID
-	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
-	if err != nil && err != domain.ErrUserNotFound {
-		s.logger.Error("Failed to get user by Discord ID",
+	// Assignees work on the issue, so unknown users are created as support staff
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleSupport)
+	if err != nil {
+		s.logger.Error("Failed to get or create user",
 			zap.Error(err),
 			zap.String("discord_id", discordID),
 		)
 		return nil, err
-	} else if err == domain.ErrUserNotFound { // Create new user
-		user = &domain.User{
-			ID:        uuid.New(),
-			DiscordID: discordID,
-			Role:      domain.UserRoleSupport,
-		}
-		if err := s.userRepo.Create(ctx, user); err != nil {
-			s.logger.Error("Failed to create user",
-				zap.Error(err),
-				zap.String("discord_id", discordID),
-			)
-			return nil, err
-		}
 	}
 
 	// Check if this assignment already exists
@@ -105,9 +103,27 @@ func (s *issueAssigneeService) AssignUserToIssue(ctx context.Context, issueID uu
 		zap.String("role", role.String()),
 	)
 
+	assignee.User = *user
+	s.publishAssigneeEvent(ctx, domain.EventAssigneeAdded, assignee)
+
 	return assignee, nil
 }
 
+// publishAssigneeEvent publishes an assignment change; assignee.User must be populated
+func (s *issueAssigneeService) publishAssigneeEvent(ctx context.Context, eventType domain.EventType, assignee *domain.IssueAssignee) {
+	issue, err := s.issueRepo.GetByID(ctx, assignee.IssueID)
+	if err != nil {
+		s.logger.Error("Failed to load issue for assignment event",
+			zap.Error(err),
+			zap.String("issue_id", assignee.IssueID.String()),
+			zap.String("event", string(eventType)),
+		)
+		return
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: eventType, Issue: issue, Assignee: assignee})
+}
+
 // UnassignUserFromIssue removes a specific user role assignment from an issue
 func (s *issueAssigneeService) UnassignUserFromIssue(ctx context.Context, issueID, userID uuid.UUID, role domain.AssigneeRole) error {
 	s.logger.Debug("Unassigning user from issue",
@@ -140,6 +156,12 @@ func (s *issueAssigneeService) UnassignUserFromIssue(ctx context.Context, issueI
 		zap.String("role", role.String()),
 	)
 
+	assignee := &domain.IssueAssignee{IssueID: issueID, UserID: userID, Role: role}
+	if user, err := s.userRepo.GetByID(ctx, userID); err == nil {
+		assignee.User = *user
+	}
+	s.publishAssigneeEvent(ctx, domain.EventAssigneeRemoved, assignee)
+
 	return nil
 }
 
@@ -167,7 +189,9 @@ func (s *issueAssigneeService) UnassignAllUsersFromIssue(ctx context.Context, is
 				zap.String("assignment_id", assignment.ID.String()),
 			)
 			// Continue with other assignments even if one fails
+			continue
 		}
+		s.publishAssigneeEvent(ctx, domain.EventAssigneeRemoved, assignment)
 	}
 
 	s.logger.Info("All users unassigned from issue",
@@ -224,6 +248,24 @@ func (s *issueAssigneeService) GetUserAssignments(ctx context.Context, userID uu
 	return assignments, nil
 }
 
+// GetUserAssignmentsByDiscordID returns all issue assignments for a user identified by Discord ID
+func (s *issueAssigneeService) GetUserAssignmentsByDiscordID(ctx context.Context, discordID string) ([]*domain.IssueAssignee, error) {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err != nil {
+		if err == domain.ErrUserNotFound {
+			// Unknown users simply have no assignments yet
+			return []*domain.IssueAssignee{}, nil
+		}
+		s.logger.Error("Failed to get user by Discord ID",
+			zap.Error(err),
+			zap.String("discord_id", discordID),
+		)
+		return nil, err
+	}
+
+	return s.GetUserAssignments(ctx, user.ID)
+}
+
 // GetAssigneesByRole returns all assignees for a specific issue with a specific role
 func (s *issueAssigneeService) GetAssigneesByRole(ctx context.Context, issueID uuid.UUID, role domain.AssigneeRole) ([]*domain.IssueAssignee, error) {
 	s.logger.Debug("Getting assignees by role",
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// issueAttachmentService implements the IssueAttachmentService interface
+type issueAttachmentService struct {
+	attachmentRepo domain.IssueAttachmentRepository
+	userRepo       domain.UserRepository
+	logger         *zap.Logger
+}
+
+// NewIssueAttachmentService creates a new instance of issue attachment service
+func NewIssueAttachmentService(attachmentRepo domain.IssueAttachmentRepository, userRepo domain.UserRepository, logger *zap.Logger) domain.IssueAttachmentService {
+	return &issueAttachmentService{
+		attachmentRepo: attachmentRepo,
+		userRepo:       userRepo,
+		logger:         logger,
+	}
+}
+
+// AddAttachments stores attachments for an issue. uploaderDiscordID may be empty.
+func (s *issueAttachmentService) AddAttachments(ctx context.Context, issueID uuid.UUID, uploaderDiscordID string, attachments []*domain.IssueAttachment) error {
+	s.logger.Debug("Adding issue attachments",
+		zap.String("issue_id", issueID.String()),
+		zap.Int("count", len(attachments)),
+	)
+
+	var uploadedByID *uuid.UUID
+	if uploaderDiscordID != "" {
+		user, err := s.userRepo.GetByDiscordID(ctx, uploaderDiscordID)
+		if err != nil && err != domain.ErrUserNotFound {
+			return fmt.Errorf("failed to get uploader: %w", err)
+		}
+		if user != nil {
+			uploadedByID = &user.ID
+		}
+	}
+
+	for _, attachment := range attachments {
+		if strings.TrimSpace(attachment.URL) == "" {
+			continue
+		}
+
+		if attachment.ID == uuid.Nil {
+			attachment.ID = uuid.New()
+		}
+		attachment.IssueID = issueID
+		attachment.UploadedByID = uploadedByID
+
+		if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+			s.logger.Error("Failed to add issue attachment",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("file_name", attachment.FileName),
+			)
+			return fmt.Errorf("failed to add issue attachment: %w", err)
+		}
+	}
+
+	s.logger.Info("Issue attachments added successfully",
+		zap.String("issue_id", issueID.String()),
+		zap.Int("count", len(attachments)),
+	)
+
+	return nil
+}
+
+// GetIssueAttachments retrieves all attachments of an issue
+func (s *issueAttachmentService) GetIssueAttachments(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueAttachment, error) {
+	s.logger.Debug("Getting issue attachments", zap.String("issue_id", issueID.String()))
+
+	attachments, err := s.attachmentRepo.GetByIssueID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to get issue attachments",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to get issue attachments: %w", err)
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// issueLinkService implements the IssueLinkService interface
+type issueLinkService struct {
+	linkRepo  domain.IssueLinkRepository
+	issueRepo domain.IssueRepository
+	logger    *zap.Logger
+}
+
+// NewIssueLinkService creates a new instance of issue link service
+func NewIssueLinkService(linkRepo domain.IssueLinkRepository, issueRepo domain.IssueRepository, logger *zap.Logger) domain.IssueLinkService {
+	return &issueLinkService{
+		linkRepo:  linkRepo,
+		issueRepo: issueRepo,
+		logger:    logger,
+	}
+}
+
+// LinkIssues records that the source issue relates to the target issue as linkType.
+// Issues can carry several relations to each other, but only one of each type.
+func (s *issueLinkService) LinkIssues(ctx context.Context, sourceID, targetID uuid.UUID, linkType domain.LinkType, createdBy string) (*domain.IssueLink, error) {
+	s.logger.Debug("Linking issues",
+		zap.String("source_issue_id", sourceID.String()),
+		zap.String("target_issue_id", targetID.String()),
+		zap.String("type", string(linkType)),
+	)
+
+	if !domain.IsValidLinkType(linkType) {
+		return nil, domain.ErrInvalidLinkType
+	}
+	if sourceID == targetID {
+		return nil, domain.ErrSelfLink
+	}
+
+	for _, id := range []uuid.UUID{sourceID, targetID} {
+		if _, err := s.issueRepo.GetByID(ctx, id); err != nil {
+			return nil, fmt.Errorf("failed to get issue to link: %w", err)
+		}
+	}
+
+	// A reversed link of the same type would say the same or contradict it
+	existing, err := s.linkRepo.GetBetween(ctx, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range existing {
+		if link.Type == linkType {
+			return nil, domain.ErrIssueLinkExists
+		}
+	}
+
+	link := &domain.IssueLink{
+		ID:            uuid.New(),
+		SourceIssueID: sourceID,
+		TargetIssueID: targetID,
+		Type:          linkType,
+		CreatedBy:     createdBy,
+	}
+	if err := s.linkRepo.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Issues linked",
+		zap.String("source_issue_id", sourceID.String()),
+		zap.String("target_issue_id", targetID.String()),
+		zap.String("type", string(linkType)),
+		zap.String("created_by", createdBy),
+	)
+
+	return link, nil
+}
+
+// UnlinkIssues removes the links between two issues, only those of linkType when it is not empty
+func (s *issueLinkService) UnlinkIssues(ctx context.Context, issueID, otherIssueID uuid.UUID, linkType domain.LinkType) error {
+	s.logger.Debug("Unlinking issues",
+		zap.String("issue_id", issueID.String()),
+		zap.String("other_issue_id", otherIssueID.String()),
+		zap.String("type", string(linkType)),
+	)
+
+	if linkType != "" && !domain.IsValidLinkType(linkType) {
+		return domain.ErrInvalidLinkType
+	}
+
+	removed, err := s.linkRepo.DeleteBetween(ctx, issueID, otherIssueID, linkType)
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return domain.ErrIssueLinkNotFound
+	}
+
+	s.logger.Info("Issues unlinked",
+		zap.String("issue_id", issueID.String()),
+		zap.String("other_issue_id", otherIssueID.String()),
+		zap.Int64("removed", removed),
+	)
+
+	return nil
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"fix-track-bot/internal/domain"
 
@@ -13,59 +15,125 @@ import (
 
 // issueService implements the IssueService interface with new schema
 type issueService struct {
-	issueRepo   domain.IssueRepository
-	channelRepo domain.ChannelRepository
-	userRepo    domain.UserRepository
-	logger      *zap.Logger
+	issueRepo        domain.IssueRepository
+	channelRepo      domain.ChannelRepository
+	projectRepo      domain.ProjectRepository
+	userRepo         domain.UserRepository
+	statusLogService domain.IssueStatusLogService
+	commentRepo      domain.IssueCommentRepository
+	uow              domain.UnitOfWork
+	events           domain.EventPublisher
+	auditor          domain.Auditor
+	limiter          domain.RateLimiter // Issues per reporter and channel; nil if unlimited
+	logger           *zap.Logger
 }
 
-// NewIssueService creates a new instance of issue service with new schema support
+// NewIssueService creates a new instance of issue service with new schema support.
+// Issue lifecycle changes are published to events. Reporters are throttled per
+// channel by limiter; nil disables the limit.
 func NewIssueService(
 	issueRepo domain.IssueRepository,
 	channelRepo domain.ChannelRepository,
+	projectRepo domain.ProjectRepository,
 	userRepo domain.UserRepository,
+	statusLogService domain.IssueStatusLogService,
+	commentRepo domain.IssueCommentRepository,
+	uow domain.UnitOfWork,
+	events domain.EventPublisher,
+	auditor domain.Auditor,
+	limiter domain.RateLimiter,
 	logger *zap.Logger,
 ) domain.IssueService {
 	return &issueService{
-		issueRepo:   issueRepo,
-		channelRepo: channelRepo,
-		userRepo:    userRepo,
-		logger:      logger,
+		issueRepo:        issueRepo,
+		channelRepo:      channelRepo,
+		projectRepo:      projectRepo,
+		userRepo:         userRepo,
+		statusLogService: statusLogService,
+		commentRepo:      commentRepo,
+		uow:              uow,
+		events:           events,
+		auditor:          auditor,
+		limiter:          limiter,
+		logger:           logger,
 	}
 }
 
-// getOrCreateUser gets existing user or creates a new one
-func (s *issueService) getOrCreateUser(ctx context.Context, discordID string) (*domain.User, error) {
-	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
-	if err != nil && err != domain.ErrUserNotFound {
-		return nil, fmt.Errorf("failed to check existing user: %w", err)
-	}
+// recordStatusChange writes a status log entry for an issue. changedBy is the
+// Discord ID of the acting user; an empty value records a system change.
+// Failures are logged but never fail the status transition itself, so inside a unit
+// of work the entry is written once it commits rather than aborting the transaction.
+func (s *issueService) recordStatusChange(ctx context.Context, issue *domain.Issue, oldStatus *domain.Status, changedBy string) {
+	issueID, status := issue.ID, issue.Status
+	s.uow.AfterCommit(ctx, func(ctx context.Context) {
+		var changedByID *uuid.UUID
+		if changedBy != "" {
+			user, err := s.userRepo.GetOrCreateByDiscordID(ctx, changedBy, "", domain.UserRoleCustomer)
+			if err != nil {
+				s.logger.Warn("Failed to resolve user for status log",
+					zap.Error(err),
+					zap.String("issue_id", issueID.String()),
+					zap.String("changed_by", changedBy),
+				)
+			} else {
+				changedByID = &user.ID
+			}
+		}
 
-	if user != nil {
-		return user, nil
-	}
+		if _, err := s.statusLogService.LogStatusChange(ctx, issueID, oldStatus, status, changedByID); err != nil {
+			s.logger.Warn("Failed to record status change",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("status", string(status)),
+			)
+		}
+	})
+}
 
-	// Create new user
-	user = &domain.User{
-		ID:        uuid.New(),
-		DiscordID: discordID,
-		Role:      domain.UserRoleCustomer,
+// auditIssue records an administrative action on an issue in the audit log
+func (s *issueService) auditIssue(ctx context.Context, action domain.AuditAction, issue *domain.Issue, before, after any) {
+	entry := domain.AuditEntry{
+		Action:     action,
+		TargetType: domain.AuditTargetIssue,
+		TargetID:   issue.ID.String(),
+		TargetName: issue.ShortID(),
+		Before:     before,
+		After:      after,
+	}
+	if issue.Channel != nil {
+		entry.GuildID = issue.Channel.GuildID
 	}
+	s.auditor.Record(ctx, entry)
+}
 
-	if err := s.userRepo.Create(ctx, user); err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
+// assignIssueKey gives a new issue the next key of its project
+func (s *issueService) assignIssueKey(ctx context.Context, issue *domain.Issue) error {
+	prefix, number, err := s.projectRepo.NextIssueNumber(ctx, issue.ProjectID)
+	if err != nil {
+		s.logger.Error("Failed to allocate issue key",
+			zap.Error(err),
+			zap.String("project_id", issue.ProjectID.String()),
+		)
+		return fmt.Errorf("failed to allocate issue key: %w", err)
 	}
 
-	s.logger.Info("Created new user for issue",
-		zap.String("user_id", user.ID.String()),
-		zap.String("discord_id", discordID),
-	)
+	issue.Number = number
+	issue.Key = domain.FormatIssueKey(prefix, number)
+	return nil
+}
 
-	return user, nil
+// publishStatusChanged publishes a status transition made by changedBy
+func (s *issueService) publishStatusChanged(ctx context.Context, issue *domain.Issue, oldStatus domain.Status, changedBy string) {
+	s.events.Publish(ctx, domain.Event{
+		Type:      domain.EventIssueStatusChanged,
+		Issue:     issue,
+		ActorID:   changedBy,
+		OldStatus: oldStatus,
+	})
 }
 
 // CreateIssue creates a new issue
-func (s *issueService) CreateIssue(ctx context.Context, title, description, imageURL, reporterID, channelID string) (*domain.Issue, error) {
+func (s *issueService) CreateIssue(ctx context.Context, title, description, imageURL, reporterID, channelID string, projectID uuid.UUID) (*domain.Issue, error) {
 	s.logger.Debug("Creating issue",
 		zap.String("title", title),
 		zap.String("reporter_id", reporterID),
@@ -87,23 +155,40 @@ func (s *issueService) CreateIssue(ctx context.Context, title, description, imag
 		)
 		return nil, fmt.Errorf("failed to get channel registration: %w", err)
 	}
-
-	// Get or create user
-	user, err := s.getOrCreateUser(ctx, reporterID)
+	if !channel.IsActive {
+		return nil, domain.ErrChannelInactive
+	}
+	projectID, err = channelProjectID(channel, projectID)
 	if err != nil {
-		s.logger.Error("Failed to get or create user",
-			zap.Error(err),
-			zap.String("reporter_id", reporterID),
-		)
-		return nil, fmt.Errorf("failed to get or create user: %w", err)
+		return nil, err
+	}
+
+	// Throttle reporters flooding a channel; the slot is given back if creation fails
+	limitKey := reporterID + ":" + channelID
+	var limitedAt time.Time
+	if s.limiter != nil {
+		at, ok, err := s.limiter.Take(ctx, limitKey)
+		switch {
+		case err != nil:
+			// Reporters are not turned away because the limiter cannot be reached
+			s.logger.Warn("Failed to check issue rate limit", zap.Error(err))
+		case !ok:
+			s.logger.Info("Issue creation rate limited",
+				zap.String("reporter_id", reporterID),
+				zap.String("channel_id", channelID),
+				zap.Time("retry_at", at),
+			)
+			return nil, &domain.RateLimitError{RetryAt: at}
+		default:
+			limitedAt = at
+		}
 	}
 
 	// Create new issue
 	issue := &domain.Issue{
 		ID:          uuid.New(),
-		ProjectID:   channel.ProjectID, // Project from channel
-		ChannelID:   &channel.ID,       // Optional channel reference
-		ReporterID:  user.ID,
+		ProjectID:   projectID,   // Project chosen in the channel
+		ChannelID:   &channel.ID, // Optional channel reference
 		Title:       strings.TrimSpace(title),
 		Description: strings.TrimSpace(description),
 		ImageURL:    strings.TrimSpace(imageURL),
@@ -113,15 +198,46 @@ func (s *issueService) CreateIssue(ctx context.Context, title, description, imag
 		PublicHash:  uuid.New().String(),
 	}
 
-	// Save to repository
-	if err := s.issueRepo.Create(ctx, issue); err != nil {
-		s.logger.Error("Failed to create issue",
-			zap.Error(err),
-			zap.String("title", title),
-		)
-		return nil, fmt.Errorf("failed to create issue: %w", err)
+	// Create the reporter, key and issue together so a failure leaves no orphaned user
+	// and does not use up an issue number. The event is published with them so what it
+	// records in the transaction, such as the pending issue card, is kept with the issue.
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.GetOrCreateByDiscordID(ctx, reporterID, "", domain.UserRoleCustomer)
+		if err != nil {
+			s.logger.Error("Failed to get or create user",
+				zap.Error(err),
+				zap.String("reporter_id", reporterID),
+			)
+			return fmt.Errorf("failed to get or create user: %w", err)
+		}
+		issue.ReporterID = user.ID
+
+		if err := s.assignIssueKey(ctx, issue); err != nil {
+			return err
+		}
+
+		if err := s.issueRepo.Create(ctx, issue); err != nil {
+			s.logger.Error("Failed to create issue",
+				zap.Error(err),
+				zap.String("title", title),
+			)
+			return fmt.Errorf("failed to create issue: %w", err)
+		}
+
+		s.events.Publish(ctx, domain.Event{Type: domain.EventIssueCreated, Issue: issue, ActorID: reporterID})
+		return nil
+	})
+	if err != nil {
+		if !limitedAt.IsZero() {
+			if err := s.limiter.Undo(ctx, limitKey, limitedAt); err != nil {
+				s.logger.Warn("Failed to give back issue rate limit slot", zap.Error(err))
+			}
+		}
+		return nil, err
 	}
 
+	s.recordStatusChange(ctx, issue, nil, reporterID)
+
 	s.logger.Info("Issue created successfully",
 		zap.String("issue_id", issue.ID.String()),
 		zap.String("title", title),
@@ -132,6 +248,125 @@ func (s *issueService) CreateIssue(ctx context.Context, title, description, imag
 	return issue, nil
 }
 
+// channelProjectID checks that issues of a project can be reported in a channel;
+// uuid.Nil stands for the channel's main project
+func channelProjectID(channel *domain.Channel, projectID uuid.UUID) (uuid.UUID, error) {
+	if projectID == uuid.Nil {
+		return channel.ProjectID, nil
+	}
+	if !channel.HasProject(projectID) {
+		return uuid.Nil, domain.ErrProjectNotInChannel
+	}
+	return projectID, nil
+}
+
+// CreateSubIssue creates a sub-task of a parent issue in the parent's project and channel
+func (s *issueService) CreateSubIssue(ctx context.Context, parentID uuid.UUID, title, description, reporterID string) (*domain.Issue, error) {
+	s.logger.Debug("Creating sub-issue",
+		zap.String("parent_issue_id", parentID.String()),
+		zap.String("title", title),
+		zap.String("reporter_id", reporterID),
+	)
+
+	if strings.TrimSpace(title) == "" || reporterID == "" {
+		return nil, fmt.Errorf("invalid sub-issue input: title and reporter_id are required")
+	}
+
+	parent, err := s.issueRepo.GetByID(ctx, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent issue: %w", err)
+	}
+	if parent.IsSubIssue() {
+		return nil, domain.ErrNestedSubIssue
+	}
+	if parent.IsClosed() {
+		return nil, domain.ErrIssueAlreadyClosed
+	}
+
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, reporterID, "", domain.UserRoleCustomer)
+	if err != nil {
+		s.logger.Error("Failed to get or create user",
+			zap.Error(err),
+			zap.String("reporter_id", reporterID),
+		)
+		return nil, fmt.Errorf("failed to get or create user: %w", err)
+	}
+
+	// Sub-tasks without their own description point back to the parent
+	description = strings.TrimSpace(description)
+	if description == "" {
+		description = fmt.Sprintf("Sub-task of %s: %s", parent.ShortID(), parent.Title)
+	}
+
+	issue := &domain.Issue{
+		ID:            uuid.New(),
+		ProjectID:     parent.ProjectID,
+		ChannelID:     parent.ChannelID,
+		ReporterID:    user.ID,
+		ParentIssueID: &parent.ID,
+		Title:         strings.TrimSpace(title),
+		Description:   description,
+		Priority:      parent.Priority,
+		Status:        domain.StatusDraft,
+		Source:        string(domain.SourceDiscord),
+		PublicHash:    uuid.New().String(),
+	}
+
+	if err := s.assignIssueKey(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	if err := s.issueRepo.Create(ctx, issue); err != nil {
+		s.logger.Error("Failed to create sub-issue",
+			zap.Error(err),
+			zap.String("parent_issue_id", parentID.String()),
+		)
+		return nil, fmt.Errorf("failed to create sub-issue: %w", err)
+	}
+
+	s.recordStatusChange(ctx, issue, nil, reporterID)
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueCreated, Issue: issue, ActorID: reporterID})
+
+	s.logger.Info("Sub-issue created successfully",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("parent_issue_id", parentID.String()),
+	)
+
+	return issue, nil
+}
+
+// GetIssueByThreadID retrieves the issue discussed in a Discord thread
+func (s *issueService) GetIssueByThreadID(ctx context.Context, threadID string) (*domain.Issue, error) {
+	issue, err := s.issueRepo.GetByThreadID(ctx, threadID)
+	if err != nil {
+		if err != domain.ErrIssueNotFound {
+			s.logger.Error("Failed to get issue by thread ID",
+				zap.Error(err),
+				zap.String("thread_id", threadID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get issue by thread ID: %w", err)
+	}
+
+	return issue, nil
+}
+
+// GetIssueByMessageID retrieves the issue whose card is the given Discord message
+func (s *issueService) GetIssueByMessageID(ctx context.Context, messageID string) (*domain.Issue, error) {
+	issue, err := s.issueRepo.GetByMessageID(ctx, messageID)
+	if err != nil {
+		if err != domain.ErrIssueNotFound {
+			s.logger.Error("Failed to get issue by message ID",
+				zap.Error(err),
+				zap.String("message_id", messageID),
+			)
+		}
+		return nil, fmt.Errorf("failed to get issue by message ID: %w", err)
+	}
+
+	return issue, nil
+}
+
 // GetIssue retrieves an issue by its ID
 func (s *issueService) GetIssue(ctx context.Context, id uuid.UUID) (*domain.Issue, error) {
 	s.logger.Debug("Getting issue", zap.String("issue_id", id.String()))
@@ -148,6 +383,51 @@ func (s *issueService) GetIssue(ctx context.Context, id uuid.UUID) (*domain.Issu
 	return issue, nil
 }
 
+// GetIssueByPublicHash retrieves an issue by its public share hash
+func (s *issueService) GetIssueByPublicHash(ctx context.Context, hash string) (*domain.Issue, error) {
+	s.logger.Debug("Getting issue by public hash", zap.String("public_hash", hash))
+
+	if strings.TrimSpace(hash) == "" {
+		return nil, domain.ErrIssueNotFound
+	}
+
+	issue, err := s.issueRepo.GetByPublicHash(ctx, hash)
+	if err != nil {
+		if err != domain.ErrIssueNotFound {
+			s.logger.Error("Failed to get issue by public hash",
+				zap.Error(err),
+				zap.String("public_hash", hash),
+			)
+		}
+		return nil, fmt.Errorf("failed to get issue by public hash: %w", err)
+	}
+
+	return issue, nil
+}
+
+// GetIssueByKey retrieves an issue by its key, e.g. "ACME-42"
+func (s *issueService) GetIssueByKey(ctx context.Context, key string) (*domain.Issue, error) {
+	s.logger.Debug("Getting issue by key", zap.String("issue_key", key))
+
+	key, ok := domain.NormalizeIssueKey(key)
+	if !ok {
+		return nil, domain.ErrIssueNotFound
+	}
+
+	issue, err := s.issueRepo.GetByIssueKey(ctx, key)
+	if err != nil {
+		if err != domain.ErrIssueNotFound {
+			s.logger.Error("Failed to get issue by key",
+				zap.Error(err),
+				zap.String("issue_key", key),
+			)
+		}
+		return nil, fmt.Errorf("failed to get issue by key: %w", err)
+	}
+
+	return issue, nil
+}
+
 // GetIssuesByChannel retrieves all issues for a specific Discord channel
 func (s *issueService) GetIssuesByChannel(ctx context.Context, discordChannelID string) ([]*domain.Issue, error) {
 	s.logger.Debug("Getting issues by Discord channel", zap.String("discord_channel_id", discordChannelID))
@@ -193,6 +473,7 @@ func (s *issueService) UpdateIssuePriority(ctx context.Context, id uuid.UUID, pr
 	}
 
 	// Update priority
+	oldPriority := issue.Priority
 	issue.Priority = priority
 
 	if err := s.issueRepo.Update(ctx, issue); err != nil {
@@ -203,6 +484,13 @@ func (s *issueService) UpdateIssuePriority(ctx context.Context, id uuid.UUID, pr
 		return fmt.Errorf("failed to update issue priority: %w", err)
 	}
 
+	if oldPriority != priority {
+		s.events.Publish(ctx, domain.Event{Type: domain.EventIssuePriorityChanged, Issue: issue, OldPriority: oldPriority})
+		s.auditIssue(ctx, domain.AuditIssuePriorityChanged, issue,
+			map[string]domain.Priority{"priority": oldPriority},
+			map[string]domain.Priority{"priority": priority})
+	}
+
 	s.logger.Info("Issue priority updated successfully",
 		zap.String("issue_id", id.String()),
 		zap.String("priority", string(priority)),
@@ -211,19 +499,150 @@ func (s *issueService) UpdateIssuePriority(ctx context.Context, id uuid.UUID, pr
 	return nil
 }
 
+// UpdateIssueSeverity sets the severity of an issue, or removes it for an empty severity
+func (s *issueService) UpdateIssueSeverity(ctx context.Context, id uuid.UUID, severity domain.Severity) error {
+	s.logger.Debug("Updating issue severity",
+		zap.String("issue_id", id.String()),
+		zap.String("severity", string(severity)),
+	)
+
+	if severity != "" && !domain.IsValidSeverity(severity) {
+		return domain.ErrInvalidSeverity
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get issue for severity update",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to get issue for severity update: %w", err)
+	}
+
+	oldSeverity := issue.Severity
+	if oldSeverity == severity {
+		return nil
+	}
+	issue.Severity = severity
+
+	if err := s.issueRepo.Update(ctx, issue); err != nil {
+		s.logger.Error("Failed to update issue severity",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to update issue severity: %w", err)
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueSeverityChanged, Issue: issue, OldSeverity: oldSeverity})
+	s.auditIssue(ctx, domain.AuditIssueSeverityChanged, issue,
+		map[string]domain.Severity{"severity": oldSeverity},
+		map[string]domain.Severity{"severity": severity})
+
+	s.logger.Info("Issue severity updated successfully",
+		zap.String("issue_id", id.String()),
+		zap.String("severity", string(severity)),
+	)
+
+	return nil
+}
+
+// UpdateIssueContent updates the title, description and image URL of an issue.
+// The edited fields are recorded in the status log; nothing is logged if no field changed.
+func (s *issueService) UpdateIssueContent(ctx context.Context, id uuid.UUID, title, description, imageURL, editedBy string) (*domain.Issue, error) {
+	s.logger.Debug("Updating issue content",
+		zap.String("issue_id", id.String()),
+		zap.String("edited_by", editedBy),
+	)
+
+	title = strings.TrimSpace(title)
+	description = strings.TrimSpace(description)
+	imageURL = strings.TrimSpace(imageURL)
+
+	if title == "" {
+		return nil, domain.ErrEmptyTitle
+	}
+	if description == "" {
+		return nil, domain.ErrEmptyDescription
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get issue for content update",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return nil, fmt.Errorf("failed to get issue for content update: %w", err)
+	}
+
+	var edited []string
+	if issue.Title != title {
+		edited = append(edited, "title")
+		issue.Title = title
+	}
+	if issue.Description != description {
+		edited = append(edited, "description")
+		issue.Description = description
+	}
+	if issue.ImageURL != imageURL {
+		edited = append(edited, "image URL")
+		issue.ImageURL = imageURL
+	}
+
+	if len(edited) == 0 {
+		return issue, nil
+	}
+
+	if err := s.issueRepo.Update(ctx, issue); err != nil {
+		s.logger.Error("Failed to update issue content",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return nil, fmt.Errorf("failed to update issue content: %w", err)
+	}
+
+	var editedByID *uuid.UUID
+	if editedBy != "" {
+		if user, err := s.userRepo.GetOrCreateByDiscordID(ctx, editedBy, "", domain.UserRoleCustomer); err == nil {
+			editedByID = &user.ID
+		}
+	}
+
+	note := fmt.Sprintf("Edited %s", strings.Join(edited, ", "))
+	if _, err := s.statusLogService.LogIssueEdit(ctx, issue.ID, issue.Status, editedByID, note); err != nil {
+		s.logger.Warn("Failed to record issue edit",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueEdited, Issue: issue, ActorID: editedBy})
+
+	s.logger.Info("Issue content updated successfully",
+		zap.String("issue_id", id.String()),
+		zap.Strings("fields", edited),
+	)
+
+	return issue, nil
+}
+
 // UpdateIssueStatus updates the status of an issue
-func (s *issueService) UpdateIssueStatus(ctx context.Context, id uuid.UUID, status domain.Status) error {
+func (s *issueService) UpdateIssueStatus(ctx context.Context, id uuid.UUID, status domain.Status, changedBy string) error {
+	return s.updateStatus(ctx, id, status, changedBy, domain.AuditIssueClosed)
+}
+
+// CloseStaleIssue closes an issue left without activity, recorded in the audit log as
+// closed by the bot
+func (s *issueService) CloseStaleIssue(ctx context.Context, id uuid.UUID) error {
+	return s.updateStatus(ctx, id, domain.StatusClosed, "", domain.AuditIssueAutoClosed)
+}
+
+// updateStatus moves an issue to a status; closing it is audited as closeAction
+func (s *issueService) updateStatus(ctx context.Context, id uuid.UUID, status domain.Status, changedBy string, closeAction domain.AuditAction) error {
 	s.logger.Debug("Updating issue status",
 		zap.String("issue_id", id.String()),
 		zap.String("status", string(status)),
 	)
 
-	// Validate status
-	if !domain.IsValidStatus(status) {
-		s.logger.Debug("Invalid status", zap.String("status", string(status)))
-		return fmt.Errorf("invalid status: %s", status)
-	}
-
 	// Get issue
 	issue, err := s.issueRepo.GetByID(ctx, id)
 	if err != nil {
@@ -234,14 +653,39 @@ func (s *issueService) UpdateIssueStatus(ctx context.Context, id uuid.UUID, stat
 		return fmt.Errorf("failed to get issue for status update: %w", err)
 	}
 
+	// Validate status against the project's workflow, which may add custom statuses
+	if !issue.Workflow().HasStatus(status) {
+		s.logger.Debug("Invalid status", zap.String("status", string(status)))
+		return domain.WithDetail(domain.ErrInvalidStatus, "%s", status)
+	}
+
+	if !issue.CanTransitionTo(status) {
+		s.logger.Debug("Invalid status transition",
+			zap.String("issue_id", id.String()),
+			zap.String("from", string(issue.Status)),
+			zap.String("to", string(status)),
+		)
+		return domain.WithDetail(domain.ErrInvalidStatusTransition, "%s -> %s", issue.Status, status)
+	}
+
+	if status == domain.StatusClosed {
+		openSubIssues, err := s.issueRepo.CountOpenSubIssues(ctx, issue.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check sub-issues: %w", err)
+		}
+		if openSubIssues > 0 {
+			return domain.WithDetail(domain.ErrOpenSubIssues, "%d still open", openSubIssues)
+		}
+	}
+
+	oldStatus := issue.Status
+
 	// Update status
 	if status == domain.StatusClosed {
 		issue.Close()
 	} else {
 		issue.Status = status
-		if status == domain.StatusOpen {
-			issue.Reopen()
-		}
+		issue.ClosedAt = nil
 	}
 
 	if err := s.issueRepo.Update(ctx, issue); err != nil {
@@ -252,6 +696,20 @@ func (s *issueService) UpdateIssueStatus(ctx context.Context, id uuid.UUID, stat
 		return fmt.Errorf("failed to update issue status: %w", err)
 	}
 
+	s.recordStatusChange(ctx, issue, &oldStatus, changedBy)
+	s.publishStatusChanged(ctx, issue, oldStatus, changedBy)
+
+	switch {
+	case status == domain.StatusClosed && oldStatus != domain.StatusClosed:
+		s.auditIssue(ctx, closeAction, issue,
+			map[string]domain.Status{"status": oldStatus},
+			map[string]domain.Status{"status": status})
+	case oldStatus == domain.StatusClosed && status != domain.StatusClosed:
+		s.auditIssue(ctx, domain.AuditIssueReopened, issue,
+			map[string]domain.Status{"status": oldStatus},
+			map[string]domain.Status{"status": status})
+	}
+
 	s.logger.Info("Issue status updated successfully",
 		zap.String("issue_id", id.String()),
 		zap.String("status", string(status)),
@@ -298,20 +756,70 @@ func (s *issueService) UpdateIssueThreadInfo(ctx context.Context, id uuid.UUID,
 	return nil
 }
 
-// SearchIssuesByID searches for issues by partial ID match
-func (s *issueService) SearchIssuesByID(ctx context.Context, partialID string) ([]*domain.Issue, error) {
-	s.logger.Debug("Searching issues by partial ID", zap.String("partial_id", partialID))
+// FindSimilarIssues retrieves the open issues of a Discord channel's project whose
+// title is at least domain.DuplicateSimilarityThreshold similar to title
+func (s *issueService) FindSimilarIssues(ctx context.Context, channelID string, projectID uuid.UUID, title string) ([]domain.SimilarIssue, error) {
+	s.logger.Debug("Finding similar issues",
+		zap.String("channel_id", channelID),
+		zap.String("title", title),
+	)
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel registration: %w", err)
+	}
+	projectID, err = channelProjectID(channel, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := s.issueRepo.GetOpenByProjectID(ctx, projectID)
+	if err != nil {
+		s.logger.Error("Failed to get open issues for similarity check",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to get open issues: %w", err)
+	}
+
+	var similar []domain.SimilarIssue
+	for _, issue := range issues {
+		if score := domain.TitleSimilarity(title, issue.Title); score >= domain.DuplicateSimilarityThreshold {
+			similar = append(similar, domain.SimilarIssue{Issue: issue, Score: score})
+		}
+	}
+	sort.SliceStable(similar, func(a, b int) bool {
+		return similar[a].Score > similar[b].Score
+	})
+
+	s.logger.Debug("Similar issues found",
+		zap.String("channel_id", channelID),
+		zap.Int("count", len(similar)),
+	)
+	return similar, nil
+}
 
-	// For now, we'll implement a simple search by getting all issues and filtering
-	// In a production system, you'd want to implement this at the database level
-	// This is a placeholder implementation
+// SearchIssuesByPartialID searches a Discord channel's issues by ID prefix
+func (s *issueService) SearchIssuesByPartialID(ctx context.Context, prefix, channelID string) ([]*domain.Issue, error) {
+	s.logger.Debug("Searching issues by partial ID",
+		zap.String("prefix", prefix),
+		zap.String("channel_id", channelID),
+	)
 
-	// Get all issues (in a real implementation, you'd add pagination and search to the repository)
-	// For now, just return empty slice since this is a complex search operation
-	// that would require additional repository methods
+	issues, err := s.issueRepo.SearchByPartialID(ctx, prefix, channelID)
+	if err != nil {
+		s.logger.Error("Failed to search issues by partial ID",
+			zap.Error(err),
+			zap.String("prefix", prefix),
+		)
+		return nil, fmt.Errorf("failed to search issues by partial ID: %w", err)
+	}
 
-	s.logger.Debug("Issue search completed", zap.String("partial_id", partialID))
-	return []*domain.Issue{}, nil
+	s.logger.Debug("Issue search completed",
+		zap.String("prefix", prefix),
+		zap.Int("count", len(issues)),
+	)
+	return issues, nil
 }
 
 // GetOpenIssues retrieves all open issues
@@ -343,23 +851,23 @@ func (s *issueService) GetClosedIssues(ctx context.Context) ([]*domain.Issue, er
 }
 
 // OpenIssue opens an issue
-func (s *issueService) OpenIssue(ctx context.Context, id uuid.UUID) error {
-	return s.UpdateIssueStatus(ctx, id, domain.StatusOpen)
+func (s *issueService) OpenIssue(ctx context.Context, id uuid.UUID, changedBy string) error {
+	return s.UpdateIssueStatus(ctx, id, domain.StatusOpen, changedBy)
 }
 
 // InProgressIssue starts working on an issue
-func (s *issueService) InProgressIssue(ctx context.Context, id uuid.UUID) error {
-	return s.UpdateIssueStatus(ctx, id, domain.StatusInProgress)
+func (s *issueService) InProgressIssue(ctx context.Context, id uuid.UUID, changedBy string) error {
+	return s.UpdateIssueStatus(ctx, id, domain.StatusInProgress, changedBy)
 }
 
 // VerifiedIssue verifies an issue
-func (s *issueService) VerifiedIssue(ctx context.Context, id uuid.UUID) error {
-	return s.UpdateIssueStatus(ctx, id, domain.StatusVerified)
+func (s *issueService) VerifiedIssue(ctx context.Context, id uuid.UUID, changedBy string) error {
+	return s.UpdateIssueStatus(ctx, id, domain.StatusVerified, changedBy)
 }
 
 // CloseIssue closes an issue
-func (s *issueService) CloseIssue(ctx context.Context, id uuid.UUID) error {
-	return s.UpdateIssueStatus(ctx, id, domain.StatusClosed)
+func (s *issueService) CloseIssue(ctx context.Context, id uuid.UUID, changedBy string) error {
+	return s.UpdateIssueStatus(ctx, id, domain.StatusClosed, changedBy)
 }
 
 // ListIssuesByChannel lists all issues for a specific channel (alias for GetIssuesByChannel)
@@ -367,14 +875,47 @@ func (s *issueService) ListIssuesByChannel(ctx context.Context, channelID string
 	return s.GetIssuesByChannel(ctx, channelID)
 }
 
+// ListIssuesByChannelPage lists one page of a channel's issues matching the filter and returns the total count
+func (s *issueService) ListIssuesByChannelPage(ctx context.Context, channelID string, filter domain.IssueFilter, offset, limit int) ([]*domain.Issue, int64, error) {
+	s.logger.Debug("Listing issues by channel page",
+		zap.String("discord_channel_id", channelID),
+		zap.Int("offset", offset),
+		zap.Int("limit", limit),
+	)
+
+	query := filter.Query()
+	query.DiscordChannelID = channelID
+	query.Offset = offset
+	query.Limit = limit
+	if err := query.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	issues, total, err := s.issueRepo.Query(ctx, query)
+	if err != nil {
+		s.logger.Error("Failed to list issues by channel page",
+			zap.Error(err),
+			zap.String("discord_channel_id", channelID),
+		)
+		return nil, 0, fmt.Errorf("failed to list issues by channel page: %w", err)
+	}
+
+	return issues, total, nil
+}
+
 // ListOpenIssues lists all open issues (alias for GetOpenIssues)
 func (s *issueService) ListOpenIssues(ctx context.Context) ([]*domain.Issue, error) {
 	return s.GetOpenIssues(ctx)
 }
 
+// RejectIssue marks a verified fix as rejected by QA
+func (s *issueService) RejectIssue(ctx context.Context, id uuid.UUID, changedBy string) error {
+	return s.UpdateIssueStatus(ctx, id, domain.StatusRejected, changedBy)
+}
+
 // ReopenIssue reopens a closed issue
-func (s *issueService) ReopenIssue(ctx context.Context, id uuid.UUID) error {
-	return s.UpdateIssueStatus(ctx, id, domain.StatusOpen)
+func (s *issueService) ReopenIssue(ctx context.Context, id uuid.UUID, changedBy string) error {
+	return s.UpdateIssueStatus(ctx, id, domain.StatusReopened, changedBy)
 }
 
 // SetThreadInfo sets the thread and message IDs for an issue (alias for UpdateIssueThreadInfo)
@@ -420,13 +961,20 @@ func (s *issueService) UpdateIssueMessageID(ctx context.Context, id uuid.UUID, m
 }
 
 // UpdateIssueResolved updates the resolved information for an issue
-func (s *issueService) UpdateIssueResolved(ctx context.Context, id uuid.UUID, cause, action string) error {
+func (s *issueService) UpdateIssueResolved(ctx context.Context, id uuid.UUID, cause, action, changedBy string) error {
 	s.logger.Debug("Updating issue resolved",
 		zap.String("issue_id", id.String()),
 		zap.String("cause", cause),
 		zap.String("action", action),
 	)
 
+	cause = strings.TrimSpace(cause)
+	action = strings.TrimSpace(action)
+	if cause == "" || action == "" {
+		s.logger.Debug("Invalid resolution input")
+		return fmt.Errorf("invalid resolution input: cause and action are required")
+	}
+
 	issue, err := s.issueRepo.GetByID(ctx, id)
 	if err != nil {
 		s.logger.Error("Failed to get issue for resolved update",
@@ -436,6 +984,12 @@ func (s *issueService) UpdateIssueResolved(ctx context.Context, id uuid.UUID, ca
 		return fmt.Errorf("failed to get issue for resolved update: %w", err)
 	}
 
+	if !issue.CanTransitionTo(domain.StatusResolved) {
+		return domain.WithDetail(domain.ErrInvalidStatusTransition, "%s -> %s", issue.Status, domain.StatusResolved)
+	}
+
+	oldStatus := issue.Status
+
 	// Update resolved information
 	issue.Status = domain.StatusResolved
 	issue.ResolutionCause = cause
@@ -451,6 +1005,9 @@ func (s *issueService) UpdateIssueResolved(ctx context.Context, id uuid.UUID, ca
 		return fmt.Errorf("failed to update issue resolved: %w", err)
 	}
 
+	s.recordStatusChange(ctx, issue, &oldStatus, changedBy)
+	s.publishStatusChanged(ctx, issue, oldStatus, changedBy)
+
 	s.logger.Info("Issue resolved updated successfully",
 		zap.String("issue_id", id.String()),
 		zap.String("cause", cause),
@@ -486,6 +1043,11 @@ func (s *issueService) CreateWebIssue(ctx context.Context, projectID uuid.UUID,
 		Priority:    domain.PriorityMedium,    // Default priority
 		Status:      domain.StatusOpen,        // Default status
 		Source:      string(domain.SourceWeb), // Mark as web issue
+		PublicHash:  uuid.New().String(),
+	}
+
+	if err := s.assignIssueKey(ctx, issue); err != nil {
+		return nil, err
 	}
 
 	// Save to repository
@@ -497,6 +1059,9 @@ func (s *issueService) CreateWebIssue(ctx context.Context, projectID uuid.UUID,
 		return nil, fmt.Errorf("failed to create web issue: %w", err)
 	}
 
+	s.recordStatusChange(ctx, issue, nil, "")
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueCreated, Issue: issue})
+
 	s.logger.Info("Web issue created successfully",
 		zap.String("issue_id", issue.ID.String()),
 		zap.String("title", title),
@@ -505,3 +1070,411 @@ func (s *issueService) CreateWebIssue(ctx context.Context, projectID uuid.UUID,
 
 	return issue, nil
 }
+
+// CreateRecurringIssue files a draft issue from a recurring issue template
+func (s *issueService) CreateRecurringIssue(ctx context.Context, recurring *domain.RecurringIssue) (*domain.Issue, error) {
+	s.logger.Debug("Creating recurring issue",
+		zap.String("recurring_issue_id", recurring.ID.String()),
+		zap.String("title", recurring.Title),
+	)
+
+	channelID := recurring.ChannelID
+	issue := &domain.Issue{
+		ID:          uuid.New(),
+		ProjectID:   recurring.ProjectID,
+		ChannelID:   &channelID,
+		Title:       recurring.Title,
+		Description: recurring.Description,
+		Priority:    recurring.Priority,
+		Status:      domain.StatusDraft,             // Opened from its card like issues reported in Discord
+		Source:      string(domain.SourceRecurring), // Mark as filed on schedule
+		PublicHash:  uuid.New().String(),
+	}
+
+	err := s.uow.Do(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.GetOrCreateByDiscordID(ctx, recurring.CreatedBy, "", domain.UserRoleCustomer)
+		if err != nil {
+			return fmt.Errorf("failed to get or create user: %w", err)
+		}
+		issue.ReporterID = user.ID
+
+		if err := s.assignIssueKey(ctx, issue); err != nil {
+			return err
+		}
+
+		if err := s.issueRepo.Create(ctx, issue); err != nil {
+			s.logger.Error("Failed to create recurring issue",
+				zap.Error(err),
+				zap.String("recurring_issue_id", recurring.ID.String()),
+			)
+			return fmt.Errorf("failed to create recurring issue: %w", err)
+		}
+
+		s.events.Publish(ctx, domain.Event{Type: domain.EventIssueCreated, Issue: issue})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordStatusChange(ctx, issue, nil, "")
+
+	s.logger.Info("Recurring issue created successfully",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("title", issue.Title),
+		zap.String("project_id", issue.ProjectID.String()),
+	)
+
+	return issue, nil
+}
+
+// CreateEmailIssue files an issue sent to a project's support address. In a registered
+// channel it is a draft opened from its card; without one it is open right away.
+func (s *issueService) CreateEmailIssue(ctx context.Context, projectID uuid.UUID, channelID *uuid.UUID, title, description string, reporterID uuid.UUID) (*domain.Issue, error) {
+	s.logger.Debug("Creating email issue",
+		zap.String("title", title),
+		zap.String("project_id", projectID.String()),
+		zap.String("reporter_id", reporterID.String()),
+	)
+
+	title = strings.TrimSpace(title)
+	description = strings.TrimSpace(description)
+	if title == "" || projectID == uuid.Nil || reporterID == uuid.Nil {
+		return nil, fmt.Errorf("invalid issue input: title, project_id, and reporter_id are required")
+	}
+	if description == "" {
+		description = title
+	}
+
+	status := domain.StatusOpen
+	if channelID != nil {
+		status = domain.StatusDraft
+	}
+
+	issue := &domain.Issue{
+		ID:          uuid.New(),
+		ProjectID:   projectID,
+		ChannelID:   channelID,
+		ReporterID:  reporterID,
+		Title:       title,
+		Description: description,
+		Priority:    domain.PriorityMedium,
+		Status:      status,
+		Source:      string(domain.SourceEmail),
+		PublicHash:  uuid.New().String(),
+	}
+
+	if err := s.assignIssueKey(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	if err := s.issueRepo.Create(ctx, issue); err != nil {
+		s.logger.Error("Failed to create email issue",
+			zap.Error(err),
+			zap.String("title", title),
+		)
+		return nil, fmt.Errorf("failed to create email issue: %w", err)
+	}
+
+	s.recordStatusChange(ctx, issue, nil, "")
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueCreated, Issue: issue})
+
+	s.logger.Info("Email issue created successfully",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("title", title),
+		zap.String("project_id", issue.ProjectID.String()),
+	)
+
+	return issue, nil
+}
+
+// AddEmailComment stores a reply to an issue's emails as a comment of the issue
+func (s *issueService) AddEmailComment(ctx context.Context, issueID uuid.UUID, author *domain.User, content string) (*domain.IssueComment, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("invalid comment input: content is required")
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	authorName := author.Name
+	if authorName == "" {
+		authorName = author.Email
+	}
+
+	comment := &domain.IssueComment{
+		ID:         uuid.New(),
+		IssueID:    issue.ID,
+		AuthorID:   &author.ID,
+		AuthorName: authorName,
+		Content:    content,
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		s.logger.Error("Failed to store email comment",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return nil, fmt.Errorf("failed to store email comment: %w", err)
+	}
+
+	s.logger.Debug("Email comment stored",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("comment_id", comment.ID.String()),
+	)
+
+	s.events.Publish(ctx, domain.Event{
+		Type:       domain.EventIssueEmailReply,
+		Issue:      issue,
+		AuthorName: authorName,
+		Content:    content,
+	})
+
+	return comment, nil
+}
+
+// SearchIssues lists the issues matching a query and returns the total count
+func (s *issueService) SearchIssues(ctx context.Context, query domain.IssueQuery) ([]*domain.Issue, int64, error) {
+	s.logger.Debug("Searching issues",
+		zap.String("customer_id", query.CustomerID.String()),
+		zap.String("project_id", query.ProjectID.String()),
+		zap.Int("offset", query.Offset),
+		zap.Int("limit", query.Limit),
+	)
+
+	if err := query.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	issues, total, err := s.issueRepo.Query(ctx, query)
+	if err != nil {
+		s.logger.Error("Failed to search issues", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to search issues: %w", err)
+	}
+
+	return issues, total, nil
+}
+
+// DeleteIssue soft-deletes an issue
+func (s *issueService) DeleteIssue(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug("Deleting issue", zap.String("issue_id", id.String()))
+
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to get issue for deletion",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to get issue for deletion: %w", err)
+	}
+
+	if err := s.issueRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("Failed to delete issue",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete issue: %w", err)
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueDeleted, Issue: issue})
+	s.auditIssue(ctx, domain.AuditIssueDeleted, issue,
+		map[string]string{"title": issue.Title, "status": string(issue.Status)}, nil)
+
+	s.logger.Info("Issue deleted successfully", zap.String("issue_id", id.String()))
+	return nil
+}
+
+// RestoreIssue brings back a soft-deleted issue
+func (s *issueService) RestoreIssue(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug("Restoring issue", zap.String("issue_id", id.String()))
+
+	if err := s.issueRepo.Restore(ctx, id); err != nil {
+		s.logger.Error("Failed to restore issue",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore issue: %w", err)
+	}
+
+	if issue, err := s.issueRepo.GetByID(ctx, id); err == nil {
+		s.auditIssue(ctx, domain.AuditIssueRestored, issue, nil,
+			map[string]string{"title": issue.Title, "status": string(issue.Status)})
+	} else {
+		s.logger.Warn("Failed to get restored issue for the audit log", zap.Error(err), zap.String("issue_id", id.String()))
+	}
+
+	s.logger.Info("Issue restored successfully", zap.String("issue_id", id.String()))
+	return nil
+}
+
+// MoveIssue moves an issue to the registration of a Discord channel and one of its projects,
+// e.g. when it was filed in the wrong channel. An issue moving to another project gets
+// that project's next key; the move is refused if the project's workflow lacks the issue's
+// status. The issue's card and thread are left to the subscribers of EventIssueMoved.
+func (s *issueService) MoveIssue(ctx context.Context, id uuid.UUID, channelID string, projectID uuid.UUID, movedBy string) (*domain.Issue, error) {
+	s.logger.Debug("Moving issue",
+		zap.String("issue_id", id.String()),
+		zap.String("channel_id", channelID),
+	)
+
+	issue, err := s.issueRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get channel registration: %w", err)
+	}
+	if !channel.IsActive {
+		return nil, domain.ErrChannelInactive
+	}
+
+	// Issues filed in the wrong channel of the right project keep their project and key
+	if projectID == uuid.Nil && channel.HasProject(issue.ProjectID) {
+		projectID = issue.ProjectID
+	}
+	projectID, err = channelProjectID(channel, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if issue.ChannelID != nil && *issue.ChannelID == channel.ID && issue.ProjectID == projectID {
+		return nil, domain.ErrIssueAlreadyInChannel
+	}
+
+	var moved *domain.Issue
+	err = s.uow.Do(ctx, func(ctx context.Context) error {
+		number, key := issue.Number, issue.Key
+		if projectID != issue.ProjectID {
+			prefix, next, err := s.projectRepo.NextIssueNumber(ctx, projectID)
+			if err != nil {
+				return fmt.Errorf("failed to allocate issue key: %w", err)
+			}
+			number, key = next, domain.FormatIssueKey(prefix, next)
+		}
+
+		if err := s.issueRepo.Move(ctx, issue.ID, channel.ID, projectID, number, key); err != nil {
+			return err
+		}
+
+		moved, err = s.issueRepo.GetByID(ctx, issue.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get moved issue: %w", err)
+		}
+		if !domain.IsBuiltInStatus(moved.Status) && moved.Project.Workflow.CustomStatus(moved.Status) == nil {
+			return domain.WithDetail(domain.ErrIssueStatusNotInProject, "%s", issue.GetStatusDisplayName())
+		}
+
+		s.events.Publish(ctx, domain.Event{Type: domain.EventIssueMoved, Issue: moved, ActorID: movedBy, OldChannel: issue.Channel})
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to move issue",
+			zap.Error(err),
+			zap.String("issue_id", id.String()),
+			zap.String("channel_id", channelID),
+		)
+		return nil, err
+	}
+
+	s.auditIssue(ctx, domain.AuditIssueMoved, moved, issueLocation(issue), issueLocation(moved))
+
+	s.logger.Info("Issue moved successfully",
+		zap.String("issue_id", id.String()),
+		zap.String("issue_key", moved.Key),
+		zap.String("channel_id", channelID),
+	)
+	return moved, nil
+}
+
+// issueLocation describes the channel, project and key of an issue for the audit log
+func issueLocation(issue *domain.Issue) map[string]string {
+	location := map[string]string{"project": issue.Project.Name, "key": issue.Key}
+	if issue.Channel != nil {
+		location["channel_id"] = issue.Channel.DiscordChannelID
+	}
+	return location
+}
+
+// AddThreadComment stores a message posted in an issue's discussion thread.
+// It returns ErrIssueNotFound if the thread does not belong to an issue.
+func (s *issueService) AddThreadComment(ctx context.Context, threadID, messageID, authorDiscordID, authorName, content string) (*domain.IssueComment, error) {
+	content = strings.TrimSpace(content)
+	if threadID == "" || content == "" {
+		return nil, fmt.Errorf("invalid comment input: thread_id and content are required")
+	}
+
+	issue, err := s.issueRepo.GetByThreadID(ctx, threadID)
+	if err != nil {
+		if err == domain.ErrIssueNotFound {
+			return nil, err
+		}
+		s.logger.Error("Failed to get issue for thread comment",
+			zap.Error(err),
+			zap.String("thread_id", threadID),
+		)
+		return nil, fmt.Errorf("failed to get issue for thread comment: %w", err)
+	}
+
+	comment := &domain.IssueComment{
+		ID:               uuid.New(),
+		IssueID:          issue.ID,
+		AuthorName:       authorName,
+		DiscordMessageID: messageID,
+		Content:          content,
+	}
+
+	if authorDiscordID != "" {
+		user, err := s.userRepo.GetOrCreateByDiscordID(ctx, authorDiscordID, "", domain.UserRoleCustomer)
+		if err != nil {
+			s.logger.Warn("Failed to resolve comment author",
+				zap.Error(err),
+				zap.String("author_id", authorDiscordID),
+			)
+		} else {
+			comment.AuthorID = &user.ID
+		}
+	}
+
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		s.logger.Error("Failed to store thread comment",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return nil, fmt.Errorf("failed to store thread comment: %w", err)
+	}
+
+	s.logger.Debug("Thread comment stored",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("comment_id", comment.ID.String()),
+	)
+
+	s.events.Publish(ctx, domain.Event{
+		Type:       domain.EventIssueCommented,
+		Issue:      issue,
+		ActorID:    authorDiscordID,
+		AuthorName: authorName,
+		Content:    content,
+	})
+
+	return comment, nil
+}
+
+// GetIssueComments retrieves the thread comments of an issue, oldest first
+func (s *issueService) GetIssueComments(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueComment, error) {
+	s.logger.Debug("Getting issue comments", zap.String("issue_id", issueID.String()))
+
+	comments, err := s.commentRepo.GetByIssueID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to get issue comments",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to get issue comments: %w", err)
+	}
+
+	return comments, nil
+}
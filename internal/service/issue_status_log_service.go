@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// issueStatusLogService implements the IssueStatusLogService interface
+type issueStatusLogService struct {
+	statusLogRepo domain.IssueStatusLogRepository
+	issueRepo     domain.IssueRepository
+	logger        *zap.Logger
+}
+
+// NewIssueStatusLogService creates a new instance of issue status log service
+func NewIssueStatusLogService(statusLogRepo domain.IssueStatusLogRepository, issueRepo domain.IssueRepository, logger *zap.Logger) domain.IssueStatusLogService {
+	return &issueStatusLogService{
+		statusLogRepo: statusLogRepo,
+		issueRepo:     issueRepo,
+		logger:        logger,
+	}
+}
+
+// LogStatusChange records a status transition for an issue
+func (s *issueStatusLogService) LogStatusChange(ctx context.Context, issueID uuid.UUID, oldStatus *domain.Status, newStatus domain.Status, changedBy *uuid.UUID) (*domain.IssueStatusLog, error) {
+	s.logger.Debug("Logging status change",
+		zap.String("issue_id", issueID.String()),
+		zap.String("new_status", string(newStatus)),
+	)
+
+	log := domain.NewIssueStatusLog(issueID, oldStatus, newStatus, changedBy)
+
+	if err := s.statusLogRepo.Create(ctx, log); err != nil {
+		s.logger.Error("Failed to log status change",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to log status change: %w", err)
+	}
+
+	s.logger.Info("Status change logged",
+		zap.String("issue_id", issueID.String()),
+		zap.String("new_status", string(newStatus)),
+	)
+
+	return log, nil
+}
+
+// LogIssueEdit records a content edit in the status log without changing the status
+func (s *issueStatusLogService) LogIssueEdit(ctx context.Context, issueID uuid.UUID, status domain.Status, changedBy *uuid.UUID, note string) (*domain.IssueStatusLog, error) {
+	s.logger.Debug("Logging issue edit",
+		zap.String("issue_id", issueID.String()),
+		zap.String("note", note),
+	)
+
+	log := domain.NewIssueEditLog(issueID, status, changedBy, note)
+
+	if err := s.statusLogRepo.Create(ctx, log); err != nil {
+		s.logger.Error("Failed to log issue edit",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to log issue edit: %w", err)
+	}
+
+	return log, nil
+}
+
+// GetIssueStatusHistory returns the status history of an issue, oldest first
+func (s *issueStatusLogService) GetIssueStatusHistory(ctx context.Context, issueID uuid.UUID) ([]*domain.IssueStatusLog, error) {
+	s.logger.Debug("Getting issue status history", zap.String("issue_id", issueID.String()))
+
+	logs, err := s.statusLogRepo.GetByIssueID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to get issue status history",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to get issue status history: %w", err)
+	}
+
+	return logs, nil
+}
+
+// GetUserStatusChanges returns all status changes made by a user
+func (s *issueStatusLogService) GetUserStatusChanges(ctx context.Context, userID uuid.UUID) ([]*domain.IssueStatusLog, error) {
+	s.logger.Debug("Getting user status changes", zap.String("user_id", userID.String()))
+
+	logs, err := s.statusLogRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user status changes",
+			zap.Error(err),
+			zap.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("failed to get user status changes: %w", err)
+	}
+
+	return logs, nil
+}
+
+// GetRecentStatusChanges returns the most recent status changes across all issues
+func (s *issueStatusLogService) GetRecentStatusChanges(ctx context.Context, limit int) ([]*domain.IssueStatusLog, error) {
+	s.logger.Debug("Getting recent status changes", zap.Int("limit", limit))
+
+	logs, err := s.statusLogRepo.GetRecentLogs(ctx, limit)
+	if err != nil {
+		s.logger.Error("Failed to get recent status changes", zap.Error(err))
+		return nil, fmt.Errorf("failed to get recent status changes: %w", err)
+	}
+
+	return logs, nil
+}
+
+// ValidateStatusTransition checks whether an issue may move to the given status
+func (s *issueStatusLogService) ValidateStatusTransition(ctx context.Context, issueID uuid.UUID, newStatus domain.Status) error {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return fmt.Errorf("failed to get issue for transition validation: %w", err)
+	}
+
+	if !issue.CanTransitionTo(newStatus) {
+		s.logger.Debug("Invalid status transition",
+			zap.String("issue_id", issueID.String()),
+			zap.String("from", string(issue.Status)),
+			zap.String("to", string(newStatus)),
+		)
+		return domain.ErrInvalidStatusTransition
+	}
+
+	return nil
+}
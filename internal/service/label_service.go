@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// labelService implements the LabelService interface
+type labelService struct {
+	labelRepo domain.LabelRepository
+	issueRepo domain.IssueRepository
+	logger    *zap.Logger
+}
+
+// NewLabelService creates a new instance of label service
+func NewLabelService(labelRepo domain.LabelRepository, issueRepo domain.IssueRepository, logger *zap.Logger) domain.LabelService {
+	return &labelService{
+		labelRepo: labelRepo,
+		issueRepo: issueRepo,
+		logger:    logger,
+	}
+}
+
+// AddLabelToIssue tags an issue, creating the label in the issue's project if needed.
+// color is only used when the label is created and may be empty.
+func (s *labelService) AddLabelToIssue(ctx context.Context, issueID uuid.UUID, name, color string) (*domain.Label, error) {
+	name = domain.NormalizeLabelName(name)
+	color = strings.ToLower(strings.TrimSpace(color))
+	s.logger.Debug("Adding label to issue",
+		zap.String("issue_id", issueID.String()),
+		zap.String("name", name),
+	)
+
+	if !domain.IsValidLabelName(name) {
+		return nil, domain.ErrInvalidLabelName
+	}
+	if color == "" {
+		color = domain.DefaultLabelColor
+	}
+	if !domain.IsValidLabelColor(color) {
+		return nil, domain.ErrInvalidLabelColor
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to get issue for label",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to get issue for label: %w", err)
+	}
+
+	label, err := s.labelRepo.GetByProjectAndName(ctx, issue.ProjectID, name)
+	if err == domain.ErrLabelNotFound {
+		label = &domain.Label{
+			ID:        uuid.New(),
+			ProjectID: issue.ProjectID,
+			Name:      name,
+			Color:     color,
+		}
+		err = s.labelRepo.Create(ctx, label)
+	}
+	if err != nil {
+		s.logger.Error("Failed to get or create label",
+			zap.Error(err),
+			zap.String("project_id", issue.ProjectID.String()),
+			zap.String("name", name),
+		)
+		return nil, fmt.Errorf("failed to get or create label: %w", err)
+	}
+
+	if err := s.labelRepo.AddToIssue(ctx, issue.ID, label.ID); err != nil {
+		return nil, fmt.Errorf("failed to add label to issue: %w", err)
+	}
+
+	s.logger.Info("Label added to issue",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("label", label.Name),
+	)
+
+	return label, nil
+}
+
+// RemoveLabelFromIssue removes a label from an issue. The label itself stays in the project.
+func (s *labelService) RemoveLabelFromIssue(ctx context.Context, issueID uuid.UUID, name string) error {
+	name = domain.NormalizeLabelName(name)
+	s.logger.Debug("Removing label from issue",
+		zap.String("issue_id", issueID.String()),
+		zap.String("name", name),
+	)
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to get issue for label removal",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return fmt.Errorf("failed to get issue for label removal: %w", err)
+	}
+
+	label, err := s.labelRepo.GetByProjectAndName(ctx, issue.ProjectID, name)
+	if err != nil {
+		return err
+	}
+
+	if err := s.labelRepo.RemoveFromIssue(ctx, issue.ID, label.ID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Label removed from issue",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("label", label.Name),
+	)
+
+	return nil
+}
+
+// GetIssueLabels retrieves the labels attached to an issue
+func (s *labelService) GetIssueLabels(ctx context.Context, issueID uuid.UUID) ([]*domain.Label, error) {
+	labels, err := s.labelRepo.GetByIssueID(ctx, issueID)
+	if err != nil {
+		s.logger.Error("Failed to get issue labels",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+		)
+		return nil, fmt.Errorf("failed to get issue labels: %w", err)
+	}
+
+	return labels, nil
+}
+
+// ListProjectLabels retrieves all labels defined in a project
+func (s *labelService) ListProjectLabels(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+	labels, err := s.labelRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		s.logger.Error("Failed to list project labels",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return nil, fmt.Errorf("failed to list project labels: %w", err)
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// milestoneService implements the MilestoneService interface
+type milestoneService struct {
+	channelRepo      domain.ChannelRepository
+	issueRepo        domain.IssueRepository
+	userRepo         domain.UserRepository
+	milestoneRepo    domain.MilestoneRepository
+	statusLogService domain.IssueStatusLogService
+	events           domain.EventPublisher
+	logger           *zap.Logger
+}
+
+// NewMilestoneService creates a new instance of milestone service
+func NewMilestoneService(
+	channelRepo domain.ChannelRepository,
+	issueRepo domain.IssueRepository,
+	userRepo domain.UserRepository,
+	milestoneRepo domain.MilestoneRepository,
+	statusLogService domain.IssueStatusLogService,
+	events domain.EventPublisher,
+	logger *zap.Logger,
+) domain.MilestoneService {
+	return &milestoneService{
+		channelRepo:      channelRepo,
+		issueRepo:        issueRepo,
+		userRepo:         userRepo,
+		milestoneRepo:    milestoneRepo,
+		statusLogService: statusLogService,
+		events:           events,
+		logger:           logger,
+	}
+}
+
+// Create adds a milestone to the project registered to a Discord channel
+func (s *milestoneService) Create(ctx context.Context, discordChannelID, name, targetDate, createdBy string) (*domain.Milestone, error) {
+	name = domain.NormalizeMilestoneName(name)
+	if !domain.IsValidMilestoneName(name) {
+		return nil, domain.ErrInvalidMilestoneName
+	}
+	target, err := domain.ParseMilestoneDate(targetDate)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.milestoneRepo.ListByProject(ctx, channel.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if findMilestone(existing, name) != nil {
+		return nil, domain.ErrMilestoneExists
+	}
+	if len(existing) >= domain.MaxMilestones {
+		return nil, domain.ErrTooManyMilestones
+	}
+
+	milestone := &domain.Milestone{
+		ID:         uuid.New(),
+		ProjectID:  channel.ProjectID,
+		Name:       name,
+		TargetDate: target,
+		CreatedBy:  createdBy,
+	}
+	if err := s.milestoneRepo.Create(ctx, milestone); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Milestone created",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("name", name),
+		zap.String("created_by", createdBy),
+	)
+
+	return milestone, nil
+}
+
+// List retrieves the milestones of the project registered to a Discord channel with their progress
+func (s *milestoneService) List(ctx context.Context, discordChannelID string) ([]*domain.MilestoneProgress, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.milestoneRepo.ListProgressByProject(ctx, channel.ProjectID)
+}
+
+// GetByName retrieves a milestone of the project registered to a Discord channel
+func (s *milestoneService) GetByName(ctx context.Context, discordChannelID, name string) (*domain.Milestone, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getProjectMilestone(ctx, channel.ProjectID, name)
+}
+
+// GetByID retrieves a milestone by ID
+func (s *milestoneService) GetByID(ctx context.Context, id uuid.UUID) (*domain.Milestone, error) {
+	return s.milestoneRepo.GetByID(ctx, id)
+}
+
+// ListIssues retrieves the issues of a milestone with their assignees, oldest first
+func (s *milestoneService) ListIssues(ctx context.Context, milestoneID uuid.UUID) ([]*domain.Issue, error) {
+	issues, _, err := s.issueRepo.Query(ctx, domain.IssueQuery{
+		MilestoneID: milestoneID,
+		Sort:        domain.IssueSortOldest,
+		Details:     domain.IssueDetailsAssignees,
+	})
+	return issues, err
+}
+
+// Delete removes a milestone of the project registered to a Discord channel
+func (s *milestoneService) Delete(ctx context.Context, discordChannelID, name string) (*domain.Milestone, error) {
+	milestone, err := s.GetByName(ctx, discordChannelID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.milestoneRepo.Delete(ctx, milestone.ID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Milestone deleted",
+		zap.String("project_id", milestone.ProjectID.String()),
+		zap.String("name", milestone.Name),
+	)
+
+	return milestone, nil
+}
+
+// Assign adds an issue to a milestone of the issue's project and records it in the issue history
+func (s *milestoneService) Assign(ctx context.Context, issueID uuid.UUID, name, changedBy string) (*domain.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, err := s.getProjectMilestone(ctx, issue.ProjectID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.setMilestone(ctx, issue, milestone, changedBy)
+}
+
+// Unassign removes an issue from its milestone and records it in the issue history
+func (s *milestoneService) Unassign(ctx context.Context, issueID uuid.UUID, changedBy string) (*domain.Issue, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.setMilestone(ctx, issue, nil, changedBy)
+}
+
+// setMilestone stores the milestone of an issue, or removes it for nil. Unchanged
+// milestones are not recorded again.
+func (s *milestoneService) setMilestone(ctx context.Context, issue *domain.Issue, milestone *domain.Milestone, changedBy string) (*domain.Issue, error) {
+	var milestoneID *uuid.UUID
+	if milestone != nil {
+		milestoneID = &milestone.ID
+	}
+	if (issue.MilestoneID == nil && milestoneID == nil) ||
+		(issue.MilestoneID != nil && milestoneID != nil && *issue.MilestoneID == *milestoneID) {
+		return issue, nil
+	}
+
+	if err := s.issueRepo.SetMilestone(ctx, issue.ID, milestoneID); err != nil {
+		return nil, err
+	}
+
+	note := "Removed from its milestone"
+	switch {
+	case milestone != nil:
+		note = fmt.Sprintf("Added to milestone %s", milestone.Name)
+	case issue.Milestone != nil:
+		note = fmt.Sprintf("Removed from milestone %s", issue.Milestone.Name)
+	}
+	issue.MilestoneID = milestoneID
+	issue.Milestone = milestone
+
+	var changedByID *uuid.UUID
+	if user, err := s.userRepo.GetByDiscordID(ctx, changedBy); err == nil {
+		changedByID = &user.ID
+	}
+	if _, err := s.statusLogService.LogIssueEdit(ctx, issue.ID, issue.Status, changedByID, note); err != nil {
+		s.logger.Warn("Failed to record milestone change",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueEdited, Issue: issue, ActorID: changedBy})
+
+	s.logger.Info("Issue milestone updated",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("note", note),
+		zap.String("changed_by", changedBy),
+	)
+
+	return issue, nil
+}
+
+// getProjectMilestone retrieves a project's milestone by name
+func (s *milestoneService) getProjectMilestone(ctx context.Context, projectID uuid.UUID, name string) (*domain.Milestone, error) {
+	milestones, err := s.milestoneRepo.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone := findMilestone(milestones, domain.NormalizeMilestoneName(name))
+	if milestone == nil {
+		return nil, domain.ErrMilestoneNotFound
+	}
+	return milestone, nil
+}
+
+// findMilestone returns the milestone with the given normalized name, or nil
+func findMilestone(milestones []*domain.Milestone, name string) *domain.Milestone {
+	for _, m := range milestones {
+		if strings.EqualFold(m.Name, name) {
+			return m
+		}
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// notifyTimeout bounds loading an issue and sending its notifications after an event
+const notifyTimeout = 30 * time.Second
+
+// userMentionPattern matches Discord user mentions such as <@123> and <@!123>
+var userMentionPattern = regexp.MustCompile(`<@!?(\d+)>`)
+
+// notificationService implements the NotificationService interface
+type notificationService struct {
+	userRepo    domain.UserRepository
+	prefRepo    domain.UserNotificationPreferenceRepository
+	issueRepo   domain.IssueRepository
+	watcherRepo domain.IssueWatcherRepository
+	notifier    domain.UserNotifier
+	logger      *zap.Logger
+}
+
+// NewNotificationService creates a new notification service delivering through notifier
+func NewNotificationService(
+	userRepo domain.UserRepository,
+	prefRepo domain.UserNotificationPreferenceRepository,
+	issueRepo domain.IssueRepository,
+	watcherRepo domain.IssueWatcherRepository,
+	notifier domain.UserNotifier,
+	logger *zap.Logger,
+) domain.NotificationService {
+	return &notificationService{
+		userRepo:    userRepo,
+		prefRepo:    prefRepo,
+		issueRepo:   issueRepo,
+		watcherRepo: watcherRepo,
+		notifier:    notifier,
+		logger:      logger,
+	}
+}
+
+// HandleIssueEvent notifies the users affected by an issue event in the background
+func (s *notificationService) HandleIssueEvent(_ context.Context, event domain.Event) {
+	var (
+		kind    domain.NotificationKind
+		role    domain.AssigneeRole
+		pick    func(ctx context.Context, issue *domain.Issue) []domain.User
+		watched domain.NotificationKind // Sent to the watchers not picked above
+		issueID = event.Issue.ID
+	)
+
+	switch event.Type {
+	case domain.EventAssigneeAdded:
+		assignee := event.Assignee
+		kind, role = domain.NotificationAssigned, assignee.Role
+		pick = func(context.Context, *domain.Issue) []domain.User { return []domain.User{assignee.User} }
+	case domain.EventIssueCommented:
+		watched = domain.NotificationWatchedCommented
+		content := event.Content
+		if userMentionPattern.MatchString(content) {
+			kind = domain.NotificationMentioned
+			pick = func(ctx context.Context, _ *domain.Issue) []domain.User { return s.mentionedUsers(ctx, content) }
+		}
+	case domain.EventSLABreached:
+		kind = domain.NotificationSLABreached
+		pick = assignees
+	case domain.EventIssueStatusChanged:
+		watched = domain.NotificationWatchedStatusChanged
+		reporter := func(_ context.Context, issue *domain.Issue) []domain.User { return []domain.User{issue.Reporter} }
+		switch event.Issue.Status {
+		case domain.StatusResolved:
+			kind, pick = domain.NotificationIssueResolved, reporter
+		case domain.StatusClosed:
+			kind, pick = domain.NotificationIssueClosed, reporter
+		case domain.StatusRejected:
+			kind, pick = domain.NotificationIssueRejected, developers
+		}
+	default:
+		return
+	}
+	if pick == nil && watched == "" {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		issue, err := s.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			s.logger.Error("Failed to load issue for notifications",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("event", string(event.Type)),
+			)
+			return
+		}
+
+		notification := domain.Notification{
+			Kind:       kind,
+			Issue:      issue,
+			Role:       role,
+			ActorID:    event.ActorID,
+			Content:    event.Content,
+			AuthorName: event.AuthorName,
+			OldStatus:  event.OldStatus,
+			Alert:      event.SLAAlert,
+		}
+
+		notified := make(map[uuid.UUID]bool)
+		if pick != nil {
+			s.notifyUsers(ctx, pick(ctx, issue), notification, notified)
+		}
+		if watched != "" {
+			notification.Kind = watched
+			s.notifyUsers(ctx, s.watchers(ctx, issue), notification, notified)
+		}
+	}()
+}
+
+// developers returns the users assigned to an issue as developers
+func developers(_ context.Context, issue *domain.Issue) []domain.User {
+	var users []domain.User
+	for _, assignee := range issue.GetDevelopers() {
+		users = append(users, assignee.User)
+	}
+	return users
+}
+
+// assignees returns the users assigned to an issue in any role
+func assignees(_ context.Context, issue *domain.Issue) []domain.User {
+	var users []domain.User
+	for _, assignee := range issue.Assignees {
+		users = append(users, assignee.User)
+	}
+	return users
+}
+
+// mentionedUsers returns the known users mentioned in a message
+func (s *notificationService) mentionedUsers(ctx context.Context, content string) []domain.User {
+	var users []domain.User
+	for _, match := range userMentionPattern.FindAllStringSubmatch(content, -1) {
+		user, err := s.userRepo.GetByDiscordID(ctx, match[1])
+		if err != nil {
+			// Users the bot has never seen have not turned mention notifications on
+			continue
+		}
+		users = append(users, *user)
+	}
+	return users
+}
+
+// watchers returns the users watching an issue
+func (s *notificationService) watchers(ctx context.Context, issue *domain.Issue) []domain.User {
+	watchers, err := s.watcherRepo.GetByIssueID(ctx, issue.ID)
+	if err != nil {
+		s.logger.Error("Failed to get issue watchers",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return nil
+	}
+
+	users := make([]domain.User, 0, len(watchers))
+	for _, watcher := range watchers {
+		users = append(users, watcher.User)
+	}
+	return users
+}
+
+// notifyUsers sends a notification to each user who can and wants to receive it.
+// Users in notified, who were considered for another notification of the same event,
+// are skipped; the users considered here are added to it.
+func (s *notificationService) notifyUsers(ctx context.Context, users []domain.User, notification domain.Notification, notified map[uuid.UUID]bool) {
+	for i := range users {
+		user := &users[i]
+		if user.DiscordID == "" || user.DMOptOut || user.DiscordID == notification.ActorID || notified[user.ID] {
+			continue
+		}
+		notified[user.ID] = true
+
+		if !s.wants(ctx, user, notification.Kind.Event()) {
+			continue
+		}
+
+		if err := s.notifier.NotifyUser(ctx, user, notification); err != nil {
+			// Users may have closed their DMs; that is not worth more than a warning
+			s.logger.Warn("Failed to send notification",
+				zap.Error(err),
+				zap.String("discord_id", user.DiscordID),
+				zap.String("issue_id", notification.Issue.ID.String()),
+				zap.String("kind", string(notification.Kind)),
+			)
+		}
+	}
+}
+
+// wants checks if a user has the notifications of an event turned on
+func (s *notificationService) wants(ctx context.Context, user *domain.User, event domain.NotificationEvent) bool {
+	stored, err := s.prefRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		// Fall back to the defaults rather than dropping the notification
+		return event.DefaultEnabled()
+	}
+	return domain.NewNotificationPreferences(stored)[event]
+}
+
+// IsOptedOut checks if a user turned direct message notifications off
+func (s *notificationService) IsOptedOut(ctx context.Context, discordID string) (bool, error) {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err == domain.ErrUserNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return user.DMOptOut, nil
+}
+
+// SetOptOut turns direct message notifications off or back on for a user
+func (s *notificationService) SetOptOut(ctx context.Context, discordID string, optOut bool) error {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err == domain.ErrUserNotFound {
+		if !optOut {
+			return nil
+		}
+		return s.userRepo.Create(ctx, &domain.User{
+			ID:        uuid.New(),
+			DiscordID: discordID,
+			Role:      domain.UserRoleCustomer,
+			DMOptOut:  true,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	if user.DMOptOut == optOut {
+		return nil
+	}
+	user.DMOptOut = optOut
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	s.logger.Info("Notification preference updated",
+		zap.String("discord_id", discordID),
+		zap.Bool("opt_out", optOut),
+	)
+
+	return nil
+}
+
+// GetPreferences retrieves a user's choices per notification event, with defaults for events they have not chosen
+func (s *notificationService) GetPreferences(ctx context.Context, discordID string) (domain.NotificationPreferences, error) {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err == domain.ErrUserNotFound {
+		return domain.NewNotificationPreferences(nil), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.prefRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewNotificationPreferences(stored), nil
+}
+
+// SetPreference turns the notifications of one event on or off for a user
+func (s *notificationService) SetPreference(ctx context.Context, discordID string, event domain.NotificationEvent, enabled bool) (domain.NotificationPreferences, error) {
+	if !event.IsValid() {
+		return nil, domain.ErrInvalidNotificationEvent
+	}
+
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.prefRepo.Upsert(ctx, &domain.UserNotificationPreference{
+		ID:      uuid.New(),
+		UserID:  user.ID,
+		Event:   event,
+		Enabled: enabled,
+	}); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Notification preference updated",
+		zap.String("discord_id", discordID),
+		zap.String("event", string(event)),
+		zap.Bool("enabled", enabled),
+	)
+
+	return s.GetPreferences(ctx, discordID)
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// onCallAssignTimeout bounds assigning an issue to the on-call member after an event
+const onCallAssignTimeout = 30 * time.Second
+
+// onCallService implements the OnCallService interface
+type onCallService struct {
+	channelRepo     domain.ChannelRepository
+	onCallRepo      domain.OnCallRepository
+	userRepo        domain.UserRepository
+	issueRepo       domain.IssueRepository
+	assigneeService domain.IssueAssigneeService
+	notifier        domain.OnCallNotifier
+	presence        domain.PresenceChecker
+	events          domain.EventPublisher
+	period          time.Duration
+	now             func() time.Time
+	logger          *zap.Logger
+}
+
+// NewOnCallService creates a new on-call service. Each member stays on call for period;
+// a zero period rotates only on handoff. presence may be nil, in which case the member
+// on call gets every auto-assignment.
+func NewOnCallService(
+	channelRepo domain.ChannelRepository,
+	onCallRepo domain.OnCallRepository,
+	userRepo domain.UserRepository,
+	issueRepo domain.IssueRepository,
+	assigneeService domain.IssueAssigneeService,
+	notifier domain.OnCallNotifier,
+	presence domain.PresenceChecker,
+	events domain.EventPublisher,
+	period time.Duration,
+	logger *zap.Logger,
+) domain.OnCallService {
+	return &onCallService{
+		channelRepo:     channelRepo,
+		onCallRepo:      onCallRepo,
+		userRepo:        userRepo,
+		issueRepo:       issueRepo,
+		assigneeService: assigneeService,
+		notifier:        notifier,
+		presence:        presence,
+		events:          events,
+		period:          period,
+		now:             time.Now,
+		logger:          logger,
+	}
+}
+
+// GetSchedule retrieves the rotation of the project registered to a Discord channel
+func (s *onCallService) GetSchedule(ctx context.Context, discordChannelID string) (*domain.OnCallSchedule, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.onCallRepo.GetByProjectID(ctx, channel.ProjectID)
+}
+
+// AddMember appends a user to the project's rotation, creating the rotation with its first member
+func (s *onCallService) AddMember(ctx context.Context, discordChannelID, discordID, addedBy string) (*domain.OnCallSchedule, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.onCallRepo.GetByProjectID(ctx, channel.ProjectID)
+	if err == domain.ErrOnCallScheduleNotFound {
+		schedule = &domain.OnCallSchedule{
+			ID:        uuid.New(),
+			ProjectID: channel.ProjectID,
+			CreatedBy: addedBy,
+		}
+		if err := s.onCallRepo.Create(ctx, schedule); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if schedule.Member(discordID) != nil {
+		return nil, domain.ErrOnCallMemberExists
+	}
+	if len(schedule.Members) >= domain.MaxOnCallMembers {
+		return nil, domain.ErrTooManyOnCallMembers
+	}
+
+	// Members keep the role they have; being on call is no reason to grant support access
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	position := 0
+	if n := len(schedule.Members); n > 0 {
+		position = schedule.Members[n-1].Position + 1
+	}
+	if err := s.onCallRepo.AddMember(ctx, &domain.OnCallMember{
+		ID:         uuid.New(),
+		ScheduleID: schedule.ID,
+		UserID:     user.ID,
+		Position:   position,
+	}); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("On-call member added",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("discord_id", discordID),
+		zap.String("added_by", addedBy),
+	)
+
+	// The first member goes on call straight away
+	first := len(schedule.Members) == 0
+	if first {
+		schedule.CurrentPosition = 0
+		schedule.StartTurn(s.now(), s.period)
+		if err := s.onCallRepo.UpdateRotation(ctx, schedule); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.onCallRepo.GetByProjectID(ctx, channel.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if first {
+		s.notifyStarted(ctx, updated)
+	}
+	return updated, nil
+}
+
+// RemoveMember removes a user from the project's rotation. If they were on call, the next member takes over.
+func (s *onCallService) RemoveMember(ctx context.Context, discordChannelID, discordID string) (*domain.OnCallSchedule, error) {
+	schedule, err := s.GetSchedule(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	member := schedule.Member(discordID)
+	if member == nil {
+		return nil, domain.ErrOnCallMemberNotFound
+	}
+
+	index, current := 0, schedule.CurrentPosition%len(schedule.Members)
+	for i := range schedule.Members {
+		if schedule.Members[i].ID == member.ID {
+			index = i
+		}
+	}
+
+	if err := s.onCallRepo.RemoveMember(ctx, schedule.ID, member.UserID); err != nil {
+		return nil, err
+	}
+
+	// Keep the same member on call, or hand over to the one after the removed member
+	remaining := len(schedule.Members) - 1
+	switch {
+	case index < current:
+		schedule.CurrentPosition = current - 1
+	case index == current:
+		schedule.CurrentPosition = 0
+		if remaining > 0 {
+			schedule.CurrentPosition = current % remaining
+		}
+		schedule.StartTurn(s.now(), s.period)
+	default:
+		schedule.CurrentPosition = current
+	}
+	if err := s.onCallRepo.UpdateRotation(ctx, schedule); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("On-call member removed",
+		zap.String("project_id", schedule.ProjectID.String()),
+		zap.String("discord_id", discordID),
+	)
+
+	updated, err := s.onCallRepo.GetByProjectID(ctx, schedule.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if index == current {
+		s.notifyStarted(ctx, updated)
+	}
+	return updated, nil
+}
+
+// Handoff hands the project's rotation to the next member now
+func (s *onCallService) Handoff(ctx context.Context, discordChannelID string) (*domain.OnCallSchedule, error) {
+	schedule, err := s.GetSchedule(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if len(schedule.Members) == 0 {
+		return nil, domain.ErrOnCallScheduleNotFound
+	}
+
+	if err := s.advance(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// AdvanceRotations hands every rotation whose period has elapsed to its next member
+func (s *onCallService) AdvanceRotations(ctx context.Context) error {
+	schedules, err := s.onCallRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	advanced := 0
+	for _, schedule := range schedules {
+		if !schedule.IsDue(now) {
+			continue
+		}
+		if err := s.advance(ctx, schedule); err != nil {
+			s.logger.Error("Failed to advance on-call rotation",
+				zap.Error(err),
+				zap.String("schedule_id", schedule.ID.String()),
+			)
+			continue
+		}
+		advanced++
+	}
+
+	s.logger.Debug("On-call rotations checked",
+		zap.Int("schedules", len(schedules)),
+		zap.Int("advanced", advanced),
+	)
+
+	return nil
+}
+
+// advance hands a rotation to its next member and tells them
+func (s *onCallService) advance(ctx context.Context, schedule *domain.OnCallSchedule) error {
+	schedule.Advance(s.now(), s.period)
+	if err := s.onCallRepo.UpdateRotation(ctx, schedule); err != nil {
+		return err
+	}
+
+	s.logger.Info("On-call rotation advanced",
+		zap.String("project_id", schedule.ProjectID.String()),
+		zap.String("discord_id", schedule.Current().User.DiscordID),
+	)
+
+	s.notifyStarted(ctx, schedule)
+	return nil
+}
+
+// notifyStarted tells the member now on call that their turn has started
+func (s *onCallService) notifyStarted(ctx context.Context, schedule *domain.OnCallSchedule) {
+	current := schedule.Current()
+	if current == nil || s.notifier == nil {
+		return
+	}
+	if err := s.notifier.NotifyOnCallStarted(ctx, schedule, &current.User); err != nil {
+		s.logger.Warn("Failed to notify on-call member",
+			zap.Error(err),
+			zap.String("discord_id", current.User.DiscordID),
+		)
+	}
+}
+
+// HandleIssueEvent assigns new high-priority issues, and issues raised to high priority
+// before anyone picked them up, to the project's on-call member
+func (s *onCallService) HandleIssueEvent(_ context.Context, event domain.Event) {
+	if event.Issue.Priority != domain.PriorityHigh || !domain.IsAwaitingResponse(event.Issue.Status) {
+		return
+	}
+
+	issueID := event.Issue.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), onCallAssignTimeout)
+		defer cancel()
+
+		if err := s.assignOnCall(ctx, issueID); err != nil {
+			s.logger.Error("Failed to assign issue to on-call member",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+		}
+	}()
+}
+
+// assignOnCall assigns an issue without a developer to the first member of its project's
+// rotation who is not away, starting with the member on call
+func (s *onCallService) assignOnCall(ctx context.Context, issueID uuid.UUID) error {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return err
+	}
+	if len(issue.GetDevelopers()) > 0 {
+		return nil
+	}
+
+	schedule, err := s.onCallRepo.GetByProjectID(ctx, issue.ProjectID)
+	if err == domain.ErrOnCallScheduleNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	member := s.pickAvailable(schedule, issue)
+	if member == nil {
+		return nil
+	}
+
+	assignee, err := s.assigneeService.AssignUserToIssue(ctx, issue.ID, member.User.DiscordID, domain.AssigneeRoleDev)
+	if err != nil {
+		return err
+	}
+	assignee.User = member.User
+
+	s.logger.Info("Issue assigned to on-call member",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("discord_id", member.User.DiscordID),
+	)
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueAutoAssigned, Issue: issue, Assignee: assignee})
+	return nil
+}
+
+// pickAvailable returns the first member of the rotation who is not away, or the member
+// on call if everyone is away
+func (s *onCallService) pickAvailable(schedule *domain.OnCallSchedule, issue *domain.Issue) *domain.OnCallMember {
+	rotation := schedule.Rotation()
+	if len(rotation) == 0 {
+		return nil
+	}
+	if s.presence == nil || issue.Channel == nil {
+		return rotation[0]
+	}
+
+	for _, member := range rotation {
+		if !s.presence.IsAway(issue.Channel.GuildID, member.User.DiscordID) {
+			return member
+		}
+	}
+	return rotation[0]
+}
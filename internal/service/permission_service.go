@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// permissionService implements the PermissionService interface
+type permissionService struct {
+	userRepo     domain.UserRepository
+	settingsRepo domain.GuildSettingsRepository
+	roleMappings map[string]domain.UserRole
+	logger       *zap.Logger
+}
+
+// NewPermissionService creates a new instance of permission service.
+// roleMappings maps Discord role IDs or names (case-insensitive) to user roles; guilds
+// can add their own admin roles on top of them.
+func NewPermissionService(userRepo domain.UserRepository, settingsRepo domain.GuildSettingsRepository, roleMappings map[string]domain.UserRole, logger *zap.Logger) domain.PermissionService {
+	normalized := make(map[string]domain.UserRole, len(roleMappings))
+	for role, userRole := range roleMappings {
+		normalized[strings.ToLower(strings.TrimSpace(role))] = userRole
+	}
+
+	return &permissionService{
+		userRepo:     userRepo,
+		settingsRepo: settingsRepo,
+		roleMappings: normalized,
+		logger:       logger,
+	}
+}
+
+// ResolveRole returns the most privileged role held by the actor, combining the stored
+// user role, configured Discord role mappings, the guild's admin roles and guild
+// administrator rights
+func (s *permissionService) ResolveRole(ctx context.Context, actor domain.Actor) (domain.UserRole, error) {
+	if actor.GuildAdmin {
+		return domain.UserRoleAdmin, nil
+	}
+
+	role := domain.UserRoleCustomer
+
+	user, err := s.userRepo.GetByDiscordID(ctx, actor.DiscordID)
+	if err != nil && err != domain.ErrUserNotFound {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if user != nil && user.Role.Rank() > role.Rank() {
+		role = user.Role
+	}
+
+	for _, discordRole := range actor.DiscordRoles {
+		if mapped, ok := s.roleMappings[strings.ToLower(discordRole)]; ok && mapped.Rank() > role.Rank() {
+			role = mapped
+		}
+	}
+
+	if actor.GuildID != "" && role != domain.UserRoleAdmin {
+		settings, err := s.settingsRepo.GetByGuildID(ctx, actor.GuildID)
+		if err != nil && err != domain.ErrGuildSettingsNotFound {
+			return "", fmt.Errorf("failed to get guild settings: %w", err)
+		}
+		for _, discordRole := range actor.DiscordRoles {
+			if settings.IsAdminRole(discordRole) {
+				return domain.UserRoleAdmin, nil
+			}
+		}
+	}
+
+	return role, nil
+}
+
+// Authorize returns ErrPermissionDenied if the actor may not use the permission
+func (s *permissionService) Authorize(ctx context.Context, actor domain.Actor, permission domain.Permission) error {
+	role, err := s.ResolveRole(ctx, actor)
+	if err != nil {
+		s.logger.Error("Failed to resolve role",
+			zap.Error(err),
+			zap.String("discord_id", actor.DiscordID),
+		)
+		return err
+	}
+
+	if !role.Can(permission) {
+		s.logger.Info("Permission denied",
+			zap.String("discord_id", actor.DiscordID),
+			zap.String("role", string(role)),
+			zap.String("permission", string(permission)),
+		)
+		return domain.ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"fix-track-bot/internal/domain"
 
@@ -14,14 +15,16 @@ import (
 type projectService struct {
 	projectRepo  domain.ProjectRepository
 	customerRepo domain.CustomerRepository
+	auditor      domain.Auditor
 	logger       *zap.Logger
 }
 
 // NewProjectService creates a new instance of project service
-func NewProjectService(projectRepo domain.ProjectRepository, customerRepo domain.CustomerRepository, logger *zap.Logger) domain.ProjectService {
+func NewProjectService(projectRepo domain.ProjectRepository, customerRepo domain.CustomerRepository, auditor domain.Auditor, logger *zap.Logger) domain.ProjectService {
 	return &projectService{
 		projectRepo:  projectRepo,
 		customerRepo: customerRepo,
+		auditor:      auditor,
 		logger:       logger,
 	}
 }
@@ -88,6 +91,14 @@ func (s *projectService) CreateProject(ctx context.Context, customerID uuid.UUID
 		return nil, fmt.Errorf("failed to create project: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditProjectCreated,
+		TargetType: domain.AuditTargetProject,
+		TargetID:   project.ID.String(),
+		TargetName: project.Name,
+		After:      map[string]string{"name": project.Name, "key_prefix": project.KeyPrefix},
+	})
+
 	s.logger.Info("Project created successfully",
 		zap.String("project_id", project.ID.String()),
 		zap.String("customer_id", customerID.String()),
@@ -161,7 +172,19 @@ func (s *projectService) UpdateProject(ctx context.Context, id uuid.UUID, name,
 		return domain.ErrEmptyProjectName
 	}
 
+	// Projects are looked up by name within their customer, so it must stay unique there
+	if name != project.Name {
+		existing, err := s.projectRepo.GetByName(ctx, project.CustomerID, name)
+		if err != nil && err != domain.ErrProjectNotFound {
+			return fmt.Errorf("failed to check existing project: %w", err)
+		}
+		if existing != nil {
+			return domain.ErrProjectAlreadyExists
+		}
+	}
+
 	// Update fields
+	before := project.Name
 	project.Name = name
 	project.Description = description
 
@@ -173,6 +196,17 @@ func (s *projectService) UpdateProject(ctx context.Context, id uuid.UUID, name,
 		return fmt.Errorf("failed to update project: %w", err)
 	}
 
+	if name != before {
+		s.auditor.Record(ctx, domain.AuditEntry{
+			Action:     domain.AuditProjectRenamed,
+			TargetType: domain.AuditTargetProject,
+			TargetID:   id.String(),
+			TargetName: name,
+			Before:     map[string]string{"name": before},
+			After:      map[string]string{"name": name},
+		})
+	}
+
 	s.logger.Info("Project updated successfully",
 		zap.String("project_id", id.String()),
 		zap.String("name", name),
@@ -206,3 +240,244 @@ func (s *projectService) ListProjects(ctx context.Context, offset, limit int) ([
 
 	return projects, nil
 }
+
+// MergeProjects moves the issues and channels of a project to another and deletes it.
+// Issues keep their keys; new issues get keys of the project merged into.
+func (s *projectService) MergeProjects(ctx context.Context, fromID, intoID uuid.UUID) (*domain.Project, error) {
+	s.logger.Debug("Merging projects",
+		zap.String("from_project_id", fromID.String()),
+		zap.String("into_project_id", intoID.String()),
+	)
+
+	if fromID == intoID {
+		return nil, domain.ErrMergeProjectIntoItself
+	}
+
+	from, err := s.projectRepo.GetByID(ctx, fromID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve project to merge: %w", err)
+	}
+	into, err := s.projectRepo.GetByID(ctx, intoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve project to merge into: %w", err)
+	}
+
+	if err := s.projectRepo.Merge(ctx, fromID, intoID); err != nil {
+		s.logger.Error("Failed to merge projects",
+			zap.Error(err),
+			zap.String("from_project_id", fromID.String()),
+			zap.String("into_project_id", intoID.String()),
+		)
+		return nil, fmt.Errorf("failed to merge projects: %w", err)
+	}
+
+	s.auditor.Record(ctx, domain.AuditEntry{
+		Action:     domain.AuditProjectMerged,
+		TargetType: domain.AuditTargetProject,
+		TargetID:   intoID.String(),
+		TargetName: into.Name,
+		Before:     map[string]string{"merged_project": from.Name, "merged_project_id": fromID.String(), "merged_key_prefix": from.KeyPrefix},
+	})
+
+	s.logger.Info("Projects merged successfully",
+		zap.String("from_project_id", fromID.String()),
+		zap.String("into_project_id", intoID.String()),
+	)
+
+	return into, nil
+}
+
+// DeleteProject soft-deletes a project
+func (s *projectService) DeleteProject(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug("Deleting project", zap.String("project_id", id.String()))
+
+	if err := s.projectRepo.Delete(ctx, id); err != nil {
+		s.logger.Error("Failed to delete project",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	s.logger.Info("Project deleted successfully", zap.String("project_id", id.String()))
+	return nil
+}
+
+// RestoreProject brings back a soft-deleted project
+func (s *projectService) RestoreProject(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug("Restoring project", zap.String("project_id", id.String()))
+
+	if err := s.projectRepo.Restore(ctx, id); err != nil {
+		s.logger.Error("Failed to restore project",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to restore project: %w", err)
+	}
+
+	s.logger.Info("Project restored successfully", zap.String("project_id", id.String()))
+	return nil
+}
+
+// SetGitHubRepo sets (or clears, with an empty repo) the GitHub repository a project is mirrored to
+func (s *projectService) SetGitHubRepo(ctx context.Context, id uuid.UUID, repo string) error {
+	repo = strings.TrimSpace(repo)
+	s.logger.Debug("Setting project GitHub repository",
+		zap.String("project_id", id.String()),
+		zap.String("repo", repo),
+	)
+
+	if repo != "" && !domain.IsValidGitHubRepo(repo) {
+		return domain.ErrInvalidGitHubRepo
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve project for GitHub repository update",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to retrieve project for GitHub repository update: %w", err)
+	}
+
+	project.GitHubRepo = repo
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to update project GitHub repository",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to update project GitHub repository: %w", err)
+	}
+
+	s.logger.Info("Project GitHub repository updated",
+		zap.String("project_id", id.String()),
+		zap.String("repo", repo),
+	)
+
+	return nil
+}
+
+// SetJiraProject sets (or clears, with an empty key) the Jira project a project is mirrored to
+func (s *projectService) SetJiraProject(ctx context.Context, id uuid.UUID, key string) error {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	s.logger.Debug("Setting project Jira mapping",
+		zap.String("project_id", id.String()),
+		zap.String("jira_project", key),
+	)
+
+	if key != "" && !domain.IsValidJiraProjectKey(key) {
+		return domain.ErrInvalidJiraProjectKey
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve project for Jira mapping update",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to retrieve project for Jira mapping update: %w", err)
+	}
+
+	project.JiraProject = key
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to update project Jira mapping",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to update project Jira mapping: %w", err)
+	}
+
+	s.logger.Info("Project Jira mapping updated",
+		zap.String("project_id", id.String()),
+		zap.String("jira_project", key),
+	)
+
+	return nil
+}
+
+// SetSlackWebhook sets (or clears, with an empty URL) the Slack incoming webhook a project notifies
+func (s *projectService) SetSlackWebhook(ctx context.Context, id uuid.UUID, webhookURL string) error {
+	webhookURL = strings.TrimSpace(webhookURL)
+	s.logger.Debug("Setting project Slack webhook", zap.String("project_id", id.String()))
+
+	if webhookURL != "" && !domain.IsValidSlackWebhookURL(webhookURL) {
+		return domain.ErrInvalidSlackWebhookURL
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve project for Slack webhook update",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to retrieve project for Slack webhook update: %w", err)
+	}
+
+	project.SlackWebhookURL = webhookURL
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to update project Slack webhook",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to update project Slack webhook: %w", err)
+	}
+
+	s.logger.Info("Project Slack webhook updated",
+		zap.String("project_id", id.String()),
+		zap.Bool("enabled", webhookURL != ""),
+	)
+
+	return nil
+}
+
+// SetInboundEmail sets (or clears, with an empty address) the support address whose emails become issues of a project
+func (s *projectService) SetInboundEmail(ctx context.Context, id uuid.UUID, address string) error {
+	s.logger.Debug("Setting project inbound email", zap.String("project_id", id.String()))
+
+	if strings.TrimSpace(address) != "" {
+		normalized, ok := domain.NormalizeInboundEmail(address)
+		if !ok {
+			return domain.ErrInvalidInboundEmail
+		}
+		address = normalized
+
+		existing, err := s.projectRepo.GetByInboundEmail(ctx, address)
+		if err != nil && err != domain.ErrProjectNotFound {
+			return fmt.Errorf("failed to check existing inbound email: %w", err)
+		}
+		if existing != nil && existing.ID != id {
+			return domain.ErrInboundEmailTaken
+		}
+	} else {
+		address = ""
+	}
+
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("Failed to retrieve project for inbound email update",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to retrieve project for inbound email update: %w", err)
+	}
+
+	project.InboundEmail = address
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		s.logger.Error("Failed to update project inbound email",
+			zap.Error(err),
+			zap.String("project_id", id.String()),
+		)
+		return fmt.Errorf("failed to update project inbound email: %w", err)
+	}
+
+	s.logger.Info("Project inbound email updated",
+		zap.String("project_id", id.String()),
+		zap.String("address", address),
+	)
+
+	return nil
+}
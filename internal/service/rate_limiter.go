@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+)
+
+// rateLimiter allows at most limit events per key within a sliding window. It keeps the
+// events in memory, so each replica of the bot counts its own.
+type rateLimiter struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	limit     int
+	window    time.Duration
+	events    map[string][]time.Time
+	nextSweep time.Time
+}
+
+// NewRateLimiter creates an in-memory rate limiter allowing limit events per key within
+// window; a limit of 0 allows every event
+func NewRateLimiter(limit int, window time.Duration) domain.RateLimiter {
+	return &rateLimiter{
+		limit:  limit,
+		window: window,
+		now:    time.Now,
+		events: make(map[string][]time.Time),
+	}
+}
+
+// Take records an event for key if the key is below its limit and returns the time
+// of the event. Otherwise ok is false and at is when the next event will be allowed.
+func (l *rateLimiter) Take(_ context.Context, key string) (at time.Time, ok bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.limit <= 0 {
+		return time.Time{}, true, nil
+	}
+	l.sweep(now)
+
+	events := l.recent(key, now)
+	if len(events) >= l.limit {
+		l.events[key] = events
+		return events[0].Add(l.window), false, nil
+	}
+
+	l.events[key] = append(events, now)
+	return now, true, nil
+}
+
+// Undo forgets the event recorded at at, e.g. because the action it allowed failed
+func (l *rateLimiter) Undo(_ context.Context, key string, at time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	events := l.events[key]
+	for i, t := range events {
+		if t.Equal(at) {
+			l.events[key] = append(events[:i:i], events[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// SetLimit changes the events allowed per key within window. Events already recorded
+// count towards the new limit.
+func (l *rateLimiter) SetLimit(limit int, window time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limit = limit
+	l.window = window
+	l.nextSweep = time.Time{}
+}
+
+// recent returns the events of key within the window ending at now, oldest first
+func (l *rateLimiter) recent(key string, now time.Time) []time.Time {
+	events := l.events[key]
+	for len(events) > 0 && !now.Before(events[0].Add(l.window)) {
+		events = events[1:]
+	}
+	return events
+}
+
+// sweep drops keys without recent events once per window, so idle users do not pile up
+func (l *rateLimiter) sweep(now time.Time) {
+	if now.Before(l.nextSweep) {
+		return
+	}
+	for key := range l.events {
+		if len(l.recent(key, now)) == 0 {
+			delete(l.events, key)
+		}
+	}
+	l.nextSweep = now.Add(l.window)
+}
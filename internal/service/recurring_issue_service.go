@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/scheduler"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// recurringIssueService implements the RecurringIssueService interface
+type recurringIssueService struct {
+	channelRepo   domain.ChannelRepository
+	recurringRepo domain.RecurringIssueRepository
+	issueService  domain.IssueService
+	location      *time.Location
+	now           func() time.Time
+	logger        *zap.Logger
+}
+
+// NewRecurringIssueService creates a new recurring issue service. Schedules are evaluated
+// in location.
+func NewRecurringIssueService(
+	channelRepo domain.ChannelRepository,
+	recurringRepo domain.RecurringIssueRepository,
+	issueService domain.IssueService,
+	location *time.Location,
+	logger *zap.Logger,
+) domain.RecurringIssueService {
+	return &recurringIssueService{
+		channelRepo:   channelRepo,
+		recurringRepo: recurringRepo,
+		issueService:  issueService,
+		location:      location,
+		now:           time.Now,
+		logger:        logger,
+	}
+}
+
+// List retrieves the recurring issues of the project registered to a Discord channel
+func (s *recurringIssueService) List(ctx context.Context, discordChannelID string) ([]*domain.RecurringIssue, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.recurringRepo.ListByProject(ctx, channel.ProjectID)
+}
+
+// Add schedules an issue to be filed into the project registered to a Discord channel
+func (s *recurringIssueService) Add(ctx context.Context, discordChannelID, schedule, title, description string, priority domain.Priority, createdBy string) (*domain.RecurringIssue, error) {
+	title = domain.NormalizeRecurringIssueTitle(title)
+	if !domain.IsValidRecurringIssueTitle(title) {
+		return nil, domain.ErrInvalidRecurringIssueTitle
+	}
+	if priority == "" {
+		priority = domain.PriorityMedium
+	}
+	if !domain.IsValidPriority(priority) {
+		return nil, domain.ErrInvalidPriority
+	}
+
+	schedule = strings.Join(strings.Fields(schedule), " ")
+	cron, err := scheduler.ParseCron(schedule, s.location)
+	if err != nil {
+		return nil, domain.WithDetail(domain.ErrInvalidRecurringSchedule, "%v", err)
+	}
+
+	// Issues need a description; point readers back to the schedule that filed them
+	description = strings.TrimSpace(description)
+	if description == "" {
+		description = fmt.Sprintf("Recurring maintenance task filed automatically on schedule `%s`.", schedule)
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.recurringRepo.ListByProject(ctx, channel.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if findRecurringIssue(existing, title) != nil {
+		return nil, domain.ErrRecurringIssueExists
+	}
+	if len(existing) >= domain.MaxRecurringIssues {
+		return nil, domain.ErrTooManyRecurringIssues
+	}
+
+	recurring := &domain.RecurringIssue{
+		ID:          uuid.New(),
+		ProjectID:   channel.ProjectID,
+		ChannelID:   channel.ID,
+		Schedule:    schedule,
+		Title:       title,
+		Description: description,
+		Priority:    priority,
+		NextRunAt:   cron.Next(s.now()).UTC(),
+		CreatedBy:   createdBy,
+	}
+	if err := s.recurringRepo.Create(ctx, recurring); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recurring issue added",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("title", title),
+		zap.String("schedule", schedule),
+		zap.Time("next_run_at", recurring.NextRunAt),
+		zap.String("created_by", createdBy),
+	)
+
+	return recurring, nil
+}
+
+// Remove stops a recurring issue of the project registered to a Discord channel
+func (s *recurringIssueService) Remove(ctx context.Context, discordChannelID, title string) (*domain.RecurringIssue, error) {
+	existing, err := s.List(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring := findRecurringIssue(existing, domain.NormalizeRecurringIssueTitle(title))
+	if recurring == nil {
+		return nil, domain.ErrRecurringIssueNotFound
+	}
+
+	if err := s.recurringRepo.Delete(ctx, recurring.ID); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Recurring issue removed",
+		zap.String("project_id", recurring.ProjectID.String()),
+		zap.String("title", recurring.Title),
+	)
+
+	return recurring, nil
+}
+
+// FileDueIssues files an issue for every recurring issue whose time has come
+func (s *recurringIssueService) FileDueIssues(ctx context.Context) error {
+	now := s.now().UTC()
+	due, err := s.recurringRepo.ListDue(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	filed := 0
+	for _, recurring := range due {
+		if err := s.file(ctx, recurring, now); err != nil {
+			s.logger.Error("Failed to file recurring issue",
+				zap.Error(err),
+				zap.String("recurring_issue_id", recurring.ID.String()),
+			)
+			continue
+		}
+		filed++
+	}
+
+	s.logger.Debug("Recurring issues checked",
+		zap.Int("due", len(due)),
+		zap.Int("filed", filed),
+	)
+
+	return nil
+}
+
+// file files one due recurring issue and schedules its next run. The next run is moved
+// on first, so a failure skips one run instead of filing the issue again every check.
+// Issues are not filed while the channel is deactivated or no longer registered.
+func (s *recurringIssueService) file(ctx context.Context, recurring *domain.RecurringIssue, now time.Time) error {
+	cron, err := scheduler.ParseCron(recurring.Schedule, s.location)
+	if err != nil {
+		return domain.WithDetail(domain.ErrInvalidRecurringSchedule, "%v", err)
+	}
+
+	recurring.LastRunAt = &now
+	recurring.NextRunAt = cron.Next(now).UTC()
+	if err := s.recurringRepo.UpdateRun(ctx, recurring); err != nil {
+		return err
+	}
+
+	if recurring.Channel == nil || !recurring.Channel.IsActive {
+		s.logger.Info("Skipped recurring issue in an inactive channel",
+			zap.String("recurring_issue_id", recurring.ID.String()),
+			zap.String("channel_id", recurring.ChannelID.String()),
+		)
+		return nil
+	}
+
+	issue, err := s.issueService.CreateRecurringIssue(ctx, recurring)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Recurring issue filed",
+		zap.String("recurring_issue_id", recurring.ID.String()),
+		zap.String("issue_id", issue.ID.String()),
+		zap.Time("next_run_at", recurring.NextRunAt),
+	)
+
+	return nil
+}
+
+// findRecurringIssue returns the recurring issue with the given normalized title, or nil
+func findRecurringIssue(recurring []*domain.RecurringIssue, title string) *domain.RecurringIssue {
+	for _, r := range recurring {
+		if strings.EqualFold(r.Title, title) {
+			return r
+		}
+	}
+	return nil
+}
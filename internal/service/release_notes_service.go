@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// releaseNotesService implements the ReleaseNotesService interface
+type releaseNotesService struct {
+	channelRepo   domain.ChannelRepository
+	issueRepo     domain.IssueRepository
+	milestoneRepo domain.MilestoneRepository
+	settingsRepo  domain.GuildSettingsRepository
+	now           func() time.Time
+	logger        *zap.Logger
+}
+
+// NewReleaseNotesService creates a new release notes service. Dates are read in UTC unless
+// the guild asking has chosen a time zone.
+func NewReleaseNotesService(
+	channelRepo domain.ChannelRepository,
+	issueRepo domain.IssueRepository,
+	milestoneRepo domain.MilestoneRepository,
+	settingsRepo domain.GuildSettingsRepository,
+	logger *zap.Logger,
+) domain.ReleaseNotesService {
+	return &releaseNotesService{
+		channelRepo:   channelRepo,
+		issueRepo:     issueRepo,
+		milestoneRepo: milestoneRepo,
+		settingsRepo:  settingsRepo,
+		now:           time.Now,
+		logger:        logger,
+	}
+}
+
+// Compile gathers the closed issues of the project registered to a Discord channel that
+// the scope picks, oldest closed first
+func (s *releaseNotesService) Compile(ctx context.Context, discordChannelID string, scope domain.ReleaseNotesScope) (*domain.ReleaseNotes, error) {
+	s.logger.Info("Compiling release notes",
+		zap.String("channel_id", discordChannelID),
+		zap.String("milestone", scope.Milestone),
+		zap.String("from", scope.From),
+		zap.String("to", scope.To),
+	)
+
+	if scope.IsEmpty() {
+		return nil, domain.ErrReleaseNotesScopeRequired
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := guildLocation(ctx, s.settingsRepo, channel.GuildID, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := scope.ParseRange(loc)
+	if err != nil {
+		return nil, err
+	}
+
+	query := domain.IssueQuery{
+		ProjectID:    channel.ProjectID,
+		Statuses:     []domain.Status{domain.StatusClosed},
+		ClosedAfter:  from,
+		ClosedBefore: to,
+	}
+
+	var milestone *domain.Milestone
+	if name := domain.NormalizeMilestoneName(scope.Milestone); name != "" {
+		milestones, err := s.milestoneRepo.ListByProject(ctx, channel.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project milestones: %w", err)
+		}
+		if milestone = findMilestone(milestones, name); milestone == nil {
+			return nil, domain.ErrMilestoneNotFound
+		}
+		query.MilestoneID = milestone.ID
+	}
+
+	issues, _, err := s.issueRepo.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get closed issues: %w", err)
+	}
+	sort.SliceStable(issues, func(a, b int) bool {
+		return closedAt(issues[a]).Before(closedAt(issues[b]))
+	})
+
+	notes := &domain.ReleaseNotes{
+		Project:   &channel.Project,
+		Milestone: milestone,
+		From:      from,
+		To:        to,
+		Location:  loc,
+		Issues:    issues,
+	}
+
+	slug := exportSlug(channel.Project.Name)
+	if milestone != nil {
+		slug += "-" + exportSlug(milestone.Name)
+	}
+	notes.File = &domain.ExportFile{
+		Name:        fmt.Sprintf("%s-release-notes-%s.md", slug, s.now().In(loc).Format("20060102")),
+		ContentType: "text/markdown",
+		Data:        []byte(releaseNotesMarkdown(notes)),
+		IssueCount:  len(issues),
+	}
+
+	s.logger.Info("Release notes compiled",
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.Int("issues", len(issues)),
+	)
+
+	return notes, nil
+}
+
+// releaseNotesMarkdown renders release notes as a Markdown changelog listing each issue's
+// key, title and resolution action
+func releaseNotesMarkdown(notes *domain.ReleaseNotes) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s release notes", notes.Project.Name)
+	if title := notes.Title(); title != "" {
+		fmt.Fprintf(&b, ": %s", title)
+	}
+	b.WriteString("\n\n")
+
+	if len(notes.Issues) == 0 {
+		b.WriteString("No issues were closed.\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "%d issues closed.\n\n", len(notes.Issues))
+	for _, issue := range notes.Issues {
+		fmt.Fprintf(&b, "- **%s** %s", issue.ShortID(), markdownLine(issue.Title))
+		if action := markdownLine(issue.ResolutionAction); action != "" {
+			fmt.Fprintf(&b, " — %s", action)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// markdownLine folds text onto one line so it stays in its list item
+func markdownLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// closedAt returns when an issue was closed, or its last update for issues closed before
+// the closing time was recorded
+func closedAt(issue *domain.Issue) time.Time {
+	if issue.ClosedAt != nil {
+		return *issue.ClosedAt
+	}
+	return issue.UpdatedAt
+}
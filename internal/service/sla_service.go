@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// slaService implements the SLAService interface
+type slaService struct {
+	issueRepo    domain.IssueRepository
+	alertRepo    domain.SLAAlertRepository
+	notifier     domain.SLANotifier
+	settingsRepo domain.GuildSettingsRepository
+	events       domain.EventPublisher
+	now          func() time.Time
+	logger       *zap.Logger
+
+	mu               sync.RWMutex
+	policies         map[domain.Priority]domain.SLAPolicy
+	severityPolicies map[domain.Severity]domain.SLAPolicy
+	warningThreshold float64
+}
+
+// NewSLAService creates a new instance of SLA service.
+// warningThreshold is the fraction of a target (0-1) after which a warning is sent.
+// Issues with a severity follow severityPolicies; guilds can override the policies per
+// priority in their settings.
+func NewSLAService(
+	issueRepo domain.IssueRepository,
+	alertRepo domain.SLAAlertRepository,
+	notifier domain.SLANotifier,
+	settingsRepo domain.GuildSettingsRepository,
+	events domain.EventPublisher,
+	policies map[domain.Priority]domain.SLAPolicy,
+	severityPolicies map[domain.Severity]domain.SLAPolicy,
+	warningThreshold float64,
+	logger *zap.Logger,
+) domain.SLAService {
+	return &slaService{
+		issueRepo:        issueRepo,
+		alertRepo:        alertRepo,
+		notifier:         notifier,
+		settingsRepo:     settingsRepo,
+		events:           events,
+		policies:         policies,
+		severityPolicies: severityPolicies,
+		warningThreshold: warningThreshold,
+		now:              time.Now,
+		logger:           logger,
+	}
+}
+
+// CheckSLAs scans active issues and sends alerts for SLAs that are about to breach or have breached
+func (s *slaService) CheckSLAs(ctx context.Context) error {
+	s.logger.Debug("Checking SLAs")
+
+	issues, err := s.issueRepo.GetByStatuses(ctx, domain.UnresolvedStatuses())
+	if err != nil {
+		s.logger.Error("Failed to get active issues for SLA check", zap.Error(err))
+		return fmt.Errorf("failed to get active issues for SLA check: %w", err)
+	}
+
+	guilds, err := s.settingsRepo.List(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get guild settings for SLA check", zap.Error(err))
+		return fmt.Errorf("failed to get guild settings for SLA check: %w", err)
+	}
+	settingsByGuild := make(map[string]*domain.GuildSettings, len(guilds))
+	for _, settings := range guilds {
+		settingsByGuild[settings.GuildID] = settings
+	}
+
+	s.mu.RLock()
+	policies, severityPolicies, warningThreshold := s.policies, s.severityPolicies, s.warningThreshold
+	s.mu.RUnlock()
+
+	now := s.now()
+	sent := 0
+	for _, issue := range issues {
+		// Incidents are held to the targets of their severity rather than their priority
+		policy, ok := severityPolicies[issue.Severity]
+		if !ok {
+			// Priorities without a policy have zero targets, which are not tracked
+			policy = policies[issue.Priority]
+			if issue.Channel != nil {
+				policy = settingsByGuild[issue.Channel.GuildID].SLAPolicy(issue.Priority, policy)
+			}
+		}
+
+		if domain.IsAwaitingResponse(issue.Status) && s.checkTarget(ctx, issue, domain.SLAKindResponse, policy.Response, warningThreshold, now) {
+			sent++
+		}
+		if domain.IsAwaitingResolution(issue.Status) && s.checkTarget(ctx, issue, domain.SLAKindResolution, policy.Resolution, warningThreshold, now) {
+			sent++
+		}
+	}
+
+	s.logger.Debug("SLA check completed",
+		zap.Int("issues_checked", len(issues)),
+		zap.Int("alerts_sent", sent),
+	)
+
+	return nil
+}
+
+// checkTarget evaluates one SLA target for an issue and sends an alert if needed.
+// It returns true if an alert was sent.
+func (s *slaService) checkTarget(ctx context.Context, issue *domain.Issue, kind domain.SLAKind, target time.Duration, warningThreshold float64, now time.Time) bool {
+	if target <= 0 {
+		return false
+	}
+
+	dueAt := issue.CreatedAt.Add(target)
+	elapsed := now.Sub(issue.CreatedAt)
+
+	var level domain.SLALevel
+	switch {
+	case elapsed >= target:
+		level = domain.SLALevelBreached
+	case elapsed >= time.Duration(float64(target)*warningThreshold):
+		level = domain.SLALevelWarning
+	default:
+		return false
+	}
+
+	exists, err := s.alertRepo.Exists(ctx, issue.ID, kind, level)
+	if err != nil || exists {
+		return false
+	}
+
+	alert := domain.NewSLAAlert(issue.ID, kind, level, dueAt)
+
+	// Only record the alert once it was delivered so failed notifications are retried on the next check
+	if err := s.notifier.NotifySLA(ctx, issue, alert); err != nil {
+		s.logger.Error("Failed to send SLA alert",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("kind", string(kind)),
+			zap.String("level", string(level)),
+		)
+		return false
+	}
+
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		s.logger.Error("Failed to record SLA alert",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	s.logger.Info("SLA alert sent",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("kind", string(kind)),
+		zap.String("level", string(level)),
+		zap.Time("due_at", dueAt),
+	)
+
+	if level == domain.SLALevelBreached {
+		s.events.Publish(ctx, domain.Event{
+			Type:     domain.EventSLABreached,
+			Issue:    issue,
+			SLAAlert: alert,
+		})
+	}
+
+	return true
+}
+
+// SetPolicies replaces the default policies and warning threshold, e.g. when the
+// configuration is reloaded. Alerts already sent are not sent again.
+func (s *slaService) SetPolicies(policies map[domain.Priority]domain.SLAPolicy, severityPolicies map[domain.Severity]domain.SLAPolicy, warningThreshold float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.policies = policies
+	s.severityPolicies = severityPolicies
+	s.warningThreshold = warningThreshold
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// staleIssueService implements the StaleIssueService interface
+type staleIssueService struct {
+	issueRepo    domain.IssueRepository
+	channelRepo  domain.ChannelRepository
+	projectRepo  domain.ProjectRepository
+	issueService domain.IssueService
+	notifier     domain.StaleIssueNotifier
+	policy       domain.StalePolicy
+	now          func() time.Time
+	logger       *zap.Logger
+}
+
+// NewStaleIssueService creates a new stale issue service. policy applies to projects
+// that did not choose their own thresholds.
+func NewStaleIssueService(
+	issueRepo domain.IssueRepository,
+	channelRepo domain.ChannelRepository,
+	projectRepo domain.ProjectRepository,
+	issueService domain.IssueService,
+	notifier domain.StaleIssueNotifier,
+	policy domain.StalePolicy,
+	logger *zap.Logger,
+) domain.StaleIssueService {
+	return &staleIssueService{
+		issueRepo:    issueRepo,
+		channelRepo:  channelRepo,
+		projectRepo:  projectRepo,
+		issueService: issueService,
+		notifier:     notifier,
+		policy:       policy,
+		now:          time.Now,
+		logger:       logger,
+	}
+}
+
+// GetPolicy retrieves the stale thresholds of the main project of a Discord channel
+func (s *staleIssueService) GetPolicy(ctx context.Context, discordChannelID string) (domain.StalePolicy, error) {
+	project, err := s.channelProject(ctx, discordChannelID)
+	if err != nil {
+		return domain.StalePolicy{}, err
+	}
+
+	return project.StalePolicy(s.policy), nil
+}
+
+// SetPolicy sets the stale thresholds in days of the main project of a Discord channel
+func (s *staleIssueService) SetPolicy(ctx context.Context, discordChannelID string, afterDays int, closeAfterDays *int) (domain.StalePolicy, error) {
+	if !domain.IsValidStaleDays(afterDays) || (closeAfterDays != nil && !domain.IsValidStaleDays(*closeAfterDays)) {
+		return domain.StalePolicy{}, domain.ErrInvalidStaleDays
+	}
+
+	return s.update(ctx, discordChannelID, func(project *domain.Project) {
+		project.StaleAfterDays = &afterDays
+		if closeAfterDays != nil {
+			project.StaleCloseAfterDays = closeAfterDays
+		}
+	})
+}
+
+// ResetPolicy makes the main project of a Discord channel use the configured thresholds
+func (s *staleIssueService) ResetPolicy(ctx context.Context, discordChannelID string) (domain.StalePolicy, error) {
+	return s.update(ctx, discordChannelID, func(project *domain.Project) {
+		project.StaleAfterDays = nil
+		project.StaleCloseAfterDays = nil
+	})
+}
+
+// update applies a change to the stale thresholds of a channel's main project
+func (s *staleIssueService) update(ctx context.Context, discordChannelID string, change func(*domain.Project)) (domain.StalePolicy, error) {
+	project, err := s.channelProject(ctx, discordChannelID)
+	if err != nil {
+		return domain.StalePolicy{}, err
+	}
+
+	change(project)
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return domain.StalePolicy{}, err
+	}
+
+	policy := project.StalePolicy(s.policy)
+	s.logger.Info("Stale issue thresholds updated",
+		zap.String("project_id", project.ID.String()),
+		zap.Duration("after", policy.After),
+		zap.Duration("close_after", policy.CloseAfter),
+	)
+
+	return policy, nil
+}
+
+// channelProject retrieves the main project registered to a Discord channel
+func (s *staleIssueService) channelProject(ctx context.Context, discordChannelID string) (*domain.Project, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.projectRepo.GetByID(ctx, channel.ProjectID)
+}
+
+// CheckStaleIssues nudges the assignees of idle unresolved issues and closes the issues
+// that stay idle after their nudge
+func (s *staleIssueService) CheckStaleIssues(ctx context.Context) error {
+	s.logger.Debug("Checking stale issues")
+
+	issues, err := s.issueRepo.GetByStatuses(ctx, domain.UnresolvedStatuses())
+	if err != nil {
+		s.logger.Error("Failed to get active issues for stale check", zap.Error(err))
+		return fmt.Errorf("failed to get active issues for stale check: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.ID
+	}
+	activity, err := s.issueRepo.GetLastActivity(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	nudged, closed := 0, 0
+	for _, issue := range issues {
+		// Issues of deactivated channels have nowhere to be nudged
+		if issue.Channel == nil || !issue.Channel.IsActive {
+			continue
+		}
+
+		lastActivity := issue.CreatedAt
+		if last, ok := activity[issue.ID]; ok && last.After(lastActivity) {
+			lastActivity = last
+		}
+
+		policy := issue.Project.StalePolicy(s.policy)
+		switch issue.StaleState(policy, lastActivity, now) {
+		case domain.StaleStateNudge:
+			if s.nudge(ctx, issue, policy, now) {
+				nudged++
+			}
+		case domain.StaleStateClose:
+			if s.close(ctx, issue, policy) {
+				closed++
+			}
+		}
+	}
+
+	s.logger.Debug("Stale issue check completed",
+		zap.Int("issues_checked", len(issues)),
+		zap.Int("nudged", nudged),
+		zap.Int("closed", closed),
+	)
+
+	return nil
+}
+
+// nudge reminds the assignees of an idle issue. It returns true if the nudge was sent.
+func (s *staleIssueService) nudge(ctx context.Context, issue *domain.Issue, policy domain.StalePolicy, now time.Time) bool {
+	// Only record the nudge once it was delivered so failed nudges are retried on the next check
+	if err := s.notifier.NotifyStale(ctx, issue, policy); err != nil {
+		s.logger.Error("Failed to nudge stale issue",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return false
+	}
+
+	if err := s.issueRepo.SetStaleNudgedAt(ctx, issue.ID, now); err != nil {
+		s.logger.Error("Failed to record stale issue nudge",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	s.logger.Info("Stale issue nudged", zap.String("issue_id", issue.ID.String()))
+	return true
+}
+
+// close closes an issue that stayed idle after its nudge. It returns true if the issue
+// was closed.
+func (s *staleIssueService) close(ctx context.Context, issue *domain.Issue, policy domain.StalePolicy) bool {
+	// Issues that cannot be closed from their status, or still have open sub-tasks, stay
+	// nudged until someone acts on them
+	if !issue.CanTransitionTo(domain.StatusClosed) {
+		return false
+	}
+	if openSubIssues, err := s.issueRepo.CountOpenSubIssues(ctx, issue.ID); err != nil || openSubIssues > 0 {
+		return false
+	}
+
+	// Announce the close first: the thread is archived and locked once the issue is closed
+	if err := s.notifier.NotifyStaleClosed(ctx, issue, policy); err != nil {
+		s.logger.Error("Failed to announce closing of stale issue",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+	}
+
+	if err := s.issueService.CloseStaleIssue(ctx, issue.ID); err != nil {
+		s.logger.Warn("Failed to close stale issue",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return false
+	}
+
+	s.logger.Info("Stale issue closed", zap.String("issue_id", issue.ID.String()))
+	return true
+}
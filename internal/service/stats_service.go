@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// statsService implements the StatsService interface
+type statsService struct {
+	channelRepo   domain.ChannelRepository
+	reportRepo    domain.ReportRepository
+	settingsRepo  domain.GuildSettingsRepository
+	componentRepo domain.ComponentRepository
+	now           func() time.Time
+	logger        *zap.Logger
+}
+
+// NewStatsService creates a new instance of stats service.
+// Days are counted in UTC unless the channel's guild has chosen a time zone.
+func NewStatsService(
+	channelRepo domain.ChannelRepository,
+	reportRepo domain.ReportRepository,
+	settingsRepo domain.GuildSettingsRepository,
+	componentRepo domain.ComponentRepository,
+	logger *zap.Logger,
+) domain.StatsService {
+	return &statsService{
+		channelRepo:   channelRepo,
+		reportRepo:    reportRepo,
+		settingsRepo:  settingsRepo,
+		componentRepo: componentRepo,
+		now:           time.Now,
+		logger:        logger,
+	}
+}
+
+// GetChannelProjectStats computes metrics for the project registered to a Discord channel
+// over the last days, or for one of its components
+func (s *statsService) GetChannelProjectStats(ctx context.Context, discordChannelID string, days int, componentID uuid.UUID) (*domain.ProjectStats, error) {
+	s.logger.Debug("Computing project stats",
+		zap.String("channel_id", discordChannelID),
+		zap.Int("days", days),
+		zap.String("component_id", componentID.String()),
+	)
+
+	if !domain.IsValidStatsRange(days) {
+		return nil, domain.ErrInvalidStatsRange
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	stats := &domain.ProjectStats{
+		Project: &channel.Project,
+		Days:    days,
+		From:    now.AddDate(0, 0, -days),
+		To:      now,
+	}
+	scope := domain.ReportScope{ProjectID: &channel.ProjectID}
+
+	if componentID != uuid.Nil {
+		component, err := s.componentRepo.GetByID(ctx, componentID)
+		if err != nil {
+			return nil, err
+		}
+		// A component of another project would only match issues outside the scope
+		if component.ProjectID != channel.ProjectID {
+			return nil, domain.ErrComponentNotFound
+		}
+		stats.Component = component
+		scope.ComponentID = &component.ID
+	}
+
+	statusCounts, err := s.reportRepo.CountByStatus(ctx, scope, stats.From, stats.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count issues by status: %w", err)
+	}
+	for _, sc := range statusCounts {
+		if sc.Status == domain.StatusClosed {
+			stats.Closed += sc.Count
+		} else {
+			stats.Open += sc.Count
+		}
+	}
+
+	stats.Resolved, stats.MeanResolutionTime, err = s.reportRepo.GetMeanResolutionTime(ctx, scope, stats.From, stats.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute mean resolution time: %w", err)
+	}
+
+	stats.ByPriority, err = s.reportRepo.CountByPriority(ctx, scope, stats.From, stats.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count issues by priority: %w", err)
+	}
+	sort.Slice(stats.ByPriority, func(i, j int) bool {
+		return priorityRank(stats.ByPriority[i].Priority) > priorityRank(stats.ByPriority[j].Priority)
+	})
+
+	loc, err := guildLocation(ctx, s.settingsRepo, channel.GuildID, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	stats.CreatedPerDay, err = s.reportRepo.CountCreatedPerDay(ctx, scope, stats.From, stats.To, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count issues per day: %w", err)
+	}
+
+	stats.Workload, err = s.reportRepo.GetAssigneeWorkload(ctx, scope, stats.From, stats.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute assignee workload: %w", err)
+	}
+
+	stats.TimeByUser, err = s.reportRepo.GetTimeSpentByUser(ctx, scope, stats.From, stats.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute time spent: %w", err)
+	}
+	for _, spent := range stats.TimeByUser {
+		stats.TimeSpent += spent.Duration()
+	}
+
+	csat, err := s.reportRepo.GetCSAT(ctx, scope, stats.From, stats.To)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute CSAT: %w", err)
+	}
+	stats.CSAT = *csat
+
+	return stats, nil
+}
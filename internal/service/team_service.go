@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// routeIssueTimeout bounds suggesting assignees for an issue after an event
+const routeIssueTimeout = 30 * time.Second
+
+// teamService implements the TeamService interface
+type teamService struct {
+	teamRepo        domain.TeamRepository
+	userRepo        domain.UserRepository
+	issueRepo       domain.IssueRepository
+	assigneeService domain.IssueAssigneeService
+	features        domain.FeatureService
+	events          domain.EventPublisher
+	logger          *zap.Logger
+}
+
+// NewTeamService creates a new team service
+func NewTeamService(
+	teamRepo domain.TeamRepository,
+	userRepo domain.UserRepository,
+	issueRepo domain.IssueRepository,
+	assigneeService domain.IssueAssigneeService,
+	features domain.FeatureService,
+	events domain.EventPublisher,
+	logger *zap.Logger,
+) domain.TeamService {
+	return &teamService{
+		teamRepo:        teamRepo,
+		userRepo:        userRepo,
+		issueRepo:       issueRepo,
+		assigneeService: assigneeService,
+		features:        features,
+		events:          events,
+		logger:          logger,
+	}
+}
+
+// ListMembers retrieves a guild's team members with their open issues
+func (s *teamService) ListMembers(ctx context.Context, guildID string) ([]*domain.TeamMember, error) {
+	members, err := s.teamRepo.ListMembers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.countOpenIssues(ctx, members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// SetMember creates or changes the profile of a member of a guild
+func (s *teamService) SetMember(ctx context.Context, guildID, discordID string, update domain.TeamMemberUpdate, updatedBy string) (*domain.TeamMember, error) {
+	// Joining the team does not grant support access; unknown users get the default role
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.teamRepo.GetMember(ctx, guildID, user.ID)
+	if err == domain.ErrTeamMemberNotFound {
+		member = &domain.TeamMember{
+			ID:          uuid.New(),
+			GuildID:     guildID,
+			UserID:      user.ID,
+			DefaultRole: domain.AssigneeRoleDev,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	if update.Name != "" {
+		member.Name = update.Name
+	}
+	if update.DefaultRole != nil {
+		if !update.DefaultRole.IsValid() {
+			return nil, domain.ErrInvalidAssigneeRole
+		}
+		member.DefaultRole = *update.DefaultRole
+	}
+	if update.Skills != nil {
+		skills, err := domain.ParseSkills(*update.Skills)
+		if err != nil {
+			return nil, err
+		}
+		member.Skills = skills
+	}
+	if update.Capacity != nil {
+		if *update.Capacity < 0 {
+			return nil, domain.ErrInvalidCapacity
+		}
+		member.Capacity = *update.Capacity
+	}
+	member.UpdatedBy = updatedBy
+	member.UpdatedAt = time.Now()
+
+	if err := s.teamRepo.SaveMember(ctx, member); err != nil {
+		return nil, err
+	}
+	member.User = *user
+
+	s.logger.Info("Team member saved",
+		zap.String("guild_id", guildID),
+		zap.String("discord_id", discordID),
+		zap.String("default_role", string(member.DefaultRole)),
+		zap.Strings("skills", member.Skills),
+		zap.Int("capacity", member.Capacity),
+		zap.String("updated_by", updatedBy),
+	)
+
+	if err := s.countOpenIssues(ctx, []*domain.TeamMember{member}); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveMember deletes the profile of a member of a guild
+func (s *teamService) RemoveMember(ctx context.Context, guildID, discordID string) error {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err == domain.ErrUserNotFound {
+		return domain.ErrTeamMemberNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if err := s.teamRepo.DeleteMember(ctx, guildID, user.ID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Team member removed",
+		zap.String("guild_id", guildID),
+		zap.String("discord_id", discordID),
+	)
+	return nil
+}
+
+// SuggestAssignees ranks the team members of the issue's guild for it and stores the best
+// of them as its suggested assignees
+func (s *teamService) SuggestAssignees(ctx context.Context, issueID uuid.UUID) ([]domain.IssueSuggestedAssignee, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.suggest(ctx, issue)
+}
+
+// suggest stores the suggested assignees of an issue and publishes them if they changed
+func (s *teamService) suggest(ctx context.Context, issue *domain.Issue) ([]domain.IssueSuggestedAssignee, error) {
+	guildID := issueGuildID(issue)
+	if guildID == "" {
+		return nil, nil
+	}
+
+	members, err := s.teamRepo.ListMembers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.countOpenIssues(ctx, members); err != nil {
+		return nil, err
+	}
+
+	issueSkills := domain.IssueSkills(issue)
+	var candidates []domain.AssigneeCandidate
+	for _, member := range members {
+		assigned := slices.ContainsFunc(issue.Assignees, func(a domain.IssueAssignee) bool {
+			return a.UserID == member.UserID
+		})
+		if assigned || !member.HasCapacity() {
+			continue
+		}
+		candidates = append(candidates, domain.AssigneeCandidate{
+			Member:  member,
+			Matched: member.MatchedSkills(issueSkills),
+			Lead:    issue.IsComponentLead(member.UserID),
+		})
+	}
+	domain.RankCandidates(candidates)
+
+	suggestions := make([]domain.IssueSuggestedAssignee, 0, domain.MaxSuggestedAssignees)
+	for position, candidate := range candidates[:min(len(candidates), domain.MaxSuggestedAssignees)] {
+		suggestions = append(suggestions, domain.IssueSuggestedAssignee{
+			ID:       uuid.New(),
+			IssueID:  issue.ID,
+			UserID:   candidate.Member.UserID,
+			Name:     candidate.Member.Name,
+			Role:     candidate.Member.DefaultRole,
+			Position: position,
+			Matched:  candidate.Matched,
+			User:     candidate.Member.User,
+		})
+	}
+
+	// Nothing to store or show when neither the issue nor its guild's team had any
+	if len(suggestions) == 0 && len(issue.SuggestedAssignees) == 0 {
+		return suggestions, nil
+	}
+
+	if err := s.teamRepo.ReplaceSuggestions(ctx, issue.ID, suggestions); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Assignees suggested",
+		zap.String("issue_id", issue.ID.String()),
+		zap.Int("candidates", len(candidates)),
+		zap.Int("suggested", len(suggestions)),
+	)
+
+	issue.SuggestedAssignees = suggestions
+	s.events.Publish(ctx, domain.Event{Type: domain.EventAssigneesSuggested, Issue: issue})
+	return suggestions, nil
+}
+
+// HandleIssueEvent suggests assignees for issues opened without a developer, created open,
+// opened from a draft or filed under another component while unresolved, and auto-assigns
+// the best of them where the guild turned it on
+func (s *teamService) HandleIssueEvent(_ context.Context, event domain.Event) {
+	switch event.Type {
+	case domain.EventIssueCreated:
+		if event.Issue.Status == domain.StatusDraft || !domain.IsAwaitingResponse(event.Issue.Status) {
+			return
+		}
+	case domain.EventIssueStatusChanged:
+		if event.OldStatus != domain.StatusDraft {
+			return
+		}
+	case domain.EventIssueComponentChanged:
+		if event.Issue.Status == domain.StatusDraft || !domain.IsAwaitingResolution(event.Issue.Status) {
+			return
+		}
+	default:
+		return
+	}
+
+	issueID := event.Issue.ID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), routeIssueTimeout)
+		defer cancel()
+
+		if err := s.route(ctx, issueID); err != nil {
+			s.logger.Error("Failed to route issue to team members",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+		}
+	}()
+}
+
+// route suggests assignees for an issue without a developer and, with FeatureAutoAssign,
+// assigns the first suggestion
+func (s *teamService) route(ctx context.Context, issueID uuid.UUID) error {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return err
+	}
+	if len(issue.GetDevelopers()) > 0 {
+		return nil
+	}
+
+	suggestions, err := s.suggest(ctx, issue)
+	if err != nil || len(suggestions) == 0 {
+		return err
+	}
+	if !s.features.IsEnabled(ctx, issueGuildID(issue), domain.FeatureAutoAssign) {
+		return nil
+	}
+
+	best := suggestions[0]
+	assignee, err := s.assigneeService.AssignUserToIssue(ctx, issue.ID, best.User.DiscordID, best.Role)
+	if err != nil {
+		return err
+	}
+	assignee.User = best.User
+
+	s.logger.Info("Issue assigned to team member",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("discord_id", best.User.DiscordID),
+		zap.Strings("matched", best.Matched),
+	)
+
+	s.events.Publish(ctx, domain.Event{Type: domain.EventIssueRouted, Issue: issue, Assignee: assignee})
+	return nil
+}
+
+// countOpenIssues fills in the open issues of team members
+func (s *teamService) countOpenIssues(ctx context.Context, members []*domain.TeamMember) error {
+	userIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		userIDs = append(userIDs, member.UserID)
+	}
+
+	counts, err := s.teamRepo.CountOpenIssues(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		member.OpenIssues = counts[member.UserID]
+	}
+	return nil
+}
+
+// issueGuildID returns the guild an issue belongs to: that of its channel, or else that of
+// its project's customer, which is empty for customers added through the REST API
+func issueGuildID(issue *domain.Issue) string {
+	if issue.Channel != nil {
+		return issue.Channel.GuildID
+	}
+	return issue.Project.Customer.GuildID
+}
@@ -139,44 +139,17 @@ func (s *userService) GetOrCreateUserByDiscordID(ctx context.Context, discordID,
 		zap.String("name", name),
 	)
 
-	// Try to get existing user
-	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
-	if err != nil && err != domain.ErrUserNotFound {
-		s.logger.Error("Failed to check existing user",
-			zap.Error(err),
-			zap.String("discord_id", discordID),
-		)
-		return nil, fmt.Errorf("failed to check existing user: %w", err)
-	}
-
-	// If user exists, return it
-	if user != nil {
-		s.logger.Debug("User found", zap.String("discord_id", discordID))
-		return user, nil
-	}
-
-	// Create new user with default role
-	newUser := &domain.User{
-		ID:        uuid.New(),
-		Name:      name,
-		DiscordID: discordID,
-		Role:      domain.UserRoleCustomer, // Default role
-	}
-
-	if err := s.userRepo.Create(ctx, newUser); err != nil {
-		s.logger.Error("Failed to create new user",
+	// New users get the default customer role
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, name, domain.UserRoleCustomer)
+	if err != nil {
+		s.logger.Error("Failed to get or create user",
 			zap.Error(err),
 			zap.String("discord_id", discordID),
 		)
-		return nil, fmt.Errorf("failed to create new user: %w", err)
+		return nil, fmt.Errorf("failed to get or create user: %w", err)
 	}
 
-	s.logger.Info("New user created",
-		zap.String("user_id", newUser.ID.String()),
-		zap.String("discord_id", discordID),
-	)
-
-	return newUser, nil
+	return user, nil
 }
 
 // UpdateUser updates user information
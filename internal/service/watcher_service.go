@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// watcherService implements the WatcherService interface
+type watcherService struct {
+	watcherRepo domain.IssueWatcherRepository
+	issueRepo   domain.IssueRepository
+	userRepo    domain.UserRepository
+	logger      *zap.Logger
+}
+
+// NewWatcherService creates a new instance of watcher service
+func NewWatcherService(
+	watcherRepo domain.IssueWatcherRepository,
+	issueRepo domain.IssueRepository,
+	userRepo domain.UserRepository,
+	logger *zap.Logger,
+) domain.WatcherService {
+	return &watcherService{
+		watcherRepo: watcherRepo,
+		issueRepo:   issueRepo,
+		userRepo:    userRepo,
+		logger:      logger,
+	}
+}
+
+// Watch subscribes a Discord user to the status changes and comments of an issue
+func (s *watcherService) Watch(ctx context.Context, issueID uuid.UUID, discordID string) error {
+	if _, err := s.issueRepo.GetByID(ctx, issueID); err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleCustomer)
+	if err != nil {
+		return err
+	}
+
+	if err := s.watcherRepo.Create(ctx, &domain.IssueWatcher{
+		ID:      uuid.New(),
+		IssueID: issueID,
+		UserID:  user.ID,
+	}); err != nil {
+		return err
+	}
+
+	s.logger.Info("Issue watched",
+		zap.String("issue_id", issueID.String()),
+		zap.String("discord_id", discordID),
+	)
+
+	return nil
+}
+
+// Unwatch ends a Discord user's subscription to an issue
+func (s *watcherService) Unwatch(ctx context.Context, issueID uuid.UUID, discordID string) error {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err == domain.ErrUserNotFound {
+		return domain.ErrNotWatching
+	} else if err != nil {
+		return err
+	}
+
+	if err := s.watcherRepo.Delete(ctx, issueID, user.ID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Issue unwatched",
+		zap.String("issue_id", issueID.String()),
+		zap.String("discord_id", discordID),
+	)
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// webhookService implements the WebhookService interface
+type webhookService struct {
+	channelRepo domain.ChannelRepository
+	webhookRepo domain.ProjectWebhookRepository
+	lookupHost  func(ctx context.Context, host string) ([]netip.Addr, error) // Resolves webhook hosts; replaced in tests
+	logger      *zap.Logger
+}
+
+// NewWebhookService creates a new instance of webhook service
+func NewWebhookService(channelRepo domain.ChannelRepository, webhookRepo domain.ProjectWebhookRepository, logger *zap.Logger) domain.WebhookService {
+	return &webhookService{
+		channelRepo: channelRepo,
+		webhookRepo: webhookRepo,
+		lookupHost: func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		},
+		logger: logger,
+	}
+}
+
+// RegisterWebhook adds a webhook to the project registered to a Discord channel.
+// The returned webhook carries the secret deliveries are signed with.
+func (s *webhookService) RegisterWebhook(ctx context.Context, discordChannelID, url, createdBy string) (*domain.ProjectWebhook, error) {
+	url = strings.TrimSpace(url)
+	if !domain.IsValidWebhookURL(url) {
+		return nil, domain.ErrInvalidWebhookURL
+	}
+	if err := s.checkPublicHost(ctx, url); err != nil {
+		return nil, err
+	}
+
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.webhookRepo.GetByProjectAndURL(ctx, channel.ProjectID, url); err == nil {
+		return nil, domain.ErrWebhookAlreadyExists
+	} else if err != domain.ErrWebhookNotFound {
+		return nil, fmt.Errorf("failed to check existing webhook: %w", err)
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	webhook := &domain.ProjectWebhook{
+		ID:        uuid.New(),
+		ProjectID: channel.ProjectID,
+		URL:       url,
+		Secret:    secret,
+		CreatedBy: createdBy,
+	}
+	if err := s.webhookRepo.Create(ctx, webhook); err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	s.logger.Info("Webhook registered",
+		zap.String("webhook_id", webhook.ID.String()),
+		zap.String("project_id", channel.ProjectID.String()),
+		zap.String("created_by", createdBy),
+	)
+
+	return webhook, nil
+}
+
+// RemoveWebhook removes a webhook from the project registered to a Discord channel
+func (s *webhookService) RemoveWebhook(ctx context.Context, discordChannelID, url string) error {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return err
+	}
+
+	webhook, err := s.webhookRepo.GetByProjectAndURL(ctx, channel.ProjectID, strings.TrimSpace(url))
+	if err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.Delete(ctx, webhook.ID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Webhook removed",
+		zap.String("webhook_id", webhook.ID.String()),
+		zap.String("project_id", channel.ProjectID.String()),
+	)
+
+	return nil
+}
+
+// ListWebhooks lists the webhooks of the project registered to a Discord channel
+func (s *webhookService) ListWebhooks(ctx context.Context, discordChannelID string) ([]*domain.ProjectWebhook, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepo.ListByProject(ctx, channel.ProjectID)
+}
+
+// checkPublicHost resolves the host of a webhook URL and rejects it unless every address
+// it resolves to is public. Deliveries check the address they connect to again, as the
+// host may resolve differently by then.
+func (s *webhookService) checkPublicHost(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return domain.ErrInvalidWebhookURL
+	}
+
+	addrs, err := s.lookupHost(ctx, u.Hostname())
+	if err != nil {
+		s.logger.Debug("Failed to resolve webhook host",
+			zap.Error(err),
+			zap.String("host", u.Hostname()),
+		)
+		return domain.ErrWebhookHostNotPublic
+	}
+	for _, addr := range addrs {
+		if !domain.IsPublicWebhookAddr(addr) {
+			s.logger.Info("Refused webhook to non-public address",
+				zap.String("host", u.Hostname()),
+				zap.String("address", addr.String()),
+			)
+			return domain.ErrWebhookHostNotPublic
+		}
+	}
+	if len(addrs) == 0 {
+		return domain.ErrWebhookHostNotPublic
+	}
+	return nil
+}
+
+// newWebhookSecret returns a random hex-encoded signing secret
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// workflowService implements the WorkflowService interface
+type workflowService struct {
+	channelRepo  domain.ChannelRepository
+	workflowRepo domain.WorkflowRepository
+	issueRepo    domain.IssueRepository
+	logger       *zap.Logger
+}
+
+// NewWorkflowService creates a new instance of workflow service
+func NewWorkflowService(channelRepo domain.ChannelRepository, workflowRepo domain.WorkflowRepository, issueRepo domain.IssueRepository, logger *zap.Logger) domain.WorkflowService {
+	return &workflowService{
+		channelRepo:  channelRepo,
+		workflowRepo: workflowRepo,
+		issueRepo:    issueRepo,
+		logger:       logger,
+	}
+}
+
+// GetWorkflow retrieves the workflow of the project registered to a Discord channel,
+// or nil when the project uses the built-in workflow
+func (s *workflowService) GetWorkflow(ctx context.Context, discordChannelID string) (*domain.WorkflowDefinition, error) {
+	channel, err := s.getChannel(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := s.workflowRepo.GetByProjectID(ctx, channel.ProjectID)
+	if errors.Is(err, domain.ErrWorkflowNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to get workflow",
+			zap.Error(err),
+			zap.String("project_id", channel.ProjectID.String()),
+		)
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+	return workflow, nil
+}
+
+// AddStatus adds a custom status to the project's workflow, creating the workflow if needed
+func (s *workflowService) AddStatus(ctx context.Context, discordChannelID, name, createdBy string) (*domain.WorkflowStatus, error) {
+	key, err := domain.NormalizeWorkflowStatus(name)
+	if err != nil {
+		return nil, err
+	}
+	if domain.IsBuiltInStatus(key) {
+		return nil, domain.ErrBuiltInWorkflowStatus
+	}
+
+	workflow, err := s.getOrCreateWorkflow(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if workflow.CustomStatus(key) != nil {
+		return nil, domain.ErrWorkflowStatusExists
+	}
+	if len(workflow.Statuses) >= domain.MaxCustomStatuses {
+		return nil, domain.ErrTooManyWorkflowStatuses
+	}
+
+	status := &domain.WorkflowStatus{
+		ID:         uuid.New(),
+		WorkflowID: workflow.ID,
+		Status:     key,
+		Name:       strings.Join(strings.Fields(name), " "),
+		CreatedBy:  createdBy,
+	}
+	if err := s.workflowRepo.AddStatus(ctx, status); err != nil {
+		s.logger.Error("Failed to add workflow status",
+			zap.Error(err),
+			zap.String("project_id", workflow.ProjectID.String()),
+			zap.String("status", string(key)),
+		)
+		return nil, fmt.Errorf("failed to add workflow status: %w", err)
+	}
+
+	s.logger.Info("Workflow status added",
+		zap.String("project_id", workflow.ProjectID.String()),
+		zap.String("status", string(key)),
+		zap.String("created_by", createdBy),
+	)
+
+	return status, nil
+}
+
+// RemoveStatus removes a custom status and its transitions from the project's workflow
+func (s *workflowService) RemoveStatus(ctx context.Context, discordChannelID, name string) error {
+	key, err := domain.NormalizeWorkflowStatus(name)
+	if err != nil {
+		return err
+	}
+	if domain.IsBuiltInStatus(key) {
+		return domain.ErrBuiltInWorkflowStatus
+	}
+
+	workflow, err := s.GetWorkflow(ctx, discordChannelID)
+	if err != nil {
+		return err
+	}
+	if workflow.CustomStatus(key) == nil {
+		return domain.ErrWorkflowStatusNotFound
+	}
+
+	if err := s.ensureStatusesUnused(ctx, workflow.ProjectID, []domain.Status{key}); err != nil {
+		return err
+	}
+
+	if err := s.workflowRepo.RemoveStatus(ctx, workflow.ID, key); err != nil {
+		s.logger.Error("Failed to remove workflow status",
+			zap.Error(err),
+			zap.String("project_id", workflow.ProjectID.String()),
+			zap.String("status", string(key)),
+		)
+		return fmt.Errorf("failed to remove workflow status: %w", err)
+	}
+
+	s.logger.Info("Workflow status removed",
+		zap.String("project_id", workflow.ProjectID.String()),
+		zap.String("status", string(key)),
+	)
+
+	return nil
+}
+
+// AddTransition allows issues of the project to move between two statuses
+func (s *workflowService) AddTransition(ctx context.Context, discordChannelID, from, to, createdBy string) (*domain.WorkflowTransition, error) {
+	fromKey, err := domain.NormalizeWorkflowStatus(from)
+	if err != nil {
+		return nil, err
+	}
+	toKey, err := domain.NormalizeWorkflowStatus(to)
+	if err != nil {
+		return nil, err
+	}
+	if fromKey == toKey {
+		return nil, domain.ErrInvalidWorkflowTransition
+	}
+
+	workflow, err := s.getOrCreateWorkflow(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if !workflow.HasStatus(fromKey) || !workflow.HasStatus(toKey) {
+		return nil, domain.ErrWorkflowStatusNotFound
+	}
+	if workflow.CanTransition(&fromKey, toKey) {
+		return nil, domain.ErrWorkflowTransitionExists
+	}
+
+	transition := &domain.WorkflowTransition{
+		ID:         uuid.New(),
+		WorkflowID: workflow.ID,
+		FromStatus: fromKey,
+		ToStatus:   toKey,
+		CreatedBy:  createdBy,
+	}
+	if err := s.workflowRepo.AddTransition(ctx, transition); err != nil {
+		s.logger.Error("Failed to add workflow transition",
+			zap.Error(err),
+			zap.String("project_id", workflow.ProjectID.String()),
+			zap.String("from", string(fromKey)),
+			zap.String("to", string(toKey)),
+		)
+		return nil, fmt.Errorf("failed to add workflow transition: %w", err)
+	}
+
+	s.logger.Info("Workflow transition added",
+		zap.String("project_id", workflow.ProjectID.String()),
+		zap.String("from", string(fromKey)),
+		zap.String("to", string(toKey)),
+		zap.String("created_by", createdBy),
+	)
+
+	return transition, nil
+}
+
+// RemoveTransition removes a custom transition from the project's workflow
+func (s *workflowService) RemoveTransition(ctx context.Context, discordChannelID, from, to string) error {
+	fromKey, err := domain.NormalizeWorkflowStatus(from)
+	if err != nil {
+		return err
+	}
+	toKey, err := domain.NormalizeWorkflowStatus(to)
+	if err != nil {
+		return err
+	}
+
+	workflow, err := s.GetWorkflow(ctx, discordChannelID)
+	if err != nil {
+		return err
+	}
+	if workflow == nil {
+		return domain.ErrWorkflowTransitionNotFound
+	}
+
+	removed, err := s.workflowRepo.RemoveTransition(ctx, workflow.ID, fromKey, toKey)
+	if err != nil {
+		s.logger.Error("Failed to remove workflow transition",
+			zap.Error(err),
+			zap.String("project_id", workflow.ProjectID.String()),
+			zap.String("from", string(fromKey)),
+			zap.String("to", string(toKey)),
+		)
+		return fmt.Errorf("failed to remove workflow transition: %w", err)
+	}
+	if removed == 0 {
+		return domain.ErrWorkflowTransitionNotFound
+	}
+
+	s.logger.Info("Workflow transition removed",
+		zap.String("project_id", workflow.ProjectID.String()),
+		zap.String("from", string(fromKey)),
+		zap.String("to", string(toKey)),
+	)
+
+	return nil
+}
+
+// ResetWorkflow returns the project to the built-in workflow
+func (s *workflowService) ResetWorkflow(ctx context.Context, discordChannelID string) error {
+	workflow, err := s.GetWorkflow(ctx, discordChannelID)
+	if err != nil || workflow == nil {
+		return err
+	}
+
+	var custom []domain.Status
+	for _, status := range workflow.Statuses {
+		custom = append(custom, status.Status)
+	}
+	if len(custom) > 0 {
+		if err := s.ensureStatusesUnused(ctx, workflow.ProjectID, custom); err != nil {
+			return err
+		}
+	}
+
+	if err := s.workflowRepo.Delete(ctx, workflow.ID); err != nil {
+		s.logger.Error("Failed to reset workflow",
+			zap.Error(err),
+			zap.String("project_id", workflow.ProjectID.String()),
+		)
+		return fmt.Errorf("failed to reset workflow: %w", err)
+	}
+
+	s.logger.Info("Workflow reset", zap.String("project_id", workflow.ProjectID.String()))
+	return nil
+}
+
+// getOrCreateWorkflow retrieves the project's workflow, creating an empty one if the
+// project still uses the built-in workflow
+func (s *workflowService) getOrCreateWorkflow(ctx context.Context, discordChannelID string) (*domain.WorkflowDefinition, error) {
+	channel, err := s.getChannel(ctx, discordChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := s.workflowRepo.GetByProjectID(ctx, channel.ProjectID)
+	if err == nil {
+		return workflow, nil
+	}
+	if !errors.Is(err, domain.ErrWorkflowNotFound) {
+		s.logger.Error("Failed to get workflow",
+			zap.Error(err),
+			zap.String("project_id", channel.ProjectID.String()),
+		)
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	workflow = &domain.WorkflowDefinition{
+		ID:        uuid.New(),
+		ProjectID: channel.ProjectID,
+	}
+	if err := s.workflowRepo.Create(ctx, workflow); err != nil {
+		s.logger.Error("Failed to create workflow",
+			zap.Error(err),
+			zap.String("project_id", channel.ProjectID.String()),
+		)
+		return nil, fmt.Errorf("failed to create workflow: %w", err)
+	}
+	return workflow, nil
+}
+
+// getChannel retrieves the registration of a Discord channel
+func (s *workflowService) getChannel(ctx context.Context, discordChannelID string) (*domain.Channel, error) {
+	channel, err := s.channelRepo.GetByChannelID(ctx, discordChannelID)
+	if err != nil {
+		s.logger.Error("Failed to get channel for workflow",
+			zap.Error(err),
+			zap.String("channel_id", discordChannelID),
+		)
+		return nil, fmt.Errorf("failed to get channel for workflow: %w", err)
+	}
+	return channel, nil
+}
+
+// ensureStatusesUnused returns ErrWorkflowStatusInUse if issues of the project have one of the statuses
+func (s *workflowService) ensureStatusesUnused(ctx context.Context, projectID uuid.UUID, statuses []domain.Status) error {
+	count, err := s.issueRepo.CountByProjectIDAndStatuses(ctx, projectID, statuses)
+	if err != nil {
+		s.logger.Error("Failed to count issues by status",
+			zap.Error(err),
+			zap.String("project_id", projectID.String()),
+		)
+		return fmt.Errorf("failed to count issues by status: %w", err)
+	}
+	if count > 0 {
+		return domain.WithDetail(domain.ErrWorkflowStatusInUse, "%d issues", count)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// worklogService implements the WorklogService interface
+type worklogService struct {
+	issueRepo   domain.IssueRepository
+	userRepo    domain.UserRepository
+	worklogRepo domain.IssueWorklogRepository
+	now         func() time.Time
+	logger      *zap.Logger
+}
+
+// NewWorklogService creates a new instance of worklog service
+func NewWorklogService(
+	issueRepo domain.IssueRepository,
+	userRepo domain.UserRepository,
+	worklogRepo domain.IssueWorklogRepository,
+	logger *zap.Logger,
+) domain.WorklogService {
+	return &worklogService{
+		issueRepo:   issueRepo,
+		userRepo:    userRepo,
+		worklogRepo: worklogRepo,
+		now:         time.Now,
+		logger:      logger,
+	}
+}
+
+// StartTimer starts a timer on an issue for a Discord user
+func (s *worklogService) StartTimer(ctx context.Context, issueID uuid.UUID, discordID string) (*domain.IssueWorklog, error) {
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if issue.IsClosed() {
+		return nil, domain.ErrTimerOnClosedIssue
+	}
+
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.worklogRepo.GetRunningByUser(ctx, user.ID); err == nil {
+		return nil, domain.ErrTimerRunning
+	} else if err != domain.ErrNoTimerRunning {
+		return nil, err
+	}
+
+	worklog := &domain.IssueWorklog{
+		ID:        uuid.New(),
+		IssueID:   issue.ID,
+		UserID:    user.ID,
+		StartedAt: s.now(),
+	}
+	if err := s.worklogRepo.Create(ctx, worklog); err != nil {
+		return nil, err
+	}
+	worklog.Issue = issue
+
+	s.logger.Info("Timer started",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("discord_id", discordID),
+	)
+
+	return worklog, nil
+}
+
+// StopTimer stops the timer a Discord user is running and records the time spent
+func (s *worklogService) StopTimer(ctx context.Context, discordID string) (*domain.IssueWorklog, error) {
+	user, err := s.userRepo.GetByDiscordID(ctx, discordID)
+	if err == domain.ErrUserNotFound {
+		return nil, domain.ErrNoTimerRunning
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	worklog, err := s.worklogRepo.GetRunningByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	worklog.Stop(s.now())
+	if err := s.worklogRepo.Stop(ctx, worklog); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Timer stopped",
+		zap.String("issue_id", worklog.IssueID.String()),
+		zap.String("discord_id", discordID),
+		zap.Duration("spent", worklog.Duration()),
+	)
+
+	return worklog, nil
+}
+
+// LogTime records time a Discord user spent on an issue without a timer. The entry ends now.
+func (s *worklogService) LogTime(ctx context.Context, issueID uuid.UUID, discordID string, spent time.Duration, note string) (*domain.IssueWorklog, error) {
+	if !domain.IsValidWorklogDuration(spent) {
+		return nil, domain.ErrInvalidWorklogDuration
+	}
+	note = domain.NormalizeWorklogNote(note)
+	if !domain.IsValidWorklogNote(note) {
+		return nil, domain.ErrInvalidWorklogNote
+	}
+
+	issue, err := s.issueRepo.GetByID(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetOrCreateByDiscordID(ctx, discordID, "", domain.UserRoleCustomer)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	worklog := &domain.IssueWorklog{
+		ID:        uuid.New(),
+		IssueID:   issue.ID,
+		UserID:    user.ID,
+		StartedAt: now.Add(-spent),
+		EndedAt:   &now,
+		Seconds:   int64(spent / time.Second),
+		Note:      note,
+	}
+	if err := s.worklogRepo.Create(ctx, worklog); err != nil {
+		return nil, err
+	}
+	worklog.Issue = issue
+
+	s.logger.Info("Time logged",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("discord_id", discordID),
+		zap.Duration("spent", spent),
+	)
+
+	return worklog, nil
+}
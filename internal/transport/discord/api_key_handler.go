@@ -0,0 +1,111 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleAPIKeyCommand handles the /apikey slash command and its create, list and revoke
+// subcommands. Keys are issued for the customer of the channel's main project.
+func (h *Handler) handleAPIKeyCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: create, list or revoke."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling API key command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageAPIKeys) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+
+	switch subcommand.Name {
+	case "create":
+		key, token, err := h.apiKeyService.CreateKey(ctx, channelID, args["name"], args["scopes"], i.Member.User.ID)
+		if err != nil {
+			h.respondAPIKeyError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🔑 API key **%s** created for this project's customer.\n\n"+
+			"Scopes: %s\n"+
+			"Key (shown only once): ||`%s`||\n"+
+			"Send it as an `Authorization: Bearer <key>` header. Revoke it with `/apikey revoke %s`.",
+			key.Name, formatAPIKeyScopes(key), token, key.Prefix), true)
+	case "list":
+		keys, err := h.apiKeyService.ListKeys(ctx, channelID)
+		if err != nil {
+			h.respondAPIKeyError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, formatAPIKeys(ctx, keys), true)
+	case "revoke":
+		key, err := h.apiKeyService.RevokeKey(ctx, channelID, args["prefix"])
+		if err != nil {
+			h.respondAPIKeyError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🔑 Revoked API key **%s** (`%s`). Requests using it are now rejected.", key.Name, key.Prefix), true)
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// respondAPIKeyError explains why an API key command failed
+func (h *Handler) respondAPIKeyError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ This customer has no active API key with that prefix. Use `/apikey list` to see them."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to manage API keys. Please try again."))
+	}
+}
+
+// formatAPIKeys describes a customer's active API keys for /apikey list
+func formatAPIKeys(ctx context.Context, keys []*domain.APIKey) string {
+	if len(keys) == 0 {
+		return i18n.T(ctx, "🔑 This project's customer has no API keys. Create one with `/apikey create`.")
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "🔑 **API keys (%d):**\n", len(keys)))
+	for _, key := range keys {
+		used := i18n.T(ctx, "never used")
+		if key.LastUsedAt != nil {
+			used = i18n.T(ctx, "last used <t:%d:R>", key.LastUsedAt.Unix())
+		}
+		content.WriteString(i18n.T(ctx, "• **%s** `%s` · %s · created <t:%d:R> · %s\n",
+			truncateText(key.Name, 40), key.Prefix, formatAPIKeyScopes(key), key.CreatedAt.Unix(), used))
+	}
+	return content.String()
+}
+
+// formatAPIKeyScopes lists the scopes of a key as code spans
+func formatAPIKeyScopes(key *domain.APIKey) string {
+	scopes := key.ScopeList()
+	parts := make([]string, len(scopes))
+	for n, scope := range scopes {
+		parts[n] = fmt.Sprintf("`%s`", scope)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,312 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxAssigneesPerSelection limits how many users can be picked in one /assign flow
+const maxAssigneesPerSelection = 5
+
+// resolveIssue finds an issue by its key (e.g. ACME-42), its full UUID or an ID prefix within
+// the given channel. Issues posted in another guild are reported as not found.
+func (h *Handler) resolveIssue(ctx context.Context, guildID, channelID, idStr string) (*domain.Issue, error) {
+	issue, err := h.lookupIssue(ctx, channelID, idStr)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Channel != nil && issue.Channel.GuildID != guildID {
+		return nil, domain.ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+// lookupIssue finds an issue by its key, its full UUID or an ID prefix within the given channel
+func (h *Handler) lookupIssue(ctx context.Context, channelID, idStr string) (*domain.Issue, error) {
+	idStr = strings.TrimSpace(idStr)
+
+	if _, ok := domain.NormalizeIssueKey(idStr); ok {
+		issue, err := h.issueService.GetIssueByKey(ctx, idStr)
+		if err != nil {
+			return nil, err
+		}
+		return h.issueInChannel(ctx, channelID, issue)
+	}
+
+	if issueID, err := uuid.Parse(idStr); err == nil {
+		issue, err := h.issueService.GetIssue(ctx, issueID)
+		if err != nil {
+			return nil, err
+		}
+		return h.issueInChannel(ctx, channelID, issue)
+	}
+
+	if idStr == "" {
+		return nil, domain.ErrIssueNotFound
+	}
+
+	issues, err := h.issueService.SearchIssuesByPartialID(ctx, idStr, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues by prefix: %w", err)
+	}
+	if len(issues) == 0 {
+		return nil, domain.ErrIssueNotFound
+	}
+
+	// Reload the newest match to get assignees and other relationships
+	return h.issueService.GetIssue(ctx, issues[0].ID)
+}
+
+// issueInChannel reports an issue as not found unless it was posted in the channel, in its
+// own thread or in one of the channel's projects, the same scope ID prefixes are searched in
+func (h *Handler) issueInChannel(ctx context.Context, channelID string, issue *domain.Issue) (*domain.Issue, error) {
+	if issue.ThreadID == channelID || (issue.Channel != nil && issue.Channel.DiscordChannelID == channelID) {
+		return issue, nil
+	}
+
+	channel, err := h.channelService.GetChannelRegistration(ctx, channelID)
+	if err != nil {
+		if errors.Is(err, domain.ErrChannelNotFound) {
+			return nil, domain.ErrIssueNotFound
+		}
+		return nil, fmt.Errorf("failed to get channel of issue lookup: %w", err)
+	}
+	if !channel.HasProject(issue.ProjectID) {
+		return nil, domain.ErrIssueNotFound
+	}
+	return issue, nil
+}
+
+// handleAssignCommand handles the /assign slash command
+func (h *Handler) handleAssignCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling assign command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please provide an issue ID."), true)
+		return
+	}
+
+	idStr := options[0].StringValue()
+	issue, err := h.resolveIssue(ctx, i.GuildID, i.ChannelID, idStr)
+	if err != nil {
+		if err == domain.ErrIssueNotFound {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ No issue found with ID: `%s`", idStr), true)
+			return
+		}
+		h.logger.Error("Failed to resolve issue for assign", zap.Error(err), zap.String("issue_id", idStr))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to find issue. Please try again."), true)
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "👥 **Assign users to %s**\n\nChoose a role first:", issue.Title),
+		Flags:   discordgo.MessageFlagsEphemeral,
+		Components: []discordgo.MessageComponent{
+			CreateRoleSelectMenu(fmt.Sprintf("assign_role_%s", issue.ID.String())),
+		},
+	}); err != nil {
+		h.logger.Error("Failed to respond with role selector", zap.Error(err))
+	}
+}
+
+// handleAssignRoleSelection handles the role chosen in the /assign flow and shows the user selector
+func (h *Handler) handleAssignRoleSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	if len(data.Values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No role selected"), true)
+		return
+	}
+
+	// Format: "assign_role_<uuid>"
+	issueIDStr := strings.TrimPrefix(data.CustomID, "assign_role_")
+	if _, err := uuid.Parse(issueIDStr); err != nil {
+		h.logger.Error("Invalid issue ID in role selector", zap.Error(err), zap.String("custom_id", data.CustomID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	role := domain.AssigneeRole(data.Values[0])
+	if !role.IsValid() {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid role"), true)
+		return
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content: i18n.T(ctx, "%s **Select %s(s):**", getRoleEmoji(role), role.GetDisplayName()),
+			Components: []discordgo.MessageComponent{
+				CreateUserSelectMenu(
+					fmt.Sprintf("assign_users_%s_%s", role, issueIDStr),
+					i18n.T(ctx, "Select %s", strings.ToLower(role.GetDisplayName())),
+					1,
+					maxAssigneesPerSelection,
+				),
+			},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to respond with user selector", zap.Error(err))
+	}
+}
+
+// handleAssignUsersSelection assigns the selected users to the issue with the chosen role
+func (h *Handler) handleAssignUsersSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	if len(data.Values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No users selected"), true)
+		return
+	}
+
+	// Format: "assign_users_<role>_<uuid>"
+	parts := strings.SplitN(strings.TrimPrefix(data.CustomID, "assign_users_"), "_", 2)
+	if len(parts) != 2 {
+		h.logger.Error("Invalid user selector custom ID", zap.String("custom_id", data.CustomID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid user selector"), true)
+		return
+	}
+
+	role := domain.AssigneeRole(parts[0])
+	issueID, err := uuid.Parse(parts[1])
+	if err != nil || !role.IsValid() {
+		h.logger.Error("Invalid user selector custom ID", zap.String("custom_id", data.CustomID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid user selector"), true)
+		return
+	}
+
+	var assigned []string
+	for _, discordID := range data.Values {
+		if _, err := h.issueAssigneeService.AssignUserToIssue(ctx, issueID, discordID, role); err != nil {
+			h.logger.Error("Failed to assign user to issue",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("discord_id", discordID),
+			)
+			continue
+		}
+		assigned = append(assigned, fmt.Sprintf("<@%s>", discordID))
+	}
+
+	if len(assigned) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to assign users. Please try again."), true)
+		return
+	}
+
+	mentions := strings.Join(assigned, ", ")
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    i18n.T(ctx, "✅ %s assigned as %s", mentions, role.GetDisplayName()),
+			Components: []discordgo.MessageComponent{},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to confirm assignment", zap.Error(err))
+	}
+
+	h.refreshIssue(ctx, issueID, i18n.T(ctx, "%s %s assigned as **%s** by <@%s>",
+		getRoleEmoji(role), mentions, role.GetDisplayName(), i.Member.User.ID))
+}
+
+// handleUnassignCommand handles the /unassign slash command
+func (h *Handler) handleUnassignCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling unassign command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	var idStr, discordID string
+	var role domain.AssigneeRole
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "id":
+			idStr = option.StringValue()
+		case "user":
+			discordID = option.UserValue(nil).ID
+		case "role":
+			role = domain.AssigneeRole(option.StringValue())
+		}
+	}
+
+	if idStr == "" || discordID == "" {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please provide an issue ID and a user."), true)
+		return
+	}
+
+	issue, err := h.resolveIssue(ctx, i.GuildID, i.ChannelID, idStr)
+	if err != nil {
+		if err == domain.ErrIssueNotFound {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ No issue found with ID: `%s`", idStr), true)
+			return
+		}
+		h.logger.Error("Failed to resolve issue for unassign", zap.Error(err), zap.String("issue_id", idStr))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to find issue. Please try again."), true)
+		return
+	}
+
+	removed := 0
+	for _, assignee := range issue.Assignees {
+		if assignee.User.DiscordID != discordID || (role != "" && assignee.Role != role) {
+			continue
+		}
+		if err := h.issueAssigneeService.UnassignUserFromIssue(ctx, issue.ID, assignee.UserID, assignee.Role); err != nil {
+			h.logger.Error("Failed to unassign user from issue",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+				zap.String("discord_id", discordID),
+			)
+			continue
+		}
+		removed++
+	}
+
+	if removed == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ <@%s> is not assigned to this issue.", discordID), true)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ <@%s> unassigned from **%s**", discordID, issue.Title), true)
+
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "➖ <@%s> unassigned by <@%s>", discordID, i.Member.User.ID))
+}
+
+// refreshIssue updates the issue card after a change and posts a note in the issue thread.
+// An empty note only updates the card.
+func (h *Handler) refreshIssue(ctx context.Context, issueID uuid.UUID, note string) {
+	loadedAt := time.Now()
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue for refresh", zap.Error(err))
+		return
+	}
+
+	if issue.Channel != nil && h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
+	}
+
+	if issue.ThreadID != "" && note != "" {
+		h.sendMessage(ctx, issue.ThreadID, note)
+	}
+}
+
+// refreshIssueWithNote updates the card of an issue after a change to one of its fields.
+// The note is only posted in the thread of issues that are not closed, whose threads stay archived.
+func (h *Handler) refreshIssueWithNote(ctx context.Context, issue *domain.Issue, note string) {
+	if issue.IsClosed() {
+		note = ""
+	}
+	h.refreshIssue(ctx, issue.ID, note)
+}
@@ -0,0 +1,105 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultAuditLogEntries is the number of entries /audit-log shows without a limit
+	defaultAuditLogEntries = 20
+	// maxAuditLogEntries is the largest limit /audit-log accepts
+	maxAuditLogEntries = 50
+	// maxAuditLogChanges limits how much of the changed values one entry shows
+	maxAuditLogChanges = 120
+	// maxAuditLogContent keeps the /audit-log response within Discord's message limit
+	maxAuditLogContent = 1900
+)
+
+// minAuditLogEntries is the smallest limit /audit-log accepts; the command option needs its address
+var minAuditLogEntries = 1.0
+
+// handleAuditLogCommand handles the /audit-log slash command
+func (h *Handler) handleAuditLogCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling audit-log command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionViewAuditLog) {
+		return
+	}
+
+	limit := defaultAuditLogEntries
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "limit" {
+			limit = int(option.IntValue())
+		}
+	}
+
+	if !h.deferResponse(ctx, i, true) {
+		return
+	}
+
+	entries, err := h.auditService.ListRecent(ctx, i.GuildID, limit)
+	if err != nil {
+		h.logger.Error("Failed to list audit log entries",
+			zap.Error(err),
+			zap.String("guild_id", i.GuildID),
+		)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to retrieve the audit log. Please try again."), true)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, formatAuditLog(ctx, entries), true)
+}
+
+// formatAuditLog renders audit log entries, newest first, within Discord's message limit
+func formatAuditLog(ctx context.Context, entries []*domain.AuditLog) string {
+	if len(entries) == 0 {
+		return i18n.T(ctx, "📭 No administrative actions have been recorded in this server yet.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "📜 **Audit Log**\n"))
+	for n, entry := range entries {
+		line := formatAuditLogEntry(ctx, entry)
+		if b.Len()+len(line) > maxAuditLogContent {
+			b.WriteString(i18n.T(ctx, "\n…and %d more", len(entries)-n))
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// formatAuditLogEntry renders one audit log entry as a line
+func formatAuditLogEntry(ctx context.Context, entry *domain.AuditLog) string {
+	actor := "API"
+	if entry.ActorID != "" {
+		actor = fmt.Sprintf("<@%s>", entry.ActorID)
+	}
+
+	target := entry.TargetName
+	if target == "" {
+		target = entry.TargetID
+	}
+
+	line := fmt.Sprintf("\n<t:%d:R> %s **%s** %s", entry.CreatedAt.Unix(), actor, entry.Action.GetDisplayName(), truncateText(target, 80))
+	switch {
+	case entry.Before != "" && entry.After != "":
+		line += fmt.Sprintf("\n   `%s` → `%s`", truncateText(entry.Before, maxAuditLogChanges/2), truncateText(entry.After, maxAuditLogChanges/2))
+	case entry.After != "":
+		line += fmt.Sprintf("\n   `%s`", truncateText(entry.After, maxAuditLogChanges))
+	case entry.Before != "":
+		line += i18n.T(ctx, "\n   was `%s`", truncateText(entry.Before, maxAuditLogChanges))
+	}
+	return line
+}
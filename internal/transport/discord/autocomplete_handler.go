@@ -0,0 +1,111 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxAutocompleteChoices is the number of suggestions shown for an issue option
+const maxAutocompleteChoices = 10
+
+// maxChoiceNameLength keeps choice names under the 100 characters Discord accepts
+const maxChoiceNameLength = 90
+
+// handleAutocomplete suggests issues of the current channel for the focused issue option,
+// the guild's projects for a project option, or the project's milestones for a milestone option
+func (h *Handler) handleAutocomplete(ctx context.Context, i *discordgo.InteractionCreate) {
+	if !h.featureService.IsEnabled(ctx, i.GuildID, domain.FeatureAutocomplete) {
+		h.respondWithChoices(i, nil)
+		return
+	}
+
+	focused := focusedOption(i.ApplicationCommandData().Options)
+	if command := i.ApplicationCommandData().Name; focused != nil && (command == "customer" || command == "project") {
+		h.suggestCustomersOrProjects(ctx, i, focused)
+		return
+	}
+	if focused != nil && focused.Name == "project" {
+		h.suggestGuildProjects(ctx, i, strings.TrimSpace(focused.StringValue()))
+		return
+	}
+	if focused != nil && (focused.Name == "milestone" || (focused.Name == "name" && i.ApplicationCommandData().Name == "milestone")) {
+		h.suggestMilestones(ctx, i, strings.TrimSpace(focused.StringValue()))
+		return
+	}
+	if focused != nil && focused.Name == "component" {
+		h.suggestComponents(ctx, i, strings.TrimSpace(focused.StringValue()))
+		return
+	}
+	if focused == nil || (focused.Name != "id" && focused.Name != "target") {
+		h.respondWithChoices(i, nil)
+		return
+	}
+
+	typed := strings.TrimSpace(focused.StringValue())
+
+	var issues []*domain.Issue
+	var err error
+	if typed == "" {
+		issues, _, err = h.issueService.ListIssuesByChannelPage(ctx, i.ChannelID, domain.IssueFilter{}, 0, maxAutocompleteChoices)
+	} else {
+		issues, err = h.issueService.SearchIssuesByPartialID(ctx, typed, i.ChannelID)
+	}
+	if err != nil {
+		h.logger.Error("Failed to search issues for autocomplete",
+			zap.Error(err),
+			zap.String("channel_id", i.ChannelID),
+			zap.String("typed", typed),
+		)
+		h.respondWithChoices(i, nil)
+		return
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(issues))
+	for _, issue := range issues {
+		value := issue.Key
+		if value == "" {
+			value = issue.ID.String()
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  truncateText(fmt.Sprintf("%s %s · %s", getStatusEmoji(issue.Status), issue.ShortID(), issue.Title), maxChoiceNameLength),
+			Value: value,
+		})
+	}
+
+	h.respondWithChoices(i, choices)
+}
+
+// respondWithChoices answers an autocomplete interaction; nil choices show an empty list
+func (h *Handler) respondWithChoices(i *discordgo.InteractionCreate, choices []*discordgo.ApplicationCommandOptionChoice) {
+	if choices == nil {
+		choices = []*discordgo.ApplicationCommandOptionChoice{}
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	}); err != nil {
+		h.logger.Error("Failed to respond to autocomplete", zap.Error(err))
+	}
+}
+
+// focusedOption returns the option the user is typing in, looking inside subcommands
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, option := range options {
+		if option.Focused {
+			return option
+		}
+		if found := focusedOption(option.Options); found != nil {
+			return found
+		}
+	}
+	return nil
+}
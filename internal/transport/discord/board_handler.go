@@ -0,0 +1,200 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBoardFieldLength is the length Discord allows for an embed field value
+	maxBoardFieldLength = 1024
+	// maxBoardTitleLength shortens issue titles so a column stays readable
+	maxBoardTitleLength = 40
+)
+
+// handleBoardCommand handles the /board slash command. It posts and pins a board showing
+// the channel's issues by status, replacing the channel's previous board.
+func (h *Handler) handleBoardCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling board command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionPostBoard) {
+		return
+	}
+	if !h.requireFeature(ctx, i, domain.FeatureBoard) {
+		return
+	}
+
+	channelID, channelType := h.intakeChannel(i.ChannelID)
+	if channelType == domain.ChannelTypeForum {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Boards can only be posted in text channels; a forum lists its issues as posts already."), true)
+		return
+	}
+
+	channel, err := h.channelService.GetChannelRegistration(ctx, channelID)
+	if err != nil {
+		h.respondBoardError(ctx, i, err)
+		return
+	}
+
+	columns, err := h.boardService.GetColumns(ctx, channel.ID)
+	if err != nil {
+		h.respondBoardError(ctx, i, err)
+		return
+	}
+
+	message, err := h.session.ChannelMessageSendEmbed(channel.DiscordChannelID, CreateBoardEmbed(columns, time.Now()))
+	if err != nil {
+		h.logger.Error("Failed to post board", zap.Error(err), zap.String("channel_id", channel.DiscordChannelID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to post the board. Make sure I can send messages here."), true)
+		return
+	}
+
+	previous, err := h.boardService.SaveBoard(ctx, channel, message.ID, i.Member.User.ID)
+	if err != nil {
+		h.respondBoardError(ctx, i, err)
+		return
+	}
+
+	content := i18n.T(ctx, "📋 Posted the issue board. It updates whenever an issue in this channel changes.")
+	if err := h.session.ChannelMessagePin(channel.DiscordChannelID, message.ID); err != nil {
+		h.logger.Warn("Failed to pin board", zap.Error(err), zap.String("message_id", message.ID))
+		content = i18n.T(ctx, "📋 Posted the issue board, but could not pin it. Give me the Manage Messages permission to keep it pinned.")
+	}
+	h.respondToInteraction(ctx, i, content, true)
+
+	// A channel has one board; the old one would no longer be kept up to date
+	if previous != nil && previous.MessageID != message.ID {
+		if err := h.session.ChannelMessageDelete(previous.DiscordChannelID, previous.MessageID); err != nil {
+			h.logger.Debug("Failed to delete previous board", zap.Error(err), zap.String("message_id", previous.MessageID))
+		}
+	}
+}
+
+// respondBoardError explains why a board could not be posted
+func (h *Handler) respondBoardError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrChannelNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This channel is not registered. Use `/register` first."), true)
+	default:
+		h.logger.Error("Failed to handle board command", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to post the board. Please try again."), true)
+	}
+}
+
+// onBoardIssueChanged refreshes the board of the channel an issue belongs to, and of the
+// channel a moved issue left
+func (h *Handler) onBoardIssueChanged(_ context.Context, event domain.Event) {
+	if event.Issue == nil || event.Issue.ChannelID == nil {
+		return
+	}
+
+	channelIDs := []uuid.UUID{*event.Issue.ChannelID}
+	if event.OldChannel != nil && event.OldChannel.ID != channelIDs[0] {
+		channelIDs = append(channelIDs, event.OldChannel.ID)
+	}
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("board refresh", zap.String("channel_id", channelIDs[0].String()))
+
+		for _, channelID := range channelIDs {
+			h.refreshBoard(ctx, channelID)
+		}
+	}()
+}
+
+// refreshBoard edits the board of a channel registration to show its current issues.
+// Refreshes run one at a time so an older snapshot cannot overwrite a newer one. A board
+// whose message was deleted is forgotten.
+func (h *Handler) refreshBoard(ctx context.Context, channelID uuid.UUID) {
+	h.boardMu.Lock()
+	defer h.boardMu.Unlock()
+
+	board, err := h.boardService.GetBoard(ctx, channelID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrBoardNotFound) {
+			h.logger.Error("Failed to load board", zap.Error(err), zap.String("channel_id", channelID.String()))
+		}
+		return
+	}
+
+	columns, err := h.boardService.GetColumns(ctx, channelID)
+	if err != nil {
+		h.logger.Error("Failed to load board issues", zap.Error(err), zap.String("channel_id", channelID.String()))
+		return
+	}
+
+	err = h.api.call(ctx, "edit board", func(opts ...discordgo.RequestOption) error {
+		_, err := h.session.ChannelMessageEditEmbed(board.DiscordChannelID, board.MessageID, CreateBoardEmbed(columns, time.Now()), opts...)
+		return err
+	})
+	if err == nil {
+		return
+	}
+
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == discordgo.ErrCodeUnknownMessage {
+		if err := h.boardService.RemoveBoard(ctx, channelID); err != nil && !errors.Is(err, domain.ErrBoardNotFound) {
+			h.logger.Error("Failed to remove deleted board", zap.Error(err), zap.String("channel_id", channelID.String()))
+		}
+		return
+	}
+	h.logger.Error("Failed to update board",
+		zap.Error(err),
+		zap.String("channel_id", channelID.String()),
+		zap.String("message_id", board.MessageID),
+	)
+}
+
+// CreateBoardEmbed creates an embed showing issues in one field per board column
+func CreateBoardEmbed(columns []*domain.BoardColumn, now time.Time) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title:       "📋 Issue Board",
+		Description: "Issues of this channel by status. Closed issues and drafts are not shown.",
+		Color:       0x3498db,
+		Footer:      &discordgo.MessageEmbedFooter{Text: "Updates automatically"},
+		Timestamp:   now.Format(time.RFC3339),
+	}
+
+	for _, column := range columns {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s %s (%d)", getStatusEmoji(column.Statuses[0]), column.Name, len(column.Issues)),
+			Value:  formatBoardColumn(column.Issues),
+			Inline: true,
+		})
+	}
+
+	return embed
+}
+
+// formatBoardColumn lists the keys and titles of a column's issues, as many as fit in a field
+func formatBoardColumn(issues []*domain.Issue) string {
+	if len(issues) == 0 {
+		return "—"
+	}
+
+	var content strings.Builder
+	for n, issue := range issues {
+		line := fmt.Sprintf("`%s` %s\n", issueDisplayName(issue), truncateText(issue.Title, maxBoardTitleLength))
+		more := fmt.Sprintf("… and %d more", len(issues)-n)
+		if len([]rune(content.String()))+len([]rune(line))+len([]rune(more)) > maxBoardFieldLength {
+			content.WriteString(more)
+			return content.String()
+		}
+		content.WriteString(line)
+	}
+	return content.String()
+}
@@ -0,0 +1,168 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxBulkSummaryLength keeps a /bulk summary within Discord's message limit
+const maxBulkSummaryLength = 1900
+
+// handleBulkCommand handles the /bulk slash command and its close, assign and label subcommands
+func (h *Handler) handleBulkCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: close, assign or label."), true)
+		return
+	}
+
+	subcommand := options[0]
+	h.logger.Info("Handling bulk command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionBulkEdit) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	req := domain.BulkRequest{
+		Action:    domain.BulkAction(subcommand.Name),
+		ChannelID: channelID,
+		ActorID:   i.Member.User.ID,
+	}
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "issues":
+			req.IssueIDs = splitIssueIDs(option.StringValue())
+		case "status":
+			req.Status = domain.Status(option.StringValue())
+		case "user":
+			req.AssigneeID = option.UserValue(nil).ID
+		case "role":
+			req.AssigneeRole = domain.AssigneeRole(option.StringValue())
+		case "label":
+			req.LabelName = option.StringValue()
+		case "dry-run":
+			req.DryRun = option.BoolValue()
+		}
+	}
+
+	// Closing issues updates their cards, which can take longer than Discord waits
+	if !h.deferResponse(ctx, i, req.DryRun) {
+		return
+	}
+
+	result, err := h.bulkService.Apply(ctx, req)
+	if err != nil {
+		h.respondBulkError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, formatBulkResult(ctx, req, result), req.DryRun || !result.Applied)
+}
+
+// splitIssueIDs splits the issues option of /bulk, e.g. "ACME-1, ACME-2 ACME-7"
+func splitIssueIDs(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
+
+// respondBulkError explains why a bulk operation was refused as a whole
+func (h *Handler) respondBulkError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidDiscordID):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please pick the user to assign."), true)
+	case errors.Is(err, domain.ErrInvalidAssigneeRole):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please pick the role to assign the user with."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the issues. Nothing was changed; please try again."))
+	}
+}
+
+// formatBulkResult summarizes what a bulk operation changed, or would change
+func formatBulkResult(ctx context.Context, req domain.BulkRequest, result *domain.BulkResult) string {
+	changed := result.Count(domain.BulkOutcomeChanged)
+	unchanged := result.Count(domain.BulkOutcomeUnchanged)
+	failed := result.Count(domain.BulkOutcomeFailed)
+
+	var b strings.Builder
+	switch {
+	case len(result.Items) == 0:
+		return i18n.T(ctx, "📭 No issues in this channel are %s.", strings.ToLower(domain.GetStatusDisplayName(req.Status)))
+	case failed > 0:
+		b.WriteString(i18n.T(ctx, "⚠️ **Nothing was changed**: %d of %d issue(s) cannot be %s.\n", failed, len(result.Items), bulkActionPastTense(ctx, req)))
+	case req.DryRun:
+		b.WriteString(i18n.T(ctx, "🔍 **Dry run**: %d issue(s) would be %s, %d already are. Run it again without dry-run to apply.\n",
+			changed, bulkActionPastTense(ctx, req), unchanged))
+	default:
+		b.WriteString(i18n.T(ctx, "✅ %d issue(s) %s, %d already were.\n", changed, bulkActionPastTense(ctx, req), unchanged))
+	}
+
+	for n, item := range result.Items {
+		line := formatBulkItem(ctx, item)
+		if b.Len()+len(line) > maxBulkSummaryLength {
+			b.WriteString(i18n.T(ctx, "…and %d more", len(result.Items)-n))
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// formatBulkItem describes the outcome of a bulk operation for one issue
+func formatBulkItem(ctx context.Context, item domain.BulkItem) string {
+	if item.Issue == nil {
+		return fmt.Sprintf("❌ `%s`: %s\n", item.IssueID, bulkItemError(ctx, item.Err))
+	}
+
+	issue := fmt.Sprintf("`%s` %s", item.Issue.ShortID(), truncateText(item.Issue.Title, 40))
+	switch item.Outcome {
+	case domain.BulkOutcomeFailed:
+		return fmt.Sprintf("❌ %s: %s\n", issue, bulkItemError(ctx, item.Err))
+	case domain.BulkOutcomeUnchanged:
+		return fmt.Sprintf("➖ %s\n", issue)
+	default:
+		return fmt.Sprintf("✔️ %s\n", issue)
+	}
+}
+
+// bulkItemError explains why an issue refused a bulk change
+func bulkItemError(ctx context.Context, err error) string {
+	switch {
+	case errors.Is(err, domain.ErrIssueNotFound):
+		return i18n.T(ctx, "no such issue in this channel")
+	case errors.Is(err, domain.ErrAmbiguousIssueID):
+		return i18n.T(ctx, "matches several issues; use the issue key")
+	case errors.Is(err, domain.ErrOpenSubIssues):
+		return i18n.T(ctx, "has open sub-tasks")
+	case errors.Is(err, domain.ErrInvalidStatusTransition):
+		return i18n.T(ctx, "its workflow does not allow this")
+	default:
+		return errorMessage(ctx, err)
+	}
+}
+
+// bulkActionPastTense describes the change of a bulk request, e.g. "assigned to @Mia as Developer"
+func bulkActionPastTense(ctx context.Context, req domain.BulkRequest) string {
+	switch req.Action {
+	case domain.BulkActionAssign:
+		return i18n.T(ctx, "assigned to <@%s> as %s", req.AssigneeID, req.AssigneeRole.GetDisplayName())
+	case domain.BulkActionLabel:
+		return i18n.T(ctx, "labelled `%s`", domain.NormalizeLabelName(req.LabelName))
+	default:
+		return i18n.T(ctx, "closed")
+	}
+}
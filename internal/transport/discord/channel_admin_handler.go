@@ -0,0 +1,205 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleChannelAdminCommand handles the /channel-admin slash command and its subcommands
+func (h *Handler) handleChannelAdminCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+
+	h.logger.Info("Handling channel-admin command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageChannels) {
+		return
+	}
+
+	// In a forum the command is used in a post and manages the forum
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	channel, err := h.channelService.GetChannelRegistration(ctx, channelID)
+	if err != nil {
+		h.respondWithChannelAdminError(ctx, i, err)
+		return
+	}
+
+	var content string
+	switch subcommand.Name {
+	case "info":
+		content = formatChannelRegistration(ctx, channel)
+	case "update":
+		customer := strings.TrimSpace(subcommand.GetOption("customer").StringValue())
+		project := strings.TrimSpace(subcommand.GetOption("project").StringValue())
+		if customer == "" || project == "" {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Customer and project names cannot be empty."), true)
+			return
+		}
+		err = h.channelService.UpdateChannelRegistration(ctx, channelID, customer, project)
+		content = i18n.T(ctx, "✅ This channel now tracks issues for **%s** (%s).", project, customer)
+	case "deactivate":
+		if !channel.IsActive {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "⏸️ This channel is already deactivated."), true)
+			return
+		}
+		err = h.channelService.DeactivateChannel(ctx, channelID)
+		content = i18n.T(ctx, "⏸️ This channel no longer accepts new issues. Existing issues can still be worked on; use `/channel-admin activate` to undo.")
+	case "activate":
+		if channel.IsActive {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "▶️ This channel is already active."), true)
+			return
+		}
+		err = h.channelService.ActivateChannel(ctx, channelID)
+		content = i18n.T(ctx, "▶️ This channel accepts new issues again.")
+	case "add-project":
+		customer := strings.TrimSpace(subcommand.GetOption("customer").StringValue())
+		projectName := strings.TrimSpace(subcommand.GetOption("project").StringValue())
+		if customer == "" || projectName == "" {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Customer and project names cannot be empty."), true)
+			return
+		}
+		var project *domain.Project
+		project, err = h.channelService.AddChannelProject(ctx, channelID, customer, projectName)
+		if err == nil {
+			content = i18n.T(ctx, "➕ This channel now also tracks **%s** (`%s`). `/issue` asks reporters which project an issue is for.", project.Name, project.KeyPrefix)
+		}
+	case "remove-project":
+		var project *domain.Project
+		project, err = h.channelService.RemoveChannelProject(ctx, channelID, subcommand.GetOption("project").StringValue())
+		if err == nil {
+			content = i18n.T(ctx, "➖ This channel no longer tracks **%s**. Its existing issues are kept.", project.Name)
+		}
+	case "transfer-project":
+		var moved *domain.Channel
+		moved, err = h.channelService.TransferChannel(ctx, channelID, subcommand.GetOption("project").StringValue())
+		if err == nil {
+			content = i18n.T(ctx, "🔀 New issues in this channel now go to **%s**. Existing issues stay in **%s**.", moved.Project.Name, channel.Project.Name)
+		}
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondWithChannelAdminError(ctx, i, err)
+		return
+	}
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// respondWithChannelAdminError explains why a /channel-admin subcommand failed
+func (h *Handler) respondWithChannelAdminError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrProjectNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ No project with that key is registered in this server."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the channel registration. Please try again."))
+	}
+}
+
+// formatChannelRegistration describes a channel registration for /channel-admin info
+func formatChannelRegistration(ctx context.Context, channel *domain.Channel) string {
+	status := i18n.T(ctx, "▶️ Active")
+	if !channel.IsActive {
+		status = i18n.T(ctx, "⏸️ Deactivated, not accepting new issues")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "📋 **Channel Registration**\n\n"))
+	b.WriteString(i18n.T(ctx, "**Customer:** %s\n", getDisplayValue(channel.Project.Customer.Name, "Unknown")))
+	b.WriteString(i18n.T(ctx, "**Project:** %s\n", channel.Project.Name))
+	b.WriteString(i18n.T(ctx, "**Issue Key Prefix:** %s\n", getDisplayValue(channel.Project.KeyPrefix, "None")))
+	if len(channel.Projects) > 0 {
+		names := make([]string, 0, len(channel.Projects))
+		for _, project := range channel.Projects {
+			names = append(names, fmt.Sprintf("%s (`%s`)", project.Name, project.KeyPrefix))
+		}
+		b.WriteString(i18n.T(ctx, "**Further Projects:** %s\n", strings.Join(names, ", ")))
+	}
+	b.WriteString(i18n.T(ctx, "**Status:** %s\n", status))
+	if channel.IsForum() {
+		b.WriteString(i18n.T(ctx, "**Type:** Forum, one post per issue\n"))
+	}
+	if channel.RegisteredByUser.DiscordID != "" {
+		b.WriteString(i18n.T(ctx, "**Registered by:** <@%s>\n", channel.RegisteredByUser.DiscordID))
+	}
+	b.WriteString(i18n.T(ctx, "**Registration Date:** %s", fmt.Sprintf("<t:%d:D>", channel.CreatedAt.Unix())))
+	return b.String()
+}
+
+// suggestGuildProjects answers autocomplete for a project option with the projects
+// registered in the guild whose key or name contains what was typed. Removing a project
+// only suggests the further projects of the current channel, and moving an issue those of
+// the channel chosen for it.
+func (h *Handler) suggestGuildProjects(ctx context.Context, i *discordgo.InteractionCreate, typed string) {
+	var projects []domain.Project
+	options := i.ApplicationCommandData().Options
+	if len(options) > 0 && options[0].Name == "remove-project" {
+		channelID, _ := h.intakeChannel(i.ChannelID)
+		channel, err := h.channelService.GetChannelRegistration(ctx, channelID)
+		if err != nil {
+			h.respondWithChoices(i, nil)
+			return
+		}
+		projects = channel.Projects
+	} else if target := moveTargetChannel(i); target != "" {
+		channel, err := h.channelService.GetChannelRegistration(ctx, target)
+		if err != nil {
+			h.respondWithChoices(i, nil)
+			return
+		}
+		projects = channel.AllProjects()
+	} else {
+		channels, err := h.channelService.ListChannelsForGuild(ctx, i.GuildID)
+		if err != nil {
+			h.logger.Error("Failed to list projects for autocomplete",
+				zap.Error(err),
+				zap.String("guild_id", i.GuildID),
+			)
+			h.respondWithChoices(i, nil)
+			return
+		}
+		for _, channel := range channels {
+			projects = append(projects, channel.AllProjects()...)
+		}
+	}
+
+	typed = strings.ToLower(typed)
+	seen := make(map[string]bool)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, maxAutocompleteChoices)
+	for _, project := range projects {
+		if project.KeyPrefix == "" || seen[project.KeyPrefix] {
+			continue
+		}
+		if !strings.Contains(strings.ToLower(project.KeyPrefix), typed) && !strings.Contains(strings.ToLower(project.Name), typed) {
+			continue
+		}
+		seen[project.KeyPrefix] = true
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  truncateText(fmt.Sprintf("%s · %s", project.KeyPrefix, project.Name), maxChoiceNameLength),
+			Value: project.KeyPrefix,
+		})
+		if len(choices) == maxAutocompleteChoices {
+			break
+		}
+	}
+
+	h.respondWithChoices(i, choices)
+}
@@ -2,6 +2,10 @@ package discord
 
 import (
 	"fmt"
+	"reflect"
+	"sync"
+
+	"fix-track-bot/internal/config"
 
 	"github.com/bwmarrin/discordgo"
 	"go.uber.org/zap"
@@ -9,64 +13,1772 @@ import (
 
 // CommandManager manages Discord slash commands
 type CommandManager struct {
-	session *discordgo.Session
-	logger  *zap.Logger
+	session      *discordgo.Session
+	scope        string   // Where the commands are registered; see config.CommandScopeGlobal
+	syncedGuilds sync.Map // IDs of the guilds whose commands were synced by this process
+	logger       *zap.Logger
 }
 
-// NewCommandManager creates a new command manager
-func NewCommandManager(session *discordgo.Session, logger *zap.Logger) *CommandManager {
+// NewCommandManager creates a new command manager registering the commands in the given
+// scope
+func NewCommandManager(session *discordgo.Session, scope string, logger *zap.Logger) *CommandManager {
 	return &CommandManager{
 		session: session,
+		scope:   scope,
 		logger:  logger,
 	}
 }
 
-// getCommandDefinitions returns the command definitions
+// getCommandDefinitions returns the command definitions, with their descriptions translated
 func (cm *CommandManager) getCommandDefinitions() []*discordgo.ApplicationCommand {
-	return []*discordgo.ApplicationCommand{
+	commands := []*discordgo.ApplicationCommand{
 		// Issue Management
 		{
 			Name:        "issue",
 			Description: "Report a new issue or bug",
 		},
 		{
-			Name:        "issues",
-			Description: "List issues in this channel",
+			Name:        "issues",
+			Description: "List issues in this channel",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "status",
+					Description: "Only show issues with this status",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "⚪ Draft", Value: "draft"},
+						{Name: "🔵 Open", Value: "open"},
+						{Name: "🔷 In Progress", Value: "in_progress"},
+						{Name: "🟢 Resolved", Value: "resolved"},
+						{Name: "✅ Verified", Value: "verified"},
+						{Name: "🔴 Rejected", Value: "rejected"},
+						{Name: "🟠 Reopened", Value: "reopened"},
+						{Name: "🟣 Closed", Value: "closed"},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "priority",
+					Description: "Only show issues with this priority",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "🔴 High", Value: "high"},
+						{Name: "🟡 Medium", Value: "medium"},
+						{Name: "🟢 Low", Value: "low"},
+					},
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "milestone",
+					Description:  "Only show issues of this milestone",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "component",
+					Description:  "Only show issues of this component",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name: createIssueFromMessageCommand,
+			Type: discordgo.MessageApplicationCommand,
+		},
+		{
+			Name:        "issue-status",
+			Description: "Check the status and history of a specific issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to check",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+
+		{
+			Name:        "issue-edit",
+			Description: "Edit the title, description or image of an issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to edit",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+
+		{
+			Name:        "issue-field",
+			Description: "Set or clear a custom field of an issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key or ID",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "field",
+					Description: "Custom field name, see /custom-fields show",
+					Required:    true,
+					MaxLength:   50,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "value",
+					Description: "New value; leave out to clear the field",
+					Required:    false,
+					MaxLength:   500,
+				},
+			},
+		},
+
+		{
+			Name:        "issue-delete",
+			Description: "Delete an issue (admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to delete",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+
+		{
+			Name:        "issue-move",
+			Description: "Move an issue to another registered channel",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to move",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "channel",
+					Description:  "Registered channel to move the issue to",
+					Required:     true,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildForum},
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "project",
+					Description:  "Issue key prefix of the channel's project to file it under; defaults to the channel's main project",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+
+		{
+			Name:        "assign",
+			Description: "Assign users to an issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to assign",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:        "unassign",
+			Description: "Remove a user from an issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to unassign from",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "User to remove",
+					Required:    true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "role",
+					Description: "Only remove this role (default: all roles)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "👨‍💻 Developer", Value: "dev"},
+						{Name: "🧪 QA Tester", Value: "qa"},
+						{Name: "👀 Reviewer", Value: "reviewer"},
+						{Name: "👤 Other", Value: "other"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "watch",
+			Description: "Get a DM when an issue changes status or is commented on",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to watch",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:        "unwatch",
+			Description: "Stop getting DMs about an issue you watch",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to stop watching",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+
+		{
+			Name:        "label",
+			Description: "Manage issue labels",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a label to an issue",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key or ID",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Label name",
+							Required:    true,
+							MaxLength:   50,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "color",
+							Description: "Hex color for a new label, e.g. #e74c3c",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a label from an issue",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key or ID",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Label name",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List the labels of an issue, or of this channel's project",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key or ID (default: all project labels)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+
+		{
+			Name:        "bulk",
+			Description: "Close, assign or label several issues at once",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "close",
+					Description: "Close several issues",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "issues",
+							Description: "Issue keys separated by spaces or commas, e.g. ACME-1 ACME-4",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "status",
+							Description: "Pick every issue of this channel with this status instead",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "⚪ Draft", Value: "draft"},
+								{Name: "🔵 Open", Value: "open"},
+								{Name: "🔷 In Progress", Value: "in_progress"},
+								{Name: "🟢 Resolved", Value: "resolved"},
+								{Name: "✅ Verified", Value: "verified"},
+								{Name: "🔴 Rejected", Value: "rejected"},
+								{Name: "🟠 Reopened", Value: "reopened"},
+								{Name: "🟣 Closed", Value: "closed"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "dry-run",
+							Description: "Only show what would change",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "assign",
+					Description: "Assign a user to several issues",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "User to assign",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "role",
+							Description: "Role to assign the user with",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "👨‍💻 Developer", Value: "dev"},
+								{Name: "🧪 QA Tester", Value: "qa"},
+								{Name: "👀 Reviewer", Value: "reviewer"},
+								{Name: "👤 Other", Value: "other"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "issues",
+							Description: "Issue keys separated by spaces or commas, e.g. ACME-1 ACME-4",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "status",
+							Description: "Pick every issue of this channel with this status instead",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "⚪ Draft", Value: "draft"},
+								{Name: "🔵 Open", Value: "open"},
+								{Name: "🔷 In Progress", Value: "in_progress"},
+								{Name: "🟢 Resolved", Value: "resolved"},
+								{Name: "✅ Verified", Value: "verified"},
+								{Name: "🔴 Rejected", Value: "rejected"},
+								{Name: "🟠 Reopened", Value: "reopened"},
+								{Name: "🟣 Closed", Value: "closed"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "dry-run",
+							Description: "Only show what would change",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "label",
+					Description: "Add a label to several issues",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "label",
+							Description: "Label to add; created if the project does not have it yet",
+							Required:    true,
+							MaxLength:   50,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "issues",
+							Description: "Issue keys separated by spaces or commas, e.g. ACME-1 ACME-4",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "status",
+							Description: "Pick every issue of this channel with this status instead",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "⚪ Draft", Value: "draft"},
+								{Name: "🔵 Open", Value: "open"},
+								{Name: "🔷 In Progress", Value: "in_progress"},
+								{Name: "🟢 Resolved", Value: "resolved"},
+								{Name: "✅ Verified", Value: "verified"},
+								{Name: "🔴 Rejected", Value: "rejected"},
+								{Name: "🟠 Reopened", Value: "reopened"},
+								{Name: "🟣 Closed", Value: "closed"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "dry-run",
+							Description: "Only show what would change",
+							Required:    false,
+						},
+					},
+				},
+			},
+		},
+
+		// Utility Commands
+		{
+			Name:        "subtask",
+			Description: "Add a sub-task to the issue of this thread",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "title",
+					Description: "Sub-task title",
+					Required:    true,
+					MaxLength:   maxIssueTitleLength,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "description",
+					Description: "Sub-task details (default: a reference to the parent issue)",
+					Required:    false,
+					MaxLength:   2000,
+				},
+			},
+		},
+
+		{
+			Name:        "link",
+			Description: "Link an issue to another issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to link",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "type",
+					Description: "How the issue relates to the target",
+					Required:    true,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "🔁 Duplicate of", Value: "duplicate_of"},
+						{Name: "⛔ Blocks", Value: "blocks"},
+						{Name: "🔗 Relates to", Value: "relates_to"},
+					},
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "target",
+					Description:  "Key or ID of the issue to link to",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:        "unlink",
+			Description: "Remove the link between two issues",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix to unlink",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "target",
+					Description:  "Key or ID of the linked issue",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "type",
+					Description: "Only remove this relation (default: all relations)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "🔁 Duplicate of", Value: "duplicate_of"},
+						{Name: "⛔ Blocks", Value: "blocks"},
+						{Name: "🔗 Relates to", Value: "relates_to"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "due",
+			Description: "Set or clear the due date of an issue",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "date",
+					Description: "Due date such as 2025-03-14 or 2025-03-14 17:00, or clear to remove it",
+					Required:    true,
+					MaxLength:   20,
+				},
+			},
+		},
+		{
+			Name:        "severity",
+			Description: "Set or clear the severity of an incident, which its SLA targets follow",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "id",
+					Description:  "Issue key (e.g. ACME-42), ID or ID prefix",
+					Required:     true,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "level",
+					Description: "How badly customers are affected",
+					Required:    true,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "🟥 S1 - Critical", Value: "s1"},
+						{Name: "🟧 S2 - Major", Value: "s2"},
+						{Name: "🟨 S3 - Minor", Value: "s3"},
+						{Name: "🟦 S4 - Low", Value: "s4"},
+						{Name: "⚪ Clear", Value: severityClear},
+					},
+				},
+			},
+		},
+		{
+			Name:        "incident",
+			Description: "Declare an incident and keep everyone updated while it is worked on",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "declare",
+					Description: "Declare an incident with its own thread, run by you",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "title",
+							Description: "What is broken, e.g. Checkout fails for all customers",
+							Required:    true,
+							MaxLength:   maxIssueTitleLength,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "description",
+							Description: "What is known so far",
+							Required:    false,
+							MaxLength:   maxEditDescriptionLength,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "severity",
+							Description: "How badly customers are affected (default: S1)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "🟥 S1 - Critical", Value: "s1"},
+								{Name: "🟧 S2 - Major", Value: "s2"},
+							},
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "bridge",
+							Description:  "Voice or stage channel to work the incident in; a stage channel gets a stage",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildVoice, discordgo.ChannelTypeGuildStageVoice},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "update",
+					Description: "Post a status update of an incident",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "message",
+							Description: "Where the incident stands and what happens next",
+							Required:    true,
+							MaxLength:   maxIncidentUpdateLength,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key or ID (default: the issue of this thread)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "milestone",
+			Description: "Group this project's issues into milestones and follow their progress",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "create",
+					Description: "Create a milestone in this channel's project",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Milestone name, e.g. v1.2",
+							Required:    true,
+							MaxLength:   100,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "target-date",
+							Description: "Day the milestone should be done, e.g. 2025-03-14",
+							Required:    false,
+							MaxLength:   10,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List this project's milestones and their progress",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show the progress and open issues of a milestone",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "name",
+							Description:  "Milestone name",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "assign",
+					Description: "Add an issue to a milestone",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key (e.g. ACME-42), ID or ID prefix",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "milestone",
+							Description:  "Milestone name",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "unassign",
+					Description: "Remove an issue from its milestone",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key (e.g. ACME-42), ID or ID prefix",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "delete",
+					Description: "Delete a milestone; its issues are kept",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "name",
+							Description:  "Milestone name",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "release-notes",
+			Description: "Compile the issues closed for a release into a changelog",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "milestone",
+					Description:  "Milestone of the release",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "from",
+					Description: "First day issues were closed on, e.g. 2025-03-01",
+					Required:    false,
+					MaxLength:   10,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "to",
+					Description: "Last day issues were closed on, e.g. 2025-03-14 (default: today)",
+					Required:    false,
+					MaxLength:   10,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionChannel,
+					Name:         "channel",
+					Description:  "Channel to post the release notes in, e.g. a customer channel (default: this one)",
+					Required:     false,
+					ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildNews},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "file",
+					Description: "Also attach the release notes as a Markdown file (default: false)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "component",
+			Description: "Split this project into components such as API or Billing and file issues under them",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a component to this channel's project",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Component name, e.g. Billing",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List this project's components and their leads",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "lead",
+					Description: "Route a component's issues to a team member first, or stop doing so",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "component",
+							Description:  "Component name",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "Team member who leads the component; leave out to remove the lead",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "File an issue under a component, or remove it from its component",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key (e.g. ACME-42), ID or ID prefix",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "component",
+							Description:  "Component name; leave out to remove the issue from its component",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a component; its issues are kept",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "component",
+							Description:  "Component name",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "board",
+			Description: "Post a pinned board of this channel's issues that updates itself",
+		},
+		{
+			Name:        "resync",
+			Description: "Repost missing issue cards and threads of this channel and refresh outdated cards",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "closed",
+					Description: "Also check closed issues (default: False)",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "track",
+			Description: "Track time spent on issues",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "start",
+					Description: "Start a timer on an issue",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key or ID (default: the issue of this thread)",
+							Required:     false,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "stop",
+					Description: "Stop your running timer and log the time",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "log",
+					Description: "Log time spent on an issue without a timer",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "duration",
+							Description: "Time spent, e.g. 45m or 1h30m (at most 24h)",
+							Required:    true,
+							MaxLength:   20,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key or ID (default: the issue of this thread)",
+							Required:     false,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "note",
+							Description: "What the time was spent on",
+							Required:    false,
+							MaxLength:   200,
+						},
+					},
+				},
+			},
+		},
+
+		{
+			Name:        "stats",
+			Description: "Show issue metrics for this channel's project",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "component",
+					Description:  "Only count the issues of this component",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:        "export",
+			Description: "Export all issues of this channel's project as a file",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "format",
+					Description: "File format (default: CSV)",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "CSV", Value: "csv"},
+						{Name: "Excel (XLSX)", Value: "xlsx"},
+					},
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "milestone",
+					Description:  "Only export the issues of this milestone",
+					Required:     false,
+					Autocomplete: true,
+				},
+			},
+		},
+		{
+			Name:        "webhook",
+			Description: "Manage webhooks that receive this project's issue events",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Send this project's issue events to a URL",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "url",
+							Description: "Public https URL that receives JSON POSTs",
+							Required:    true,
+							MaxLength:   500,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop sending events to a URL",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "url",
+							Description: "Webhook URL to remove",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List this project's webhooks",
+				},
+			},
+		},
+		{
+			Name:        "apikey",
+			Description: "Manage the REST API keys of this project's customer",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "create",
+					Description: "Issue an API key for this project's customer",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "What the key is for, e.g. the integration using it",
+							Required:    true,
+							MaxLength:   100,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "scopes",
+							Description: "all, or comma-separated scopes such as issues:read,issues:write",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List the active API keys of this project's customer",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "revoke",
+					Description: "Revoke an API key",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "prefix",
+							Description: "Prefix of the key as shown by /apikey list, e.g. stb_1a2b3c4d",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "my-issues",
+			Description: "Show issues assigned to you",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "role",
+					Description: "Filter by your role",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: "👨‍💻 Developer", Value: "dev"},
+						{Name: "🧪 QA Tester", Value: "qa"},
+						{Name: "👀 Reviewer", Value: "reviewer"},
+					},
+				},
+			},
+		},
+		{
+			Name:        "workflow",
+			Description: "Show the issue workflow and your current tasks",
+		},
+		{
+			Name:        "notify-prefs",
+			Description: "Choose which events you get direct messages about",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show your notification preferences",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "Turn direct messages for an event on or off",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "event",
+							Description: "Event to change",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "👥 Assigned to an issue", Value: "assigned"},
+								{Name: "🔄 Status change", Value: "status_change"},
+								{Name: "💬 Mentioned in a thread", Value: "mention"},
+								{Name: "🚨 SLA breach", Value: "sla_breach"},
+								{Name: "👀 Watched issues", Value: "watching"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "enabled",
+							Description: "Whether to get direct messages for the event",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "notifications",
+			Description: "Show or change whether you get direct messages about your issues",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "enabled",
+					Description: "Turn direct message notifications on or off",
+					Required:    false,
+				},
+			},
+		},
+		{
+			Name:        "oncall",
+			Description: "Manage this project's on-call rotation",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show who is on call and the rotation order",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a member to the end of the rotation",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "Member to add",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a member from the rotation",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "Member to remove",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "handoff",
+					Description: "Hand the rotation to the next member now",
+				},
+			},
 		},
 		{
-			Name:        "issue-status",
-			Description: "Check the status and history of a specific issue",
+			Name:        "team",
+			Description: "Manage the profiles used to suggest who works on new issues",
 			Options: []*discordgo.ApplicationCommandOption{
 				{
-					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "id",
-					Description: "Issue ID to check",
-					Required:    true,
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List team members with their skills and open issues",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "Add a team member or change their profile",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "Member whose profile to set",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "role",
+							Description: "Role they are assigned with (default: Developer)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "👨‍💻 Developer", Value: "dev"},
+								{Name: "🧪 QA Tester", Value: "qa"},
+								{Name: "👀 Reviewer", Value: "reviewer"},
+								{Name: "👤 Other", Value: "other"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "skills",
+							Description: "Labels and components they cover, comma-separated (e.g. ios, payments)",
+							Required:    false,
+							MaxLength:   500,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "capacity",
+							Description: "Most open issues to suggest them for (0: unlimited)",
+							Required:    false,
+							MinValue:    &minTeamCapacity,
+							MaxValue:    maxTeamCapacity,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a member's team profile",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "Member to remove",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "suggest",
+					Description: "Suggest assignees for an issue again, e.g. after labeling it",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "id",
+							Description:  "Issue key (e.g. ACME-42), ID or ID prefix",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
 				},
 			},
 		},
-
-		// Utility Commands
 		{
-			Name:        "my-issues",
-			Description: "Show issues assigned to you",
+			Name:        "audit-log",
+			Description: "Show the latest administrative actions in this server",
 			Options: []*discordgo.ApplicationCommandOption{
 				{
-					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "role",
-					Description: "Filter by your role",
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "limit",
+					Description: fmt.Sprintf("Number of entries to show (default: %d)", defaultAuditLogEntries),
 					Required:    false,
-					Choices: []*discordgo.ApplicationCommandOptionChoice{
-						{Name: "👨‍💻 Developer", Value: "dev"},
-						{Name: "🧪 QA Tester", Value: "qa"},
-						{Name: "👀 Reviewer", Value: "reviewer"},
+					MinValue:    &minAuditLogEntries,
+					MaxValue:    maxAuditLogEntries,
+				},
+			},
+		},
+		{
+			Name:        "settings",
+			Description: "Configure the bot for this server",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show this server's settings",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "locale",
+					Description: "Set the default language of bot responses",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "locale",
+							Description: "Language code, e.g. en, th or en-US",
+							Required:    true,
+							MaxLength:   5,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "timezone",
+					Description: "Set the time zone digests, stats and exports count days in",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "timezone",
+							Description: "IANA time zone, e.g. Asia/Bangkok, Europe/Berlin or UTC",
+							Required:    true,
+							MaxLength:   64,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "admin-role-add",
+					Description: "Give members of a role the admin role",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionRole,
+							Name:        "role",
+							Description: "Role to grant admin",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "admin-role-remove",
+					Description: "Stop a role from granting the admin role",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionRole,
+							Name:        "role",
+							Description: "Role to remove",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "escalation-channel",
+					Description: "Set the channel receiving SLA breach alerts",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionChannel,
+							Name:         "channel",
+							Description:  "Channel for breach alerts; leave empty to use the default",
+							Required:     false,
+							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "report-emoji",
+					Description: "Set the reaction that reports a message as an issue",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "emoji",
+							Description: "Emoji to react with, or off; leave empty to use 🐞",
+							Required:    false,
+							MaxLength:   100,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "sla",
+					Description: "Override the SLA targets of a priority",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "priority",
+							Description: "Priority the targets apply to",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "🔴 High", Value: "high"},
+								{Name: "🟡 Medium", Value: "medium"},
+								{Name: "🟢 Low", Value: "low"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "response",
+							Description: "Time to first response, e.g. 4h; 0 disables it",
+							Required:    true,
+							MaxLength:   20,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "resolution",
+							Description: "Time to resolution, e.g. 72h; 0 disables it",
+							Required:    true,
+							MaxLength:   20,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "sla-clear",
+					Description: "Use the default SLA targets for a priority again",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "priority",
+							Description: "Priority the targets apply to",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "🔴 High", Value: "high"},
+								{Name: "🟡 Medium", Value: "medium"},
+								{Name: "🟢 Low", Value: "low"},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			Name:        "workflow",
-			Description: "Show the issue workflow and your current tasks",
+			Name:        "feature",
+			Description: "Turn experimental features on or off in this server",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show which features are on in this server",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "enable",
+					Description: "Turn a feature on in this server",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "feature",
+							Description: "Feature to change",
+							Required:    true,
+							Choices:     featureChoices(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "disable",
+					Description: "Turn a feature off in this server",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "feature",
+							Description: "Feature to change",
+							Required:    true,
+							Choices:     featureChoices(),
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "reset",
+					Description: "Use the bot's default for a feature again",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "feature",
+							Description: "Feature to change",
+							Required:    true,
+							Choices:     featureChoices(),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "workflow-config",
+			Description: "Customize this project's statuses and transitions",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show the project's workflow",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add-status",
+					Description: "Add a custom status",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Status name, e.g. Waiting for Customer",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove-status",
+					Description: "Remove a custom status and its transitions",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Custom status to remove",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add-transition",
+					Description: "Allow issues to move between two statuses",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "from",
+							Description: "Current status, built-in or custom",
+							Required:    true,
+							MaxLength:   50,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "to",
+							Description: "Next status, built-in or custom",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove-transition",
+					Description: "Remove a custom transition",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "from",
+							Description: "Current status",
+							Required:    true,
+							MaxLength:   50,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "to",
+							Description: "Next status",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "reset",
+					Description: "Drop all customizations and use the built-in workflow",
+				},
+			},
+		},
+		{
+			Name:        "custom-fields",
+			Description: "Manage the custom fields of this project's issues",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "List the project's custom fields",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "Add a custom field to the project's issues",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Field name, e.g. Environment",
+							Required:    true,
+							MaxLength:   45,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "type",
+							Description: "Kind of value the field holds",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Text", Value: "text"},
+								{Name: "Number", Value: "number"},
+								{Name: "Select", Value: "select"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "options",
+							Description: "Comma-separated choices of a select field, e.g. dev, staging, production",
+							Required:    false,
+							MaxLength:   1000,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "required",
+							Description: "Reporters must fill the field in (default: no)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Remove a custom field and its values on issues",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Custom field to remove",
+							Required:    true,
+							MaxLength:   50,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "recurring",
+			Description: "Manage issues filed automatically on a schedule",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add",
+					Description: "File an issue into this project on a schedule",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "schedule",
+							Description: "Cron expression: minute hour day-of-month month day-of-week, e.g. 0 9 * * 1",
+							Required:    true,
+							MaxLength:   100,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "title",
+							Description: "Title of the filed issues, e.g. Weekly certificate check",
+							Required:    true,
+							MaxLength:   100,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "description",
+							Description: "Description of the filed issues",
+							Required:    false,
+							MaxLength:   1000,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "priority",
+							Description: "Priority of the filed issues (default: medium)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "🔴 High", Value: "high"},
+								{Name: "🟡 Medium", Value: "medium"},
+								{Name: "🟢 Low", Value: "low"},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List this project's recurring issues",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop filing a recurring issue",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "title",
+							Description: "Title of the recurring issue",
+							Required:    true,
+							MaxLength:   100,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "stale",
+			Description: "Manage when idle issues of this project are nudged and closed",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "show",
+					Description: "Show this project's stale issue thresholds",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "Set this project's stale issue thresholds",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "after",
+							Description: "Days without a status change or thread comment before assignees are nudged (0 = off)",
+							Required:    true,
+							MinValue:    &minStaleDays,
+							MaxValue:    maxStaleDays,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "close_after",
+							Description: "Further idle days after the nudge before the issue is closed (0 = never)",
+							Required:    false,
+							MinValue:    &minStaleDays,
+							MaxValue:    maxStaleDays,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "reset",
+					Description: "Use the bot's default stale issue thresholds",
+				},
+			},
+		},
+
+		{
+			Name:        "escalation",
+			Description: "Manage how issues of this project that stay open too long are escalated",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "set",
+					Description: "Escalate issues of a priority that stay open too long",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "priority",
+							Description: "Priority of the issues to escalate",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "🔴 High", Value: "high"},
+								{Name: "🟡 Medium", Value: "medium"},
+								{Name: "🟢 Low", Value: "low"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "hours",
+							Description: "Hours an issue may stay open before it is escalated",
+							Required:    true,
+							MinValue:    &minEscalationHours,
+							MaxValue:    maxEscalationHours,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionRole,
+							Name:        "role",
+							Description: "Role to ping in the issue thread",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "bump",
+							Description: "Also raise the issue one priority (default: false)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "Show this project's escalation rules",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove",
+					Description: "Stop escalating issues of a priority",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "priority",
+							Description: "Priority of the rule to remove",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "🔴 High", Value: "high"},
+								{Name: "🟡 Medium", Value: "medium"},
+								{Name: "🟢 Low", Value: "low"},
+							},
+						},
+					},
+				},
+			},
 		},
 
 		// Setup Commands
@@ -78,91 +1790,483 @@ func (cm *CommandManager) getCommandDefinitions() []*discordgo.ApplicationComman
 			Name:        "register",
 			Description: "Register this channel for issue tracking with customer and project information",
 		},
+		{
+			Name:        "channel-admin",
+			Description: "Manage this channel's registration",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "info",
+					Description: "Show this channel's registration",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "update",
+					Description: "Change the customer and project of this channel",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "customer",
+							Description: "Customer or organization name; created if it does not exist",
+							Required:    true,
+							MaxLength:   255,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "project",
+							Description: "Project name; created for the customer if it does not exist",
+							Required:    true,
+							MaxLength:   255,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "deactivate",
+					Description: "Stop accepting new issues in this channel",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "activate",
+					Description: "Accept new issues in this channel again",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "add-project",
+					Description: "Track another project in this channel; reporters choose the project of each issue",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "customer",
+							Description: "Customer or organization name; created if it does not exist",
+							Required:    true,
+							MaxLength:   255,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "project",
+							Description: "Project name; created for the customer if it does not exist",
+							Required:    true,
+							MaxLength:   255,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "remove-project",
+					Description: "Stop tracking a further project in this channel",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "project",
+							Description:  "Issue key prefix of the project, e.g. ACME",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "transfer-project",
+					Description: "Move this channel to another project of this server",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "project",
+							Description:  "Issue key prefix of the project, e.g. ACME",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "customer",
+			Description: "Manage the customers of this server",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "create",
+					Description: "Add a customer",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Customer or organization name",
+							Required:    true,
+							MaxLength:   255,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "email",
+							Description: "Contact email that gets issue notifications",
+							MaxLength:   255,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rename",
+					Description: "Rename a customer",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "customer",
+							Description:  "Customer to rename",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "New name",
+							Required:    true,
+							MaxLength:   255,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List the customers of this server and their projects",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "merge",
+					Description: "Move the projects of a customer to another and delete it",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "from",
+							Description:  "Customer to merge and delete",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "into",
+							Description:  "Customer to keep",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "project",
+			Description: "Manage the projects of this server's customers",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "create",
+					Description: "Add a project for a customer",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "customer",
+							Description:  "Customer the project is for",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Project name",
+							Required:    true,
+							MaxLength:   255,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "description",
+							Description: "What the project is about",
+							MaxLength:   1000,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rename",
+					Description: "Rename a project; its issue keys stay the same",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "project",
+							Description:  "Issue key prefix of the project, e.g. ACME",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "New name",
+							Required:    true,
+							MaxLength:   255,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List the projects of this server's customers",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "customer",
+							Description:  "Only list the projects of this customer",
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "merge",
+					Description: "Move the issues and channels of a project to another and delete it",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "from",
+							Description:  "Issue key prefix of the project to merge and delete",
+							Required:     true,
+							Autocomplete: true,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "into",
+							Description:  "Issue key prefix of the project to keep",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:        "help",
 			Description: "Show help information for the bot",
 		},
 	}
+	localizeCommands(commands)
+	return commands
 }
 
-// RegisterCommands registers all slash commands with Discord
-func (cm *CommandManager) RegisterCommands() error {
-	commands := cm.getCommandDefinitions()
+// SyncCommands reconciles the commands registered globally, or in a guild if guildID is
+// set, with the bot's command definitions
+func (cm *CommandManager) SyncCommands(guildID string) error {
+	return cm.syncCommands(cm.session, guildID, cm.getCommandDefinitions())
+}
 
-	cm.logger.Info("Registering Discord commands")
+// SyncGlobalCommands reconciles the global commands with the command scope: the bot's
+// commands when they are registered globally, none when they are registered per guild
+func (cm *CommandManager) SyncGlobalCommands() error {
+	if cm.scope == config.CommandScopeGuild {
+		return cm.syncCommands(cm.session, "", nil)
+	}
+	return cm.SyncCommands("")
+}
 
-	// Register commands globally
-	for _, command := range commands {
-		_, err := cm.session.ApplicationCommandCreate(cm.session.State.User.ID, "", command)
-		if err != nil {
-			cm.logger.Error("Failed to register command",
-				zap.Error(err),
-				zap.String("command", command.Name),
-			)
-			return fmt.Errorf("failed to register command %s: %w", command.Name, err)
-		}
+// HandleGuildCreate reconciles the commands of a guild with the command scope once per
+// process, when its shard connects or the bot joins it. Guild commands left from the
+// other scope would show twice, so with global commands they are removed.
+func (cm *CommandManager) HandleGuildCreate(s *discordgo.Session, g *discordgo.GuildCreate) {
+	if g.Unavailable {
+		return
+	}
+	if _, synced := cm.syncedGuilds.LoadOrStore(g.ID, true); synced {
+		return
+	}
 
-		cm.logger.Debug("Registered command", zap.String("command", command.Name))
+	var commands []*discordgo.ApplicationCommand
+	if cm.scope == config.CommandScopeGuild {
+		commands = cm.getCommandDefinitions()
+	}
+	if err := cm.syncCommands(s, g.ID, commands); err != nil {
+		cm.logger.Error("Failed to sync guild commands", zap.Error(err), zap.String("guild_id", g.ID))
+		cm.syncedGuilds.Delete(g.ID)
 	}
+}
 
-	cm.logger.Info("All Discord commands registered successfully")
-	return nil
+// CleanupGuildCommands removes all commands registered for a specific guild, or the
+// global commands if guildID is empty
+func (cm *CommandManager) CleanupGuildCommands(guildID string) error {
+	return cm.syncCommands(cm.session, guildID, nil)
 }
 
-// RegisterGuildCommands registers commands for a specific guild (server)
-func (cm *CommandManager) RegisterGuildCommands(guildID string) error {
-	// Use the same commands as global registration
-	commands := cm.getCommandDefinitions()
+// syncCommands makes the commands registered globally, or in a guild, match commands:
+// missing ones are created, changed ones edited and the others deleted. Commands that are
+// up to date are left alone, so Discord does not propagate them again. The calls go
+// through session, whose State must hold the bot user.
+func (cm *CommandManager) syncCommands(session *discordgo.Session, guildID string, commands []*discordgo.ApplicationCommand) error {
+	appID := session.State.User.ID
+
+	existing, err := session.ApplicationCommands(appID, guildID)
+	if err != nil {
+		cm.logger.Error("Failed to get application commands", zap.Error(err), zap.String("guild_id", guildID))
+		return fmt.Errorf("failed to get application commands: %w", err)
+	}
 
-	cm.logger.Info("Registering Discord commands for guild", zap.String("guild_id", guildID))
+	registered := make(map[commandKey]*discordgo.ApplicationCommand, len(existing))
+	for _, command := range existing {
+		registered[keyOf(command)] = command
+	}
 
+	var created, updated, unchanged, deleted int
 	for _, command := range commands {
-		_, err := cm.session.ApplicationCommandCreate(cm.session.State.User.ID, guildID, command)
-		if err != nil {
-			cm.logger.Error("Failed to register guild command",
+		key := keyOf(command)
+		current, ok := registered[key]
+		delete(registered, key)
+
+		switch {
+		case !ok:
+			if _, err := session.ApplicationCommandCreate(appID, guildID, command); err != nil {
+				cm.logger.Error("Failed to create command",
+					zap.Error(err),
+					zap.String("command", command.Name),
+					zap.String("guild_id", guildID),
+				)
+				return fmt.Errorf("failed to create command %s: %w", command.Name, err)
+			}
+			created++
+			cm.logger.Debug("Created command", zap.String("command", command.Name), zap.String("guild_id", guildID))
+		case !reflect.DeepEqual(specOf(command), specOf(current)):
+			if _, err := session.ApplicationCommandEdit(appID, guildID, current.ID, command); err != nil {
+				cm.logger.Error("Failed to update command",
+					zap.Error(err),
+					zap.String("command", command.Name),
+					zap.String("guild_id", guildID),
+				)
+				return fmt.Errorf("failed to update command %s: %w", command.Name, err)
+			}
+			updated++
+			cm.logger.Debug("Updated command", zap.String("command", command.Name), zap.String("guild_id", guildID))
+		default:
+			unchanged++
+		}
+	}
+
+	// Commands the bot no longer has
+	for _, command := range registered {
+		if err := session.ApplicationCommandDelete(appID, guildID, command.ID); err != nil {
+			cm.logger.Error("Failed to delete command",
 				zap.Error(err),
 				zap.String("command", command.Name),
 				zap.String("guild_id", guildID),
 			)
-			return fmt.Errorf("failed to register guild command %s: %w", command.Name, err)
+			continue
 		}
-
-		cm.logger.Debug("Registered guild command",
-			zap.String("command", command.Name),
-			zap.String("guild_id", guildID),
-		)
+		deleted++
+		cm.logger.Debug("Deleted command", zap.String("command", command.Name), zap.String("guild_id", guildID))
 	}
 
-	cm.logger.Info("All Discord commands registered successfully for guild", zap.String("guild_id", guildID))
+	cm.logger.Info("Discord commands synced",
+		zap.String("guild_id", guildID),
+		zap.Int("created", created),
+		zap.Int("updated", updated),
+		zap.Int("deleted", deleted),
+		zap.Int("unchanged", unchanged),
+	)
 	return nil
 }
 
-// CleanupCommands removes all registered commands
-func (cm *CommandManager) CleanupCommands() error {
-	cm.logger.Info("Cleaning up Discord commands")
+// commandKey identifies a command within its scope: names are unique per command type
+type commandKey struct {
+	Type discordgo.ApplicationCommandType
+	Name string
+}
 
-	// Get all registered commands
-	commands, err := cm.session.ApplicationCommands(cm.session.State.User.ID, "")
-	if err != nil {
-		cm.logger.Error("Failed to get application commands", zap.Error(err))
-		return fmt.Errorf("failed to get application commands: %w", err)
+// keyOf returns the key of a command. Commands defined without a type are slash commands.
+func keyOf(command *discordgo.ApplicationCommand) commandKey {
+	commandType := command.Type
+	if commandType == 0 {
+		commandType = discordgo.ChatApplicationCommand
 	}
+	return commandKey{Type: commandType, Name: command.Name}
+}
 
-	// Delete each command
-	for _, command := range commands {
+// commandSpec holds the parts of a command the bot defines, in a form that is equal for a
+// definition and the command Discord returns for it
+type commandSpec struct {
+	Description              string
+	NameLocalizations        map[discordgo.Locale]string
+	DescriptionLocalizations map[discordgo.Locale]string
+	Options                  []optionSpec
+}
 
-		err := cm.session.ApplicationCommandDelete(cm.session.State.User.ID, command.GuildID, command.ID)
-		if err != nil {
-			cm.logger.Error("Failed to delete command",
-				zap.Error(err),
-				zap.String("command", command.Name),
-			)
-			continue
-		}
+// optionSpec holds the parts of a command option the bot defines
+type optionSpec struct {
+	Type                     discordgo.ApplicationCommandOptionType
+	Name                     string
+	Description              string
+	DescriptionLocalizations map[discordgo.Locale]string
+	Required                 bool
+	Autocomplete             bool
+	ChannelTypes             []discordgo.ChannelType
+	MinValue                 *float64
+	MaxValue                 float64
+	MaxLength                int
+	Choices                  []choiceSpec
+	Options                  []optionSpec
+}
+
+// choiceSpec holds a choice of a command option; numbers are read back from Discord as
+// floats, so values are compared as text
+type choiceSpec struct {
+	Name              string
+	NameLocalizations map[discordgo.Locale]string
+	Value             string
+}
+
+// specOf returns the parts of a command the bot defines
+func specOf(command *discordgo.ApplicationCommand) commandSpec {
+	spec := commandSpec{
+		Description: command.Description,
+		Options:     optionSpecsOf(command.Options),
+	}
+	if command.NameLocalizations != nil {
+		spec.NameLocalizations = localizationsOf(*command.NameLocalizations)
+	}
+	if command.DescriptionLocalizations != nil {
+		spec.DescriptionLocalizations = localizationsOf(*command.DescriptionLocalizations)
+	}
+	return spec
+}
 
-		cm.logger.Debug("Deleted command", zap.String("command", command.Name))
+// optionSpecsOf returns the parts of command options the bot defines
+func optionSpecsOf(options []*discordgo.ApplicationCommandOption) []optionSpec {
+	var specs []optionSpec
+	for _, option := range options {
+		spec := optionSpec{
+			Type:                     option.Type,
+			Name:                     option.Name,
+			Description:              option.Description,
+			DescriptionLocalizations: localizationsOf(option.DescriptionLocalizations),
+			Required:                 option.Required,
+			Autocomplete:             option.Autocomplete,
+			MinValue:                 option.MinValue,
+			MaxValue:                 option.MaxValue,
+			MaxLength:                option.MaxLength,
+			Options:                  optionSpecsOf(option.Options),
+		}
+		if len(option.ChannelTypes) > 0 {
+			spec.ChannelTypes = option.ChannelTypes
+		}
+		for _, choice := range option.Choices {
+			spec.Choices = append(spec.Choices, choiceSpec{
+				Name:              choice.Name,
+				NameLocalizations: localizationsOf(choice.NameLocalizations),
+				Value:             fmt.Sprint(choice.Value),
+			})
+		}
+		specs = append(specs, spec)
 	}
+	return specs
+}
 
-	cm.logger.Info("Discord commands cleanup completed")
-	return nil
+// localizationsOf returns localizations, or nil if there are none
+func localizationsOf(localizations map[discordgo.Locale]string) map[discordgo.Locale]string {
+	if len(localizations) == 0 {
+		return nil
+	}
+	return localizations
 }
@@ -0,0 +1,308 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// componentSelectPrefix starts the custom ID of the select menu filing an issue under a component
+const componentSelectPrefix = "issue_component_"
+
+// handleComponentCommand handles the /component slash command and its add, list, lead, set
+// and remove subcommands
+func (h *Handler) handleComponentCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: add, list, lead, set or remove."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		if option.Type == discordgo.ApplicationCommandOptionString {
+			args[option.Name] = option.StringValue()
+		}
+	}
+
+	h.logger.Info("Handling component command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	// Anyone can see the components and reporters can file their own issues; changing the
+	// components themselves requires the permission
+	if subcommand.Name != "list" && subcommand.Name != "set" && !h.authorize(ctx, i, domain.PermissionManageComponents) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	userID := i.Member.User.ID
+
+	switch subcommand.Name {
+	case "add":
+		component, err := h.componentService.Create(ctx, channelID, args["name"], userID)
+		if err != nil {
+			h.respondComponentError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🧱 Added component **%s**. Give it a lead with `/component lead`.", component.Name), true)
+	case "list":
+		components, err := h.componentService.List(ctx, channelID)
+		if err != nil {
+			h.respondComponentError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, formatComponents(ctx, components), true)
+	case "lead":
+		leadID := ""
+		if option := subcommand.GetOption("user"); option != nil {
+			leadID = option.UserValue(nil).ID
+		}
+		component, err := h.componentService.SetLead(ctx, channelID, args["component"], leadID)
+		if errors.Is(err, domain.ErrTeamMemberNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ <@%s> is not on the team. Add them with `/team set` first.", leadID), true)
+			return
+		}
+		if err != nil {
+			h.respondComponentError(ctx, i, err)
+			return
+		}
+		if component.Lead == nil {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "🧱 Component **%s** no longer has a lead.", component.Name), true)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🧱 <@%s> now leads component **%s** and is suggested first for its issues.", leadID, component.Name), true)
+	case "set":
+		issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+		if !ok {
+			return
+		}
+		if !h.authorizeComponentChange(ctx, i, issue) {
+			return
+		}
+		h.setIssueComponent(ctx, i, issue, args["component"], func(content string) {
+			h.respondToInteraction(ctx, i, content, true)
+		})
+	case "remove":
+		component, err := h.componentService.Delete(ctx, channelID, args["component"])
+		if err != nil {
+			h.respondComponentError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🗑️ Removed component **%s**. Its issues are kept without a component.", component.Name), true)
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// handleComponentSelection files an issue under the component picked in its select menu
+func (h *Handler) handleComponentSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	if len(data.Values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No component selected"), true)
+		return
+	}
+
+	// Format: "issue_component_<issue uuid>"
+	issueID, err := uuid.Parse(strings.TrimPrefix(data.CustomID, componentSelectPrefix))
+	if err != nil {
+		h.logger.Error("Invalid issue ID in component selector", zap.Error(err), zap.String("custom_id", data.CustomID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.authorizeComponentChange(ctx, i, issue) {
+		return
+	}
+
+	// The select menu is replaced by the outcome so it is not picked twice
+	h.setIssueComponent(ctx, i, issue, data.Values[0], func(content string) {
+		if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    content,
+				Components: []discordgo.MessageComponent{},
+			},
+		}); err != nil {
+			h.logger.Error("Failed to update component selector", zap.Error(err))
+		}
+	})
+}
+
+// setIssueComponent files an issue under a component, or removes it from its component for
+// an empty name, answers with reply and shows the change on the issue's card
+func (h *Handler) setIssueComponent(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue, name string, reply func(string)) {
+	before := issue.Component
+	issue, err := h.componentService.SetIssueComponent(ctx, issue.ID, name, i.Member.User.ID)
+	if err != nil {
+		h.respondComponentError(ctx, i, err)
+		return
+	}
+
+	userID := i.Member.User.ID
+	switch {
+	case issue.Component == nil && before == nil:
+		reply(i18n.T(ctx, "ℹ️ **%s** is not filed under a component.", issueDisplayName(issue)))
+	case issue.Component == nil:
+		reply(i18n.T(ctx, "🧱 Removed **%s** from component **%s**.", issueDisplayName(issue), before.Name))
+		h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "🧱 Removed from component **%s** by <@%s>", before.Name, userID))
+	case before != nil && before.ID == issue.Component.ID:
+		reply(i18n.T(ctx, "ℹ️ **%s** is already filed under component **%s**.", issueDisplayName(issue), issue.Component.Name))
+	default:
+		reply(i18n.T(ctx, "🧱 Filed **%s** under component **%s**.", issueDisplayName(issue), issue.Component.Name))
+		h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "🧱 Filed under component **%s** by <@%s>", issue.Component.Name, userID))
+	}
+}
+
+// authorizeComponentChange lets the reporter of an issue pick its component, and otherwise
+// requires the permission to manage components
+func (h *Handler) authorizeComponentChange(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue) bool {
+	if issue.Reporter.DiscordID != "" && issue.Reporter.DiscordID == i.Member.User.ID {
+		return true
+	}
+	return h.authorize(ctx, i, domain.PermissionManageComponents)
+}
+
+// respondComponentError explains why a component command failed
+func (h *Handler) respondComponentError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrComponentNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no component with that name. See `/component list`."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update components. Please try again."))
+	}
+}
+
+// suggestComponents suggests the components of the channel's project for a component option
+func (h *Handler) suggestComponents(ctx context.Context, i *discordgo.InteractionCreate, typed string) {
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	components, err := h.componentService.List(ctx, channelID)
+	if err != nil {
+		h.respondWithChoices(i, nil)
+		return
+	}
+
+	typed = strings.ToLower(typed)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, maxAutocompleteChoices)
+	for _, component := range components {
+		if !strings.Contains(strings.ToLower(component.Name), typed) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  truncateText(component.Name, maxChoiceNameLength),
+			Value: component.Name,
+		})
+		if len(choices) == maxAutocompleteChoices {
+			break
+		}
+	}
+
+	h.respondWithChoices(i, choices)
+}
+
+// createComponentSelect creates the select menu filing an issue under one of its project's components
+func createComponentSelect(ctx context.Context, issueID uuid.UUID, components []*domain.Component) discordgo.ActionsRow {
+	options := make([]discordgo.SelectMenuOption, 0, len(components))
+	for _, component := range components {
+		options = append(options, discordgo.SelectMenuOption{
+			Label: component.Name,
+			Value: component.Name,
+			Emoji: &discordgo.ComponentEmoji{Name: "🧱"},
+		})
+	}
+
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				CustomID:    componentSelectPrefix + issueID.String(),
+				Placeholder: i18n.T(ctx, "Select component"),
+				Options:     options,
+			},
+		},
+	}
+}
+
+// projectComponents lists the components of an issue's project, or none if they cannot be loaded
+func (h *Handler) projectComponents(ctx context.Context, issue *domain.Issue) []*domain.Component {
+	components, err := h.componentService.ListByProject(ctx, issue.ProjectID)
+	if err != nil {
+		h.logger.Error("Failed to list project components",
+			zap.Error(err),
+			zap.String("project_id", issue.ProjectID.String()),
+		)
+		return nil
+	}
+	return components
+}
+
+// offerComponentSelect asks the reporter of a newly created issue which component it is
+// about, when its project has any
+func (h *Handler) offerComponentSelect(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue) {
+	components := h.projectComponents(ctx, issue)
+	if len(components) == 0 {
+		return
+	}
+
+	if _, err := h.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content:    i18n.T(ctx, "🧱 Which part of the project is **%s** about? Picking a component routes it to the right people.", issueDisplayName(issue)),
+		Components: []discordgo.MessageComponent{createComponentSelect(ctx, issue.ID, components)},
+		Flags:      discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to send component selector", zap.Error(err))
+	}
+}
+
+// sendComponentSelector posts the component select menu in an issue's thread, unless the
+// issue already has a component or its project has none
+func (h *Handler) sendComponentSelector(ctx context.Context, channelID string, issue *domain.Issue) {
+	if issue.ComponentID != nil {
+		return
+	}
+	components := h.projectComponents(ctx, issue)
+	if len(components) == 0 {
+		return
+	}
+
+	if _, err := h.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:    i18n.T(ctx, "🧱 **Set Issue Component:**"),
+		Components: []discordgo.MessageComponent{createComponentSelect(ctx, issue.ID, components)},
+	}); err != nil {
+		h.logger.Error("Failed to send component selector", zap.Error(err))
+	}
+}
+
+// formatComponents describes a project's components and their leads for /component list
+func formatComponents(ctx context.Context, components []*domain.Component) string {
+	if len(components) == 0 {
+		return i18n.T(ctx, "🧱 This project has no components. Add one with `/component add`.")
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "🧱 **Components (%d):**\n", len(components)))
+	for _, component := range components {
+		content.WriteString(fmt.Sprintf("• **%s**", component.Name))
+		if component.Lead != nil {
+			content.WriteString(i18n.T(ctx, " · lead <@%s>", component.Lead.DiscordID))
+		}
+		content.WriteString("\n")
+	}
+	return content.String()
+}
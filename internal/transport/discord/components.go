@@ -3,6 +3,10 @@ package discord
 import (
 	"fix-track-bot/internal/domain"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -36,13 +40,22 @@ func CreateIssueCard(issue *domain.Issue) (*discordgo.MessageEmbed, []discordgo.
 			},
 			{
 				Name:   "Reporter",
-				Value:  fmt.Sprintf("<@%s>", issue.Reporter.DiscordID),
+				Value:  formatReporter(&issue.Reporter),
 				Inline: true,
 			},
 		},
 		Timestamp: issue.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
+	// Show the severity of incidents next to the priority
+	if issue.Severity != "" {
+		embed.Fields = slices.Insert(embed.Fields, 2, &discordgo.MessageEmbedField{
+			Name:   "Severity",
+			Value:  fmt.Sprintf("%s %s", getSeverityEmoji(issue.Severity), issue.Severity.GetDisplayName()),
+			Inline: true,
+		})
+	}
+
 	// Add assignees if any
 	if len(issue.Assignees) > 0 {
 		assigneeText := ""
@@ -57,12 +70,276 @@ func CreateIssueCard(issue *domain.Issue) (*discordgo.MessageEmbed, []discordgo.
 		})
 	}
 
+	// Suggest team members while nobody works on the issue
+	if suggestions := issue.PendingSuggestions(); len(suggestions) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Suggested Assignees",
+			Value:  formatSuggestedAssignees(suggestions),
+			Inline: false,
+		})
+	}
+
+	// Add label chips if any
+	if len(issue.Labels) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Labels",
+			Value:  "🏷️ " + formatLabels(issue.Labels),
+			Inline: false,
+		})
+	}
+
+	// Show the values of the project's custom fields in the project's field order
+	values := append([]domain.IssueCustomFieldValue(nil), issue.CustomFieldValues...)
+	sort.SliceStable(values, func(a, b int) bool {
+		return values[a].Field.Position < values[b].Field.Position
+	})
+	for _, value := range values {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   value.Field.Name,
+			Value:  value.Value,
+			Inline: true,
+		})
+	}
+
+	// Show the due date, marking overdue issues
+	if issue.DueDate != nil {
+		value := fmt.Sprintf("<t:%d:f> (<t:%d:R>)", issue.DueDate.Unix(), issue.DueDate.Unix())
+		if issue.IsOverdue(time.Now()) {
+			value = "⏰ " + value
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Due",
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	// Show the milestone the issue is planned for
+	if issue.Milestone != nil {
+		value := "🎯 " + issue.Milestone.Name
+		if issue.Milestone.TargetDate != nil {
+			value += fmt.Sprintf(" (%s)", issue.Milestone.FormatTargetDate())
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Milestone",
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	// Show the component the issue is filed under
+	if issue.Component != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Component",
+			Value:  "🧱 " + issue.Component.Name,
+			Inline: true,
+		})
+	}
+
+	// Show the time tracked on the issue and timers still running
+	if spent, running := issue.TimeSpent(), issue.RunningTimers(); spent > 0 || running > 0 {
+		value := formatTimeSpent(spent)
+		if running > 0 {
+			value += fmt.Sprintf(" (⏳ %d running)", running)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "⏱️ Time Spent",
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	// Show the parent of a sub-task, and the progress of an issue's sub-tasks
+	if issue.ParentIssue != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Parent Issue",
+			Value:  fmt.Sprintf("%s **%s** %s", getStatusEmoji(issue.ParentIssue.Status), issue.ParentIssue.ShortID(), truncateText(issue.ParentIssue.Title, 60)),
+			Inline: false,
+		})
+	}
+	if closed, total := issue.GetSubIssueProgress(); total > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("Sub-tasks (%d/%d closed)", closed, total),
+			Value:  formatSubIssues(issue.SubIssues),
+			Inline: false,
+		})
+	}
+
+	// Show related issues if any
+	if links := formatIssueLinks(issue); links != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Linked Issues",
+			Value:  links,
+			Inline: false,
+		})
+	}
+
+	// Show the screenshot (explicit image URL first, then the first attached image)
+	if imageURL := getDisplayValue(issue.ImageURL, domain.FirstImageURL(issue.Attachments)); imageURL != "" {
+		embed.Image = &discordgo.MessageEmbedImage{URL: imageURL}
+	}
+
+	// List attached files
+	if len(issue.Attachments) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("Attachments (%d)", len(issue.Attachments)),
+			Value:  formatAttachments(issue.Attachments),
+			Inline: false,
+		})
+	}
+
 	// Create action buttons based on current status
 	buttons := createActionButtons(issue)
 
 	return embed, buttons
 }
 
+// formatAttachments renders attachments as links, staying within the embed field limit
+func formatAttachments(attachments []domain.IssueAttachment) string {
+	var lines []string
+	length := 0
+	for i, attachment := range attachments {
+		icon := "📎"
+		if attachment.IsImage() {
+			icon = "🖼️"
+		}
+		line := fmt.Sprintf("%s [%s](%s)", icon, attachment.FileName, attachment.URL)
+		if length+len(line) > 950 {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(attachments)-i))
+			break
+		}
+		lines = append(lines, line)
+		length += len(line) + 1
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CreateResolutionSummary creates an embed summarising how an issue was resolved
+func CreateResolutionSummary(issue *domain.Issue, resolverID string) *discordgo.MessageEmbed {
+	return &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("%s Resolved: %s", getStatusEmoji(domain.StatusResolved), issue.Title),
+		Color: getStatusColorInt(domain.StatusResolved),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:  "Root Cause",
+				Value: issue.ResolutionCause,
+			},
+			{
+				Name:  "Corrective Action",
+				Value: issue.ResolutionAction,
+			},
+			{
+				Name:   "Resolved By",
+				Value:  fmt.Sprintf("<@%s>", resolverID),
+				Inline: true,
+			},
+		},
+		Timestamp: issue.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// workflowStage describes one stage of the issue workflow diagram
+type workflowStage struct {
+	number int
+	name   string
+	status domain.Status // empty for stages that are not a status of their own
+}
+
+// workflowStages lists the 7 workflow stages in order (see domain.GetWorkflowStage)
+var workflowStages = []workflowStage{
+	{number: 1, name: "Open", status: domain.StatusOpen},
+	{number: 2, name: "Developer Assigned"},
+	{number: 3, name: "In Progress", status: domain.StatusInProgress},
+	{number: 4, name: "Resolved", status: domain.StatusResolved},
+	{number: 5, name: "QA Assigned"},
+	{number: 6, name: "Verified", status: domain.StatusVerified},
+	{number: 7, name: "Closed", status: domain.StatusClosed},
+}
+
+// maxWorkflowIssuesPerStage limits how many issues are listed under each stage
+const maxWorkflowIssuesPerStage = 5
+
+// CreateWorkflowEmbed creates an embed showing the workflow diagram and the given
+// assignments grouped by workflow stage
+func CreateWorkflowEmbed(assignments []*domain.IssueAssignee) *discordgo.MessageEmbed {
+	// Group the user's assignments by stage; stage 0 holds rejected/reopened issues
+	byStage := make(map[int][]*domain.IssueAssignee)
+	for _, assignment := range assignments {
+		stage := assignment.Issue.GetWorkflowStage()
+		byStage[stage] = append(byStage[stage], assignment)
+	}
+
+	var diagram strings.Builder
+	for _, stage := range workflowStages {
+		emoji := "▫️"
+		if stage.status != "" {
+			emoji = getStatusEmoji(stage.status)
+		}
+
+		line := fmt.Sprintf("`%d` %s %s", stage.number, emoji, stage.name)
+		if count := len(byStage[stage.number]); count > 0 {
+			line = fmt.Sprintf("**%s** ← %d yours", line, count)
+		}
+		diagram.WriteString(line + "\n")
+	}
+	diagram.WriteString(fmt.Sprintf("\n%s Rejected returns to In Progress, %s Reopened returns to Open",
+		getStatusEmoji(domain.StatusRejected), getStatusEmoji(domain.StatusReopened)))
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "🔄 Issue Workflow",
+		Description: diagram.String(),
+		Color:       getStatusColorInt(domain.StatusInProgress),
+	}
+
+	for _, stage := range workflowStages {
+		if items := byStage[stage.number]; len(items) > 0 {
+			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+				Name:  fmt.Sprintf("%d. %s", stage.number, stage.name),
+				Value: formatWorkflowTasks(items),
+			})
+		}
+	}
+
+	if items := byStage[0]; len(items) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "⚠️ Needs Attention",
+			Value: formatWorkflowTasks(items),
+		})
+	}
+
+	if len(assignments) == 0 {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: "You have no active assignments"}
+	}
+
+	return embed
+}
+
+// formatWorkflowTasks lists assignments with links to their issue threads
+func formatWorkflowTasks(assignments []*domain.IssueAssignee) string {
+	var b strings.Builder
+	for idx, assignment := range assignments {
+		if idx >= maxWorkflowIssuesPerStage {
+			b.WriteString(fmt.Sprintf("*... and %d more*", len(assignments)-maxWorkflowIssuesPerStage))
+			break
+		}
+
+		issue := assignment.Issue
+		b.WriteString(fmt.Sprintf("%s %s `%s`", getRoleEmoji(assignment.Role), issue.Title, issue.ShortID()))
+		if issue.ThreadID != "" {
+			b.WriteString(fmt.Sprintf(" <#%s>", issue.ThreadID))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+const (
+	// maxActionRows is how many rows of components a Discord message can have
+	maxActionRows = 5
+	// maxSuggestedAssigneeLabel shortens team member names on suggested assignee buttons
+	maxSuggestedAssigneeLabel = 60
+)
+
 // createActionButtons creates context-aware action buttons based on issue status
 func createActionButtons(issue *domain.Issue) []discordgo.MessageComponent {
 	// Get possible next statuses
@@ -73,6 +350,9 @@ func createActionButtons(issue *domain.Issue) []discordgo.MessageComponent {
 
 	for _, status := range nextStatuses {
 		button := createStatusButton(issue.ID.String(), status)
+		if custom := issue.Workflow().CustomStatus(status); button == nil && custom != nil {
+			button = createCustomStatusButton(issue.ID.String(), custom)
+		}
 		if button != nil {
 			buttons = append(buttons, button)
 		}
@@ -112,9 +392,47 @@ func createActionButtons(issue *domain.Issue) []discordgo.MessageComponent {
 		rows = append(rows, row)
 	}
 
+	// Suggested assignees get a row of their own, if the message has room for it
+	if suggestions := issue.PendingSuggestions(); len(suggestions) > 0 && len(rows) < maxActionRows {
+		rows = append(rows, createSuggestedAssigneeButtons(issue.ID.String(), suggestions))
+	}
+
 	return rows
 }
 
+// createSuggestedAssigneeButtons creates a button assigning each suggested team member
+func createSuggestedAssigneeButtons(issueID string, suggestions []domain.IssueSuggestedAssignee) discordgo.MessageComponent {
+	buttons := make([]discordgo.MessageComponent, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		label := truncateText(suggestion.Name, maxSuggestedAssigneeLabel)
+		if suggestion.Role != domain.AssigneeRoleDev {
+			label += fmt.Sprintf(" (%s)", suggestion.Role.GetDisplayName())
+		}
+		buttons = append(buttons, &discordgo.Button{
+			Label:    label,
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("suggest_assign_%s_%s", issueID, suggestion.UserID.String()),
+			Emoji: &discordgo.ComponentEmoji{
+				Name: "💡",
+			},
+		})
+	}
+	return discordgo.ActionsRow{Components: buttons}
+}
+
+// formatSuggestedAssignees lists suggested team members with the skills they matched
+func formatSuggestedAssignees(suggestions []domain.IssueSuggestedAssignee) string {
+	var b strings.Builder
+	for _, suggestion := range suggestions {
+		b.WriteString(fmt.Sprintf("%s <@%s>", getRoleEmoji(suggestion.Role), suggestion.User.DiscordID))
+		if len(suggestion.Matched) > 0 {
+			b.WriteString(fmt.Sprintf(" · %s", strings.Join(suggestion.Matched, ", ")))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 // createStatusButton creates a button for a specific status transition
 func createStatusButton(issueID string, status domain.Status) discordgo.MessageComponent {
 	switch status {
@@ -172,37 +490,40 @@ func createStatusButton(issueID string, status domain.Status) discordgo.MessageC
 				Name: "🟣",
 			},
 		}
-		// case domain.StatusRejected:
-		// 	return &discordgo.Button{
-		// 		Label:    "🔴 Reject",
-		// 		Style:    discordgo.DangerButton,
-		// 		CustomID: fmt.Sprintf("reject_issue_%s", issueID),
-		// 		Emoji: &discordgo.ComponentEmoji{
-		// 			Name: "🔴",
-		// 		},
-		// 	}
-		// case domain.StatusReopened:
-		// 	return &discordgo.Button{
-		// 		Label:    "🟠 Reopen",
-		// 		Style:    discordgo.SecondaryButton,
-		// 		CustomID: fmt.Sprintf("reopen_issue_%s", issueID),
-		// 		Emoji: &discordgo.ComponentEmoji{
-		// 			Name: "🟠",
-		// 		},
-		// 	}
-		// case domain.StatusOpen:
-		// 	return &discordgo.Button{
-		// 		Label:    "🔵 Back to Open",
-		// 		Style:    discordgo.SecondaryButton,
-		// 		CustomID: fmt.Sprintf("back_to_open_%s", issueID),
-		// 		Emoji: &discordgo.ComponentEmoji{
-		// 			Name: "🔵",
-		// 		},
-		// 	}
+	case domain.StatusRejected:
+		return &discordgo.Button{
+			Label:    "Reject",
+			Style:    discordgo.DangerButton,
+			CustomID: fmt.Sprintf("reject_issue_%s", issueID),
+			Emoji: &discordgo.ComponentEmoji{
+				Name: "🔴",
+			},
+		}
+	case domain.StatusReopened:
+		return &discordgo.Button{
+			Label:    "Reopen",
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("reopen_issue_%s", issueID),
+			Emoji: &discordgo.ComponentEmoji{
+				Name: "🟠",
+			},
+		}
 	}
 	return nil
 }
 
+// createCustomStatusButton creates a button moving an issue to a status added by its project's workflow
+func createCustomStatusButton(issueID string, status *domain.WorkflowStatus) discordgo.MessageComponent {
+	return &discordgo.Button{
+		Label:    status.Name,
+		Style:    discordgo.SecondaryButton,
+		CustomID: fmt.Sprintf("set_status_%s_%s", issueID, status.Status),
+		Emoji: &discordgo.ComponentEmoji{
+			Name: getStatusEmoji(status.Status),
+		},
+	}
+}
+
 // CreateUserSelectMenu creates a select menu for choosing users
 func CreateUserSelectMenu(customID string, placeholder string, minValues, maxValues int) discordgo.MessageComponent {
 	return discordgo.ActionsRow{
@@ -386,6 +707,21 @@ func getPriorityEmoji(priority domain.Priority) string {
 	}
 }
 
+func getSeverityEmoji(severity domain.Severity) string {
+	switch severity {
+	case domain.SeverityS1:
+		return "🟥"
+	case domain.SeverityS2:
+		return "🟧"
+	case domain.SeverityS3:
+		return "🟨"
+	case domain.SeverityS4:
+		return "🟦"
+	default:
+		return "⚪"
+	}
+}
+
 func getRoleEmoji(role domain.AssigneeRole) string {
 	switch role {
 	case domain.AssigneeRoleDev:
@@ -420,3 +756,20 @@ func getStatusColorInt(status domain.Status) int {
 		return 0x7f8c8d // Default gray
 	}
 }
+
+// formatReporter mentions the reporter of an issue, or names reporters without a Discord
+// account, such as customers who sent the issue by email
+func formatReporter(reporter *domain.User) string {
+	switch {
+	case reporter.DiscordID != "":
+		return fmt.Sprintf("<@%s>", reporter.DiscordID)
+	case reporter.Name != "" && reporter.Email != "":
+		return fmt.Sprintf("%s (%s)", reporter.Name, reporter.Email)
+	case reporter.Email != "":
+		return reporter.Email
+	case reporter.Name != "":
+		return reporter.Name
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,254 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// customFieldInputPrefix starts the custom ID of a custom field input in an issue form,
+	// followed by the field ID
+	customFieldInputPrefix = "cf_"
+	// maxModalRows is the number of rows Discord allows in a modal
+	maxModalRows = 5
+	// maxInputPlaceholder is the length Discord allows for a text input placeholder
+	maxInputPlaceholder = 100
+)
+
+// handleCustomFieldsCommand handles the /custom-fields slash command and its subcommands
+func (h *Handler) handleCustomFieldsCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	required := false
+	for _, option := range subcommand.Options {
+		if option.Type == discordgo.ApplicationCommandOptionBoolean {
+			required = option.BoolValue()
+			continue
+		}
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling custom-fields command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageFields) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+
+	var (
+		content string
+		err     error
+	)
+	switch subcommand.Name {
+	case "show":
+		var fields []*domain.CustomFieldDefinition
+		if fields, err = h.customFieldService.ListFields(ctx, channelID); err == nil {
+			content = formatCustomFields(ctx, fields)
+		}
+	case "add":
+		var field *domain.CustomFieldDefinition
+		fieldType := domain.CustomFieldType(args["type"])
+		options := domain.ParseCustomFieldOptions(args["options"])
+		if field, err = h.customFieldService.AddField(ctx, channelID, args["name"], fieldType, options, required, i.Member.User.ID); err == nil {
+			content = i18n.T(ctx, "✅ Added custom field **%s** (%s). Reporters fill it in on the issue form; `/issue-field` sets it on existing issues.",
+				field.Name, field.Describe())
+		}
+	case "remove":
+		if err = h.customFieldService.RemoveField(ctx, channelID, args["name"]); err == nil {
+			content = i18n.T(ctx, "🗑️ Removed custom field **%s** and its values.", domain.NormalizeCustomFieldName(args["name"]))
+		}
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondCustomFieldsError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// handleIssueFieldCommand handles the /issue-field slash command, setting or clearing a
+// custom field of an issue
+func (h *Handler) handleIssueFieldCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	args := make(map[string]string)
+	for _, option := range i.ApplicationCommandData().Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling issue-field command",
+		zap.String("field", args["field"]),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+	if !ok {
+		return
+	}
+
+	if !h.authorizeEdit(ctx, i, issue) {
+		return
+	}
+
+	value, err := h.customFieldService.SetIssueValue(ctx, issue.ID, args["field"], args["value"])
+	if err != nil {
+		h.respondCustomFieldsError(ctx, i, err)
+		return
+	}
+
+	if value.Value == "" {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🧹 Cleared **%s** on **%s**", value.Field.Name, issue.Title), true)
+		h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "🧹 **%s** cleared by <@%s>", value.Field.Name, i.Member.User.ID))
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "📝 Set **%s** to `%s` on **%s**", value.Field.Name, value.Value, issue.Title), true)
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "📝 **%s** set to `%s` by <@%s>", value.Field.Name, value.Value, i.Member.User.ID))
+}
+
+// respondCustomFieldsError explains why a custom field change was refused
+func (h *Handler) respondCustomFieldsError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrCustomFieldNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no custom field with that name. See `/custom-fields show`."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update custom fields. Please try again."))
+	}
+}
+
+// formatCustomFields describes a project's custom fields for /custom-fields show
+func formatCustomFields(ctx context.Context, fields []*domain.CustomFieldDefinition) string {
+	if len(fields) == 0 {
+		return i18n.T(ctx, "🧩 This project has no custom fields. Add one with `/custom-fields add`.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🧩 **Custom fields (%d/%d)**\n", len(fields), domain.MaxCustomFields))
+	for _, field := range fields {
+		fmt.Fprintf(&b, "• **%s** (%s)", field.Name, field.Describe())
+		if field.Required {
+			b.WriteString(i18n.T(ctx, ", required"))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// formCustomFields retrieves the custom fields of a project for an issue form, or of the
+// main project of a Discord channel when projectID is uuid.Nil. A failed lookup leaves the
+// fields out, so reporting still works.
+func (h *Handler) formCustomFields(ctx context.Context, discordChannelID string, projectID uuid.UUID) []*domain.CustomFieldDefinition {
+	var (
+		fields []*domain.CustomFieldDefinition
+		err    error
+	)
+	if projectID != uuid.Nil {
+		fields, err = h.customFieldService.ListProjectFields(ctx, projectID)
+	} else {
+		fields, err = h.customFieldService.ListFields(ctx, discordChannelID)
+	}
+	if err != nil && !errors.Is(err, domain.ErrChannelNotFound) {
+		h.logger.Warn("Failed to get custom fields for issue form", zap.Error(err), zap.String("channel_id", discordChannelID))
+	}
+	return fields
+}
+
+// customFieldInputs builds issue form rows for as many custom fields as fit in room rows,
+// required fields first. Fields left out can be set later with /issue-field.
+func customFieldInputs(fields []*domain.CustomFieldDefinition, room int) []discordgo.MessageComponent {
+	ordered := make([]*domain.CustomFieldDefinition, 0, len(fields))
+	for _, field := range fields {
+		if field.Required {
+			ordered = append(ordered, field)
+		}
+	}
+	for _, field := range fields {
+		if !field.Required {
+			ordered = append(ordered, field)
+		}
+	}
+	if len(ordered) > room {
+		ordered = ordered[:max(room, 0)]
+	}
+
+	rows := make([]discordgo.MessageComponent, 0, len(ordered))
+	for _, field := range ordered {
+		rows = append(rows, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.TextInput{
+					CustomID:    customFieldInputPrefix + field.ID.String(),
+					Label:       field.Name,
+					Style:       discordgo.TextInputShort,
+					Placeholder: truncateText(field.Describe(), maxInputPlaceholder),
+					Required:    field.Required,
+					MaxLength:   domain.MaxCustomFieldValueLength,
+				},
+			},
+		})
+	}
+	return rows
+}
+
+// customFieldInputValues reads the custom field inputs of a submitted issue form, keyed by field ID
+func customFieldInputValues(data discordgo.ModalSubmitInteractionData) map[uuid.UUID]string {
+	values := make(map[uuid.UUID]string)
+	for _, row := range data.Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			input, ok := component.(*discordgo.TextInput)
+			if !ok || !strings.HasPrefix(input.CustomID, customFieldInputPrefix) {
+				continue
+			}
+			if fieldID, err := uuid.Parse(strings.TrimPrefix(input.CustomID, customFieldInputPrefix)); err == nil {
+				values[fieldID] = input.Value
+			}
+		}
+	}
+	return values
+}
+
+// saveDraftCustomFields stores the custom field values entered with a new issue. The issue
+// already exists, so values that cannot be stored only earn the reporter a note, which is
+// returned.
+func (h *Handler) saveDraftCustomFields(ctx context.Context, issueID uuid.UUID, values map[uuid.UUID]string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	err := h.customFieldService.SetIssueValues(ctx, issueID, values)
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, domain.ErrInvalidCustomFieldValue):
+		return i18n.T(ctx, "⚠️ The custom fields were not saved, %s. Set them with `/issue-field`.", errorMessage(ctx, err))
+	default:
+		h.logger.Error("Failed to store custom field values", zap.Error(err), zap.String("issue_id", issueID.String()))
+		return i18n.T(ctx, "⚠️ The custom fields could not be saved. Set them with `/issue-field`.")
+	}
+}
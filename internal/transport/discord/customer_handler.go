@@ -0,0 +1,513 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// mergeCustomerConfirmationAction is the action ID of the /customer merge confirmation buttons
+	mergeCustomerConfirmationAction = "merge-customer"
+	// mergeProjectConfirmationAction is the action ID of the /project merge confirmation buttons
+	mergeProjectConfirmationAction = "merge-project"
+	// maxCustomerListContent keeps the /customer list and /project list responses within
+	// Discord's message limit
+	maxCustomerListContent = 1900
+)
+
+// handleCustomerCommand handles the /customer slash command and its create, rename, list
+// and merge subcommands. Only the customers of the server can be seen and changed: those
+// added in it and those whose projects are tracked in its channels.
+func (h *Handler) handleCustomerCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: create, rename, list or merge."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = strings.TrimSpace(option.StringValue())
+	}
+
+	h.logger.Info("Handling customer command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageCustomers) {
+		return
+	}
+
+	switch subcommand.Name {
+	case "create":
+		customer, err := h.customerService.CreateCustomer(ctx, i.GuildID, args["name"], args["email"])
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🏢 Customer **%s** added. Add a project for it with `/project create`, or choose it when running `/register`.", customer.Name), true)
+	case "rename":
+		customer, err := h.guildCustomer(ctx, i.GuildID, args["customer"])
+		if err == nil {
+			err = h.customerService.UpdateCustomer(ctx, customer.ID, args["name"], customer.ContactEmail)
+		}
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "✏️ Customer **%s** is now called **%s**.", customer.Name, args["name"]), true)
+	case "list":
+		customers, err := h.customerService.ListGuildCustomers(ctx, i.GuildID)
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		projects, err := h.projectsOf(ctx, customers)
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, formatCustomers(ctx, customers, projects), true)
+	case "merge":
+		h.confirmCustomerMerge(ctx, i, args["from"], args["into"])
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// confirmCustomerMerge asks to confirm merging one customer of the guild into another
+func (h *Handler) confirmCustomerMerge(ctx context.Context, i *discordgo.InteractionCreate, fromValue, intoValue string) {
+	from, err := h.guildCustomer(ctx, i.GuildID, fromValue)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+	into, err := h.guildCustomer(ctx, i.GuildID, intoValue)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+	if from.ID == into.ID {
+		h.respondCustomerError(ctx, i, domain.ErrMergeCustomerIntoItself)
+		return
+	}
+
+	projects, err := h.projectService.GetProjectsByCustomer(ctx, from.ID)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "🔀 Merge customer **%s** into **%s**?\n\n"+
+			"Its %d project(s), users and API keys move to **%s**, and **%s** is deleted.",
+			from.Name, into.Name, len(projects), into.Name, from.Name),
+		Components: CreateConfirmationButtons(mergeCustomerConfirmationAction, from.ID.String()+"_"+into.ID.String()),
+		Flags:      discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond with customer merge confirmation", zap.Error(err))
+	}
+}
+
+// handleConfirmCustomerMergeButton merges the customers of a /customer merge confirmation
+func (h *Handler) handleConfirmCustomerMergeButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	fromID, intoID, ok := mergeConfirmationIDs(i.MessageComponentData().CustomID, mergeCustomerConfirmationAction)
+	if !ok {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid customer ID"), true)
+		return
+	}
+
+	// Permissions may have changed since the confirmation was shown
+	if !h.authorize(ctx, i, domain.PermissionManageCustomers) {
+		return
+	}
+
+	from, err := h.customerService.GetCustomer(ctx, fromID)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+	into, err := h.customerService.MergeCustomers(ctx, fromID, intoID)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+
+	h.updateMergeConfirmation(i, i18n.T(ctx, "🔀 Customer **%s** was merged into **%s**.", from.Name, into.Name))
+}
+
+// handleProjectCommand handles the /project slash command and its create, rename, list
+// and merge subcommands. Projects are those of the customers /customer manages and are
+// named by their issue key prefix.
+func (h *Handler) handleProjectCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: create, rename, list or merge."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = strings.TrimSpace(option.StringValue())
+	}
+
+	h.logger.Info("Handling project command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageCustomers) {
+		return
+	}
+
+	switch subcommand.Name {
+	case "create":
+		customer, err := h.guildCustomer(ctx, i.GuildID, args["customer"])
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		project, err := h.projectService.CreateProject(ctx, customer.ID, args["name"], args["description"])
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "📁 Project **%s** (`%s`) added for **%s**. Track it in a channel with `/register` or `/channel-admin add-project`.",
+			project.Name, project.KeyPrefix, customer.Name), true)
+	case "rename":
+		project, err := h.guildProject(ctx, i.GuildID, args["project"])
+		if err == nil {
+			err = h.projectService.UpdateProject(ctx, project.ID, args["name"], project.Description)
+		}
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "✏️ Project **%s** is now called **%s**. Its issue keys stay `%s-…`.", project.Name, args["name"], project.KeyPrefix), true)
+	case "list":
+		customers, err := h.customerService.ListGuildCustomers(ctx, i.GuildID)
+		if err == nil && args["customer"] != "" {
+			var customer *domain.Customer
+			customer, err = h.guildCustomer(ctx, i.GuildID, args["customer"])
+			customers = []*domain.Customer{customer}
+		}
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		projects, err := h.projectsOf(ctx, customers)
+		if err != nil {
+			h.respondCustomerError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, formatProjects(ctx, customers, projects), true)
+	case "merge":
+		h.confirmProjectMerge(ctx, i, args["from"], args["into"])
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// confirmProjectMerge asks to confirm merging one project of the guild into another
+func (h *Handler) confirmProjectMerge(ctx context.Context, i *discordgo.InteractionCreate, fromKey, intoKey string) {
+	from, err := h.guildProject(ctx, i.GuildID, fromKey)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+	into, err := h.guildProject(ctx, i.GuildID, intoKey)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+	if from.ID == into.ID {
+		h.respondCustomerError(ctx, i, domain.ErrMergeProjectIntoItself)
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "🔀 Merge project **%s** (`%s`) into **%s** (`%s`)?\n\n"+
+			"Its issues, keeping their keys, and its channels move to **%s**. Labels, milestones and custom fields of the same name are combined; "+
+			"webhooks, escalation rules, recurring issues, the workflow and the on-call schedule move unless **%s** has the same. **%s** is then deleted.",
+			from.Name, from.KeyPrefix, into.Name, into.KeyPrefix, into.Name, into.Name, from.Name),
+		Components: CreateConfirmationButtons(mergeProjectConfirmationAction, from.ID.String()+"_"+into.ID.String()),
+		Flags:      discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond with project merge confirmation", zap.Error(err))
+	}
+}
+
+// handleConfirmProjectMergeButton merges the projects of a /project merge confirmation
+func (h *Handler) handleConfirmProjectMergeButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	fromID, intoID, ok := mergeConfirmationIDs(i.MessageComponentData().CustomID, mergeProjectConfirmationAction)
+	if !ok {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid project ID"), true)
+		return
+	}
+
+	// Permissions may have changed since the confirmation was shown
+	if !h.authorize(ctx, i, domain.PermissionManageCustomers) {
+		return
+	}
+
+	from, err := h.projectService.GetProject(ctx, fromID)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+	into, err := h.projectService.MergeProjects(ctx, fromID, intoID)
+	if err != nil {
+		h.respondCustomerError(ctx, i, err)
+		return
+	}
+
+	h.updateMergeConfirmation(i, i18n.T(ctx, "🔀 Project **%s** (`%s`) was merged into **%s** (`%s`). Run `/resync` in its channels to bring the issue cards up to date.",
+		from.Name, from.KeyPrefix, into.Name, into.KeyPrefix))
+}
+
+// handleCancelMergeButton answers the cancel button of a merge confirmation
+func (h *Handler) handleCancelMergeButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.updateMergeConfirmation(i, i18n.T(ctx, "Merge cancelled."))
+}
+
+// updateMergeConfirmation replaces a merge confirmation prompt with a result and removes its buttons
+func (h *Handler) updateMergeConfirmation(i *discordgo.InteractionCreate, content string) {
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update merge confirmation", zap.Error(err))
+	}
+}
+
+// mergeConfirmationIDs reads the IDs of the merged and kept customer or project from the
+// custom ID of a merge confirmation button
+func mergeConfirmationIDs(customID, action string) (uuid.UUID, uuid.UUID, bool) {
+	from, into, ok := strings.Cut(strings.TrimPrefix(customID, "confirm_"+action+"_"), "_")
+	if !ok {
+		return uuid.Nil, uuid.Nil, false
+	}
+	fromID, err := uuid.Parse(from)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	intoID, err := uuid.Parse(into)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return fromID, intoID, true
+}
+
+// respondCustomerError explains why a /customer or /project subcommand failed
+func (h *Handler) respondCustomerError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrCustomerNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ No such customer in this server. Use `/customer list` to see them."), true)
+	case errors.Is(err, domain.ErrProjectNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ No project with that key belongs to a customer of this server. Use `/project list` to see them."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to manage customers and projects. Please try again."))
+	}
+}
+
+// guildCustomer finds a customer of the guild by the ID autocomplete fills in, or by name
+func (h *Handler) guildCustomer(ctx context.Context, guildID, value string) (*domain.Customer, error) {
+	customers, err := h.customerService.ListGuildCustomers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customer := range customers {
+		if customer.ID.String() == value || strings.EqualFold(customer.Name, value) {
+			return customer, nil
+		}
+	}
+	return nil, domain.ErrCustomerNotFound
+}
+
+// guildProject finds a project of the guild's customers by its issue key prefix
+func (h *Handler) guildProject(ctx context.Context, guildID, key string) (*domain.Project, error) {
+	customers, err := h.customerService.ListGuildCustomers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := h.projectsOf(ctx, customers)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customerProjects := range projects {
+		for _, project := range customerProjects {
+			if project.KeyPrefix != "" && strings.EqualFold(project.KeyPrefix, key) {
+				return project, nil
+			}
+		}
+	}
+	return nil, domain.ErrProjectNotFound
+}
+
+// projectsOf lists the projects of customers by customer ID
+func (h *Handler) projectsOf(ctx context.Context, customers []*domain.Customer) (map[uuid.UUID][]*domain.Project, error) {
+	projects := make(map[uuid.UUID][]*domain.Project, len(customers))
+	for _, customer := range customers {
+		customerProjects, err := h.projectService.GetProjectsByCustomer(ctx, customer.ID)
+		if err != nil {
+			return nil, err
+		}
+		projects[customer.ID] = customerProjects
+	}
+	return projects, nil
+}
+
+// suggestCustomersOrProjects answers autocomplete for the options of /customer and
+// /project with the guild's customers, or its projects for a project option, whose name
+// or key contains what was typed
+func (h *Handler) suggestCustomersOrProjects(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) {
+	customers, err := h.customerService.ListGuildCustomers(ctx, i.GuildID)
+	if err != nil {
+		h.logger.Error("Failed to list customers for autocomplete",
+			zap.Error(err),
+			zap.String("guild_id", i.GuildID),
+		)
+		h.respondWithChoices(i, nil)
+		return
+	}
+
+	typed := strings.ToLower(strings.TrimSpace(focused.StringValue()))
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, maxAutocompleteChoices)
+
+	if focused.Name == "customer" || i.ApplicationCommandData().Name == "customer" {
+		for _, customer := range customers {
+			if !strings.Contains(strings.ToLower(customer.Name), typed) {
+				continue
+			}
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  truncateText(customer.Name, maxChoiceNameLength),
+				Value: customer.ID.String(),
+			})
+			if len(choices) == maxAutocompleteChoices {
+				break
+			}
+		}
+		h.respondWithChoices(i, choices)
+		return
+	}
+
+	projects, err := h.projectsOf(ctx, customers)
+	if err != nil {
+		h.logger.Error("Failed to list projects for autocomplete",
+			zap.Error(err),
+			zap.String("guild_id", i.GuildID),
+		)
+		h.respondWithChoices(i, nil)
+		return
+	}
+	for _, customer := range customers {
+		for _, project := range projects[customer.ID] {
+			if project.KeyPrefix == "" {
+				continue
+			}
+			if !strings.Contains(strings.ToLower(project.KeyPrefix), typed) && !strings.Contains(strings.ToLower(project.Name), typed) {
+				continue
+			}
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+				Name:  truncateText(fmt.Sprintf("%s · %s (%s)", project.KeyPrefix, project.Name, customer.Name), maxChoiceNameLength),
+				Value: project.KeyPrefix,
+			})
+			if len(choices) == maxAutocompleteChoices {
+				h.respondWithChoices(i, choices)
+				return
+			}
+		}
+	}
+	h.respondWithChoices(i, choices)
+}
+
+// formatCustomers describes the guild's customers and the keys of their projects for
+// /customer list
+func formatCustomers(ctx context.Context, customers []*domain.Customer, projects map[uuid.UUID][]*domain.Project) string {
+	if len(customers) == 0 {
+		return i18n.T(ctx, "🏢 This server has no customers yet. Add one with `/customer create` or `/register`.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🏢 **Customers (%d):**\n", len(customers)))
+	for n, customer := range customers {
+		keys := make([]string, 0, len(projects[customer.ID]))
+		for _, project := range projects[customer.ID] {
+			keys = append(keys, fmt.Sprintf("`%s`", project.KeyPrefix))
+		}
+
+		line := "• **" + truncateText(customer.Name, 80) + "**"
+		if customer.ContactEmail != "" {
+			line += " · " + customer.ContactEmail
+		}
+		if len(keys) > 0 {
+			line += " · " + strings.Join(keys, ", ")
+		} else {
+			line += " · " + i18n.T(ctx, "no projects")
+		}
+		line += "\n"
+
+		if b.Len()+len(line) > maxCustomerListContent {
+			b.WriteString(i18n.T(ctx, "…and %d more", len(customers)-n))
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// formatProjects describes the projects of customers, grouped by customer, for /project list
+func formatProjects(ctx context.Context, customers []*domain.Customer, projects map[uuid.UUID][]*domain.Project) string {
+	count := 0
+	for _, customer := range customers {
+		count += len(projects[customer.ID])
+	}
+	if count == 0 {
+		return i18n.T(ctx, "📁 No projects yet. Add one with `/project create`.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "📁 **Projects (%d):**\n", count))
+	shown := 0
+	for _, customer := range customers {
+		if len(projects[customer.ID]) == 0 {
+			continue
+		}
+
+		lines := "\n**" + truncateText(customer.Name, 80) + "**\n"
+		for _, project := range projects[customer.ID] {
+			line := fmt.Sprintf("• `%s` %s", project.KeyPrefix, truncateText(project.Name, 80))
+			if project.Description != "" {
+				line += " · " + truncateText(project.Description, 60)
+			}
+			lines += line + "\n"
+		}
+
+		if b.Len()+len(lines) > maxCustomerListContent {
+			b.WriteString(i18n.T(ctx, "…and %d more", count-shown))
+			break
+		}
+		b.WriteString(lines)
+		shown += len(projects[customer.ID])
+	}
+	return b.String()
+}
@@ -0,0 +1,77 @@
+package discord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// interactionDedupeTTL is how long handled interaction IDs are remembered. Interaction
+// tokens expire after 15 minutes, so a delivery after that could not be answered anyway.
+const interactionDedupeTTL = 15 * time.Minute
+
+// reactionClaimTTL is how long a reaction is claimed for a replica. Reactions have no ID,
+// so the claim has to end soon for a user removing and adding the same one again.
+const reactionClaimTTL = 30 * time.Second
+
+// dedupeStore remembers recently handled IDs so a redelivered event is handled only once
+type dedupeStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	seen      map[string]time.Time
+	nextSweep time.Time
+}
+
+// newDedupeStore creates an empty dedupe store remembering IDs for ttl
+func newDedupeStore(ttl time.Duration) *dedupeStore {
+	return &dedupeStore{
+		ttl:  ttl,
+		now:  time.Now,
+		seen: make(map[string]time.Time),
+	}
+}
+
+// firstSeen records id and reports whether it was not recorded within the ttl before
+func (s *dedupeStore) firstSeen(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.After(s.nextSweep) {
+		for key, at := range s.seen {
+			if now.Sub(at) > s.ttl {
+				delete(s.seen, key)
+			}
+		}
+		s.nextSweep = now.Add(s.ttl)
+	}
+
+	if at, ok := s.seen[id]; ok && now.Sub(at) <= s.ttl {
+		return false
+	}
+	s.seen[id] = now
+	return true
+}
+
+// claimEvent makes sure only one replica of the bot handles an event that every replica
+// connected to the shard receives. A process running alone handles every event.
+func (h *Handler) claimEvent(ctx context.Context, key string, ttl time.Duration) bool {
+	if h.claims == nil {
+		return true
+	}
+
+	claimed, err := h.claims.Claim(ctx, key, ttl)
+	if err != nil {
+		// Handling an event twice is better than no replica handling it
+		h.logger.Warn("Failed to claim event; handling it anyway", zap.Error(err), zap.String("key", key))
+		return true
+	}
+	if !claimed {
+		h.logger.Debug("Event handled by another replica", zap.String("key", key))
+	}
+	return claimed
+}
@@ -0,0 +1,128 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// deleteConfirmationAction is the action ID used for the delete confirmation buttons
+const deleteConfirmationAction = "delete"
+
+// handleIssueDeleteCommand handles the /issue-delete slash command by asking for confirmation
+func (h *Handler) handleIssueDeleteCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling issue-delete command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionDeleteIssue) {
+		return
+	}
+
+	var idStr string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "id" {
+			idStr = option.StringValue()
+		}
+	}
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "🗑️ Delete issue **%s** (`%s`)?\n\nThe issue card will be removed and its thread archived.",
+			issue.Title, issue.ShortID()),
+		Components: CreateConfirmationButtons(deleteConfirmationAction, issue.ID.String()),
+		Flags:      discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond with delete confirmation", zap.Error(err))
+	}
+}
+
+// handleConfirmDeleteButton soft-deletes the issue, removes its card and archives its thread
+func (h *Handler) handleConfirmDeleteButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	customID := i.MessageComponentData().CustomID
+	issueID, err := uuid.Parse(strings.TrimPrefix(customID, "confirm_"+deleteConfirmationAction+"_"))
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	// Permissions may have changed since the confirmation was shown
+	if !h.authorize(ctx, i, domain.PermissionDeleteIssue) {
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			h.updateDeleteConfirmation(i, i18n.T(ctx, "❌ Issue not found. It may already have been deleted."))
+			return
+		}
+		h.logger.Error("Failed to get issue for deletion", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if err := h.issueService.DeleteIssue(ctx, issueID); err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			h.updateDeleteConfirmation(i, i18n.T(ctx, "❌ Issue not found. It may already have been deleted."))
+			return
+		}
+		h.logger.Error("Failed to delete issue", zap.Error(err), zap.String("issue_id", issueID.String()))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to delete issue. Please try again."), true)
+		return
+	}
+
+	h.logger.Info("Issue deleted",
+		zap.String("issue_id", issueID.String()),
+		zap.String("deleted_by", i.Member.User.ID),
+	)
+
+	h.updateDeleteConfirmation(i, i18n.T(ctx, "🗑️ Issue **%s** has been deleted.", issue.Title))
+
+	if cardChannelID := issueCardChannelID(issue); issue.MessageID != "" && cardChannelID != "" {
+		if err := h.session.ChannelMessageDelete(cardChannelID, issue.MessageID); err != nil {
+			h.logger.Error("Failed to delete issue card", zap.Error(err), zap.String("message_id", issue.MessageID))
+		}
+	}
+
+	if issue.ThreadID != "" {
+		h.sendMessage(ctx, issue.ThreadID, i18n.T(ctx, "🗑️ **This issue has been deleted by <@%s>.**\n\nThis thread will be archived.", i.Member.User.ID))
+
+		if _, err := h.session.ChannelEditComplex(issue.ThreadID, &discordgo.ChannelEdit{
+			Archived: &[]bool{true}[0],
+			Locked:   &[]bool{true}[0],
+		}); err != nil {
+			h.logger.Error("Failed to archive thread", zap.Error(err))
+		}
+	}
+}
+
+// handleCancelDeleteButton dismisses the delete confirmation
+func (h *Handler) handleCancelDeleteButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.updateDeleteConfirmation(i, i18n.T(ctx, "Deletion cancelled."))
+}
+
+// updateDeleteConfirmation replaces the confirmation prompt with a result and removes its buttons
+func (h *Handler) updateDeleteConfirmation(i *discordgo.InteractionCreate, content string) {
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update delete confirmation", zap.Error(err))
+	}
+}
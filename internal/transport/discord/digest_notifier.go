@@ -0,0 +1,172 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// DigestNotifier posts digest reports to registered channels
+type DigestNotifier struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewDigestNotifier creates a new digest notifier
+func NewDigestNotifier(session *discordgo.Session, logger *zap.Logger) *DigestNotifier {
+	return &DigestNotifier{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// NotifyDigest posts the digest embed to the digest's channel
+func (n *DigestNotifier) NotifyDigest(ctx context.Context, digest *domain.Digest) error {
+	if _, err := n.session.ChannelMessageSendEmbed(digest.Channel.DiscordChannelID, CreateDigestEmbed(digest)); err != nil {
+		return fmt.Errorf("failed to post digest to channel %s: %w", digest.Channel.DiscordChannelID, err)
+	}
+
+	n.logger.Debug("Digest posted", zap.String("channel_id", digest.Channel.DiscordChannelID))
+	return nil
+}
+
+// CreateDigestEmbed creates an embed summarizing a digest period
+func CreateDigestEmbed(digest *domain.Digest) *discordgo.MessageEmbed {
+	title := "📊 Issue Digest"
+	if digest.Channel.Project.Name != "" {
+		title = fmt.Sprintf("📊 Issue Digest: %s", digest.Channel.Project.Name)
+	}
+
+	avgClose := "—"
+	if digest.Summary.Closed > 0 {
+		avgClose = formatDuration(digest.Summary.AvgTimeToClose)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: fmt.Sprintf("<t:%d:D> – <t:%d:D>", digest.From.Unix(), digest.To.Unix()),
+		Color:       0x3498db,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "🆕 Opened",
+				Value:  fmt.Sprintf("%d", digest.Summary.Opened),
+				Inline: true,
+			},
+			{
+				Name:   "✅ Resolved",
+				Value:  fmt.Sprintf("%d", digest.Summary.Resolved),
+				Inline: true,
+			},
+			{
+				Name:   "🔒 Closed",
+				Value:  fmt.Sprintf("%d", digest.Summary.Closed),
+				Inline: true,
+			},
+			{
+				Name:   "⏱️ Avg. Time to Close",
+				Value:  avgClose,
+				Inline: true,
+			},
+			{
+				Name:   "📅 Opened per Day",
+				Value:  formatIssuesPerDay(digest.OpenedPerDay, digest.To.Sub(digest.From)),
+				Inline: true,
+			},
+			{
+				Name:   "📌 Unresolved by Priority",
+				Value:  formatPriorityCounts(digest.UnresolvedByPriority),
+				Inline: true,
+			},
+			{
+				Name:   "⭐ CSAT",
+				Value:  formatCSAT(digest.CSAT),
+				Inline: true,
+			},
+		},
+		Timestamp: digest.To.Format(time.RFC3339),
+	}
+
+	if len(digest.StaleIssues) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("🕸️ Stale (no update for %s)", formatDuration(digest.To.Sub(digest.StaleBefore))),
+			Value:  formatStaleIssues(digest.StaleIssues),
+			Inline: false,
+		})
+	}
+
+	if len(digest.OverdueIssues) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "⏰ Overdue",
+			Value:  formatOverdueIssues(digest.OverdueIssues),
+			Inline: false,
+		})
+	}
+
+	return embed
+}
+
+// formatPriorityCounts lists per-priority counts, one per line
+func formatPriorityCounts(counts []domain.PriorityCount) string {
+	var lines []string
+	for _, pc := range counts {
+		if pc.Count == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %s: %d", getPriorityEmoji(pc.Priority), string(pc.Priority), pc.Count))
+	}
+	if len(lines) == 0 {
+		return "None"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatStaleIssues lists stale issues with a link to their thread when available
+func formatStaleIssues(issues []*domain.Issue) string {
+	lines := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		line := fmt.Sprintf("%s %s", getStatusEmoji(issue.Status), truncateText(issue.Title, 60))
+		if issue.ThreadID != "" {
+			line += fmt.Sprintf(" — <#%s>", issue.ThreadID)
+		}
+		line += fmt.Sprintf(" (updated <t:%d:R>)", issue.UpdatedAt.Unix())
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatOverdueIssues lists overdue issues with a link to their thread when available
+func formatOverdueIssues(issues []*domain.Issue) string {
+	lines := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		line := fmt.Sprintf("⏰ %s", truncateText(issue.Title, 60))
+		if issue.ThreadID != "" {
+			line += fmt.Sprintf(" — <#%s>", issue.ThreadID)
+		}
+		line += fmt.Sprintf(" (due <t:%d:R>)", issue.DueDate.Unix())
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatDuration renders a duration as days and hours, or hours and minutes when shorter than a day
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return "< 1m"
+	}
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	if days > 0 {
+		return fmt.Sprintf("%dd %dh", days, hours)
+	}
+	if hours > 0 {
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+	return fmt.Sprintf("%dm", minutes)
+}
@@ -0,0 +1,131 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// DMNotifier sends issue notifications to users as direct messages
+type DMNotifier struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewDMNotifier creates a new direct message notifier
+func NewDMNotifier(session *discordgo.Session, logger *zap.Logger) *DMNotifier {
+	return &DMNotifier{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// NotifyUser sends a notification about an issue to a user's DMs
+func (n *DMNotifier) NotifyUser(_ context.Context, user *domain.User, notification domain.Notification) error {
+	return sendDirectMessage(n.session, user.DiscordID, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{CreateNotificationEmbed(notification)},
+	})
+}
+
+// CreateNotificationEmbed creates an embed describing a notification
+func CreateNotificationEmbed(notification domain.Notification) *discordgo.MessageEmbed {
+	issue := notification.Issue
+
+	var title, by string
+	color := 0x3498db
+	switch notification.Kind {
+	case domain.NotificationAssigned:
+		title = fmt.Sprintf("👥 You were assigned as %s", notification.Role.GetDisplayName())
+	case domain.NotificationIssueResolved:
+		title, color, by = "✅ Your issue was resolved", 0x2ecc71, "Resolved"
+	case domain.NotificationIssueClosed:
+		title, color, by = "🔒 Your issue was closed", 0x95a5a6, "Closed"
+	case domain.NotificationIssueRejected:
+		title, color, by = "❌ QA rejected the fix", 0xe74c3c, "Rejected"
+	case domain.NotificationMentioned:
+		title, by = "💬 You were mentioned", "Mentioned"
+	case domain.NotificationWatchedStatusChanged:
+		title, by = fmt.Sprintf("👀 Watched issue: %s → %s",
+			domain.GetStatusDisplayName(notification.OldStatus), domain.GetStatusDisplayName(issue.Status)), "Changed"
+	case domain.NotificationWatchedCommented:
+		title, by = "💬 New comment on a watched issue", "Commented"
+	case domain.NotificationSLABreached:
+		title, color = "🚨 SLA breached", 0xe74c3c
+		if notification.Alert != nil {
+			title = fmt.Sprintf("🚨 %s SLA breached", notification.Alert.Kind.GetDisplayName())
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: fmt.Sprintf("**%s** - %s", issueDisplayName(issue), issue.Title),
+		Color:       color,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Priority",
+				Value:  fmt.Sprintf("%s %s", getPriorityEmoji(issue.Priority), strings.Title(string(issue.Priority))),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Choose which messages you get with /notify-prefs",
+		},
+	}
+
+	if issue.Project.Name != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Project",
+			Value:  issue.Project.Name,
+			Inline: true,
+		})
+	}
+	if by != "" && notification.ActorID != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("%s by", by),
+			Value:  fmt.Sprintf("<@%s>", notification.ActorID),
+			Inline: true,
+		})
+	}
+	if notification.Kind == domain.NotificationIssueResolved && issue.ResolutionAction != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Corrective action",
+			Value: truncateText(issue.ResolutionAction, 500),
+		})
+	}
+	if notification.Kind == domain.NotificationMentioned && notification.Content != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Message",
+			Value: truncateText(notification.Content, 500),
+		})
+	}
+	if notification.Kind == domain.NotificationWatchedCommented && notification.Content != "" {
+		name := "Message"
+		if notification.ActorID == "" && notification.AuthorName != "" {
+			name = fmt.Sprintf("Message from %s", notification.AuthorName)
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  name,
+			Value: truncateText(notification.Content, 500),
+		})
+	}
+	if notification.Kind == domain.NotificationSLABreached && notification.Alert != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Due",
+			Value:  fmt.Sprintf("<t:%d:R>", notification.Alert.DueAt.Unix()),
+			Inline: true,
+		})
+	}
+	if issue.ThreadID != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Discussion",
+			Value: fmt.Sprintf("<#%s>", issue.ThreadID),
+		})
+	}
+
+	return embed
+}
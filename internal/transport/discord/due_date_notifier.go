@@ -0,0 +1,58 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// DueDateNotifier posts due date reminders to issue threads
+type DueDateNotifier struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewDueDateNotifier creates a new due date notifier
+func NewDueDateNotifier(session *discordgo.Session, logger *zap.Logger) *DueDateNotifier {
+	return &DueDateNotifier{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// NotifyDueSoon reminds the assignees of an issue that its due date is close
+func (n *DueDateNotifier) NotifyDueSoon(ctx context.Context, issue *domain.Issue) error {
+	return n.post(issue, &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🗓️ Due soon: %s", issue.Title),
+		Description: fmt.Sprintf("This issue is due <t:%d:R> (<t:%d:f>).", issue.DueDate.Unix(), issue.DueDate.Unix()),
+		Color:       0xf39c12,
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Issue %s", issue.ID.String())},
+	})
+}
+
+// NotifyOverdue tells the assignees of an issue that its due date has passed
+func (n *DueDateNotifier) NotifyOverdue(ctx context.Context, issue *domain.Issue) error {
+	return n.post(issue, &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("⏰ Overdue: %s", issue.Title),
+		Description: fmt.Sprintf("This issue was due <t:%d:R> (<t:%d:f>). Please post an update or set a new date with `/due`.", issue.DueDate.Unix(), issue.DueDate.Unix()),
+		Color:       0xe74c3c,
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Issue %s", issue.ID.String())},
+	})
+}
+
+// post sends an embed mentioning the assignees to the issue thread
+func (n *DueDateNotifier) post(issue *domain.Issue, embed *discordgo.MessageEmbed) error {
+	if err := postIssueNotice(n.session, issue, embed); err != nil {
+		n.logger.Error("Failed to post due date reminder",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return fmt.Errorf("failed to post due date reminder: %w", err)
+	}
+
+	return nil
+}
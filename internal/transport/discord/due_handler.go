@@ -0,0 +1,74 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleDueCommand handles the /due slash command, which sets or clears an issue's due date
+func (h *Handler) handleDueCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	args := make(map[string]string)
+	for _, option := range i.ApplicationCommandData().Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling due command",
+		zap.String("issue_id", args["id"]),
+		zap.String("date", args["date"]),
+		zap.String("user_id", i.Member.User.ID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionSetDueDate) {
+		return
+	}
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+	if !ok {
+		return
+	}
+
+	issue, err := h.dueDateService.SetDueDate(ctx, issue.ID, args["date"], i.Member.User.ID)
+	if err != nil {
+		h.respondDueError(ctx, i, err)
+		return
+	}
+
+	if issue.DueDate == nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🗓️ Removed the due date of **%s**.", issueDisplayName(issue)), true)
+		h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "🗓️ Due date removed by <@%s>", i.Member.User.ID))
+		return
+	}
+
+	due := issue.DueDate.Unix()
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🗓️ **%s** is due <t:%d:f> (<t:%d:R>).", issueDisplayName(issue), due, due), true)
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "🗓️ Due date set to <t:%d:f> by <@%s>", due, i.Member.User.ID))
+}
+
+// respondDueError explains why a due date could not be set
+func (h *Handler) respondDueError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrIssueAlreadyClosed):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Closed issues cannot get a due date."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to set the due date. Please try again."))
+	}
+}
+
+// formatDueDate describes when an issue is due for issue listings, marking overdue issues
+// with ⏰. Issues without a due date, and resolved ones, return an empty string.
+func formatDueDate(ctx context.Context, issue *domain.Issue, now time.Time) string {
+	if issue.DueDate == nil || !issue.IsUnresolved() {
+		return ""
+	}
+	if issue.IsOverdue(now) {
+		return i18n.T(ctx, "⏰ overdue since <t:%d:R>", issue.DueDate.Unix())
+	}
+	return i18n.T(ctx, "🗓️ due <t:%d:R>", issue.DueDate.Unix())
+}
@@ -0,0 +1,326 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxDuplicateCandidates is the number of possible duplicates offered for linking
+const maxDuplicateCandidates = 4
+
+// pendingIssueTTL is how long a submission waits for the reporter to decide on possible duplicates
+const pendingIssueTTL = 15 * time.Minute
+
+// issueDraft is an issue submission that has not been created yet
+type issueDraft struct {
+	title        string
+	description  string
+	imageURL     string
+	reporterID   string
+	projectID    uuid.UUID            // Project chosen in a multi-project channel; uuid.Nil for the channel's main project
+	customFields map[uuid.UUID]string // Custom field values entered in the form, keyed by field ID
+	attachments  []*domain.IssueAttachment
+	submittedAt  time.Time
+}
+
+// storedIssueDraft is an issueDraft as it is kept in a shared state store
+type storedIssueDraft struct {
+	Title        string
+	Description  string
+	ImageURL     string
+	ReporterID   string
+	ProjectID    uuid.UUID
+	CustomFields map[uuid.UUID]string
+	Attachments  []*domain.IssueAttachment
+	SubmittedAt  time.Time
+}
+
+// pendingIssueStore keeps submissions held back by the duplicate check, keyed by the
+// ID of the interaction that submitted them. With a shared store, the reporter's
+// decision can be handled by another replica than the one holding the submission back.
+type pendingIssueStore struct {
+	shared domain.StateStore // nil keeps submissions in this process
+
+	mu     sync.Mutex
+	drafts map[string]*issueDraft
+}
+
+// newPendingIssueStore creates an empty pending issue store
+func newPendingIssueStore(shared domain.StateStore) *pendingIssueStore {
+	return &pendingIssueStore{shared: shared, drafts: make(map[string]*issueDraft)}
+}
+
+// put stores a draft and drops drafts older than pendingIssueTTL
+func (s *pendingIssueStore) put(ctx context.Context, token string, draft *issueDraft) error {
+	if s.shared != nil {
+		var value bytes.Buffer
+		if err := gob.NewEncoder(&value).Encode(storedIssueDraft{
+			Title:        draft.title,
+			Description:  draft.description,
+			ImageURL:     draft.imageURL,
+			ReporterID:   draft.reporterID,
+			ProjectID:    draft.projectID,
+			CustomFields: draft.customFields,
+			Attachments:  draft.attachments,
+			SubmittedAt:  draft.submittedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to encode pending issue: %w", err)
+		}
+		return s.shared.Put(ctx, "pending-issue:"+token, value.Bytes(), pendingIssueTTL)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, pending := range s.drafts {
+		if time.Since(pending.submittedAt) > pendingIssueTTL {
+			delete(s.drafts, key)
+		}
+	}
+	s.drafts[token] = draft
+	return nil
+}
+
+// take removes and returns a draft; ok is false if it is unknown or expired
+func (s *pendingIssueStore) take(ctx context.Context, token string) (*issueDraft, bool, error) {
+	if s.shared != nil {
+		value, ok, err := s.shared.Take(ctx, "pending-issue:"+token)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+
+		var stored storedIssueDraft
+		if err := gob.NewDecoder(bytes.NewReader(value)).Decode(&stored); err != nil {
+			return nil, false, fmt.Errorf("failed to decode pending issue: %w", err)
+		}
+		return &issueDraft{
+			title:        stored.Title,
+			description:  stored.Description,
+			imageURL:     stored.ImageURL,
+			reporterID:   stored.ReporterID,
+			projectID:    stored.ProjectID,
+			customFields: stored.CustomFields,
+			attachments:  stored.Attachments,
+			submittedAt:  stored.SubmittedAt,
+		}, true, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	draft, ok := s.drafts[token]
+	delete(s.drafts, token)
+	if !ok || time.Since(draft.submittedAt) > pendingIssueTTL {
+		return nil, false, nil
+	}
+	return draft, true, nil
+}
+
+// submitIssue creates the submitted issue, or first asks the reporter to review open
+// issues with a similar title
+func (h *Handler) submitIssue(ctx context.Context, i *discordgo.InteractionCreate, draft *issueDraft) {
+	if !h.deferResponse(ctx, i, false) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	similar, err := h.issueService.FindSimilarIssues(ctx, channelID, draft.projectID, draft.title)
+	if err != nil {
+		// The check is advisory, so a failure must not block reporting
+		h.logger.Warn("Duplicate check failed", zap.Error(err), zap.String("channel_id", channelID))
+	}
+
+	if len(similar) == 0 {
+		h.createIssue(ctx, i, draft)
+		return
+	}
+
+	if len(similar) > maxDuplicateCandidates {
+		similar = similar[:maxDuplicateCandidates]
+	}
+
+	token := i.ID
+	draft.submittedAt = time.Now()
+	if err := h.pendingIssues.put(ctx, token, draft); err != nil {
+		// Without a place to hold the submission, it is created like one without duplicates
+		h.logger.Error("Failed to hold back issue for duplicate review", zap.Error(err))
+		h.createIssue(ctx, i, draft)
+		return
+	}
+
+	h.logger.Info("Possible duplicates found",
+		zap.String("title", draft.title),
+		zap.Int("count", len(similar)),
+	)
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "🔍 **Possible duplicates of \"%s\":**\n", truncateText(draft.title, 80)))
+	buttons := make([]discordgo.MessageComponent, 0, len(similar)+1)
+	for _, candidate := range similar {
+		issue := candidate.Issue
+		content.WriteString(i18n.T(ctx, "%s **%s** %s (%.0f%% similar)\n",
+			getStatusEmoji(issue.Status), issue.ShortID(), truncateText(issue.Title, 80), candidate.Score*100))
+		buttons = append(buttons, discordgo.Button{
+			Label:    i18n.T(ctx, "Duplicate of %s", issue.ShortID()),
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("dup_of_%s_%s", token, issue.ID),
+			Emoji:    &discordgo.ComponentEmoji{Name: "📎"},
+		})
+	}
+	content.WriteString(i18n.T(ctx, "\nIf your report is one of these, add it to that issue instead. Otherwise create it anyway."))
+	buttons = append(buttons, discordgo.Button{
+		Label:    i18n.T(ctx, "Create anyway"),
+		Style:    discordgo.PrimaryButton,
+		CustomID: fmt.Sprintf("dup_create_%s", token),
+		Emoji:    &discordgo.ComponentEmoji{Name: "➕"},
+	})
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content:    content.String(),
+		Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}},
+		Flags:      discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond with possible duplicates", zap.Error(err))
+	}
+}
+
+// createIssue creates a submitted issue and publishes its card. The interaction must
+// already have been responded to.
+func (h *Handler) createIssue(ctx context.Context, i *discordgo.InteractionCreate, draft *issueDraft) {
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	issue, err := h.issueService.CreateIssue(ctx, draft.title, draft.description, draft.imageURL, draft.reporterID, channelID, draft.projectID)
+	var limited *domain.RateLimitError
+	var rejection string
+	switch {
+	case errors.As(err, &limited):
+		rejection = i18n.T(ctx, "⏳ You're reporting issues too quickly. Please try again <t:%d:R>.", limited.RetryAt.Unix())
+	case errors.Is(err, domain.ErrChannelInactive):
+		rejection = i18n.T(ctx, "⏸️ This channel has been deactivated and does not accept new issues.")
+	case errors.Is(err, domain.ErrProjectNotInChannel):
+		rejection = i18n.T(ctx, "❌ That project is no longer tracked in this channel. Please use `/issue` again.")
+	}
+	if rejection != "" {
+		if _, deferred := h.deferred.Load(i.ID); deferred {
+			// Only the reporter needs to see this, not the whole channel
+			h.respondToInteraction(ctx, i, rejection, true)
+		} else {
+			h.editInteractionResponse(ctx, i, rejection)
+		}
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to create issue", zap.Error(err))
+		h.editInteractionResponse(ctx, i, i18n.T(ctx, "❌ Failed to create issue. Please try again."))
+		return
+	}
+
+	if len(draft.attachments) > 0 {
+		if err := h.attachmentService.AddAttachments(ctx, issue.ID, draft.reporterID, draft.attachments); err != nil {
+			h.logger.Error("Failed to store message attachments",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		}
+	}
+
+	note := h.saveDraftCustomFields(ctx, issue.ID, draft.customFields)
+
+	h.publishIssueCard(ctx, i, issue.ID)
+
+	if note != "" {
+		if _, err := h.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: note,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		}); err != nil {
+			h.logger.Error("Failed to send custom field note", zap.Error(err))
+		}
+	}
+
+	h.offerComponentSelect(ctx, i, issue)
+}
+
+// handleCreateAnywayButton creates a held back submission despite possible duplicates
+func (h *Handler) handleCreateAnywayButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, "dup_create_")
+
+	draft, ok, err := h.pendingIssues.take(ctx, token)
+	if err != nil {
+		h.logger.Error("Failed to load pending issue", zap.Error(err), zap.String("token", token))
+	}
+	if !ok {
+		h.updateDuplicatePrompt(i, i18n.T(ctx, "⌛ This submission has expired. Please submit the issue again."))
+		return
+	}
+
+	h.updateDuplicatePrompt(i, i18n.T(ctx, "🔄 Creating issue..."))
+	h.createIssue(ctx, i, draft)
+}
+
+// handleDuplicateOfButton adds a held back submission to the chosen existing issue instead of creating it
+func (h *Handler) handleDuplicateOfButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	token, idStr, found := strings.Cut(strings.TrimPrefix(i.MessageComponentData().CustomID, "dup_of_"), "_")
+	issueID, err := uuid.Parse(idStr)
+	if !found || err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	draft, ok, err := h.pendingIssues.take(ctx, token)
+	if err != nil {
+		h.logger.Error("Failed to load pending issue", zap.Error(err), zap.String("token", token))
+	}
+	if !ok {
+		h.updateDuplicatePrompt(i, i18n.T(ctx, "⌛ This submission has expired. Please submit the issue again."))
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get duplicate issue", zap.Error(err), zap.String("issue_id", issueID.String()))
+		h.updateDuplicatePrompt(i, i18n.T(ctx, "❌ The existing issue could not be loaded. Please submit the issue again."))
+		return
+	}
+
+	// Keep the report on the existing issue so nothing the reporter wrote is lost
+	target := issue.ThreadID
+	if target == "" {
+		target = i.ChannelID
+	}
+	h.sendMessage(ctx, target, i18n.T(ctx, "📎 <@%s> reported the same problem as **%s**:\n> **%s**\n> %s",
+		draft.reporterID, issue.ShortID(), draft.title,
+		strings.ReplaceAll(truncateText(draft.description, 1500), "\n", "\n> ")))
+
+	h.logger.Info("Submission added to existing issue as duplicate",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("reporter_id", draft.reporterID),
+	)
+
+	h.updateDuplicatePrompt(i, i18n.T(ctx, "📎 Your report was added to **%s** (%s) instead of creating a new issue.",
+		issue.ShortID(), issue.Title))
+}
+
+// updateDuplicatePrompt replaces the possible duplicates prompt with a result and removes its buttons
+func (h *Handler) updateDuplicatePrompt(i *discordgo.InteractionCreate, content string) {
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update duplicates prompt", zap.Error(err))
+	}
+}
@@ -0,0 +1,186 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxEditDescriptionLength is the longest text a Discord modal input accepts
+const maxEditDescriptionLength = 4000
+
+// handleIssueEditCommand handles the /issue-edit slash command by opening a prefilled modal
+func (h *Handler) handleIssueEditCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling issue-edit command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	var idStr string
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "id" {
+			idStr = option.StringValue()
+		}
+	}
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	if !h.authorizeEdit(ctx, i, issue) {
+		return
+	}
+
+	// Saving a truncated description would silently drop text
+	if len([]rune(issue.Description)) > maxEditDescriptionLength {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This issue's description is too long to edit in Discord."), true)
+		return
+	}
+
+	modal := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("issue_edit_modal_%s", issue.ID.String()),
+			Title:    i18n.T(ctx, "Edit Issue %s", issue.ShortID()),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:  "title",
+							Label:     i18n.T(ctx, "Issue Title"),
+							Style:     discordgo.TextInputShort,
+							Value:     issue.Title,
+							Required:  true,
+							MaxLength: maxIssueTitleLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:  "description",
+							Label:     "Description",
+							Style:     discordgo.TextInputParagraph,
+							Value:     issue.Description,
+							Required:  true,
+							MaxLength: maxEditDescriptionLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "image_url",
+							Label:       i18n.T(ctx, "Image URL (Optional)"),
+							Style:       discordgo.TextInputShort,
+							Placeholder: "https://example.com/image.png",
+							Value:       issue.ImageURL,
+							Required:    false,
+							MaxLength:   500,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, modal); err != nil {
+		h.logger.Error("Failed to respond with issue edit modal", zap.Error(err))
+	}
+}
+
+// handleIssueEditModalSubmit saves the edited issue content and refreshes its card
+func (h *Handler) handleIssueEditModalSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.ModalSubmitData()
+
+	issueID, err := uuid.Parse(strings.TrimPrefix(data.CustomID, "issue_edit_modal_"))
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	if !h.deferResponse(ctx, i, true) {
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue for edit", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	// Permissions may have changed while the modal was open
+	if !h.authorizeEdit(ctx, i, issue) {
+		return
+	}
+
+	var title, description, imageURL string
+	for _, row := range data.Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			input, ok := component.(*discordgo.TextInput)
+			if !ok {
+				continue
+			}
+			switch input.CustomID {
+			case "title":
+				title = input.Value
+			case "description":
+				description = input.Value
+			case "image_url":
+				imageURL = input.Value
+			}
+		}
+	}
+
+	before := *issue
+	updated, err := h.issueService.UpdateIssueContent(ctx, issueID, title, description, imageURL, i.Member.User.ID)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update issue. Please try again."))
+		return
+	}
+
+	edited := editedFields(ctx, &before, updated)
+	if len(edited) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ Nothing changed."), true)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✏️ Issue updated."), true)
+	h.refreshIssue(ctx, issueID, i18n.T(ctx, "✏️ <@%s> edited the %s.", i.Member.User.ID, strings.Join(edited, ", ")))
+}
+
+// authorizeEdit lets reporters edit their own issues and requires the edit permission otherwise
+func (h *Handler) authorizeEdit(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue) bool {
+	if issue.Reporter.DiscordID != "" && issue.Reporter.DiscordID == i.Member.User.ID {
+		return true
+	}
+	return h.authorize(ctx, i, domain.PermissionEditIssue)
+}
+
+// editedFields lists the content fields that differ between two versions of an issue
+func editedFields(ctx context.Context, before, after *domain.Issue) []string {
+	var fields []string
+	if before.Title != after.Title {
+		fields = append(fields, i18n.T(ctx, "title"))
+	}
+	if before.Description != after.Description {
+		fields = append(fields, i18n.T(ctx, "description"))
+	}
+	if before.ImageURL != after.ImageURL {
+		fields = append(fields, i18n.T(ctx, "image URL"))
+	}
+	return fields
+}
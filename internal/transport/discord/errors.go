@@ -0,0 +1,94 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// errorMessages replaces the message of domain errors whose own message does not tell a
+// Discord user what to do next
+var errorMessages = map[*domain.Error]string{
+	domain.ErrChannelNotFound: "This channel is not registered. Use `/register` first.",
+	domain.ErrIssueNotFound:   "Issue not found.",
+}
+
+// respondError responds to an interaction that failed with err. Errors the user can act
+// on are explained in the user's language and logged at debug level, or info level when
+// the user was not allowed to act. Other errors are logged as errors and answered with
+// failure, such as "❌ Failed to update milestones. Please try again."
+func (h *Handler) respondError(ctx context.Context, i *discordgo.InteractionCreate, err error, failure string) {
+	kind := domain.KindOf(err)
+	fields := []zap.Field{
+		zap.Error(err),
+		zap.String("kind", string(kind)),
+		zap.String("interaction", interactionName(i)),
+		zap.String("user_id", interactionUserID(i)),
+		zap.String("guild_id", i.GuildID),
+		zap.String("channel_id", i.ChannelID),
+	}
+
+	switch kind {
+	case domain.KindInternal:
+		h.logger.Error("Interaction failed", fields...)
+		h.respondToInteraction(ctx, i, failure, true)
+		return
+	case domain.KindForbidden:
+		h.logger.Info("Interaction forbidden", fields...)
+	default:
+		h.logger.Debug("Interaction rejected", fields...)
+	}
+	h.respondToInteraction(ctx, i, userErrorMessage(ctx, err), true)
+}
+
+// userErrorMessage renders a domain error as an ephemeral message in the language of ctx,
+// e.g. "❌ a project can have at most 25 milestones"
+func userErrorMessage(ctx context.Context, err error) string {
+	var limited *domain.RateLimitError
+	if errors.As(err, &limited) {
+		return i18n.T(ctx, "⏳ You're doing that too often. Please try again <t:%d:R>.", limited.RetryAt.Unix())
+	}
+	return "❌ " + errorMessage(ctx, err)
+}
+
+// errorMessage translates the message of a domain error to the language of ctx, followed
+// by its detail. Errors that are not domain errors get a generic message, as their text
+// is meant for the logs.
+func errorMessage(ctx context.Context, err error) string {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return i18n.T(ctx, "Something went wrong. Please try again.")
+	}
+
+	message, ok := errorMessages[domainErr]
+	if !ok {
+		message = domainErr.Error()
+	}
+	message = i18n.T(ctx, message)
+
+	var detailed *domain.DetailError
+	if errors.As(err, &detailed) {
+		message += ": " + i18n.T(ctx, detailed.Format, detailed.Args...)
+	}
+	return message
+}
+
+// interactionName names an interaction in logs: the command name, or the custom ID of a
+// component or form
+func interactionName(i *discordgo.InteractionCreate) string {
+	switch i.Type {
+	case discordgo.InteractionApplicationCommand, discordgo.InteractionApplicationCommandAutocomplete:
+		return i.ApplicationCommandData().Name
+	case discordgo.InteractionMessageComponent:
+		return i.MessageComponentData().CustomID
+	case discordgo.InteractionModalSubmit:
+		return i.ModalSubmitData().CustomID
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,120 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxEscalationHours is the longest delay /escalation set accepts
+const maxEscalationHours = domain.MaxEscalationHours
+
+// minEscalationHours is the shortest delay /escalation set accepts; the command option needs its address
+var minEscalationHours = 1.0
+
+// handleEscalationCommand handles the /escalation slash command and its set, list and remove subcommands
+func (h *Handler) handleEscalationCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: set, list or remove."), true)
+		return
+	}
+
+	subcommand := options[0]
+	h.logger.Info("Handling escalation command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageEscalation) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+
+	var (
+		content string
+		err     error
+	)
+	switch subcommand.Name {
+	case "set":
+		priority := domain.Priority(subcommand.GetOption("priority").StringValue())
+		hours := int(subcommand.GetOption("hours").IntValue())
+		role := subcommand.GetOption("role").RoleValue(nil, i.GuildID)
+		bump := false
+		if option := subcommand.GetOption("bump"); option != nil {
+			bump = option.BoolValue()
+		}
+		var rule *domain.EscalationRule
+		if rule, err = h.escalationService.SetRule(ctx, channelID, priority, hours, role.ID, bump, i.Member.User.ID); err == nil {
+			content = fmt.Sprintf("🚨 %s", formatEscalationRule(ctx, rule))
+		}
+	case "list":
+		var rules []*domain.EscalationRule
+		if rules, err = h.escalationService.ListRules(ctx, channelID); err == nil {
+			content = formatEscalationRules(ctx, rules)
+		}
+	case "remove":
+		priority := domain.Priority(subcommand.GetOption("priority").StringValue())
+		if _, err = h.escalationService.RemoveRule(ctx, channelID, priority); err == nil {
+			content = i18n.T(ctx, "🗑️ %s priority issues are no longer escalated.", strings.Title(string(priority)))
+		}
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondEscalationError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// respondEscalationError explains why an escalation rule change was refused
+func (h *Handler) respondEscalationError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrEscalationRuleNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no escalation rule for that priority. See `/escalation list`."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update escalation rules. Please try again."))
+	}
+}
+
+// formatEscalationRules describes a project's escalation rules for /escalation list
+func formatEscalationRules(ctx context.Context, rules []*domain.EscalationRule) string {
+	if len(rules) == 0 {
+		return i18n.T(ctx, "🚨 This project has no escalation rules. Add one with `/escalation set`.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🚨 **Escalation rules**\n"))
+	for _, rule := range rules {
+		fmt.Fprintf(&b, "• %s\n", formatEscalationRule(ctx, rule))
+	}
+	return b.String()
+}
+
+// formatEscalationRule describes one escalation rule, e.g. "🔴 High priority issues open
+// for 4 hours ping @Leads and are raised one priority"
+func formatEscalationRule(ctx context.Context, rule *domain.EscalationRule) string {
+	hours := i18n.T(ctx, "hours")
+	if rule.AfterHours == 1 {
+		hours = i18n.T(ctx, "hour")
+	}
+	text := i18n.T(ctx, "%s **%s** priority issues open for %d %s ping <@&%s>",
+		getPriorityEmoji(rule.Priority), strings.Title(string(rule.Priority)), rule.AfterHours, hours, rule.RoleID)
+	if next, ok := domain.NextPriority(rule.Priority); rule.BumpPriority && ok {
+		text += i18n.T(ctx, " and are raised to **%s**", strings.Title(string(next)))
+	}
+	return text + "."
+}
@@ -0,0 +1,68 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// EscalationNotifier pings the escalation role of a project in the threads of issues that
+// stayed open too long
+type EscalationNotifier struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewEscalationNotifier creates a new escalation notifier
+func NewEscalationNotifier(session *discordgo.Session, logger *zap.Logger) *EscalationNotifier {
+	return &EscalationNotifier{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// NotifyEscalation pings the rule's role, and the issue's assignees if it has any, in the
+// issue thread or, without one, the issue's channel
+func (n *EscalationNotifier) NotifyEscalation(ctx context.Context, issue *domain.Issue, rule *domain.EscalationRule) error {
+	targetID := issue.ThreadID
+	if targetID == "" && issue.Channel != nil {
+		targetID = issue.Channel.DiscordChannelID
+	}
+	if targetID == "" {
+		return fmt.Errorf("issue %s has no thread or channel to post to", issue.ID)
+	}
+
+	description := fmt.Sprintf("This %s priority issue has been open for over %s without anyone starting on it.",
+		string(issue.Priority), formatStaleDuration(rule.After()))
+	if next, ok := domain.NextPriority(issue.Priority); rule.BumpPriority && ok {
+		description += fmt.Sprintf("\nIts priority is raised to %s **%s**.", getPriorityEmoji(next), strings.Title(string(next)))
+	}
+
+	content := strings.TrimSpace(fmt.Sprintf("<@&%s> %s", rule.RoleID, assigneeMentions(issue)))
+	if _, err := n.session.ChannelMessageSendComplex(targetID, &discordgo.MessageSend{
+		Content: content,
+		Embeds: []*discordgo.MessageEmbed{{
+			Title:       fmt.Sprintf("🚨 Escalated: %s", issue.Title),
+			Description: description,
+			Color:       0xe74c3c,
+			Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Issue %s", issueDisplayName(issue))},
+		}},
+		AllowedMentions: &discordgo.MessageAllowedMentions{
+			Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeUsers},
+			Roles: []string{rule.RoleID},
+		},
+	}); err != nil {
+		n.logger.Error("Failed to post escalation",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return fmt.Errorf("failed to post escalation: %w", err)
+	}
+
+	return nil
+}
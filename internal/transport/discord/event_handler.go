@@ -0,0 +1,207 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// cardRefreshTimeout bounds reloading an issue and editing its card after an event
+	cardRefreshTimeout = 30 * time.Second
+	// maxEmailReplyLength shortens email replies posted in a thread to fit a message
+	maxEmailReplyLength = 1800
+)
+
+// Subscribe registers the handler for the events that change what an issue card, thread or
+// board shows
+func (h *Handler) Subscribe(bus domain.EventBus) {
+	bus.SubscribeInTransaction(h.recordOutbox, outboxEvents...)
+	bus.Subscribe(h.onIssueStatusChanged, domain.EventIssueStatusChanged)
+	bus.Subscribe(h.onIssuePriorityChanged, domain.EventIssuePriorityChanged)
+	bus.Subscribe(h.onIssueAutoAssigned, domain.EventIssueAutoAssigned)
+	bus.Subscribe(h.onAssigneesSuggested, domain.EventAssigneesSuggested)
+	bus.Subscribe(h.onIssueRouted, domain.EventIssueRouted)
+	bus.Subscribe(h.onIssueCreated, domain.EventIssueCreated)
+	bus.Subscribe(h.onIssueEscalated, domain.EventIssueEscalated)
+	bus.Subscribe(h.onIssueEmailReply, domain.EventIssueEmailReply)
+	bus.Subscribe(h.onBoardIssueChanged, domain.EventIssueCreated, domain.EventIssueStatusChanged, domain.EventIssueEdited, domain.EventIssueDeleted, domain.EventIssueMoved)
+}
+
+// onIssueCreated posts the card of an issue filed on schedule by a recurring issue or sent
+// by email to a project with a registered channel. Cards of issues reported in Discord
+// are posted by the interaction handlers.
+func (h *Handler) onIssueCreated(_ context.Context, event domain.Event) {
+	issue := event.Issue
+	emailed := issue.Source == string(domain.SourceEmail) && issue.ChannelID != nil
+	if issue.Source != string(domain.SourceRecurring) && !emailed {
+		return
+	}
+
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("issue card", zap.String("issue_id", issue.ID.String()))
+
+		if _, err := h.postIssueCard(ctx, issue.ID, ""); err != nil {
+			h.logger.Error("Failed to post issue card",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		}
+	}()
+}
+
+// onIssueStatusChanged renames, archives or reopens the issue's thread and refreshes its
+// card to match the new status (see applyStatusChange). The card of a sub-task's parent
+// is always refreshed to update its sub-task progress.
+func (h *Handler) onIssueStatusChanged(_ context.Context, event domain.Event) {
+	if event.Issue.ParentIssueID != nil {
+		h.refreshCardAsync(*event.Issue.ParentIssueID)
+	}
+
+	issueID := event.Issue.ID
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("status change", zap.String("issue_id", issueID.String()))
+
+		loadedAt := time.Now()
+		issue, err := h.issueService.GetIssue(ctx, issueID)
+		if err != nil {
+			h.logger.Error("Failed to load issue after status change",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+			return
+		}
+
+		h.applyStatusChange(ctx, issue, event, loadedAt)
+	}()
+}
+
+// onIssuePriorityChanged adjusts the auto-archive duration of the issue's thread to its
+// new priority
+func (h *Handler) onIssuePriorityChanged(_ context.Context, event domain.Event) {
+	if event.Issue.ThreadID == "" || event.Issue.IsClosed() {
+		return
+	}
+
+	issueID := event.Issue.ID
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("priority change", zap.String("issue_id", issueID.String()))
+
+		loadedAt := time.Now()
+		issue, err := h.issueService.GetIssue(ctx, issueID)
+		if err != nil {
+			h.logger.Error("Failed to load issue after priority change",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+			return
+		}
+
+		if h.syncThread(issue) == nil {
+			h.completeOutbox(ctx, issueID, domain.OutboxSyncThread, loadedAt)
+		}
+	}()
+}
+
+// onIssueAutoAssigned refreshes the card of an issue assigned to the on-call member
+// and pings them in the issue thread. They also get the assignment notification DM.
+func (h *Handler) onIssueAutoAssigned(_ context.Context, event domain.Event) {
+	issue, assignee := event.Issue, event.Assignee
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("on-call assignment", zap.String("issue_id", issue.ID.String()))
+
+		h.refreshIssue(ctx, issue.ID, fmt.Sprintf("📟 <@%s> is on call and was assigned as **%s**",
+			assignee.User.DiscordID, assignee.Role.GetDisplayName()))
+	}()
+}
+
+// onAssigneesSuggested refreshes the card of an issue to show the team members suggested
+// for it
+func (h *Handler) onAssigneesSuggested(_ context.Context, event domain.Event) {
+	h.refreshCardAsync(event.Issue.ID)
+}
+
+// onIssueRouted refreshes the card of an issue auto-assigned to the team member best
+// matching it and pings them in the issue thread
+func (h *Handler) onIssueRouted(_ context.Context, event domain.Event) {
+	issue, assignee := event.Issue, event.Assignee
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("team assignment", zap.String("issue_id", issue.ID.String()))
+
+		h.refreshIssue(ctx, issue.ID, fmt.Sprintf("💡 <@%s> best matches this issue on the team and was assigned as **%s**",
+			assignee.User.DiscordID, assignee.Role.GetDisplayName()))
+	}()
+}
+
+// onIssueEscalated refreshes the card of an escalated issue whose priority was raised. The
+// escalation itself is posted in the issue thread by the escalation notifier.
+func (h *Handler) onIssueEscalated(_ context.Context, event domain.Event) {
+	if event.OldPriority == "" {
+		return
+	}
+	h.refreshCardAsync(event.Issue.ID)
+}
+
+// refreshCardAsync reloads an issue and edits its card in the background
+func (h *Handler) refreshCardAsync(issueID uuid.UUID) {
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("card refresh", zap.String("issue_id", issueID.String()))
+
+		loadedAt := time.Now()
+		issue, err := h.issueService.GetIssue(ctx, issueID)
+		if err != nil {
+			h.logger.Error("Failed to load issue for card refresh",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+			)
+			return
+		}
+
+		if issue.Channel == nil {
+			return
+		}
+		if h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue) == nil {
+			h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
+		}
+	}()
+}
+
+// onIssueEmailReply posts a reply a customer sent by email in the issue thread
+func (h *Handler) onIssueEmailReply(_ context.Context, event domain.Event) {
+	if event.Issue.ThreadID == "" {
+		return
+	}
+
+	issue := event.Issue
+	content := fmt.Sprintf("📧 **%s** replied by email:\n%s", event.AuthorName, truncateText(event.Content, maxEmailReplyLength))
+	ctx, done := h.track(cardRefreshTimeout)
+	go func() {
+		defer done()
+		defer h.recoverPanic("email reply", zap.String("issue_id", issue.ID.String()))
+
+		if _, err := h.session.ChannelMessageSend(issue.ThreadID, content, discordgo.WithContext(ctx)); err != nil {
+			h.logger.Error("Failed to post email reply to thread",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		}
+	}()
+}
@@ -0,0 +1,67 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleExportCommand handles the /export slash command
+func (h *Handler) handleExportCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling export command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionExportIssues) {
+		return
+	}
+
+	format := domain.ExportFormatCSV
+	var milestone string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "format":
+			format = domain.ExportFormat(option.StringValue())
+		case "milestone":
+			milestone = option.StringValue()
+		}
+	}
+
+	// Large projects can take longer than the interaction deadline to export
+	if !h.deferResponse(ctx, i, true) {
+		return
+	}
+
+	file, err := h.exportService.ExportChannelProjectIssues(ctx, i.ChannelID, format, milestone)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrMilestoneNotFound):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no milestone with that name. See `/milestone list`."), true)
+		default:
+			h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to export issues. Please try again."))
+		}
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "📤 Exported %d issues.", file.IssueCount),
+		Files: []*discordgo.File{
+			{
+				Name:        file.Name,
+				ContentType: file.ContentType,
+				Reader:      bytes.NewReader(file.Data),
+			},
+		},
+		Flags: discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to upload export", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to upload the export file. It may be too large for Discord."), true)
+	}
+}
@@ -0,0 +1,125 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// featureLabels names the experimental features for people
+var featureLabels = map[domain.Feature]string{
+	domain.FeatureBoard:        "The issue board",
+	domain.FeatureAutocomplete: "Autocomplete",
+	domain.FeatureForumMode:    "Forum channels",
+	domain.FeatureAutoAssign:   "Auto-assignment to team members",
+}
+
+// featureLabel names a feature in the language of the interaction
+func featureLabel(ctx context.Context, feature domain.Feature) string {
+	return i18n.T(ctx, featureLabels[feature])
+}
+
+// handleFeatureCommand handles the /feature slash command, which turns experimental
+// features on or off in the server
+func (h *Handler) handleFeatureCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+
+	h.logger.Info("Handling feature command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageSettings) {
+		return
+	}
+
+	userID := i.Member.User.ID
+	var (
+		content string
+		err     error
+	)
+	switch subcommand.Name {
+	case "list":
+		var states []domain.FeatureState
+		states, err = h.featureService.List(ctx, i.GuildID)
+		if err == nil {
+			content = formatFeatures(ctx, states)
+		}
+	case "enable", "disable":
+		feature := domain.Feature(subcommand.GetOption("feature").StringValue())
+		enabled := subcommand.Name == "enable"
+		err = h.featureService.SetEnabled(ctx, i.GuildID, feature, enabled, userID)
+		content = i18n.T(ctx, "🧪 %s is now off in this server.", featureLabel(ctx, feature))
+		if enabled {
+			content = i18n.T(ctx, "🧪 %s is now on in this server.", featureLabel(ctx, feature))
+		}
+	case "reset":
+		feature := domain.Feature(subcommand.GetOption("feature").StringValue())
+		err = h.featureService.Reset(ctx, i.GuildID, feature, userID)
+		content = i18n.T(ctx, "🧪 %s follows the bot's default again.", featureLabel(ctx, feature))
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the server's features. Please try again."))
+		return
+	}
+
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// requireFeature checks that a feature is on in the interaction's guild, and tells the
+// user that it is not otherwise
+func (h *Handler) requireFeature(ctx context.Context, i *discordgo.InteractionCreate, feature domain.Feature) bool {
+	if h.featureService.IsEnabled(ctx, i.GuildID, feature) {
+		return true
+	}
+	h.respondToInteraction(ctx, i, featureDisabledMessage(ctx, feature), true)
+	return false
+}
+
+// featureDisabledMessage tells users that a feature is off in their server
+func featureDisabledMessage(ctx context.Context, feature domain.Feature) string {
+	return i18n.T(ctx, "❌ %s is not enabled in this server yet. An administrator can turn it on with `/feature enable`.", featureLabel(ctx, feature))
+}
+
+// formatFeatures lists the features of a server and where their state comes from
+func formatFeatures(ctx context.Context, states []domain.FeatureState) string {
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🧪 **Features**\n"))
+	for _, state := range states {
+		status, source := i18n.T(ctx, "❌ off"), i18n.T(ctx, "default")
+		if state.Enabled {
+			status = i18n.T(ctx, "✅ on")
+		}
+		if state.Overridden {
+			source = i18n.T(ctx, "set in this server")
+		}
+		b.WriteString(fmt.Sprintf("• %s (`%s`): %s, %s\n", featureLabel(ctx, state.Feature), state.Feature, status, source))
+	}
+	return b.String()
+}
+
+// featureChoices offers the experimental features as choices of a command option
+func featureChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(domain.Features()))
+	for _, feature := range domain.Features() {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: featureLabels[feature], Value: string(feature)})
+	}
+	return choices
+}
@@ -0,0 +1,199 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// feedbackRatePrefix starts the custom IDs of survey rating buttons:
+	// "feedback_rate_<issue ID>_<rating>"
+	feedbackRatePrefix = "feedback_rate_"
+	// feedbackCommentPrefix starts the custom ID of the button opening the comment form
+	feedbackCommentPrefix = "feedback_comment_"
+	// feedbackModalPrefix starts the custom ID of the comment form
+	feedbackModalPrefix = "feedback_modal_"
+)
+
+// feedbackRatingLabels label the survey rating buttons from 1 to 5
+var feedbackRatingLabels = []string{"😞 1", "🙁 2", "😐 3", "🙂 4", "😄 5"}
+
+// FeedbackSurveyor sends satisfaction surveys to the reporters of closed issues as direct messages
+type FeedbackSurveyor struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewFeedbackSurveyor creates a new feedback surveyor
+func NewFeedbackSurveyor(session *discordgo.Session, logger *zap.Logger) *FeedbackSurveyor {
+	return &FeedbackSurveyor{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// SendSurvey DMs the reporter of a closed issue a row of rating buttons
+func (s *FeedbackSurveyor) SendSurvey(_ context.Context, user *domain.User, issue *domain.Issue) error {
+	buttons := make([]discordgo.MessageComponent, 0, len(feedbackRatingLabels))
+	for n, label := range feedbackRatingLabels {
+		buttons = append(buttons, discordgo.Button{
+			Label:    label,
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("%s%s_%d", feedbackRatePrefix, issue.ID.String(), n+domain.MinFeedbackRating),
+		})
+	}
+
+	return sendDirectMessage(s.session, user.DiscordID, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{{
+			Title:       "⭐ How did we do?",
+			Description: fmt.Sprintf("Your issue **%s** - %s was closed. How satisfied are you with how it was handled?", issueDisplayName(issue), issue.Title),
+			Color:       0xf1c40f,
+			Footer:      &discordgo.MessageEmbedFooter{Text: "1 is very unsatisfied, 5 very satisfied"},
+		}},
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: buttons},
+		},
+	})
+}
+
+// handleFeedbackRateButton stores the rating picked in a survey and offers to add a comment
+func (h *Handler) handleFeedbackRateButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, feedbackRatePrefix), "_")
+	if len(parts) != 2 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+	issueID, err := uuid.Parse(parts[0])
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+	rating, err := strconv.Atoi(parts[1])
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+
+	if _, err := h.feedbackService.Rate(ctx, issueID, interactionUserID(i), rating); err != nil {
+		h.respondFeedbackError(ctx, i, err)
+		return
+	}
+
+	h.updateFeedbackSurvey(ctx, i, i18n.T(ctx, "Thanks! You rated this issue **%d/%d**. Anything else you want to tell the team?", rating, domain.MaxFeedbackRating),
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    i18n.T(ctx, "Add a comment"),
+				Style:    discordgo.PrimaryButton,
+				CustomID: feedbackCommentPrefix + issueID.String(),
+				Emoji:    &discordgo.ComponentEmoji{Name: "💬"},
+			},
+		}})
+}
+
+// handleFeedbackCommentButton opens the form for commenting on a rating
+func (h *Handler) handleFeedbackCommentButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	issueID := strings.TrimPrefix(i.MessageComponentData().CustomID, feedbackCommentPrefix)
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: feedbackModalPrefix + issueID,
+			Title:    i18n.T(ctx, "Your Feedback"),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "comment",
+							Label:       i18n.T(ctx, "What went well, or what could be better?"),
+							Style:       discordgo.TextInputParagraph,
+							Required:    true,
+							MaxLength:   domain.MaxFeedbackCommentLength,
+							Placeholder: i18n.T(ctx, "Your comment is shared with the team that handled the issue"),
+						},
+					},
+				},
+			},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to open feedback form", zap.Error(err))
+	}
+}
+
+// handleFeedbackModalSubmit stores the comment added to a rating
+func (h *Handler) handleFeedbackModalSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
+	issueID, err := uuid.Parse(strings.TrimPrefix(i.ModalSubmitData().CustomID, feedbackModalPrefix))
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid form data"), true)
+		return
+	}
+
+	components := i.ModalSubmitData().Components
+	if len(components) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid form data"), true)
+		return
+	}
+	comment := components[0].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
+
+	feedback, err := h.feedbackService.Comment(ctx, issueID, interactionUserID(i), comment)
+	if err != nil {
+		h.respondFeedbackError(ctx, i, err)
+		return
+	}
+
+	h.updateFeedbackSurvey(ctx, i, i18n.T(ctx, "Thanks! You rated this issue **%d/%d** and your comment was shared with the team.", feedback.Rating, domain.MaxFeedbackRating))
+}
+
+// updateFeedbackSurvey replaces the survey with a thank-you note and the given components
+func (h *Handler) updateFeedbackSurvey(ctx context.Context, i *discordgo.InteractionCreate, description string, components ...discordgo.MessageComponent) {
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{{
+				Title:       i18n.T(ctx, "⭐ Thanks for your feedback"),
+				Description: description,
+				Color:       0x2ecc71,
+			}},
+			Components: components,
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update feedback survey", zap.Error(err))
+	}
+}
+
+// respondFeedbackError explains why a rating or comment was not stored
+func (h *Handler) respondFeedbackError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrIssueNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This issue no longer exists."), true)
+	case errors.Is(err, domain.ErrFeedbackNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please rate the issue before adding a comment."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to save your feedback. Please try again."))
+	}
+}
+
+// interactionUserID returns the Discord ID of the user of an interaction. Interactions in
+// direct messages, such as survey answers, have a user but no member.
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
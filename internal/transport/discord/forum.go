@@ -0,0 +1,165 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxForumTags is the number of tags Discord allows on a forum
+	maxForumTags = 20
+	// maxForumTagName is the length Discord allows for a forum tag name
+	maxForumTagName = 20
+	// maxAppliedForumTags is the number of tags Discord allows on a forum post
+	maxAppliedForumTags = 5
+	// maxThreadName is the length Discord allows for a thread or forum post name
+	maxThreadName = 100
+)
+
+// builtInStatuses lists the statuses every workflow has, for recognizing status tags
+var builtInStatuses = []domain.Status{
+	domain.StatusDraft,
+	domain.StatusOpen,
+	domain.StatusInProgress,
+	domain.StatusResolved,
+	domain.StatusVerified,
+	domain.StatusClosed,
+	domain.StatusRejected,
+	domain.StatusReopened,
+}
+
+// fetchChannel looks up a Discord channel in the state cache, falling back to the API
+func (h *Handler) fetchChannel(channelID string) (*discordgo.Channel, error) {
+	if ch, err := h.shards.Channel(channelID); err == nil {
+		return ch, nil
+	}
+	return h.session.Channel(channelID)
+}
+
+// intakeChannel resolves the channel that issues reported in a Discord channel are filed
+// in, and its registration type. Slash commands cannot be used in a forum itself, only in
+// its posts, so a post stands for its forum.
+func (h *Handler) intakeChannel(discordChannelID string) (channelID, channelType string) {
+	ch, err := h.fetchChannel(discordChannelID)
+	if err != nil || !ch.IsThread() || ch.ParentID == "" {
+		return discordChannelID, domain.ChannelTypeText
+	}
+
+	parent, err := h.fetchChannel(ch.ParentID)
+	if err != nil || parent.Type != discordgo.ChannelTypeGuildForum {
+		return discordChannelID, domain.ChannelTypeText
+	}
+	return parent.ID, domain.ChannelTypeForum
+}
+
+// issueCardChannelID returns the channel holding an issue's card: its post in a forum,
+// the registered channel otherwise
+func issueCardChannelID(issue *domain.Issue) string {
+	if issue.Channel == nil {
+		return ""
+	}
+	if issue.Channel.IsForum() && issue.ThreadID != "" {
+		return issue.ThreadID
+	}
+	return issue.Channel.DiscordChannelID
+}
+
+// publishForumPost creates the forum post of a new issue with its card as the starter
+// message. The starter message of a post shares the post's ID.
+func (h *Handler) publishForumPost(ctx context.Context, issue *domain.Issue, card *discordgo.MessageSend) error {
+	thread := &discordgo.ThreadStart{
+		Name:                issueThreadName(issue),
+		AutoArchiveDuration: threadAutoArchiveDuration(issue.Priority),
+	}
+	if tag, err := h.statusTag(issue.Channel.DiscordChannelID, issue); err != nil {
+		h.logger.Warn("Failed to prepare forum status tag", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+	} else {
+		thread.AppliedTags = []string{tag.ID}
+	}
+
+	post, err := h.session.ForumThreadStartComplex(issue.Channel.DiscordChannelID, thread, card)
+	if err != nil {
+		return fmt.Errorf("failed to create forum post: %w", err)
+	}
+
+	if err := h.issueService.SetThreadInfo(ctx, issue.ID, post.ID, post.ID); err != nil {
+		h.logger.Error("Failed to store forum post of issue", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+	}
+	return nil
+}
+
+// statusTag finds the forum tag named after the issue's status, creating it if the
+// forum has room for another tag
+func (h *Handler) statusTag(forumID string, issue *domain.Issue) (*discordgo.ForumTag, error) {
+	forum, err := h.session.Channel(forumID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forum: %w", err)
+	}
+
+	name := truncateText(issue.GetStatusDisplayName(), maxForumTagName)
+	for i := range forum.AvailableTags {
+		if forum.AvailableTags[i].Name == name {
+			return &forum.AvailableTags[i], nil
+		}
+	}
+
+	if len(forum.AvailableTags) >= maxForumTags {
+		return nil, fmt.Errorf("forum already has %d tags", maxForumTags)
+	}
+
+	tags := append(forum.AvailableTags, discordgo.ForumTag{Name: name, EmojiName: getStatusEmoji(issue.Status)})
+	forum, err = h.session.ChannelEditComplex(forumID, &discordgo.ChannelEdit{AvailableTags: &tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add forum tag: %w", err)
+	}
+	for i := range forum.AvailableTags {
+		if forum.AvailableTags[i].Name == name {
+			return &forum.AvailableTags[i], nil
+		}
+	}
+	return nil, fmt.Errorf("forum tag %q was not created", name)
+}
+
+// forumPostTags returns the tags an issue's forum post should carry: the tag of the
+// issue's status in place of any other status tag, keeping tags that are not about a
+// status. It returns nil for issues outside forums or when the tag cannot be prepared.
+func (h *Handler) forumPostTags(issue *domain.Issue, post *discordgo.Channel) []string {
+	if issue.Channel == nil || !issue.Channel.IsForum() {
+		return nil
+	}
+
+	tag, err := h.statusTag(issue.Channel.DiscordChannelID, issue)
+	if err != nil {
+		h.logger.Warn("Failed to prepare forum status tag", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		return nil
+	}
+
+	statusNames := make(map[string]bool)
+	for _, status := range builtInStatuses {
+		statusNames[truncateText(issue.Workflow().StatusDisplayName(status), maxForumTagName)] = true
+	}
+	if workflow := issue.Workflow(); workflow != nil {
+		for _, status := range workflow.Statuses {
+			statusNames[truncateText(status.Name, maxForumTagName)] = true
+		}
+	}
+	tagNames := make(map[string]string)
+	if forum, err := h.fetchChannel(issue.Channel.DiscordChannelID); err == nil {
+		for _, available := range forum.AvailableTags {
+			tagNames[available.ID] = available.Name
+		}
+	}
+
+	applied := []string{tag.ID}
+	for _, id := range post.AppliedTags {
+		if id != tag.ID && !statusNames[tagNames[id]] && len(applied) < maxAppliedForumTags {
+			applied = append(applied, id)
+		}
+	}
+	return applied
+}
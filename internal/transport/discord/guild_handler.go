@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"strconv"
+	"time"
+
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// guildJoinClaimTTL is how long a guild join is claimed for a replica. Every replica
+// connected to the shard receives the join at about the same time.
+const guildJoinClaimTTL = time.Hour
+
+// handleGuildCreate sets up a server the bot was invited to while running: it stores
+// default settings for it and posts a message on how to get started. Discord also sends
+// the servers the bot was already in when a shard connects, and servers that come back
+// after an outage; those joined before the process started are left alone. Commands are
+// registered by CommandManager.HandleGuildCreate.
+func (h *Handler) handleGuildCreate(s *discordgo.Session, g *discordgo.GuildCreate) {
+	if g.Unavailable || g.JoinedAt.Before(h.startedAt) {
+		return
+	}
+
+	// A shard reconnecting sends the guild again with the same join time
+	joinKey := "guild_join:" + g.ID + ":" + strconv.FormatInt(g.JoinedAt.UnixMilli(), 10)
+	if _, seen := h.guildJoins.LoadOrStore(joinKey, true); seen {
+		return
+	}
+
+	ctx, done := h.track(interactionTimeout)
+	defer done()
+	defer h.recoverPanic("guild join", zap.String("guild_id", g.ID))
+
+	if !h.claimEvent(ctx, joinKey, guildJoinClaimTTL) {
+		return
+	}
+
+	h.logger.Info("Joined guild",
+		zap.String("guild_id", g.ID),
+		zap.String("guild_name", g.Name),
+		zap.Int("member_count", g.MemberCount),
+	)
+
+	settings, err := h.guildSettingsService.InitSettings(ctx, g.ID)
+	if err != nil {
+		h.logger.Error("Failed to create guild settings", zap.Error(err), zap.String("guild_id", g.ID))
+	}
+
+	// The server has no language chosen yet unless the bot was in it before, so the
+	// message is in the server's community language when the bot speaks it
+	locale := i18n.Match(g.PreferredLocale)
+	if settings != nil && i18n.Match(settings.Locale) != "" {
+		locale = i18n.Match(settings.Locale)
+	}
+	if locale == "" {
+		locale = i18n.DefaultLocale
+	}
+	ctx = i18n.WithLocale(ctx, locale)
+
+	channelID := onboardingChannel(s, g.Guild)
+	if channelID == "" {
+		h.logger.Info("No channel to post the onboarding message in", zap.String("guild_id", g.ID))
+		return
+	}
+	h.sendMessage(ctx, channelID, i18n.T(ctx, "👋 Thanks for adding Fix Track Bot!\n\n"+
+		"**Getting started**\n"+
+		"1. Run `/register` in the channel where issues should be reported, choosing its customer and project\n"+
+		"2. Members report issues there with `/issue`, by reacting to a message with 🐞 or with the *Create Issue from Message* app command\n"+
+		"3. Admins set the server's language, time zone and admin roles with `/settings`\n\n"+
+		"Run `/help` for every command. Server members with the Administrator or Manage Server permission are admins of the bot."))
+}
+
+// onboardingChannel picks the channel a server's onboarding message goes to: its system
+// channel, where Discord posts welcome messages, else its first text channel. Only
+// channels the bot can see and send messages in are picked; none is an empty string.
+func onboardingChannel(s *discordgo.Session, guild *discordgo.Guild) string {
+	canSend := func(channelID string) bool {
+		perms, err := s.State.UserChannelPermissions(s.State.User.ID, channelID)
+		return err == nil && perms&(discordgo.PermissionViewChannel|discordgo.PermissionSendMessages) == discordgo.PermissionViewChannel|discordgo.PermissionSendMessages
+	}
+
+	if guild.SystemChannelID != "" && canSend(guild.SystemChannelID) {
+		return guild.SystemChannelID
+	}
+
+	var first *discordgo.Channel
+	for _, channel := range guild.Channels {
+		if channel.Type != discordgo.ChannelTypeGuildText || !canSend(channel.ID) {
+			continue
+		}
+		if first == nil || channel.Position < first.Position {
+			first = channel
+		}
+	}
+	if first == nil {
+		return ""
+	}
+	return first.ID
+}
@@ -2,40 +2,202 @@ package discord
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+// maxStatusComments limits how many recent comments /issue-status shows
+const maxStatusComments = 5
+
+// maxStatusHistory is the number of most recent status changes shown by /issue-status
+const maxStatusHistory = 10
+
+// maxIssueStatusLength keeps an /issue-status response within Discord's message limit
+const maxIssueStatusLength = 1990
+
+// interactionTimeout bounds handling one interaction, message or reaction, including the
+// work done after responding
+const interactionTimeout = 30 * time.Second
+
 // Handler handles Discord interactions
 type Handler struct {
-	session              *discordgo.Session
-	issueService         domain.IssueService
-	channelService       domain.ChannelService
-	issueAssigneeService domain.IssueAssigneeService
-	logger               *zap.Logger
+	session               *discordgo.Session // Primary shard, for REST calls
+	shards                *ShardManager
+	api                   *APICaller // Retries message sends and edits
+	issueService          domain.IssueService
+	channelService        domain.ChannelService
+	customerService       domain.CustomerService
+	projectService        domain.ProjectService
+	issueAssigneeService  domain.IssueAssigneeService
+	attachmentService     domain.IssueAttachmentService
+	labelService          domain.LabelService
+	issueLinkService      domain.IssueLinkService
+	statsService          domain.StatsService
+	exportService         domain.ExportService
+	releaseNotesService   domain.ReleaseNotesService
+	permissionService     domain.PermissionService
+	webhookService        domain.WebhookService
+	workflowService       domain.WorkflowService
+	customFieldService    domain.CustomFieldService
+	recurringIssueService domain.RecurringIssueService
+	staleIssueService     domain.StaleIssueService
+	worklogService        domain.WorklogService
+	dueDateService        domain.DueDateService
+	milestoneService      domain.MilestoneService
+	componentService      domain.ComponentService
+	boardService          domain.BoardService
+	escalationService     domain.EscalationService
+	incidentService       domain.IncidentService
+	feedbackService       domain.FeedbackService
+	apiKeyService         domain.APIKeyService
+	guildSettingsService  domain.GuildSettingsService
+	featureService        domain.FeatureService
+	onCallService         domain.OnCallService
+	teamService           domain.TeamService
+	notificationService   domain.NotificationService
+	auditService          domain.AuditLogService
+	bulkService           domain.BulkService
+	statusLogService      domain.IssueStatusLogService
+	watcherService        domain.WatcherService
+	pendingIssues         *pendingIssueStore
+	registrations         *registrationStore          // Registration wizards in progress
+	interactions          *dedupeStore                // IDs of interactions already handled
+	reportReactions       *dedupeStore                // Message and user IDs of report reactions already handled
+	claims                domain.EventClaimRepository // Shares handled events with other replicas; nil when running alone
+	outbox                domain.OutboxRepository     // Card and thread changes still to be done in Discord
+	deferred              sync.Map                    // Interaction ID -> whether its deferred response is ephemeral
+	resyncs               sync.Map                    // Discord channel IDs with a /resync running
+	guildJoins            sync.Map                    // Guild joins already handled by this process
+	startedAt             time.Time                   // Guilds joined before then are not new
+	panics                atomic.Uint64               // Panics recovered in the handlers
+	logger                *zap.Logger
+
+	// boardMu serializes board refreshes
+	boardMu sync.Mutex
+
+	// ctx is the application context handlers derive their contexts from
+	ctx      context.Context
+	mu       sync.Mutex
+	stopping bool
+	inflight sync.WaitGroup
 }
 
 // NewHandler creates a new Discord handler
-func NewHandler(session *discordgo.Session, issueService domain.IssueService, channelService domain.ChannelService, issueAssigneeService domain.IssueAssigneeService, logger *zap.Logger) *Handler {
+func NewHandler(shards *ShardManager, api *APICaller, issueService domain.IssueService, channelService domain.ChannelService, customerService domain.CustomerService, projectService domain.ProjectService, issueAssigneeService domain.IssueAssigneeService, attachmentService domain.IssueAttachmentService, labelService domain.LabelService, issueLinkService domain.IssueLinkService, statsService domain.StatsService, exportService domain.ExportService, releaseNotesService domain.ReleaseNotesService, permissionService domain.PermissionService, webhookService domain.WebhookService, workflowService domain.WorkflowService, customFieldService domain.CustomFieldService, recurringIssueService domain.RecurringIssueService, staleIssueService domain.StaleIssueService, worklogService domain.WorklogService, dueDateService domain.DueDateService, milestoneService domain.MilestoneService, componentService domain.ComponentService, boardService domain.BoardService, escalationService domain.EscalationService, incidentService domain.IncidentService, feedbackService domain.FeedbackService, apiKeyService domain.APIKeyService, guildSettingsService domain.GuildSettingsService, featureService domain.FeatureService, onCallService domain.OnCallService, teamService domain.TeamService, notificationService domain.NotificationService, auditService domain.AuditLogService, bulkService domain.BulkService, statusLogService domain.IssueStatusLogService, watcherService domain.WatcherService, claims domain.EventClaimRepository, outbox domain.OutboxRepository, state domain.StateStore, logger *zap.Logger) *Handler {
 	return &Handler{
-		session:              session,
-		issueService:         issueService,
-		channelService:       channelService,
-		issueAssigneeService: issueAssigneeService,
-		logger:               logger,
+		session:               shards.Primary(),
+		shards:                shards,
+		api:                   api,
+		issueService:          issueService,
+		channelService:        channelService,
+		customerService:       customerService,
+		projectService:        projectService,
+		issueAssigneeService:  issueAssigneeService,
+		attachmentService:     attachmentService,
+		labelService:          labelService,
+		issueLinkService:      issueLinkService,
+		statsService:          statsService,
+		exportService:         exportService,
+		releaseNotesService:   releaseNotesService,
+		permissionService:     permissionService,
+		webhookService:        webhookService,
+		workflowService:       workflowService,
+		customFieldService:    customFieldService,
+		recurringIssueService: recurringIssueService,
+		staleIssueService:     staleIssueService,
+		worklogService:        worklogService,
+		dueDateService:        dueDateService,
+		milestoneService:      milestoneService,
+		componentService:      componentService,
+		boardService:          boardService,
+		escalationService:     escalationService,
+		incidentService:       incidentService,
+		feedbackService:       feedbackService,
+		apiKeyService:         apiKeyService,
+		guildSettingsService:  guildSettingsService,
+		featureService:        featureService,
+		onCallService:         onCallService,
+		teamService:           teamService,
+		notificationService:   notificationService,
+		auditService:          auditService,
+		bulkService:           bulkService,
+		statusLogService:      statusLogService,
+		watcherService:        watcherService,
+		pendingIssues:         newPendingIssueStore(state),
+		registrations:         newRegistrationStore(state),
+		interactions:          newDedupeStore(interactionDedupeTTL),
+		reportReactions:       newDedupeStore(reportReactionTTL),
+		claims:                claims,
+		outbox:                outbox,
+		startedAt:             time.Now(),
+		logger:                logger,
+		ctx:                   context.Background(),
+	}
+}
+
+// RegisterHandlers registers all Discord event handlers. Their work is cancelled when ctx is.
+func (h *Handler) RegisterHandlers(ctx context.Context) {
+	h.ctx = ctx
+
+	h.shards.AddHandler(h.handleMessageCreate)
+	h.shards.AddHandler(h.handleInteractionCreate)
+	h.shards.AddHandler(h.handleMessageReactionAdd)
+	h.shards.AddHandler(h.handleGuildCreate)
+}
+
+// track starts tracking a unit of handler work so Wait can wait for it. The returned
+// context is derived from the application context and times out after timeout;
+// done must be called when the work returns. Work started after Wait gets a
+// cancelled context.
+func (h *Handler) track(timeout time.Duration) (context.Context, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.stopping {
+		ctx, cancel := context.WithCancel(h.ctx)
+		cancel()
+		return ctx, func() {}
+	}
+
+	h.inflight.Add(1)
+	ctx, cancel := context.WithTimeout(h.ctx, timeout)
+	return ctx, func() {
+		cancel()
+		h.inflight.Done()
 	}
 }
 
-// RegisterHandlers registers all Discord event handlers
-func (h *Handler) RegisterHandlers() {
-	h.session.AddHandler(h.handleMessageCreate)
-	h.session.AddHandler(h.handleInteractionCreate)
+// Wait blocks until the handler work in flight returns, or ctx is done.
+// Cancel the context passed to RegisterHandlers first so the work stops early.
+func (h *Handler) Wait(ctx context.Context) error {
+	h.mu.Lock()
+	h.stopping = true
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // handleMessageCreate handles regular Discord messages
@@ -45,24 +207,89 @@ func (h *Handler) handleMessageCreate(s *discordgo.Session, m *discordgo.Message
 		return
 	}
 
-	ctx := context.Background()
+	ctx, done := h.track(interactionTimeout)
+	defer done()
+	defer h.recoverPanic("message", zap.String("message_id", m.ID), zap.String("channel_id", m.ChannelID))
+
+	if !h.claimEvent(ctx, "message:"+m.ID, interactionDedupeTTL) {
+		return
+	}
 
 	// Handle simple ping/pong commands
 	switch strings.ToLower(m.Content) {
 	case "ping":
 		h.sendMessage(ctx, m.ChannelID, "Pong! 🏓")
+		return
 	case "pong":
 		h.sendMessage(ctx, m.ChannelID, "Ping! 🏓")
+		return
+	}
+
+	// Store human messages posted in issue threads as issue comments
+	if m.Author.Bot {
+		return
+	}
+
+	if m.GuildID != "" && h.handleQuickReport(ctx, m, s.State.User.ID) {
+		return
+	}
+
+	content := strings.TrimSpace(m.Content)
+	for _, attachment := range m.Attachments {
+		content = strings.TrimSpace(fmt.Sprintf("%s\n📎 %s", content, attachment.URL))
+	}
+	if content == "" {
+		return
+	}
+
+	if _, err := h.issueService.AddThreadComment(ctx, m.ChannelID, m.ID, m.Author.ID, m.Author.Username, content); err != nil && err != domain.ErrIssueNotFound {
+		h.logger.Error("Failed to record thread comment",
+			zap.Error(err),
+			zap.String("channel_id", m.ChannelID),
+		)
 	}
 }
 
 // handleInteractionCreate handles Discord interactions (slash commands, buttons, modals, etc.)
 func (h *Handler) handleInteractionCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	ctx := context.Background()
+	// Discord occasionally delivers an interaction twice, e.g. when the gateway resumes.
+	// The first delivery answers it, so a repeat must not run its action again.
+	if !h.interactions.firstSeen(i.ID) {
+		h.logger.Info("Ignoring redelivered interaction",
+			zap.String("interaction_id", i.ID),
+			zap.Int("type", int(i.Type)),
+		)
+		return
+	}
+
+	ctx, done := h.track(interactionTimeout)
+	defer done()
+	defer h.deferred.Delete(i.ID)
+	defer func() {
+		if r := recover(); r != nil {
+			h.recoverInteraction(ctx, i, r)
+		}
+	}()
+
+	if !h.claimEvent(ctx, "interaction:"+i.ID, interactionDedupeTTL) {
+		return
+	}
+
+	// Attribute the actions the interaction triggers to its user in the audit log
+	actor := domain.Actor{GuildID: i.GuildID}
+	if i.Member != nil && i.Member.User != nil {
+		actor.DiscordID = i.Member.User.ID
+	} else if i.User != nil {
+		actor.DiscordID = i.User.ID
+	}
+	ctx = domain.ContextWithActor(ctx, actor)
+	ctx = i18n.WithLocale(ctx, h.interactionLocale(ctx, i))
 
 	switch i.Type {
 	case discordgo.InteractionApplicationCommand:
 		h.handleSlashCommand(ctx, i)
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		h.handleAutocomplete(ctx, i)
 	case discordgo.InteractionModalSubmit:
 		h.handleModalSubmit(ctx, i)
 	case discordgo.InteractionMessageComponent:
@@ -74,14 +301,10 @@ func (h *Handler) handleInteractionCreate(s *discordgo.Session, i *discordgo.Int
 func (h *Handler) handleSlashCommand(ctx context.Context, i *discordgo.InteractionCreate) {
 	commandName := i.ApplicationCommandData().Name
 
-	ch, err := h.session.State.Channel(i.ChannelID)
+	ch, err := h.fetchChannel(i.ChannelID)
 	if err != nil {
-		// ถ้า state cache ไม่มี ลอง fetch จาก API ตรงๆ
-		ch, err = h.session.Channel(i.ChannelID)
-		if err != nil {
-			h.logger.Error("Cannot fetch channel", zap.Error(err))
-			return
-		}
+		h.logger.Error("Cannot fetch channel", zap.Error(err))
+		return
 	}
 
 	h.logger.Info("Handling slash command",
@@ -96,35 +319,141 @@ func (h *Handler) handleSlashCommand(ctx context.Context, i *discordgo.Interacti
 		h.handleIssueCommand(ctx, i)
 	case "issues":
 		h.handleIssuesCommand(ctx, i)
-	// case "issue-status":
-	// 	h.handleIssueStatusCommand(ctx, i)
-	case "init":
-		h.handleInitCommand(ctx, i)
-	case "register":
+	case "issue-status":
+		h.handleIssueStatusCommand(ctx, i)
+	case "issue-field":
+		h.handleIssueFieldCommand(ctx, i)
+	case "issue-edit":
+		h.handleIssueEditCommand(ctx, i)
+	case "issue-delete":
+		h.handleIssueDeleteCommand(ctx, i)
+	case "issue-move":
+		h.handleIssueMoveCommand(ctx, i)
+	case "assign":
+		h.handleAssignCommand(ctx, i)
+	case "unassign":
+		h.handleUnassignCommand(ctx, i)
+	case "label":
+		h.handleLabelCommand(ctx, i)
+	case "bulk":
+		h.handleBulkCommand(ctx, i)
+	case "watch":
+		h.handleWatchCommand(ctx, i)
+	case "unwatch":
+		h.handleUnwatchCommand(ctx, i)
+	case "subtask":
+		h.handleSubtaskCommand(ctx, i)
+	case "link":
+		h.handleLinkCommand(ctx, i)
+	case "unlink":
+		h.handleUnlinkCommand(ctx, i)
+	case "track":
+		h.handleTrackCommand(ctx, i)
+	case "due":
+		h.handleDueCommand(ctx, i)
+	case "severity":
+		h.handleSeverityCommand(ctx, i)
+	case "milestone":
+		h.handleMilestoneCommand(ctx, i)
+	case "component":
+		h.handleComponentCommand(ctx, i)
+	case "board":
+		h.handleBoardCommand(ctx, i)
+	case "resync":
+		h.handleResyncCommand(ctx, i)
+	case "stats":
+		h.handleStatsCommand(ctx, i)
+	case "export":
+		h.handleExportCommand(ctx, i)
+	case "release-notes":
+		h.handleReleaseNotesCommand(ctx, i)
+	case "webhook":
+		h.handleWebhookCommand(ctx, i)
+	case "apikey":
+		h.handleAPIKeyCommand(ctx, i)
+	case "my-issues":
+		h.handleMyIssuesCommand(ctx, i)
+	case "workflow":
+		h.handleWorkflowCommand(ctx, i)
+	case "workflow-config":
+		h.handleWorkflowConfigCommand(ctx, i)
+	case "custom-fields":
+		h.handleCustomFieldsCommand(ctx, i)
+	case "recurring":
+		h.handleRecurringCommand(ctx, i)
+	case "stale":
+		h.handleStaleCommand(ctx, i)
+	case "escalation":
+		h.handleEscalationCommand(ctx, i)
+	case "incident":
+		h.handleIncidentCommand(ctx, i)
+	case "settings":
+		h.handleSettingsCommand(ctx, i)
+	case "feature":
+		h.handleFeatureCommand(ctx, i)
+	case "audit-log":
+		h.handleAuditLogCommand(ctx, i)
+	case "oncall":
+		h.handleOnCallCommand(ctx, i)
+	case "team":
+		h.handleTeamCommand(ctx, i)
+	case "notify-prefs":
+		h.handleNotifyPrefsCommand(ctx, i)
+	case "notifications":
+		h.handleNotificationsCommand(ctx, i)
+	case "init", "register":
 		h.handleRegisterCommand(ctx, i)
+	case "channel-admin":
+		h.handleChannelAdminCommand(ctx, i)
+	case "customer":
+		h.handleCustomerCommand(ctx, i)
+	case "project":
+		h.handleProjectCommand(ctx, i)
 	case "help":
 		h.handleHelpCommand(ctx, i)
+	case createIssueFromMessageCommand:
+		h.handleCreateIssueFromMessageCommand(ctx, i)
 	default:
 		h.logger.Warn("Unknown slash command", zap.String("command", commandName))
-		h.respondToInteraction(ctx, i, "Unknown command", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown command"), true)
 	}
 }
 
-// handleIssueCommand handles the /issue slash command
+// handleIssueCommand handles the /issue slash command. In channels with several projects
+// the reporter picks the project first.
 func (h *Handler) handleIssueCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	channelID, _ := h.intakeChannel(i.ChannelID)
+
+	// Unregistered channels still get the form; creating the issue reports the problem
+	if channel, err := h.channelService.GetChannelRegistration(ctx, channelID); err == nil && len(channel.Projects) > 0 {
+		if err := h.session.InteractionRespond(i.Interaction, issueProjectSelect(ctx, channel)); err != nil {
+			h.logger.Error("Failed to respond with project select", zap.Error(err))
+		}
+		return
+	}
+
+	fields := h.formCustomFields(ctx, channelID, uuid.Nil)
+	if err := h.session.InteractionRespond(i.Interaction, issueModal(ctx, "issue_modal", fields)); err != nil {
+		h.logger.Error("Failed to respond with modal", zap.Error(err))
+	}
+}
+
+// issueModal builds the form for a new issue, with inputs for the project's custom fields
+// in the rows left
+func issueModal(ctx context.Context, customID string, fields []*domain.CustomFieldDefinition) *discordgo.InteractionResponse {
 	modal := &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
-			CustomID: "issue_modal",
-			Title:    "Create New Issue",
+			CustomID: customID,
+			Title:    i18n.T(ctx, "Create New Issue"),
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "title",
-							Label:       "Issue Title",
+							Label:       i18n.T(ctx, "Issue Title"),
 							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. Cannot login",
+							Placeholder: i18n.T(ctx, "e.g. Cannot login"),
 							Required:    true,
 							MaxLength:   255,
 						},
@@ -136,7 +465,7 @@ func (h *Handler) handleIssueCommand(ctx context.Context, i *discordgo.Interacti
 							CustomID:    "description",
 							Label:       "Description",
 							Style:       discordgo.TextInputParagraph,
-							Placeholder: "Describe the issue in detail...",
+							Placeholder: i18n.T(ctx, "Describe the issue in detail..."),
 							Required:    true,
 							MaxLength:   2000,
 						},
@@ -146,7 +475,7 @@ func (h *Handler) handleIssueCommand(ctx context.Context, i *discordgo.Interacti
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "image_url",
-							Label:       "Image URL (Optional)",
+							Label:       i18n.T(ctx, "Image URL (Optional)"),
 							Style:       discordgo.TextInputShort,
 							Placeholder: "https://example.com/image.png",
 							Required:    false,
@@ -158,80 +487,9 @@ func (h *Handler) handleIssueCommand(ctx context.Context, i *discordgo.Interacti
 		},
 	}
 
-	if err := h.session.InteractionRespond(i.Interaction, modal); err != nil {
-		h.logger.Error("Failed to respond with modal", zap.Error(err))
-	}
-}
-
-// handleIssuesCommand handles the /issues slash command
-func (h *Handler) handleIssuesCommand(ctx context.Context, i *discordgo.InteractionCreate) {
-	h.logger.Info("Handling issues command",
-		zap.String("user_id", i.Member.User.ID),
-		zap.String("channel_id", i.ChannelID),
-	)
-
-	// Get all issues for this channel
-	issues, err := h.issueService.ListIssuesByChannel(ctx, i.ChannelID)
-	if err != nil {
-		h.logger.Error("Failed to get issues for channel",
-			zap.Error(err),
-			zap.String("channel_id", i.ChannelID),
-		)
-		h.respondToInteraction(ctx, i, "❌ Failed to retrieve issues. Please try again.", true)
-		return
-	}
-
-	if len(issues) == 0 {
-		h.respondToInteraction(ctx, i, "📋 No issues found in this channel.", false)
-		return
-	}
-
-	// Build the response message
-	var content strings.Builder
-	content.WriteString(fmt.Sprintf("📋 **Issues in this channel (%d total):**\n\n", len(issues)))
-
-	openCount := 0
-	closedCount := 0
-
-	for i, issue := range issues {
-		if i >= 10 { // Limit to first 10 issues to avoid message length limits
-			content.WriteString(fmt.Sprintf("*... and %d more issues*\n", len(issues)-10))
-			break
-		}
-
-		// Status emoji
-		if issue.Status == domain.StatusClosed {
-			closedCount++
-		} else {
-			openCount++
-		}
-
-		// Priority emoji
-		priorityEmoji := "🟡"
-		switch issue.Priority {
-		case domain.PriorityLow:
-			priorityEmoji = "🟢"
-		case domain.PriorityHigh:
-			priorityEmoji = "🔴"
-		}
-
-		// Format creation time
-		createdTime := issue.CreatedAt.Format("Jan 2, 2006")
-
-		content.WriteString(fmt.Sprintf("%s **%s**\n", priorityEmoji, issue.Title))
-		content.WriteString(fmt.Sprintf("📅 %s | 👤 <@%s>\n",
-			createdTime, issue.Reporter.DiscordID))
-
-		if issue.ThreadID != "" {
-			content.WriteString(fmt.Sprintf("   💬 <#%s>\n", issue.ThreadID))
-		}
-		content.WriteString("\n")
-	}
-
-	// Add summary
-	content.WriteString(fmt.Sprintf("📊 **Summary:** %d Open, %d Closed", openCount, closedCount))
-
-	h.respondToInteraction(ctx, i, content.String(), false)
+	rows := &modal.Data.Components
+	*rows = append(*rows, customFieldInputs(fields, maxModalRows-len(*rows))...)
+	return modal
 }
 
 // handleIssueStatusCommand handles the /issue-status slash command
@@ -244,55 +502,13 @@ func (h *Handler) handleIssueStatusCommand(ctx context.Context, i *discordgo.Int
 	// Get the issue ID from the command options
 	options := i.ApplicationCommandData().Options
 	if len(options) == 0 {
-		h.respondToInteraction(ctx, i, "❌ Please provide an issue ID.", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please provide an issue ID."), true)
 		return
 	}
 
-	issueIDStr := options[0].StringValue()
-
-	// Try to parse as UUID (full ID)
-	issueID, err := uuid.Parse(issueIDStr)
-	if err != nil {
-		// If not a valid UUID, try to find by partial ID
-		issues, err := h.issueService.ListIssuesByChannel(ctx, i.ChannelID)
-		if err != nil {
-			h.logger.Error("Failed to get issues for partial ID search",
-				zap.Error(err),
-				zap.String("channel_id", i.ChannelID),
-			)
-			h.respondToInteraction(ctx, i, "❌ Failed to search for issues. Please try again.", true)
-			return
-		}
-
-		// Look for issue with matching partial ID
-		var foundIssue *domain.Issue
-		for _, issue := range issues {
-			if strings.HasPrefix(issue.ID.String(), issueIDStr) {
-				foundIssue = issue
-				break
-			}
-		}
-
-		if foundIssue == nil {
-			h.respondToInteraction(ctx, i, fmt.Sprintf("❌ No issue found with ID: `%s`", issueIDStr), true)
-			return
-		}
-		issueID = foundIssue.ID
-	}
-
-	// Get the issue details
-	issue, err := h.issueService.GetIssue(ctx, issueID)
-	if err != nil {
-		if err == domain.ErrIssueNotFound {
-			h.respondToInteraction(ctx, i, fmt.Sprintf("❌ Issue not found: `%s`", issueIDStr), true)
-			return
-		}
-
-		h.logger.Error("Failed to get issue details",
-			zap.Error(err),
-			zap.String("issue_id", issueID.String()),
-		)
-		h.respondToInteraction(ctx, i, "❌ Failed to retrieve issue details. Please try again.", true)
+	// Find the issue by key, full UUID or ID prefix
+	issue, ok := h.resolveIssueForCommand(ctx, i, options[0].StringValue())
+	if !ok {
 		return
 	}
 
@@ -300,10 +516,7 @@ func (h *Handler) handleIssueStatusCommand(ctx context.Context, i *discordgo.Int
 	var content strings.Builder
 
 	// Status emoji
-	statusEmoji := "🟢 **OPEN**"
-	if issue.Status == domain.StatusClosed {
-		statusEmoji = "🔴 **CLOSED**"
-	}
+	statusEmoji := fmt.Sprintf("%s **%s**", getStatusEmoji(issue.Status), strings.ToUpper(issue.GetStatusDisplayName()))
 
 	// Priority emoji
 	priorityEmoji := "🟡"
@@ -317,30 +530,68 @@ func (h *Handler) handleIssueStatusCommand(ctx context.Context, i *discordgo.Int
 		priorityText = "High"
 	}
 
-	content.WriteString(fmt.Sprintf("🎫 **Issue Details**\n\n"))
-	content.WriteString(fmt.Sprintf("**Title:** %s\n", issue.Title))
-	content.WriteString(fmt.Sprintf("**ID:** `%s`\n", issue.ID.String()))
-	content.WriteString(fmt.Sprintf("**Status:** %s\n", statusEmoji))
-	content.WriteString(fmt.Sprintf("**Priority:** %s %s\n", priorityEmoji, priorityText))
-	content.WriteString(fmt.Sprintf("**Reporter:** <@%s>\n", issue.ReporterID))
-	content.WriteString(fmt.Sprintf("**Created:** %s\n", issue.CreatedAt.Format("January 2, 2006 at 3:04 PM")))
+	content.WriteString(i18n.T(ctx, "🎫 **Issue Details**\n\n"))
+	content.WriteString(i18n.T(ctx, "**Title:** %s\n", issue.Title))
+	if issue.Key != "" {
+		content.WriteString(i18n.T(ctx, "**Key:** `%s`\n", issue.Key))
+	}
+	content.WriteString(i18n.T(ctx, "**ID:** `%s`\n", issue.ID.String()))
+	content.WriteString(i18n.T(ctx, "**Status:** %s\n", statusEmoji))
+	content.WriteString(i18n.T(ctx, "**Priority:** %s %s\n", priorityEmoji, priorityText))
+	if issue.Severity != "" {
+		content.WriteString(i18n.T(ctx, "**Severity:** %s %s\n", getSeverityEmoji(issue.Severity), issue.Severity.GetDisplayName()))
+	}
+	content.WriteString(i18n.T(ctx, "**Reporter:** %s\n", formatReporter(&issue.Reporter)))
+	content.WriteString(i18n.T(ctx, "**Created:** %s\n", fmt.Sprintf("<t:%d:F>", issue.CreatedAt.Unix())))
 
 	if issue.Status == domain.StatusClosed && issue.ClosedAt != nil {
-		content.WriteString(fmt.Sprintf("**Closed:** %s\n", issue.ClosedAt.Format("January 2, 2006 at 3:04 PM")))
+		content.WriteString(i18n.T(ctx, "**Closed:** %s\n", fmt.Sprintf("<t:%d:F>", issue.ClosedAt.Unix())))
 	}
 
 	if issue.ThreadID != "" {
-		content.WriteString(fmt.Sprintf("**Discussion:** <#%s>\n", issue.ThreadID))
+		content.WriteString(i18n.T(ctx, "**Discussion:** <#%s>\n", issue.ThreadID))
 	}
 
-	content.WriteString(fmt.Sprintf("\n**Description:**\n%s", issue.Description))
+	content.WriteString(fmt.Sprintf("\n**Description:**\n%s", truncateText(issue.Description, 800)))
+
+	history, err := h.statusLogService.GetIssueStatusHistory(ctx, issue.ID)
+	if err != nil {
+		h.logger.Warn("Failed to get issue status history", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+	} else if len(history) > 0 {
+		content.WriteString("\n\n**History:**\n")
+		if len(history) > maxStatusHistory {
+			content.WriteString(i18n.T(ctx, "…%d earlier changes\n", len(history)-maxStatusHistory))
+			history = history[len(history)-maxStatusHistory:]
+		}
+		for _, entry := range history {
+			content.WriteString(formatStatusLogEntry(ctx, entry))
+		}
+	}
+
+	// Recent thread comments are stored, so they remain visible after the thread is archived
+	comments, err := h.issueService.GetIssueComments(ctx, issue.ID)
+	if err != nil {
+		h.logger.Warn("Failed to get issue comments", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+	} else if len(comments) > 0 {
+		content.WriteString(i18n.T(ctx, "\n\n**Recent Comments** (%d total):\n", len(comments)))
+		if len(comments) > maxStatusComments {
+			comments = comments[len(comments)-maxStatusComments:]
+		}
+		for _, comment := range comments {
+			content.WriteString(fmt.Sprintf("• **%s** <t:%d:R>: %s\n",
+				getDisplayValue(comment.AuthorName, "Unknown"),
+				comment.CreatedAt.Unix(),
+				truncateText(strings.ReplaceAll(comment.Content, "\n", " "), 150),
+			))
+		}
+	}
 
 	// Create embed for image if present
 	var embeds []*discordgo.MessageEmbed
 	if issue.ImageURL != "" {
 		embeds = []*discordgo.MessageEmbed{
 			{
-				Title: "Issue Screenshot",
+				Title: i18n.T(ctx, "Issue Screenshot"),
 				Image: &discordgo.MessageEmbedImage{URL: issue.ImageURL},
 				Color: 0x3498db,
 			},
@@ -349,18 +600,35 @@ func (h *Handler) handleIssueStatusCommand(ctx context.Context, i *discordgo.Int
 
 	// Send response with embed if there's an image
 	if len(embeds) > 0 {
-		if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{
-				Content: content.String(),
-				Embeds:  embeds,
-			},
+		if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+			Content: truncateText(content.String(), maxIssueStatusLength),
+			Embeds:  embeds,
 		}); err != nil {
 			h.logger.Error("Failed to respond to interaction with embed", zap.Error(err))
 		}
 	} else {
-		h.respondToInteraction(ctx, i, content.String(), false)
+		h.respondToInteraction(ctx, i, truncateText(content.String(), maxIssueStatusLength), false)
+	}
+}
+
+// formatStatusLogEntry renders one entry of an issue's history, e.g.
+// "• <t:…:f> Open → In Progress by @Mia"
+func formatStatusLogEntry(ctx context.Context, entry *domain.IssueStatusLog) string {
+	var change string
+	switch {
+	case entry.IsEdit():
+		change = "✏️ " + truncateText(entry.Note, 80)
+	case entry.OldStatus != nil:
+		change = fmt.Sprintf("%s → %s %s", domain.GetStatusDisplayName(*entry.OldStatus), getStatusEmoji(entry.NewStatus), domain.GetStatusDisplayName(entry.NewStatus))
+	default:
+		change = i18n.T(ctx, "Created as %s %s", getStatusEmoji(entry.NewStatus), domain.GetStatusDisplayName(entry.NewStatus))
+	}
+
+	line := fmt.Sprintf("• <t:%d:f> %s", entry.ChangedAt.Unix(), change)
+	if entry.ChangedByUser != nil {
+		line += i18n.T(ctx, " by %s", formatReporter(entry.ChangedByUser))
 	}
+	return line + "\n"
 }
 
 // handleHelpCommand handles the /help slash command
@@ -370,31 +638,144 @@ func (h *Handler) handleHelpCommand(ctx context.Context, i *discordgo.Interactio
 		zap.String("channel_id", i.ChannelID),
 	)
 
-	helpContent := `🤖 **Fix Track Bot - Help**
+	helpSections := []string{`🤖 **Fix Track Bot - Help**
 
 **Available Commands:**
 
 🎫 ` + "`/issue`" + ` - Create a new issue or bug report
    Opens a form to submit a new issue with title, description, and optional screenshot
 
-📋 ` + "`/issues`" + ` - List all issues in this channel
-   Shows a summary of all issues in the current channel with status and priority
+📨 **Create Issue from Message** - Right-click a message → Apps
+   Turns an existing message into an issue and keeps its attached images and files
+   Reacting to a message with 🐞 (or the server's report emoji) reports it right away
+
+⚡ **Mention the bot with** ` + "`report: <text>`" + ` - Capture an issue in one message, e.g. on mobile
+   Saves a draft right away; **Complete details** opens the form prefilled to post it, **Discard** deletes it
+
+📋 ` + "`/issues [status] [priority] [milestone] [component]`" + ` - List issues in this channel
+   Shows issues with status and priority, 10 per page; optionally filter by status, priority, milestone or component
 
 🔍 ` + "`/issue-status <id>`" + ` - Check the status of a specific issue
-   Shows detailed information about an issue (use full UUID or first 8 characters)
+   Shows detailed information, the status history and recent thread comments (use the issue key like ACME-42, the full UUID or its first 8 characters)
+
+✏️ ` + "`/issue-edit <id>`" + ` - Fix the title, description or image URL of an issue
+   Reporters can edit their own issues; editing others' issues needs the support role
+
+📝 ` + "`/issue-field <id> <field> [value]`" + ` - Set a custom field of an issue, or clear it by leaving out the value
+
+🗑️ ` + "`/issue-delete <id>`" + ` - Delete an issue (admin only)
+   Asks for confirmation, then removes the issue card and archives its thread
+
+📦 ` + "`/issue-move <id> <channel> [project]`" + ` - Move an issue filed in the wrong channel
+   Its card and a new thread are posted in the registered channel, and the old thread is archived; moving to another project gives it a new key (support role)
+
+👥 ` + "`/assign <id>`" + ` - Assign users to an issue
+   Pick a role, then choose one or more users; assignees are pinged in the issue thread
+
+➖ ` + "`/unassign <id> <user> [role]`" + ` - Remove a user from an issue
+
+👀 ` + "`/watch <id>`" + ` - Get a DM when an issue changes status or is commented on
+   ` + "`/unwatch <id>`" + ` stops the messages
+
+🏷️ ` + "`/label add|remove|list`" + ` - Tag issues with project labels
+   ` + "`/label add <id> <name> [color]`" + ` creates the label if it does not exist yet
+
+📦 ` + "`/bulk close|assign|label`" + ` - Change up to 50 issues at once by key or by status (support role)
+   Either all issues change or none do; add ` + "`dry-run`" + ` to see what would change first
+
+🧩 ` + "`/subtask <title> [description]`" + ` - Add a sub-task to the issue of the current thread
+   The parent card shows how many sub-tasks are closed; it cannot be closed until all are
+
+🔗 ` + "`/link <id> <type> <target>`" + ` - Mark an issue as a duplicate of, blocking or related to another
+   ` + "`/unlink <id> <target> [type]`" + ` removes the link; linked issues are listed on the issue card
+
+🗓️ ` + "`/due <id> <date>`" + ` - Set a due date such as 2025-03-14 or 2025-03-14 17:00, or ` + "`clear`" + ` it (support role)
+   Assignees are reminded before it is due and when it is overdue; overdue issues are marked ⏰
+
+🚨 ` + "`/severity <id> <level>`" + ` - Set how badly an incident affects customers, S1 to S4, or clear it (support role)
+   Incidents are held to the SLA targets of their severity instead of their priority
+
+🔥 ` + "`/incident declare|update`" + ` - Run an S1 or S2 incident in its own thread with reminded status updates (support role)
+
+⏱️ ` + "`/track start|stop|log`" + ` - Track time spent on an issue (support role)
+   ` + "`/track log <duration> [id] [note]`" + ` logs time without a timer; the total is shown on the card
+
+🎯 ` + "`/milestone create|list|show|assign|unassign|delete`" + ` - Group issues into milestones such as a release
+   ` + "`/milestone show <name>`" + ` shows how many of its issues are closed; changes need the support role
+
+🧱 ` + "`/component add|list|lead|set|remove`" + ` - File issues under areas of the project such as API or Billing
+   Reporters pick one when creating an issue; a component's lead is suggested first for its issues
+
+📌 ` + "`/board`" + ` - Post a pinned board of this channel's issues by status (support role)
+   Open, In Progress, Resolved and Verified columns update whenever an issue changes`,
+
+		`**Project and Team Commands:**
+
+📈 ` + "`/stats [component]`" + ` - Show metrics for this channel's project, or one of its components
+   Open vs closed, resolution time, priorities, workload, time logged and CSAT; pick 7, 30 or 90 days
+
+📤 ` + "`/export [format] [milestone]`" + ` - Export all project issues, or a milestone's, as CSV or XLSX (administrators only)
+   Includes assignees, labels, milestone, custom fields, time spent and the full status history
+
+🚀 ` + "`/release-notes [milestone] [from] [to] [channel] [file]`" + ` - Post the issues closed for a release as a changelog, optionally as a Markdown file (support role)
+
+🔗 ` + "`/webhook add|remove|list`" + ` - Manage webhooks that receive this project's issue events (administrators only)
+   Events are signed JSON POSTs for issue.created, issue.status_changed and issue.assigned
+
+🔑 ` + "`/apikey create|list|revoke`" + ` - Manage the REST API keys of this project's customer (administrators only)
+   A new key is shown once; scopes limit it to reading or writing issues, projects or customers
+
+🙋 ` + "`/my-issues [role]`" + ` - Show the active issues assigned to you across this server's channels
+
+🔄 ` + "`/workflow`" + ` - Show the issue workflow and where your current tasks are
+
+⚙️ ` + "`/workflow-config`" + ` - Add custom statuses and transitions to this project's workflow (administrators only)
+
+🧩 ` + "`/custom-fields show|add|remove`" + ` - Define extra fields such as environment or app version for this project's issues (administrators only)
+   Fields appear on the issue form, the issue card and exports
+
+🔁 ` + "`/recurring add|list|remove`" + ` - File an issue such as a weekly certificate check on a cron schedule (administrators only)
+
+💤 ` + "`/stale show|set|reset`" + ` - Choose after how many idle days assignees are nudged and issues closed (administrators only)
+
+🚨 ` + "`/escalation set|list|remove`" + ` - Ping a role about issues left open too long at a priority, optionally raising it (administrators only)
+
+📟 ` + "`/oncall show|add|remove|handoff`" + ` - See or change who picks up new high-priority issues
+👥 ` + "`/team list|set|remove|suggest`" + ` - Set each member's role, skills and capacity; new issues suggest the best match on their card
+
+🔔 ` + "`/notify-prefs show|set`" + ` - Choose which events you get DMs about
+🔕 ` + "`/notifications [enabled]`" + ` - Turn DMs about your issues and assignments on or off
+
+🛠️ ` + "`/settings`" + ` - Configure this server's locale, time zone, admin roles, escalation channel and SLA targets (administrators only)
+🧪 ` + "`/feature list|enable|disable|reset`" + ` - Turn experimental features such as the issue board on or off in this server (administrators only)
+
+📜 ` + "`/audit-log [limit]`" + ` - Show who registered channels, closed, deleted or exported issues and what changed (administrators only)
 
 📝 ` + "`/register`" + ` - Register this channel for issue tracking
-   Register the channel with customer and project information (required before creating issues)
+   Choose or add the customer and project step by step, then confirm (required before creating issues)
+   To register a forum, run it in one of the forum's posts; each issue then becomes a post tagged with its status
+
+🗂️ ` + "`/channel-admin info|update|add-project|remove-project|deactivate|activate|transfer-project`" + ` - Manage this channel's registration (administrators only)
+   With several projects in a channel, ` + "`/issue`" + ` asks which project the issue is for
 
-❓ ` + "`/help`" + ` - Show this help message
+🏢 ` + "`/customer create|rename|list|merge`" + ` - Manage this server's customers (administrators only)
+📁 ` + "`/project create|rename|list|merge`" + ` - Manage their projects; merging moves all issues and channels of a project to another (administrators only)
 
-**Features:**
+❓ ` + "`/help`" + ` - Show this help message`,
+
+		`**Features:**
 
 • **Issue Tracking** - Create and track issues with unique IDs
 • **Thread Discussions** - Each issue gets its own discussion thread
+• **Forum Channels** - In a registered forum, each issue is a post whose tag follows the issue's status
 • **Priority Levels** - Set priority as Low 🟢, Medium 🟡, or High 🔴
+• **Severity Levels** - Rate the customer impact of incidents from S1 🟥 to S4 🟦, separately from their priority
+• **Incident Mode** - Declared incidents get a briefing in their thread, status update reminders and a timeline when closed
+• **Quick Triage** - React 🔴/🟡/🟢 on an issue card to set its priority, or ✅ to resolve it
 • **Status Management** - Issues can be Open 🟢 or Closed 🔴
-• **Screenshots** - Attach images to issues via URL
+• **Screenshots** - Attach images via URL, or create the issue from a message with attachments
+• **Custom Fields** - Projects can ask for extra details such as environment or app version
+• **Permissions** - Closing, reopening other people's issues, changing priority or severity, declaring incidents, posting release notes, resolving by reaction and on-call changes need the support role; exports, deletion, webhooks, API keys, workflow changes, custom fields, recurring issues, stale thresholds, escalation rules, settings, channel registrations, customers, projects and the audit log need admin
 
 **How to Use:**
 
@@ -411,204 +792,44 @@ func (h *Handler) handleHelpCommand(ctx context.Context, i *discordgo.Interactio
 • Use the thread for follow-up discussion and updates
 • Close issues when they're resolved
 
-Need more help? Contact your server administrators.`
-
-	h.respondToInteraction(ctx, i, helpContent, false)
-}
-
-// handleInitCommand handles the /init slash command
-func (h *Handler) handleInitCommand(ctx context.Context, i *discordgo.InteractionCreate) {
-	h.logger.Info("Handling init command",
-		zap.String("user_id", i.Member.User.ID),
-		zap.String("channel_id", i.ChannelID),
-		zap.String("guild_id", i.GuildID),
-	)
-
-	// Check if channel is already registered
-	isRegistered, err := h.channelService.IsChannelRegistered(ctx, i.ChannelID)
-	if err != nil {
-		h.logger.Error("Failed to check channel registration status",
-			zap.Error(err),
-			zap.String("channel_id", i.ChannelID),
-		)
-		h.respondToInteraction(ctx, i, "❌ Failed to check channel registration status. Please try again.", true)
-		return
+Need more help? Contact your server administrators.`,
 	}
 
-	if isRegistered {
-		// Get existing registration details
-		channel, err := h.channelService.GetChannelRegistration(ctx, i.ChannelID)
-		if err != nil {
-			h.logger.Error("Failed to get existing channel registration",
-				zap.Error(err),
-				zap.String("channel_id", i.ChannelID),
-			)
-			h.respondToInteraction(ctx, i, "❌ Failed to get existing registration details.", true)
+	// The help text is longer than a message may be, so it is sent as several messages
+	messages := helpMessages(helpSections)
+	h.respondToInteraction(ctx, i, messages[0], false)
+	for _, message := range messages[1:] {
+		if _, err := h.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: message,
+		}); err != nil {
+			h.logger.Error("Failed to send help", zap.Error(err))
 			return
 		}
-
-		response := fmt.Sprintf("⚠️ **Channel Already Registered**\n\n"+
-			"This channel is already registered for issue tracking:\n\n"+
-			"**Customer:** %s\n"+
-			"**Project:** %s\n"+
-			"**Registered by:** <@%s>\n"+
-			"**Registration Date:** %s\n\n"+
-			"To update the registration, please contact an administrator.",
-			channel.Project.Customer.Name,
-			channel.Project.Name,
-			channel.RegisteredByUser.DiscordID,
-			channel.CreatedAt.Format("January 2, 2006"),
-		)
-
-		h.respondToInteraction(ctx, i, response, true)
-		return
-	}
-
-	// Show registration modal
-	modal := &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseModal,
-		Data: &discordgo.InteractionResponseData{
-			CustomID: "init_modal",
-			Title:    "Register Channel for Issue Tracking",
-			Components: []discordgo.MessageComponent{
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "customer_name",
-							Label:       "Customer/Organization Name",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. Acme Corporation",
-							Required:    true,
-							MaxLength:   255,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "customer_email",
-							Label:       "Customer Contact Email (Optional)",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. contact@acme.com",
-							Required:    false,
-							MaxLength:   255,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "project_name",
-							Label:       "Project Name",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. E-commerce Platform",
-							Required:    true,
-							MaxLength:   255,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "project_description",
-							Label:       "Project Description (Optional)",
-							Style:       discordgo.TextInputParagraph,
-							Placeholder: "Brief description of the project...",
-							Required:    false,
-							MaxLength:   500,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "user_name",
-							Label:       "Your Full Name (Optional)",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. John Doe",
-							Required:    false,
-							MaxLength:   255,
-						},
-					},
-				},
-			},
-		},
-	}
-
-	if err := h.session.InteractionRespond(i.Interaction, modal); err != nil {
-		h.logger.Error("Failed to respond with register modal", zap.Error(err))
 	}
 }
 
-// handleRegisterCommand handles the /register slash command
-func (h *Handler) handleRegisterCommand(ctx context.Context, i *discordgo.InteractionCreate) {
-	h.logger.Info("Handling register command",
-		zap.String("user_id", i.Member.User.ID),
-		zap.String("channel_id", i.ChannelID),
-	)
-
-	// TODO: Implement register user
-	modal := &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseModal,
-		Data: &discordgo.InteractionResponseData{
-			CustomID: "register_modal",
-			Title:    "Register New User In This Channel",
-			Components: []discordgo.MessageComponent{
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "user_name",
-							Label:       "Your Full Name (Optional)",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. John Doe",
-							Required:    false,
-							MaxLength:   255,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "user_email",
-							Label:       "Your Email (Optional)",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. john.doe@example.com",
-							Required:    false,
-							MaxLength:   255,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "user_role",
-							Label:       "Your Role (Optional)",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. customer or support",
-							Required:    false,
-							MaxLength:   255,
-						},
-					},
-				},
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
-						discordgo.TextInput{
-							CustomID:    "customer_name",
-							Label:       "Customer/Organization Name",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. Acme Corporation",
-							Required:    false,
-							MaxLength:   255,
-						},
-					},
-				},
-			},
-		},
-	}
-
-	if err := h.session.InteractionRespond(i.Interaction, modal); err != nil {
-		h.logger.Error("Failed to respond with register modal", zap.Error(err))
+// maxMessageLength is the most characters Discord allows in a message
+const maxMessageLength = 2000
+
+// helpMessages packs the paragraphs of the help sections into as few messages as fit
+// Discord's message limit. Each section starts a new message.
+func helpMessages(sections []string) []string {
+	var messages []string
+	for _, section := range sections {
+		message := ""
+		for _, paragraph := range strings.Split(section, "\n\n") {
+			if message != "" && utf8.RuneCountInString(message)+2+utf8.RuneCountInString(paragraph) > maxMessageLength {
+				messages = append(messages, message)
+				message = ""
+			}
+			if message != "" {
+				message += "\n\n"
+			}
+			message += paragraph
+		}
+		messages = append(messages, message)
 	}
+	return messages
 }
 
 // handleModalSubmit handles modal submission interactions
@@ -617,122 +838,31 @@ func (h *Handler) handleModalSubmit(ctx context.Context, i *discordgo.Interactio
 
 	h.logger.Info("Handling modal submit",
 		zap.String("modal_id", modalID),
-		zap.String("user_id", i.Member.User.ID),
+		zap.String("user_id", interactionUserID(i)),
 		zap.String("channel_id", i.ChannelID),
 	)
 
 	switch {
-	case modalID == "issue_modal":
+	case modalID == "issue_modal", strings.HasPrefix(modalID, issueModalPrefix):
 		h.handleIssueModalSubmit(ctx, i)
 	case strings.HasPrefix(modalID, "resolve_modal_"):
 		h.handleResolveModelSubmit(ctx, i)
-	case modalID == "init_modal":
-		h.handleRegisterChannelModalSubmit(ctx, i)
+	case strings.HasPrefix(modalID, "message_issue_modal_"):
+		h.handleMessageIssueModalSubmit(ctx, i)
+	case strings.HasPrefix(modalID, "issue_edit_modal_"):
+		h.handleIssueEditModalSubmit(ctx, i)
+	case strings.HasPrefix(modalID, quickReportModalPrefix):
+		h.handleQuickReportModalSubmit(ctx, i)
+	case strings.HasPrefix(modalID, registerCustomerFormPrefix):
+		h.handleRegisterCustomerFormSubmit(ctx, i)
+	case strings.HasPrefix(modalID, registerProjectFormPrefix):
+		h.handleRegisterProjectFormSubmit(ctx, i)
+	case strings.HasPrefix(modalID, feedbackModalPrefix):
+		h.handleFeedbackModalSubmit(ctx, i)
 	default:
 		h.logger.Warn("Unknown modal ID", zap.String("modal_id", modalID))
-		h.respondToInteraction(ctx, i, "Unknown modal", true)
-	}
-}
-
-// handleRegisterModalSubmit handles the channel registration modal submission
-func (h *Handler) handleRegisterChannelModalSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
-	// Extract modal data
-	components := i.ModalSubmitData().Components
-	if len(components) < 3 {
-		h.logger.Error("Invalid register modal components")
-		h.respondToInteraction(ctx, i, "Invalid form data", true)
-		return
-	}
-
-	customerName := components[0].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
-	customerEmail := components[1].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
-	projectName := components[2].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
-
-	// Optional fields
-	var projectDescription, userName string
-	if len(components) > 3 {
-		projectDescription = components[3].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
-	}
-	if len(components) > 4 {
-		userName = components[4].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
-	}
-
-	h.logger.Info("Processing channel registration",
-		zap.String("customer_name", customerName),
-		zap.String("customer_email", customerEmail),
-		zap.String("project_name", projectName),
-		zap.String("project_description", projectDescription),
-		zap.String("user_name", userName),
-		zap.String("user_id", i.Member.User.ID),
-		zap.String("channel_id", i.ChannelID),
-	)
-
-	// Respond immediately to avoid timeout
-	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Content: "🔄 Registering channel...",
-		},
-	}); err != nil {
-		h.logger.Error("Failed to respond to registration interaction", zap.Error(err))
-		return
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown modal"), true)
 	}
-
-	// Register the channel through service
-	channel, err := h.channelService.RegisterChannel(ctx, i.ChannelID, customerName, customerEmail, projectName, projectDescription, i.Member.User.ID, userName, i.GuildID)
-	if err != nil {
-		h.logger.Error("Failed to register channel", zap.Error(err))
-
-		var errorMessage string
-		switch err {
-		case domain.ErrChannelAlreadyRegistered:
-			errorMessage = "❌ This channel is already registered for issue tracking."
-		case domain.ErrEmptyCustomerName:
-			errorMessage = "❌ Customer name cannot be empty."
-		case domain.ErrEmptyProjectName:
-			errorMessage = "❌ Project name cannot be empty."
-		default:
-			errorMessage = "❌ Failed to register channel. Please try again."
-		}
-
-		h.editInteractionResponse(ctx, i, errorMessage)
-		return
-	}
-
-	// Create success response
-	successContent := fmt.Sprintf("✅ **Channel Registration Successful!**\n\n"+
-		"This channel has been registered for issue tracking:\n\n"+
-		"🏢 **Customer:** %s\n"+
-		"📧 **Contact:** %s\n"+
-		"📋 **Project:** %s\n"+
-		"📝 **Description:** %s\n"+
-		"📅 **Registered:** %s\n"+
-		"👤 **Registered by:** %s (<@%s>)\n\n"+
-		"You can now use the `/issue` command to create and track issues in this channel.\n\n"+
-		"**Available Commands:**\n"+
-		"• `/issue` - Create a new issue\n"+
-		"• `/issues` - List all issues\n"+
-		"• `/issue-status <id>` - Check issue status\n"+
-		"• `/help` - Show help information",
-		channel.Project.Customer.Name,
-		getDisplayValue(channel.Project.Customer.ContactEmail, "Not provided"),
-		channel.Project.Name,
-		getDisplayValue(channel.Project.Description, "No description provided"),
-		channel.CreatedAt.Format("January 2, 2006 at 3:04 PM"),
-		getDisplayValue(channel.RegisteredByUser.Name, "Discord User"),
-		channel.RegisteredByUser.DiscordID,
-	)
-
-	// Update the original response
-	h.editInteractionResponse(ctx, i, successContent)
-
-	// Log successful registration
-	h.logger.Info("Channel registration completed successfully",
-		zap.String("registration_id", channel.ID.String()),
-		zap.String("channel_id", i.ChannelID),
-		zap.String("customer_name", customerName),
-		zap.String("project_name", projectName),
-	)
 }
 
 // handleIssueModalSubmit handles the issue creation modal submission
@@ -741,7 +871,7 @@ func (h *Handler) handleIssueModalSubmit(ctx context.Context, i *discordgo.Inter
 	components := i.ModalSubmitData().Components
 	if len(components) < 2 {
 		h.logger.Error("Invalid modal components")
-		h.respondToInteraction(ctx, i, "Invalid form data", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid form data"), true)
 		return
 	}
 
@@ -757,99 +887,127 @@ func (h *Handler) handleIssueModalSubmit(ctx context.Context, i *discordgo.Inter
 		zap.String("user_id", i.Member.User.ID),
 	)
 
-	// Respond immediately to avoid timeout
-	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Content: "🔄 Creating issue...",
-		},
-	}); err != nil {
-		h.logger.Error("Failed to respond to interaction", zap.Error(err))
-		return
+	// The modal of a project picked in the select menu carries the project ID
+	var projectID uuid.UUID
+	if id := strings.TrimPrefix(i.ModalSubmitData().CustomID, issueModalPrefix); id != i.ModalSubmitData().CustomID {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid form data"), true)
+			return
+		}
+		projectID = parsed
 	}
 
-	// Create issue through service
-	issue, err := h.issueService.CreateIssue(ctx, title, description, imageURL, i.Member.User.ID, i.ChannelID)
+	h.submitIssue(ctx, i, &issueDraft{
+		title:        title,
+		description:  description,
+		imageURL:     imageURL,
+		reporterID:   i.Member.User.ID,
+		projectID:    projectID,
+		customFields: customFieldInputValues(i.ModalSubmitData()),
+	})
+}
+
+// publishIssueCard posts the card of a newly created issue and edits the deferred
+// interaction response with the result
+func (h *Handler) publishIssueCard(ctx context.Context, i *discordgo.InteractionCreate, issueID uuid.UUID) {
+	issue, err := h.postIssueCard(ctx, issueID, i.ChannelID)
 	if err != nil {
-		h.logger.Error("Failed to create issue", zap.Error(err))
-		h.editInteractionResponse(ctx, i, "❌ Failed to create issue. Please try again.")
+		h.logger.Error("Failed to publish issue card", zap.Error(err), zap.String("issue_id", issueID.String()))
+		h.editInteractionResponse(ctx, i, i18n.T(ctx, "❌ Failed to post issue message."))
 		return
 	}
 
-	issue, err = h.issueService.GetIssue(ctx, issue.ID)
+	// Update the original response
+	h.editInteractionResponse(ctx, i, i18n.T(ctx, "✅ Issue **%s** created successfully!", issueDisplayName(issue)))
+}
+
+// postIssueCard posts the card of a newly created issue in the issue's channel, falling
+// back to fallbackChannelID, or as a new post of the issue's forum, and removes it from
+// the outbox
+func (h *Handler) postIssueCard(ctx context.Context, issueID uuid.UUID, fallbackChannelID string) (*domain.Issue, error) {
+	loadedAt := time.Now()
+	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
-		h.logger.Error("Failed to get issue", zap.Error(err))
-		h.editInteractionResponse(ctx, i, "❌ Failed to get issue. Please try again.")
-		return
+		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
-	shortIssueID := fmt.Sprintf("%s-%s", issue.Project.Name, issue.ID.String()[:8])
-
 	// Create issue card with action buttons
 	embed, components := CreateIssueCard(issue)
+	card := &discordgo.MessageSend{
+		Content:    fmt.Sprintf("🎫 **#%s**", issueDisplayName(issue)),
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	}
+
+	// In a forum the card starts the issue's own post
+	if issue.Channel != nil && issue.Channel.IsForum() {
+		if err := h.publishForumPost(ctx, issue, card); err != nil {
+			return nil, err
+		}
+		h.completeOutbox(ctx, issue.ID, domain.OutboxPostCard, loadedAt)
+		return issue, nil
+	}
 
-	// Add image to embed if provided
-	if imageURL != "" {
-		embed.Image = &discordgo.MessageEmbedImage{URL: imageURL}
+	// Sub-tasks are created from a thread but their card belongs in the parent's channel
+	channelID := fallbackChannelID
+	if issue.Channel != nil && issue.Channel.DiscordChannelID != "" {
+		channelID = issue.Channel.DiscordChannelID
 	}
 
 	// Create message with issue card
-	message, err := h.session.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
-		Content:    fmt.Sprintf("🎫 **#%s**", shortIssueID),
-		Embeds:     []*discordgo.MessageEmbed{embed},
-		Components: components,
-	})
+	message, err := h.session.ChannelMessageSendComplex(channelID, card)
 	if err != nil {
-		h.logger.Error("Failed to send issue message", zap.Error(err))
-		h.editInteractionResponse(ctx, i, "❌ Failed to post issue message.")
-		return
+		return nil, fmt.Errorf("failed to send issue message: %w", err)
 	}
 
 	// Always store the main message ID for future updates
 	if err := h.issueService.UpdateIssueMessageID(ctx, issue.ID, message.ID); err != nil {
 		h.logger.Error("Failed to store message ID for issue", zap.Error(err))
 	}
-
-	// Update the original response
-	h.editInteractionResponse(ctx, i, fmt.Sprintf("✅ Issue **%s** created successfully!", shortIssueID))
+	h.completeOutbox(ctx, issue.ID, domain.OutboxPostCard, loadedAt)
+	return issue, nil
 }
 
 // handleResolveModelSubmit handles the resolve issue modal submission
 func (h *Handler) handleResolveModelSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
-	// Extract modal data
-	components := i.ModalSubmitData().Components
-	if len(components) < 2 {
-		h.logger.Error("Invalid modal components")
-		h.respondToInteraction(ctx, i, "Invalid form data", true)
+	data := i.ModalSubmitData()
+
+	issueID, err := uuid.Parse(strings.TrimPrefix(data.CustomID, "resolve_modal_"))
+	if err != nil {
+		h.logger.Error("Invalid issue ID in resolve modal", zap.Error(err), zap.String("modal_id", data.CustomID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
 		return
 	}
 
-	cause := components[0].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
-	action := components[1].(*discordgo.ActionsRow).Components[0].(*discordgo.TextInput).Value
+	// Extract modal data
+	values := make(map[string]string)
+	for _, component := range data.Components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, c := range row.Components {
+			if input, ok := c.(*discordgo.TextInput); ok {
+				values[input.CustomID] = strings.TrimSpace(input.Value)
+			}
+		}
+	}
+
+	cause := values["cause"]
+	action := values["action"]
+	if cause == "" || action == "" {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Both root cause and corrective action are required."), true)
+		return
+	}
 
 	h.logger.Info("Resolving issue from modal",
-		zap.String("cause", cause),
-		zap.String("action", action),
+		zap.String("issue_id", issueID.String()),
 		zap.String("user_id", i.Member.User.ID),
 		zap.String("channel_id", i.ChannelID),
-		zap.String("issue_id", i.ModalSubmitData().CustomID),
 	)
 
-	// Respond to user
-	h.respondToInteraction(ctx, i, "Resolving issue...", true)
-
-	parts := strings.Split(i.ModalSubmitData().CustomID, "_")
-	if len(parts) < 3 {
-		h.logger.Error("Invalid resolve issue button custom ID")
-		h.respondToInteraction(ctx, i, "Invalid button action", true)
-		return
-	}
-
-	issueIDStr := parts[2]
-	issueID, err := uuid.Parse(issueIDStr)
-	if err != nil {
-		h.logger.Error("Invalid issue ID in button", zap.Error(err))
-		h.respondToInteraction(ctx, i, "Invalid issue ID", true)
+	if !h.deferResponse(ctx, i, true) {
 		return
 	}
 
@@ -857,22 +1015,57 @@ func (h *Handler) handleResolveModelSubmit(ctx context.Context, i *discordgo.Int
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	// The status may have changed while the modal was open
+	if !h.ensureTransition(ctx, i, issue, domain.StatusResolved) {
 		return
 	}
 
 	// Resolve the issue through service
-	if err := h.issueService.UpdateIssueResolved(ctx, issueID, cause, action); err != nil {
+	if err := h.issueService.UpdateIssueResolved(ctx, issueID, cause, action, i.Member.User.ID); err != nil {
 		h.logger.Error("Failed to resolve issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to resolve issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to resolve issue"), true)
 		return
 	}
 
+	// Respond to user
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🟢 Issue resolved"), true)
+
 	// Get updated issue and refresh the main issue card
-	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil {
-		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue)
+	loadedAt := time.Now()
+	updatedIssue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get resolved issue", zap.Error(err))
+		return
+	}
+	if h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
 	}
 
+	// Post the resolution summary in the discussion thread
+	if updatedIssue.ThreadID != "" {
+		if _, err := h.session.ChannelMessageSendComplex(updatedIssue.ThreadID, &discordgo.MessageSend{
+			Content: h.mentionQATesters(ctx, updatedIssue),
+			Embeds:  []*discordgo.MessageEmbed{CreateResolutionSummary(updatedIssue, i.Member.User.ID)},
+		}); err != nil {
+			h.logger.Error("Failed to send resolution summary", zap.Error(err))
+		}
+	}
+}
+
+// mentionQATesters returns a message pinging the QA testers assigned to an issue, if any
+func (h *Handler) mentionQATesters(ctx context.Context, issue *domain.Issue) string {
+	var mentions []string
+	for _, qa := range issue.GetQATesters() {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", qa.User.DiscordID))
+	}
+	if len(mentions) == 0 {
+		return ""
+	}
+	return strings.Join(mentions, " ") + i18n.T(ctx, " ready for verification")
 }
 
 // handleMessageComponent handles button clicks and select menu interactions
@@ -881,7 +1074,7 @@ func (h *Handler) handleMessageComponent(ctx context.Context, i *discordgo.Inter
 
 	h.logger.Info("Handling message component",
 		zap.String("custom_id", customID),
-		zap.String("user_id", i.Member.User.ID),
+		zap.String("user_id", interactionUserID(i)),
 	)
 
 	// Handle workflow buttons
@@ -894,12 +1087,45 @@ func (h *Handler) handleMessageComponent(ctx context.Context, i *discordgo.Inter
 		h.handleResolveIssueButton(ctx, i)
 	case strings.HasPrefix(customID, "verify_issue_"):
 		h.handleVerifyIssueButton(ctx, i)
-	// case strings.HasPrefix(customID, "reject_issue_"):
-	// 	h.handleRejectIssueButton(ctx, i)
+	case strings.HasPrefix(customID, "reject_issue_"):
+		h.handleRejectIssueButton(ctx, i)
 	case strings.HasPrefix(customID, "close_issue_"):
 		h.handleCloseIssueButton(ctx, i)
-	// case strings.HasPrefix(customID, "reopen_issue_"):
-	// 	h.handleReopenIssueButton(ctx, i)
+	case strings.HasPrefix(customID, "reopen_issue_"):
+		h.handleReopenIssueButton(ctx, i)
+	case strings.HasPrefix(customID, "set_status_"):
+		h.handleCustomStatusButton(ctx, i)
+	case strings.HasPrefix(customID, "dup_of_"):
+		h.handleDuplicateOfButton(ctx, i)
+	case strings.HasPrefix(customID, "dup_create_"):
+		h.handleCreateAnywayButton(ctx, i)
+	case strings.HasPrefix(customID, quickReportCompletePrefix):
+		h.handleQuickReportCompleteButton(ctx, i)
+	case strings.HasPrefix(customID, quickReportDiscardPrefix):
+		h.handleQuickReportDiscardButton(ctx, i)
+	case strings.HasPrefix(customID, "confirm_"+deleteConfirmationAction+"_"):
+		h.handleConfirmDeleteButton(ctx, i)
+	case strings.HasPrefix(customID, "cancel_"+deleteConfirmationAction+"_"):
+		h.handleCancelDeleteButton(ctx, i)
+	case strings.HasPrefix(customID, registerPickCustomerPrefix):
+		h.handleRegisterCustomerSelection(ctx, i)
+	case strings.HasPrefix(customID, registerAddCustomerPrefix):
+		h.handleRegisterAddCustomerButton(ctx, i)
+	case strings.HasPrefix(customID, registerPickProjectPrefix):
+		h.handleRegisterProjectSelection(ctx, i)
+	case strings.HasPrefix(customID, registerAddProjectPrefix):
+		h.handleRegisterAddProjectButton(ctx, i)
+	case strings.HasPrefix(customID, "confirm_"+registerConfirmationAction+"_"):
+		h.handleConfirmRegisterButton(ctx, i)
+	case strings.HasPrefix(customID, "cancel_"+registerConfirmationAction+"_"):
+		h.handleCancelRegisterButton(ctx, i)
+	case strings.HasPrefix(customID, "confirm_"+mergeCustomerConfirmationAction+"_"):
+		h.handleConfirmCustomerMergeButton(ctx, i)
+	case strings.HasPrefix(customID, "confirm_"+mergeProjectConfirmationAction+"_"):
+		h.handleConfirmProjectMergeButton(ctx, i)
+	case strings.HasPrefix(customID, "cancel_"+mergeCustomerConfirmationAction+"_"),
+		strings.HasPrefix(customID, "cancel_"+mergeProjectConfirmationAction+"_"):
+		h.handleCancelMergeButton(ctx, i)
 	// case strings.HasPrefix(customID, "issue_details_"):
 	// 	h.handleIssueDetailsButton(ctx, i)
 	// case strings.HasPrefix(customID, "issue_history_"):
@@ -907,15 +1133,37 @@ func (h *Handler) handleMessageComponent(ctx context.Context, i *discordgo.Inter
 	// case strings.HasPrefix(customID, "back_to_open_"):
 	// 	h.handleBackToOpenButton(ctx, i)
 
+	case strings.HasPrefix(customID, severitySelectPrefix):
+		h.handleSeveritySelection(ctx, i)
 	case strings.HasPrefix(customID, "issue_priority_"):
 		h.handlePrioritySelection(ctx, i)
 	case strings.HasPrefix(customID, "issue_assignee_dev_"):
 		h.handleAssigneeDeveloperSelection(ctx, i)
 	case strings.HasPrefix(customID, "issue_assignee_qa_"):
 		h.handleAssigneeQASelection(ctx, i)
+	case strings.HasPrefix(customID, "assign_role_"):
+		h.handleAssignRoleSelection(ctx, i)
+	case strings.HasPrefix(customID, "assign_users_"):
+		h.handleAssignUsersSelection(ctx, i)
+	case strings.HasPrefix(customID, suggestedAssigneePrefix):
+		h.handleSuggestedAssigneeButton(ctx, i)
+	case strings.HasPrefix(customID, "my_issues_page_"):
+		h.handleMyIssuesPageButton(ctx, i)
+	case strings.HasPrefix(customID, "issues_page_"):
+		h.handleIssuesPageButton(ctx, i)
+	case strings.HasPrefix(customID, componentSelectPrefix):
+		h.handleComponentSelection(ctx, i)
+	case strings.HasPrefix(customID, statsRangeSelectID):
+		h.handleStatsRangeSelection(ctx, i)
+	case customID == issueProjectSelectID:
+		h.handleIssueProjectSelection(ctx, i)
+	case strings.HasPrefix(customID, feedbackRatePrefix):
+		h.handleFeedbackRateButton(ctx, i)
+	case strings.HasPrefix(customID, feedbackCommentPrefix):
+		h.handleFeedbackCommentButton(ctx, i)
 	default:
 		h.logger.Warn("Unknown message component", zap.String("custom_id", customID))
-		h.respondToInteraction(ctx, i, "Unknown action", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown action"), true)
 	}
 }
 
@@ -924,7 +1172,7 @@ func (h *Handler) handleOpenIssueButton(ctx context.Context, i *discordgo.Intera
 	parts := strings.Split(i.MessageComponentData().CustomID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid close button custom ID")
-		h.respondToInteraction(ctx, i, "Invalid button action", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
 		return
 	}
 
@@ -932,7 +1180,7 @@ func (h *Handler) handleOpenIssueButton(ctx context.Context, i *discordgo.Intera
 	issueID, err := uuid.Parse(issueIDStr)
 	if err != nil {
 		h.logger.Error("Invalid issue ID in button", zap.Error(err))
-		h.respondToInteraction(ctx, i, "Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
 		return
 	}
 
@@ -940,44 +1188,66 @@ func (h *Handler) handleOpenIssueButton(ctx context.Context, i *discordgo.Intera
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, domain.StatusOpen) {
 		return
 	}
 
 	// Open the issue through service
-	if err := h.issueService.OpenIssue(ctx, issueID); err != nil {
+	if err := h.issueService.OpenIssue(ctx, issueID, i.Member.User.ID); err != nil {
 		h.logger.Error("Failed to open issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to open issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to open issue"), true)
 		return
 	}
 
 	// Respond to user
-	h.respondToInteraction(ctx, i, "Opening issue...", true)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "Opening issue..."), true)
 
 	// Get updated issue and refresh the main issue card
-	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil {
-		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue)
+	loadedAt := time.Now()
+	updatedIssue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get opened issue", zap.Error(err))
+		return
+	}
+	if h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
 	}
 
-	// Create thread for discussion
-	thread, err := h.session.MessageThreadStart(i.ChannelID, issue.MessageID, fmt.Sprintf("%s-%s", issue.Project.Name, issue.ID.String()[:8]), 0)
-	if err != nil {
-		h.logger.Error("Failed to create thread", zap.Error(err))
-		// Continue without thread
-	} else {
+	// Issues coming back to open (rejected or reopened) already have a thread
+	if issue.ThreadID != "" && issue.Status != domain.StatusDraft {
+		h.sendMessage(ctx, issue.ThreadID, i18n.T(ctx, "🔵 **Issue moved back to Open** by <@%s>.", i.Member.User.ID))
+		return
+	}
+
+	// Forum issues are discussed in their post, other issues get a thread on their card
+	threadID := issue.ThreadID
+	if threadID == "" {
+		thread, err := h.session.MessageThreadStart(i.ChannelID, issue.MessageID, issueThreadName(updatedIssue), threadAutoArchiveDuration(updatedIssue.Priority))
+		if err != nil {
+			h.logger.Error("Failed to create thread", zap.Error(err))
+			return
+		}
+		threadID = thread.ID
+
 		// Update issue with thread and message info
 		if err := h.issueService.SetThreadInfo(ctx, issue.ID, thread.ID, issue.MessageID); err != nil {
 			h.logger.Error("Failed to update issue thread info", zap.Error(err))
 		}
+	}
 
-		// Add priority selection in thread
-		h.sendPrioritySelector(ctx, thread.ID, issue.ID.String())
-		h.sendAssigneeDeveloperSelector(ctx, thread.ID, issue.ID.String())
-		h.sendAssigneeQASelector(ctx, thread.ID, issue.ID.String())
+	// Add priority selection in thread
+	h.sendPrioritySelector(ctx, threadID, issue.ID.String())
+	h.sendSeveritySelector(ctx, threadID, updatedIssue)
+	h.sendAssigneeDeveloperSelector(ctx, threadID, issue.ID.String())
+	h.sendAssigneeQASelector(ctx, threadID, issue.ID.String())
+	h.sendComponentSelector(ctx, threadID, updatedIssue)
 
-		// Send welcome message in thread
-		h.sendMessage(ctx, thread.ID, fmt.Sprintf("💬 Discussion thread for Issue **%s**\n\nFeel free to add comments, updates, or additional information here.", fmt.Sprintf("%s-%s", issue.Project.Name, issue.ID.String()[:8])))
-	}
+	// Send welcome message in thread
+	h.sendMessage(ctx, threadID, i18n.T(ctx, "💬 Discussion thread for Issue **%s**\n\nFeel free to add comments, updates, or additional information here.", issueDisplayName(issue)))
 }
 
 // handleStartWorkButton handles the start work button click
@@ -985,7 +1255,7 @@ func (h *Handler) handleStartWorkButton(ctx context.Context, i *discordgo.Intera
 	parts := strings.Split(i.MessageComponentData().CustomID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid close button custom ID")
-		h.respondToInteraction(ctx, i, "Invalid button action", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
 		return
 	}
 
@@ -993,7 +1263,7 @@ func (h *Handler) handleStartWorkButton(ctx context.Context, i *discordgo.Intera
 	issueID, err := uuid.Parse(issueIDStr)
 	if err != nil {
 		h.logger.Error("Invalid issue ID in button", zap.Error(err))
-		h.respondToInteraction(ctx, i, "Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
 		return
 	}
 
@@ -1001,23 +1271,28 @@ func (h *Handler) handleStartWorkButton(ctx context.Context, i *discordgo.Intera
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, domain.StatusInProgress) {
 		return
 	}
 
 	// Start work on the issue through service
-	if err := h.issueService.InProgressIssue(ctx, issueID); err != nil {
+	if err := h.issueService.InProgressIssue(ctx, issueID, i.Member.User.ID); err != nil {
 		h.logger.Error("Failed to start work", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to start work", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to start work"), true)
 		return
 	}
 
 	// Respond to user
-	h.respondToInteraction(ctx, i, "Starting work...", true)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "Starting work..."), true)
 
 	// Get updated issue and refresh the main issue card
-	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil {
-		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue)
+	loadedAt := time.Now()
+	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil && h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
 	}
 }
 
@@ -1026,25 +1301,42 @@ func (h *Handler) handleResolveIssueButton(ctx context.Context, i *discordgo.Int
 	parts := strings.Split(i.MessageComponentData().CustomID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid resolve issue button custom ID")
-		h.respondToInteraction(ctx, i, "Invalid button action", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
 		return
 	}
 
 	issueIDStr := parts[2]
+	issueID, err := uuid.Parse(issueIDStr)
+	if err != nil {
+		h.logger.Error("Invalid issue ID in button", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, domain.StatusResolved) {
+		return
+	}
 
 	modal := &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseModal,
 		Data: &discordgo.InteractionResponseData{
 			CustomID: "resolve_modal_" + issueIDStr,
-			Title:    "Resolve Issue",
+			Title:    i18n.T(ctx, "Resolve Issue"),
 			Components: []discordgo.MessageComponent{
 				discordgo.ActionsRow{
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "cause",
-							Label:       "Cause",
-							Style:       discordgo.TextInputShort,
-							Placeholder: "e.g. Bug found",
+							Label:       i18n.T(ctx, "Root Cause"),
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: i18n.T(ctx, "What caused the issue?"),
 							Required:    true,
 							MaxLength:   2000,
 						},
@@ -1054,9 +1346,9 @@ func (h *Handler) handleResolveIssueButton(ctx context.Context, i *discordgo.Int
 					Components: []discordgo.MessageComponent{
 						discordgo.TextInput{
 							CustomID:    "action",
-							Label:       "Action",
+							Label:       i18n.T(ctx, "Corrective Action"),
 							Style:       discordgo.TextInputParagraph,
-							Placeholder: "Describe the action to resolve the issue...",
+							Placeholder: i18n.T(ctx, "What was changed to fix it?"),
 							Required:    true,
 							MaxLength:   2000,
 						},
@@ -1076,7 +1368,7 @@ func (h *Handler) handleVerifyIssueButton(ctx context.Context, i *discordgo.Inte
 	parts := strings.Split(i.MessageComponentData().CustomID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid verify issue button custom ID")
-		h.respondToInteraction(ctx, i, "Invalid button action", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
 		return
 	}
 
@@ -1084,7 +1376,7 @@ func (h *Handler) handleVerifyIssueButton(ctx context.Context, i *discordgo.Inte
 	issueID, err := uuid.Parse(issueIDStr)
 	if err != nil {
 		h.logger.Error("Invalid issue ID in button", zap.Error(err))
-		h.respondToInteraction(ctx, i, "Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
 		return
 	}
 
@@ -1092,23 +1384,28 @@ func (h *Handler) handleVerifyIssueButton(ctx context.Context, i *discordgo.Inte
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, domain.StatusVerified) {
 		return
 	}
 
 	// Verify the issue through service
-	if err := h.issueService.VerifiedIssue(ctx, issueID); err != nil {
+	if err := h.issueService.VerifiedIssue(ctx, issueID, i.Member.User.ID); err != nil {
 		h.logger.Error("Failed to verify issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to verify issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to verify issue"), true)
 		return
 	}
 
 	// Respond to user
-	h.respondToInteraction(ctx, i, "Verifying issue...", true)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "Verifying issue..."), true)
 
 	// Get updated issue and refresh the main issue card
-	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil {
-		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue)
+	loadedAt := time.Now()
+	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil && h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
 	}
 }
 
@@ -1117,7 +1414,7 @@ func (h *Handler) handleCloseIssueButton(ctx context.Context, i *discordgo.Inter
 	parts := strings.Split(i.MessageComponentData().CustomID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid close button custom ID")
-		h.respondToInteraction(ctx, i, "Invalid button action", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
 		return
 	}
 
@@ -1125,62 +1422,46 @@ func (h *Handler) handleCloseIssueButton(ctx context.Context, i *discordgo.Inter
 	issueID, err := uuid.Parse(issueIDStr)
 	if err != nil {
 		h.logger.Error("Invalid issue ID in button", zap.Error(err))
-		h.respondToInteraction(ctx, i, "Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
 		return
 	}
 
-	// Close the issue through service
-	if err := h.issueService.CloseIssue(ctx, issueID); err != nil {
-		h.logger.Error("Failed to close issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to close issue", true)
+	if !h.authorize(ctx, i, domain.PermissionCloseIssue) {
 		return
 	}
 
-	// Get updated issue and refresh the main issue card
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
 		return
 	}
 
-	embed, _ := CreateIssueCard(issue)
-
-	// Respond to user
-	h.respondToInteraction(ctx, i, "🔒 Closing issue...", true)
-
-	// Update the original message
-	originalMessage := i.Message
-	closedContent := fmt.Sprintf("🔒 **[CLOSED]** %s", originalMessage.Content)
-
-	if _, err := h.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
-		Channel:    i.ChannelID,
-		ID:         originalMessage.ID,
-		Content:    &closedContent,
-		Embeds:     &[]*discordgo.MessageEmbed{embed},
-		Components: &[]discordgo.MessageComponent{}, // Remove all components
-	}); err != nil {
-		h.logger.Error("Failed to update message", zap.Error(err))
+	if !h.ensureTransition(ctx, i, issue, domain.StatusClosed) {
+		return
 	}
 
-	// If there's a thread, close it
-	if len(parts) > 3 {
-		threadID := parts[3]
-		h.sendMessage(ctx, threadID, "🔒 **This issue has been closed.**\n\nThis thread will be archived.")
-
-		if _, err := h.session.ChannelEditComplex(threadID, &discordgo.ChannelEdit{
-			Archived: &[]bool{true}[0],
-			Locked:   &[]bool{true}[0],
-		}); err != nil {
-			h.logger.Error("Failed to archive thread", zap.Error(err))
+	// Close the issue through service
+	if err := h.issueService.CloseIssue(ctx, issueID, i.Member.User.ID); err != nil {
+		if errors.Is(err, domain.ErrOpenSubIssues) {
+			closed, total := issue.GetSubIssueProgress()
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "⚠️ This issue still has %d open sub-task(s). Close them before closing the issue.",
+				total-closed), true)
+			return
 		}
+		h.logger.Error("Failed to close issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to close issue"), true)
+		return
 	}
+
+	// The card and thread are updated by the status change event handler
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🔒 Closing issue..."), true)
 }
 
 // handlePrioritySelection handles priority selection from select menu
 func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.InteractionCreate) {
 	if len(i.MessageComponentData().Values) == 0 {
-		h.respondToInteraction(ctx, i, "No priority selected", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No priority selected"), true)
 		return
 	}
 
@@ -1192,7 +1473,7 @@ func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.Inte
 	parts := strings.Split(customID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid priority selector custom ID", zap.String("custom_id", customID))
-		h.respondToInteraction(ctx, i, "❌ Invalid priority selector", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid priority selector"), true)
 		return
 	}
 
@@ -1203,7 +1484,11 @@ func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.Inte
 			zap.Error(err),
 			zap.String("issue_id", issueIDStr),
 		)
-		h.respondToInteraction(ctx, i, "❌ Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	if !h.authorize(ctx, i, domain.PermissionSetPriority) {
 		return
 	}
 
@@ -1214,7 +1499,7 @@ func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.Inte
 			zap.String("issue_id", issueID.String()),
 			zap.String("priority", priorityStr),
 		)
-		h.respondToInteraction(ctx, i, "❌ Failed to update priority. Please try again.", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update priority. Please try again."), true)
 		return
 	}
 
@@ -1222,7 +1507,7 @@ func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.Inte
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get updated issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get updated issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get updated issue"), true)
 		return
 	}
 
@@ -1230,7 +1515,7 @@ func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.Inte
 	if _, err := h.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
 		Channel: i.ChannelID,
 		ID:      i.Message.ID,
-		Content: &[]string{fmt.Sprintf("📊 **Priority set to %s %s**",
+		Content: &[]string{i18n.T(ctx, "📊 **Priority set to %s %s**",
 			func() string {
 				switch priority {
 				case domain.PriorityLow:
@@ -1250,12 +1535,12 @@ func (h *Handler) handlePrioritySelection(ctx context.Context, i *discordgo.Inte
 
 	h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue)
 
-	h.respondToInteraction(ctx, i, fmt.Sprintf("✅ Priority set to **%s**", strings.Title(priorityStr)), true)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ Priority set to **%s**", strings.Title(priorityStr)), true)
 }
 
 func (h *Handler) handleAssigneeDeveloperSelection(ctx context.Context, i *discordgo.InteractionCreate) {
 	if len(i.MessageComponentData().Values) == 0 {
-		h.respondToInteraction(ctx, i, "No assignee selected", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No assignee selected"), true)
 		return
 	}
 
@@ -1267,7 +1552,7 @@ func (h *Handler) handleAssigneeDeveloperSelection(ctx context.Context, i *disco
 	parts := strings.Split(customID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid assignee developer selector custom ID", zap.String("custom_id", customID))
-		h.respondToInteraction(ctx, i, "❌ Invalid assignee developer selector", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid assignee developer selector"), true)
 		return
 	}
 
@@ -1275,7 +1560,7 @@ func (h *Handler) handleAssigneeDeveloperSelection(ctx context.Context, i *disco
 	issueID, err := uuid.Parse(issueIDStr)
 	if err != nil {
 		h.logger.Error("Invalid issue ID in assignee developer selector", zap.Error(err), zap.String("issue_id", issueIDStr))
-		h.respondToInteraction(ctx, i, "❌ Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
 		return
 	}
 
@@ -1283,7 +1568,7 @@ func (h *Handler) handleAssigneeDeveloperSelection(ctx context.Context, i *disco
 	// Update the issue assignee using Discord ID
 	if _, err := h.issueAssigneeService.AssignUserToIssue(ctx, issueID, assigneeStr, domain.AssigneeRoleDev); err != nil {
 		h.logger.Error("Failed to update issue assignee", zap.Error(err), zap.String("issue_id", issueID.String()), zap.String("assignee", assigneeStr))
-		h.respondToInteraction(ctx, i, "❌ Failed to update assignee. Please try again.", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update assignee. Please try again."), true)
 		return
 	}
 
@@ -1291,7 +1576,7 @@ func (h *Handler) handleAssigneeDeveloperSelection(ctx context.Context, i *disco
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get updated issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get updated issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get updated issue"), true)
 		return
 	}
 
@@ -1299,23 +1584,24 @@ func (h *Handler) handleAssigneeDeveloperSelection(ctx context.Context, i *disco
 	if _, err := h.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
 		Channel:    i.ChannelID,
 		ID:         i.Message.ID,
-		Content:    &[]string{fmt.Sprintf("👨‍💻 **Developer assigned: <@%s>**", assigneeStr)}[0],
+		Content:    &[]string{i18n.T(ctx, "👨‍💻 **Developer assigned: <@%s>**", assigneeStr)}[0],
 		Components: &[]discordgo.MessageComponent{},
 	}); err != nil {
 		h.logger.Error("Failed to update assignee developer selector message", zap.Error(err))
 	}
 
 	// Get updated issue and refresh the main issue card
-	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil {
-		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue)
+	loadedAt := time.Now()
+	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil && h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
 	}
 
-	h.respondToInteraction(ctx, i, fmt.Sprintf("✅ Developer assigned: <@%s>", assigneeStr), true)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ Developer assigned: <@%s>", assigneeStr), true)
 }
 
 func (h *Handler) handleAssigneeQASelection(ctx context.Context, i *discordgo.InteractionCreate) {
 	if len(i.MessageComponentData().Values) == 0 {
-		h.respondToInteraction(ctx, i, "No assignee selected", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No assignee selected"), true)
 		return
 	}
 
@@ -1327,7 +1613,7 @@ func (h *Handler) handleAssigneeQASelection(ctx context.Context, i *discordgo.In
 	parts := strings.Split(customID, "_")
 	if len(parts) < 3 {
 		h.logger.Error("Invalid assignee qa selector custom ID", zap.String("custom_id", customID))
-		h.respondToInteraction(ctx, i, "❌ Invalid assignee qa selector", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid assignee qa selector"), true)
 		return
 	}
 
@@ -1335,7 +1621,7 @@ func (h *Handler) handleAssigneeQASelection(ctx context.Context, i *discordgo.In
 	issueID, err := uuid.Parse(issueIDStr)
 	if err != nil {
 		h.logger.Error("Invalid issue ID in assignee qa selector", zap.Error(err), zap.String("issue_id", issueIDStr))
-		h.respondToInteraction(ctx, i, "❌ Invalid issue ID", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
 		return
 	}
 
@@ -1344,7 +1630,7 @@ func (h *Handler) handleAssigneeQASelection(ctx context.Context, i *discordgo.In
 	// Update the issue assignee using Discord ID
 	if _, err := h.issueAssigneeService.AssignUserToIssue(ctx, issueID, assigneeStr, domain.AssigneeRoleQA); err != nil {
 		h.logger.Error("Failed to update issue assignee", zap.Error(err), zap.String("issue_id", issueID.String()), zap.String("assignee", assigneeStr))
-		h.respondToInteraction(ctx, i, "❌ Failed to update assignee. Please try again.", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update assignee. Please try again."), true)
 		return
 	}
 
@@ -1352,7 +1638,7 @@ func (h *Handler) handleAssigneeQASelection(ctx context.Context, i *discordgo.In
 	issue, err := h.issueService.GetIssue(ctx, issueID)
 	if err != nil {
 		h.logger.Error("Failed to get updated issue", zap.Error(err))
-		h.respondToInteraction(ctx, i, "❌ Failed to get updated issue", true)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get updated issue"), true)
 		return
 	}
 
@@ -1360,24 +1646,28 @@ func (h *Handler) handleAssigneeQASelection(ctx context.Context, i *discordgo.In
 	if _, err := h.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
 		Channel:    i.ChannelID,
 		ID:         i.Message.ID,
-		Content:    &[]string{fmt.Sprintf("🧪 **QA assigned: <@%s>**", assigneeStr)}[0],
+		Content:    &[]string{i18n.T(ctx, "🧪 **QA assigned: <@%s>**", assigneeStr)}[0],
 		Components: &[]discordgo.MessageComponent{},
 	}); err != nil {
 		h.logger.Error("Failed to update assignee qa selector message", zap.Error(err))
 	}
 
 	// Get updated issue and refresh the main issue card
-	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil {
-		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue)
+	loadedAt := time.Now()
+	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil && h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
 	}
 
-	h.respondToInteraction(ctx, i, fmt.Sprintf("✅ QA assigned: <@%s>", assigneeStr), true)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ QA assigned: <@%s>", assigneeStr), true)
 }
 
 // Helper methods
 
 func (h *Handler) sendMessage(ctx context.Context, channelID, content string) {
-	if _, err := h.session.ChannelMessageSend(channelID, content); err != nil {
+	if err := h.api.call(ctx, "send message", func(opts ...discordgo.RequestOption) error {
+		_, err := h.session.ChannelMessageSend(channelID, content, opts...)
+		return err
+	}); err != nil {
 		h.logger.Error("Failed to send message",
 			zap.Error(err),
 			zap.String("channel_id", channelID),
@@ -1385,37 +1675,12 @@ func (h *Handler) sendMessage(ctx context.Context, channelID, content string) {
 	}
 }
 
-func (h *Handler) respondToInteraction(ctx context.Context, i *discordgo.InteractionCreate, content string, ephemeral bool) {
-	flags := discordgo.MessageFlags(0)
-	if ephemeral {
-		flags = discordgo.MessageFlagsEphemeral
-	}
-
-	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Content: content,
-			Flags:   flags,
-		},
-	}); err != nil {
-		h.logger.Error("Failed to respond to interaction", zap.Error(err))
-	}
-}
-
-func (h *Handler) editInteractionResponse(ctx context.Context, i *discordgo.InteractionCreate, content string) {
-	if _, err := h.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-		Content: &content,
-	}); err != nil {
-		h.logger.Error("Failed to edit interaction response", zap.Error(err))
-	}
-}
-
 func (h *Handler) sendPrioritySelector(ctx context.Context, channelID, issueID string) {
 	selectMenu := discordgo.ActionsRow{
 		Components: []discordgo.MessageComponent{
 			discordgo.SelectMenu{
 				CustomID:    fmt.Sprintf("issue_priority_%s", issueID),
-				Placeholder: "Select priority",
+				Placeholder: i18n.T(ctx, "Select priority"),
 				Options: []discordgo.SelectMenuOption{
 					{Label: "Low", Value: "low", Emoji: &discordgo.ComponentEmoji{Name: "🟢"}},
 					{Label: "Medium", Value: "medium", Emoji: &discordgo.ComponentEmoji{Name: "🟡"}},
@@ -1426,7 +1691,7 @@ func (h *Handler) sendPrioritySelector(ctx context.Context, channelID, issueID s
 	}
 
 	if _, err := h.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
-		Content:    "📊 **Set Issue Priority:**",
+		Content:    i18n.T(ctx, "📊 **Set Issue Priority:**"),
 		Components: []discordgo.MessageComponent{selectMenu},
 	}); err != nil {
 		h.logger.Error("Failed to send priority selector", zap.Error(err))
@@ -1438,7 +1703,7 @@ func (h *Handler) sendAssigneeDeveloperSelector(ctx context.Context, channelID,
 		Components: []discordgo.MessageComponent{
 			discordgo.SelectMenu{
 				CustomID:    fmt.Sprintf("issue_assignee_dev_%s", issueID),
-				Placeholder: "Select assignee (User or Role)",
+				Placeholder: i18n.T(ctx, "Select assignee (User or Role)"),
 				MenuType:    discordgo.MentionableSelectMenu, // ✅ สำคัญ
 				MinValues:   &[]int{1}[0],
 				MaxValues:   1,
@@ -1447,7 +1712,7 @@ func (h *Handler) sendAssigneeDeveloperSelector(ctx context.Context, channelID,
 	}
 
 	if _, err := h.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
-		Content:    "👨‍💻 **Assign Developer:**",
+		Content:    i18n.T(ctx, "👨‍💻 **Assign Developer:**"),
 		Components: []discordgo.MessageComponent{selectMenu},
 	}); err != nil {
 		h.logger.Error("Failed to send assignee developer selector", zap.Error(err))
@@ -1459,7 +1724,7 @@ func (h *Handler) sendAssigneeQASelector(ctx context.Context, channelID, issueID
 		Components: []discordgo.MessageComponent{
 			discordgo.SelectMenu{
 				CustomID:    fmt.Sprintf("issue_assignee_qa_%s", issueID),
-				Placeholder: "Select assignee (User or Role)",
+				Placeholder: i18n.T(ctx, "Select assignee (User or Role)"),
 				MenuType:    discordgo.MentionableSelectMenu, // ✅ สำคัญ
 				MinValues:   &[]int{1}[0],
 				MaxValues:   1,
@@ -1468,7 +1733,7 @@ func (h *Handler) sendAssigneeQASelector(ctx context.Context, channelID, issueID
 	}
 
 	if _, err := h.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
-		Content:    "🧪 **Assign QA:**",
+		Content:    i18n.T(ctx, "🧪 **Assign QA:**"),
 		Components: []discordgo.MessageComponent{selectMenu},
 	}); err != nil {
 		h.logger.Error("Failed to send assignee qa selector", zap.Error(err))
@@ -1476,7 +1741,12 @@ func (h *Handler) sendAssigneeQASelector(ctx context.Context, channelID, issueID
 }
 
 // updateIssueCard finds and updates the main issue card in the channel
-func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *domain.Issue) {
+func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *domain.Issue) error {
+	// The card of a forum issue starts its post
+	if issue.Channel != nil && issue.Channel.IsForum() {
+		channelID = issueCardChannelID(issue)
+	}
+
 	// Method 1: Try to use stored message ID first
 	if issue.MessageID != "" {
 		h.logger.Debug("Using stored message ID to update issue card",
@@ -1493,8 +1763,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 				zap.String("issue_id", issue.ID.String()),
 			)
 
-			h.doUpdateIssueCard(message, issue, channelID)
-			return
+			return h.doUpdateIssueCard(ctx, message, issue, channelID)
 		} else {
 			h.logger.Warn("Failed to get message by stored ID, falling back to search",
 				zap.Error(err),
@@ -1514,7 +1783,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 	messages, err := h.session.ChannelMessages(channelID, 100, "", "", "")
 	if err != nil {
 		h.logger.Error("Failed to get channel messages", zap.Error(err))
-		return
+		return err
 	}
 
 	h.logger.Debug("Searching for issue card to update",
@@ -1543,8 +1812,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 				zap.String("message_id", message.ID),
 				zap.String("issue_id", issue.ID.String()),
 			)
-			h.doUpdateIssueCard(message, issue, channelID)
-			return
+			return h.doUpdateIssueCard(ctx, message, issue, channelID)
 		}
 
 		// Method 2: Check if message content contains public hash
@@ -1553,8 +1821,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 				zap.String("message_id", message.ID),
 				zap.String("public_hash", issue.PublicHash),
 			)
-			h.doUpdateIssueCard(message, issue, channelID)
-			return
+			return h.doUpdateIssueCard(ctx, message, issue, channelID)
 		}
 
 		// Method 3: Check embeds for issue identification
@@ -1566,8 +1833,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 					zap.String("embed_title", embed.Title),
 					zap.String("public_hash", issue.PublicHash),
 				)
-				h.doUpdateIssueCard(message, issue, channelID)
-				return
+				return h.doUpdateIssueCard(ctx, message, issue, channelID)
 			}
 
 			// Check embed description for issue ID
@@ -1576,8 +1842,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 					zap.String("message_id", message.ID),
 					zap.String("issue_id", issue.ID.String()),
 				)
-				h.doUpdateIssueCard(message, issue, channelID)
-				return
+				return h.doUpdateIssueCard(ctx, message, issue, channelID)
 			}
 
 			// Check embed fields for issue ID
@@ -1588,8 +1853,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 						zap.String("field_name", field.Name),
 						zap.String("issue_id", issue.ID.String()),
 					)
-					h.doUpdateIssueCard(message, issue, channelID)
-					return
+					return h.doUpdateIssueCard(ctx, message, issue, channelID)
 				}
 			}
 
@@ -1600,8 +1864,7 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 					zap.String("footer_text", embed.Footer.Text),
 					zap.String("issue_id", issue.ID.String()),
 				)
-				h.doUpdateIssueCard(message, issue, channelID)
-				return
+				return h.doUpdateIssueCard(ctx, message, issue, channelID)
 			}
 		}
 	}
@@ -1612,32 +1875,57 @@ func (h *Handler) updateIssueCard(ctx context.Context, channelID string, issue *
 		zap.String("public_hash", issue.PublicHash),
 		zap.Int("searched_messages", len(messages)),
 	)
+	return errCardNotFound
 }
 
 // doUpdateIssueCard performs the actual update of an issue card message
-func (h *Handler) doUpdateIssueCard(message *discordgo.Message, issue *domain.Issue, channelID string) {
-	// Create updated issue card
+func (h *Handler) doUpdateIssueCard(ctx context.Context, message *discordgo.Message, issue *domain.Issue, channelID string) error {
+	// Create updated issue card, keeping the original content apart from the closed marker
 	embed, components := CreateIssueCard(issue)
+	content := cardContent(message.Content, issue)
 
 	// Update the message
-	if _, err := h.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
-		Channel:    channelID,
-		ID:         message.ID,
-		Content:    &message.Content, // Keep original content
-		Embeds:     &[]*discordgo.MessageEmbed{embed},
-		Components: &components,
+	if err := h.api.call(ctx, "edit issue card", func(opts ...discordgo.RequestOption) error {
+		_, err := h.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel:    channelID,
+			ID:         message.ID,
+			Content:    &content,
+			Embeds:     &[]*discordgo.MessageEmbed{embed},
+			Components: &components,
+		}, opts...)
+		return err
 	}); err != nil {
 		h.logger.Error("Failed to update issue card",
 			zap.Error(err),
 			zap.String("message_id", message.ID),
 			zap.String("issue_id", issue.ID.String()),
 		)
-	} else {
-		h.logger.Info("Successfully updated issue card",
-			zap.String("message_id", message.ID),
-			zap.String("issue_id", issue.ID.String()),
-		)
+		return err
+	}
+
+	h.logger.Info("Successfully updated issue card",
+		zap.String("message_id", message.ID),
+		zap.String("issue_id", issue.ID.String()),
+	)
+	return nil
+}
+
+// issueDisplayName names an issue in cards and thread titles: its key, or the
+// project name and ID prefix for issues created before keys existed
+func issueDisplayName(issue *domain.Issue) string {
+	if issue.Key != "" {
+		return issue.Key
+	}
+	return fmt.Sprintf("%s-%s", issue.Project.Name, issue.ID.String()[:8])
+}
+
+// truncateText shortens s to at most n runes
+func truncateText(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
 	}
+	return string(runes[:n]) + "…"
 }
 
 // getDisplayValue returns the value if not empty, otherwise returns the default value
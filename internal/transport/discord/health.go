@@ -0,0 +1,27 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// errGatewayNotConnected is reported while the session is not connected to the Discord gateway
+var errGatewayNotConnected = errors.New("discord gateway not connected")
+
+// GatewayHealth returns a health check that fails while a shard is not connected to
+// the Discord gateway, e.g. before its first Ready event or while reconnecting
+func GatewayHealth(shards *ShardManager) func(ctx context.Context) error {
+	return func(context.Context) error {
+		for _, session := range shards.Sessions() {
+			session.RLock()
+			ready := session.DataReady
+			session.RUnlock()
+
+			if !ready {
+				return fmt.Errorf("%w: shard %d", errGatewayNotConnected, session.ShardID)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,220 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxIncidentUpdateLength is the longest status update /incident update accepts
+	maxIncidentUpdateLength = domain.MaxIncidentUpdateLength
+	// maxStageTopicLength is the longest topic Discord accepts for a stage instance
+	maxStageTopicLength = 120
+)
+
+// handleIncidentCommand handles the /incident slash command and its declare and update subcommands
+func (h *Handler) handleIncidentCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: declare or update."), true)
+		return
+	}
+
+	subcommand := options[0]
+	h.logger.Info("Handling incident command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageIncidents) {
+		return
+	}
+
+	switch subcommand.Name {
+	case "declare":
+		h.declareIncident(ctx, i, subcommand)
+	case "update":
+		h.postIncidentUpdate(ctx, i, subcommand)
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// declareIncident files an incident, posts its card and opens its thread with a briefing
+// for everyone joining. A stage channel picked as the bridge gets a stage for the incident.
+func (h *Handler) declareIncident(ctx context.Context, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	declaration := domain.IncidentDeclaration{
+		Title:       subcommand.GetOption("title").StringValue(),
+		CommanderID: i.Member.User.ID,
+	}
+	if option := subcommand.GetOption("description"); option != nil {
+		declaration.Description = option.StringValue()
+	}
+	if option := subcommand.GetOption("severity"); option != nil {
+		declaration.Severity = domain.Severity(option.StringValue())
+	}
+	if option := subcommand.GetOption("bridge"); option != nil {
+		declaration.BridgeChannelID = option.ChannelValue(nil).ID
+		if channel, ok := i.ApplicationCommandData().Resolved.Channels[declaration.BridgeChannelID]; ok {
+			declaration.BridgeStage = channel.Type == discordgo.ChannelTypeGuildStageVoice
+		}
+	}
+
+	// Posting the card and opening the thread can take longer than Discord waits for the response
+	if !h.deferResponse(ctx, i, false) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	incident, err := h.incidentService.Declare(ctx, channelID, declaration)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to declare the incident. Please try again."))
+		return
+	}
+
+	if _, err := h.postIssueCard(ctx, incident.IssueID, channelID); err != nil {
+		h.logger.Error("Failed to post incident card", zap.Error(err), zap.String("issue_id", incident.IssueID.String()))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ The incident was declared but its card could not be posted."), true)
+		return
+	}
+
+	// The card was posted from the issue loaded before its message was stored
+	loadedAt := time.Now()
+	issue, err := h.issueService.GetIssue(ctx, incident.IssueID)
+	if err != nil {
+		h.logger.Error("Failed to get declared incident", zap.Error(err), zap.String("issue_id", incident.IssueID.String()))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ The incident was declared but its thread could not be opened."), true)
+		return
+	}
+
+	// Forum incidents are worked in their post, other incidents get a thread on their card
+	if issue.ThreadID == "" {
+		thread, err := h.session.MessageThreadStart(issue.Channel.DiscordChannelID, issue.MessageID, issueThreadName(issue), threadAutoArchiveDuration(issue.Priority))
+		if err != nil {
+			h.logger.Error("Failed to create incident thread", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ The incident was declared but its thread could not be opened."), true)
+			return
+		}
+		if err := h.issueService.SetThreadInfo(ctx, issue.ID, thread.ID, issue.MessageID); err != nil {
+			h.logger.Error("Failed to update incident thread info", zap.Error(err))
+		}
+		issue.ThreadID = thread.ID
+	}
+	if h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue) == nil {
+		h.completeOutbox(ctx, issue.ID, domain.OutboxUpdateCard, loadedAt)
+	}
+
+	if incident.BridgeStage {
+		h.startIncidentStage(ctx, incident, issue)
+	}
+
+	h.sendIncidentBriefing(ctx, incident, issue)
+	h.sendAssigneeDeveloperSelector(ctx, issue.ThreadID, issue.ID.String())
+	h.sendAssigneeQASelector(ctx, issue.ThreadID, issue.ID.String())
+	h.sendComponentSelector(ctx, issue.ThreadID, issue)
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🚨 <@%s> declared incident **%s**: %s\nFollow it in <#%s>.",
+		incident.CommanderID, issueDisplayName(issue), issue.Title, issue.ThreadID), false)
+}
+
+// startIncidentStage starts a stage on the bridge channel of an incident. A stage already
+// live there is left as it is.
+func (h *Handler) startIncidentStage(ctx context.Context, incident *domain.Incident, issue *domain.Issue) {
+	if _, err := h.session.StageInstanceCreate(&discordgo.StageInstanceParams{
+		ChannelID: incident.BridgeChannelID,
+		Topic:     truncateText(fmt.Sprintf("🚨 %s: %s", issueDisplayName(issue), issue.Title), maxStageTopicLength),
+	}, discordgo.WithContext(ctx)); err != nil {
+		h.logger.Warn("Failed to start incident stage",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("channel_id", incident.BridgeChannelID),
+		)
+	}
+}
+
+// sendIncidentBriefing posts and pins what everyone joining an incident thread needs to
+// know: its severity, commander, bridge and how status updates are posted
+func (h *Handler) sendIncidentBriefing(ctx context.Context, incident *domain.Incident, issue *domain.Issue) {
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   i18n.T(ctx, "Severity"),
+			Value:  fmt.Sprintf("%s %s", getSeverityEmoji(issue.Severity), issue.Severity.GetDisplayName()),
+			Inline: true,
+		},
+		{Name: i18n.T(ctx, "Commander"), Value: fmt.Sprintf("<@%s>", incident.CommanderID), Inline: true},
+	}
+	if incident.BridgeChannelID != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: i18n.T(ctx, "Bridge"), Value: fmt.Sprintf("<#%s>", incident.BridgeChannelID), Inline: true})
+	}
+
+	description := i18n.T(ctx, "Post a status update with `/incident update` in this thread at least every %s; the commander is reminded when one is due. Closing the issue posts the incident timeline.",
+		formatDuration(h.incidentService.UpdateInterval()))
+	if issue.Description != "" {
+		description = truncateText(issue.Description, 1000) + "\n\n" + description
+	}
+
+	message, err := h.session.ChannelMessageSendComplex(issue.ThreadID, &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{{
+			Title:       i18n.T(ctx, "🚨 Incident: %s", issue.Title),
+			Description: description,
+			Color:       0xe74c3c,
+			Fields:      fields,
+			Footer:      &discordgo.MessageEmbedFooter{Text: i18n.T(ctx, "Incident %s", issueDisplayName(issue))},
+		}},
+	}, discordgo.WithContext(ctx))
+	if err != nil {
+		h.logger.Error("Failed to send incident briefing", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		return
+	}
+	if err := h.session.ChannelMessagePin(issue.ThreadID, message.ID, discordgo.WithContext(ctx)); err != nil {
+		h.logger.Warn("Failed to pin incident briefing", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+	}
+}
+
+// postIncidentUpdate records a status update of an incident and posts it in its thread
+func (h *Handler) postIncidentUpdate(ctx context.Context, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	idStr := ""
+	if option := subcommand.GetOption("id"); option != nil {
+		idStr = option.StringValue()
+	}
+	issue, ok := h.resolveTrackedIssue(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	userID := i.Member.User.ID
+	message := strings.TrimSpace(subcommand.GetOption("message").StringValue())
+	if _, err := h.incidentService.PostUpdate(ctx, issue.ID, message, userID); err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to post the status update. Please try again."))
+		return
+	}
+
+	if issue.ThreadID != "" {
+		if _, err := h.session.ChannelMessageSendComplex(issue.ThreadID, &discordgo.MessageSend{
+			Embeds: []*discordgo.MessageEmbed{{
+				Title:       i18n.T(ctx, "📣 Status update"),
+				Description: message,
+				Color:       0x3498db,
+				Fields: []*discordgo.MessageEmbedField{
+					{Name: i18n.T(ctx, "Status"), Value: fmt.Sprintf("%s %s", getStatusEmoji(issue.Status), domain.GetStatusDisplayName(issue.Status)), Inline: true},
+					{Name: i18n.T(ctx, "Posted by"), Value: fmt.Sprintf("<@%s>", userID), Inline: true},
+				},
+				Footer:    &discordgo.MessageEmbedFooter{Text: i18n.T(ctx, "Incident %s", issueDisplayName(issue))},
+				Timestamp: time.Now().Format(time.RFC3339),
+			}},
+		}, discordgo.WithContext(ctx)); err != nil {
+			h.logger.Error("Failed to post incident status update", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		}
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ Status update of incident **%s** posted.", issueDisplayName(issue)), true)
+}
@@ -0,0 +1,147 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxTimelineLength keeps an incident timeline within an embed description
+const maxTimelineLength = 4000
+
+// IncidentNotifier posts status update reminders and the closing timeline in incident threads
+type IncidentNotifier struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewIncidentNotifier creates a new incident notifier
+func NewIncidentNotifier(session *discordgo.Session, logger *zap.Logger) *IncidentNotifier {
+	return &IncidentNotifier{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// NotifyUpdateDue pings the commander of an incident that a status update is due
+func (n *IncidentNotifier) NotifyUpdateDue(ctx context.Context, incident *domain.Incident, interval time.Duration) error {
+	issue := &incident.Issue
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("⌛ Status update due: %s", issue.Title),
+		Description: fmt.Sprintf("No status update was posted for %s. Post one with `/incident update` so everyone following the incident knows where it stands.",
+			formatDuration(interval)),
+		Color:  0xf39c12,
+		Footer: &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Incident %s", issueDisplayName(issue))},
+	}
+
+	targetID := issue.ThreadID
+	if targetID == "" && issue.Channel != nil {
+		targetID = issue.Channel.DiscordChannelID
+	}
+	if err := n.send(targetID, fmt.Sprintf("<@%s>", incident.CommanderID), embed); err != nil {
+		n.logger.Error("Failed to post status update reminder",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return fmt.Errorf("failed to post status update reminder: %w", err)
+	}
+
+	return nil
+}
+
+// NotifyClosed posts the timeline of a closed incident, from its declaration to its
+// closing, and ends the stage of its bridge channel. The timeline goes to the issue's
+// channel, as the thread is archived on closing, except in forums where the thread is
+// the issue's post.
+func (n *IncidentNotifier) NotifyClosed(ctx context.Context, incident *domain.Incident, history []*domain.IssueStatusLog) error {
+	issue := &incident.Issue
+	closedAt := time.Now()
+	if issue.ClosedAt != nil {
+		closedAt = *issue.ClosedAt
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Commander", Value: fmt.Sprintf("<@%s>", incident.CommanderID), Inline: true},
+		{Name: "Duration", Value: formatDuration(closedAt.Sub(incident.DeclaredAt)), Inline: true},
+	}
+	if issue.Severity != "" {
+		fields = append([]*discordgo.MessageEmbedField{{
+			Name:   "Severity",
+			Value:  fmt.Sprintf("%s %s", getSeverityEmoji(issue.Severity), issue.Severity.GetDisplayName()),
+			Inline: true,
+		}}, fields...)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🧾 Incident timeline: %s", issue.Title),
+		Description: formatIncidentTimeline(ctx, history),
+		Color:       0x9b59b6,
+		Fields:      fields,
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Incident %s", issueDisplayName(issue))},
+	}
+	targetID := incident.Issue.ThreadID
+	if issue.Channel != nil && !issue.Channel.IsForum() {
+		targetID = issue.Channel.DiscordChannelID
+	}
+	if err := n.send(targetID, "", embed); err != nil {
+		n.logger.Error("Failed to post incident timeline",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return fmt.Errorf("failed to post incident timeline: %w", err)
+	}
+
+	if incident.BridgeStage {
+		// The stage may have been ended by hand already
+		if err := n.session.StageInstanceDelete(incident.BridgeChannelID); err != nil {
+			n.logger.Warn("Failed to end incident stage",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+				zap.String("channel_id", incident.BridgeChannelID),
+			)
+		}
+	}
+
+	return nil
+}
+
+// send posts an embed pinging only the users mentioned in content
+func (n *IncidentNotifier) send(targetID, content string, embed *discordgo.MessageEmbed) error {
+	if targetID == "" {
+		return errors.New("incident has no thread or channel to post to")
+	}
+
+	_, err := n.session.ChannelMessageSendComplex(targetID, &discordgo.MessageSend{
+		Content: content,
+		Embeds:  []*discordgo.MessageEmbed{embed},
+		AllowedMentions: &discordgo.MessageAllowedMentions{
+			Parse: []discordgo.AllowedMentionType{discordgo.AllowedMentionTypeUsers},
+		},
+	})
+	return err
+}
+
+// formatIncidentTimeline lists the history of an incident, oldest first. Entries past the
+// embed limit are only counted.
+func formatIncidentTimeline(ctx context.Context, history []*domain.IssueStatusLog) string {
+	var timeline strings.Builder
+	for shown, entry := range history {
+		line := formatStatusLogEntry(ctx, entry)
+		if timeline.Len()+len(line) > maxTimelineLength {
+			timeline.WriteString(fmt.Sprintf("…%d more entries", len(history)-shown))
+			break
+		}
+		timeline.WriteString(line)
+	}
+	if timeline.Len() == 0 {
+		return "No changes were recorded."
+	}
+	return timeline.String()
+}
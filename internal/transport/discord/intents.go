@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// closeDisallowedIntents is the gateway close code for intents the bot has not been
+// approved for in the Discord developer portal
+const closeDisallowedIntents = 4014
+
+// privilegedIntents need to be turned on for the bot in the Discord developer portal
+const privilegedIntents = discordgo.IntentsGuildMembers | discordgo.IntentsGuildPresences | discordgo.IntentsMessageContent
+
+// intentNames maps the names of discord.intents in the configuration to gateway intents
+var intentNames = map[string]discordgo.Intent{
+	"guilds":                   discordgo.IntentsGuilds,
+	"guild_members":            discordgo.IntentsGuildMembers,
+	"guild_bans":               discordgo.IntentsGuildBans,
+	"guild_emojis":             discordgo.IntentsGuildEmojis,
+	"guild_integrations":       discordgo.IntentsGuildIntegrations,
+	"guild_webhooks":           discordgo.IntentsGuildWebhooks,
+	"guild_invites":            discordgo.IntentsGuildInvites,
+	"guild_voice_states":       discordgo.IntentsGuildVoiceStates,
+	"guild_presences":          discordgo.IntentsGuildPresences,
+	"guild_messages":           discordgo.IntentsGuildMessages,
+	"guild_message_reactions":  discordgo.IntentsGuildMessageReactions,
+	"guild_message_typing":     discordgo.IntentsGuildMessageTyping,
+	"direct_messages":          discordgo.IntentsDirectMessages,
+	"direct_message_reactions": discordgo.IntentsDirectMessageReactions,
+	"direct_message_typing":    discordgo.IntentsDirectMessageTyping,
+	"message_content":          discordgo.IntentsMessageContent,
+	"guild_scheduled_events":   discordgo.IntentsGuildScheduledEvents,
+}
+
+// ParseIntents combines the gateway intents with the given names, e.g. "guild_messages"
+func ParseIntents(names []string) (discordgo.Intent, error) {
+	var intents discordgo.Intent
+	for _, name := range names {
+		intent, ok := intentNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return 0, fmt.Errorf("unknown discord intent %q", name)
+		}
+		intents |= intent
+	}
+	return intents, nil
+}
+
+// intentList names the gateway intents in a set, for logging
+func intentList(intents discordgo.Intent) string {
+	names := make([]string, 0, len(intentNames))
+	for name, intent := range intentNames {
+		if intents&intent != 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// OpenSession connects the session to the Discord gateway. When Discord refuses the
+// privileged intents because the bot has not been approved for them, it connects again
+// without them and the features needing them are degraded (see Handler.hasIntent).
+func OpenSession(session *discordgo.Session, logger *zap.Logger) error {
+	err := session.Open()
+
+	var closeErr *websocket.CloseError
+	requested := session.Identify.Intents & privilegedIntents
+	if !errors.As(err, &closeErr) || closeErr.Code != closeDisallowedIntents || requested == 0 {
+		return err
+	}
+
+	logger.Warn("Discord refused the privileged intents; connecting without them. Turn them on for the bot in the Discord developer portal to enable every feature.",
+		zap.String("intents", intentList(requested)),
+	)
+	session.Identify.Intents &^= privilegedIntents
+	return session.Open()
+}
+
+// hasIntent checks if the session receives the events and data of a gateway intent
+func (h *Handler) hasIntent(intent discordgo.Intent) bool {
+	return h.session.Identify.Intents&intent == intent
+}
+
+// LogDegradedFeatures warns about the features that do not work with the intents the
+// session connected with
+func (h *Handler) LogDegradedFeatures() {
+	if !h.hasIntent(discordgo.IntentsGuildMessages) {
+		h.logger.Warn("The guild_messages intent is off: thread messages are not recorded as issue comments and quick reports are not captured")
+	} else if !h.hasIntent(discordgo.IntentsMessageContent) {
+		h.logger.Warn("The message_content intent is off: thread messages are not recorded as issue comments and messages reported by reaction keep only a link to the message. Quick reports, slash commands and message commands still work.")
+	}
+	if !h.hasIntent(discordgo.IntentsGuildMessageReactions) {
+		h.logger.Warn("The guild_message_reactions intent is off: messages cannot be reported by reaction")
+	}
+}
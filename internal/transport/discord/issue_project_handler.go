@@ -0,0 +1,77 @@
+package discord
+
+import (
+	"context"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// issueProjectSelectID is the custom ID of the /issue project select menu
+const issueProjectSelectID = "issue_project"
+
+// issueModalPrefix starts the custom ID of an issue form for a chosen project, followed by the project ID
+const issueModalPrefix = "issue_modal_"
+
+// maxSelectOptions is the number of options Discord allows in a select menu
+const maxSelectOptions = 25
+
+// issueProjectSelect asks the reporter which of the channel's projects a new issue is for
+func issueProjectSelect(ctx context.Context, channel *domain.Channel) *discordgo.InteractionResponse {
+	projects := channel.AllProjects()
+	if len(projects) > maxSelectOptions {
+		projects = projects[:maxSelectOptions]
+	}
+
+	options := make([]discordgo.SelectMenuOption, 0, len(projects))
+	for _, project := range projects {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       truncateText(project.Name, 100),
+			Value:       project.ID.String(),
+			Description: project.KeyPrefix,
+		})
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: i18n.T(ctx, "📂 This channel tracks several projects. Which one is the issue about?"),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.SelectMenu{
+							CustomID:    issueProjectSelectID,
+							Placeholder: i18n.T(ctx, "Choose a project..."),
+							Options:     options,
+						},
+					},
+				},
+			},
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}
+}
+
+// handleIssueProjectSelection opens the issue form for the project chosen in the /issue select menu
+func (h *Handler) handleIssueProjectSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	values := i.MessageComponentData().Values
+	if len(values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+
+	projectID, err := uuid.Parse(values[0])
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+
+	fields := h.formCustomFields(ctx, i.ChannelID, projectID)
+	if err := h.session.InteractionRespond(i.Interaction, issueModal(ctx, issueModalPrefix+projectID.String(), fields)); err != nil {
+		h.logger.Error("Failed to respond with modal", zap.Error(err))
+	}
+}
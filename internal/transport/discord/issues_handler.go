@@ -0,0 +1,310 @@
+package discord
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// issuesPageSize is the number of issues shown per /issues page
+const issuesPageSize = 10
+
+// issuesFilterAll is used in custom IDs when a filter is not applied
+const issuesFilterAll = "all"
+
+// handleIssuesCommand handles the /issues slash command
+func (h *Handler) handleIssuesCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling issues command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	var filter domain.IssueFilter
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "status":
+			filter.Status = domain.Status(option.StringValue())
+		case "priority":
+			filter.Priority = domain.Priority(option.StringValue())
+		case "milestone":
+			channelID, _ := h.intakeChannel(i.ChannelID)
+			milestone, err := h.milestoneService.GetByName(ctx, channelID, option.StringValue())
+			if err != nil {
+				h.respondMilestoneError(ctx, i, err)
+				return
+			}
+			filter.MilestoneID = milestone.ID
+		case "component":
+			channelID, _ := h.intakeChannel(i.ChannelID)
+			component, err := h.componentService.GetByName(ctx, channelID, option.StringValue())
+			if err != nil {
+				h.respondComponentError(ctx, i, err)
+				return
+			}
+			filter.ComponentID = component.ID
+		}
+	}
+
+	if !h.deferResponse(ctx, i, false) {
+		return
+	}
+
+	content, components, err := h.buildIssuesPage(ctx, i.ChannelID, filter, 0)
+	if err != nil {
+		h.logger.Error("Failed to get issues for channel",
+			zap.Error(err),
+			zap.String("channel_id", i.ChannelID),
+		)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to retrieve issues. Please try again."), true)
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content:    content,
+		Components: components,
+	}); err != nil {
+		h.logger.Error("Failed to respond to issues command", zap.Error(err))
+	}
+}
+
+// handleIssuesPageButton handles the pagination buttons of /issues
+func (h *Handler) handleIssuesPageButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	filter, page, err := parseIssuesPageID(i.MessageComponentData().CustomID)
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+
+	content, components, err := h.buildIssuesPage(ctx, i.ChannelID, filter, page)
+	if errors.Is(err, domain.ErrMilestoneNotFound) {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This milestone was deleted. Run `/issues` again."), true)
+		return
+	}
+	if errors.Is(err, domain.ErrComponentNotFound) {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This component was deleted. Run `/issues` again."), true)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to build issues page", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to retrieve issues. Please try again."), true)
+		return
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: components,
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update issues page", zap.Error(err))
+	}
+}
+
+// buildIssuesPage renders one page of the channel's issues
+func (h *Handler) buildIssuesPage(ctx context.Context, channelID string, filter domain.IssueFilter, page int) (string, []discordgo.MessageComponent, error) {
+	var milestone string
+	if filter.MilestoneID != uuid.Nil {
+		m, err := h.milestoneService.GetByID(ctx, filter.MilestoneID)
+		if err != nil {
+			return "", nil, err
+		}
+		milestone = m.Name
+	}
+	var component string
+	if filter.ComponentID != uuid.Nil {
+		c, err := h.componentService.GetByID(ctx, filter.ComponentID)
+		if err != nil {
+			return "", nil, err
+		}
+		component = c.Name
+	}
+
+	issues, total, err := h.issueService.ListIssuesByChannelPage(ctx, channelID, filter, page*issuesPageSize, issuesPageSize)
+	if err != nil {
+		return "", nil, err
+	}
+
+	totalPages := int((total + issuesPageSize - 1) / issuesPageSize)
+
+	// The page may no longer exist if issues were deleted since the buttons were rendered
+	if len(issues) == 0 && total > 0 {
+		page = totalPages - 1
+		issues, total, err = h.issueService.ListIssuesByChannelPage(ctx, channelID, filter, page*issuesPageSize, issuesPageSize)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if total == 0 {
+		if filter == (domain.IssueFilter{}) {
+			return i18n.T(ctx, "📋 No issues found in this channel."), []discordgo.MessageComponent{}, nil
+		}
+		return i18n.T(ctx, "📋 No issues found in this channel matching %s.", describeIssueFilter(ctx, filter, milestone, component)), []discordgo.MessageComponent{}, nil
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "📋 **Issues in this channel (%d total)**", total))
+	if filter != (domain.IssueFilter{}) {
+		content.WriteString(i18n.T(ctx, " matching %s", describeIssueFilter(ctx, filter, milestone, component)))
+	}
+	content.WriteString(":\n\n")
+
+	now := time.Now()
+	for _, issue := range issues {
+		content.WriteString(fmt.Sprintf("%s %s **%s** `%s`\n",
+			getPriorityEmoji(issue.Priority), getStatusEmoji(issue.Status), issue.Title, issue.ShortID()))
+		content.WriteString(fmt.Sprintf("📅 %s | 👤 %s | %s",
+			fmt.Sprintf("<t:%d:D>", issue.CreatedAt.Unix()), formatReporter(&issue.Reporter), issue.GetStatusDisplayName()))
+
+		if due := formatDueDate(ctx, issue, now); due != "" {
+			content.WriteString(" | " + due)
+		}
+
+		if issue.ThreadID != "" {
+			content.WriteString(fmt.Sprintf(" | 💬 <#%s>", issue.ThreadID))
+		}
+		content.WriteString("\n\n")
+	}
+
+	if totalPages > 1 {
+		content.WriteString(i18n.T(ctx, "Page %d/%d", page+1, totalPages))
+	}
+
+	return content.String(), createIssuesPagination(filter, page, totalPages), nil
+}
+
+// describeIssueFilter renders the active filters for display; milestone and component are
+// the names of the filtered milestone and component
+func describeIssueFilter(ctx context.Context, filter domain.IssueFilter, milestone, component string) string {
+	var parts []string
+	if filter.Status != "" {
+		parts = append(parts, i18n.T(ctx, "status **%s**", filter.Status))
+	}
+	if filter.Priority != "" {
+		parts = append(parts, i18n.T(ctx, "priority **%s**", filter.Priority))
+	}
+	if filter.MilestoneID != uuid.Nil {
+		parts = append(parts, i18n.T(ctx, "milestone **%s**", milestone))
+	}
+	if filter.ComponentID != uuid.Nil {
+		parts = append(parts, i18n.T(ctx, "component **%s**", component))
+	}
+	return strings.Join(parts, i18n.T(ctx, " and "))
+}
+
+// issuesPageID builds the custom ID of an /issues page button.
+// Format: "issues_page_<status>_<priority>_<milestone ID>_<component ID>_<page>" with "all"
+// for unset filters. IDs are written as hex without dashes to fit Discord's 100 characters.
+func issuesPageID(filter domain.IssueFilter, page int) string {
+	status := string(filter.Status)
+	if status == "" {
+		status = issuesFilterAll
+	}
+	priority := string(filter.Priority)
+	if priority == "" {
+		priority = issuesFilterAll
+	}
+	return fmt.Sprintf("issues_page_%s_%s_%s_%s_%d", status, priority,
+		issuesPageFilterID(filter.MilestoneID), issuesPageFilterID(filter.ComponentID), page)
+}
+
+// issuesPageFilterID writes a filtered ID for an /issues page button
+func issuesPageFilterID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return issuesFilterAll
+	}
+	return hex.EncodeToString(id[:])
+}
+
+// parseIssuesPageID parses a custom ID built by issuesPageID. It is parsed from
+// the right because statuses such as "in_progress" contain underscores.
+func parseIssuesPageID(customID string) (domain.IssueFilter, int, error) {
+	rest := strings.TrimPrefix(customID, "issues_page_")
+
+	idx := strings.LastIndex(rest, "_")
+	if idx < 0 {
+		return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page ID: %s", customID)
+	}
+	page, err := strconv.Atoi(rest[idx+1:])
+	if err != nil || page < 0 {
+		return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page: %s", customID)
+	}
+	rest = rest[:idx]
+
+	var filter domain.IssueFilter
+	idx = strings.LastIndex(rest, "_")
+	if idx < 0 {
+		return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page ID: %s", customID)
+	}
+	if component := rest[idx+1:]; component != issuesFilterAll {
+		if filter.ComponentID, err = uuid.Parse(component); err != nil {
+			return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page component: %s", customID)
+		}
+	}
+	rest = rest[:idx]
+
+	idx = strings.LastIndex(rest, "_")
+	if idx < 0 {
+		return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page ID: %s", customID)
+	}
+	if milestone := rest[idx+1:]; milestone != issuesFilterAll {
+		if filter.MilestoneID, err = uuid.Parse(milestone); err != nil {
+			return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page milestone: %s", customID)
+		}
+	}
+	rest = rest[:idx]
+
+	idx = strings.LastIndex(rest, "_")
+	if idx < 0 {
+		return domain.IssueFilter{}, 0, fmt.Errorf("invalid issues page ID: %s", customID)
+	}
+
+	if status := rest[:idx]; status != issuesFilterAll {
+		filter.Status = domain.Status(status)
+	}
+	if priority := rest[idx+1:]; priority != issuesFilterAll {
+		filter.Priority = domain.Priority(priority)
+	}
+
+	return filter, page, nil
+}
+
+// createIssuesPagination creates the previous/next buttons for /issues
+func createIssuesPagination(filter domain.IssueFilter, page, totalPages int) []discordgo.MessageComponent {
+	if totalPages <= 1 {
+		return []discordgo.MessageComponent{}
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				&discordgo.Button{
+					Label:    "Previous",
+					Style:    discordgo.SecondaryButton,
+					CustomID: issuesPageID(filter, page-1),
+					Disabled: page == 0,
+					Emoji:    &discordgo.ComponentEmoji{Name: "⬅️"},
+				},
+				&discordgo.Button{
+					Label:    "Next",
+					Style:    discordgo.SecondaryButton,
+					CustomID: issuesPageID(filter, page+1),
+					Disabled: page >= totalPages-1,
+					Emoji:    &discordgo.ComponentEmoji{Name: "➡️"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleLabelCommand handles the /label slash command and its add, remove and list subcommands
+func (h *Handler) handleLabelCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: add, remove or list."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling label command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	switch subcommand.Name {
+	case "add":
+		h.handleLabelAdd(ctx, i, args["id"], args["name"], args["color"])
+	case "remove":
+		h.handleLabelRemove(ctx, i, args["id"], args["name"])
+	case "list":
+		h.handleLabelList(ctx, i, args["id"])
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// handleLabelAdd adds a label to an issue
+func (h *Handler) handleLabelAdd(ctx context.Context, i *discordgo.InteractionCreate, idStr, name, color string) {
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.AddLabelToIssue(ctx, issue.ID, name, color)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to add label. Please try again."))
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🏷️ Added `%s` to **%s**", label.Name, issue.Title), true)
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "🏷️ Label `%s` added by <@%s>", label.Name, i.Member.User.ID))
+}
+
+// handleLabelRemove removes a label from an issue
+func (h *Handler) handleLabelRemove(ctx context.Context, i *discordgo.InteractionCreate, idStr, name string) {
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	name = domain.NormalizeLabelName(name)
+	if err := h.labelService.RemoveLabelFromIssue(ctx, issue.ID, name); err != nil {
+		if err == domain.ErrLabelNotFound {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ **%s** does not have the label `%s`.", issue.Title, name), true)
+			return
+		}
+		h.logger.Error("Failed to remove label", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to remove label. Please try again."), true)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🏷️ Removed `%s` from **%s**", name, issue.Title), true)
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "🏷️ Label `%s` removed by <@%s>", name, i.Member.User.ID))
+}
+
+// handleLabelList lists an issue's labels, or every label of the channel's project when no ID is given
+func (h *Handler) handleLabelList(ctx context.Context, i *discordgo.InteractionCreate, idStr string) {
+	if idStr != "" {
+		issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+		if !ok {
+			return
+		}
+		if len(issue.Labels) == 0 {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "🏷️ **%s** has no labels.", issue.Title), true)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🏷️ **Labels on %s:** %s", issue.Title, formatLabels(issue.Labels)), true)
+		return
+	}
+
+	channel, err := h.channelService.GetChannelRegistration(ctx, i.ChannelID)
+	if err != nil {
+		if errors.Is(err, domain.ErrChannelNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This channel is not registered. Use `/register` first."), true)
+			return
+		}
+		h.logger.Error("Failed to get channel registration for labels", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to list labels. Please try again."), true)
+		return
+	}
+
+	labels, err := h.labelService.ListProjectLabels(ctx, channel.ProjectID)
+	if err != nil {
+		h.logger.Error("Failed to list project labels", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to list labels. Please try again."), true)
+		return
+	}
+
+	if len(labels) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🏷️ This project has no labels yet. Add one with `/label add`."), true)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "🏷️ **Project labels (%d):**\n", len(labels)))
+	for _, label := range labels {
+		content.WriteString(fmt.Sprintf("• `%s` %s\n", label.Name, label.Color))
+	}
+
+	h.respondToInteraction(ctx, i, content.String(), true)
+}
+
+// resolveIssueForCommand resolves an issue ID option and responds with an error if it cannot be found
+func (h *Handler) resolveIssueForCommand(ctx context.Context, i *discordgo.InteractionCreate, idStr string) (*domain.Issue, bool) {
+	issue, err := h.resolveIssue(ctx, i.GuildID, i.ChannelID, idStr)
+	if err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ No issue found with ID: `%s`", idStr), true)
+			return nil, false
+		}
+		h.logger.Error("Failed to resolve issue", zap.Error(err), zap.String("issue_id", idStr))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to find issue. Please try again."), true)
+		return nil, false
+	}
+
+	return issue, true
+}
+
+// formatLabels renders labels as inline chips
+func formatLabels(labels []domain.Label) string {
+	chips := make([]string, 0, len(labels))
+	for _, label := range labels {
+		chips = append(chips, fmt.Sprintf("`%s`", label.Name))
+	}
+	return strings.Join(chips, " ")
+}
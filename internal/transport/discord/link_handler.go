@@ -0,0 +1,126 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleLinkCommand handles the /link slash command
+func (h *Handler) handleLinkCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	args := make(map[string]string)
+	for _, option := range i.ApplicationCommandData().Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling link command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+		zap.String("type", args["type"]),
+	)
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+	if !ok {
+		return
+	}
+	target, ok := h.resolveIssueForCommand(ctx, i, args["target"])
+	if !ok {
+		return
+	}
+
+	linkType := domain.LinkType(args["type"])
+	if _, err := h.issueLinkService.LinkIssues(ctx, issue.ID, target.ID, linkType, i.Member.User.ID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrIssueLinkExists):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ **%s** is already linked to **%s** as *%s*.",
+				issue.ShortID(), target.ShortID(), domain.GetLinkTypeDisplayName(linkType, false)), true)
+		default:
+			h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to link issues. Please try again."))
+		}
+		return
+	}
+
+	h.respondToInteraction(ctx, i, fmt.Sprintf("🔗 **%s** %s **%s**",
+		issue.ShortID(), domain.GetLinkTypeDisplayName(linkType, false), target.ShortID()), true)
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "🔗 This issue *%s* **%s** (linked by <@%s>)",
+		domain.GetLinkTypeDisplayName(linkType, false), target.ShortID(), i.Member.User.ID))
+	h.refreshIssue(ctx, target.ID, i18n.T(ctx, "🔗 This issue is *%s* **%s** (linked by <@%s>)",
+		domain.GetLinkTypeDisplayName(linkType, true), issue.ShortID(), i.Member.User.ID))
+}
+
+// handleUnlinkCommand handles the /unlink slash command
+func (h *Handler) handleUnlinkCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	args := make(map[string]string)
+	for _, option := range i.ApplicationCommandData().Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling unlink command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+	if !ok {
+		return
+	}
+	target, ok := h.resolveIssueForCommand(ctx, i, args["target"])
+	if !ok {
+		return
+	}
+
+	if err := h.issueLinkService.UnlinkIssues(ctx, issue.ID, target.ID, domain.LinkType(args["type"])); err != nil {
+		if errors.Is(err, domain.ErrIssueLinkNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ **%s** and **%s** are not linked.", issue.ShortID(), target.ShortID()), true)
+			return
+		}
+		h.logger.Error("Failed to unlink issues", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to unlink issues. Please try again."), true)
+		return
+	}
+
+	note := i18n.T(ctx, "🔗 <@%s> removed the link between **%s** and **%s**", i.Member.User.ID, issue.ShortID(), target.ShortID())
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🔗 Unlinked **%s** and **%s**", issue.ShortID(), target.ShortID()), true)
+	h.refreshIssue(ctx, issue.ID, note)
+	h.refreshIssue(ctx, target.ID, note)
+}
+
+// formatIssueLinks renders an issue's links for the issue card, staying within the embed
+// field limit. Links to deleted issues are skipped.
+func formatIssueLinks(issue *domain.Issue) string {
+	var links []string
+	for _, link := range issue.OutgoingLinks {
+		if link.TargetIssue != nil {
+			links = append(links, formatIssueLink(domain.GetLinkTypeDisplayName(link.Type, false), link.TargetIssue))
+		}
+	}
+	for _, link := range issue.IncomingLinks {
+		if link.SourceIssue != nil {
+			links = append(links, formatIssueLink(domain.GetLinkTypeDisplayName(link.Type, true), link.SourceIssue))
+		}
+	}
+
+	var lines []string
+	length := 0
+	for i, line := range links {
+		if length+len(line) > 950 {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(links)-i))
+			break
+		}
+		lines = append(lines, line)
+		length += len(line) + 1
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatIssueLink renders one linked issue with its relation and status
+func formatIssueLink(relation string, other *domain.Issue) string {
+	return fmt.Sprintf("%s %s **%s** %s", getStatusEmoji(other.Status), relation, other.ShortID(), truncateText(other.Title, 60))
+}
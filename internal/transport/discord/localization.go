@@ -0,0 +1,87 @@
+package discord
+
+import (
+	"context"
+
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// interactionLocale picks the language of the responses to an interaction: the locale
+// chosen for the server with /settings locale, else the user's Discord language if the
+// bot speaks it, else English
+func (h *Handler) interactionLocale(ctx context.Context, i *discordgo.InteractionCreate) string {
+	if locale := h.guildLocale(ctx, i.GuildID); locale != "" {
+		return locale
+	}
+	if locale := i18n.Match(string(i.Locale)); locale != "" {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
+
+// guildLocale returns the supported locale chosen for a server, or an empty string if
+// it has not chosen one
+func (h *Handler) guildLocale(ctx context.Context, guildID string) string {
+	if guildID == "" {
+		return ""
+	}
+
+	settings, err := h.guildSettingsService.GetSettings(ctx, guildID)
+	if err != nil {
+		h.logger.Warn("Failed to get guild settings for locale", zap.Error(err), zap.String("guild_id", guildID))
+		return ""
+	}
+	return i18n.Match(settings.Locale)
+}
+
+// localizeCommands adds the catalogs' translations to the descriptions of commands, their
+// options and choices, so Discord shows them in each user's language. Slash command and
+// option names stay English as they are typed; message command names are translated.
+func localizeCommands(commands []*discordgo.ApplicationCommand) {
+	for _, cmd := range commands {
+		if cmd.Type == discordgo.MessageApplicationCommand {
+			if names := discordLocalizations(cmd.Name); names != nil {
+				cmd.NameLocalizations = &names
+			}
+		}
+		if descriptions := discordLocalizations(cmd.Description); descriptions != nil {
+			cmd.DescriptionLocalizations = &descriptions
+		}
+		localizeOptions(cmd.Options)
+	}
+}
+
+// localizeOptions adds translations to the descriptions and choices of options and their
+// subcommands
+func localizeOptions(options []*discordgo.ApplicationCommandOption) {
+	for _, option := range options {
+		option.DescriptionLocalizations = discordLocalizations(option.Description)
+		for _, choice := range option.Choices {
+			choice.NameLocalizations = discordLocalizations(choice.Name)
+		}
+		localizeOptions(option.Options)
+	}
+}
+
+// discordLocalizations returns the translations of a text keyed by the Discord locales of
+// their languages, or nil if it has none
+func discordLocalizations(text string) map[discordgo.Locale]string {
+	if text == "" {
+		return nil
+	}
+
+	var localizations map[discordgo.Locale]string
+	for locale, translated := range i18n.Translations(text) {
+		if _, ok := discordgo.Locales[discordgo.Locale(locale)]; !ok {
+			continue
+		}
+		if localizations == nil {
+			localizations = make(map[discordgo.Locale]string)
+		}
+		localizations[discordgo.Locale(locale)] = translated
+	}
+	return localizations
+}
@@ -0,0 +1,280 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// createIssueFromMessageCommand is the name of the message context menu command
+const createIssueFromMessageCommand = "Create Issue from Message"
+
+// maxIssueTitleLength matches the title input limit of the /issue modal
+const maxIssueTitleLength = 255
+
+// reportReactionTTL is how long handled report reactions are remembered, so a redelivered
+// reaction does not report a message twice
+const reportReactionTTL = 15 * time.Minute
+
+// handleCreateIssueFromMessageCommand opens an issue modal prefilled from the target message
+func (h *Handler) handleCreateIssueFromMessageCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.ApplicationCommandData()
+
+	var message *discordgo.Message
+	if data.Resolved != nil {
+		message = data.Resolved.Messages[data.TargetID]
+	}
+	if message == nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Could not read the selected message."), true)
+		return
+	}
+
+	title, description := splitMessageContent(message.Content)
+
+	// Issues from messages go to the channel's main project
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	fields := h.formCustomFields(ctx, channelID, uuid.Nil)
+
+	modal := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: fmt.Sprintf("message_issue_modal_%s", message.ID),
+			Title:    i18n.T(ctx, "Create Issue from Message"),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "title",
+							Label:       i18n.T(ctx, "Issue Title"),
+							Style:       discordgo.TextInputShort,
+							Placeholder: i18n.T(ctx, "e.g. Cannot login"),
+							Value:       title,
+							Required:    true,
+							MaxLength:   maxIssueTitleLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "description",
+							Label:       "Description",
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: i18n.T(ctx, "Describe the issue in detail..."),
+							Value:       description,
+							Required:    true,
+							MaxLength:   2000,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rows := &modal.Data.Components
+	*rows = append(*rows, customFieldInputs(fields, maxModalRows-len(*rows))...)
+
+	if err := h.session.InteractionRespond(i.Interaction, modal); err != nil {
+		h.logger.Error("Failed to respond with message issue modal", zap.Error(err))
+	}
+}
+
+// handleMessageIssueModalSubmit creates an issue from the modal and stores the source message's attachments
+func (h *Handler) handleMessageIssueModalSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.ModalSubmitData()
+	messageID := strings.TrimPrefix(data.CustomID, "message_issue_modal_")
+
+	var title, description string
+	for _, row := range data.Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			input, ok := component.(*discordgo.TextInput)
+			if !ok {
+				continue
+			}
+			switch input.CustomID {
+			case "title":
+				title = input.Value
+			case "description":
+				description = input.Value
+			}
+		}
+	}
+
+	// Modals cannot carry attachments, so read them from the source message again
+	message, err := h.session.ChannelMessage(i.ChannelID, messageID)
+	if err != nil {
+		h.logger.Error("Failed to fetch source message",
+			zap.Error(err),
+			zap.String("message_id", messageID),
+		)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ The original message could not be found."), true)
+		return
+	}
+
+	// The author of the message is the reporter unless it was posted by a bot
+	reporterID := i.Member.User.ID
+	if message.Author != nil && !message.Author.Bot {
+		reporterID = message.Author.ID
+	}
+
+	h.logger.Info("Creating issue from message",
+		zap.String("message_id", messageID),
+		zap.String("reporter_id", reporterID),
+		zap.Int("attachments", len(message.Attachments)),
+	)
+
+	description = withMessageLink(description, i.GuildID, i.ChannelID, messageID)
+
+	h.submitIssue(ctx, i, &issueDraft{
+		title:        title,
+		description:  description,
+		reporterID:   reporterID,
+		attachments:  toIssueAttachments(message.Attachments),
+		customFields: customFieldInputValues(data),
+	})
+}
+
+// reportMessageByReaction creates an issue from a message reacted to with the guild's
+// report emoji in a registered channel, for the channel's main project. The message
+// author becomes the reporter and the card is linked from a reply to the message.
+// A message is only reported by its first reaction, and the reaction is removed again
+// when no issue could be created.
+func (h *Handler) reportMessageByReaction(ctx context.Context, r *discordgo.MessageReactionAdd) {
+	settings, err := h.guildSettingsService.GetSettings(ctx, r.GuildID)
+	if err != nil {
+		h.logger.Error("Failed to get guild settings for reaction", zap.Error(err), zap.String("guild_id", r.GuildID))
+		return
+	}
+	emoji := settings.GetReportEmoji()
+	if emoji == "" || r.Emoji.APIName() != emoji {
+		return
+	}
+	ctx = i18n.WithLocale(ctx, i18n.Match(settings.Locale))
+
+	channelID, _ := h.intakeChannel(r.ChannelID)
+	if _, err := h.channelService.GetChannelRegistration(ctx, channelID); err != nil {
+		if !errors.Is(err, domain.ErrChannelNotFound) {
+			h.logger.Error("Failed to get channel registration for reaction", zap.Error(err), zap.String("channel_id", channelID))
+		}
+		return
+	}
+
+	if !h.reportReactions.firstSeen(r.MessageID + ":" + r.UserID) {
+		return
+	}
+
+	message, err := h.session.ChannelMessage(r.ChannelID, r.MessageID)
+	if err != nil {
+		h.logger.Error("Failed to fetch reported message", zap.Error(err), zap.String("message_id", r.MessageID))
+		return
+	}
+
+	// Bot messages such as issue cards are not reports
+	if message.Author == nil || message.Author.Bot {
+		h.removeReaction(r)
+		return
+	}
+
+	// Whoever reacted first reported the message already
+	for _, reaction := range message.Reactions {
+		if reaction.Emoji != nil && reaction.Emoji.APIName() == emoji && reaction.Count > 1 {
+			return
+		}
+	}
+
+	title, description := splitMessageContent(message.Content)
+	if title == "" {
+		title = i18n.T(ctx, "Message from %s", message.Author.Username)
+	}
+	description = withMessageLink(description, r.GuildID, r.ChannelID, r.MessageID)
+
+	h.logger.Info("Reporting message by reaction",
+		zap.String("message_id", r.MessageID),
+		zap.String("reporter_id", message.Author.ID),
+		zap.String("reacted_by", r.UserID),
+	)
+
+	created, err := h.issueService.CreateIssue(ctx, title, description, "", message.Author.ID, channelID, uuid.Nil)
+	if err != nil {
+		var limited *domain.RateLimitError
+		if !errors.As(err, &limited) && !errors.Is(err, domain.ErrChannelInactive) {
+			h.logger.Error("Failed to create issue from reaction", zap.Error(err), zap.String("message_id", r.MessageID))
+		}
+		h.removeReaction(r)
+		return
+	}
+
+	if len(message.Attachments) > 0 {
+		if err := h.attachmentService.AddAttachments(ctx, created.ID, message.Author.ID, toIssueAttachments(message.Attachments)); err != nil {
+			h.logger.Error("Failed to store message attachments",
+				zap.Error(err),
+				zap.String("issue_id", created.ID.String()),
+			)
+		}
+	}
+
+	issue, err := h.postIssueCard(ctx, created.ID, channelID)
+	if err != nil {
+		h.logger.Error("Failed to publish issue card", zap.Error(err), zap.String("issue_id", created.ID.String()))
+		return
+	}
+
+	reply := i18n.T(ctx, "📝 <@%s> reported this as issue **%s**.", r.UserID, issueDisplayName(issue))
+	if !h.hasIntent(discordgo.IntentsMessageContent) {
+		// Without the intent Discord hands the bot messages without their text and attachments
+		reply += i18n.T(ctx, "\nThe bot cannot read message text here, so the issue only links to this message. Use *Create Issue from Message* to copy its text.")
+	}
+	if _, err := h.session.ChannelMessageSendReply(r.ChannelID, reply, message.Reference()); err != nil {
+		h.logger.Warn("Failed to reply to reported message", zap.Error(err), zap.String("message_id", r.MessageID))
+	}
+}
+
+// withMessageLink appends a link to the message an issue was created from to its description
+func withMessageLink(description, guildID, channelID, messageID string) string {
+	return fmt.Sprintf("%s\n\n[Original message](https://discord.com/channels/%s/%s/%s)",
+		strings.TrimSpace(description), guildID, channelID, messageID)
+}
+
+// splitMessageContent derives a title (first line) and description (full text) from a message
+func splitMessageContent(content string) (title, description string) {
+	content = strings.TrimSpace(content)
+	title, _, _ = strings.Cut(content, "\n")
+	title = strings.TrimSpace(title)
+
+	if runes := []rune(title); len(runes) > maxIssueTitleLength {
+		title = string(runes[:maxIssueTitleLength])
+	}
+	if runes := []rune(content); len(runes) > 2000 {
+		content = string(runes[:2000])
+	}
+
+	return title, content
+}
+
+// toIssueAttachments converts Discord message attachments to issue attachments
+func toIssueAttachments(attachments []*discordgo.MessageAttachment) []*domain.IssueAttachment {
+	result := make([]*domain.IssueAttachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, &domain.IssueAttachment{
+			DiscordAttachmentID: attachment.ID,
+			FileName:            attachment.Filename,
+			URL:                 attachment.URL,
+			ContentType:         attachment.ContentType,
+			Size:                attachment.Size,
+		})
+	}
+	return result
+}
@@ -0,0 +1,251 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// milestoneProgressBarLength is the number of segments of a milestone progress bar
+const milestoneProgressBarLength = 10
+
+// handleMilestoneCommand handles the /milestone slash command and its create, list, show,
+// assign, unassign and delete subcommands
+func (h *Handler) handleMilestoneCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: create, list, show, assign, unassign or delete."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling milestone command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	// Anyone can follow milestones; changing them requires the permission
+	if subcommand.Name != "list" && subcommand.Name != "show" && !h.authorize(ctx, i, domain.PermissionManageMilestone) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	userID := i.Member.User.ID
+
+	switch subcommand.Name {
+	case "create":
+		milestone, err := h.milestoneService.Create(ctx, channelID, args["name"], args["target-date"], userID)
+		if err != nil {
+			h.respondMilestoneError(ctx, i, err)
+			return
+		}
+		content := i18n.T(ctx, "🎯 Created milestone **%s**.", milestone.Name)
+		if milestone.TargetDate != nil {
+			content = i18n.T(ctx, "🎯 Created milestone **%s**, targeted for %s.", milestone.Name, milestone.FormatTargetDate())
+		}
+		h.respondToInteraction(ctx, i, content+i18n.T(ctx, " Add issues with `/milestone assign`."), true)
+	case "list":
+		progress, err := h.milestoneService.List(ctx, channelID)
+		if err != nil {
+			h.respondMilestoneError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, formatMilestones(ctx, progress, time.Now()), true)
+	case "show":
+		h.handleMilestoneShow(ctx, i, channelID, args["name"])
+	case "assign":
+		issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+		if !ok {
+			return
+		}
+		issue, err := h.milestoneService.Assign(ctx, issue.ID, args["milestone"], userID)
+		if err != nil {
+			h.respondMilestoneError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🎯 Added **%s** to milestone **%s**.", issueDisplayName(issue), issue.Milestone.Name), true)
+		h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "🎯 Added to milestone **%s** by <@%s>", issue.Milestone.Name, userID))
+	case "unassign":
+		issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+		if !ok {
+			return
+		}
+		if issue.MilestoneID == nil {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ **%s** is not in a milestone.", issueDisplayName(issue)), true)
+			return
+		}
+		issue, err := h.milestoneService.Unassign(ctx, issue.ID, userID)
+		if err != nil {
+			h.respondMilestoneError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🎯 Removed **%s** from its milestone.", issueDisplayName(issue)), true)
+		h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "🎯 Removed from its milestone by <@%s>", userID))
+	case "delete":
+		milestone, err := h.milestoneService.Delete(ctx, channelID, args["name"])
+		if err != nil {
+			h.respondMilestoneError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🗑️ Deleted milestone **%s**. Its issues are kept without a milestone.", milestone.Name), true)
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// handleMilestoneShow shows the progress of a milestone and its open issues
+func (h *Handler) handleMilestoneShow(ctx context.Context, i *discordgo.InteractionCreate, channelID, name string) {
+	milestone, err := h.milestoneService.GetByName(ctx, channelID, name)
+	if err != nil {
+		h.respondMilestoneError(ctx, i, err)
+		return
+	}
+
+	issues, err := h.milestoneService.ListIssues(ctx, milestone.ID)
+	if err != nil {
+		h.respondMilestoneError(ctx, i, err)
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{CreateMilestoneEmbed(domain.NewMilestoneProgress(milestone, issues), issues, time.Now())},
+		Flags:  discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond to milestone show", zap.Error(err))
+	}
+}
+
+// respondMilestoneError explains why a milestone command failed
+func (h *Handler) respondMilestoneError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrMilestoneNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no milestone with that name. See `/milestone list`."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update milestones. Please try again."))
+	}
+}
+
+// suggestMilestones suggests the milestones of the channel's project for a milestone option
+func (h *Handler) suggestMilestones(ctx context.Context, i *discordgo.InteractionCreate, typed string) {
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	progress, err := h.milestoneService.List(ctx, channelID)
+	if err != nil {
+		h.respondWithChoices(i, nil)
+		return
+	}
+
+	typed = strings.ToLower(typed)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, maxAutocompleteChoices)
+	for _, p := range progress {
+		if !strings.Contains(strings.ToLower(p.Milestone.Name), typed) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  truncateText(i18n.T(ctx, "%s · %d%% closed", p.Milestone.Name, p.Percent()), maxChoiceNameLength),
+			Value: p.Milestone.Name,
+		})
+		if len(choices) == maxAutocompleteChoices {
+			break
+		}
+	}
+
+	h.respondWithChoices(i, choices)
+}
+
+// CreateMilestoneEmbed creates an embed showing the progress of a milestone and its open issues
+func CreateMilestoneEmbed(progress *domain.MilestoneProgress, issues []*domain.Issue, now time.Time) *discordgo.MessageEmbed {
+	milestone := progress.Milestone
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🎯 %s", milestone.Name),
+		Description: formatMilestoneProgress(progress),
+		Color:       0x3498db,
+	}
+
+	if milestone.TargetDate != nil {
+		target := milestone.FormatTargetDate()
+		if milestone.IsPastTarget(now) && progress.Closed < progress.Total {
+			target = "⏰ " + target + " (passed)"
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Target Date",
+			Value:  target,
+			Inline: true,
+		})
+	}
+
+	embed.Fields = append(embed.Fields,
+		&discordgo.MessageEmbedField{
+			Name:   "🔓 Open",
+			Value:  fmt.Sprintf("%d", progress.Total-progress.Closed),
+			Inline: true,
+		},
+		&discordgo.MessageEmbedField{
+			Name:   "🔒 Closed",
+			Value:  fmt.Sprintf("%d", progress.Closed),
+			Inline: true,
+		},
+	)
+
+	var open []domain.Issue
+	for _, issue := range issues {
+		if !issue.IsClosed() {
+			open = append(open, *issue)
+		}
+	}
+	if len(open) > 0 {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Open Issues",
+			Value:  formatSubIssues(open),
+			Inline: false,
+		})
+	}
+
+	return embed
+}
+
+// formatMilestones describes a project's milestones for /milestone list
+func formatMilestones(ctx context.Context, progress []*domain.MilestoneProgress, now time.Time) string {
+	if len(progress) == 0 {
+		return i18n.T(ctx, "🎯 This project has no milestones. Create one with `/milestone create`.")
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "🎯 **Milestones (%d):**\n", len(progress)))
+	for _, p := range progress {
+		content.WriteString(fmt.Sprintf("• **%s** %s", truncateText(p.Milestone.Name, 40), formatMilestoneProgress(p)))
+		if p.Milestone.TargetDate != nil {
+			marker := "🗓️"
+			if p.Milestone.IsPastTarget(now) && p.Closed < p.Total {
+				marker = "⏰"
+			}
+			content.WriteString(fmt.Sprintf(" · %s %s", marker, p.Milestone.FormatTargetDate()))
+		}
+		content.WriteString("\n")
+	}
+	return content.String()
+}
+
+// formatMilestoneProgress renders a progress bar with the share of closed issues,
+// e.g. "▰▰▰▰▰▰▱▱▱▱ 60% closed (3/5)"
+func formatMilestoneProgress(progress *domain.MilestoneProgress) string {
+	if progress.Total == 0 {
+		return "No issues yet"
+	}
+	filled := progress.Percent() * milestoneProgressBarLength / 100
+	bar := strings.Repeat("▰", filled) + strings.Repeat("▱", milestoneProgressBarLength-filled)
+	return fmt.Sprintf("%s %d%% closed (%d/%d)", bar, progress.Percent(), progress.Closed, progress.Total)
+}
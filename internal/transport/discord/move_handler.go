@@ -0,0 +1,184 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// handleIssueMoveCommand handles the /issue-move slash command. The issue is filed under
+// the chosen channel and project, its card is posted there with a new thread, and its old
+// card is removed and its old thread archived with a pointer to the new one.
+func (h *Handler) handleIssueMoveCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling issue-move command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionMoveIssue) {
+		return
+	}
+
+	var idStr, targetID, projectKey string
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "id":
+			idStr = option.StringValue()
+		case "channel":
+			targetID = option.Value.(string)
+		case "project":
+			projectKey = strings.TrimSpace(option.StringValue())
+		}
+	}
+
+	if !h.deferResponse(ctx, i, true) {
+		return
+	}
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	target, err := h.channelService.GetChannelRegistration(ctx, targetID)
+	if err != nil {
+		h.respondMoveError(ctx, i, err, targetID)
+		return
+	}
+
+	projectID := uuid.Nil
+	if projectKey != "" {
+		projectID, err = channelProjectByKey(target, projectKey)
+		if err != nil {
+			h.respondMoveError(ctx, i, err, targetID)
+			return
+		}
+	}
+
+	moved, err := h.issueService.MoveIssue(ctx, issue.ID, target.DiscordChannelID, projectID, i.Member.User.ID)
+	if err != nil {
+		h.respondMoveError(ctx, i, err, targetID)
+		return
+	}
+
+	h.logger.Info("Issue moved",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("from_key", issue.Key),
+		zap.String("to_key", moved.Key),
+		zap.String("to_channel_id", target.DiscordChannelID),
+		zap.String("moved_by", i.Member.User.ID),
+	)
+
+	h.relocateIssueMessages(ctx, issue, moved, i.Member.User.ID)
+
+	content := i18n.T(ctx, "📦 Issue **%s** was moved to <#%s>.", issueDisplayName(moved), target.DiscordChannelID)
+	if moved.Key != issue.Key && issue.Key != "" {
+		content += i18n.T(ctx, "\nIt now belongs to **%s** and its key changed from `%s` to `%s`.", moved.Project.Name, issue.Key, moved.Key)
+	}
+	h.editInteractionResponse(ctx, i, content)
+}
+
+// relocateIssueMessages posts the card of a moved issue in its new channel, opening a new
+// thread for an issue under discussion, and retires its old card and thread. A forum post
+// holding the old card is archived rather than deleted, keeping its discussion readable.
+func (h *Handler) relocateIssueMessages(ctx context.Context, issue, moved *domain.Issue, movedBy string) {
+	if _, err := h.postIssueCard(ctx, moved.ID, ""); err != nil {
+		h.logger.Error("Failed to post card of moved issue", zap.Error(err), zap.String("issue_id", moved.ID.String()))
+	} else if err := h.openMovedIssueThread(ctx, issue, moved.ID); err != nil {
+		h.logger.Error("Failed to open thread of moved issue", zap.Error(err), zap.String("issue_id", moved.ID.String()))
+	}
+
+	if issue.Channel == nil {
+		return
+	}
+
+	if issue.MessageID != "" && !issue.Channel.IsForum() {
+		if err := h.session.ChannelMessageDelete(issue.Channel.DiscordChannelID, issue.MessageID); err != nil && !discordNotFound(err) {
+			h.logger.Error("Failed to delete old card of moved issue", zap.Error(err), zap.String("message_id", issue.MessageID))
+		}
+	}
+
+	if issue.ThreadID != "" {
+		h.sendMessage(ctx, issue.ThreadID, i18n.T(ctx, "📦 **This issue was moved to <#%s> by <@%s> and is now %s.**\n\nThe discussion continues there; this thread will be archived.",
+			moved.Channel.DiscordChannelID, movedBy, issueDisplayName(moved)))
+		if _, err := h.session.ChannelEditComplex(issue.ThreadID, &discordgo.ChannelEdit{
+			Archived: &[]bool{true}[0],
+			Locked:   &[]bool{true}[0],
+		}); err != nil && !discordNotFound(err) {
+			h.logger.Error("Failed to archive old thread of moved issue", zap.Error(err), zap.String("thread_id", issue.ThreadID))
+		}
+	}
+}
+
+// openMovedIssueThread starts the thread of a moved issue on its new card in a text
+// channel, pointing to the old thread. Drafts and closed issues get none, as when they are
+// created or closed; a forum post is already the issue's thread.
+func (h *Handler) openMovedIssueThread(ctx context.Context, issue *domain.Issue, issueID uuid.UUID) error {
+	moved, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		return err
+	}
+	if moved.Channel == nil || moved.Channel.IsForum() || moved.MessageID == "" || moved.Status == domain.StatusDraft || moved.IsClosed() {
+		return nil
+	}
+
+	thread, err := h.session.MessageThreadStart(moved.Channel.DiscordChannelID, moved.MessageID, issueThreadName(moved), threadAutoArchiveDuration(moved.Priority), discordgo.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if err := h.issueService.SetThreadInfo(ctx, moved.ID, thread.ID, moved.MessageID); err != nil {
+		return err
+	}
+
+	content := i18n.T(ctx, "💬 Discussion thread for Issue **%s**\n\nFeel free to add comments, updates, or additional information here.", issueDisplayName(moved))
+	if issue.ThreadID != "" {
+		content = i18n.T(ctx, "💬 Discussion thread for Issue **%s**, moved here. Earlier messages are in its old thread <#%s>.", issueDisplayName(moved), issue.ThreadID)
+	}
+	h.sendMessage(ctx, thread.ID, content)
+	return nil
+}
+
+// channelProjectByKey finds the project of a channel registration with an issue key prefix
+func channelProjectByKey(channel *domain.Channel, key string) (uuid.UUID, error) {
+	for _, project := range channel.AllProjects() {
+		if project.KeyPrefix != "" && strings.EqualFold(project.KeyPrefix, key) {
+			return project.ID, nil
+		}
+	}
+	return uuid.Nil, domain.ErrProjectNotInChannel
+}
+
+// moveTargetChannel returns the channel chosen so far in an /issue-move autocomplete, or
+// an empty string
+func moveTargetChannel(i *discordgo.InteractionCreate) string {
+	if i.ApplicationCommandData().Name != "issue-move" {
+		return ""
+	}
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "channel" {
+			if channelID, ok := option.Value.(string); ok {
+				return channelID
+			}
+		}
+	}
+	return ""
+}
+
+// respondMoveError explains why an issue could not be moved to a channel
+func (h *Handler) respondMoveError(ctx context.Context, i *discordgo.InteractionCreate, err error, targetID string) {
+	switch {
+	case errors.Is(err, domain.ErrChannelNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ <#%s> is not registered. Run `/register` there first.", targetID), true)
+	case errors.Is(err, domain.ErrProjectNotInChannel):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ <#%s> does not track that project. See `/channel-admin info` there.", targetID), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to move issue. Please try again."))
+	}
+}
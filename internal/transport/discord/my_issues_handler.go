@@ -0,0 +1,224 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// myIssuesPageSize is the number of issues shown per /my-issues page
+const myIssuesPageSize = 10
+
+// myIssuesFilterAll is used in custom IDs when no role filter is applied
+const myIssuesFilterAll = "all"
+
+// myIssueEntry groups every role a user holds on a single issue
+type myIssueEntry struct {
+	issue domain.Issue
+	roles []domain.AssigneeRole
+}
+
+// handleMyIssuesCommand handles the /my-issues slash command
+func (h *Handler) handleMyIssuesCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling my-issues command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	filter := myIssuesFilterAll
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "role" {
+			filter = option.StringValue()
+		}
+	}
+
+	if !h.deferResponse(ctx, i, true) {
+		return
+	}
+
+	content, components, err := h.buildMyIssuesPage(ctx, i.GuildID, i.Member.User.ID, filter, 0)
+	if err != nil {
+		h.logger.Error("Failed to build my-issues page", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to retrieve your issues. Please try again."), true)
+		return
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content:    content,
+		Components: components,
+		Flags:      discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond to my-issues command", zap.Error(err))
+	}
+}
+
+// handleMyIssuesPageButton handles the pagination buttons of /my-issues
+func (h *Handler) handleMyIssuesPageButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	// Format: "my_issues_page_<filter>_<page>"
+	parts := strings.Split(strings.TrimPrefix(i.MessageComponentData().CustomID, "my_issues_page_"), "_")
+	if len(parts) != 2 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+
+	page, err := strconv.Atoi(parts[1])
+	if err != nil || page < 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid page"), true)
+		return
+	}
+
+	content, components, err := h.buildMyIssuesPage(ctx, i.GuildID, i.Member.User.ID, parts[0], page)
+	if err != nil {
+		h.logger.Error("Failed to build my-issues page", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to retrieve your issues. Please try again."), true)
+		return
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: components,
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update my-issues page", zap.Error(err))
+	}
+}
+
+// buildMyIssuesPage renders one page of the caller's active assignments in the guild
+func (h *Handler) buildMyIssuesPage(ctx context.Context, guildID, discordID, filter string, page int) (string, []discordgo.MessageComponent, error) {
+	assignments, err := h.issueAssigneeService.GetUserAssignmentsByDiscordID(ctx, discordID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get user assignments: %w", err)
+	}
+
+	entries := groupAssignments(assignmentsInGuild(assignments, guildID), filter)
+
+	if len(entries) == 0 {
+		if filter == myIssuesFilterAll {
+			return i18n.T(ctx, "📭 You have no active issue assignments."), []discordgo.MessageComponent{}, nil
+		}
+		return i18n.T(ctx, "📭 You have no active issue assignments as **%s**.", domain.AssigneeRole(filter).GetDisplayName()), []discordgo.MessageComponent{}, nil
+	}
+
+	totalPages := (len(entries) + myIssuesPageSize - 1) / myIssuesPageSize
+	if page >= totalPages {
+		page = totalPages - 1
+	}
+
+	start := page * myIssuesPageSize
+	end := start + myIssuesPageSize
+	if end > len(entries) {
+		end = len(entries)
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "📋 **Your active issues (%d total)**", len(entries)))
+	if filter != myIssuesFilterAll {
+		content.WriteString(i18n.T(ctx, " as %s %s", getRoleEmoji(domain.AssigneeRole(filter)), domain.AssigneeRole(filter).GetDisplayName()))
+	}
+	content.WriteString("\n\n")
+
+	now := time.Now()
+	for _, entry := range entries[start:end] {
+		var roles []string
+		for _, role := range entry.roles {
+			roles = append(roles, getRoleEmoji(role))
+		}
+
+		content.WriteString(fmt.Sprintf("%s **%s** `%s`\n", getStatusEmoji(entry.issue.Status), entry.issue.Title, entry.issue.ShortID()))
+		content.WriteString(fmt.Sprintf("   %s %s | %s %s",
+			getPriorityEmoji(entry.issue.Priority), entry.issue.Priority,
+			strings.Join(roles, ""), entry.issue.GetStatusDisplayName()))
+		if entry.issue.Project.Name != "" {
+			content.WriteString(fmt.Sprintf(" | 📁 %s", entry.issue.Project.Name))
+		}
+		if due := formatDueDate(ctx, &entry.issue, now); due != "" {
+			content.WriteString(" | " + due)
+		}
+		if entry.issue.ThreadID != "" {
+			content.WriteString(fmt.Sprintf(" | 💬 <#%s>", entry.issue.ThreadID))
+		}
+		content.WriteString("\n")
+	}
+
+	if totalPages > 1 {
+		content.WriteString(i18n.T(ctx, "\nPage %d/%d", page+1, totalPages))
+	}
+
+	return content.String(), createMyIssuesPagination(filter, page, totalPages), nil
+}
+
+// groupAssignments merges assignments per issue, skipping closed issues and roles outside the filter
+func groupAssignments(assignments []*domain.IssueAssignee, filter string) []*myIssueEntry {
+	var entries []*myIssueEntry
+	byIssue := make(map[uuid.UUID]*myIssueEntry)
+
+	for _, assignment := range assignments {
+		if assignment.Issue.IsClosed() {
+			continue
+		}
+		if filter != myIssuesFilterAll && string(assignment.Role) != filter {
+			continue
+		}
+
+		entry, ok := byIssue[assignment.IssueID]
+		if !ok {
+			entry = &myIssueEntry{issue: assignment.Issue}
+			byIssue[assignment.IssueID] = entry
+			entries = append(entries, entry)
+		}
+		entry.roles = append(entry.roles, assignment.Role)
+	}
+
+	return entries
+}
+
+// assignmentsInGuild keeps the assignments on issues posted in the guild, and on web
+// issues, so one server's issues are not listed in another
+func assignmentsInGuild(assignments []*domain.IssueAssignee, guildID string) []*domain.IssueAssignee {
+	var kept []*domain.IssueAssignee
+	for _, assignment := range assignments {
+		if assignment.Issue.Channel == nil || assignment.Issue.Channel.GuildID == guildID {
+			kept = append(kept, assignment)
+		}
+	}
+	return kept
+}
+
+// createMyIssuesPagination creates the previous/next buttons for /my-issues
+func createMyIssuesPagination(filter string, page, totalPages int) []discordgo.MessageComponent {
+	if totalPages <= 1 {
+		return []discordgo.MessageComponent{}
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				&discordgo.Button{
+					Label:    "Previous",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("my_issues_page_%s_%d", filter, page-1),
+					Disabled: page == 0,
+					Emoji:    &discordgo.ComponentEmoji{Name: "⬅️"},
+				},
+				&discordgo.Button{
+					Label:    "Next",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("my_issues_page_%s_%d", filter, page+1),
+					Disabled: page >= totalPages-1,
+					Emoji:    &discordgo.ComponentEmoji{Name: "➡️"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package discord
+
+import (
+	"context"
+
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleNotificationsCommand handles the /notifications slash command, which shows or
+// changes whether the user gets direct message notifications
+func (h *Handler) handleNotificationsCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	userID := i.Member.User.ID
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		optedOut, err := h.notificationService.IsOptedOut(ctx, userID)
+		if err != nil {
+			h.logger.Error("Failed to get notification preference", zap.Error(err), zap.String("user_id", userID))
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get your notification preference. Please try again."), true)
+			return
+		}
+		if optedOut {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "🔕 Direct message notifications are **off**. Turn them on with `/notifications enabled:True`."), true)
+		} else {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "🔔 Direct message notifications are **on**. Turn them off with `/notifications enabled:False`."), true)
+		}
+		return
+	}
+
+	enabled := options[0].BoolValue()
+	if err := h.notificationService.SetOptOut(ctx, userID, !enabled); err != nil {
+		h.logger.Error("Failed to update notification preference", zap.Error(err), zap.String("user_id", userID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update your notification preference. Please try again."), true)
+		return
+	}
+
+	if enabled {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🔔 You will get a direct message when you are assigned to an issue, when QA rejects your fix and when your issues are resolved or closed."), true)
+	} else {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🔕 You will no longer get direct message notifications."), true)
+	}
+}
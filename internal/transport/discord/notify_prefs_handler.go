@@ -0,0 +1,93 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleNotifyPrefsCommand handles the /notify-prefs slash command, which shows or changes
+// which events the user gets direct messages about
+func (h *Handler) handleNotifyPrefsCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+	userID := i.Member.User.ID
+
+	h.logger.Info("Handling notify-prefs command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", userID),
+	)
+
+	var (
+		prefs domain.NotificationPreferences
+		note  string
+		err   error
+	)
+	switch subcommand.Name {
+	case "show":
+		prefs, err = h.notificationService.GetPreferences(ctx, userID)
+	case "set":
+		event := domain.NotificationEvent(subcommand.GetOption("event").StringValue())
+		enabled := subcommand.GetOption("enabled").BoolValue()
+		prefs, err = h.notificationService.SetPreference(ctx, userID, event, enabled)
+		if enabled {
+			note = i18n.T(ctx, "🔔 Turned on: %s", event.GetDisplayName())
+		} else {
+			note = i18n.T(ctx, "🔕 Turned off: %s", event.GetDisplayName())
+		}
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidNotificationEvent):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Unknown notification event."), true)
+		default:
+			h.logger.Error("Failed to handle notify-prefs command", zap.Error(err), zap.String("user_id", userID))
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update your notification preferences. Please try again."), true)
+		}
+		return
+	}
+
+	optedOut, err := h.notificationService.IsOptedOut(ctx, userID)
+	if err != nil {
+		h.logger.Warn("Failed to get notification opt-out", zap.Error(err), zap.String("user_id", userID))
+	}
+
+	content := formatNotificationPreferences(ctx, prefs, optedOut)
+	if note != "" {
+		content = note + "\n\n" + content
+	}
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// formatNotificationPreferences renders a user's notification preferences
+func formatNotificationPreferences(ctx context.Context, prefs domain.NotificationPreferences, optedOut bool) string {
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "**Direct message notifications:**\n"))
+	for _, event := range domain.NotificationEvents {
+		icon := "🔕"
+		if prefs[event] {
+			icon = "🔔"
+		}
+		fmt.Fprintf(&b, "%s `%s` - %s\n", icon, event, event.GetDisplayName())
+	}
+	if optedOut {
+		b.WriteString(i18n.T(ctx, "\n⚠️ All direct messages are off. Turn them back on with `/notifications enabled:True`."))
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleOnCallCommand handles the /oncall slash command and its subcommands
+func (h *Handler) handleOnCallCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+
+	h.logger.Info("Handling oncall command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	// Anyone may see the rotation; changing it needs the support role
+	if subcommand.Name != "show" && !h.authorize(ctx, i, domain.PermissionManageOnCall) {
+		return
+	}
+
+	var (
+		schedule *domain.OnCallSchedule
+		note     string
+		err      error
+	)
+	switch subcommand.Name {
+	case "show":
+		schedule, err = h.onCallService.GetSchedule(ctx, i.ChannelID)
+	case "add":
+		user := subcommand.GetOption("user").UserValue(nil)
+		schedule, err = h.onCallService.AddMember(ctx, i.ChannelID, user.ID, i.Member.User.ID)
+		note = i18n.T(ctx, "✅ Added <@%s> to the on-call rotation.", user.ID)
+	case "remove":
+		user := subcommand.GetOption("user").UserValue(nil)
+		schedule, err = h.onCallService.RemoveMember(ctx, i.ChannelID, user.ID)
+		note = i18n.T(ctx, "🗑️ Removed <@%s> from the on-call rotation.", user.ID)
+	case "handoff":
+		schedule, err = h.onCallService.Handoff(ctx, i.ChannelID)
+		note = i18n.T(ctx, "🔁 Handed the rotation to the next member.")
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrOnCallScheduleNotFound):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "📟 This project has no on-call rotation yet. Add members with `/oncall add`."), true)
+		default:
+			h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the on-call rotation. Please try again."))
+		}
+		return
+	}
+
+	content := formatOnCallSchedule(ctx, schedule)
+	if note != "" {
+		content = note + "\n\n" + content
+	}
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// formatOnCallSchedule describes who is on call and the rotation order
+func formatOnCallSchedule(ctx context.Context, schedule *domain.OnCallSchedule) string {
+	rotation := schedule.Rotation()
+	if len(rotation) == 0 {
+		return i18n.T(ctx, "📟 The on-call rotation is empty. Add members with `/oncall add`.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "📟 **On call:** <@%s>", rotation[0].User.DiscordID))
+	if schedule.NextRotationAt != nil {
+		b.WriteString(i18n.T(ctx, " until <t:%d:f>", schedule.NextRotationAt.Unix()))
+	}
+	b.WriteString("\n")
+
+	if len(rotation) > 1 {
+		b.WriteString(i18n.T(ctx, "\n**Up next:**\n"))
+		for n, member := range rotation[1:] {
+			b.WriteString(fmt.Sprintf("%d. <@%s>\n", n+1, member.User.DiscordID))
+		}
+	}
+
+	b.WriteString(i18n.T(ctx, "\nNew high-priority issues are assigned to the member on call."))
+	return b.String()
+}
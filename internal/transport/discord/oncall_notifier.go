@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// OnCallNotifier sends on-call members direct messages and reports their presence
+type OnCallNotifier struct {
+	session *discordgo.Session
+	shards  *ShardManager
+	logger  *zap.Logger
+}
+
+// NewOnCallNotifier creates a new on-call notifier. Presence is only known when the
+// sessions receive presence updates, which needs the Presence intent.
+func NewOnCallNotifier(shards *ShardManager, logger *zap.Logger) *OnCallNotifier {
+	return &OnCallNotifier{
+		session: shards.Primary(),
+		shards:  shards,
+		logger:  logger,
+	}
+}
+
+// NotifyOnCallStarted tells a user their on-call turn for a project has started
+func (n *OnCallNotifier) NotifyOnCallStarted(_ context.Context, schedule *domain.OnCallSchedule, user *domain.User) error {
+	project := "a project"
+	if schedule.Project != nil {
+		project = fmt.Sprintf("**%s**", schedule.Project.Name)
+	}
+
+	content := fmt.Sprintf("📟 You are now on call for %s. New high-priority issues will be assigned to you.", project)
+	if schedule.NextRotationAt != nil {
+		content += fmt.Sprintf("\nYour turn ends <t:%d:R>.", schedule.NextRotationAt.Unix())
+	}
+
+	return sendDirectMessage(n.session, user.DiscordID, &discordgo.MessageSend{Content: content})
+}
+
+// IsAway reports whether a member of a guild is known to be offline
+func (n *OnCallNotifier) IsAway(guildID, discordID string) bool {
+	presence, err := n.shards.ForGuild(guildID).State.Presence(guildID, discordID)
+	if err != nil {
+		// Unknown presence, e.g. without the Presence intent
+		return false
+	}
+	return presence.Status == discordgo.StatusOffline || presence.Status == discordgo.StatusInvisible
+}
+
+// sendDirectMessage sends a message to a user's DM channel
+func sendDirectMessage(session *discordgo.Session, discordID string, message *discordgo.MessageSend) error {
+	channel, err := session.UserChannelCreate(discordID)
+	if err != nil {
+		return fmt.Errorf("failed to open DM channel: %w", err)
+	}
+	if _, err := session.ChannelMessageSendComplex(channel.ID, message); err != nil {
+		return fmt.Errorf("failed to send direct message: %w", err)
+	}
+	return nil
+}
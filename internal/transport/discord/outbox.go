@@ -0,0 +1,203 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// outboxGracePeriod is how long the outbox worker leaves an action to the handler that
+	// does it right after the change, well past the time such a handler may take
+	outboxGracePeriod = 2 * time.Minute
+	// outboxBatchSize limits the actions done in one run of the outbox worker
+	outboxBatchSize = 50
+	// outboxMaxAttempts is how many times the outbox worker tries an action before giving up
+	outboxMaxAttempts = 10
+	// outboxRetryBackoff is the wait before retrying a failed action, doubled after every
+	// failed attempt up to outboxMaxRetryBackoff
+	outboxRetryBackoff    = time.Minute
+	outboxMaxRetryBackoff = time.Hour
+)
+
+// errCardNotFound is returned when an issue card to update is no longer in its channel
+var errCardNotFound = errors.New("issue card not found")
+
+// outboxEvents are the events that change what an issue card or thread shows
+var outboxEvents = []domain.EventType{
+	domain.EventIssueCreated,
+	domain.EventIssueMoved,
+	domain.EventIssueStatusChanged,
+	domain.EventIssuePriorityChanged,
+	domain.EventIssueSeverityChanged,
+	domain.EventIssueEdited,
+	domain.EventAssigneeAdded,
+	domain.EventAssigneeRemoved,
+	domain.EventAssigneesSuggested,
+	domain.EventIssueComponentChanged,
+}
+
+// cardPostedLaterKey marks a context creating an issue whose card is not posted yet
+type cardPostedLaterKey struct{}
+
+// withCardPostedLater marks ctx for creating an issue whose card is posted later, such as
+// a quick report draft, so the outbox does not post it in the meantime
+func withCardPostedLater(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cardPostedLaterKey{}, true)
+}
+
+// recordOutbox records the card and thread changes an event calls for in the outbox, in
+// the transaction of the change. The handlers do them right away and remove them; the
+// outbox worker does those left after outboxGracePeriod.
+func (h *Handler) recordOutbox(ctx context.Context, event domain.Event) {
+	issue := event.Issue
+	var actions []domain.OutboxActionType
+
+	switch event.Type {
+	case domain.EventIssueCreated:
+		if issue.ChannelID != nil && ctx.Value(cardPostedLaterKey{}) == nil {
+			actions = append(actions, domain.OutboxPostCard)
+		}
+	case domain.EventIssueMoved:
+		// The card is posted again in the new channel
+		actions = append(actions, domain.OutboxPostCard)
+	default:
+		if issue.ChannelID != nil {
+			actions = append(actions, domain.OutboxUpdateCard)
+		}
+		// Threads of closed issues only change when the status does
+		changesThread := event.Type == domain.EventIssueStatusChanged ||
+			(event.Type == domain.EventIssuePriorityChanged && !issue.IsClosed())
+		if issue.ThreadID != "" && changesThread {
+			actions = append(actions, domain.OutboxSyncThread)
+		}
+	}
+
+	for _, actionType := range actions {
+		h.addOutbox(ctx, issue.ID, actionType)
+	}
+}
+
+// addOutbox records an action for an issue in the outbox, due after outboxGracePeriod
+func (h *Handler) addOutbox(ctx context.Context, issueID uuid.UUID, actionType domain.OutboxActionType) {
+	if err := h.outbox.Add(ctx, issueID, actionType, time.Now().Add(outboxGracePeriod)); err != nil {
+		h.logger.Error("Failed to record Discord action in the outbox",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("type", string(actionType)),
+		)
+	}
+}
+
+// completeOutbox removes an issue's action from the outbox once a handler did it from the
+// issue loaded at loadedAt. An action recorded since is for a newer change and stays.
+func (h *Handler) completeOutbox(ctx context.Context, issueID uuid.UUID, actionType domain.OutboxActionType, loadedAt time.Time) {
+	if err := h.outbox.Complete(ctx, issueID, actionType, loadedAt); err != nil {
+		h.logger.Warn("Failed to complete Discord action in the outbox",
+			zap.Error(err),
+			zap.String("issue_id", issueID.String()),
+			zap.String("type", string(actionType)),
+		)
+	}
+}
+
+// ProcessOutbox does the card and thread changes left in the outbox because the bot
+// stopped or Discord failed right after an issue changed. Actions are done from the
+// issue's current state. Failed actions are retried with growing waits and given up after
+// outboxMaxAttempts.
+func (h *Handler) ProcessOutbox(ctx context.Context) error {
+	actions, err := h.outbox.Due(ctx, time.Now(), outboxBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		h.processOutboxAction(ctx, action)
+	}
+	return nil
+}
+
+// processOutboxAction does a single outbox action and removes it, or reschedules it if it
+// failed
+func (h *Handler) processOutboxAction(ctx context.Context, action *domain.OutboxAction) {
+	fields := []zap.Field{
+		zap.String("issue_id", action.IssueID.String()),
+		zap.String("type", string(action.Type)),
+		zap.Int("attempts", action.Attempts+1),
+	}
+
+	loadedAt := time.Now()
+	err := h.doOutboxAction(ctx, action)
+	switch {
+	case err == nil:
+		h.logger.Debug("Outbox action done", fields...)
+		h.completeOutbox(ctx, action.IssueID, action.Type, loadedAt)
+		return
+	case errors.Is(err, errCardNotFound) || discordNotFound(err):
+		h.logger.Warn("Dropping outbox action for a card or thread that no longer exists", append(fields, zap.Error(err))...)
+		h.completeOutbox(ctx, action.IssueID, action.Type, action.UpdatedAt)
+		return
+	case action.Attempts+1 >= outboxMaxAttempts:
+		h.logger.Error("Giving up outbox action after repeated failures", append(fields, zap.Error(err))...)
+		h.completeOutbox(ctx, action.IssueID, action.Type, action.UpdatedAt)
+		return
+	}
+
+	wait := min(outboxRetryBackoff<<action.Attempts, outboxMaxRetryBackoff)
+	h.logger.Warn("Outbox action failed, retrying later", append(fields, zap.Error(err), zap.Duration("retry_in", wait))...)
+	if err := h.outbox.Retry(ctx, action.ID, time.Now().Add(wait), err.Error()); err != nil {
+		h.logger.Error("Failed to reschedule outbox action", append(fields, zap.Error(err))...)
+	}
+}
+
+// doOutboxAction brings an issue's card or thread in line with the issue. Actions whose
+// issue was deleted, or was never given a card or thread, have nothing left to do.
+func (h *Handler) doOutboxAction(ctx context.Context, action *domain.OutboxAction) error {
+	issue, err := h.issueService.GetIssue(ctx, action.IssueID)
+	if errors.Is(err, domain.ErrIssueNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch action.Type {
+	case domain.OutboxPostCard:
+		if issue.MessageID != "" || issue.Channel == nil {
+			return nil
+		}
+		_, err := h.postIssueCard(ctx, issue.ID, "")
+		return err
+	case domain.OutboxUpdateCard:
+		// A card not posted yet is posted up to date
+		if issue.MessageID == "" || issue.Channel == nil {
+			return nil
+		}
+		return h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue)
+	case domain.OutboxSyncThread:
+		if err := h.syncThread(issue); err != nil || !issue.IsClosed() {
+			return err
+		}
+		return h.archiveThread(issue)
+	default:
+		h.logger.Warn("Unknown outbox action type", zap.String("type", string(action.Type)))
+		return nil
+	}
+}
+
+// discordNotFound reports whether err is Discord answering that a message or channel does
+// not exist
+func discordNotFound(err error) bool {
+	var restErr *discordgo.RESTError
+	return errors.As(err, &restErr) && restErr.Response != nil && restErr.Response.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,75 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// guildAdminPermissions are the Discord permissions that grant the admin role
+const guildAdminPermissions = discordgo.PermissionAdministrator | discordgo.PermissionManageGuild
+
+// authorize checks that the interacting member may use the permission. If not, it
+// responds with an ephemeral denial and returns false.
+func (h *Handler) authorize(ctx context.Context, i *discordgo.InteractionCreate, permission domain.Permission) bool {
+	err := h.permissionService.Authorize(ctx, h.actorFromInteraction(i), permission)
+	if err == nil {
+		return true
+	}
+
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🚫 You don't have permission to %s. This requires the **%s** role.",
+			i18n.T(ctx, permission.GetDisplayName()), permission.RequiredRole()), true)
+		return false
+	}
+
+	h.logger.Error("Failed to check permission",
+		zap.Error(err),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("permission", string(permission)),
+	)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to check your permissions. Please try again."), true)
+	return false
+}
+
+// actorFromInteraction describes the interacting member. Role names are resolved
+// from the state cache so mappings can use either role IDs or names.
+func (h *Handler) actorFromInteraction(i *discordgo.InteractionCreate) domain.Actor {
+	actor := domain.Actor{
+		DiscordID:  i.Member.User.ID,
+		GuildID:    i.GuildID,
+		GuildAdmin: i.Member.Permissions&guildAdminPermissions != 0,
+	}
+
+	for _, roleID := range i.Member.Roles {
+		actor.DiscordRoles = append(actor.DiscordRoles, roleID)
+		if role, err := h.shards.ForGuild(i.GuildID).State.Role(i.GuildID, roleID); err == nil {
+			actor.DiscordRoles = append(actor.DiscordRoles, role.Name)
+		}
+	}
+
+	return actor
+}
+
+// actorFromReaction describes the reacting member. Reaction events carry no permissions,
+// so the member's channel permissions are looked up to detect guild admins.
+func (h *Handler) actorFromReaction(r *discordgo.MessageReactionAdd) domain.Actor {
+	actor := domain.Actor{DiscordID: r.UserID, GuildID: r.GuildID}
+	if perms, err := h.session.UserChannelPermissions(r.UserID, r.ChannelID); err == nil {
+		actor.GuildAdmin = perms&guildAdminPermissions != 0
+	}
+
+	for _, roleID := range r.Member.Roles {
+		actor.DiscordRoles = append(actor.DiscordRoles, roleID)
+		if role, err := h.shards.ForGuild(r.GuildID).State.Role(r.GuildID, roleID); err == nil {
+			actor.DiscordRoles = append(actor.DiscordRoles, role.Name)
+		}
+	}
+
+	return actor
+}
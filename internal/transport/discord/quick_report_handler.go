@@ -0,0 +1,326 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// quickReportKeyword follows the bot mention in a message that reports an issue,
+// e.g. "@FixTrackBot report: Checkout button does nothing"
+const quickReportKeyword = "report:"
+
+// Custom ID prefixes of the buttons and modal of a quick report draft
+const (
+	quickReportCompletePrefix = "quick_report_complete_"
+	quickReportDiscardPrefix  = "quick_report_discard_"
+	quickReportModalPrefix    = "quick_report_modal_"
+)
+
+// parseQuickReport returns the text of a message that mentions the bot first and then
+// says "report:". ok is false for any other message.
+func parseQuickReport(content, botID string) (text string, ok bool) {
+	content = strings.TrimSpace(content)
+
+	var rest string
+	for _, mention := range []string{"<@" + botID + ">", "<@!" + botID + ">"} {
+		if after, found := strings.CutPrefix(content, mention); found {
+			rest, ok = strings.TrimSpace(after), true
+			break
+		}
+	}
+	if !ok || len(rest) < len(quickReportKeyword) || !strings.EqualFold(rest[:len(quickReportKeyword)], quickReportKeyword) {
+		return "", false
+	}
+	return strings.TrimSpace(rest[len(quickReportKeyword):]), true
+}
+
+// handleQuickReport creates a draft issue from a "@bot report: <text>" message in a
+// registered channel and replies with the draft and buttons to complete or discard it.
+// The draft's card is only posted once its details are completed. It reports whether
+// the message was a quick report.
+func (h *Handler) handleQuickReport(ctx context.Context, m *discordgo.MessageCreate, botID string) bool {
+	text, ok := parseQuickReport(m.Content, botID)
+	if !ok {
+		return false
+	}
+	ctx = i18n.WithLocale(ctx, h.guildLocale(ctx, m.GuildID))
+
+	reply := func(content string) {
+		if _, err := h.session.ChannelMessageSendReply(m.ChannelID, content, m.Reference()); err != nil {
+			h.logger.Warn("Failed to reply to quick report", zap.Error(err), zap.String("message_id", m.ID))
+		}
+	}
+
+	if text == "" {
+		reply(i18n.T(ctx, "✏️ Write the problem after `%s`, e.g. `@%s report: Checkout button does nothing`.",
+			quickReportKeyword, h.session.State.User.Username))
+		return true
+	}
+
+	channelID, _ := h.intakeChannel(m.ChannelID)
+	if _, err := h.channelService.GetChannelRegistration(ctx, channelID); err != nil {
+		if !errors.Is(err, domain.ErrChannelNotFound) {
+			h.logger.Error("Failed to get channel registration for quick report", zap.Error(err), zap.String("channel_id", channelID))
+			reply(i18n.T(ctx, "❌ Failed to create the draft. Please try again."))
+			return true
+		}
+		reply(i18n.T(ctx, "❌ This channel is not registered for issue tracking. Use `/register` first."))
+		return true
+	}
+
+	title, description := splitMessageContent(text)
+	description = withMessageLink(description, m.GuildID, m.ChannelID, m.ID)
+
+	h.logger.Info("Creating issue from quick report",
+		zap.String("message_id", m.ID),
+		zap.String("reporter_id", m.Author.ID),
+	)
+
+	// The card of a draft is posted once the reporter completes it
+	created, err := h.issueService.CreateIssue(withCardPostedLater(ctx), title, description, "", m.Author.ID, channelID, uuid.Nil)
+	if err != nil {
+		var limited *domain.RateLimitError
+		switch {
+		case errors.As(err, &limited):
+			reply(i18n.T(ctx, "⏳ You're reporting issues too quickly. Please try again <t:%d:R>.", limited.RetryAt.Unix()))
+		case errors.Is(err, domain.ErrChannelInactive):
+			reply(i18n.T(ctx, "⏸️ This channel has been deactivated and does not accept new issues."))
+		default:
+			h.logger.Error("Failed to create issue from quick report", zap.Error(err), zap.String("message_id", m.ID))
+			reply(i18n.T(ctx, "❌ Failed to create the draft. Please try again."))
+		}
+		return true
+	}
+
+	if len(m.Attachments) > 0 {
+		if err := h.attachmentService.AddAttachments(ctx, created.ID, m.Author.ID, toIssueAttachments(m.Attachments)); err != nil {
+			h.logger.Error("Failed to store message attachments",
+				zap.Error(err),
+				zap.String("issue_id", created.ID.String()),
+			)
+		}
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, created.ID)
+	if err != nil {
+		h.logger.Error("Failed to get quick report draft", zap.Error(err), zap.String("issue_id", created.ID.String()))
+		return true
+	}
+
+	embed, _ := CreateIssueCard(issue)
+	if _, err := h.session.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
+		Content:    i18n.T(ctx, "📝 Saved draft **%s**. Complete the details to post it, or discard it.", issueDisplayName(issue)),
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: quickReportButtons(ctx, issue.ID),
+		Reference:  m.Reference(),
+	}); err != nil {
+		h.logger.Error("Failed to reply with quick report draft", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+	}
+	return true
+}
+
+// quickReportButtons builds the buttons of a quick report draft
+func quickReportButtons(ctx context.Context, issueID uuid.UUID) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    i18n.T(ctx, "Complete details"),
+					Style:    discordgo.PrimaryButton,
+					CustomID: quickReportCompletePrefix + issueID.String(),
+					Emoji:    &discordgo.ComponentEmoji{Name: "✏️"},
+				},
+				discordgo.Button{
+					Label:    "Discard",
+					Style:    discordgo.DangerButton,
+					CustomID: quickReportDiscardPrefix + issueID.String(),
+					Emoji:    &discordgo.ComponentEmoji{Name: "🗑️"},
+				},
+			},
+		},
+	}
+}
+
+// quickReportDraft loads the draft of a quick report button or modal. It responds and
+// returns false when the draft is gone, was already completed, or is not the user's to change.
+func (h *Handler) quickReportDraft(ctx context.Context, i *discordgo.InteractionCreate, idStr string) (*domain.Issue, bool) {
+	issueID, err := uuid.Parse(idStr)
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return nil, false
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			h.updateQuickReport(i, i18n.T(ctx, "🗑️ This draft no longer exists."))
+			return nil, false
+		}
+		h.logger.Error("Failed to get quick report draft", zap.Error(err), zap.String("issue_id", idStr))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return nil, false
+	}
+
+	if issue.MessageID != "" {
+		h.updateQuickReport(i, i18n.T(ctx, "✅ Issue **%s** has already been posted.", issueDisplayName(issue)))
+		return nil, false
+	}
+
+	if !h.authorizeEdit(ctx, i, issue) {
+		return nil, false
+	}
+	return issue, true
+}
+
+// handleQuickReportCompleteButton opens the issue form prefilled with a quick report draft
+func (h *Handler) handleQuickReportCompleteButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	issue, ok := h.quickReportDraft(ctx, i, strings.TrimPrefix(i.MessageComponentData().CustomID, quickReportCompletePrefix))
+	if !ok {
+		return
+	}
+
+	modal := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: quickReportModalPrefix + issue.ID.String(),
+			Title:    i18n.T(ctx, "Complete Issue %s", issue.ShortID()),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:  "title",
+							Label:     i18n.T(ctx, "Issue Title"),
+							Style:     discordgo.TextInputShort,
+							Value:     issue.Title,
+							Required:  true,
+							MaxLength: maxIssueTitleLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "description",
+							Label:       "Description",
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: i18n.T(ctx, "Describe the issue in detail..."),
+							Value:       issue.Description,
+							Required:    true,
+							MaxLength:   maxEditDescriptionLength,
+						},
+					},
+				},
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "image_url",
+							Label:       i18n.T(ctx, "Image URL (Optional)"),
+							Style:       discordgo.TextInputShort,
+							Placeholder: "https://example.com/image.png",
+							Value:       issue.ImageURL,
+							Required:    false,
+							MaxLength:   500,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, modal); err != nil {
+		h.logger.Error("Failed to respond with quick report modal", zap.Error(err))
+	}
+}
+
+// handleQuickReportModalSubmit saves the completed details of a quick report draft and
+// posts its card
+func (h *Handler) handleQuickReportModalSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.ModalSubmitData()
+	issue, ok := h.quickReportDraft(ctx, i, strings.TrimPrefix(data.CustomID, quickReportModalPrefix))
+	if !ok {
+		return
+	}
+
+	var title, description, imageURL string
+	for _, row := range data.Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			input, ok := component.(*discordgo.TextInput)
+			if !ok {
+				continue
+			}
+			switch input.CustomID {
+			case "title":
+				title = input.Value
+			case "description":
+				description = input.Value
+			case "image_url":
+				imageURL = input.Value
+			}
+		}
+	}
+
+	if _, err := h.issueService.UpdateIssueContent(ctx, issue.ID, title, description, imageURL, i.Member.User.ID); err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update issue. Please try again."))
+		return
+	}
+
+	// Posting the card can take longer than Discord waits for the response
+	h.updateQuickReport(i, i18n.T(ctx, "🔄 Posting issue..."))
+
+	h.addOutbox(ctx, issue.ID, domain.OutboxPostCard)
+	posted, err := h.postIssueCard(ctx, issue.ID, i.ChannelID)
+	if err != nil {
+		h.logger.Error("Failed to publish issue card", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		h.editInteractionResponse(ctx, i, i18n.T(ctx, "❌ Failed to post issue message."))
+		return
+	}
+	h.editInteractionResponse(ctx, i, i18n.T(ctx, "📝 <@%s> reported issue **%s**.", posted.Reporter.DiscordID, issueDisplayName(posted)))
+}
+
+// handleQuickReportDiscardButton deletes a quick report draft
+func (h *Handler) handleQuickReportDiscardButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	issue, ok := h.quickReportDraft(ctx, i, strings.TrimPrefix(i.MessageComponentData().CustomID, quickReportDiscardPrefix))
+	if !ok {
+		return
+	}
+
+	if err := h.issueService.DeleteIssue(ctx, issue.ID); err != nil && !errors.Is(err, domain.ErrIssueNotFound) {
+		h.logger.Error("Failed to discard quick report draft", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to discard the draft. Please try again."), true)
+		return
+	}
+
+	h.logger.Info("Quick report draft discarded",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("discarded_by", i.Member.User.ID),
+	)
+
+	h.updateQuickReport(i, i18n.T(ctx, "🗑️ Draft **%s** discarded.", issueDisplayName(issue)))
+}
+
+// updateQuickReport replaces a quick report draft message with a note, removing its
+// card and buttons
+func (h *Handler) updateQuickReport(i *discordgo.InteractionCreate, content string) {
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Embeds:     []*discordgo.MessageEmbed{},
+			Components: []discordgo.MessageComponent{},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to update quick report draft", zap.Error(err))
+	}
+}
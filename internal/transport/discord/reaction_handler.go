@@ -0,0 +1,138 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// triageEmojiResolve marks an issue resolved when reacted on its card
+const triageEmojiResolve = "✅"
+
+// triagePriorityEmojis maps the reactions that set an issue's priority
+var triagePriorityEmojis = map[string]domain.Priority{
+	"🔴": domain.PriorityHigh,
+	"🟡": domain.PriorityMedium,
+	"🟢": domain.PriorityLow,
+}
+
+// handleMessageReactionAdd triages an issue from reactions on its card: 🔴/🟡/🟢 set the
+// priority and ✅ resolves it. Reactions of members without the required role, and
+// reactions that cannot be applied, are removed again so the card does not mislead.
+// Other reactions may be the guild's report emoji, which turns a message into an issue.
+func (h *Handler) handleMessageReactionAdd(s *discordgo.Session, r *discordgo.MessageReactionAdd) {
+	// Ignore reactions from the bot itself and outside guilds
+	if r.UserID == s.State.User.ID || r.Member == nil || r.Member.User == nil || r.Member.User.Bot {
+		return
+	}
+
+	ctx, done := h.track(interactionTimeout)
+	defer done()
+	defer h.recoverPanic("reaction", zap.String("message_id", r.MessageID), zap.String("user_id", r.UserID))
+	ctx = domain.ContextWithActor(ctx, domain.Actor{DiscordID: r.UserID, GuildID: r.GuildID})
+
+	if !h.claimEvent(ctx, "reaction:"+r.MessageID+":"+r.UserID+":"+r.Emoji.APIName(), reactionClaimTTL) {
+		return
+	}
+
+	priority, isPriority := triagePriorityEmojis[r.Emoji.Name]
+	if !isPriority && r.Emoji.Name != triageEmojiResolve {
+		h.reportMessageByReaction(ctx, r)
+		return
+	}
+
+	issue, err := h.issueService.GetIssueByMessageID(ctx, r.MessageID)
+	if err != nil {
+		if !errors.Is(err, domain.ErrIssueNotFound) {
+			h.logger.Error("Failed to get issue for reaction", zap.Error(err), zap.String("message_id", r.MessageID))
+		}
+		return
+	}
+
+	permission := domain.PermissionSetPriority
+	if !isPriority {
+		permission = domain.PermissionResolveIssue
+	}
+	if err := h.permissionService.Authorize(ctx, h.actorFromReaction(r), permission); err != nil {
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			h.logger.Error("Failed to check permission",
+				zap.Error(err),
+				zap.String("user_id", r.UserID),
+				zap.String("permission", string(permission)),
+			)
+		}
+		h.removeReaction(r)
+		return
+	}
+
+	h.logger.Info("Handling triage reaction",
+		zap.String("user_id", r.UserID),
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("emoji", r.Emoji.Name),
+	)
+
+	ctx = i18n.WithLocale(ctx, h.guildLocale(ctx, r.GuildID))
+	if isPriority {
+		h.triagePriority(ctx, r, issue, priority)
+		return
+	}
+	h.triageResolve(ctx, r, issue)
+}
+
+// triagePriority sets the priority chosen by a reaction. Reacting with the current
+// priority changes nothing.
+func (h *Handler) triagePriority(ctx context.Context, r *discordgo.MessageReactionAdd, issue *domain.Issue, priority domain.Priority) {
+	if issue.Priority == priority {
+		return
+	}
+
+	if err := h.issueService.UpdateIssuePriority(ctx, issue.ID, priority); err != nil {
+		h.logger.Error("Failed to update issue priority from reaction",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		h.removeReaction(r)
+		return
+	}
+
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "%s Priority set to **%s** by <@%s>",
+		getPriorityEmoji(priority), strings.Title(string(priority)), r.UserID))
+}
+
+// triageResolve resolves an issue from a reaction. Issues already resolved are left
+// alone, and the reaction is removed when the workflow does not allow resolving.
+func (h *Handler) triageResolve(ctx context.Context, r *discordgo.MessageReactionAdd, issue *domain.Issue) {
+	if issue.Status == domain.StatusResolved {
+		return
+	}
+
+	if err := h.issueService.UpdateIssueStatus(ctx, issue.ID, domain.StatusResolved, r.UserID); err != nil {
+		if !errors.Is(err, domain.ErrInvalidStatusTransition) {
+			h.logger.Error("Failed to resolve issue from reaction",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		}
+		h.removeReaction(r)
+		return
+	}
+
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "%s Issue resolved by <@%s>", getStatusEmoji(domain.StatusResolved), r.UserID))
+}
+
+// removeReaction takes back a reaction that was not applied
+func (h *Handler) removeReaction(r *discordgo.MessageReactionAdd) {
+	if err := h.session.MessageReactionRemove(r.ChannelID, r.MessageID, r.Emoji.APIName(), r.UserID); err != nil {
+		h.logger.Warn("Failed to remove reaction",
+			zap.Error(err),
+			zap.String("message_id", r.MessageID),
+			zap.String("user_id", r.UserID),
+		)
+	}
+}
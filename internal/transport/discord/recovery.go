@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"context"
+
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// Panics returns the number of panics recovered in the handlers since the bot started
+func (h *Handler) Panics() uint64 {
+	return h.panics.Load()
+}
+
+// recoverPanic logs and counts a panic in handler work, such as a message or a card
+// refresh, so it does not crash the bot. It must be deferred directly.
+func (h *Handler) recoverPanic(work string, fields ...zap.Field) {
+	if r := recover(); r != nil {
+		h.logPanic(r, append(fields, zap.String("work", work))...)
+	}
+}
+
+// recoverInteraction handles a panic while handling an interaction like recoverPanic,
+// and tells the user the interaction failed so it is not left hanging
+func (h *Handler) recoverInteraction(ctx context.Context, i *discordgo.InteractionCreate, r any) {
+	h.logPanic(r,
+		zap.String("interaction", interactionName(i)),
+		zap.String("user_id", interactionUserID(i)),
+		zap.String("guild_id", i.GuildID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	// Autocomplete interactions can only be answered with choices
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		return
+	}
+
+	data := &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "❌ Something went wrong while handling that. Please try again."),
+		Flags:   discordgo.MessageFlagsEphemeral,
+	}
+	if err := h.respond(ctx, i, data); err == nil {
+		return
+	}
+
+	// The interaction was answered before the panic, so only a follow-up can still reach the user
+	if _, err := h.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: data.Content,
+		Flags:   data.Flags,
+	}); err != nil {
+		h.logger.Error("Failed to tell the user an interaction failed", zap.Error(err))
+	}
+}
+
+// logPanic logs a recovered panic with the stack of the goroutine that panicked and
+// counts it
+func (h *Handler) logPanic(r any, fields ...zap.Field) {
+	h.panics.Add(1)
+	h.logger.Error("Discord handler panicked", append(fields, zap.Any("panic", r), zap.Stack("stack"))...)
+}
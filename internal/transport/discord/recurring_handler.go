@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleRecurringCommand handles the /recurring slash command and its add, list and remove subcommands
+func (h *Handler) handleRecurringCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: add, list or remove."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling recurring command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageRecurring) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+
+	var (
+		content string
+		err     error
+	)
+	switch subcommand.Name {
+	case "add":
+		var recurring *domain.RecurringIssue
+		priority := domain.Priority(args["priority"])
+		if recurring, err = h.recurringIssueService.Add(ctx, channelID, args["schedule"], args["title"], args["description"], priority, i.Member.User.ID); err == nil {
+			content = i18n.T(ctx, "🔁 **%s** will be filed on schedule `%s`. The first one is due <t:%d:f>.",
+				recurring.Title, recurring.Schedule, recurring.NextRunAt.Unix())
+		}
+	case "list":
+		var recurring []*domain.RecurringIssue
+		if recurring, err = h.recurringIssueService.List(ctx, channelID); err == nil {
+			content = formatRecurringIssues(ctx, recurring)
+		}
+	case "remove":
+		var recurring *domain.RecurringIssue
+		if recurring, err = h.recurringIssueService.Remove(ctx, channelID, args["title"]); err == nil {
+			content = i18n.T(ctx, "🗑️ **%s** will no longer be filed. Issues already filed are kept.", recurring.Title)
+		}
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondRecurringError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// respondRecurringError explains why a recurring issue change was refused
+func (h *Handler) respondRecurringError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrRecurringIssueNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no recurring issue with that title. See `/recurring list`."), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update recurring issues. Please try again."))
+	}
+}
+
+// formatRecurringIssues describes a project's recurring issues for /recurring list
+func formatRecurringIssues(ctx context.Context, recurring []*domain.RecurringIssue) string {
+	if len(recurring) == 0 {
+		return i18n.T(ctx, "🔁 This project has no recurring issues. Add one with `/recurring add`.")
+	}
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🔁 **Recurring issues (%d/%d)**\n", len(recurring), domain.MaxRecurringIssues))
+	for _, r := range recurring {
+		b.WriteString(i18n.T(ctx, "• %s **%s**: `%s`, next <t:%d:R>", getPriorityEmoji(r.Priority), r.Title, r.Schedule, r.NextRunAt.Unix()))
+		if r.LastRunAt != nil {
+			b.WriteString(i18n.T(ctx, ", last <t:%d:R>", r.LastRunAt.Unix()))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,682 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Custom IDs of the registration wizard, followed by the ID of the /register interaction
+// that started it
+const (
+	registerPickCustomerPrefix = "register_pick_customer_"
+	registerAddCustomerPrefix  = "register_add_customer_"
+	registerCustomerFormPrefix = "register_customer_form_"
+	registerPickProjectPrefix  = "register_pick_project_"
+	registerAddProjectPrefix   = "register_add_project_"
+	registerProjectFormPrefix  = "register_project_form_"
+)
+
+// registerConfirmationAction is the action ID used for the registration confirmation buttons
+const registerConfirmationAction = "register"
+
+// registrationTTL is how long a registration wizard waits for its next step
+const registrationTTL = 15 * time.Minute
+
+// registrationDraft is a channel registration being put together in the wizard. A
+// customer or project chosen from the list has its ID set; a new one has uuid.Nil.
+type registrationDraft struct {
+	CustomerID         uuid.UUID
+	CustomerName       string
+	CustomerEmail      string
+	ProjectID          uuid.UUID
+	ProjectName        string
+	ProjectDescription string
+	StartedAt          time.Time
+}
+
+// registrationStore keeps the registration wizards in progress, keyed by the ID of the
+// interaction that started them. With a shared store, each step can be handled by
+// another replica.
+type registrationStore struct {
+	shared domain.StateStore // nil keeps wizards in this process
+
+	mu     sync.Mutex
+	drafts map[string]*registrationDraft
+}
+
+// newRegistrationStore creates an empty registration store
+func newRegistrationStore(shared domain.StateStore) *registrationStore {
+	return &registrationStore{shared: shared, drafts: make(map[string]*registrationDraft)}
+}
+
+// put stores a draft and drops drafts older than registrationTTL
+func (s *registrationStore) put(ctx context.Context, token string, draft *registrationDraft) error {
+	if s.shared != nil {
+		var value bytes.Buffer
+		if err := gob.NewEncoder(&value).Encode(draft); err != nil {
+			return fmt.Errorf("failed to encode registration: %w", err)
+		}
+		return s.shared.Put(ctx, "registration:"+token, value.Bytes(), registrationTTL)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, pending := range s.drafts {
+		if time.Since(pending.StartedAt) > registrationTTL {
+			delete(s.drafts, key)
+		}
+	}
+	s.drafts[token] = draft
+	return nil
+}
+
+// take removes and returns a draft; ok is false if it is unknown or expired
+func (s *registrationStore) take(ctx context.Context, token string) (*registrationDraft, bool, error) {
+	if s.shared != nil {
+		value, ok, err := s.shared.Take(ctx, "registration:"+token)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+
+		var draft registrationDraft
+		if err := gob.NewDecoder(bytes.NewReader(value)).Decode(&draft); err != nil {
+			return nil, false, fmt.Errorf("failed to decode registration: %w", err)
+		}
+		return &draft, true, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	draft, ok := s.drafts[token]
+	delete(s.drafts, token)
+	if !ok || time.Since(draft.StartedAt) > registrationTTL {
+		return nil, false, nil
+	}
+	return draft, true, nil
+}
+
+// handleRegisterCommand handles the /register and /init slash commands. Registering a
+// channel takes three steps in an ephemeral message: choosing the customer, among those
+// already tracked in the server or a new one, then its project, then confirming. Picking
+// existing ones rather than typing their names avoids duplicates from typos.
+func (h *Handler) handleRegisterCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling register command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	// A forum is registered from one of its posts
+	channelID, channelType := h.intakeChannel(i.ChannelID)
+	if channelType == domain.ChannelTypeForum && !h.featureService.IsEnabled(ctx, i.GuildID, domain.FeatureForumMode) {
+		h.respondToInteraction(ctx, i, featureDisabledMessage(ctx, domain.FeatureForumMode), true)
+		return
+	}
+
+	channel, err := h.channelService.GetChannelRegistration(ctx, channelID)
+	switch {
+	case err == nil:
+		h.respondToInteraction(ctx, i, alreadyRegisteredMessage(ctx, channel), true)
+		return
+	case !errors.Is(err, domain.ErrChannelNotFound):
+		h.logger.Error("Failed to check channel registration status",
+			zap.Error(err),
+			zap.String("channel_id", channelID),
+		)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to check channel registration status. Please try again."), true)
+		return
+	}
+
+	if err := h.registrations.put(ctx, i.ID, &registrationDraft{StartedAt: time.Now()}); err != nil {
+		h.logger.Error("Failed to start registration", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to register channel. Please try again."), true)
+		return
+	}
+
+	data := h.registrationCustomerStep(ctx, i.GuildID, i.ID)
+	data.Flags = discordgo.MessageFlagsEphemeral
+	if err := h.respond(ctx, i, data); err != nil {
+		h.logger.Error("Failed to respond with registration wizard", zap.Error(err))
+	}
+}
+
+// alreadyRegisteredMessage describes the registration of a channel that is registered already
+func alreadyRegisteredMessage(ctx context.Context, channel *domain.Channel) string {
+	return i18n.T(ctx, "⚠️ **Channel Already Registered**\n\n"+
+		"This channel is already registered for issue tracking:\n\n"+
+		"**Customer:** %s\n"+
+		"**Project:** %s\n"+
+		"**Registered by:** <@%s>\n"+
+		"**Registration Date:** %s\n\n"+
+		"Admins can change the registration with `/channel-admin update`, track another project here with `/channel-admin add-project`, move the channel with `/channel-admin transfer-project`, or stop new issues with `/channel-admin deactivate`.",
+		channel.Project.Customer.Name,
+		channel.Project.Name,
+		channel.RegisteredByUser.DiscordID,
+		fmt.Sprintf("<t:%d:D>", channel.CreatedAt.Unix()),
+	)
+}
+
+// registrationCustomerStep asks for the customer of the channel, listing the customers
+// of the channels already registered in the server
+func (h *Handler) registrationCustomerStep(ctx context.Context, guildID, token string) *discordgo.InteractionResponseData {
+	customers, err := h.guildCustomers(ctx, guildID)
+	if err != nil {
+		// A new customer can still be entered
+		h.logger.Warn("Failed to list the customers of the guild", zap.Error(err), zap.String("guild_id", guildID))
+	}
+
+	content := i18n.T(ctx, "📝 **Register this channel (step 1 of 3)**\n\nWhich customer are the issues reported here for?")
+	var components []discordgo.MessageComponent
+	if len(customers) > 0 {
+		options := make([]discordgo.SelectMenuOption, 0, len(customers))
+		for _, customer := range customers {
+			options = append(options, discordgo.SelectMenuOption{
+				Label:       truncateText(customer.Name, 100),
+				Value:       customer.ID.String(),
+				Description: truncateText(customer.ContactEmail, 100),
+			})
+		}
+		content += i18n.T(ctx, " Choose one already tracked in this server, or add a new one.")
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    registerPickCustomerPrefix + token,
+					Placeholder: i18n.T(ctx, "Choose a customer..."),
+					Options:     options,
+				},
+			},
+		})
+	}
+	components = append(components, registrationButtons(ctx, token, registerAddCustomerPrefix, i18n.T(ctx, "New customer")))
+
+	return &discordgo.InteractionResponseData{Content: content, Components: components}
+}
+
+// guildCustomers lists the customers of a guild by name, up to the number of options a
+// select menu takes. Customers of other servers are not offered.
+func (h *Handler) guildCustomers(ctx context.Context, guildID string) ([]*domain.Customer, error) {
+	customers, err := h.customerService.ListGuildCustomers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	if len(customers) > maxSelectOptions {
+		customers = customers[:maxSelectOptions]
+	}
+	return customers, nil
+}
+
+// registrationProjectStep asks for the project of the channel, listing the projects of
+// the chosen customer
+func (h *Handler) registrationProjectStep(ctx context.Context, draft *registrationDraft, token string) *discordgo.InteractionResponseData {
+	var projects []*domain.Project
+	if draft.CustomerID != uuid.Nil {
+		var err error
+		if projects, err = h.projectService.GetProjectsByCustomer(ctx, draft.CustomerID); err != nil {
+			h.logger.Warn("Failed to list the projects of the customer", zap.Error(err), zap.String("customer_id", draft.CustomerID.String()))
+		}
+	}
+
+	content := i18n.T(ctx, "📝 **Register this channel (step 2 of 3)**\n\n🏢 **Customer:** %s\n\nWhich project are the issues reported here about?", draft.CustomerName)
+	var components []discordgo.MessageComponent
+	if len(projects) > 0 {
+		if len(projects) > maxSelectOptions {
+			projects = projects[:maxSelectOptions]
+		}
+		options := make([]discordgo.SelectMenuOption, 0, len(projects))
+		for _, project := range projects {
+			options = append(options, discordgo.SelectMenuOption{
+				Label:       truncateText(project.Name, 100),
+				Value:       project.ID.String(),
+				Description: project.KeyPrefix,
+			})
+		}
+		content += i18n.T(ctx, " Choose one of the customer's projects, or add a new one.")
+		components = append(components, discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.SelectMenu{
+					CustomID:    registerPickProjectPrefix + token,
+					Placeholder: i18n.T(ctx, "Choose a project..."),
+					Options:     options,
+				},
+			},
+		})
+	}
+	components = append(components, registrationButtons(ctx, token, registerAddProjectPrefix, i18n.T(ctx, "New project")))
+
+	return &discordgo.InteractionResponseData{Content: content, Components: components}
+}
+
+// registrationConfirmStep shows the registration for confirmation
+func registrationConfirmStep(ctx context.Context, draft *registrationDraft, token string) *discordgo.InteractionResponseData {
+	customer := draft.CustomerName
+	if draft.CustomerID == uuid.Nil {
+		customer += i18n.T(ctx, " (new)")
+	}
+	project := draft.ProjectName
+	if draft.ProjectID == uuid.Nil {
+		project += i18n.T(ctx, " (new)")
+	}
+
+	content := i18n.T(ctx, "📝 **Register this channel (step 3 of 3)**\n\n"+
+		"🏢 **Customer:** %s\n"+
+		"📧 **Contact:** %s\n"+
+		"📋 **Project:** %s\n"+
+		"📝 **Description:** %s\n\n"+
+		"Register the channel for issue tracking?",
+		customer,
+		getDisplayValue(draft.CustomerEmail, i18n.T(ctx, "Not provided")),
+		project,
+		getDisplayValue(draft.ProjectDescription, i18n.T(ctx, "No description provided")),
+	)
+	return &discordgo.InteractionResponseData{
+		Content:    content,
+		Components: CreateConfirmationButtons(registerConfirmationAction, token),
+	}
+}
+
+// registrationButtons creates the button adding a new customer or project, next to the
+// button cancelling the registration
+func registrationButtons(ctx context.Context, token, addPrefix, addLabel string) discordgo.ActionsRow {
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    addLabel,
+				Style:    discordgo.PrimaryButton,
+				CustomID: addPrefix + token,
+				Emoji:    &discordgo.ComponentEmoji{Name: "➕"},
+			},
+			discordgo.Button{
+				Label:    i18n.T(ctx, "Cancel"),
+				Style:    discordgo.SecondaryButton,
+				CustomID: "cancel_" + registerConfirmationAction + "_" + token,
+			},
+		},
+	}
+}
+
+// takeRegistration takes the draft of a registration wizard, telling the user when it has
+// expired. The step must put it back unless it ends the wizard.
+func (h *Handler) takeRegistration(ctx context.Context, i *discordgo.InteractionCreate, token string) (*registrationDraft, bool) {
+	draft, ok, err := h.registrations.take(ctx, token)
+	if err != nil {
+		h.logger.Error("Failed to get registration", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to register channel. Please try again."), true)
+		return nil, false
+	}
+	if !ok {
+		h.updateRegistration(ctx, i, &discordgo.InteractionResponseData{
+			Content: i18n.T(ctx, "⌛ This registration has expired. Run `/register` again."),
+		})
+		return nil, false
+	}
+	return draft, true
+}
+
+// nextRegistrationStep keeps the draft for the next step and shows that step
+func (h *Handler) nextRegistrationStep(ctx context.Context, i *discordgo.InteractionCreate, token string, draft *registrationDraft, step *discordgo.InteractionResponseData) {
+	if err := h.registrations.put(ctx, token, draft); err != nil {
+		h.logger.Error("Failed to keep registration", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to register channel. Please try again."), true)
+		return
+	}
+	h.updateRegistration(ctx, i, step)
+}
+
+// updateRegistration replaces the wizard message, removing its menus and buttons unless
+// data has some
+func (h *Handler) updateRegistration(ctx context.Context, i *discordgo.InteractionCreate, data *discordgo.InteractionResponseData) {
+	if data.Components == nil {
+		data.Components = []discordgo.MessageComponent{}
+	}
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: data,
+	}); err != nil {
+		h.logger.Error("Failed to update registration wizard", zap.Error(err))
+	}
+}
+
+// handleRegisterCustomerSelection continues the registration with a customer chosen from the list
+func (h *Handler) handleRegisterCustomerSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, registerPickCustomerPrefix)
+	values := i.MessageComponentData().Values
+	if len(values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+	customerID, err := uuid.Parse(values[0])
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+
+	draft, ok := h.takeRegistration(ctx, i, token)
+	if !ok {
+		return
+	}
+
+	customer, err := h.customerService.GetCustomer(ctx, customerID)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to register channel. Please try again."))
+		h.restoreRegistration(ctx, token, draft)
+		return
+	}
+
+	draft.CustomerID = customer.ID
+	draft.CustomerName = customer.Name
+	draft.CustomerEmail = customer.ContactEmail
+	h.nextRegistrationStep(ctx, i, token, draft, h.registrationProjectStep(ctx, draft, token))
+}
+
+// handleRegisterAddCustomerButton opens the form for a new customer
+func (h *Handler) handleRegisterAddCustomerButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, registerAddCustomerPrefix)
+	h.openRegistrationForm(i, registerCustomerFormPrefix+token, i18n.T(ctx, "New Customer"), []discordgo.TextInput{
+		{
+			CustomID:    "customer_name",
+			Label:       i18n.T(ctx, "Customer/Organization Name"),
+			Style:       discordgo.TextInputShort,
+			Placeholder: i18n.T(ctx, "e.g. Acme Corporation"),
+			Required:    true,
+			MaxLength:   255,
+		},
+		{
+			CustomID:    "customer_email",
+			Label:       i18n.T(ctx, "Customer Contact Email (Optional)"),
+			Style:       discordgo.TextInputShort,
+			Placeholder: i18n.T(ctx, "e.g. contact@acme.com"),
+			Required:    false,
+			MaxLength:   255,
+		},
+	})
+}
+
+// handleRegisterCustomerFormSubmit continues the registration with the customer entered
+// in the form. A customer of that name that exists already in the guild is used instead of
+// a new one; names taken in other guilds are rejected when the channel is registered.
+func (h *Handler) handleRegisterCustomerFormSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.ModalSubmitData().CustomID, registerCustomerFormPrefix)
+	values := registrationFormValues(i)
+	name := strings.TrimSpace(values["customer_name"])
+	if name == "" {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Customer name cannot be empty."), true)
+		return
+	}
+
+	draft, ok := h.takeRegistration(ctx, i, token)
+	if !ok {
+		return
+	}
+
+	customer, err := h.customerService.GetCustomerByNameInGuild(ctx, i.GuildID, name)
+	switch {
+	case err == nil:
+		draft.CustomerID = customer.ID
+		draft.CustomerName = customer.Name
+		draft.CustomerEmail = customer.ContactEmail
+	case errors.Is(err, domain.ErrCustomerNotFound):
+		draft.CustomerID = uuid.Nil
+		draft.CustomerName = name
+		draft.CustomerEmail = strings.TrimSpace(values["customer_email"])
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to register channel. Please try again."))
+		h.restoreRegistration(ctx, token, draft)
+		return
+	}
+	draft.ProjectID = uuid.Nil
+	draft.ProjectName = ""
+	draft.ProjectDescription = ""
+
+	h.nextRegistrationStep(ctx, i, token, draft, h.registrationProjectStep(ctx, draft, token))
+}
+
+// handleRegisterProjectSelection continues the registration with a project chosen from the list
+func (h *Handler) handleRegisterProjectSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, registerPickProjectPrefix)
+	values := i.MessageComponentData().Values
+	if len(values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+	projectID, err := uuid.Parse(values[0])
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+
+	draft, ok := h.takeRegistration(ctx, i, token)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.GetProject(ctx, projectID)
+	if err == nil && project.CustomerID != draft.CustomerID {
+		err = domain.ErrProjectNotFound
+	}
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to register channel. Please try again."))
+		h.restoreRegistration(ctx, token, draft)
+		return
+	}
+
+	draft.ProjectID = project.ID
+	draft.ProjectName = project.Name
+	draft.ProjectDescription = project.Description
+	h.nextRegistrationStep(ctx, i, token, draft, registrationConfirmStep(ctx, draft, token))
+}
+
+// handleRegisterAddProjectButton opens the form for a new project
+func (h *Handler) handleRegisterAddProjectButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, registerAddProjectPrefix)
+	h.openRegistrationForm(i, registerProjectFormPrefix+token, i18n.T(ctx, "New Project"), []discordgo.TextInput{
+		{
+			CustomID:    "project_name",
+			Label:       i18n.T(ctx, "Project Name"),
+			Style:       discordgo.TextInputShort,
+			Placeholder: i18n.T(ctx, "e.g. E-commerce Platform"),
+			Required:    true,
+			MaxLength:   255,
+		},
+		{
+			CustomID:    "project_description",
+			Label:       i18n.T(ctx, "Project Description (Optional)"),
+			Style:       discordgo.TextInputParagraph,
+			Placeholder: i18n.T(ctx, "Brief description of the project..."),
+			Required:    false,
+			MaxLength:   500,
+		},
+	})
+}
+
+// handleRegisterProjectFormSubmit continues the registration with the project entered in
+// the form. A project of that name the customer has already is used instead of a new one.
+func (h *Handler) handleRegisterProjectFormSubmit(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.ModalSubmitData().CustomID, registerProjectFormPrefix)
+	values := registrationFormValues(i)
+	name := strings.TrimSpace(values["project_name"])
+	if name == "" {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Project name cannot be empty."), true)
+		return
+	}
+
+	draft, ok := h.takeRegistration(ctx, i, token)
+	if !ok {
+		return
+	}
+
+	draft.ProjectID = uuid.Nil
+	draft.ProjectName = name
+	draft.ProjectDescription = strings.TrimSpace(values["project_description"])
+	if draft.CustomerID != uuid.Nil {
+		projects, err := h.projectService.GetProjectsByCustomer(ctx, draft.CustomerID)
+		if err != nil {
+			h.logger.Warn("Failed to list the projects of the customer", zap.Error(err), zap.String("customer_id", draft.CustomerID.String()))
+		}
+		for _, project := range projects {
+			if strings.EqualFold(project.Name, name) {
+				draft.ProjectID = project.ID
+				draft.ProjectName = project.Name
+				draft.ProjectDescription = project.Description
+				break
+			}
+		}
+	}
+
+	h.nextRegistrationStep(ctx, i, token, draft, registrationConfirmStep(ctx, draft, token))
+}
+
+// handleConfirmRegisterButton registers the channel as put together in the wizard and
+// announces it in the channel
+func (h *Handler) handleConfirmRegisterButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, "confirm_"+registerConfirmationAction+"_")
+	draft, ok := h.takeRegistration(ctx, i, token)
+	if !ok {
+		return
+	}
+
+	h.logger.Info("Processing channel registration",
+		zap.String("customer_name", draft.CustomerName),
+		zap.String("customer_email", draft.CustomerEmail),
+		zap.String("project_name", draft.ProjectName),
+		zap.String("project_description", draft.ProjectDescription),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	// Register the channel through service; used in a forum post, this registers the forum
+	channelID, channelType := h.intakeChannel(i.ChannelID)
+	if channelType == domain.ChannelTypeForum && !h.featureService.IsEnabled(ctx, i.GuildID, domain.FeatureForumMode) {
+		h.updateRegistration(ctx, i, &discordgo.InteractionResponseData{Content: featureDisabledMessage(ctx, domain.FeatureForumMode)})
+		return
+	}
+	channel, err := h.channelService.RegisterChannel(ctx, channelID, draft.CustomerName, draft.CustomerEmail, draft.ProjectName, draft.ProjectDescription, i.Member.User.ID, i.Member.DisplayName(), i.GuildID, channelType)
+	if err != nil {
+		h.logger.Error("Failed to register channel", zap.Error(err))
+
+		var errorMessage string
+		switch err {
+		case domain.ErrChannelAlreadyRegistered:
+			errorMessage = i18n.T(ctx, "❌ This channel is already registered for issue tracking.")
+		case domain.ErrEmptyCustomerName:
+			errorMessage = i18n.T(ctx, "❌ Customer name cannot be empty.")
+		case domain.ErrEmptyProjectName:
+			errorMessage = i18n.T(ctx, "❌ Project name cannot be empty.")
+		default:
+			errorMessage = i18n.T(ctx, "❌ Failed to register channel. Please try again.")
+		}
+
+		h.updateRegistration(ctx, i, &discordgo.InteractionResponseData{Content: errorMessage})
+		return
+	}
+
+	h.updateRegistration(ctx, i, &discordgo.InteractionResponseData{
+		Content: i18n.T(ctx, "✅ Channel registered for **%s** / **%s**.", channel.Project.Customer.Name, channel.Project.Name),
+	})
+
+	usage := i18n.T(ctx, "You can now use the `/issue` command to create and track issues in this channel.")
+	if channel.IsForum() {
+		usage = i18n.T(ctx, "This is a forum, so every issue becomes a post tagged with its status. Use the `/issue` command in any post of the forum to report one.")
+	}
+
+	// Announce the registration to the channel
+	successContent := i18n.T(ctx, "✅ **Channel Registration Successful!**\n\n"+
+		"This channel has been registered for issue tracking:\n\n"+
+		"🏢 **Customer:** %s\n"+
+		"📧 **Contact:** %s\n"+
+		"📋 **Project:** %s\n"+
+		"📝 **Description:** %s\n"+
+		"📅 **Registered:** %s\n"+
+		"👤 **Registered by:** %s (<@%s>)\n\n"+
+		"%s\n\n"+
+		"**Available Commands:**\n"+
+		"• `/issue` - Create a new issue\n"+
+		"• `/issues` - List all issues\n"+
+		"• `/issue-status <id>` - Check issue status\n"+
+		"• `/help` - Show help information",
+		channel.Project.Customer.Name,
+		getDisplayValue(channel.Project.Customer.ContactEmail, i18n.T(ctx, "Not provided")),
+		channel.Project.Name,
+		getDisplayValue(channel.Project.Description, i18n.T(ctx, "No description provided")),
+		fmt.Sprintf("<t:%d:F>", channel.CreatedAt.Unix()),
+		getDisplayValue(channel.RegisteredByUser.Name, i18n.T(ctx, "Discord User")),
+		channel.RegisteredByUser.DiscordID,
+		usage,
+	)
+	h.sendMessage(ctx, i.ChannelID, successContent)
+
+	// Log successful registration
+	h.logger.Info("Channel registration completed successfully",
+		zap.String("registration_id", channel.ID.String()),
+		zap.String("channel_id", channelID),
+		zap.String("channel_type", channel.ChannelType),
+		zap.String("customer_name", draft.CustomerName),
+		zap.String("project_name", draft.ProjectName),
+	)
+}
+
+// handleCancelRegisterButton ends a registration wizard without registering the channel
+func (h *Handler) handleCancelRegisterButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	token := strings.TrimPrefix(i.MessageComponentData().CustomID, "cancel_"+registerConfirmationAction+"_")
+	if _, _, err := h.registrations.take(ctx, token); err != nil {
+		h.logger.Warn("Failed to discard registration", zap.Error(err))
+	}
+	h.updateRegistration(ctx, i, &discordgo.InteractionResponseData{Content: i18n.T(ctx, "Registration cancelled.")})
+}
+
+// restoreRegistration puts a draft back after a step failed, so the user can retry it
+func (h *Handler) restoreRegistration(ctx context.Context, token string, draft *registrationDraft) {
+	if err := h.registrations.put(ctx, token, draft); err != nil {
+		h.logger.Error("Failed to keep registration", zap.Error(err))
+	}
+}
+
+// openRegistrationForm opens a wizard form with one text input per row
+func (h *Handler) openRegistrationForm(i *discordgo.InteractionCreate, customID, title string, inputs []discordgo.TextInput) {
+	rows := make([]discordgo.MessageComponent, 0, len(inputs))
+	for _, input := range inputs {
+		rows = append(rows, discordgo.ActionsRow{Components: []discordgo.MessageComponent{input}})
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID:   customID,
+			Title:      title,
+			Components: rows,
+		},
+	}); err != nil {
+		h.logger.Error("Failed to open registration form", zap.Error(err))
+	}
+}
+
+// registrationFormValues returns the values of a submitted wizard form by input custom ID
+func registrationFormValues(i *discordgo.InteractionCreate) map[string]string {
+	values := make(map[string]string)
+	for _, component := range i.ModalSubmitData().Components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, field := range row.Components {
+			if input, ok := field.(*discordgo.TextInput); ok {
+				values[input.CustomID] = input.Value
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,137 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxReleaseNotesLength keeps the changelog within an embed description, leaving room for
+// the line counting the issues left out
+const maxReleaseNotesLength = 3900
+
+// handleReleaseNotesCommand handles the /release-notes slash command. The notes are posted
+// in this channel or the one picked; when they do not fit a message, or when asked for,
+// the Markdown file is attached.
+func (h *Handler) handleReleaseNotesCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	var scope domain.ReleaseNotesScope
+	targetID := i.ChannelID
+	attach := false
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "milestone":
+			scope.Milestone = option.StringValue()
+		case "from":
+			scope.From = option.StringValue()
+		case "to":
+			scope.To = option.StringValue()
+		case "channel":
+			targetID = option.ChannelValue(nil).ID
+		case "file":
+			attach = option.BoolValue()
+		}
+	}
+
+	h.logger.Info("Handling release notes command",
+		zap.String("milestone", scope.Milestone),
+		zap.String("from", scope.From),
+		zap.String("to", scope.To),
+		zap.String("target_channel_id", targetID),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionReleaseNotes) {
+		return
+	}
+
+	// Notes posted elsewhere are confirmed privately here
+	elsewhere := targetID != i.ChannelID
+	if !h.deferResponse(ctx, i, elsewhere) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	notes, err := h.releaseNotesService.Compile(ctx, channelID, scope)
+	if err != nil {
+		if errors.Is(err, domain.ErrMilestoneNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This project has no milestone with that name. See `/milestone list`."), true)
+			return
+		}
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to compile the release notes. Please try again."))
+		return
+	}
+	if len(notes.Issues) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ No closed issues match these release notes."), true)
+		return
+	}
+
+	changelog, complete := formatReleaseNotes(ctx, notes)
+	message := &discordgo.MessageSend{
+		Embeds: []*discordgo.MessageEmbed{{
+			Title:       releaseNotesTitle(ctx, notes),
+			Description: changelog,
+			Color:       0x2ecc71,
+			Footer:      &discordgo.MessageEmbedFooter{Text: i18n.T(ctx, "%s · %d issues closed", notes.Project.Name, len(notes.Issues))},
+		}},
+	}
+	if attach || !complete {
+		message.Files = []*discordgo.File{{
+			Name:        notes.File.Name,
+			ContentType: notes.File.ContentType,
+			Reader:      bytes.NewReader(notes.File.Data),
+		}}
+	}
+
+	if !elsewhere {
+		if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+			Embeds: message.Embeds,
+			Files:  message.Files,
+		}); err != nil {
+			h.logger.Error("Failed to respond with release notes", zap.Error(err))
+		}
+		return
+	}
+
+	if _, err := h.session.ChannelMessageSendComplex(targetID, message, discordgo.WithContext(ctx)); err != nil {
+		h.logger.Error("Failed to post release notes",
+			zap.Error(err),
+			zap.String("target_channel_id", targetID),
+		)
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to post the release notes in <#%s>. Check that the bot can send messages there.", targetID), true)
+		return
+	}
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🚀 Posted the release notes of %d issues in <#%s>.", len(notes.Issues), targetID), true)
+}
+
+// releaseNotesTitle titles the release notes embed after the project and the release
+func releaseNotesTitle(ctx context.Context, notes *domain.ReleaseNotes) string {
+	return truncateText(i18n.T(ctx, "🚀 Release notes: %s %s", notes.Project.Name, notes.Title()), 256)
+}
+
+// formatReleaseNotes lists the issues of release notes with their key, title and
+// resolution action, and tells whether all of them fit
+func formatReleaseNotes(ctx context.Context, notes *domain.ReleaseNotes) (string, bool) {
+	var changelog strings.Builder
+	for shown, issue := range notes.Issues {
+		line := fmt.Sprintf("• **%s** %s", issue.ShortID(), truncateText(strings.Join(strings.Fields(issue.Title), " "), 100))
+		if action := strings.Join(strings.Fields(issue.ResolutionAction), " "); action != "" {
+			line += " — " + truncateText(action, 150)
+		}
+		line += "\n"
+		if changelog.Len()+len(line) > maxReleaseNotesLength {
+			changelog.WriteString(i18n.T(ctx, "…and %d more in the attached file", len(notes.Issues)-shown))
+			return changelog.String(), false
+		}
+		changelog.WriteString(line)
+	}
+	return changelog.String(), true
+}
@@ -0,0 +1,100 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// deferResponse acknowledges an interaction before slow work so it does not miss
+// Discord's 3 second deadline. Discord shows the bot as thinking until the handler
+// answers with respondToInteraction or respond, which then edit the deferred response.
+// It returns false if the interaction could not be acknowledged.
+func (h *Handler) deferResponse(ctx context.Context, i *discordgo.InteractionCreate, ephemeral bool) bool {
+	flags := discordgo.MessageFlags(0)
+	if ephemeral {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: flags,
+		},
+	}); err != nil {
+		h.logger.Error("Failed to defer interaction response", zap.Error(err))
+		return false
+	}
+
+	h.deferred.Store(i.ID, ephemeral)
+	return true
+}
+
+// respond answers an interaction with a message, or fills in its deferred response.
+// A deferred response cannot change its visibility, so an ephemeral answer to a public
+// deferred response replaces it with an ephemeral follow-up message.
+func (h *Handler) respond(ctx context.Context, i *discordgo.InteractionCreate, data *discordgo.InteractionResponseData) error {
+	deferredEphemeral, deferred := h.deferred.Load(i.ID)
+	if !deferred {
+		return h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: data,
+		})
+	}
+
+	if data.Flags&discordgo.MessageFlagsEphemeral != 0 && !deferredEphemeral.(bool) {
+		if err := h.session.InteractionResponseDelete(i.Interaction); err != nil {
+			h.logger.Warn("Failed to delete deferred response", zap.Error(err))
+		}
+		_, err := h.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content:    data.Content,
+			Embeds:     data.Embeds,
+			Components: data.Components,
+			Files:      data.Files,
+			Flags:      discordgo.MessageFlagsEphemeral,
+		})
+		return err
+	}
+
+	edit := &discordgo.WebhookEdit{
+		Content: &data.Content,
+		Files:   data.Files,
+	}
+	if data.Embeds != nil {
+		edit.Embeds = &data.Embeds
+	}
+	if data.Components != nil {
+		edit.Components = &data.Components
+	}
+	_, err := h.session.InteractionResponseEdit(i.Interaction, edit)
+	return err
+}
+
+// respondToInteraction answers an interaction with a text message
+func (h *Handler) respondToInteraction(ctx context.Context, i *discordgo.InteractionCreate, content string, ephemeral bool) {
+	flags := discordgo.MessageFlags(0)
+	if ephemeral {
+		flags = discordgo.MessageFlagsEphemeral
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Content: content,
+		Flags:   flags,
+	}); err != nil {
+		h.logger.Error("Failed to respond to interaction", zap.Error(err))
+	}
+}
+
+// editInteractionResponse replaces the content of a response that was already sent,
+// e.g. to report the result of work started from a button
+func (h *Handler) editInteractionResponse(ctx context.Context, i *discordgo.InteractionCreate, content string) {
+	if err := h.api.call(ctx, "edit interaction response", func(opts ...discordgo.RequestOption) error {
+		_, err := h.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: &content,
+		}, opts...)
+		return err
+	}); err != nil {
+		h.logger.Error("Failed to edit interaction response", zap.Error(err))
+	}
+}
@@ -0,0 +1,297 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+const (
+	// resyncTimeout bounds a /resync run, within the 15 minutes Discord keeps an
+	// interaction response editable
+	resyncTimeout = 10 * time.Minute
+	// resyncMaxIssues limits a /resync run to the channel's most recent issues
+	resyncMaxIssues = 250
+)
+
+// resyncResult counts what a /resync run changed in Discord
+type resyncResult struct {
+	checked         int
+	cardsPosted     int
+	cardsUpdated    int
+	threadsCreated  int
+	threadsRepaired int
+	failed          int
+}
+
+// handleResyncCommand handles the /resync slash command. It rebuilds the Discord messages
+// of the channel's issues from the database: cards and threads that were deleted or never
+// posted are posted again, and cards whose content or buttons are outdated are edited.
+// Closed issues are only checked when asked to.
+func (h *Handler) handleResyncCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling resync command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionResyncChannel) {
+		return
+	}
+
+	var closed bool
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name == "closed" {
+			closed = option.BoolValue()
+		}
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+	if _, err := h.channelService.GetChannelRegistration(ctx, channelID); err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to rebuild the issue messages. Please try again."))
+		return
+	}
+
+	if _, running := h.resyncs.LoadOrStore(channelID, true); running {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "⏳ The issue messages of this channel are already being rebuilt."), true)
+		return
+	}
+
+	if !h.deferResponse(ctx, i, true) {
+		h.resyncs.Delete(channelID)
+		return
+	}
+
+	// Checking every issue can take longer than an interaction is handled, so the run
+	// continues in the background and edits the deferred response when done
+	runCtx, done := h.track(resyncTimeout)
+	runCtx = i18n.WithLocale(domain.ContextWithActor(runCtx, domain.Actor{DiscordID: i.Member.User.ID, GuildID: i.GuildID}), i18n.LocaleFrom(ctx))
+	go func() {
+		defer done()
+		defer h.resyncs.Delete(channelID)
+		defer h.recoverPanic("resync", zap.String("channel_id", channelID))
+
+		h.resyncChannel(runCtx, i, channelID, closed)
+	}()
+}
+
+// resyncChannel rebuilds the issue messages of a channel and reports the outcome in the
+// response to the interaction
+func (h *Handler) resyncChannel(ctx context.Context, i *discordgo.InteractionCreate, channelID string, closed bool) {
+	issues, err := h.issueService.ListIssuesByChannel(ctx, channelID)
+	if err != nil {
+		h.logger.Error("Failed to list issues to resync", zap.Error(err), zap.String("channel_id", channelID))
+		h.editInteractionResponse(ctx, i, i18n.T(ctx, "❌ Failed to rebuild the issue messages. Please try again."))
+		return
+	}
+
+	var result resyncResult
+	truncated := false
+	for _, listed := range issues {
+		if !closed && listed.IsClosed() {
+			continue
+		}
+		if result.checked == resyncMaxIssues {
+			truncated = true
+			break
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		result.checked++
+		if err := h.resyncIssue(ctx, listed, &result); err != nil {
+			result.failed++
+			h.logger.Warn("Failed to resync issue",
+				zap.Error(err),
+				zap.String("issue_id", listed.ID.String()),
+				zap.String("channel_id", channelID),
+			)
+		}
+	}
+
+	h.logger.Info("Resynced channel",
+		zap.String("channel_id", channelID),
+		zap.Int("checked", result.checked),
+		zap.Int("cards_posted", result.cardsPosted),
+		zap.Int("cards_updated", result.cardsUpdated),
+		zap.Int("threads_created", result.threadsCreated),
+		zap.Int("threads_repaired", result.threadsRepaired),
+		zap.Int("failed", result.failed),
+	)
+
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🔄 Checked %d issue(s): %d card(s) posted, %d card(s) updated, %d thread(s) created, %d thread(s) repaired.",
+		result.checked, result.cardsPosted, result.cardsUpdated, result.threadsCreated, result.threadsRepaired))
+	if result.failed > 0 {
+		b.WriteString(i18n.T(ctx, "\n⚠️ %d issue(s) could not be rebuilt. Run `/resync` again later.", result.failed))
+	}
+	if truncated {
+		b.WriteString(i18n.T(ctx, "\nOnly the %d most recent issues were checked.", resyncMaxIssues))
+	}
+	if ctx.Err() != nil {
+		b.WriteString(i18n.T(ctx, "\n⏱️ Stopped before checking every issue. Run `/resync` again to continue."))
+	}
+	h.editInteractionResponse(ctx, i, b.String())
+}
+
+// resyncIssue brings the card and thread of an issue in line with the database. Drafts
+// without a card are quick reports not completed yet and are left alone.
+func (h *Handler) resyncIssue(ctx context.Context, listed *domain.Issue, result *resyncResult) error {
+	loadedAt := time.Now()
+	issue, err := h.issueService.GetIssue(ctx, listed.ID)
+	if err != nil {
+		return err
+	}
+	if issue.Channel == nil || (issue.MessageID == "" && issue.Status == domain.StatusDraft) {
+		return nil
+	}
+
+	cardChannelID := issueCardChannelID(issue)
+	var card *discordgo.Message
+	if issue.MessageID != "" {
+		card, err = h.session.ChannelMessage(cardChannelID, issue.MessageID, discordgo.WithContext(ctx))
+		if err != nil && !discordNotFound(err) {
+			return fmt.Errorf("failed to get issue card: %w", err)
+		}
+	}
+
+	// A forum card starts the issue's post, so posting it again also creates the thread
+	if card == nil {
+		if _, err := h.postIssueCard(ctx, issue.ID, ""); err != nil {
+			return err
+		}
+		result.cardsPosted++
+		if issue.Channel.IsForum() {
+			return nil
+		}
+		// The thread goes on the new card
+		if issue, err = h.issueService.GetIssue(ctx, issue.ID); err != nil {
+			return err
+		}
+	} else if cardOutdated(card, issue) {
+		if err := h.doUpdateIssueCard(ctx, card, issue, cardChannelID); err != nil {
+			return err
+		}
+		h.completeOutbox(ctx, issue.ID, domain.OutboxUpdateCard, loadedAt)
+		result.cardsUpdated++
+	}
+
+	return h.resyncThread(ctx, issue, loadedAt, result)
+}
+
+// resyncThread recreates the thread of an opened issue on its card if it was deleted, and
+// renames, archives or reopens it to match the issue otherwise
+func (h *Handler) resyncThread(ctx context.Context, issue *domain.Issue, loadedAt time.Time, result *resyncResult) error {
+	if issue.Status == domain.StatusDraft {
+		return nil
+	}
+
+	if issue.ThreadID != "" {
+		thread, err := h.session.Channel(issue.ThreadID, discordgo.WithContext(ctx))
+		switch {
+		case err == nil:
+			if !threadOutdated(thread, issue) {
+				return nil
+			}
+			if err := h.syncThread(issue); err != nil {
+				return err
+			}
+			if issue.IsClosed() {
+				if err := h.archiveThread(issue); err != nil {
+					return err
+				}
+			}
+			h.completeOutbox(ctx, issue.ID, domain.OutboxSyncThread, loadedAt)
+			result.threadsRepaired++
+			return nil
+		case !discordNotFound(err):
+			return fmt.Errorf("failed to get issue thread: %w", err)
+		}
+	}
+
+	// The discussion of a closed issue is over, so only its card is restored
+	if issue.IsClosed() || issue.Channel.IsForum() {
+		return nil
+	}
+
+	thread, err := h.session.MessageThreadStart(issue.Channel.DiscordChannelID, issue.MessageID, issueThreadName(issue), threadAutoArchiveDuration(issue.Priority), discordgo.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to create issue thread: %w", err)
+	}
+	if err := h.issueService.SetThreadInfo(ctx, issue.ID, thread.ID, issue.MessageID); err != nil {
+		return err
+	}
+	result.threadsCreated++
+
+	h.sendMessage(ctx, thread.ID, i18n.T(ctx, "💬 Discussion thread for Issue **%s**, recreated by `/resync`. Earlier messages of the issue are in its history.", issueDisplayName(issue)))
+	return nil
+}
+
+// cardOutdated reports whether an issue card no longer shows the issue as its card
+// would: its closed mark, embed or buttons differ, e.g. after the workflow changed
+func cardOutdated(card *discordgo.Message, issue *domain.Issue) bool {
+	if cardContent(card.Content, issue) != card.Content || len(card.Embeds) != 1 {
+		return true
+	}
+
+	embed, components := CreateIssueCard(issue)
+	posted := card.Embeds[0]
+	if posted.Title != embed.Title || strings.TrimSpace(posted.Description) != strings.TrimSpace(embed.Description) ||
+		posted.Color != embed.Color || len(posted.Fields) != len(embed.Fields) {
+		return true
+	}
+	for n, field := range embed.Fields {
+		if posted.Fields[n].Name != field.Name || strings.TrimSpace(posted.Fields[n].Value) != strings.TrimSpace(field.Value) {
+			return true
+		}
+	}
+
+	return !sameTags(componentCustomIDs(card.Components), componentCustomIDs(components))
+}
+
+// threadOutdated reports whether an issue thread's name no longer matches the issue, or it
+// is not locked while the issue is closed or the other way round. Threads of active issues
+// archived by Discord after a quiet spell are left archived.
+func threadOutdated(thread *discordgo.Channel, issue *domain.Issue) bool {
+	if thread.Name != issueThreadName(issue) {
+		return true
+	}
+	if thread.ThreadMetadata == nil {
+		return false
+	}
+	if issue.IsClosed() {
+		return !thread.ThreadMetadata.Archived || !thread.ThreadMetadata.Locked
+	}
+	return thread.ThreadMetadata.Locked
+}
+
+// componentCustomIDs lists the custom IDs of the buttons and select menus in message
+// components, in order. Components built by the bot are values and pointers, and those
+// read from Discord are pointers.
+func componentCustomIDs(components []discordgo.MessageComponent) []string {
+	var ids []string
+	for _, component := range components {
+		switch c := component.(type) {
+		case discordgo.ActionsRow:
+			ids = append(ids, componentCustomIDs(c.Components)...)
+		case *discordgo.ActionsRow:
+			ids = append(ids, componentCustomIDs(c.Components)...)
+		case discordgo.Button:
+			ids = append(ids, c.CustomID)
+		case *discordgo.Button:
+			ids = append(ids, c.CustomID)
+		case discordgo.SelectMenu:
+			ids = append(ids, c.CustomID)
+		case *discordgo.SelectMenu:
+			ids = append(ids, c.CustomID)
+		}
+	}
+	return ids
+}
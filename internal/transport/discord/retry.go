@@ -0,0 +1,175 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxAPIRetryWait caps the wait between two attempts of a Discord API call, including
+// the wait Discord asks for when rate limiting
+const maxAPIRetryWait = 30 * time.Second
+
+// errCircuitOpen is returned without calling Discord while calls are paused after
+// failing in a row
+var errCircuitOpen = errors.New("discord API calls are paused after repeated failures")
+
+// APICaller calls the Discord REST API, retrying calls that fail transiently: rate
+// limits, 5xx responses and network errors. When calls keep failing after their retries,
+// it stops calling Discord for a while so handlers fail fast instead of piling up
+// retries against an outage.
+type APICaller struct {
+	maxAttempts      int
+	backoff          time.Duration
+	breakerThreshold int
+	breakerCooldown  time.Duration
+	logger           *zap.Logger
+
+	mu        sync.Mutex
+	failures  int       // Calls that failed transiently in a row
+	openUntil time.Time // Calls are paused until then
+	probing   bool      // A call is testing whether Discord recovered
+}
+
+// NewAPICaller creates an API caller making up to maxAttempts attempts per call, waiting
+// backoff before the first retry and doubling it after that. After breakerThreshold calls
+// in a row failed transiently, calls are paused for breakerCooldown; 0 never pauses them.
+func NewAPICaller(maxAttempts int, backoff time.Duration, breakerThreshold int, breakerCooldown time.Duration, logger *zap.Logger) *APICaller {
+	return &APICaller{
+		maxAttempts:      maxAttempts,
+		backoff:          backoff,
+		breakerThreshold: breakerThreshold,
+		breakerCooldown:  breakerCooldown,
+		logger:           logger,
+	}
+}
+
+// call runs fn, a Discord API call named op in logs, until it succeeds, fails with an
+// error a retry cannot fix, runs out of attempts or ctx is done. fn must pass opts to the
+// session method, so rate limits are returned to call instead of discordgo sleeping
+// through them without regard for ctx.
+func (c *APICaller) call(ctx context.Context, op string, fn func(opts ...discordgo.RequestOption) error) error {
+	if !c.allow() {
+		return errCircuitOpen
+	}
+
+	opts := []discordgo.RequestOption{
+		discordgo.WithContext(ctx),
+		discordgo.WithRetryOnRatelimit(false),
+	}
+	wait := c.backoff
+
+	for attempt := 1; ; attempt++ {
+		err := fn(opts...)
+		if err == nil {
+			c.record(false)
+			return nil
+		}
+		if ctx.Err() != nil {
+			c.release()
+			return err
+		}
+
+		retryAfter, transient := retryableAPIError(err)
+		if !transient {
+			// Discord answered, so it is up
+			c.record(false)
+			return err
+		}
+		if attempt >= c.maxAttempts {
+			c.record(true)
+			return err
+		}
+
+		retryAfter = min(max(retryAfter, wait), maxAPIRetryWait)
+		c.logger.Debug("Discord API call failed, retrying",
+			zap.Error(err),
+			zap.String("op", op),
+			zap.Int("attempt", attempt),
+			zap.Duration("retry_after", retryAfter),
+		)
+
+		timer := time.NewTimer(retryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			c.release()
+			return err
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// allow reports whether a call may go to Discord. Once the pause ends, a single call
+// goes through to test whether Discord recovered.
+func (c *APICaller) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.openUntil.IsZero() {
+		return true
+	}
+	if time.Now().Before(c.openUntil) || c.probing {
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+// release ends a call that was cancelled before its outcome was known
+func (c *APICaller) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.probing = false
+}
+
+// record counts the outcome of a call, pausing calls when too many failed in a row
+func (c *APICaller) record(failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probing = false
+	if !failed {
+		if !c.openUntil.IsZero() {
+			c.logger.Info("Discord API calls resumed")
+		}
+		c.failures = 0
+		c.openUntil = time.Time{}
+		return
+	}
+
+	c.failures++
+	if c.breakerThreshold > 0 && c.failures >= c.breakerThreshold {
+		c.openUntil = time.Now().Add(c.breakerCooldown)
+		c.logger.Warn("Pausing Discord API calls after repeated failures",
+			zap.Int("failures", c.failures),
+			zap.Duration("cooldown", c.breakerCooldown),
+		)
+	}
+}
+
+// retryableAPIError reports whether err is a transient Discord API failure and how long
+// Discord asked to wait before retrying, if it did
+func retryableAPIError(err error) (time.Duration, bool) {
+	var limited *discordgo.RateLimitError
+	if errors.As(err, &limited) {
+		return limited.RetryAfter, true
+	}
+
+	var restErr *discordgo.RESTError
+	if errors.As(err, &restErr) {
+		status := restErr.Response.StatusCode
+		return 0, status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+	}
+
+	// Network errors; discordgo already retried 502 responses itself
+	var urlErr *url.Error
+	return 0, errors.As(err, &urlErr)
+}
@@ -0,0 +1,193 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleSettingsCommand handles the /settings slash command and its subcommands
+func (h *Handler) handleSettingsCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+
+	h.logger.Info("Handling settings command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageSettings) {
+		return
+	}
+
+	userID := i.Member.User.ID
+	var (
+		settings *domain.GuildSettings
+		content  string
+		err      error
+	)
+	switch subcommand.Name {
+	case "show":
+		settings, err = h.guildSettingsService.GetSettings(ctx, i.GuildID)
+	case "locale":
+		locale := subcommand.GetOption("locale").StringValue()
+		settings, err = h.guildSettingsService.SetLocale(ctx, i.GuildID, locale, userID)
+		content = i18n.T(ctx, "🌐 Default locale set to `%s`.", strings.TrimSpace(locale))
+	case "timezone":
+		timezone := subcommand.GetOption("timezone").StringValue()
+		settings, err = h.guildSettingsService.SetTimezone(ctx, i.GuildID, timezone, userID)
+		content = i18n.T(ctx, "🕒 Time zone set to `%s`. Digests, stats and exports count days in it; other times show in each member's own time zone.", strings.TrimSpace(timezone))
+	case "admin-role-add":
+		role := subcommand.GetOption("role").RoleValue(nil, i.GuildID)
+		settings, err = h.guildSettingsService.AddAdminRole(ctx, i.GuildID, role.ID, userID)
+		content = i18n.T(ctx, "🛡️ Members of <@&%s> now have the admin role.", role.ID)
+	case "admin-role-remove":
+		role := subcommand.GetOption("role").RoleValue(nil, i.GuildID)
+		settings, err = h.guildSettingsService.RemoveAdminRole(ctx, i.GuildID, role.ID, userID)
+		content = i18n.T(ctx, "🛡️ <@&%s> no longer grants the admin role.", role.ID)
+	case "escalation-channel":
+		channelID := ""
+		if option := subcommand.GetOption("channel"); option != nil {
+			channelID = option.ChannelValue(nil).ID
+		}
+		settings, err = h.guildSettingsService.SetEscalationChannel(ctx, i.GuildID, channelID, userID)
+		content = i18n.T(ctx, "🚨 SLA breach alerts go to the default escalation channel.")
+		if channelID != "" {
+			content = i18n.T(ctx, "🚨 SLA breach alerts go to <#%s>.", channelID)
+		}
+	case "report-emoji":
+		emoji := ""
+		if option := subcommand.GetOption("emoji"); option != nil {
+			emoji = strings.TrimSpace(option.StringValue())
+		}
+		if _, triage := triagePriorityEmojis[emoji]; triage || emoji == triageEmojiResolve {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Triage emojis cannot be the report emoji."), true)
+			return
+		}
+		settings, err = h.guildSettingsService.SetReportEmoji(ctx, i.GuildID, emoji, userID)
+		if err == nil {
+			content = formatReportEmoji(ctx, settings)
+		}
+	case "sla":
+		priority := domain.Priority(subcommand.GetOption("priority").StringValue())
+		var policy domain.SLAPolicy
+		policy, err = parseSLAPolicy(subcommand.GetOption("response").StringValue(), subcommand.GetOption("resolution").StringValue())
+		if err == nil {
+			settings, err = h.guildSettingsService.SetSLATarget(ctx, i.GuildID, priority, policy, userID)
+			content = i18n.T(ctx, "⏱️ %s priority SLA: %s", strings.Title(string(priority)), formatSLAPolicy(ctx, policy))
+		}
+	case "sla-clear":
+		priority := domain.Priority(subcommand.GetOption("priority").StringValue())
+		settings, err = h.guildSettingsService.ClearSLATarget(ctx, i.GuildID, priority, userID)
+		content = i18n.T(ctx, "⏱️ %s priority uses the default SLA targets again.", strings.Title(string(priority)))
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnsupportedLocale):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ The bot does not speak that language yet. Supported locales: %s", strings.Join(i18n.Locales(), ", ")), true)
+		default:
+			h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the server settings. Please try again."))
+		}
+		return
+	}
+
+	if content == "" {
+		content = formatGuildSettings(ctx, settings)
+	}
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// parseSLAPolicy parses response and resolution targets such as "4h" or "90m"
+func parseSLAPolicy(response, resolution string) (domain.SLAPolicy, error) {
+	responseTarget, err := time.ParseDuration(strings.TrimSpace(response))
+	if err != nil {
+		return domain.SLAPolicy{}, domain.ErrInvalidSLATarget
+	}
+	resolutionTarget, err := time.ParseDuration(strings.TrimSpace(resolution))
+	if err != nil {
+		return domain.SLAPolicy{}, domain.ErrInvalidSLATarget
+	}
+	return domain.SLAPolicy{Response: responseTarget, Resolution: resolutionTarget}, nil
+}
+
+// formatSLAPolicy describes the targets of an SLA policy
+func formatSLAPolicy(ctx context.Context, policy domain.SLAPolicy) string {
+	format := func(target time.Duration) string {
+		if target <= 0 {
+			return i18n.T(ctx, "off")
+		}
+		return target.String()
+	}
+	return i18n.T(ctx, "response %s, resolution %s", format(policy.Response), format(policy.Resolution))
+}
+
+// formatReportEmoji describes how members report a message as an issue by reaction
+func formatReportEmoji(ctx context.Context, settings *domain.GuildSettings) string {
+	emoji := settings.GetReportEmoji()
+	switch {
+	case emoji == "":
+		return i18n.T(ctx, "🚩 Report emoji: off")
+	case strings.Contains(emoji, ":"):
+		// Custom emojis are stored as name:id
+		return i18n.T(ctx, "🚩 Report emoji: <:%s>", emoji)
+	default:
+		return i18n.T(ctx, "🚩 Report emoji: %s", emoji)
+	}
+}
+
+// formatGuildSettings describes a guild's settings
+func formatGuildSettings(ctx context.Context, settings *domain.GuildSettings) string {
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "⚙️ **Server settings**\n"))
+	b.WriteString(i18n.T(ctx, "🌐 Locale: `%s`\n", settings.GetLocale()))
+	b.WriteString(i18n.T(ctx, "🕒 Time zone: `%s`\n", settings.Location(time.UTC)))
+
+	if len(settings.AdminRoleIDs) > 0 {
+		roles := make([]string, 0, len(settings.AdminRoleIDs))
+		for _, id := range settings.AdminRoleIDs {
+			roles = append(roles, fmt.Sprintf("<@&%s>", id))
+		}
+		b.WriteString(i18n.T(ctx, "🛡️ Admin roles: %s\n", strings.Join(roles, " ")))
+	} else {
+		b.WriteString(i18n.T(ctx, "🛡️ Admin roles: none (Administrator and Manage Server still grant admin)\n"))
+	}
+
+	if settings.EscalationChannelID != "" {
+		b.WriteString(i18n.T(ctx, "🚨 Escalation channel: <#%s>\n", settings.EscalationChannelID))
+	} else {
+		b.WriteString(i18n.T(ctx, "🚨 Escalation channel: default\n"))
+	}
+
+	b.WriteString(formatReportEmoji(ctx, settings) + "\n")
+
+	b.WriteString(i18n.T(ctx, "⏱️ SLA targets:"))
+	if len(settings.SLATargets) == 0 {
+		b.WriteString(i18n.T(ctx, " default\n"))
+		return b.String()
+	}
+	b.WriteString("\n")
+	for _, priority := range []domain.Priority{domain.PriorityHigh, domain.PriorityMedium, domain.PriorityLow} {
+		if policy, ok := settings.SLATargets[priority]; ok {
+			b.WriteString(fmt.Sprintf("• %s %s: %s\n", getPriorityEmoji(priority), strings.Title(string(priority)), formatSLAPolicy(ctx, policy)))
+		}
+	}
+	return b.String()
+}
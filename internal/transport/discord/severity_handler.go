@@ -0,0 +1,178 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// severitySelectPrefix starts the custom ID of the select menu setting the severity of an issue
+const severitySelectPrefix = "issue_severity_"
+
+// severityClear is the /severity choice that removes the severity of an issue
+const severityClear = "clear"
+
+// handleSeverityCommand handles the /severity slash command
+func (h *Handler) handleSeverityCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	args := make(map[string]string)
+	for _, option := range i.ApplicationCommandData().Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling severity command",
+		zap.String("issue_id", args["id"]),
+		zap.String("level", args["level"]),
+		zap.String("user_id", i.Member.User.ID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionSetSeverity) {
+		return
+	}
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, args["id"])
+	if !ok {
+		return
+	}
+
+	severity := domain.Severity(args["level"])
+	if severity == severityClear {
+		severity = ""
+	}
+
+	h.setIssueSeverity(ctx, i, issue, severity, func(content string) {
+		h.respondToInteraction(ctx, i, content, true)
+	})
+}
+
+// handleSeveritySelection sets the severity picked in an issue thread's select menu
+func (h *Handler) handleSeveritySelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	data := i.MessageComponentData()
+	if len(data.Values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "No severity selected"), true)
+		return
+	}
+
+	// Format: "issue_severity_<issue uuid>"
+	issueID, err := uuid.Parse(strings.TrimPrefix(data.CustomID, severitySelectPrefix))
+	if err != nil {
+		h.logger.Error("Invalid issue ID in severity selector", zap.Error(err), zap.String("custom_id", data.CustomID))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid issue ID"), true)
+		return
+	}
+
+	if !h.authorize(ctx, i, domain.PermissionSetSeverity) {
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	// The select menu is replaced by the outcome; /severity changes it later
+	h.setIssueSeverity(ctx, i, issue, domain.Severity(data.Values[0]), func(content string) {
+		if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    content,
+				Components: []discordgo.MessageComponent{},
+			},
+		}); err != nil {
+			h.logger.Error("Failed to update severity selector", zap.Error(err))
+		}
+	})
+}
+
+// setIssueSeverity sets the severity of an issue, or removes it for an empty severity,
+// answers with reply and shows the change on the issue's card
+func (h *Handler) setIssueSeverity(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue, severity domain.Severity, reply func(string)) {
+	if issue.Severity == severity {
+		if severity == "" {
+			reply(i18n.T(ctx, "ℹ️ **%s** has no severity.", issueDisplayName(issue)))
+		} else {
+			reply(i18n.T(ctx, "ℹ️ **%s** already has severity **%s**.", issueDisplayName(issue), severity.GetDisplayName()))
+		}
+		return
+	}
+
+	if err := h.issueService.UpdateIssueSeverity(ctx, issue.ID, severity); err != nil {
+		if errors.Is(err, domain.ErrInvalidSeverity) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Invalid severity. Choose S1, S2, S3 or S4."), true)
+			return
+		}
+		h.logger.Error("Failed to update issue severity",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("severity", string(severity)),
+		)
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update severity. Please try again."))
+		return
+	}
+
+	userID := i.Member.User.ID
+	if severity == "" {
+		reply(i18n.T(ctx, "✅ Removed the severity of **%s**.", issueDisplayName(issue)))
+		h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "⚪ Severity removed by <@%s>", userID))
+		return
+	}
+
+	reply(i18n.T(ctx, "✅ Severity of **%s** set to %s **%s**", issueDisplayName(issue), getSeverityEmoji(severity), severity.GetDisplayName()))
+	h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "%s Severity set to **%s** by <@%s>", getSeverityEmoji(severity), severity.GetDisplayName(), userID))
+}
+
+// sendSeveritySelector posts the select menu setting the severity of an issue in its thread
+func (h *Handler) sendSeveritySelector(ctx context.Context, channelID string, issue *domain.Issue) {
+	if issue.Severity != "" {
+		return
+	}
+
+	options := make([]discordgo.SelectMenuOption, 0, len(domain.Severities))
+	for _, severity := range domain.Severities {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       severity.GetDisplayName(),
+			Value:       string(severity),
+			Description: severityDescription(ctx, severity),
+			Emoji:       &discordgo.ComponentEmoji{Name: getSeverityEmoji(severity)},
+		})
+	}
+
+	if _, err := h.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content: i18n.T(ctx, "🚨 **Set Issue Severity** (incidents only):"),
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.SelectMenu{
+						CustomID:    severitySelectPrefix + issue.ID.String(),
+						Placeholder: i18n.T(ctx, "Select severity"),
+						Options:     options,
+					},
+				},
+			},
+		},
+	}); err != nil {
+		h.logger.Error("Failed to send severity selector", zap.Error(err))
+	}
+}
+
+// severityDescription explains the customer impact a severity stands for
+func severityDescription(ctx context.Context, severity domain.Severity) string {
+	switch severity {
+	case domain.SeverityS1:
+		return i18n.T(ctx, "Service down or data lost for many customers")
+	case domain.SeverityS2:
+		return i18n.T(ctx, "Major feature broken without a workaround")
+	case domain.SeverityS3:
+		return i18n.T(ctx, "Feature impaired, with a workaround")
+	default:
+		return i18n.T(ctx, "Cosmetic or minor inconvenience")
+	}
+}
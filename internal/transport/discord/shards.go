@@ -0,0 +1,198 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// identifyInterval is how long Discord wants between gateway identifies of the same bucket
+const identifyInterval = 5 * time.Second
+
+// ShardManager runs a gateway session for each shard of the bot handled by this process.
+// Discord sends a guild's events to shard (guild ID >> 22) % shard count only; REST
+// calls work on any session and go through the primary one.
+type ShardManager struct {
+	sessions       []*discordgo.Session // One per shard ID handled here, in shard ID order
+	count          int
+	maxConcurrency int // Shards that may identify at once
+	logger         *zap.Logger
+}
+
+// NewShardManager creates the sessions of a bot. A shard count of 0 uses the number of
+// shards Discord recommends for the bot; empty shardIDs runs every shard in this process.
+func NewShardManager(token string, intents discordgo.Intent, count int, shardIDs []int, logger *zap.Logger) (*ShardManager, error) {
+	newSession := func() (*discordgo.Session, error) {
+		session, err := discordgo.New("Bot " + token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Discord session: %w", err)
+		}
+		session.Identify.Intents = intents
+		return session, nil
+	}
+
+	first, err := newSession()
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrency := 1
+	if count == 0 {
+		gateway, err := first.GatewayBot()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the recommended shard count: %w", err)
+		}
+		count = max(gateway.Shards, 1)
+		maxConcurrency = max(gateway.SessionStartLimit.MaxConcurrency, 1)
+		logger.Info("Using the shard count recommended by Discord", zap.Int("shard_count", count))
+	}
+
+	if len(shardIDs) == 0 {
+		shardIDs = make([]int, count)
+		for id := range shardIDs {
+			shardIDs[id] = id
+		}
+	}
+	shardIDs = slices.Sorted(slices.Values(shardIDs))
+
+	m := &ShardManager{count: count, maxConcurrency: maxConcurrency, logger: logger}
+	for n, id := range shardIDs {
+		if id < 0 || id >= count {
+			return nil, fmt.Errorf("shard ID %d is not below the shard count %d", id, count)
+		}
+
+		session := first
+		if n > 0 {
+			if session, err = newSession(); err != nil {
+				return nil, err
+			}
+		}
+		// A single shard identifies without sharding, as the bot did before it had shards
+		if count > 1 {
+			session.ShardID = id
+			session.ShardCount = count
+		}
+		m.sessions = append(m.sessions, session)
+	}
+	return m, nil
+}
+
+// Primary returns the session of the lowest shard handled here, for REST calls
+func (m *ShardManager) Primary() *discordgo.Session {
+	return m.sessions[0]
+}
+
+// Sessions returns the sessions of the shards handled here
+func (m *ShardManager) Sessions() []*discordgo.Session {
+	return m.sessions
+}
+
+// HasShard checks if this process handles the shard with the given ID
+func (m *ShardManager) HasShard(id int) bool {
+	for _, session := range m.sessions {
+		if session.ShardID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// ForGuild returns the session of the shard receiving a guild's events. Guilds of shards
+// handled by other processes, and unparsable IDs, get the primary session.
+func (m *ShardManager) ForGuild(guildID string) *discordgo.Session {
+	id, err := strconv.ParseUint(guildID, 10, 64)
+	if err != nil || m.count <= 1 {
+		return m.Primary()
+	}
+
+	shard := int((id >> 22) % uint64(m.count))
+	for _, session := range m.sessions {
+		if session.ShardID == shard {
+			return session
+		}
+	}
+	return m.Primary()
+}
+
+// Channel looks a channel up in the state of the shards handled here
+func (m *ShardManager) Channel(channelID string) (*discordgo.Channel, error) {
+	for _, session := range m.sessions {
+		if ch, err := session.State.Channel(channelID); err == nil {
+			return ch, nil
+		}
+	}
+	return nil, discordgo.ErrStateNotFound
+}
+
+// Guilds returns the guilds of the shards handled here
+func (m *ShardManager) Guilds() []*discordgo.Guild {
+	var guilds []*discordgo.Guild
+	for _, session := range m.sessions {
+		guilds = append(guilds, session.State.Guilds...)
+	}
+	return guilds
+}
+
+// AddHandler registers an event handler on every shard
+func (m *ShardManager) AddHandler(handler interface{}) {
+	for _, session := range m.sessions {
+		session.AddHandler(handler)
+	}
+}
+
+// Open connects the shards to the gateway, waiting between identifies as Discord
+// requires. Privileged intents refused for the first shard are left out for the
+// others too (see OpenSession).
+func (m *ShardManager) Open() error {
+	for n, session := range m.sessions {
+		if n > 0 {
+			session.Identify.Intents = m.sessions[0].Identify.Intents
+			if n%m.maxConcurrency == 0 {
+				time.Sleep(identifyInterval)
+			}
+		}
+
+		if err := OpenSession(session, m.logger); err != nil {
+			for _, opened := range m.sessions[:n] {
+				opened.Close()
+			}
+			return fmt.Errorf("failed to open shard %d: %w", session.ShardID, err)
+		}
+		if m.count > 1 {
+			m.logger.Info("Shard connected",
+				zap.Int("shard_id", session.ShardID),
+				zap.Int("shard_count", m.count),
+			)
+		}
+	}
+	return nil
+}
+
+// Close disconnects every shard
+func (m *ShardManager) Close() error {
+	var errs []error
+	for _, session := range m.sessions {
+		if err := session.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("shard %d: %w", session.ShardID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Rotate switches the shards to a new bot token, e.g. after it was reset in the Discord
+// developer portal, and reconnects them with it
+func (m *ShardManager) Rotate(token string) error {
+	if err := m.Close(); err != nil {
+		m.logger.Warn("Failed to disconnect shards before rotating the token", zap.Error(err))
+	}
+	for _, session := range m.sessions {
+		session.Token = "Bot " + token
+		session.Identify.Token = session.Token
+	}
+	return m.Open()
+}
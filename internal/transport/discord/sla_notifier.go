@@ -0,0 +1,162 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// SLANotifier posts SLA alerts to issue threads and an escalation channel
+type SLANotifier struct {
+	session             *discordgo.Session
+	escalationChannelID string
+	guildSettings       domain.GuildSettingsService
+	logger              *zap.Logger
+}
+
+// NewSLANotifier creates a new SLA notifier. escalationChannelID may be empty; guilds
+// can choose their own escalation channel in their settings.
+func NewSLANotifier(session *discordgo.Session, escalationChannelID string, guildSettings domain.GuildSettingsService, logger *zap.Logger) *SLANotifier {
+	return &SLANotifier{
+		session:             session,
+		escalationChannelID: escalationChannelID,
+		guildSettings:       guildSettings,
+		logger:              logger,
+	}
+}
+
+// NotifySLA posts the alert to the issue thread (or channel) and, for breaches, to the escalation channel
+func (n *SLANotifier) NotifySLA(ctx context.Context, issue *domain.Issue, alert *domain.SLAAlert) error {
+	embed := CreateSLAAlertEmbed(issue, alert)
+	mentions := assigneeMentions(issue)
+
+	targetID := issue.ThreadID
+	if targetID == "" && issue.Channel != nil {
+		targetID = issue.Channel.DiscordChannelID
+	}
+
+	delivered := false
+	if targetID != "" {
+		if _, err := n.session.ChannelMessageSendComplex(targetID, &discordgo.MessageSend{
+			Content: mentions,
+			Embeds:  []*discordgo.MessageEmbed{embed},
+		}); err != nil {
+			n.logger.Error("Failed to post SLA alert to issue thread",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		} else {
+			delivered = true
+		}
+	}
+
+	escalationChannelID := n.escalationChannel(ctx, issue)
+	if alert.Level == domain.SLALevelBreached && escalationChannelID != "" {
+		if _, err := n.session.ChannelMessageSendComplex(escalationChannelID, &discordgo.MessageSend{
+			Embeds: []*discordgo.MessageEmbed{embed},
+		}); err != nil {
+			n.logger.Error("Failed to post SLA alert to escalation channel",
+				zap.Error(err),
+				zap.String("issue_id", issue.ID.String()),
+			)
+		} else {
+			delivered = true
+		}
+	}
+
+	if !delivered {
+		return fmt.Errorf("no destination accepted SLA alert for issue %s", issue.ID)
+	}
+
+	return nil
+}
+
+// escalationChannel returns the escalation channel of the issue's guild, or the configured one
+func (n *SLANotifier) escalationChannel(ctx context.Context, issue *domain.Issue) string {
+	if issue.Channel == nil {
+		return n.escalationChannelID
+	}
+
+	settings, err := n.guildSettings.GetSettings(ctx, issue.Channel.GuildID)
+	if err != nil {
+		n.logger.Error("Failed to get guild settings for SLA escalation",
+			zap.Error(err),
+			zap.String("guild_id", issue.Channel.GuildID),
+		)
+		return n.escalationChannelID
+	}
+	if settings.EscalationChannelID != "" {
+		return settings.EscalationChannelID
+	}
+	return n.escalationChannelID
+}
+
+// CreateSLAAlertEmbed creates an embed describing an SLA warning or breach
+func CreateSLAAlertEmbed(issue *domain.Issue, alert *domain.SLAAlert) *discordgo.MessageEmbed {
+	title := fmt.Sprintf("⏰ %s SLA at risk: %s", alert.Kind.GetDisplayName(), issue.Title)
+	color := 0xf39c12
+	if alert.Level == domain.SLALevelBreached {
+		title = fmt.Sprintf("🚨 %s SLA breached: %s", alert.Kind.GetDisplayName(), issue.Title)
+		color = 0xe74c3c
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: title,
+		Color: color,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "Due",
+				Value:  fmt.Sprintf("<t:%d:R>", alert.DueAt.Unix()),
+				Inline: true,
+			},
+			{
+				Name:   "Status",
+				Value:  fmt.Sprintf("%s %s", getStatusEmoji(issue.Status), issue.GetStatusDisplayName()),
+				Inline: true,
+			},
+			{
+				Name:   "Priority",
+				Value:  fmt.Sprintf("%s %s", getPriorityEmoji(issue.Priority), string(issue.Priority)),
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Issue %s", issue.ID.String()),
+		},
+	}
+
+	// Incidents are held to the targets of their severity, so show it as well
+	if issue.Severity != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "Severity",
+			Value:  fmt.Sprintf("%s %s", getSeverityEmoji(issue.Severity), issue.Severity.GetDisplayName()),
+			Inline: true,
+		})
+	}
+
+	if issue.ThreadID != "" {
+		embed.Description = fmt.Sprintf("💬 <#%s>", issue.ThreadID)
+	}
+
+	return embed
+}
+
+// assigneeMentions pings everyone assigned to the issue
+func assigneeMentions(issue *domain.Issue) string {
+	var mentions []string
+	seen := make(map[string]bool)
+	for _, assignee := range issue.Assignees {
+		id := assignee.User.DiscordID
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		mentions = append(mentions, fmt.Sprintf("<@%s>", id))
+	}
+	return strings.Join(mentions, " ")
+}
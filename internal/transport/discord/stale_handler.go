@@ -0,0 +1,96 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// maxStaleDays is the largest threshold /stale set accepts
+const maxStaleDays = domain.MaxStaleDays
+
+// minStaleDays is the smallest threshold /stale set accepts; the command option needs its address
+var minStaleDays = 0.0
+
+// handleStaleCommand handles the /stale slash command and its show, set and reset subcommands
+func (h *Handler) handleStaleCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: show, set or reset."), true)
+		return
+	}
+
+	subcommand := options[0]
+	h.logger.Info("Handling stale command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageStale) {
+		return
+	}
+
+	channelID, _ := h.intakeChannel(i.ChannelID)
+
+	var (
+		policy domain.StalePolicy
+		prefix string
+		err    error
+	)
+	switch subcommand.Name {
+	case "show":
+		policy, err = h.staleIssueService.GetPolicy(ctx, channelID)
+		prefix = i18n.T(ctx, "💤 Stale issues in this project:")
+	case "set":
+		var afterDays int
+		var closeAfterDays *int
+		for _, option := range subcommand.Options {
+			days := int(option.IntValue())
+			switch option.Name {
+			case "after":
+				afterDays = days
+			case "close_after":
+				closeAfterDays = &days
+			}
+		}
+		policy, err = h.staleIssueService.SetPolicy(ctx, channelID, afterDays, closeAfterDays)
+		prefix = i18n.T(ctx, "✅ Stale issue thresholds updated:")
+	case "reset":
+		policy, err = h.staleIssueService.ResetPolicy(ctx, channelID)
+		prefix = i18n.T(ctx, "✅ Stale issue thresholds reset to the defaults:")
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondStaleError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, fmt.Sprintf("%s %s", prefix, formatStalePolicy(ctx, policy)), true)
+}
+
+// respondStaleError explains why a stale threshold change was refused
+func (h *Handler) respondStaleError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update stale issue thresholds. Please try again."))
+}
+
+// formatStalePolicy describes stale thresholds for /stale
+func formatStalePolicy(ctx context.Context, policy domain.StalePolicy) string {
+	if !policy.IsEnabled() {
+		return i18n.T(ctx, "assignees are not nudged.")
+	}
+
+	description := i18n.T(ctx, "assignees are nudged after %s without a status change or thread comment", formatStaleDuration(policy.After))
+	if policy.CloseAfter > 0 {
+		return i18n.T(ctx, "%s, and the issue is closed if it stays idle for another %s.", description, formatStaleDuration(policy.CloseAfter))
+	}
+	return description + i18n.T(ctx, "; issues are never closed automatically.")
+}
@@ -0,0 +1,98 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// StaleIssueNotifier posts stale issue nudges and closing notices to issue threads
+type StaleIssueNotifier struct {
+	session *discordgo.Session
+	logger  *zap.Logger
+}
+
+// NewStaleIssueNotifier creates a new stale issue notifier
+func NewStaleIssueNotifier(session *discordgo.Session, logger *zap.Logger) *StaleIssueNotifier {
+	return &StaleIssueNotifier{
+		session: session,
+		logger:  logger,
+	}
+}
+
+// NotifyStale asks the assignees of an idle issue for an update
+func (n *StaleIssueNotifier) NotifyStale(ctx context.Context, issue *domain.Issue, policy domain.StalePolicy) error {
+	description := fmt.Sprintf("Nothing has happened on this issue for %s. Please post an update or change its status.",
+		formatStaleDuration(policy.After))
+	if policy.CloseAfter > 0 {
+		description += fmt.Sprintf("\nIt will be closed automatically if it stays idle for another %s.",
+			formatStaleDuration(policy.CloseAfter))
+	}
+
+	return n.post(issue, &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("💤 Stale issue: %s", issue.Title),
+		Description: description,
+		Color:       0x95a5a6,
+		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Issue %s", issue.ID.String())},
+	})
+}
+
+// NotifyStaleClosed tells the assignees of an issue that it is being closed for staying idle
+func (n *StaleIssueNotifier) NotifyStaleClosed(ctx context.Context, issue *domain.Issue, policy domain.StalePolicy) error {
+	return n.post(issue, &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🔒 Closing stale issue: %s", issue.Title),
+		Description: fmt.Sprintf("This issue is being closed after %s without activity since its reminder. Reopen it if it still needs work.",
+			formatStaleDuration(policy.CloseAfter)),
+		Color:  0x95a5a6,
+		Footer: &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("Issue %s", issue.ID.String())},
+	})
+}
+
+// post sends an embed mentioning the assignees to the issue thread
+func (n *StaleIssueNotifier) post(issue *domain.Issue, embed *discordgo.MessageEmbed) error {
+	if err := postIssueNotice(n.session, issue, embed); err != nil {
+		n.logger.Error("Failed to post stale issue notice",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+		)
+		return fmt.Errorf("failed to post stale issue notice: %w", err)
+	}
+
+	return nil
+}
+
+// postIssueNotice sends an embed mentioning the assignees to the issue thread, or its
+// channel when the issue has no thread
+func postIssueNotice(session *discordgo.Session, issue *domain.Issue, embed *discordgo.MessageEmbed) error {
+	targetID := issue.ThreadID
+	if targetID == "" && issue.Channel != nil {
+		targetID = issue.Channel.DiscordChannelID
+	}
+	if targetID == "" {
+		return fmt.Errorf("issue %s has no thread or channel to post to", issue.ID)
+	}
+
+	_, err := session.ChannelMessageSendComplex(targetID, &discordgo.MessageSend{
+		Content: assigneeMentions(issue),
+		Embeds:  []*discordgo.MessageEmbed{embed},
+	})
+	return err
+}
+
+// formatStaleDuration formats a stale threshold, in days when it is a whole number of days
+func formatStaleDuration(d time.Duration) string {
+	day := 24 * time.Hour
+	switch {
+	case d == day:
+		return "1 day"
+	case d > 0 && d%day == 0:
+		return fmt.Sprintf("%d days", d/day)
+	default:
+		return formatDuration(d)
+	}
+}
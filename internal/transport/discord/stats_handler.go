@@ -0,0 +1,278 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// statsRangeSelectID is the custom ID of the /stats range select menu, followed by
+// "_<component ID>" when the stats only count the issues of a component
+const statsRangeSelectID = "stats_range"
+
+// maxStatsAssignees limits how many assignees the workload field lists
+const maxStatsAssignees = 10
+
+// handleStatsCommand handles the /stats slash command
+func (h *Handler) handleStatsCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling stats command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	var componentID uuid.UUID
+	for _, option := range i.ApplicationCommandData().Options {
+		if option.Name != "component" {
+			continue
+		}
+		channelID, _ := h.intakeChannel(i.ChannelID)
+		component, err := h.componentService.GetByName(ctx, channelID, option.StringValue())
+		if err != nil {
+			h.respondComponentError(ctx, i, err)
+			return
+		}
+		componentID = component.ID
+	}
+
+	if !h.deferResponse(ctx, i, false) {
+		return
+	}
+
+	data, ok := h.buildStatsResponse(ctx, i, domain.DefaultStatsRangeDays, componentID)
+	if !ok {
+		return
+	}
+
+	if err := h.respond(ctx, i, data); err != nil {
+		h.logger.Error("Failed to respond to stats command", zap.Error(err))
+	}
+}
+
+// handleStatsRangeSelection handles a range change in the /stats select menu
+func (h *Handler) handleStatsRangeSelection(ctx context.Context, i *discordgo.InteractionCreate) {
+	values := i.MessageComponentData().Values
+	if len(values) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+
+	days, err := strconv.Atoi(values[0])
+	if err != nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+		return
+	}
+
+	// Format: "stats_range" or "stats_range_<component uuid>"
+	var componentID uuid.UUID
+	if rest := strings.TrimPrefix(i.MessageComponentData().CustomID, statsRangeSelectID); rest != "" {
+		if componentID, err = uuid.Parse(strings.TrimPrefix(rest, "_")); err != nil {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid selection"), true)
+			return
+		}
+	}
+
+	data, ok := h.buildStatsResponse(ctx, i, days, componentID)
+	if !ok {
+		return
+	}
+
+	if err := h.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: data,
+	}); err != nil {
+		h.logger.Error("Failed to update stats message", zap.Error(err))
+	}
+}
+
+// buildStatsResponse computes the stats, only of the issues of a component unless
+// componentID is uuid.Nil, and renders them. On failure it responds with an ephemeral
+// error and returns false.
+func (h *Handler) buildStatsResponse(ctx context.Context, i *discordgo.InteractionCreate, days int, componentID uuid.UUID) (*discordgo.InteractionResponseData, bool) {
+	stats, err := h.statsService.GetChannelProjectStats(ctx, i.ChannelID, days, componentID)
+	if errors.Is(err, domain.ErrComponentNotFound) {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This component was deleted. Run `/stats` again."), true)
+		return nil, false
+	}
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to compute stats. Please try again."))
+		return nil, false
+	}
+
+	return &discordgo.InteractionResponseData{
+		Embeds:     []*discordgo.MessageEmbed{CreateStatsEmbed(stats)},
+		Components: []discordgo.MessageComponent{createStatsRangeSelect(ctx, stats.Days, componentID)},
+	}, true
+}
+
+// CreateStatsEmbed creates an embed showing project metrics
+func CreateStatsEmbed(stats *domain.ProjectStats) *discordgo.MessageEmbed {
+	meanResolution := "—"
+	if stats.Resolved > 0 {
+		meanResolution = fmt.Sprintf("%s (%d resolved)", formatDuration(stats.MeanResolutionTime), stats.Resolved)
+	}
+
+	title := fmt.Sprintf("📈 Stats: %s", stats.Project.Name)
+	if stats.Component != nil {
+		title += fmt.Sprintf(" · 🧱 %s", stats.Component.Name)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: fmt.Sprintf("Issues created in the last %d days, since <t:%d:D>", stats.Days, stats.From.Unix()),
+		Color:       0x3498db,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   "🔓 Open",
+				Value:  fmt.Sprintf("%d", stats.Open),
+				Inline: true,
+			},
+			{
+				Name:   "🔒 Closed",
+				Value:  fmt.Sprintf("%d", stats.Closed),
+				Inline: true,
+			},
+			{
+				Name:   "⏱️ Mean Resolution Time",
+				Value:  meanResolution,
+				Inline: true,
+			},
+			{
+				Name:   "📌 By Priority",
+				Value:  formatPriorityCounts(stats.ByPriority),
+				Inline: true,
+			},
+			{
+				Name:   "📅 Issues per Day",
+				Value:  formatIssuesPerDay(stats.CreatedPerDay, stats.To.Sub(stats.From)),
+				Inline: true,
+			},
+			{
+				Name:   "👥 Assignee Workload",
+				Value:  formatWorkload(stats.Workload),
+				Inline: true,
+			},
+			{
+				Name:   "⏱️ Time Logged",
+				Value:  formatTimeByUser(stats.TimeSpent, stats.TimeByUser),
+				Inline: true,
+			},
+			{
+				Name:   "⭐ CSAT",
+				Value:  formatCSAT(stats.CSAT),
+				Inline: true,
+			},
+		},
+		Timestamp: stats.To.Format(time.RFC3339),
+	}
+}
+
+// formatIssuesPerDay renders the mean number of issues created per day over a range and
+// the busiest day, e.g. "1.4 on average\nBusiest: Mar 3 (6)"
+func formatIssuesPerDay(counts []domain.DailyCount, span time.Duration) string {
+	busiest, ok := domain.BusiestDay(counts)
+	if !ok {
+		return "No issues"
+	}
+
+	var total int64
+	for _, dc := range counts {
+		total += dc.Count
+	}
+	days := span.Hours() / 24
+	if days < 1 {
+		days = 1
+	}
+	return fmt.Sprintf("%.1f on average\nBusiest: %s (%d)", float64(total)/days, busiest.Date.Format("Jan 2"), busiest.Count)
+}
+
+// formatCSAT renders the mean satisfaction rating, e.g. "4.3/5 (12 ratings)"
+func formatCSAT(csat domain.CSATSummary) string {
+	switch csat.Responses {
+	case 0:
+		return "No ratings"
+	case 1:
+		return fmt.Sprintf("%.1f/%d (1 rating)", csat.Average, domain.MaxFeedbackRating)
+	default:
+		return fmt.Sprintf("%.1f/%d (%d ratings)", csat.Average, domain.MaxFeedbackRating, csat.Responses)
+	}
+}
+
+// formatWorkload lists open and closed issue counts per assignee
+func formatWorkload(workload []domain.AssigneeWorkload) string {
+	if len(workload) == 0 {
+		return "No assigned issues"
+	}
+
+	var lines []string
+	for idx, w := range workload {
+		if idx == maxStatsAssignees {
+			lines = append(lines, fmt.Sprintf("…and %d more", len(workload)-maxStatsAssignees))
+			break
+		}
+		who := w.Name
+		if w.DiscordID != "" {
+			who = fmt.Sprintf("<@%s>", w.DiscordID)
+		}
+		lines = append(lines, fmt.Sprintf("%s: %d open, %d closed", who, w.OpenIssues, w.ClosedIssues))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatTimeByUser renders the time logged in the range, in total and per user
+func formatTimeByUser(total time.Duration, byUser []domain.UserTimeSpent) string {
+	if len(byUser) == 0 {
+		return "No time logged"
+	}
+
+	lines := []string{fmt.Sprintf("**Total:** %s", formatTimeSpent(total))}
+	for idx, spent := range byUser {
+		if idx == maxStatsAssignees {
+			lines = append(lines, fmt.Sprintf("…and %d more", len(byUser)-maxStatsAssignees))
+			break
+		}
+		who := spent.Name
+		if spent.DiscordID != "" {
+			who = fmt.Sprintf("<@%s>", spent.DiscordID)
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", who, formatTimeSpent(spent.Duration())))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// createStatsRangeSelect creates the select menu for choosing the /stats range, keeping
+// the component the stats are filtered by
+func createStatsRangeSelect(ctx context.Context, selected int, componentID uuid.UUID) discordgo.MessageComponent {
+	options := make([]discordgo.SelectMenuOption, 0, len(domain.StatsRanges))
+	for _, days := range domain.StatsRanges {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   i18n.T(ctx, "Last %d days", days),
+			Value:   strconv.Itoa(days),
+			Default: days == selected,
+		})
+	}
+
+	customID := statsRangeSelectID
+	if componentID != uuid.Nil {
+		customID += "_" + componentID.String()
+	}
+
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				CustomID:    customID,
+				Placeholder: i18n.T(ctx, "Choose a date range..."),
+				Options:     options,
+			},
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleSubtaskCommand handles the /subtask slash command, which creates a sub-task of
+// the issue whose thread the command is used in
+func (h *Handler) handleSubtaskCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	args := make(map[string]string)
+	for _, option := range i.ApplicationCommandData().Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling subtask command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	parent, err := h.issueService.GetIssueByThreadID(ctx, i.ChannelID)
+	if err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Use `/subtask` inside the thread of the issue it belongs to."), true)
+			return
+		}
+		h.logger.Error("Failed to get issue for thread", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to find the issue of this thread. Please try again."), true)
+		return
+	}
+
+	if !h.deferResponse(ctx, i, false) {
+		return
+	}
+
+	sub, err := h.issueService.CreateSubIssue(ctx, parent.ID, args["title"], args["description"], i.Member.User.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNestedSubIssue):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This issue is already a sub-task; sub-tasks cannot have sub-tasks of their own."), false)
+		case errors.Is(err, domain.ErrIssueAlreadyClosed):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This issue is closed. Reopen it before adding sub-tasks."), false)
+		default:
+			h.logger.Error("Failed to create sub-issue", zap.Error(err), zap.String("parent_issue_id", parent.ID.String()))
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to create sub-task. Please try again."), false)
+		}
+		return
+	}
+
+	h.publishIssueCard(ctx, i, sub.ID)
+	h.refreshIssue(ctx, parent.ID, i18n.T(ctx, "🧩 Sub-task **%s** %s added by <@%s>", sub.ShortID(), sub.Title, i.Member.User.ID))
+}
+
+// formatSubIssues renders an issue's sub-tasks with their status, staying within the embed field limit
+func formatSubIssues(subIssues []domain.Issue) string {
+	var lines []string
+	length := 0
+	for i, sub := range subIssues {
+		line := fmt.Sprintf("%s **%s** %s", getStatusEmoji(sub.Status), sub.ShortID(), truncateText(sub.Title, 60))
+		if length+len(line) > 950 {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(subIssues)-i))
+			break
+		}
+		lines = append(lines, line)
+		length += len(line) + 1
+	}
+	return strings.Join(lines, "\n")
+}
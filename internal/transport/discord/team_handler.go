@@ -0,0 +1,255 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// suggestedAssigneePrefix starts the custom ID of a suggested assignee button on an issue card
+const suggestedAssigneePrefix = "suggest_assign_"
+
+// maxTeamCapacity is the largest capacity /team set accepts
+const maxTeamCapacity = 100
+
+// minTeamCapacity is the smallest capacity /team set accepts; the command option needs its address
+var minTeamCapacity = 0.0
+
+// maxTeamListContent keeps a /team list response under Discord's message length limit
+const maxTeamListContent = 1900
+
+// handleTeamCommand handles the /team slash command and its subcommands
+func (h *Handler) handleTeamCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+
+	h.logger.Info("Handling team command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("guild_id", i.GuildID),
+	)
+
+	// Anyone may see the team; changing it needs the support role
+	if subcommand.Name != "list" && !h.authorize(ctx, i, domain.PermissionManageTeam) {
+		return
+	}
+
+	switch subcommand.Name {
+	case "list":
+		h.handleTeamList(ctx, i)
+	case "set":
+		h.handleTeamSet(ctx, i, subcommand)
+	case "remove":
+		user := subcommand.GetOption("user").UserValue(nil)
+		if err := h.teamService.RemoveMember(ctx, i.GuildID, user.ID); err != nil {
+			h.respondTeamError(ctx, i, err, user.ID)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🗑️ Removed <@%s> from the team. Suggestions already on issue cards stay.", user.ID), true)
+	case "suggest":
+		h.handleTeamSuggest(ctx, i, subcommand.GetOption("id").StringValue())
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// handleTeamList lists the team members of the server
+func (h *Handler) handleTeamList(ctx context.Context, i *discordgo.InteractionCreate) {
+	members, err := h.teamService.ListMembers(ctx, i.GuildID)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to list team members. Please try again."))
+		return
+	}
+
+	if len(members) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "👥 No team members yet. Add them with `/team set`."), true)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, formatTeamMembers(ctx, members), true)
+}
+
+// handleTeamSet creates or changes the profile of a team member with the options given
+func (h *Handler) handleTeamSet(ctx context.Context, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	user := subcommand.GetOption("user").UserValue(nil)
+	update := domain.TeamMemberUpdate{Name: memberDisplayName(i, user)}
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "role":
+			role := domain.AssigneeRole(option.StringValue())
+			update.DefaultRole = &role
+		case "skills":
+			skills := option.StringValue()
+			update.Skills = &skills
+		case "capacity":
+			capacity := int(option.IntValue())
+			update.Capacity = &capacity
+		}
+	}
+
+	member, err := h.teamService.SetMember(ctx, i.GuildID, user.ID, update, i.Member.User.ID)
+	if err != nil {
+		h.respondTeamError(ctx, i, err, user.ID)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ Saved the team profile of <@%s>.", user.ID)+"\n\n"+formatTeamMember(ctx, member), true)
+}
+
+// handleTeamSuggest ranks the team members for an issue again and shows those suggested
+func (h *Handler) handleTeamSuggest(ctx context.Context, i *discordgo.InteractionCreate, idStr string) {
+	if !h.deferResponse(ctx, i, true) {
+		return
+	}
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	suggestions, err := h.teamService.SuggestAssignees(ctx, issue.ID)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to suggest assignees. Please try again."))
+		return
+	}
+
+	if len(suggestions) == 0 {
+		h.editInteractionResponse(ctx, i, i18n.T(ctx, "👥 No team member is available for **%s**. Add members with `/team set`, or raise the capacity of those at it.", issueDisplayName(issue)))
+		return
+	}
+
+	mentions := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", suggestion.User.DiscordID))
+	}
+	h.editInteractionResponse(ctx, i, i18n.T(ctx, "💡 Suggested %s for **%s**. Assign them with the buttons on its card.", strings.Join(mentions, ", "), issueDisplayName(issue)))
+}
+
+// handleSuggestedAssigneeButton assigns a suggested team member to an issue with the role
+// of their profile
+func (h *Handler) handleSuggestedAssigneeButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	// Format: "suggest_assign_<issue uuid>_<user uuid>"
+	issueIDStr, userIDStr, _ := strings.Cut(strings.TrimPrefix(i.MessageComponentData().CustomID, suggestedAssigneePrefix), "_")
+	issueID, err := uuid.Parse(issueIDStr)
+	if err != nil {
+		h.logger.Error("Invalid issue ID in suggested assignee button", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid issue ID"), true)
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		h.logger.Error("Invalid user ID in suggested assignee button", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+
+	if !h.authorize(ctx, i, domain.PermissionManageTeam) {
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	var suggestion *domain.IssueSuggestedAssignee
+	for _, pending := range issue.PendingSuggestions() {
+		if pending.UserID == userID {
+			suggestion = &pending
+			break
+		}
+	}
+	if suggestion == nil {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ This suggestion no longer applies: the issue already has a developer or that member was assigned."), true)
+		h.refreshCardAsync(issue.ID)
+		return
+	}
+
+	if _, err := h.issueAssigneeService.AssignUserToIssue(ctx, issue.ID, suggestion.User.DiscordID, suggestion.Role); err != nil {
+		h.logger.Error("Failed to assign suggested team member",
+			zap.Error(err),
+			zap.String("issue_id", issue.ID.String()),
+			zap.String("discord_id", suggestion.User.DiscordID),
+		)
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to assign users. Please try again."))
+		return
+	}
+
+	h.logger.Info("Suggested team member assigned",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("discord_id", suggestion.User.DiscordID),
+		zap.String("assigned_by", i.Member.User.ID),
+	)
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "✅ %s assigned as %s", fmt.Sprintf("<@%s>", suggestion.User.DiscordID), suggestion.Role.GetDisplayName()), true)
+
+	h.refreshIssue(ctx, issue.ID, i18n.T(ctx, "%s %s assigned as **%s** by <@%s>",
+		getRoleEmoji(suggestion.Role), fmt.Sprintf("<@%s>", suggestion.User.DiscordID), suggestion.Role.GetDisplayName(), i.Member.User.ID))
+}
+
+// memberDisplayName returns the name a user goes by in the server of an interaction: their
+// nickname, or else their Discord display name
+func memberDisplayName(i *discordgo.InteractionCreate, user *discordgo.User) string {
+	if resolved := i.ApplicationCommandData().Resolved; resolved != nil {
+		if member, ok := resolved.Members[user.ID]; ok && member.Nick != "" {
+			return member.Nick
+		}
+	}
+	return user.DisplayName()
+}
+
+// respondTeamError explains why a team member profile could not be changed
+func (h *Handler) respondTeamError(ctx context.Context, i *discordgo.InteractionCreate, err error, discordID string) {
+	switch {
+	case errors.Is(err, domain.ErrTeamMemberNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ <@%s> is not on the team.", discordID), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the team. Please try again."))
+	}
+}
+
+// formatTeamMembers lists team members, cut short to fit a message
+func formatTeamMembers(ctx context.Context, members []*domain.TeamMember) string {
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "👥 **Team** (%d)\n", len(members)))
+	for idx, member := range members {
+		line := formatTeamMember(ctx, member) + "\n"
+		if b.Len()+len(line) > maxTeamListContent {
+			b.WriteString(i18n.T(ctx, "…and %d more", len(members)-idx))
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// formatTeamMember describes a team member's role, skills and workload on one line
+func formatTeamMember(ctx context.Context, member *domain.TeamMember) string {
+	skills := i18n.T(ctx, "no skills")
+	if len(member.Skills) > 0 {
+		skills = strings.Join(member.Skills, ", ")
+	}
+
+	workload := i18n.T(ctx, "%d open", member.OpenIssues)
+	if member.Capacity > 0 {
+		workload = i18n.T(ctx, "%d/%d open", member.OpenIssues, member.Capacity)
+	}
+
+	return fmt.Sprintf("%s <@%s> · %s · %s · %s", getRoleEmoji(member.DefaultRole), member.User.DiscordID,
+		member.DefaultRole.GetDisplayName(), skills, workload)
+}
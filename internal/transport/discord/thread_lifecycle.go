@@ -0,0 +1,172 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// closedCardMarker starts the content of a closed issue's card
+const closedCardMarker = "🔒 **[CLOSED]** "
+
+// Auto-archive durations of issue threads in minutes, among the values Discord accepts.
+// Threads of more urgent issues stay in the channel's thread list longer without messages.
+const (
+	threadArchiveHigh   = 10080 // 1 week
+	threadArchiveMedium = 4320  // 3 days
+	threadArchiveLow    = 1440  // 1 day
+)
+
+// issueThreadName names an issue's thread after its status, e.g. "[IN PROGRESS] ACME-42".
+// Forum posts also carry the issue title.
+func issueThreadName(issue *domain.Issue) string {
+	name := fmt.Sprintf("[%s] %s", strings.ToUpper(issue.GetStatusDisplayName()), issueDisplayName(issue))
+	if issue.Channel != nil && issue.Channel.IsForum() {
+		name += " " + issue.Title
+	}
+	return truncateText(name, maxThreadName)
+}
+
+// threadAutoArchiveDuration returns the auto-archive duration of a thread for an issue
+// of the given priority
+func threadAutoArchiveDuration(priority domain.Priority) int {
+	switch priority {
+	case domain.PriorityHigh:
+		return threadArchiveHigh
+	case domain.PriorityLow:
+		return threadArchiveLow
+	default:
+		return threadArchiveMedium
+	}
+}
+
+// cardContent marks the content of an issue's card as closed, or removes the mark
+func cardContent(content string, issue *domain.Issue) string {
+	content = strings.TrimPrefix(content, closedCardMarker)
+	if issue.IsClosed() {
+		return closedCardMarker + content
+	}
+	return content
+}
+
+// applyStatusChange brings an issue's thread and card, loaded at loadedAt, in line with
+// its new status. Threads are open and named after the status while the issue is active,
+// and archived and locked once it is closed. Changes made by a Discord user otherwise
+// leave the card to the interaction handler, but closing and reopening refresh it here: a
+// forum card starts the post, so it can only be edited while the post is not archived.
+// What was done is removed from the outbox.
+func (h *Handler) applyStatusChange(ctx context.Context, issue *domain.Issue, event domain.Event, loadedAt time.Time) {
+	closing := issue.IsClosed() && event.OldStatus != domain.StatusClosed
+	reopening := !issue.IsClosed() && event.OldStatus == domain.StatusClosed
+
+	if !issue.IsClosed() && h.syncThread(issue) == nil {
+		h.completeOutbox(ctx, issue.ID, domain.OutboxSyncThread, loadedAt)
+	}
+
+	if (event.ActorID == "" || closing || reopening) && issue.Channel != nil {
+		if h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue) == nil {
+			h.completeOutbox(ctx, issue.ID, domain.OutboxUpdateCard, loadedAt)
+		}
+	}
+
+	if issue.ThreadID == "" {
+		return
+	}
+	switch {
+	case closing:
+		err := h.syncThread(issue)
+		h.sendMessage(ctx, issue.ThreadID, "🔒 **This issue has been closed.**\n\nThis thread will be archived. If the problem comes back, use the **Reopen** button on the issue card.")
+		if err == nil && h.archiveThread(issue) == nil {
+			h.completeOutbox(ctx, issue.ID, domain.OutboxSyncThread, loadedAt)
+		}
+	case reopening && event.ActorID != "":
+		h.sendMessage(ctx, issue.ThreadID, fmt.Sprintf("🟠 **This issue has been reopened** by <@%s>.", event.ActorID))
+	case reopening:
+		h.sendMessage(ctx, issue.ThreadID, "🟠 **This issue has been reopened.**")
+	}
+}
+
+// syncThread renames an issue's thread after its status, sets its auto-archive duration
+// from the priority and its forum status tag, unarchiving it on the way. Unchanged
+// settings are left out of the edit, as Discord allows few renames in a short time.
+func (h *Handler) syncThread(issue *domain.Issue) error {
+	if issue.ThreadID == "" {
+		return nil
+	}
+
+	thread, err := h.session.Channel(issue.ThreadID)
+	if err != nil {
+		h.logger.Error("Failed to get issue thread", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		return err
+	}
+
+	edit := &discordgo.ChannelEdit{}
+	changed := false
+	if name := issueThreadName(issue); name != thread.Name {
+		edit.Name = name
+		changed = true
+	}
+	if duration := threadAutoArchiveDuration(issue.Priority); thread.ThreadMetadata == nil || duration != thread.ThreadMetadata.AutoArchiveDuration {
+		edit.AutoArchiveDuration = duration
+		changed = true
+	}
+	if tags := h.forumPostTags(issue, thread); tags != nil && !sameTags(tags, thread.AppliedTags) {
+		edit.AppliedTags = &tags
+		changed = true
+	}
+	if thread.ThreadMetadata != nil && (thread.ThreadMetadata.Archived || thread.ThreadMetadata.Locked) {
+		edit.Archived = &[]bool{false}[0]
+		edit.Locked = &[]bool{false}[0]
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err := h.session.ChannelEditComplex(issue.ThreadID, edit); err != nil {
+		h.logger.Error("Failed to update issue thread", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		return err
+	}
+
+	h.logger.Debug("Issue thread updated",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("thread_id", issue.ThreadID),
+		zap.String("name", edit.Name),
+	)
+	return nil
+}
+
+// archiveThread archives and locks a closed issue's thread
+func (h *Handler) archiveThread(issue *domain.Issue) error {
+	if issue.ThreadID == "" {
+		return nil
+	}
+
+	if _, err := h.session.ChannelEditComplex(issue.ThreadID, &discordgo.ChannelEdit{
+		Archived: &[]bool{true}[0],
+		Locked:   &[]bool{true}[0],
+	}); err != nil {
+		h.logger.Error("Failed to archive thread", zap.Error(err), zap.String("issue_id", issue.ID.String()))
+		return err
+	}
+	return nil
+}
+
+// sameTags checks if two lists hold the same forum tags in the same order
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,138 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleTrackCommand handles the /track slash command and its start, stop and log subcommands
+func (h *Handler) handleTrackCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: start, stop or log."), true)
+		return
+	}
+
+	subcommand := options[0]
+	h.logger.Info("Handling track command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionTrackTime) {
+		return
+	}
+
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	userID := i.Member.User.ID
+	switch subcommand.Name {
+	case "start":
+		issue, ok := h.resolveTrackedIssue(ctx, i, args["id"])
+		if !ok {
+			return
+		}
+		if _, err := h.worklogService.StartTimer(ctx, issue.ID, userID); err != nil {
+			h.respondTrackError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "⏱️ Timer started on **%s** %s. Use `/track stop` when you are done.",
+			issueDisplayName(issue), truncateText(issue.Title, 60)), true)
+		h.refreshIssueWithNote(ctx, issue, i18n.T(ctx, "⏱️ <@%s> started working on this issue.", userID))
+	case "stop":
+		worklog, err := h.worklogService.StopTimer(ctx, userID)
+		if err != nil {
+			h.respondTrackError(ctx, i, err)
+			return
+		}
+		// The issue is missing when it was deleted while the timer ran
+		message := i18n.T(ctx, "⏱️ Logged %s.", formatTimeSpent(worklog.Duration()))
+		if worklog.Issue != nil {
+			message = i18n.T(ctx, "⏱️ Logged %s on **%s**.", formatTimeSpent(worklog.Duration()), issueDisplayName(worklog.Issue))
+		}
+		if worklog.Duration() == domain.MaxWorklogDuration {
+			message += i18n.T(ctx, " Timers record at most 24h; use `/track log` for the rest.")
+		}
+		h.respondToInteraction(ctx, i, message, true)
+		if worklog.Issue != nil {
+			h.refreshIssueWithNote(ctx, worklog.Issue, i18n.T(ctx, "⏱️ <@%s> logged %s on this issue.", userID, formatTimeSpent(worklog.Duration())))
+		}
+	case "log":
+		spent, err := time.ParseDuration(strings.ReplaceAll(args["duration"], " ", ""))
+		if err != nil {
+			h.respondTrackError(ctx, i, domain.ErrInvalidWorklogDuration)
+			return
+		}
+		issue, ok := h.resolveTrackedIssue(ctx, i, args["id"])
+		if !ok {
+			return
+		}
+		worklog, err := h.worklogService.LogTime(ctx, issue.ID, userID, spent, args["note"])
+		if err != nil {
+			h.respondTrackError(ctx, i, err)
+			return
+		}
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "⏱️ Logged %s on **%s**.", formatTimeSpent(worklog.Duration()), issueDisplayName(issue)), true)
+
+		note := i18n.T(ctx, "⏱️ <@%s> logged %s on this issue.", userID, formatTimeSpent(worklog.Duration()))
+		if worklog.Note != "" {
+			note += fmt.Sprintf("\n> %s", worklog.Note)
+		}
+		h.refreshIssueWithNote(ctx, issue, note)
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// resolveTrackedIssue finds the issue a /track command is about: the given key or ID, or
+// the issue of the thread the command is used in. It responds to the interaction and
+// returns false when there is none.
+func (h *Handler) resolveTrackedIssue(ctx context.Context, i *discordgo.InteractionCreate, idStr string) (*domain.Issue, bool) {
+	if idStr != "" {
+		return h.resolveIssueForCommand(ctx, i, idStr)
+	}
+
+	issue, err := h.issueService.GetIssueByThreadID(ctx, i.ChannelID)
+	if err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Use this command inside an issue thread, or pass the issue `id`."), true)
+			return nil, false
+		}
+		h.logger.Error("Failed to get issue for thread", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to find the issue of this thread. Please try again."), true)
+		return nil, false
+	}
+
+	return issue, true
+}
+
+// respondTrackError explains why time could not be tracked
+func (h *Handler) respondTrackError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to track time. Please try again."))
+}
+
+// formatTimeSpent formats tracked time in hours and minutes, e.g. "26h 30m"
+func formatTimeSpent(d time.Duration) string {
+	if d < time.Minute {
+		return "< 1m"
+	}
+	hours := int(d / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+	if hours == 0 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %dm", hours, minutes)
+}
@@ -0,0 +1,76 @@
+package discord
+
+import (
+	"context"
+	"errors"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleWatchCommand handles the /watch slash command, which subscribes the user to
+// status changes and comments of an issue
+func (h *Handler) handleWatchCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	idStr := i.ApplicationCommandData().Options[0].StringValue()
+
+	h.logger.Info("Handling watch command",
+		zap.String("issue_id", idStr),
+		zap.String("user_id", i.Member.User.ID),
+	)
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	if err := h.watcherService.Watch(ctx, issue.ID, i.Member.User.ID); err != nil {
+		h.respondWatchError(ctx, i, err)
+		return
+	}
+
+	message := i18n.T(ctx, "👀 You are now watching **%s**. You will get a DM when its status changes or someone comments on it.", issueDisplayName(issue))
+	if optedOut, err := h.notificationService.IsOptedOut(ctx, i.Member.User.ID); err == nil && optedOut {
+		message += i18n.T(ctx, "\n\n🔕 Your DMs are turned off; use `/notifications enabled:True` to get them.")
+	}
+	h.respondToInteraction(ctx, i, message, true)
+}
+
+// handleUnwatchCommand handles the /unwatch slash command
+func (h *Handler) handleUnwatchCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	idStr := i.ApplicationCommandData().Options[0].StringValue()
+
+	h.logger.Info("Handling unwatch command",
+		zap.String("issue_id", idStr),
+		zap.String("user_id", i.Member.User.ID),
+	)
+
+	issue, ok := h.resolveIssueForCommand(ctx, i, idStr)
+	if !ok {
+		return
+	}
+
+	if err := h.watcherService.Unwatch(ctx, issue.ID, i.Member.User.ID); err != nil {
+		h.respondWatchError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🙈 You stopped watching **%s**.", issueDisplayName(issue)), true)
+}
+
+// respondWatchError explains why an issue could not be watched or unwatched
+func (h *Handler) respondWatchError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrIssueNotFound):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Issue not found."), true)
+	case errors.Is(err, domain.ErrAlreadyWatching):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ You are already watching this issue."), true)
+	case errors.Is(err, domain.ErrNotWatching):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ You are not watching this issue."), true)
+	default:
+		h.logger.Error("Failed to update issue watchers", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update your watch list. Please try again."), true)
+	}
+}
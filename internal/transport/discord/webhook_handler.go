@@ -0,0 +1,111 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleWebhookCommand handles the /webhook slash command and its add, remove and list subcommands
+func (h *Handler) handleWebhookCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand: add, remove or list."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling webhook command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageWebhooks) {
+		return
+	}
+
+	switch subcommand.Name {
+	case "add":
+		h.handleWebhookAdd(ctx, i, args["url"])
+	case "remove":
+		h.handleWebhookRemove(ctx, i, args["url"])
+	case "list":
+		h.handleWebhookList(ctx, i)
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+	}
+}
+
+// handleWebhookAdd registers a webhook for the channel's project and shows its signing secret once
+func (h *Handler) handleWebhookAdd(ctx context.Context, i *discordgo.InteractionCreate, url string) {
+	webhook, err := h.webhookService.RegisterWebhook(ctx, i.ChannelID, url, i.Member.User.ID)
+	if err != nil {
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to add webhook. Please try again."))
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🔗 Webhook added: %s\n\n"+
+		"Events: `%s`, `%s`, `%s`\n"+
+		"Signing secret (shown only once): ||`%s`||\n"+
+		"Each request carries an `X-Sentinel-Signature: sha256=<hmac>` header computed over the body with this secret.",
+		webhook.URL,
+		domain.WebhookEventIssueCreated, domain.WebhookEventIssueStatusChanged, domain.WebhookEventIssueAssigned,
+		webhook.Secret), true)
+}
+
+// handleWebhookRemove removes a webhook from the channel's project
+func (h *Handler) handleWebhookRemove(ctx context.Context, i *discordgo.InteractionCreate, url string) {
+	if err := h.webhookService.RemoveWebhook(ctx, i.ChannelID, url); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrChannelNotFound):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This channel is not registered. Use `/register` first."), true)
+		case errors.Is(err, domain.ErrWebhookNotFound):
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "ℹ️ This project has no webhook with that URL. Use `/webhook list` to see them."), true)
+		default:
+			h.logger.Error("Failed to remove webhook", zap.Error(err))
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to remove webhook. Please try again."), true)
+		}
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🔗 Webhook removed: %s", strings.TrimSpace(url)), true)
+}
+
+// handleWebhookList lists the webhooks of the channel's project
+func (h *Handler) handleWebhookList(ctx context.Context, i *discordgo.InteractionCreate) {
+	webhooks, err := h.webhookService.ListWebhooks(ctx, i.ChannelID)
+	if err != nil {
+		if errors.Is(err, domain.ErrChannelNotFound) {
+			h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ This channel is not registered. Use `/register` first."), true)
+			return
+		}
+		h.logger.Error("Failed to list webhooks", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to list webhooks. Please try again."), true)
+		return
+	}
+
+	if len(webhooks) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "🔗 This project has no webhooks. Add one with `/webhook add`."), true)
+		return
+	}
+
+	var content strings.Builder
+	content.WriteString(i18n.T(ctx, "🔗 **Project webhooks (%d):**\n", len(webhooks)))
+	for _, webhook := range webhooks {
+		content.WriteString(i18n.T(ctx, "• %s (added <t:%d:R>)\n", webhook.URL, webhook.CreatedAt.Unix()))
+	}
+
+	h.respondToInteraction(ctx, i, content.String(), true)
+}
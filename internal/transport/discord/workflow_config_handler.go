@@ -0,0 +1,123 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"go.uber.org/zap"
+)
+
+// handleWorkflowConfigCommand handles the /workflow-config slash command and its subcommands
+func (h *Handler) handleWorkflowConfigCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Please choose a subcommand."), true)
+		return
+	}
+
+	subcommand := options[0]
+	args := make(map[string]string)
+	for _, option := range subcommand.Options {
+		args[option.Name] = option.StringValue()
+	}
+
+	h.logger.Info("Handling workflow-config command",
+		zap.String("subcommand", subcommand.Name),
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	if !h.authorize(ctx, i, domain.PermissionManageWorkflow) {
+		return
+	}
+
+	var (
+		content string
+		err     error
+	)
+	switch subcommand.Name {
+	case "show":
+		var workflow *domain.WorkflowDefinition
+		if workflow, err = h.workflowService.GetWorkflow(ctx, i.ChannelID); err == nil {
+			content = formatWorkflowConfig(ctx, workflow)
+		}
+	case "add-status":
+		var status *domain.WorkflowStatus
+		if status, err = h.workflowService.AddStatus(ctx, i.ChannelID, args["name"], i.Member.User.ID); err == nil {
+			content = i18n.T(ctx, "✅ Added status **%s** (`%s`). Add a transition to it with `/workflow-config add-transition` to make it reachable.",
+				status.Name, status.Status)
+		}
+	case "remove-status":
+		if err = h.workflowService.RemoveStatus(ctx, i.ChannelID, args["name"]); err == nil {
+			content = i18n.T(ctx, "🗑️ Removed status **%s** and its transitions.", strings.TrimSpace(args["name"]))
+		}
+	case "add-transition":
+		var transition *domain.WorkflowTransition
+		if transition, err = h.workflowService.AddTransition(ctx, i.ChannelID, args["from"], args["to"], i.Member.User.ID); err == nil {
+			content = i18n.T(ctx, "✅ Issues can now move from `%s` to `%s`.", transition.FromStatus, transition.ToStatus)
+		}
+	case "remove-transition":
+		if err = h.workflowService.RemoveTransition(ctx, i.ChannelID, args["from"], args["to"]); err == nil {
+			content = i18n.T(ctx, "🗑️ Removed the transition from **%s** to **%s**.", strings.TrimSpace(args["from"]), strings.TrimSpace(args["to"]))
+		}
+	case "reset":
+		if err = h.workflowService.ResetWorkflow(ctx, i.ChannelID); err == nil {
+			content = i18n.T(ctx, "🔄 This project now uses the built-in workflow.")
+		}
+	default:
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Unknown subcommand"), true)
+		return
+	}
+
+	if err != nil {
+		h.respondWorkflowConfigError(ctx, i, err)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, content, true)
+}
+
+// respondWorkflowConfigError explains why a workflow change was refused
+func (h *Handler) respondWorkflowConfigError(ctx context.Context, i *discordgo.InteractionCreate, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWorkflowStatusInUse):
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ %s. Move them to another status first.", errorMessage(ctx, err)), true)
+	default:
+		h.respondError(ctx, i, err, i18n.T(ctx, "❌ Failed to update the workflow. Please try again."))
+	}
+}
+
+// formatWorkflowConfig describes a project's custom statuses and transitions
+func formatWorkflowConfig(ctx context.Context, workflow *domain.WorkflowDefinition) string {
+	var b strings.Builder
+	b.WriteString(i18n.T(ctx, "🔄 **Project workflow**\n"))
+	b.WriteString(i18n.T(ctx, "Built-in: Open → In Progress → Resolved → Verified → Closed, with Rejected and Reopened\n"))
+
+	if workflow == nil || (len(workflow.Statuses) == 0 && len(workflow.Transitions) == 0) {
+		b.WriteString(i18n.T(ctx, "\nThis project uses the built-in workflow. Add statuses with `/workflow-config add-status`."))
+		return b.String()
+	}
+
+	if len(workflow.Statuses) > 0 {
+		b.WriteString(i18n.T(ctx, "\n**Custom statuses:**\n"))
+		for _, status := range workflow.Statuses {
+			b.WriteString(fmt.Sprintf("• %s **%s** (`%s`)\n", getStatusEmoji(status.Status), status.Name, status.Status))
+		}
+	}
+
+	if len(workflow.Transitions) > 0 {
+		b.WriteString(i18n.T(ctx, "\n**Custom transitions:**\n"))
+		for _, transition := range workflow.Transitions {
+			b.WriteString(fmt.Sprintf("• %s → %s\n",
+				workflow.StatusDisplayName(transition.FromStatus), workflow.StatusDisplayName(transition.ToStatus)))
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,203 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/i18n"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// parseButtonIssueID extracts the issue ID from a workflow button custom ID (format: "<verb>_<noun>_<uuid>")
+func parseButtonIssueID(customID string) (uuid.UUID, error) {
+	parts := strings.Split(customID, "_")
+	if len(parts) < 3 {
+		return uuid.Nil, fmt.Errorf("invalid button custom ID: %s", customID)
+	}
+	return uuid.Parse(parts[2])
+}
+
+// ensureTransition responds with an ephemeral error and returns false if the issue cannot move to the given status
+func (h *Handler) ensureTransition(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue, status domain.Status) bool {
+	if issue.CanTransitionTo(status) {
+		return true
+	}
+
+	h.logger.Debug("Rejected invalid status transition",
+		zap.String("issue_id", issue.ID.String()),
+		zap.String("from", string(issue.Status)),
+		zap.String("to", string(status)),
+	)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "⚠️ Issue is **%s** and cannot be moved to **%s**.",
+		issue.GetStatusDisplayName(), issue.Workflow().StatusDisplayName(status)), true)
+
+	// The card is probably stale, refresh it so the buttons match the real status
+	if issue.Channel != nil {
+		h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, issue)
+	}
+	return false
+}
+
+// handleWorkflowCommand handles the /workflow slash command
+func (h *Handler) handleWorkflowCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	h.logger.Info("Handling workflow command",
+		zap.String("user_id", i.Member.User.ID),
+		zap.String("channel_id", i.ChannelID),
+	)
+
+	assignments, err := h.issueAssigneeService.GetUserAssignmentsByDiscordID(ctx, i.Member.User.ID)
+	if err != nil {
+		h.logger.Error("Failed to get user assignments", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to retrieve your tasks. Please try again."), true)
+		return
+	}
+
+	var active []*domain.IssueAssignee
+	for _, assignment := range assignmentsInGuild(assignments, i.GuildID) {
+		if !assignment.Issue.IsClosed() {
+			active = append(active, assignment)
+		}
+	}
+
+	if err := h.respond(ctx, i, &discordgo.InteractionResponseData{
+		Embeds: []*discordgo.MessageEmbed{CreateWorkflowEmbed(active)},
+		Flags:  discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		h.logger.Error("Failed to respond to workflow command", zap.Error(err))
+	}
+}
+
+// handleCustomStatusButton handles the buttons of statuses added by a project's workflow
+// (format: "set_status_<uuid>_<status>")
+func (h *Handler) handleCustomStatusButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	customID := i.MessageComponentData().CustomID
+	issueID, err := parseButtonIssueID(customID)
+	if err != nil {
+		h.logger.Error("Invalid custom status button custom ID", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+	status := domain.Status(strings.TrimPrefix(customID, fmt.Sprintf("set_status_%s_", issueID)))
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, status) {
+		return
+	}
+
+	if err := h.issueService.UpdateIssueStatus(ctx, issueID, status, i.Member.User.ID); err != nil {
+		h.logger.Error("Failed to update issue status", zap.Error(err), zap.String("status", string(status)))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to update issue status"), true)
+		return
+	}
+
+	name := issue.Workflow().StatusDisplayName(status)
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "%s Moved to **%s**", getStatusEmoji(status), name), true)
+	h.refreshIssue(ctx, issueID, i18n.T(ctx, "%s **Moved to %s** by <@%s>", getStatusEmoji(status), name, i.Member.User.ID))
+}
+
+// handleRejectIssueButton handles the reject issue button click
+func (h *Handler) handleRejectIssueButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	issueID, err := parseButtonIssueID(i.MessageComponentData().CustomID)
+	if err != nil {
+		h.logger.Error("Invalid reject issue button custom ID", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, domain.StatusRejected) {
+		return
+	}
+
+	if err := h.issueService.RejectIssue(ctx, issueID, i.Member.User.ID); err != nil {
+		h.logger.Error("Failed to reject issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to reject issue"), true)
+		return
+	}
+
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "Rejecting issue..."), true)
+
+	loadedAt := time.Now()
+	if updatedIssue, err := h.issueService.GetIssue(ctx, issueID); err == nil && h.updateIssueCard(ctx, issue.Channel.DiscordChannelID, updatedIssue) == nil {
+		h.completeOutbox(ctx, issueID, domain.OutboxUpdateCard, loadedAt)
+	}
+
+	if issue.ThreadID != "" {
+		var mentions []string
+		for _, dev := range issue.GetDevelopers() {
+			mentions = append(mentions, fmt.Sprintf("<@%s>", dev.User.DiscordID))
+		}
+		message := i18n.T(ctx, "🔴 **Fix rejected by QA** (<@%s>)", i.Member.User.ID)
+		if len(mentions) > 0 {
+			message += "\n\n" + strings.Join(mentions, " ") + i18n.T(ctx, " please take another look.")
+		}
+		h.sendMessage(ctx, issue.ThreadID, message)
+	}
+}
+
+// handleReopenIssueButton handles the Reopen button left on a closed issue's card, which
+// stays usable after the issue's thread is archived and locked
+func (h *Handler) handleReopenIssueButton(ctx context.Context, i *discordgo.InteractionCreate) {
+	issueID, err := parseButtonIssueID(i.MessageComponentData().CustomID)
+	if err != nil {
+		h.logger.Error("Invalid reopen issue button custom ID", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "Invalid button action"), true)
+		return
+	}
+
+	issue, err := h.issueService.GetIssue(ctx, issueID)
+	if err != nil {
+		h.logger.Error("Failed to get issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to get issue"), true)
+		return
+	}
+
+	if !h.authorizeReopen(ctx, i, issue) {
+		return
+	}
+
+	if !h.ensureTransition(ctx, i, issue, domain.StatusReopened) {
+		return
+	}
+
+	if err := h.issueService.ReopenIssue(ctx, issueID, i.Member.User.ID); err != nil {
+		h.logger.Error("Failed to reopen issue", zap.Error(err))
+		h.respondToInteraction(ctx, i, i18n.T(ctx, "❌ Failed to reopen issue"), true)
+		return
+	}
+
+	h.logger.Info("Issue reopened from its card",
+		zap.String("issue_id", issueID.String()),
+		zap.String("user_id", i.Member.User.ID),
+	)
+
+	// The thread is unarchived and the card refreshed by the status change event handler
+	h.respondToInteraction(ctx, i, i18n.T(ctx, "🟠 Reopening issue..."), true)
+}
+
+// authorizeReopen lets reporters reopen their own issues, since they can no longer post
+// in the locked thread; anyone else needs the reopen permission
+func (h *Handler) authorizeReopen(ctx context.Context, i *discordgo.InteractionCreate, issue *domain.Issue) bool {
+	if issue.Reporter.DiscordID != "" && issue.Reporter.DiscordID == i.Member.User.ID {
+		return true
+	}
+	return h.authorize(ctx, i, domain.PermissionReopenIssue)
+}
@@ -0,0 +1,273 @@
+package email
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxInboundSize limits the size of accepted raw emails, attachments included
+	maxInboundSize = 25 << 20
+	// maxInboundBodyLength limits the text kept from an email body
+	maxInboundBodyLength = 10000
+)
+
+// recipientHeaders are the headers whose addresses are matched against support addresses.
+// Delivered-To and X-Original-To catch emails the support address got as Bcc.
+var recipientHeaders = []string{"To", "Cc", "Delivered-To", "X-Original-To"}
+
+// messageIDPattern matches the message IDs in Message-ID, In-Reply-To and References headers
+var messageIDPattern = regexp.MustCompile(`<([^<>\s]+)>`)
+
+// htmlTagPattern matches the tags of HTML bodies, which are reduced to their text
+var htmlTagPattern = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]+>`)
+
+// InboundHandler receives raw emails forwarded by a mail provider and hands them to the
+// inbound email service
+type InboundHandler struct {
+	secret     []byte
+	service    domain.InboundEmailService
+	ownAddress string
+	logger     *zap.Logger
+}
+
+// NewInboundHandler creates a new inbound email handler. Emails from ownAddress, the sender
+// of the bot's own notifications, are ignored so they cannot loop back into issues.
+func NewInboundHandler(secret string, service domain.InboundEmailService, ownAddress string, logger *zap.Logger) *InboundHandler {
+	if from, err := mail.ParseAddress(ownAddress); err == nil {
+		ownAddress = strings.ToLower(from.Address)
+	}
+	return &InboundHandler{
+		secret:     []byte(secret),
+		service:    service,
+		ownAddress: ownAddress,
+		logger:     logger,
+	}
+}
+
+// ServeHTTP checks the shared secret and files the raw RFC 5322 email in the request body.
+// Emails that are not for a support address or sent automatically are accepted and dropped,
+// so the provider does not retry them.
+func (h *InboundHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authorized(r) {
+		h.logger.Warn("Rejected inbound email with invalid secret")
+		http.Error(w, "invalid secret", http.StatusUnauthorized)
+		return
+	}
+
+	msg, err := mail.ReadMessage(io.LimitReader(r.Body, maxInboundSize))
+	if err != nil {
+		http.Error(w, "invalid email", http.StatusBadRequest)
+		return
+	}
+
+	if isAutomatic(msg.Header) {
+		h.logger.Debug("Ignored automatic email", zap.String("message_id", msg.Header.Get("Message-ID")))
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	email, err := parseEmail(msg)
+	if err != nil {
+		h.logger.Warn("Rejected unreadable inbound email", zap.Error(err))
+		http.Error(w, "invalid email", http.StatusBadRequest)
+		return
+	}
+
+	if email.FromAddress == h.ownAddress {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if _, err := h.service.Receive(r.Context(), email); err != nil {
+		if errors.Is(err, domain.ErrNoInboundProject) {
+			h.logger.Warn("Dropped email not sent to a support address",
+				zap.String("from", email.FromAddress),
+				zap.Strings("to", email.To),
+			)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		h.logger.Error("Failed to file inbound email",
+			zap.Error(err),
+			zap.String("from", email.FromAddress),
+			zap.String("message_id", email.MessageID),
+		)
+		http.Error(w, "failed to file email", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authorized checks the secret given as bearer token or, for providers that cannot set
+// headers, as the token query parameter
+func (h *InboundHandler) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token = r.URL.Query().Get("token")
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), h.secret) == 1
+}
+
+// isAutomatic reports whether an email is an auto-reply, bounce or bulk message
+func isAutomatic(header mail.Header) bool {
+	if submitted := strings.ToLower(header.Get("Auto-Submitted")); submitted != "" && submitted != "no" {
+		return true
+	}
+	switch strings.ToLower(header.Get("Precedence")) {
+	case "bulk", "junk", "list", "auto_reply":
+		return true
+	}
+	return header.Get("X-Autoreply") != "" || header.Get("X-Autorespond") != ""
+}
+
+// parseEmail reads the addresses, threading headers, subject and text of an email
+func parseEmail(msg *mail.Message) (*domain.InboundEmail, error) {
+	from, err := msg.Header.AddressList("From")
+	if err != nil || len(from) == 0 {
+		return nil, fmt.Errorf("invalid From header: %w", err)
+	}
+
+	email := &domain.InboundEmail{
+		FromName:    from[0].Name,
+		FromAddress: strings.ToLower(from[0].Address),
+		Subject:     decodeHeader(msg.Header.Get("Subject")),
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range recipientHeaders {
+		addresses, err := msg.Header.AddressList(name)
+		if err != nil {
+			continue
+		}
+		for _, address := range addresses {
+			a := strings.ToLower(address.Address)
+			if !seen[a] {
+				seen[a] = true
+				email.To = append(email.To, a)
+			}
+		}
+	}
+
+	if ids := messageIDs(msg.Header.Get("Message-ID")); len(ids) > 0 {
+		email.MessageID = ids[0]
+	}
+	// The email replied to comes first, then the rest of the conversation from newest to oldest
+	email.References = messageIDs(msg.Header.Get("In-Reply-To"))
+	references := messageIDs(msg.Header.Get("References"))
+	for i := len(references) - 1; i >= 0; i-- {
+		email.References = append(email.References, references[i])
+	}
+
+	body, err := readText(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	if runes := []rune(body); len(runes) > maxInboundBodyLength {
+		body = string(runes[:maxInboundBodyLength-1]) + "…"
+	}
+	email.Body = body
+
+	return email, nil
+}
+
+// decodeHeader decodes the RFC 2047 encoded words of a header, keeping it as is when it cannot
+func decodeHeader(value string) string {
+	decoded, err := new(mime.WordDecoder).DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+	return decoded
+}
+
+// messageIDs returns the message IDs of a header without their angle brackets
+func messageIDs(value string) []string {
+	var ids []string
+	for _, match := range messageIDPattern.FindAllStringSubmatch(value, -1) {
+		ids = append(ids, match[1])
+	}
+	return ids
+}
+
+// readText returns the text of an email body. Of multipart bodies the first plain text part
+// is used, falling back to the text of an HTML part; attachments are skipped.
+func readText(contentType, transferEncoding string, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		var htmlText string
+		parts := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := parts.NextRawPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to read email part: %w", err)
+			}
+			if disposition, _, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition")); disposition == "attachment" {
+				continue
+			}
+
+			partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+			text, err := readText(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return "", err
+			}
+			switch {
+			case partType == "text/html":
+				if htmlText == "" {
+					htmlText = text
+				}
+			case text != "":
+				return text, nil
+			}
+		}
+		return htmlText, nil
+	}
+
+	if !strings.HasPrefix(mediaType, "text/") {
+		return "", nil
+	}
+
+	raw, err := io.ReadAll(decodeTransfer(transferEncoding, body))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode email body: %w", err)
+	}
+	text := strings.ReplaceAll(string(raw), "\r\n", "\n")
+	if mediaType == "text/html" {
+		text = html.UnescapeString(htmlTagPattern.ReplaceAllString(text, ""))
+	}
+	return strings.TrimSpace(text), nil
+}
+
+// decodeTransfer undoes the Content-Transfer-Encoding of a body
+func decodeTransfer(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
@@ -0,0 +1,210 @@
+// Package email emails customer contacts when issues of their projects are created,
+// resolved or closed.
+package email
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"html/template"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// notifyTimeout bounds loading the issue of one email
+const notifyTimeout = 30 * time.Second
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// Each email is the shared layout filled with the content of its event
+var (
+	createdTemplate  = parseTemplate("templates/created.html")
+	resolvedTemplate = parseTemplate("templates/resolved.html")
+	closedTemplate   = parseTemplate("templates/closed.html")
+)
+
+// parseTemplate parses an email content template together with the layout
+func parseTemplate(name string) *template.Template {
+	return template.Must(template.ParseFS(templateFS, "templates/layout.html", name))
+}
+
+// Notifier emails the contact of a customer about the issues of the customer's projects
+type Notifier struct {
+	issueRepo domain.IssueRepository
+	config    config.EmailConfig
+	from      *mail.Address
+	logger    *zap.Logger
+}
+
+// NewNotifier creates a new email notifier. The configuration is expected to be validated,
+// so the sender address parses.
+func NewNotifier(issueRepo domain.IssueRepository, cfg config.EmailConfig, logger *zap.Logger) *Notifier {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		from = &mail.Address{Address: cfg.From}
+	}
+	return &Notifier{
+		issueRepo: issueRepo,
+		config:    cfg,
+		from:      from,
+		logger:    logger,
+	}
+}
+
+// issueView is the data the email templates are rendered with
+type issueView struct {
+	Customer         string
+	Project          string
+	Key              string
+	Title            string
+	Description      string
+	Priority         string
+	Status           string
+	ResolutionCause  string
+	ResolutionAction string
+	StatusPageURL    string
+}
+
+// Subscribe registers the notifier for the events customers are emailed about
+func (n *Notifier) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(n.onIssueCreated, domain.EventIssueCreated)
+	bus.Subscribe(n.onIssueStatusChanged, domain.EventIssueStatusChanged)
+}
+
+// onIssueCreated emails that a new issue was received
+func (n *Notifier) onIssueCreated(_ context.Context, event domain.Event) {
+	n.async(event.Issue.ID, "created", func(issue *domain.Issue) (string, *template.Template) {
+		return fmt.Sprintf("[%s] We received your issue: %s", issueRef(issue), issue.Title), createdTemplate
+	})
+}
+
+// onIssueStatusChanged emails that an issue was resolved or closed
+func (n *Notifier) onIssueStatusChanged(_ context.Context, event domain.Event) {
+	switch event.Issue.Status {
+	case domain.StatusResolved:
+		n.async(event.Issue.ID, "resolved", func(issue *domain.Issue) (string, *template.Template) {
+			return fmt.Sprintf("[%s] Your issue was resolved: %s", issueRef(issue), issue.Title), resolvedTemplate
+		})
+	case domain.StatusClosed:
+		n.async(event.Issue.ID, "closed", func(issue *domain.Issue) (string, *template.Template) {
+			return fmt.Sprintf("[%s] Your issue was closed: %s", issueRef(issue), issue.Title), closedTemplate
+		})
+	}
+}
+
+// async reloads the issue and emails its customer contact in the background so issue
+// changes are never delayed by the mail server. Customers without a contact email or who
+// opted out are skipped.
+func (n *Notifier) async(issueID uuid.UUID, action string, build func(issue *domain.Issue) (string, *template.Template)) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		issue, err := n.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			n.logger.Error("Failed to load issue for email notification",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("action", action),
+			)
+			return
+		}
+
+		customer := issue.Project.Customer
+		if !customer.WantsEmail() {
+			return
+		}
+
+		subject, tmpl := build(issue)
+		if err := n.send(customer.ContactEmail, subject, tmpl, n.newIssueView(issue)); err != nil {
+			n.logger.Error("Email notification failed",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("customer_id", customer.ID.String()),
+				zap.String("action", action),
+			)
+			return
+		}
+
+		n.logger.Debug("Email notification sent",
+			zap.String("issue_id", issueID.String()),
+			zap.String("customer_id", customer.ID.String()),
+			zap.String("action", action),
+		)
+	}()
+}
+
+// newIssueView builds the template data of an issue
+func (n *Notifier) newIssueView(issue *domain.Issue) issueView {
+	view := issueView{
+		Customer:         issue.Project.Customer.Name,
+		Project:          issue.Project.Name,
+		Key:              issueRef(issue),
+		Title:            issue.Title,
+		Description:      issue.Description,
+		Priority:         string(issue.Priority),
+		Status:           issue.GetStatusDisplayName(),
+		ResolutionCause:  issue.ResolutionCause,
+		ResolutionAction: issue.ResolutionAction,
+	}
+	if base := strings.TrimRight(n.config.StatusPageURL, "/"); base != "" && issue.PublicHash != "" {
+		view.StatusPageURL = base + "/public/issues/" + issue.PublicHash
+	}
+	return view
+}
+
+// send renders an email and delivers it to one recipient through the configured SMTP server.
+// STARTTLS is used when the server offers it.
+func (n *Notifier) send(to, subject string, tmpl *template.Template, view issueView) error {
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("invalid contact email: %w", err)
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&body, "layout", view); err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", recipient.String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+
+	var auth smtp.Auth
+	if n.config.Username != "" {
+		auth = smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+	}
+
+	addr := net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port))
+	if err := smtp.SendMail(addr, auth, n.from.Address, []string{recipient.Address}, msg.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// issueRef identifies an issue by key, or by ID for issues without one
+func issueRef(issue *domain.Issue) string {
+	if issue.Key == "" {
+		return issue.ID.String()
+	}
+	return issue.Key
+}
@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyContextKey is the context key of the API key a call was authenticated with
+type apiKeyContextKey struct{}
+
+// methodScopes is the API key scope each method requires. Methods missing here are
+// refused, so new methods must be given a scope.
+var methodScopes = map[string]domain.APIKeyScope{
+	fixtrackv1.IssueService_GetIssue_FullMethodName:            domain.ScopeIssuesRead,
+	fixtrackv1.IssueService_ListIssues_FullMethodName:          domain.ScopeIssuesRead,
+	fixtrackv1.IssueService_CreateIssue_FullMethodName:         domain.ScopeIssuesWrite,
+	fixtrackv1.IssueService_UpdateIssueStatus_FullMethodName:   domain.ScopeIssuesWrite,
+	fixtrackv1.IssueService_UpdateIssuePriority_FullMethodName: domain.ScopeIssuesWrite,
+	fixtrackv1.IssueService_ResolveIssue_FullMethodName:        domain.ScopeIssuesWrite,
+	fixtrackv1.IssueService_DeleteIssue_FullMethodName:         domain.ScopeIssuesWrite,
+	fixtrackv1.IssueService_RestoreIssue_FullMethodName:        domain.ScopeIssuesWrite,
+	fixtrackv1.IssueService_WatchStatusChanges_FullMethodName:  domain.ScopeIssuesRead,
+
+	// Channel registrations belong to projects
+	fixtrackv1.ChannelService_GetChannel_FullMethodName:       domain.ScopeProjectsRead,
+	fixtrackv1.ChannelService_ListChannels_FullMethodName:     domain.ScopeProjectsRead,
+	fixtrackv1.ChannelService_RegisterChannel_FullMethodName:  domain.ScopeProjectsWrite,
+	fixtrackv1.ChannelService_SetChannelActive_FullMethodName: domain.ScopeProjectsWrite,
+	fixtrackv1.ChannelService_TransferChannel_FullMethodName:  domain.ScopeProjectsWrite,
+}
+
+// authenticateUnary requires a call to carry an API key granting its method's scope
+func (s *Server) authenticateUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authenticateStream requires a stream to carry an API key granting its method's scope
+func (s *Server) authenticateStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticate checks the bearer API key of a call's metadata and stores it in the context
+func (s *Server) authenticate(ctx context.Context, method string) (context.Context, error) {
+	scope, ok := methodScopes[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not available", method)
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing bearer API key")
+	}
+
+	key, err := s.apiKeyService.Authenticate(ctx, token)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAPIKey) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, s.serviceError(err)
+	}
+	if !key.HasScope(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key lacks the %s scope", scope)
+	}
+
+	return context.WithValue(ctx, apiKeyContextKey{}, key), nil
+}
+
+// authenticatedStream carries the context with the API key of a stream to its handler
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context holding the stream's API key
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// bearerToken extracts the token of "authorization: Bearer <token>" metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// contextAPIKey returns the API key stored in a call's context
+func contextAPIKey(ctx context.Context) *domain.APIKey {
+	key, _ := ctx.Value(apiKeyContextKey{}).(*domain.APIKey)
+	return key
+}
+
+// keyCustomerID returns the customer the call's API key is restricted to, or nil when
+// it may reach all customers
+func keyCustomerID(ctx context.Context) *uuid.UUID {
+	if key := contextAPIKey(ctx); key != nil {
+		return key.CustomerID
+	}
+	return nil
+}
+
+// authorizeProject checks that the call's API key may reach a project. Other customers'
+// projects are reported as not found so keys cannot probe for them.
+func (s *Server) authorizeProject(ctx context.Context, projectID uuid.UUID) error {
+	if keyCustomerID(ctx) == nil {
+		return nil
+	}
+
+	project, err := s.projectService.GetProject(ctx, projectID)
+	if err != nil {
+		return s.serviceError(err)
+	}
+	if !contextAPIKey(ctx).CanAccessCustomer(project.CustomerID) {
+		return s.serviceError(domain.ErrProjectNotFound)
+	}
+	return nil
+}
+
+// authorizeIssue checks that the call's API key may reach an issue
+func (s *Server) authorizeIssue(ctx context.Context, issue *domain.Issue) error {
+	if key := contextAPIKey(ctx); key != nil && !key.CanAccessCustomer(issue.Project.CustomerID) {
+		return s.serviceError(domain.ErrIssueNotFound)
+	}
+	return nil
+}
+
+// authorizeChannel checks that the call's API key may reach a channel registration
+func (s *Server) authorizeChannel(ctx context.Context, channel *domain.Channel) error {
+	if key := contextAPIKey(ctx); key != nil && !key.CanAccessCustomer(channel.Project.CustomerID) {
+		return s.serviceError(domain.ErrChannelNotFound)
+	}
+	return nil
+}
+
+// requireUnboundKey refuses keys bound to a customer, for calls that reach across customers
+func requireUnboundKey(ctx context.Context) error {
+	if keyCustomerID(ctx) != nil {
+		return status.Error(codes.PermissionDenied, "api keys bound to a customer cannot do this")
+	}
+	return nil
+}
+
+// authorizeReporter checks that a user exists and, for keys bound to a customer, that
+// it belongs to that customer, so keys cannot file issues in the name of staff or of
+// other customers' users
+func (s *Server) authorizeReporter(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userService.GetUser(ctx, userID)
+	if err != nil {
+		return s.serviceError(err)
+	}
+	if keyCustomerID(ctx) != nil && (user.CustomerID == nil || !contextAPIKey(ctx).CanAccessCustomer(*user.CustomerID)) {
+		return s.serviceError(domain.ErrUserNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/domain"
+)
+
+// channelServer implements fixtrackv1.ChannelServiceServer on top of the channel service
+type channelServer struct {
+	fixtrackv1.UnimplementedChannelServiceServer
+	*Server
+}
+
+// GetChannel returns the registration of a Discord channel
+func (s *channelServer) GetChannel(ctx context.Context, req *fixtrackv1.ChannelRef) (*fixtrackv1.Channel, error) {
+	channel, err := s.authorizedChannel(ctx, req.GetDiscordChannelId())
+	if err != nil {
+		return nil, err
+	}
+	return channelMessage(channel), nil
+}
+
+// ListChannels returns the registered channels of a Discord guild. Keys bound to a
+// customer only see the channels of its projects.
+func (s *channelServer) ListChannels(ctx context.Context, req *fixtrackv1.ListChannelsRequest) (*fixtrackv1.ListChannelsResponse, error) {
+	if strings.TrimSpace(req.GetGuildId()) == "" {
+		return nil, invalidArgument("guild_id is required")
+	}
+
+	channels, err := s.channelService.ListChannelsForGuild(ctx, req.GetGuildId())
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	resp := &fixtrackv1.ListChannelsResponse{}
+	for _, channel := range channels {
+		if s.authorizeChannel(ctx, channel) != nil {
+			continue
+		}
+		resp.Channels = append(resp.Channels, channelMessage(channel))
+	}
+	return resp, nil
+}
+
+// RegisterChannel registers a channel for a customer's project, creating both if needed.
+// It may create customers, so keys bound to one cannot use it.
+func (s *channelServer) RegisterChannel(ctx context.Context, req *fixtrackv1.RegisterChannelRequest) (*fixtrackv1.Channel, error) {
+	if err := requireUnboundKey(ctx); err != nil {
+		return nil, err
+	}
+
+	// The registering user is created under their Discord ID when the bot does not know them yet
+	channel, err := s.channelService.RegisterChannel(ctx,
+		req.GetDiscordChannelId(),
+		req.GetCustomerName(),
+		req.GetCustomerEmail(),
+		req.GetProjectName(),
+		req.GetProjectDescription(),
+		req.GetRegisteredBy(),
+		req.GetRegisteredBy(),
+		req.GetGuildId(),
+		req.GetChannelType(),
+	)
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getChannel(ctx, channel.DiscordChannelID)
+}
+
+// SetChannelActive activates or deactivates a channel registration
+func (s *channelServer) SetChannelActive(ctx context.Context, req *fixtrackv1.SetChannelActiveRequest) (*fixtrackv1.Channel, error) {
+	channel, err := s.authorizedChannel(ctx, req.GetDiscordChannelId())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetActive() {
+		err = s.channelService.ActivateChannel(ctx, channel.DiscordChannelID)
+	} else {
+		err = s.channelService.DeactivateChannel(ctx, channel.DiscordChannelID)
+	}
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getChannel(ctx, channel.DiscordChannelID)
+}
+
+// TransferChannel moves a channel to another project of the same guild. The project may
+// belong to another customer, so keys bound to one cannot use it.
+func (s *channelServer) TransferChannel(ctx context.Context, req *fixtrackv1.TransferChannelRequest) (*fixtrackv1.Channel, error) {
+	if err := requireUnboundKey(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetProjectKey()) == "" {
+		return nil, invalidArgument("project_key is required")
+	}
+
+	if _, err := s.channelService.TransferChannel(ctx, req.GetDiscordChannelId(), req.GetProjectKey()); err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getChannel(ctx, req.GetDiscordChannelId())
+}
+
+// authorizedChannel returns a channel registration the call's API key may reach
+func (s *channelServer) authorizedChannel(ctx context.Context, discordChannelID string) (*domain.Channel, error) {
+	if strings.TrimSpace(discordChannelID) == "" {
+		return nil, invalidArgument("discord_channel_id is required")
+	}
+
+	channel, err := s.channelService.GetChannelRegistration(ctx, discordChannelID)
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+	if err := s.authorizeChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+// getChannel returns the current state of a channel registration after it was changed,
+// with its projects loaded
+func (s *channelServer) getChannel(ctx context.Context, discordChannelID string) (*fixtrackv1.Channel, error) {
+	channel, err := s.channelService.GetChannelRegistration(ctx, discordChannelID)
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+	return channelMessage(channel), nil
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"time"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// priorities maps the domain priorities to their protobuf values
+var priorities = map[domain.Priority]fixtrackv1.Priority{
+	domain.PriorityLow:    fixtrackv1.Priority_PRIORITY_LOW,
+	domain.PriorityMedium: fixtrackv1.Priority_PRIORITY_MEDIUM,
+	domain.PriorityHigh:   fixtrackv1.Priority_PRIORITY_HIGH,
+}
+
+// domainPriority converts a protobuf priority; unspecified and unknown values give ""
+func domainPriority(priority fixtrackv1.Priority) domain.Priority {
+	for p, value := range priorities {
+		if value == priority {
+			return p
+		}
+	}
+	return ""
+}
+
+// issueMessage converts an issue to its protobuf message
+func issueMessage(issue *domain.Issue) *fixtrackv1.Issue {
+	return &fixtrackv1.Issue{
+		Id:               issue.ID.String(),
+		Key:              issue.Key,
+		ProjectId:        issue.ProjectID.String(),
+		ReporterId:       issue.ReporterID.String(),
+		Title:            issue.Title,
+		Description:      issue.Description,
+		ImageUrl:         issue.ImageURL,
+		Status:           string(issue.Status),
+		Priority:         priorities[issue.Priority],
+		Source:           issue.Source,
+		ResolutionCause:  issue.ResolutionCause,
+		ResolutionAction: issue.ResolutionAction,
+		DueDate:          optionalTimestamp(issue.DueDate),
+		CreatedAt:        timestamppb.New(issue.CreatedAt),
+		UpdatedAt:        timestamppb.New(issue.UpdatedAt),
+		ClosedAt:         optionalTimestamp(issue.ClosedAt),
+	}
+}
+
+// channelMessage converts a channel registration to its protobuf message
+func channelMessage(channel *domain.Channel) *fixtrackv1.Channel {
+	msg := &fixtrackv1.Channel{
+		Id:               channel.ID.String(),
+		DiscordChannelId: channel.DiscordChannelID,
+		GuildId:          channel.GuildID,
+		ProjectId:        channel.ProjectID.String(),
+		Active:           channel.IsActive,
+		ChannelType:      channel.ChannelType,
+		CreatedAt:        timestamppb.New(channel.CreatedAt),
+	}
+	for _, project := range channel.Projects {
+		msg.ExtraProjectIds = append(msg.ExtraProjectIds, project.ID.String())
+	}
+	return msg
+}
+
+// optionalTimestamp converts a time that may be unset
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceErrorCodes is the gRPC status code of each kind of service error
+var serviceErrorCodes = map[domain.ErrorKind]codes.Code{
+	domain.KindInvalid:     codes.InvalidArgument,
+	domain.KindNotFound:    codes.NotFound,
+	domain.KindConflict:    codes.FailedPrecondition,
+	domain.KindForbidden:   codes.PermissionDenied,
+	domain.KindRateLimited: codes.ResourceExhausted,
+}
+
+// serviceError maps a service error to a gRPC status by its kind. Errors that are not
+// the client's fault are logged and hidden.
+func (s *Server) serviceError(err error) error {
+	code, ok := serviceErrorCodes[domain.KindOf(err)]
+	if !ok {
+		s.logger.Error("gRPC call failed", zap.Error(err))
+		return status.Error(codes.Internal, "internal server error")
+	}
+	s.logger.Debug("gRPC call rejected", zap.Error(err), zap.String("code", code.String()))
+	return status.Error(code, err.Error())
+}
+
+// invalidArgument reports a malformed request field
+func invalidArgument(message string) error {
+	return status.Error(codes.InvalidArgument, message)
+}
+
+// parseID parses a required UUID request field
+func parseID(value, name string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, invalidArgument("invalid " + name)
+	}
+	return id, nil
+}
+
+// parseOptionalID parses a UUID request field that may be empty
+func parseOptionalID(value, name string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+	return parseID(value, name)
+}
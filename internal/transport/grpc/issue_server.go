@@ -0,0 +1,294 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// issueServer implements fixtrackv1.IssueServiceServer on top of the issue service
+type issueServer struct {
+	fixtrackv1.UnimplementedIssueServiceServer
+	*Server
+}
+
+// GetIssue returns an issue by ID or key
+func (s *issueServer) GetIssue(ctx context.Context, req *fixtrackv1.GetIssueRequest) (*fixtrackv1.Issue, error) {
+	var (
+		issue *domain.Issue
+		err   error
+	)
+	switch ref := req.GetRef().(type) {
+	case *fixtrackv1.GetIssueRequest_Id:
+		id, parseErr := parseID(ref.Id, "issue ID")
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		issue, err = s.issueService.GetIssue(ctx, id)
+	case *fixtrackv1.GetIssueRequest_Key:
+		issue, err = s.issueService.GetIssueByKey(ctx, ref.Key)
+	default:
+		return nil, invalidArgument("id or key is required")
+	}
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+	if err := s.authorizeIssue(ctx, issue); err != nil {
+		return nil, err
+	}
+
+	return issueMessage(issue), nil
+}
+
+// ListIssues returns one page of the issues matching a filter. Keys bound to a customer
+// only see its issues.
+func (s *issueServer) ListIssues(ctx context.Context, req *fixtrackv1.ListIssuesRequest) (*fixtrackv1.ListIssuesResponse, error) {
+	query := domain.IssueQuery{
+		Priority: domainPriority(req.GetPriority()),
+		Offset:   max(int(req.GetOffset()), 0),
+		Limit:    int(req.GetLimit()),
+	}
+	if req.GetPriority() != fixtrackv1.Priority_PRIORITY_UNSPECIFIED && query.Priority == "" {
+		return nil, invalidArgument(domain.ErrInvalidPriority.Error())
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultPageLimit
+	}
+	query.Limit = min(query.Limit, maxPageLimit)
+	if req.GetStatus() != "" {
+		query.Statuses = []domain.Status{domain.Status(req.GetStatus())}
+	}
+	if req.GetCreatedAfter() != nil {
+		query.CreatedAfter = req.GetCreatedAfter().AsTime()
+	}
+	if req.GetCreatedBefore() != nil {
+		query.CreatedBefore = req.GetCreatedBefore().AsTime()
+	}
+
+	for name, field := range map[string]struct {
+		value string
+		dst   *uuid.UUID
+	}{
+		"customer_id": {req.GetCustomerId(), &query.CustomerID},
+		"project_id":  {req.GetProjectId(), &query.ProjectID},
+		"assignee_id": {req.GetAssigneeId(), &query.AssigneeID},
+	} {
+		id, err := parseOptionalID(field.value, name)
+		if err != nil {
+			return nil, err
+		}
+		*field.dst = id
+	}
+
+	if customerID := keyCustomerID(ctx); customerID != nil {
+		if query.CustomerID != uuid.Nil && query.CustomerID != *customerID {
+			return &fixtrackv1.ListIssuesResponse{}, nil
+		}
+		query.CustomerID = *customerID
+	}
+
+	issues, total, err := s.issueService.SearchIssues(ctx, query)
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	resp := &fixtrackv1.ListIssuesResponse{TotalCount: total}
+	for _, issue := range issues {
+		resp.Issues = append(resp.Issues, issueMessage(issue))
+	}
+	return resp, nil
+}
+
+// CreateIssue files a web issue in a project
+func (s *issueServer) CreateIssue(ctx context.Context, req *fixtrackv1.CreateIssueRequest) (*fixtrackv1.Issue, error) {
+	if strings.TrimSpace(req.GetTitle()) == "" {
+		return nil, invalidArgument(domain.ErrEmptyTitle.Error())
+	}
+	if strings.TrimSpace(req.GetDescription()) == "" {
+		return nil, invalidArgument(domain.ErrEmptyDescription.Error())
+	}
+	projectID, err := parseID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return nil, err
+	}
+	reporterID, err := parseID(req.GetReporterId(), "reporter_id")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeReporter(ctx, reporterID); err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueService.CreateWebIssue(ctx, projectID, req.GetTitle(), req.GetDescription(), req.GetImageUrl(), reporterID)
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return issueMessage(issue), nil
+}
+
+// UpdateIssueStatus moves an issue to a status its workflow allows
+func (s *issueServer) UpdateIssueStatus(ctx context.Context, req *fixtrackv1.UpdateIssueStatusRequest) (*fixtrackv1.Issue, error) {
+	id, err := s.authorizedIssueID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// The service validates the status against the project's workflow
+	if err := s.issueService.UpdateIssueStatus(ctx, id, domain.Status(req.GetStatus()), ""); err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getIssue(ctx, id)
+}
+
+// UpdateIssuePriority changes the priority of an issue
+func (s *issueServer) UpdateIssuePriority(ctx context.Context, req *fixtrackv1.UpdateIssuePriorityRequest) (*fixtrackv1.Issue, error) {
+	priority := domainPriority(req.GetPriority())
+	if priority == "" {
+		return nil, invalidArgument(domain.ErrInvalidPriority.Error())
+	}
+	id, err := s.authorizedIssueID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.issueService.UpdateIssuePriority(ctx, id, priority); err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getIssue(ctx, id)
+}
+
+// ResolveIssue marks an issue resolved with its root cause and corrective action
+func (s *issueServer) ResolveIssue(ctx context.Context, req *fixtrackv1.ResolveIssueRequest) (*fixtrackv1.Issue, error) {
+	if strings.TrimSpace(req.GetCause()) == "" || strings.TrimSpace(req.GetAction()) == "" {
+		return nil, invalidArgument("cause and action are required")
+	}
+	id, err := s.authorizedIssueID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.issueService.UpdateIssueResolved(ctx, id, req.GetCause(), req.GetAction(), ""); err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getIssue(ctx, id)
+}
+
+// DeleteIssue soft-deletes an issue
+func (s *issueServer) DeleteIssue(ctx context.Context, req *fixtrackv1.IssueRef) (*emptypb.Empty, error) {
+	id, err := s.authorizedIssueID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.issueService.DeleteIssue(ctx, id); err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RestoreIssue brings back a soft-deleted issue
+func (s *issueServer) RestoreIssue(ctx context.Context, req *fixtrackv1.IssueRef) (*fixtrackv1.Issue, error) {
+	id, err := s.authorizedIssueID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.issueService.RestoreIssue(ctx, id); err != nil {
+		return nil, s.serviceError(err)
+	}
+
+	return s.getIssue(ctx, id)
+}
+
+// WatchStatusChanges streams the status changes published on the event bus until the
+// client cancels or the server shuts down. Keys bound to a customer only see its issues.
+func (s *issueServer) WatchStatusChanges(req *fixtrackv1.WatchStatusChangesRequest, stream grpc.ServerStreamingServer[fixtrackv1.StatusChange]) error {
+	ctx := stream.Context()
+
+	projectID, err := parseOptionalID(req.GetProjectId(), "project_id")
+	if err != nil {
+		return err
+	}
+	customerID, err := parseOptionalID(req.GetCustomerId(), "customer_id")
+	if err != nil {
+		return err
+	}
+	if projectID != uuid.Nil {
+		if err := s.authorizeProject(ctx, projectID); err != nil {
+			return err
+		}
+	}
+	if keyCustomer := keyCustomerID(ctx); keyCustomer != nil {
+		if customerID != uuid.Nil && customerID != *keyCustomer {
+			return s.serviceError(domain.ErrCustomerNotFound)
+		}
+		customerID = *keyCustomer
+	}
+
+	w := s.statusChanges.watch(projectID, customerID)
+	defer s.statusChanges.unwatch(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-w.changes:
+			if !ok {
+				return status.Error(codes.Unavailable, "status change stream ended; reconnect to keep watching")
+			}
+			if err := stream.Send(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// authorizedIssueID parses an issue ID and checks that the call's API key may reach the issue
+func (s *issueServer) authorizedIssueID(ctx context.Context, value string) (uuid.UUID, error) {
+	id, err := parseID(value, "issue ID")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if keyCustomerID(ctx) == nil {
+		return id, nil
+	}
+
+	issue, err := s.issueService.GetIssue(ctx, id)
+	if err != nil {
+		return uuid.Nil, s.serviceError(err)
+	}
+	if err := s.authorizeIssue(ctx, issue); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+// getIssue returns the current state of an issue after it was changed
+func (s *issueServer) getIssue(ctx context.Context, id uuid.UUID) (*fixtrackv1.Issue, error) {
+	issue, err := s.issueService.GetIssue(ctx, id)
+	if err != nil {
+		return nil, s.serviceError(err)
+	}
+	return issueMessage(issue), nil
+}
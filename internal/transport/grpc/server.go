@@ -0,0 +1,132 @@
+// Package grpc serves the gRPC API used by internal tooling such as the admin dashboard.
+// Its services are defined in api/proto/fixtrack/v1.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Server exposes the issue and channel services over gRPC
+type Server struct {
+	config         *config.GRPCConfig
+	server         *grpc.Server
+	issueService   domain.IssueService
+	channelService domain.ChannelService
+	projectService domain.ProjectService
+	apiKeyService  domain.APIKeyService
+	userService    domain.UserService
+	statusChanges  *statusStream
+	logger         *zap.Logger
+}
+
+// NewServer creates a new gRPC server
+func NewServer(
+	cfg *config.GRPCConfig,
+	issueService domain.IssueService,
+	channelService domain.ChannelService,
+	projectService domain.ProjectService,
+	apiKeyService domain.APIKeyService,
+	userService domain.UserService,
+	logger *zap.Logger,
+) *Server {
+	s := &Server{
+		config:         cfg,
+		issueService:   issueService,
+		channelService: channelService,
+		projectService: projectService,
+		apiKeyService:  apiKeyService,
+		userService:    userService,
+		statusChanges:  newStatusStream(),
+		logger:         logger,
+	}
+
+	s.server = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.logUnary, s.authenticateUnary),
+		grpc.ChainStreamInterceptor(s.logStream, s.authenticateStream),
+	)
+	fixtrackv1.RegisterIssueServiceServer(s.server, &issueServer{Server: s})
+	fixtrackv1.RegisterChannelServiceServer(s.server, &channelServer{Server: s})
+
+	return s
+}
+
+// Subscribe feeds the status changes published on the bus to WatchStatusChanges streams
+func (s *Server) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(s.statusChanges.handleEvent, domain.EventIssueStatusChanged)
+}
+
+// Start starts listening for gRPC calls. It blocks until the server stops.
+func (s *Server) Start() error {
+	s.logger.Info("Starting gRPC server", zap.String("address", s.config.Address))
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to start gRPC server: %w", err)
+	}
+	if err := s.server.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+		return fmt.Errorf("failed to start gRPC server: %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown gracefully stops the gRPC server. Status change streams are ended first so
+// they do not hold it open; calls still running after the shutdown timeout are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Info("Shutting down gRPC server")
+
+	s.statusChanges.close()
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.ShutdownTimeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return fmt.Errorf("failed to shutdown gRPC server: %w", ctx.Err())
+	}
+}
+
+// logUnary logs every unary call with its status code and duration
+func (s *Server) logUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	s.logCall(info.FullMethod, err, start)
+	return resp, err
+}
+
+// logStream logs every stream when it ends with its status code and duration
+func (s *Server) logStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	s.logCall(info.FullMethod, err, start)
+	return err
+}
+
+// logCall logs a finished call
+func (s *Server) logCall(method string, err error, start time.Time) {
+	s.logger.Info("Handled gRPC call",
+		zap.String("method", method),
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
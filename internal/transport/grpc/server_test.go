@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Fixtures shared by the tests: two customers with one project and one issue each
+var (
+	customerA = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	customerB = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	projectA  = domain.Project{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000a1"), CustomerID: customerA, Name: "Portal"}
+	projectB  = domain.Project{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000b1"), CustomerID: customerB, Name: "Shop"}
+	issueA    = &domain.Issue{ID: uuid.MustParse("00000000-0000-0000-0000-0000000a1001"), ProjectID: projectA.ID, Project: projectA,
+		Key: "POR-1", Title: "Login fails", Status: domain.StatusOpen, Priority: domain.PriorityHigh}
+	issueB = &domain.Issue{ID: uuid.MustParse("00000000-0000-0000-0000-0000000b1001"), ProjectID: projectB.ID, Project: projectB,
+		Key: "SHO-1", Title: "Cart empty", Status: domain.StatusOpen, Priority: domain.PriorityLow}
+)
+
+// apiKeys are the keys the fake API key service knows, by token
+var apiKeys = map[string]*domain.APIKey{
+	"reader":   {Scopes: "issues:read"},
+	"projects": {Scopes: "projects:read"},
+	"acme":     {Scopes: "issues:write", CustomerID: &customerA},
+}
+
+// fakeAPIKeyService authenticates the apiKeys
+type fakeAPIKeyService struct {
+	domain.APIKeyService
+}
+
+func (fakeAPIKeyService) Authenticate(ctx context.Context, token string) (*domain.APIKey, error) {
+	if key, ok := apiKeys[token]; ok {
+		return key, nil
+	}
+	return nil, domain.ErrInvalidAPIKey
+}
+
+// fakeIssueService serves the issue fixtures
+type fakeIssueService struct {
+	domain.IssueService
+}
+
+func (fakeIssueService) GetIssue(ctx context.Context, id uuid.UUID) (*domain.Issue, error) {
+	for _, issue := range []*domain.Issue{issueA, issueB} {
+		if issue.ID == id {
+			return issue, nil
+		}
+	}
+	return nil, domain.ErrIssueNotFound
+}
+
+// startServer serves s over an in-memory connection and returns a client of its issue service
+func startServer(t *testing.T, s *Server) fixtrackv1.IssueServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	go s.server.Serve(listener)
+	t.Cleanup(s.server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return fixtrackv1.NewIssueServiceClient(conn)
+}
+
+// newTestServer creates a server backed by the fake services
+func newTestServer() *Server {
+	return NewServer(&config.GRPCConfig{ShutdownTimeout: time.Second}, fakeIssueService{}, nil, nil, fakeAPIKeyService{}, nil, zap.NewNop())
+}
+
+// withKey adds an API key to the metadata of outgoing calls
+func withKey(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func TestGetIssueAuthorization(t *testing.T) {
+	client := startServer(t, newTestServer())
+
+	tests := []struct {
+		name  string
+		token string
+		issue *domain.Issue
+		want  codes.Code
+	}{
+		{name: "missing key", issue: issueA, want: codes.Unauthenticated},
+		{name: "unknown key", token: "nope", issue: issueA, want: codes.Unauthenticated},
+		{name: "key without issues:read", token: "projects", issue: issueA, want: codes.PermissionDenied},
+		{name: "key with issues:read", token: "reader", issue: issueB, want: codes.OK},
+		{name: "write scope grants reading", token: "acme", issue: issueA, want: codes.OK},
+		{name: "other customer's issue", token: "acme", issue: issueB, want: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := withKey(context.Background(), tt.token)
+			resp, err := client.GetIssue(ctx, &fixtrackv1.GetIssueRequest{Ref: &fixtrackv1.GetIssueRequest_Id{Id: tt.issue.ID.String()}})
+			if code := status.Code(err); code != tt.want {
+				t.Fatalf("code = %s, want %s (%v)", code, tt.want, err)
+			}
+			if err == nil && resp.GetKey() != tt.issue.Key {
+				t.Errorf("key = %q, want %q", resp.GetKey(), tt.issue.Key)
+			}
+		})
+	}
+}
+
+func TestWatchStatusChanges(t *testing.T) {
+	s := newTestServer()
+	client := startServer(t, s)
+
+	ctx, cancel := context.WithTimeout(withKey(context.Background(), "acme"), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchStatusChanges(ctx, &fixtrackv1.WatchStatusChangesRequest{})
+	if err != nil {
+		t.Fatalf("failed to watch: %v", err)
+	}
+
+	// Publish once the watcher is registered; the change of the other customer is not sent
+	for {
+		s.statusChanges.mu.Lock()
+		watching := len(s.statusChanges.watchers) > 0
+		s.statusChanges.mu.Unlock()
+		if watching {
+			break
+		}
+		if ctx.Err() != nil {
+			t.Fatal("the stream was not watched")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	closedB, closedA := *issueB, *issueA
+	closedB.Status, closedA.Status = domain.StatusClosed, domain.StatusClosed
+	s.statusChanges.handleEvent(ctx, domain.Event{Type: domain.EventIssueStatusChanged, Issue: &closedB, OldStatus: domain.StatusOpen})
+	s.statusChanges.handleEvent(ctx, domain.Event{Type: domain.EventIssueStatusChanged, Issue: &closedA, OldStatus: domain.StatusOpen})
+
+	change, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("failed to receive: %v", err)
+	}
+	if change.GetIssue().GetKey() != "POR-1" || change.GetOldStatus() != "open" || change.GetNewStatus() != "closed" {
+		t.Errorf("unexpected change %v", change)
+	}
+
+	// Shutting down ends the stream
+	if err := s.Shutdown(context.Background()); err != nil {
+		t.Fatalf("failed to shut down: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("error after shutdown = %v, want Unavailable", err)
+	}
+}
@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	fixtrackv1 "fix-track-bot/api/proto/fixtrack/v1"
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// watchBufferSize is how many status changes a watcher may fall behind before its
+// stream is ended; clients reconnect on their own
+const watchBufferSize = 64
+
+// statusWatcher is a client watching the status changes of a project, of a customer's
+// projects, or of every project when both are nil
+type statusWatcher struct {
+	projectID  uuid.UUID
+	customerID uuid.UUID
+	changes    chan *fixtrackv1.StatusChange
+}
+
+// matches checks if a watcher wants the status changes of an issue. Issues published
+// without their project are only sent to watchers of every customer.
+func (w *statusWatcher) matches(issue *domain.Issue) bool {
+	if w.projectID != uuid.Nil && issue.ProjectID != w.projectID {
+		return false
+	}
+	if w.customerID != uuid.Nil && (issue.Project.ID != issue.ProjectID || issue.Project.CustomerID != w.customerID) {
+		return false
+	}
+	return true
+}
+
+// statusStream fans out the status changes published on the event bus to watchers
+type statusStream struct {
+	mu       sync.Mutex
+	watchers map[*statusWatcher]struct{}
+	closed   bool
+}
+
+// newStatusStream creates a status stream without watchers
+func newStatusStream() *statusStream {
+	return &statusStream{watchers: make(map[*statusWatcher]struct{})}
+}
+
+// watch adds a watcher. Its channel is closed when it falls behind or the server shuts down.
+func (ss *statusStream) watch(projectID, customerID uuid.UUID) *statusWatcher {
+	w := &statusWatcher{projectID: projectID, customerID: customerID, changes: make(chan *fixtrackv1.StatusChange, watchBufferSize)}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if ss.closed {
+		close(w.changes)
+		return w
+	}
+	ss.watchers[w] = struct{}{}
+	return w
+}
+
+// unwatch removes a watcher whose client disconnected
+func (ss *statusStream) unwatch(w *statusWatcher) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if _, ok := ss.watchers[w]; ok {
+		delete(ss.watchers, w)
+		close(w.changes)
+	}
+}
+
+// handleEvent sends a status change to the watchers of its issue. It never blocks the
+// publisher: watchers whose buffer is full are dropped instead.
+func (ss *statusStream) handleEvent(_ context.Context, event domain.Event) {
+	if event.Issue == nil {
+		return
+	}
+
+	change := &fixtrackv1.StatusChange{
+		Issue:      issueMessage(event.Issue),
+		OldStatus:  string(event.OldStatus),
+		NewStatus:  string(event.Issue.Status),
+		ActorId:    event.ActorID,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	for w := range ss.watchers {
+		if !w.matches(event.Issue) {
+			continue
+		}
+		select {
+		case w.changes <- change:
+		default:
+			delete(ss.watchers, w)
+			close(w.changes)
+		}
+	}
+}
+
+// close ends every watch so that shutdown does not wait for open streams
+func (ss *statusStream) close() {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.closed = true
+	for w := range ss.watchers {
+		delete(ss.watchers, w)
+		close(w.changes)
+	}
+}
@@ -0,0 +1,167 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// apiKeyContextKey is the context key of the API key a request was authenticated with
+type apiKeyContextKey struct{}
+
+// withScope requires a request to carry an API key as a bearer token that grants the scope
+func (s *Server) withScope(scope domain.APIKeyScope, next http.HandlerFunc) http.HandlerFunc {
+	return s.withAPIKey(func(w http.ResponseWriter, r *http.Request) {
+		if !requestAPIKey(r).HasScope(scope) {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="api", error="insufficient_scope", scope="%s"`, scope))
+			s.writeError(w, http.StatusForbidden, scopeError(scope).Error())
+			return
+		}
+		next(w, r)
+	})
+}
+
+// withAPIKey requires a request to carry an API key as a bearer token. Handlers that
+// serve several scopes check them with requireScope.
+func (s *Server) withAPIKey(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+			s.writeError(w, http.StatusUnauthorized, "missing bearer API key")
+			return
+		}
+
+		key, err := s.apiKeyService.Authenticate(r.Context(), token)
+		if err != nil {
+			if !errors.Is(err, domain.ErrInvalidAPIKey) {
+				s.writeServiceError(w, err)
+				return
+			}
+			w.Header().Set("WWW-Authenticate", `Bearer realm="api", error="invalid_token"`)
+			s.writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		next(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, key)))
+	}
+}
+
+// requireScope checks that the API key of a request context grants the scope
+func requireScope(ctx context.Context, scope domain.APIKeyScope) error {
+	if key := contextAPIKey(ctx); key == nil || !key.HasScope(scope) {
+		return scopeError(scope)
+	}
+	return nil
+}
+
+// scopeError explains that an API key lacks a scope
+func scopeError(scope domain.APIKeyScope) error {
+	return fmt.Errorf("api key lacks the %s scope", scope)
+}
+
+// bearerToken extracts the token of an "Authorization: Bearer <token>" header
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// requestAPIKey returns the API key a request was authenticated with by withAPIKey
+func requestAPIKey(r *http.Request) *domain.APIKey {
+	return contextAPIKey(r.Context())
+}
+
+// contextAPIKey returns the API key stored in a request context
+func contextAPIKey(ctx context.Context) *domain.APIKey {
+	key, _ := ctx.Value(apiKeyContextKey{}).(*domain.APIKey)
+	return key
+}
+
+// keyCustomerID returns the customer the request's API key is restricted to, or nil
+// when it may reach all customers
+func keyCustomerID(r *http.Request) *uuid.UUID {
+	if key := requestAPIKey(r); key != nil {
+		return key.CustomerID
+	}
+	return nil
+}
+
+// authorizeCustomer checks that the request's API key may reach a customer. Other
+// customers are reported as not found so keys cannot probe for them.
+func (s *Server) authorizeCustomer(w http.ResponseWriter, r *http.Request, customerID uuid.UUID) bool {
+	if key := requestAPIKey(r); key != nil && !key.CanAccessCustomer(customerID) {
+		s.writeServiceError(w, domain.ErrCustomerNotFound)
+		return false
+	}
+	return true
+}
+
+// authorizeProject checks that the request's API key may reach a project
+func (s *Server) authorizeProject(w http.ResponseWriter, r *http.Request, projectID uuid.UUID) bool {
+	if keyCustomerID(r) == nil {
+		return true
+	}
+
+	project, err := s.projectService.GetProject(r.Context(), projectID)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return false
+	}
+	if !requestAPIKey(r).CanAccessCustomer(project.CustomerID) {
+		s.writeServiceError(w, domain.ErrProjectNotFound)
+		return false
+	}
+	return true
+}
+
+// authorizeIssue checks that the request's API key may reach an issue
+func (s *Server) authorizeIssue(w http.ResponseWriter, r *http.Request, issueID uuid.UUID) bool {
+	if keyCustomerID(r) == nil {
+		return true
+	}
+
+	issue, err := s.issueService.GetIssue(r.Context(), issueID)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return false
+	}
+	if !requestAPIKey(r).CanAccessCustomer(issue.Project.CustomerID) {
+		s.writeServiceError(w, domain.ErrIssueNotFound)
+		return false
+	}
+	return true
+}
+
+// authorizeReporter checks that a user exists and, for keys bound to a customer, that
+// it belongs to that customer, so keys cannot file issues in the name of staff or of
+// other customers' users
+func (s *Server) authorizeReporter(w http.ResponseWriter, r *http.Request, userID uuid.UUID) bool {
+	user, err := s.userService.GetUser(r.Context(), userID)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return false
+	}
+	if keyCustomerID(r) != nil && (user.CustomerID == nil || !requestAPIKey(r).CanAccessCustomer(*user.CustomerID)) {
+		s.writeServiceError(w, domain.ErrUserNotFound)
+		return false
+	}
+	return true
+}
+
+// pageOf returns one page of a list that is not paginated by the service
+func pageOf[T any](items []T, offset, limit int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	return items[offset:min(offset+limit, len(items))]
+}
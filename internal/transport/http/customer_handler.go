@@ -0,0 +1,234 @@
+package http
+
+import (
+	"net/http"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// customerRequest is the body accepted when creating or updating a customer
+type customerRequest struct {
+	Name         string `json:"name"`
+	ContactEmail string `json:"contact_email"`
+}
+
+// customerEmailRequest is the body accepted when changing a customer's email notifications
+type customerEmailRequest struct {
+	OptOut bool `json:"opt_out"`
+}
+
+// customerMergeRequest is the body accepted when merging a customer into another
+type customerMergeRequest struct {
+	Into uuid.UUID `json:"into"`
+}
+
+// handleListCustomers handles GET /api/v1/customers
+func (s *Server) handleListCustomers(w http.ResponseWriter, r *http.Request) {
+	offset, limit := pagination(r)
+
+	// A key restricted to a customer only sees that customer
+	if customerID := keyCustomerID(r); customerID != nil {
+		customer, err := s.customerService.GetCustomer(r.Context(), *customerID)
+		if err != nil {
+			s.writeServiceError(w, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, pageOf([]*domain.Customer{customer}, offset, limit))
+		return
+	}
+
+	customers, err := s.customerService.ListCustomers(r.Context(), offset, limit)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, customers)
+}
+
+// handleCreateCustomer handles POST /api/v1/customers
+func (s *Server) handleCreateCustomer(w http.ResponseWriter, r *http.Request) {
+	if keyCustomerID(r) != nil {
+		s.writeError(w, http.StatusForbidden, "api keys of a customer cannot create customers")
+		return
+	}
+
+	var req customerRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	customer, err := s.customerService.CreateCustomer(r.Context(), "", req.Name, req.ContactEmail)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, customer)
+}
+
+// handleGetCustomer handles GET /api/v1/customers/{id}
+func (s *Server) handleGetCustomer(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	if !s.authorizeCustomer(w, r, id) {
+		return
+	}
+
+	customer, err := s.customerService.GetCustomer(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, customer)
+}
+
+// handleUpdateCustomer handles PUT /api/v1/customers/{id}
+func (s *Server) handleUpdateCustomer(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	if !s.authorizeCustomer(w, r, id) {
+		return
+	}
+
+	var req customerRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.customerService.UpdateCustomer(r.Context(), id, req.Name, req.ContactEmail); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	customer, err := s.customerService.GetCustomer(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, customer)
+}
+
+// handleDeleteCustomer handles DELETE /api/v1/customers/{id}
+func (s *Server) handleDeleteCustomer(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	if !s.authorizeCustomer(w, r, id) {
+		return
+	}
+
+	if err := s.customerService.DeleteCustomer(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRestoreCustomer handles POST /api/v1/customers/{id}/restore
+func (s *Server) handleRestoreCustomer(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	if !s.authorizeCustomer(w, r, id) {
+		return
+	}
+
+	if err := s.customerService.RestoreCustomer(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleMergeCustomer handles POST /api/v1/customers/{id}/merge. The customer's
+// projects, users and API keys move to the customer in the body, and it is deleted.
+func (s *Server) handleMergeCustomer(w http.ResponseWriter, r *http.Request) {
+	if keyCustomerID(r) != nil {
+		s.writeError(w, http.StatusForbidden, "api keys of a customer cannot merge customers")
+		return
+	}
+
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+
+	var req customerMergeRequest
+	if err := decodeJSON(r, &req); err != nil || req.Into == uuid.Nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	customer, err := s.customerService.MergeCustomers(r.Context(), id, req.Into)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, customer)
+}
+
+// handleSetCustomerEmailOptOut handles PUT /api/v1/customers/{id}/email
+func (s *Server) handleSetCustomerEmailOptOut(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	if !s.authorizeCustomer(w, r, id) {
+		return
+	}
+
+	var req customerEmailRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.customerService.SetEmailOptOut(r.Context(), id, req.OptOut); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleListCustomerProjects handles GET /api/v1/customers/{id}/projects
+func (s *Server) handleListCustomerProjects(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid customer ID")
+		return
+	}
+	if !s.authorizeCustomer(w, r, id) {
+		return
+	}
+
+	projects, err := s.projectService.GetProjectsByCustomer(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, projects)
+}
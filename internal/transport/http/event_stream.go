@@ -0,0 +1,140 @@
+package http
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// streamBufferSize is how many events a stream client may fall behind before it is
+// disconnected; browsers reconnect on their own
+const streamBufferSize = 64
+
+// streamedEventTypes are the issue lifecycle events sent to stream clients. Comments
+// are left out as they may be internal.
+var streamedEventTypes = []domain.EventType{
+	domain.EventIssueCreated,
+	domain.EventIssueStatusChanged,
+	domain.EventIssuePriorityChanged,
+	domain.EventIssueSeverityChanged,
+	domain.EventIssueEdited,
+	domain.EventIssueComponentChanged,
+	domain.EventIssueDeleted,
+	domain.EventAssigneeAdded,
+	domain.EventAssigneeRemoved,
+	domain.EventIssueAutoAssigned,
+	domain.EventIssueRouted,
+	domain.EventIssueEscalated,
+}
+
+// liveEvent is the data of an event sent to stream clients
+type liveEvent struct {
+	id          uint64
+	Type        domain.EventType        `json:"type"`
+	OccurredAt  time.Time               `json:"occurred_at"`
+	Issue       domain.WebhookIssue     `json:"issue"`
+	OldStatus   domain.Status           `json:"old_status,omitempty"`
+	OldPriority domain.Priority         `json:"old_priority,omitempty"`
+	OldSeverity domain.Severity         `json:"old_severity,omitempty"`
+	Assignee    *domain.WebhookAssignee `json:"assignee,omitempty"`
+}
+
+// streamSubscriber is a client streaming the events of one project
+type streamSubscriber struct {
+	projectID uuid.UUID
+	events    chan liveEvent
+}
+
+// eventStream fans out issue events to the clients streaming them
+type eventStream struct {
+	mu          sync.Mutex
+	subscribers map[*streamSubscriber]struct{}
+	closed      bool
+	lastID      atomic.Uint64
+}
+
+// newEventStream creates an event stream without subscribers
+func newEventStream() *eventStream {
+	return &eventStream{subscribers: make(map[*streamSubscriber]struct{})}
+}
+
+// subscribe adds a client for a project's events. Its channel is closed when the
+// client falls behind or the server shuts down.
+func (es *eventStream) subscribe(projectID uuid.UUID) *streamSubscriber {
+	sub := &streamSubscriber{projectID: projectID, events: make(chan liveEvent, streamBufferSize)}
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if es.closed {
+		close(sub.events)
+		return sub
+	}
+	es.subscribers[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe removes a client that disconnected
+func (es *eventStream) unsubscribe(sub *streamSubscriber) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if _, ok := es.subscribers[sub]; ok {
+		delete(es.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// handleEvent sends an issue event to the clients of its project. It never blocks the
+// publisher: clients whose buffer is full are disconnected instead.
+func (es *eventStream) handleEvent(_ context.Context, event domain.Event) {
+	if event.Issue == nil {
+		return
+	}
+
+	live := liveEvent{
+		id:          es.lastID.Add(1),
+		Type:        event.Type,
+		OccurredAt:  event.OccurredAt,
+		Issue:       domain.NewWebhookIssue(event.Issue),
+		OldStatus:   event.OldStatus,
+		OldPriority: event.OldPriority,
+		OldSeverity: event.OldSeverity,
+	}
+	if event.Assignee != nil {
+		live.Assignee = &domain.WebhookAssignee{
+			UserID:    event.Assignee.UserID,
+			Name:      event.Assignee.User.Name,
+			DiscordID: event.Assignee.User.DiscordID,
+			Role:      event.Assignee.Role,
+		}
+	}
+
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	for sub := range es.subscribers {
+		if sub.projectID != event.Issue.ProjectID {
+			continue
+		}
+		select {
+		case sub.events <- live:
+		default:
+			delete(es.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// close disconnects every client so that shutdown does not wait for open streams
+func (es *eventStream) close() {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.closed = true
+	for sub := range es.subscribers {
+		delete(es.subscribers, sub)
+		close(sub.events)
+	}
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+// streamKeepAlive is how often an idle stream sends a comment so proxies keep it open
+const streamKeepAlive = 30 * time.Second
+
+// Subscribe registers the server for the issue events it streams to clients
+func (s *Server) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(s.events.handleEvent, streamedEventTypes...)
+}
+
+// handleProjectEvents handles GET /api/v1/projects/{id}/events, streaming the issue
+// events of a project as server-sent events until the client disconnects
+func (s *Server) handleProjectEvents(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+	if _, err := s.projectService.GetProject(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	// The stream outlives the server's write timeout
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		s.logger.Error("Failed to clear event stream write deadline", zap.Error(err))
+	}
+
+	sub := s.events.subscribe(id)
+	defer s.events.unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 5000\n\n")
+	if err := rc.Flush(); err != nil {
+		s.logger.Error("Event stream cannot be flushed", zap.Error(err))
+		return
+	}
+
+	keepAlive := time.NewTicker(streamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				s.logger.Error("Failed to encode streamed event", zap.Error(err))
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.id, event.Type, data); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// maxGraphQLRequestSize bounds the body of a GraphQL request
+const maxGraphQLRequestSize = 1 << 20
+
+// graphQLRequest is a GraphQL request as sent by a client
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// handleGraphQL handles GET and POST /api/v1/graphql. POST takes a JSON body with
+// query, operationName and variables; GET takes them as query parameters, with the
+// variables JSON-encoded.
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	req, err := readGraphQLRequest(w, r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if strings.TrimSpace(req.Query) == "" {
+		s.writeError(w, http.StatusBadRequest, "missing query")
+		return
+	}
+
+	resp, executed := s.executeGraphQL(r.Context(), req)
+
+	// Requests that fail before execution are the client's fault; field errors are not
+	status := http.StatusOK
+	if !executed {
+		status = http.StatusBadRequest
+	}
+	s.writeJSON(w, status, resp)
+}
+
+// readGraphQLRequest reads a GraphQL request from the body or the query parameters
+func readGraphQLRequest(w http.ResponseWriter, r *http.Request) (graphQLRequest, error) {
+	var req graphQLRequest
+
+	if r.Method == http.MethodGet {
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if variables := params.Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return req, errors.New("variables must be a JSON object")
+			}
+		}
+		return req, nil
+	}
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLRequestSize)).Decode(&req); err != nil {
+		return req, errors.New("invalid request body")
+	}
+	return req, nil
+}
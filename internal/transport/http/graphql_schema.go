@@ -0,0 +1,735 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"go.uber.org/zap"
+)
+
+// graphQLMaxDepth bounds how deeply queries may nest relations, e.g.
+// customer → projects → issues → nodes → assignees → user
+const graphQLMaxDepth = 10
+
+const (
+	// graphQLMaxFragments bounds the named fragments a query may define
+	graphQLMaxFragments = 50
+	// graphQLMaxSelections bounds the fields, spreads and inline fragments a query may have
+	graphQLMaxSelections = 500
+)
+
+// issueConnection is one page of issues and the number of issues matching the query
+type issueConnection struct {
+	TotalCount int64
+	Nodes      []*domain.Issue
+}
+
+// dateTimeScalar is an RFC 3339 timestamp
+var dateTimeScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "DateTime",
+	Description: "An RFC 3339 timestamp",
+	Serialize: func(v any) any {
+		switch t := v.(type) {
+		case time.Time:
+			return t.UTC().Format(time.RFC3339)
+		case *time.Time:
+			if t != nil {
+				return t.UTC().Format(time.RFC3339)
+			}
+		}
+		return nil
+	},
+	ParseValue: func(v any) any {
+		s, _ := v.(string)
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil
+		}
+		return t
+	},
+	ParseLiteral: func(v ast.Value) any {
+		s, ok := v.(*ast.StringValue)
+		if !ok {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339, s.Value)
+		if err != nil {
+			return nil
+		}
+		return t
+	},
+})
+
+// enumOf creates an enum whose values are the given constants, so fields resolve from
+// and arguments parse into the domain type
+func enumOf[T ~string](name string, values ...T) *graphql.Enum {
+	config := make(graphql.EnumValueConfigMap, len(values))
+	for _, value := range values {
+		config[string(value)] = &graphql.EnumValueConfig{Value: value}
+	}
+	return graphql.NewEnum(graphql.EnumConfig{Name: name, Values: config})
+}
+
+var (
+	priorityEnum = enumOf("Priority", domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh)
+	severityEnum = enumOf("Severity", domain.SeverityS1, domain.SeverityS2, domain.SeverityS3, domain.SeverityS4)
+	// Issues keep their source as a plain string
+	sourceEnum = enumOf("Source", string(domain.SourceWeb), string(domain.SourceDiscord), string(domain.SourceRecurring),
+		string(domain.SourceEmail))
+	assigneeRoleEnum = enumOf("AssigneeRole", domain.AssigneeRoleDev, domain.AssigneeRoleQA, domain.AssigneeRoleReviewer,
+		domain.AssigneeRoleOther)
+	issueSortEnum = enumOf("IssueSort", domain.IssueSortNewest, domain.IssueSortOldest, domain.IssueSortUpdated,
+		domain.IssueSortStale, domain.IssueSortPriority, domain.IssueSortDueDate)
+	userRoleEnum = enumOf("UserRole", domain.UserRoleCustomer, domain.UserRoleSupport, domain.UserRoleAdmin)
+)
+
+// issueFilterInput narrows the issues of a query. Status is a string because projects
+// may define their own workflow statuses.
+var issueFilterInput = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "IssueFilter",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"status":        {Type: graphql.String},
+		"priority":      {Type: priorityEnum},
+		"source":        {Type: sourceEnum},
+		"customerId":    {Type: graphql.ID},
+		"projectId":     {Type: graphql.ID},
+		"milestoneId":   {Type: graphql.ID},
+		"assigneeId":    {Type: graphql.ID},
+		"reporterId":    {Type: graphql.ID},
+		"createdAfter":  {Type: dateTimeScalar},
+		"createdBefore": {Type: dateTimeScalar},
+		"text":          {Type: graphql.String},
+		"sort":          {Type: issueSortEnum},
+	},
+})
+
+// pageArgs are the offset and limit arguments of paginated fields
+var pageArgs = graphql.FieldConfigArgument{
+	"offset": {Type: graphql.Int, DefaultValue: 0},
+	"limit":  {Type: graphql.Int, DefaultValue: defaultPageLimit},
+}
+
+// newGraphQLSchema builds the schema served at /api/v1/graphql. Root fields and relations
+// between types are nullable so that a key lacking a scope only loses those fields.
+func (s *Server) newGraphQLSchema() (*graphql.Schema, error) {
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"id":         {Type: graphql.NewNonNull(graphql.ID)},
+			"name":       {Type: graphql.String},
+			"role":       {Type: graphql.NewNonNull(userRoleEnum)},
+			"isInternal": {Type: graphql.NewNonNull(graphql.Boolean)},
+		},
+	})
+
+	assigneeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Assignee",
+		Fields: graphql.Fields{
+			"user":       {Type: graphql.NewNonNull(userType)},
+			"role":       {Type: graphql.NewNonNull(assigneeRoleEnum)},
+			"assignedAt": {Type: graphql.NewNonNull(dateTimeScalar)},
+		},
+	})
+
+	statusLogType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "StatusLog",
+		Fields: graphql.Fields{
+			"oldStatus": {Type: graphql.String, Resolve: func(p graphql.ResolveParams) (any, error) {
+				if old := p.Source.(*domain.IssueStatusLog).OldStatus; old != nil {
+					return *old, nil
+				}
+				return nil, nil
+			}},
+			"newStatus": {Type: graphql.NewNonNull(graphql.String)},
+			"note":      {Type: graphql.String},
+			"changedAt": {Type: graphql.NewNonNull(dateTimeScalar)},
+			"changedBy": {Type: userType, Resolve: func(p graphql.ResolveParams) (any, error) {
+				return p.Source.(*domain.IssueStatusLog).ChangedByUser, nil
+			}},
+		},
+	})
+
+	// Issues, projects and customers refer to each other, so their fields are built once
+	// all three exist
+	var issueType, projectType, customerType, connectionType *graphql.Object
+
+	issueType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Issue",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":               {Type: graphql.NewNonNull(graphql.ID)},
+				"key":              {Type: graphql.String},
+				"number":           {Type: graphql.Int},
+				"title":            {Type: graphql.NewNonNull(graphql.String)},
+				"description":      {Type: graphql.NewNonNull(graphql.String)},
+				"status":           {Type: graphql.NewNonNull(graphql.String)},
+				"priority":         {Type: graphql.NewNonNull(priorityEnum)},
+				"severity":         {Type: severityEnum, Resolve: resolveIssueSeverity},
+				"source":           {Type: graphql.NewNonNull(sourceEnum)},
+				"imageUrl":         {Type: graphql.String},
+				"resolutionCause":  {Type: graphql.String},
+				"resolutionAction": {Type: graphql.String},
+				"dueDate":          {Type: dateTimeScalar},
+				"createdAt":        {Type: graphql.NewNonNull(dateTimeScalar)},
+				"updatedAt":        {Type: graphql.NewNonNull(dateTimeScalar)},
+				"closedAt":         {Type: dateTimeScalar},
+				"project":          {Type: projectType, Resolve: s.resolveIssueProject},
+				"reporter":         {Type: userType, Resolve: resolveIssueReporter},
+				"assignees":        {Type: graphql.NewList(graphql.NewNonNull(assigneeType)), Resolve: s.resolveIssueAssignees},
+				"statusLogs":       {Type: graphql.NewList(graphql.NewNonNull(statusLogType)), Resolve: s.resolveIssueStatusLogs},
+			}
+		}),
+	})
+
+	connectionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "IssueConnection",
+		Fields: graphql.Fields{
+			"totalCount": {Type: graphql.NewNonNull(graphql.Int)},
+			"nodes":      {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(issueType)))},
+		},
+	})
+
+	projectType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Project",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          {Type: graphql.NewNonNull(graphql.ID)},
+				"name":        {Type: graphql.NewNonNull(graphql.String)},
+				"description": {Type: graphql.String},
+				"keyPrefix":   {Type: graphql.String},
+				"createdAt":   {Type: graphql.NewNonNull(dateTimeScalar)},
+				"updatedAt":   {Type: graphql.NewNonNull(dateTimeScalar)},
+				"customer":    {Type: customerType, Resolve: s.resolveProjectCustomer},
+				"issues": {
+					Type:    connectionType,
+					Args:    withPageArgs(graphql.FieldConfigArgument{"filter": {Type: issueFilterInput}}),
+					Resolve: s.resolveProjectIssues,
+				},
+			}
+		}),
+	})
+
+	customerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Customer",
+		Fields: graphql.Fields{
+			"id":        {Type: graphql.NewNonNull(graphql.ID)},
+			"name":      {Type: graphql.NewNonNull(graphql.String)},
+			"createdAt": {Type: graphql.NewNonNull(dateTimeScalar)},
+			"updatedAt": {Type: graphql.NewNonNull(dateTimeScalar)},
+			"projects":  {Type: graphql.NewList(graphql.NewNonNull(projectType)), Resolve: s.resolveCustomerProjects},
+			"issues": {
+				Type:    connectionType,
+				Args:    withPageArgs(graphql.FieldConfigArgument{"filter": {Type: issueFilterInput}}),
+				Resolve: s.resolveCustomerIssues,
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"issue": {
+				Type:    issueType,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.ID}, "key": {Type: graphql.String}},
+				Resolve: s.resolveIssue,
+			},
+			"issues": {
+				Type:    connectionType,
+				Args:    withPageArgs(graphql.FieldConfigArgument{"filter": {Type: issueFilterInput}}),
+				Resolve: s.resolveIssues,
+			},
+			"project": {
+				Type:    projectType,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: s.resolveProject,
+			},
+			"projects": {
+				Type:    graphql.NewList(graphql.NewNonNull(projectType)),
+				Args:    withPageArgs(nil),
+				Resolve: s.resolveProjects,
+			},
+			"customer": {
+				Type:    customerType,
+				Args:    graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.ID)}},
+				Resolve: s.resolveCustomer,
+			},
+			"customers": {
+				Type:    graphql.NewList(graphql.NewNonNull(customerType)),
+				Args:    withPageArgs(nil),
+				Resolve: s.resolveCustomers,
+			},
+		},
+	})
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+	if err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// executeGraphQL parses, validates and executes a request against the schema. Documents
+// with more fragments, selections or nesting than allowed are rejected before they run.
+// It reports whether the request got past parsing and validation.
+func (s *Server) executeGraphQL(ctx context.Context, req graphQLRequest) (*graphql.Result, bool) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, false
+	}
+	if err := checkGraphQLSize(doc); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, false
+	}
+	if validation := graphql.ValidateDocument(s.graphQLSchema, doc, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}, false
+	}
+	if err := checkGraphQLDepth(doc); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}, false
+	}
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        *s.graphQLSchema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+	// Root fields are nullable, so only requests whose operation or variables were
+	// rejected have no data
+	return result, result.Data != nil
+}
+
+// checkGraphQLSize rejects documents with more fragments or selections than allowed,
+// before any of them is validated
+func checkGraphQLSize(doc *ast.Document) error {
+	fragments, selections := 0, 0
+	for _, node := range doc.Definitions {
+		def, ok := node.(ast.Definition)
+		if !ok {
+			continue
+		}
+		if _, ok := def.(*ast.FragmentDefinition); ok {
+			fragments++
+		}
+		selections += countGraphQLSelections(def.GetSelectionSet())
+	}
+	if fragments > graphQLMaxFragments {
+		return fmt.Errorf("document defines more than the maximum of %d fragments", graphQLMaxFragments)
+	}
+	if selections > graphQLMaxSelections {
+		return fmt.Errorf("document has more than the maximum of %d selections", graphQLMaxSelections)
+	}
+	return nil
+}
+
+// countGraphQLSelections counts the selections written in a selection set, without
+// following fragment spreads
+func countGraphQLSelections(set *ast.SelectionSet) int {
+	if set == nil {
+		return 0
+	}
+	count := len(set.Selections)
+	for _, sel := range set.Selections {
+		count += countGraphQLSelections(sel.GetSelectionSet())
+	}
+	return count
+}
+
+// checkGraphQLDepth rejects operations nesting fields deeper than allowed, following
+// fragment spreads. The document must be valid, so fragments do not spread themselves.
+func checkGraphQLDepth(doc *ast.Document) error {
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		if frag, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[frag.Name.Value] = frag
+		}
+	}
+
+	var depthOf func(set *ast.SelectionSet) int
+	depthOf = func(set *ast.SelectionSet) int {
+		deepest := 0
+		for _, sel := range set.Selections {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				if sel.SelectionSet != nil {
+					deepest = max(deepest, depthOf(sel.SelectionSet))
+				}
+			case *ast.InlineFragment:
+				deepest = max(deepest, depthOf(sel.SelectionSet)-1)
+			case *ast.FragmentSpread:
+				deepest = max(deepest, depthOf(fragments[sel.Name.Value].SelectionSet)-1)
+			}
+		}
+		return deepest + 1
+	}
+
+	for _, def := range doc.Definitions {
+		if op, ok := def.(*ast.OperationDefinition); ok && depthOf(op.SelectionSet) > graphQLMaxDepth {
+			return fmt.Errorf("query is nested deeper than the maximum depth of %d", graphQLMaxDepth)
+		}
+	}
+	return nil
+}
+
+// withPageArgs adds the offset and limit arguments to a field's arguments
+func withPageArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	if args == nil {
+		args = make(graphql.FieldConfigArgument)
+	}
+	for name, arg := range pageArgs {
+		args[name] = arg
+	}
+	return args
+}
+
+// pageFromArgs reads the offset and limit arguments, capping the limit like REST listings
+func pageFromArgs(args map[string]any) (offset, limit int) {
+	offset, _ = args["offset"].(int)
+	limit, _ = args["limit"].(int)
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	return offset, min(limit, maxPageLimit)
+}
+
+// resolveIssue handles Query.issue, looking the issue up by ID or key
+func (s *Server) resolveIssue(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeIssuesRead); err != nil {
+		return nil, err
+	}
+
+	var issue *domain.Issue
+	var err error
+	switch {
+	case p.Args["id"] != nil:
+		id, parseErr := parseGraphQLID(p.Args["id"])
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		issue, err = s.issueService.GetIssue(p.Context, id)
+	case p.Args["key"] != nil:
+		issue, err = s.issueService.GetIssueByKey(p.Context, p.Args["key"].(string))
+	default:
+		return nil, fmt.Errorf("issue requires an id or a key")
+	}
+	if err != nil {
+		return s.graphQLResult(err)
+	}
+
+	if !contextAPIKey(p.Context).CanAccessCustomer(issue.Project.CustomerID) {
+		return nil, nil
+	}
+	return issue, nil
+}
+
+// resolveIssues handles Query.issues. Keys bound to a customer only see its issues.
+func (s *Server) resolveIssues(p graphql.ResolveParams) (any, error) {
+	return s.searchIssues(p.Context, p.Args, domain.IssueQuery{})
+}
+
+// resolveProjectIssues handles Project.issues
+func (s *Server) resolveProjectIssues(p graphql.ResolveParams) (any, error) {
+	return s.searchIssues(p.Context, p.Args, domain.IssueQuery{ProjectID: p.Source.(*domain.Project).ID})
+}
+
+// resolveCustomerIssues handles Customer.issues
+func (s *Server) resolveCustomerIssues(p graphql.ResolveParams) (any, error) {
+	return s.searchIssues(p.Context, p.Args, domain.IssueQuery{CustomerID: p.Source.(*domain.Customer).ID})
+}
+
+// searchIssues runs an issue search from the filter argument. Scope holds the project
+// or customer the field belongs to, which the filter cannot widen.
+func (s *Server) searchIssues(ctx context.Context, args map[string]any, scope domain.IssueQuery) (any, error) {
+	if err := requireScope(ctx, domain.ScopeIssuesRead); err != nil {
+		return nil, err
+	}
+
+	search, err := issueQueryFromFilter(args["filter"])
+	if err != nil {
+		return nil, err
+	}
+	if scope.ProjectID != uuid.Nil {
+		if search.ProjectID != uuid.Nil && search.ProjectID != scope.ProjectID {
+			return &issueConnection{Nodes: []*domain.Issue{}}, nil
+		}
+		search.ProjectID = scope.ProjectID
+	}
+	if scope.CustomerID != uuid.Nil {
+		if search.CustomerID != uuid.Nil && search.CustomerID != scope.CustomerID {
+			return &issueConnection{Nodes: []*domain.Issue{}}, nil
+		}
+		search.CustomerID = scope.CustomerID
+	}
+	if customerID := contextAPIKey(ctx).CustomerID; customerID != nil {
+		if search.CustomerID != uuid.Nil && search.CustomerID != *customerID {
+			return &issueConnection{Nodes: []*domain.Issue{}}, nil
+		}
+		search.CustomerID = *customerID
+	}
+
+	search.Offset, search.Limit = pageFromArgs(args)
+	issues, total, err := s.issueService.SearchIssues(ctx, search)
+	if err != nil {
+		return nil, s.graphQLError(err)
+	}
+	return &issueConnection{TotalCount: total, Nodes: issues}, nil
+}
+
+// issueQueryFromFilter converts an IssueFilter argument to a query
+func issueQueryFromFilter(filter any) (domain.IssueQuery, error) {
+	var search domain.IssueQuery
+	fields, _ := filter.(map[string]any)
+
+	if status, ok := fields["status"].(string); ok {
+		search.Statuses = []domain.Status{domain.Status(status)}
+	}
+	if priority, ok := fields["priority"].(domain.Priority); ok {
+		search.Priority = priority
+	}
+	if source, ok := fields["source"].(string); ok {
+		search.Source = domain.Source(source)
+	}
+	if after, ok := fields["createdAfter"].(time.Time); ok {
+		search.CreatedAfter = after
+	}
+	if before, ok := fields["createdBefore"].(time.Time); ok {
+		search.CreatedBefore = before
+	}
+	if text, ok := fields["text"].(string); ok {
+		search.Text = text
+	}
+	if sort, ok := fields["sort"].(domain.IssueSort); ok {
+		search.Sort = sort
+	}
+
+	for name, dst := range map[string]*uuid.UUID{
+		"customerId":  &search.CustomerID,
+		"projectId":   &search.ProjectID,
+		"milestoneId": &search.MilestoneID,
+		"assigneeId":  &search.AssigneeID,
+		"reporterId":  &search.ReporterID,
+	} {
+		if fields[name] == nil {
+			continue
+		}
+		id, err := parseGraphQLID(fields[name])
+		if err != nil {
+			return domain.IssueQuery{}, fmt.Errorf("filter %s: %w", name, err)
+		}
+		*dst = id
+	}
+
+	return search, nil
+}
+
+// resolveIssueProject handles Issue.project
+func (s *Server) resolveIssueProject(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeProjectsRead); err != nil {
+		return nil, err
+	}
+
+	issue := p.Source.(*domain.Issue)
+	if issue.Project.ID != uuid.Nil {
+		return &issue.Project, nil
+	}
+	project, err := s.projectService.GetProject(p.Context, issue.ProjectID)
+	if err != nil {
+		return s.graphQLResult(err)
+	}
+	return project, nil
+}
+
+// resolveIssueReporter handles Issue.reporter, which issue queries preload
+func resolveIssueReporter(p graphql.ResolveParams) (any, error) {
+	issue := p.Source.(*domain.Issue)
+	if issue.Reporter.ID == uuid.Nil {
+		return nil, nil
+	}
+	return &issue.Reporter, nil
+}
+
+// resolveIssueSeverity handles Issue.severity, which is null for issues without one
+func resolveIssueSeverity(p graphql.ResolveParams) (any, error) {
+	issue := p.Source.(*domain.Issue)
+	if issue.Severity == "" {
+		return nil, nil
+	}
+	return issue.Severity, nil
+}
+
+// resolveIssueAssignees handles Issue.assignees
+func (s *Server) resolveIssueAssignees(p graphql.ResolveParams) (any, error) {
+	assignees, err := s.issueAssigneeService.GetIssueAssignees(p.Context, p.Source.(*domain.Issue).ID)
+	if err != nil {
+		return nil, s.graphQLError(err)
+	}
+	return assignees, nil
+}
+
+// resolveIssueStatusLogs handles Issue.statusLogs, oldest first
+func (s *Server) resolveIssueStatusLogs(p graphql.ResolveParams) (any, error) {
+	logs, err := s.statusLogService.GetIssueStatusHistory(p.Context, p.Source.(*domain.Issue).ID)
+	if err != nil {
+		return nil, s.graphQLError(err)
+	}
+	return logs, nil
+}
+
+// resolveProject handles Query.project
+func (s *Server) resolveProject(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeProjectsRead); err != nil {
+		return nil, err
+	}
+
+	id, err := parseGraphQLID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	project, err := s.projectService.GetProject(p.Context, id)
+	if err != nil {
+		return s.graphQLResult(err)
+	}
+	if !contextAPIKey(p.Context).CanAccessCustomer(project.CustomerID) {
+		return nil, nil
+	}
+	return project, nil
+}
+
+// resolveProjects handles Query.projects. Keys bound to a customer only see its projects.
+func (s *Server) resolveProjects(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeProjectsRead); err != nil {
+		return nil, err
+	}
+
+	offset, limit := pageFromArgs(p.Args)
+	if customerID := contextAPIKey(p.Context).CustomerID; customerID != nil {
+		projects, err := s.projectService.GetProjectsByCustomer(p.Context, *customerID)
+		if err != nil {
+			return nil, s.graphQLError(err)
+		}
+		return pageOf(projects, offset, limit), nil
+	}
+
+	projects, err := s.projectService.ListProjects(p.Context, offset, limit)
+	if err != nil {
+		return nil, s.graphQLError(err)
+	}
+	return projects, nil
+}
+
+// resolveProjectCustomer handles Project.customer
+func (s *Server) resolveProjectCustomer(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeCustomersRead); err != nil {
+		return nil, err
+	}
+
+	project := p.Source.(*domain.Project)
+	if project.Customer.ID != uuid.Nil {
+		return &project.Customer, nil
+	}
+	customer, err := s.customerService.GetCustomer(p.Context, project.CustomerID)
+	if err != nil {
+		return s.graphQLResult(err)
+	}
+	return customer, nil
+}
+
+// resolveCustomer handles Query.customer
+func (s *Server) resolveCustomer(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeCustomersRead); err != nil {
+		return nil, err
+	}
+
+	id, err := parseGraphQLID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+	if !contextAPIKey(p.Context).CanAccessCustomer(id) {
+		return nil, nil
+	}
+	customer, err := s.customerService.GetCustomer(p.Context, id)
+	if err != nil {
+		return s.graphQLResult(err)
+	}
+	return customer, nil
+}
+
+// resolveCustomers handles Query.customers. Keys bound to a customer only see it.
+func (s *Server) resolveCustomers(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeCustomersRead); err != nil {
+		return nil, err
+	}
+
+	offset, limit := pageFromArgs(p.Args)
+	if customerID := contextAPIKey(p.Context).CustomerID; customerID != nil {
+		customer, err := s.customerService.GetCustomer(p.Context, *customerID)
+		if err != nil {
+			return nil, s.graphQLError(err)
+		}
+		return pageOf([]*domain.Customer{customer}, offset, limit), nil
+	}
+
+	customers, err := s.customerService.ListCustomers(p.Context, offset, limit)
+	if err != nil {
+		return nil, s.graphQLError(err)
+	}
+	return customers, nil
+}
+
+// resolveCustomerProjects handles Customer.projects
+func (s *Server) resolveCustomerProjects(p graphql.ResolveParams) (any, error) {
+	if err := requireScope(p.Context, domain.ScopeProjectsRead); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.projectService.GetProjectsByCustomer(p.Context, p.Source.(*domain.Customer).ID)
+	if err != nil {
+		return nil, s.graphQLError(err)
+	}
+	return projects, nil
+}
+
+// parseGraphQLID parses an ID argument as a UUID
+func parseGraphQLID(v any) (uuid.UUID, error) {
+	s, _ := v.(string)
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid ID %q", s)
+	}
+	return id, nil
+}
+
+// graphQLResult resolves a lookup that failed: missing records resolve to null like
+// records of other customers, so keys cannot probe for them
+func (s *Server) graphQLResult(err error) (any, error) {
+	if errors.Is(err, domain.ErrIssueNotFound) ||
+		errors.Is(err, domain.ErrProjectNotFound) ||
+		errors.Is(err, domain.ErrCustomerNotFound) {
+		return nil, nil
+	}
+	return nil, s.graphQLError(err)
+}
+
+// graphQLError reports a service error to the client. Unexpected errors are logged and
+// hidden, as with writeServiceError.
+func (s *Server) graphQLError(err error) error {
+	if domain.KindOf(err) != domain.KindInternal {
+		return err
+	}
+	s.logger.Error("GraphQL resolver failed", zap.Error(err))
+	return errors.New("internal server error")
+}
@@ -0,0 +1,348 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"go.uber.org/zap"
+)
+
+// Fixtures shared by the tests: two customers with one project and one issue each
+var (
+	customerA = &domain.Customer{ID: uuid.MustParse("00000000-0000-0000-0000-00000000000a"), Name: "Acme"}
+	customerB = &domain.Customer{ID: uuid.MustParse("00000000-0000-0000-0000-00000000000b"), Name: "Globex"}
+	projectA  = &domain.Project{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000a1"), CustomerID: customerA.ID, Name: "Portal"}
+	projectB  = &domain.Project{ID: uuid.MustParse("00000000-0000-0000-0000-0000000000b1"), CustomerID: customerB.ID, Name: "Shop"}
+	issueA    = &domain.Issue{ID: uuid.MustParse("00000000-0000-0000-0000-0000000a1001"), ProjectID: projectA.ID, Project: *projectA,
+		Key: "POR-1", Title: "Login fails", Status: domain.StatusOpen, Priority: domain.PriorityHigh, Source: string(domain.SourceWeb)}
+	issueB = &domain.Issue{ID: uuid.MustParse("00000000-0000-0000-0000-0000000b1001"), ProjectID: projectB.ID, Project: *projectB,
+		Key: "SHO-1", Title: "Cart empty", Status: domain.StatusOpen, Priority: domain.PriorityLow, Source: string(domain.SourceWeb)}
+)
+
+// fakeIssueService serves the issue fixtures
+type fakeIssueService struct {
+	domain.IssueService
+}
+
+func (fakeIssueService) GetIssue(ctx context.Context, id uuid.UUID) (*domain.Issue, error) {
+	for _, issue := range []*domain.Issue{issueA, issueB} {
+		if issue.ID == id {
+			return issue, nil
+		}
+	}
+	return nil, domain.ErrIssueNotFound
+}
+
+func (fakeIssueService) GetIssueByKey(ctx context.Context, key string) (*domain.Issue, error) {
+	for _, issue := range []*domain.Issue{issueA, issueB} {
+		if issue.Key == key {
+			return issue, nil
+		}
+	}
+	return nil, domain.ErrIssueNotFound
+}
+
+func (fakeIssueService) SearchIssues(ctx context.Context, query domain.IssueQuery) ([]*domain.Issue, int64, error) {
+	var issues []*domain.Issue
+	for _, issue := range []*domain.Issue{issueA, issueB} {
+		if query.CustomerID != uuid.Nil && issue.Project.CustomerID != query.CustomerID {
+			continue
+		}
+		if query.ProjectID != uuid.Nil && issue.ProjectID != query.ProjectID {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues, int64(len(issues)), nil
+}
+
+// fakeProjectService serves the project fixtures
+type fakeProjectService struct {
+	domain.ProjectService
+}
+
+func (fakeProjectService) GetProject(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+	for _, project := range []*domain.Project{projectA, projectB} {
+		if project.ID == id {
+			return project, nil
+		}
+	}
+	return nil, domain.ErrProjectNotFound
+}
+
+func (fakeProjectService) GetProjectsByCustomer(ctx context.Context, customerID uuid.UUID) ([]*domain.Project, error) {
+	var projects []*domain.Project
+	for _, project := range []*domain.Project{projectA, projectB} {
+		if project.CustomerID == customerID {
+			projects = append(projects, project)
+		}
+	}
+	return projects, nil
+}
+
+func (fakeProjectService) ListProjects(ctx context.Context, offset, limit int) ([]*domain.Project, error) {
+	return pageOf([]*domain.Project{projectA, projectB}, offset, limit), nil
+}
+
+// fakeCustomerService serves the customer fixtures
+type fakeCustomerService struct {
+	domain.CustomerService
+}
+
+func (fakeCustomerService) GetCustomer(ctx context.Context, id uuid.UUID) (*domain.Customer, error) {
+	for _, customer := range []*domain.Customer{customerA, customerB} {
+		if customer.ID == id {
+			return customer, nil
+		}
+	}
+	return nil, domain.ErrCustomerNotFound
+}
+
+func (fakeCustomerService) ListCustomers(ctx context.Context, offset, limit int) ([]*domain.Customer, error) {
+	return pageOf([]*domain.Customer{customerA, customerB}, offset, limit), nil
+}
+
+// executeGraphQL runs a query with an API key of the given scopes, bound to a customer
+// when customerID is not nil
+func executeGraphQL(t *testing.T, scopes string, customerID *uuid.UUID, query string) (map[string]any, []gqlerrors.FormattedError) {
+	t.Helper()
+
+	s := newGraphQLTestServer()
+	key := &domain.APIKey{Scopes: scopes, CustomerID: customerID}
+	ctx := context.WithValue(context.Background(), apiKeyContextKey{}, key)
+
+	resp, executed := s.executeGraphQL(ctx, graphQLRequest{Query: query})
+	if !executed {
+		t.Fatalf("query was not executed: %v", resp.Errors)
+	}
+	data, ok := resp.Data.(map[string]any)
+	if !ok {
+		t.Fatalf("invalid response data %v", resp.Data)
+	}
+	return data, resp.Errors
+}
+
+// newGraphQLTestServer creates a server backed by the fake services
+func newGraphQLTestServer() *Server {
+	return NewServer(&config.HTTPConfig{}, fakeIssueService{}, fakeProjectService{}, fakeCustomerService{}, nil, nil, nil, nil, zap.NewNop())
+}
+
+func TestGraphQLFieldScopes(t *testing.T) {
+	tests := []struct {
+		name      string
+		scopes    string
+		query     string
+		want      string // Response data as JSON
+		wantError string // Expected field error; empty when there is none
+	}{
+		{
+			name:   "issue with issues:read",
+			scopes: "issues:read",
+			query:  `{ issue(key: "POR-1") { key title } }`,
+			want:   `{"issue":{"key":"POR-1","title":"Login fails"}}`,
+		},
+		{
+			name:      "issue without issues:read",
+			scopes:    "projects:read",
+			query:     `{ issue(key: "POR-1") { key } }`,
+			want:      `{"issue":null}`,
+			wantError: "api key lacks the issues:read scope",
+		},
+		{
+			name:      "issue project without projects:read",
+			scopes:    "issues:read",
+			query:     `{ issue(key: "POR-1") { key project { name } } }`,
+			want:      `{"issue":{"key":"POR-1","project":null}}`,
+			wantError: "api key lacks the projects:read scope",
+		},
+		{
+			name:   "issue project with projects:read",
+			scopes: "issues:read,projects:read",
+			query:  `{ issue(key: "POR-1") { project { name } } }`,
+			want:   `{"issue":{"project":{"name":"Portal"}}}`,
+		},
+		{
+			name:      "project customer without customers:read",
+			scopes:    "projects:read",
+			query:     `{ projects { name customer { name } } }`,
+			want:      `{"projects":[{"name":"Portal","customer":null},{"name":"Shop","customer":null}]}`,
+			wantError: "api key lacks the customers:read scope",
+		},
+		{
+			name:      "customer issues without issues:read",
+			scopes:    "customers:read",
+			query:     `{ customers { name issues { totalCount } } }`,
+			want:      `{"customers":[{"name":"Acme","issues":null},{"name":"Globex","issues":null}]}`,
+			wantError: "api key lacks the issues:read scope",
+		},
+		{
+			name:   "write scope grants reading",
+			scopes: "issues:write",
+			query:  `{ issues { totalCount } }`,
+			want:   `{"issues":{"totalCount":2}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, errs := executeGraphQL(t, tt.scopes, nil, tt.query)
+			assertGraphQLData(t, data, tt.want)
+
+			if tt.wantError == "" {
+				if len(errs) > 0 {
+					t.Fatalf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || !strings.Contains(errs[0].Message, tt.wantError) {
+				t.Errorf("errors %v do not contain %q", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestGraphQLCustomerBoundKeys(t *testing.T) {
+	const scopes = "issues:read,projects:read,customers:read"
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "own issue",
+			query: `{ issue(key: "POR-1") { key } }`,
+			want:  `{"issue":{"key":"POR-1"}}`,
+		},
+		{
+			name:  "other customer's issue",
+			query: `{ issue(key: "SHO-1") { key } }`,
+			want:  `{"issue":null}`,
+		},
+		{
+			name:  "issue search",
+			query: `{ issues { totalCount nodes { key } } }`,
+			want:  `{"issues":{"totalCount":1,"nodes":[{"key":"POR-1"}]}}`,
+		},
+		{
+			name:  "issue search filtered on another customer",
+			query: `{ issues(filter: {customerId: "` + customerB.ID.String() + `"}) { totalCount } }`,
+			want:  `{"issues":{"totalCount":0}}`,
+		},
+		{
+			name:  "other customer's project",
+			query: `{ project(id: "` + projectB.ID.String() + `") { name } }`,
+			want:  `{"project":null}`,
+		},
+		{
+			name:  "projects",
+			query: `{ projects { name } }`,
+			want:  `{"projects":[{"name":"Portal"}]}`,
+		},
+		{
+			name:  "other customer",
+			query: `{ customer(id: "` + customerB.ID.String() + `") { name } }`,
+			want:  `{"customer":null}`,
+		},
+		{
+			name:  "customers",
+			query: `{ customers { name } }`,
+			want:  `{"customers":[{"name":"Acme"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, errs := executeGraphQL(t, scopes, &customerA.ID, tt.query)
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			assertGraphQLData(t, data, tt.want)
+		})
+	}
+}
+
+func TestGraphQLLimits(t *testing.T) {
+	// nested selects issue → project → customer → projects … down to a name at the given depth
+	nested := func(depth int) string {
+		fields := []string{`issue(key: "POR-1")`, "project"}
+		for len(fields) < depth-1 {
+			if len(fields)%2 == 0 {
+				fields = append(fields, "customer")
+			} else {
+				fields = append(fields, "projects")
+			}
+		}
+		return "{ " + strings.Join(fields, " { ") + " { name" + strings.Repeat(" }", len(fields)) + " }"
+	}
+	fragments := func(n int) string {
+		query := "{ issue(key: \"POR-1\") { key } }"
+		for i := range n {
+			query += fmt.Sprintf(" fragment f%d on Issue { key }", i)
+		}
+		return query
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantError string // Empty when the query runs
+	}{
+		{name: "at maximum depth", query: nested(graphQLMaxDepth)},
+		{name: "too deep", query: nested(graphQLMaxDepth + 1), wantError: "maximum depth"},
+		{
+			name:      "too deep through a fragment",
+			query:     "{ issue(key: \"POR-1\") { ...deep } } fragment deep on Issue { project { " + strings.Repeat("customer { projects { ", 4) + "name" + strings.Repeat(" } }", 4) + " } }",
+			wantError: "maximum depth",
+		},
+		{name: "too many fragments", query: fragments(graphQLMaxFragments + 1), wantError: "fragments"},
+		{name: "too many selections", query: "{ issue(key: \"POR-1\") { " + strings.Repeat("key ", graphQLMaxSelections) + "} }", wantError: "selections"},
+		{name: "mutation", query: "mutation { issue(key: \"POR-1\") { key } }", wantError: "mutation"},
+		{name: "syntax error", query: "{ issue(", wantError: "Syntax Error"},
+	}
+
+	s := newGraphQLTestServer()
+	key := &domain.APIKey{Scopes: "issues:read,projects:read,customers:read"}
+	ctx := context.WithValue(context.Background(), apiKeyContextKey{}, key)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, executed := s.executeGraphQL(ctx, graphQLRequest{Query: tt.query})
+			if tt.wantError == "" {
+				if !executed || len(resp.Errors) > 0 {
+					t.Fatalf("query was rejected: %v", resp.Errors)
+				}
+				return
+			}
+			if executed {
+				t.Fatalf("query was executed, want it rejected with %q", tt.wantError)
+			}
+			if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0].Message, tt.wantError) {
+				t.Errorf("errors %v do not contain %q", resp.Errors, tt.wantError)
+			}
+		})
+	}
+}
+
+// assertGraphQLData compares response data with the expected JSON
+func assertGraphQLData(t *testing.T, data map[string]any, want string) {
+	t.Helper()
+
+	var expected map[string]any
+	if err := json.Unmarshal([]byte(want), &expected); err != nil {
+		t.Fatalf("invalid expected data %s: %v", want, err)
+	}
+	got, _ := json.Marshal(data)
+	wantJSON, _ := json.Marshal(expected)
+	if string(got) != string(wantJSON) {
+		t.Errorf("data = %s, want %s", got, wantJSON)
+	}
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// readinessTimeout bounds all readiness checks of one /readyz request
+const readinessTimeout = 3 * time.Second
+
+// HealthCheck reports whether a dependency of the bot is usable
+type HealthCheck func(ctx context.Context) error
+
+// namedCheck is a readiness check with the name it is reported under
+type namedCheck struct {
+	name  string
+	check HealthCheck
+}
+
+// healthResponse is the JSON body of the health endpoints
+type healthResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// AddReadinessCheck registers a check that must pass for /readyz to report ready.
+// It must be called before Start.
+func (s *Server) AddReadinessCheck(name string, check HealthCheck) {
+	s.readinessChecks = append(s.readinessChecks, namedCheck{name: name, check: check})
+}
+
+// handleHealthz handles GET /healthz. It only reports that the process serves requests,
+// so a liveness probe does not restart the bot while a dependency is briefly unavailable.
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	s.writeJSON(w, http.StatusOK, healthResponse{Status: "ok"})
+}
+
+// handleReadyz handles GET /readyz, running every readiness check
+func (s *Server) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	status := http.StatusOK
+	resp := healthResponse{Status: "ok", Checks: make(map[string]string, len(s.readinessChecks))}
+	for _, c := range s.readinessChecks {
+		if err := c.check(ctx); err != nil {
+			s.logger.Warn("Readiness check failed", zap.String("check", c.name), zap.Error(err))
+			status = http.StatusServiceUnavailable
+			resp.Status = "unavailable"
+			resp.Checks[c.name] = err.Error()
+			continue
+		}
+		resp.Checks[c.name] = "ok"
+	}
+
+	s.writeJSON(w, status, resp)
+}
+
+// isProbe checks if a request is a health probe or metrics scrape, which is logged at debug level only
+func isProbe(r *http.Request) bool {
+	return r.URL.Path == "/healthz" || r.URL.Path == "/readyz" || r.URL.Path == "/metrics"
+}
@@ -0,0 +1,274 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// createIssueRequest is the body accepted by POST /api/v1/issues
+type createIssueRequest struct {
+	ProjectID   uuid.UUID `json:"project_id"`
+	ReporterID  uuid.UUID `json:"reporter_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	ImageURL    string    `json:"image_url"`
+}
+
+// updateIssueRequest is the body accepted by PATCH /api/v1/issues/{id}
+type updateIssueRequest struct {
+	Status   *domain.Status   `json:"status"`
+	Priority *domain.Priority `json:"priority"`
+	Severity *domain.Severity `json:"severity"` // An empty severity removes it
+}
+
+// handleListIssues handles GET /api/v1/issues. Keys bound to a customer only see its issues.
+func (s *Server) handleListIssues(w http.ResponseWriter, r *http.Request) {
+	query, err := issueQueryFromRequest(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if customerID := keyCustomerID(r); customerID != nil {
+		if query.CustomerID != uuid.Nil && query.CustomerID != *customerID {
+			s.writeJSON(w, http.StatusOK, []*domain.Issue{})
+			return
+		}
+		query.CustomerID = *customerID
+	}
+
+	issues, _, err := s.issueService.SearchIssues(r.Context(), query)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, issues)
+}
+
+// issueQueryFromRequest reads the filters, sort order and page of GET /api/v1/issues
+func issueQueryFromRequest(r *http.Request) (domain.IssueQuery, error) {
+	params := r.URL.Query()
+	query := domain.IssueQuery{
+		Priority: domain.Priority(params.Get("priority")),
+		Source:   domain.Source(params.Get("source")),
+		Text:     params.Get("q"),
+		Sort:     domain.IssueSort(params.Get("sort")),
+	}
+	query.Offset, query.Limit = pagination(r)
+
+	for _, status := range params["status"] {
+		for _, value := range strings.Split(status, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				query.Statuses = append(query.Statuses, domain.Status(value))
+			}
+		}
+	}
+
+	for name, dst := range map[string]*uuid.UUID{
+		"customer_id":  &query.CustomerID,
+		"project_id":   &query.ProjectID,
+		"milestone_id": &query.MilestoneID,
+		"assignee_id":  &query.AssigneeID,
+		"reporter_id":  &query.ReporterID,
+	} {
+		if params.Get(name) == "" {
+			continue
+		}
+		id, err := uuid.Parse(params.Get(name))
+		if err != nil {
+			return domain.IssueQuery{}, fmt.Errorf("invalid %s", name)
+		}
+		*dst = id
+	}
+
+	for name, dst := range map[string]*time.Time{
+		"created_after":  &query.CreatedAfter,
+		"created_before": &query.CreatedBefore,
+	} {
+		if params.Get(name) == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, params.Get(name))
+		if err != nil {
+			return domain.IssueQuery{}, fmt.Errorf("%s must be an RFC 3339 timestamp", name)
+		}
+		*dst = t
+	}
+
+	return query, nil
+}
+
+// handleCreateIssue handles POST /api/v1/issues
+func (s *Server) handleCreateIssue(w http.ResponseWriter, r *http.Request) {
+	var req createIssueRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if strings.TrimSpace(req.Title) == "" {
+		s.writeError(w, http.StatusBadRequest, domain.ErrEmptyTitle.Error())
+		return
+	}
+	if strings.TrimSpace(req.Description) == "" {
+		s.writeError(w, http.StatusBadRequest, domain.ErrEmptyDescription.Error())
+		return
+	}
+	if req.ProjectID == uuid.Nil || req.ReporterID == uuid.Nil {
+		s.writeError(w, http.StatusBadRequest, "project_id and reporter_id are required")
+		return
+	}
+	if !s.authorizeProject(w, r, req.ProjectID) || !s.authorizeReporter(w, r, req.ReporterID) {
+		return
+	}
+
+	issue, err := s.issueService.CreateWebIssue(r.Context(), req.ProjectID, req.Title, req.Description, req.ImageURL, req.ReporterID)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, issue)
+}
+
+// handleGetIssue handles GET /api/v1/issues/{id}
+func (s *Server) handleGetIssue(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid issue ID")
+		return
+	}
+	if !s.authorizeIssue(w, r, id) {
+		return
+	}
+
+	issue, err := s.issueService.GetIssue(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, issue)
+}
+
+// handleListIssueComments handles GET /api/v1/issues/{id}/comments
+func (s *Server) handleListIssueComments(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid issue ID")
+		return
+	}
+	if !s.authorizeIssue(w, r, id) {
+		return
+	}
+
+	// Make unknown issues return 404 instead of an empty list
+	if _, err := s.issueService.GetIssue(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	comments, err := s.issueService.GetIssueComments(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, comments)
+}
+
+// handleUpdateIssue handles PATCH /api/v1/issues/{id}
+func (s *Server) handleUpdateIssue(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid issue ID")
+		return
+	}
+	if !s.authorizeIssue(w, r, id) {
+		return
+	}
+
+	var req updateIssueRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Priority != nil {
+		if !domain.IsValidPriority(*req.Priority) {
+			s.writeError(w, http.StatusBadRequest, domain.ErrInvalidPriority.Error())
+			return
+		}
+		if err := s.issueService.UpdateIssuePriority(r.Context(), id, *req.Priority); err != nil {
+			s.writeServiceError(w, err)
+			return
+		}
+	}
+
+	if req.Severity != nil {
+		if err := s.issueService.UpdateIssueSeverity(r.Context(), id, *req.Severity); err != nil {
+			s.writeServiceError(w, err)
+			return
+		}
+	}
+
+	if req.Status != nil {
+		// The service validates the status against the project's workflow
+		if err := s.issueService.UpdateIssueStatus(r.Context(), id, *req.Status, ""); err != nil {
+			s.writeServiceError(w, err)
+			return
+		}
+	}
+
+	issue, err := s.issueService.GetIssue(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, issue)
+}
+
+// handleDeleteIssue handles DELETE /api/v1/issues/{id}
+func (s *Server) handleDeleteIssue(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid issue ID")
+		return
+	}
+	if !s.authorizeIssue(w, r, id) {
+		return
+	}
+
+	if err := s.issueService.DeleteIssue(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRestoreIssue handles POST /api/v1/issues/{id}/restore
+func (s *Server) handleRestoreIssue(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid issue ID")
+		return
+	}
+	if !s.authorizeIssue(w, r, id) {
+		return
+	}
+
+	if err := s.issueService.RestoreIssue(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// metric is one sample in the Prometheus text exposition format
+type metric struct {
+	name  string
+	kind  string // gauge or counter
+	help  string
+	value float64
+}
+
+// CacheStats are the statistics of an in-memory cache
+type CacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+}
+
+// SetDBStats makes /metrics report the database connection pool statistics returned by stats.
+// It must be called before Start.
+func (s *Server) SetDBStats(stats func() sql.DBStats) {
+	s.dbStats = stats
+}
+
+// SetChannelCacheStats makes /metrics report the channel cache statistics returned by stats.
+// It must be called before Start.
+func (s *Server) SetChannelCacheStats(stats func() CacheStats) {
+	s.channelCacheStats = stats
+}
+
+// SetDiscordPanics makes /metrics report the number of panics recovered in the Discord
+// handlers returned by panics. It must be called before Start.
+func (s *Server) SetDiscordPanics(panics func() uint64) {
+	s.discordPanics = panics
+}
+
+// handleMetrics handles GET /metrics in the Prometheus text format
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	var metrics []metric
+	if s.dbStats != nil {
+		metrics = append(metrics, dbPoolMetrics(s.dbStats())...)
+	}
+	if s.channelCacheStats != nil {
+		metrics = append(metrics, channelCacheMetrics(s.channelCacheStats())...)
+	}
+	if s.discordPanics != nil {
+		metrics = append(metrics, metric{"fixtrack_discord_handler_panics_total", "counter", "Total number of panics recovered in the Discord handlers.", float64(s.discordPanics())})
+	}
+
+	var b strings.Builder
+	for _, m := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s %s\n%s %g\n", m.name, m.help, m.name, m.kind, m.name, m.value)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(b.String())); err != nil {
+		s.logger.Debug("Failed to write metrics", zap.Error(err))
+	}
+}
+
+// dbPoolMetrics converts connection pool statistics into metrics
+func dbPoolMetrics(stats sql.DBStats) []metric {
+	return []metric{
+		{"fixtrack_db_max_open_connections", "gauge", "Maximum number of open connections to the database.", float64(stats.MaxOpenConnections)},
+		{"fixtrack_db_open_connections", "gauge", "Number of established connections, in use and idle.", float64(stats.OpenConnections)},
+		{"fixtrack_db_in_use_connections", "gauge", "Number of connections currently in use.", float64(stats.InUse)},
+		{"fixtrack_db_idle_connections", "gauge", "Number of idle connections.", float64(stats.Idle)},
+		{"fixtrack_db_wait_count_total", "counter", "Total number of connections waited for.", float64(stats.WaitCount)},
+		{"fixtrack_db_wait_duration_seconds_total", "counter", "Total time blocked waiting for a new connection.", stats.WaitDuration.Seconds()},
+		{"fixtrack_db_max_idle_closed_total", "counter", "Total number of connections closed due to max_idle_conns.", float64(stats.MaxIdleClosed)},
+		{"fixtrack_db_max_idle_time_closed_total", "counter", "Total number of connections closed due to conn_max_idle_time.", float64(stats.MaxIdleTimeClosed)},
+		{"fixtrack_db_max_lifetime_closed_total", "counter", "Total number of connections closed due to conn_max_lifetime.", float64(stats.MaxLifetimeClosed)},
+	}
+}
+
+// channelCacheMetrics converts channel cache statistics into metrics
+func channelCacheMetrics(stats CacheStats) []metric {
+	return []metric{
+		{"fixtrack_channel_cache_hits_total", "counter", "Total number of channel lookups served from the cache.", float64(stats.Hits)},
+		{"fixtrack_channel_cache_misses_total", "counter", "Total number of channel lookups that went to the database.", float64(stats.Misses)},
+		{"fixtrack_channel_cache_entries", "gauge", "Number of cached channel lookups.", float64(stats.Entries)},
+	}
+}
@@ -0,0 +1,278 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// projectRequest is the body accepted when creating or updating a project
+type projectRequest struct {
+	CustomerID  uuid.UUID `json:"customer_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+// projectGitHubRequest is the body accepted when mapping a project to a GitHub repository
+type projectGitHubRequest struct {
+	Repo string `json:"repo"`
+}
+
+// projectSlackRequest is the body accepted when setting a project's Slack incoming webhook
+type projectSlackRequest struct {
+	WebhookURL string `json:"webhook_url"`
+}
+
+// projectEmailRequest is the body accepted when setting a project's support address
+type projectEmailRequest struct {
+	Address string `json:"address"`
+}
+
+// projectJiraRequest is the body accepted when mapping a project to a Jira project
+type projectJiraRequest struct {
+	ProjectKey string `json:"project_key"`
+}
+
+// handleListProjects handles GET /api/v1/projects
+func (s *Server) handleListProjects(w http.ResponseWriter, r *http.Request) {
+	offset, limit := pagination(r)
+
+	if customerID := keyCustomerID(r); customerID != nil {
+		projects, err := s.projectService.GetProjectsByCustomer(r.Context(), *customerID)
+		if err != nil {
+			s.writeServiceError(w, err)
+			return
+		}
+		s.writeJSON(w, http.StatusOK, pageOf(projects, offset, limit))
+		return
+	}
+
+	projects, err := s.projectService.ListProjects(r.Context(), offset, limit)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, projects)
+}
+
+// handleCreateProject handles POST /api/v1/projects
+func (s *Server) handleCreateProject(w http.ResponseWriter, r *http.Request) {
+	var req projectRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if !s.authorizeCustomer(w, r, req.CustomerID) {
+		return
+	}
+
+	project, err := s.projectService.CreateProject(r.Context(), req.CustomerID, req.Name, req.Description)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, project)
+}
+
+// handleGetProject handles GET /api/v1/projects/{id}
+func (s *Server) handleGetProject(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	project, err := s.projectService.GetProject(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, project)
+}
+
+// handleUpdateProject handles PUT /api/v1/projects/{id}
+func (s *Server) handleUpdateProject(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	var req projectRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.projectService.UpdateProject(r.Context(), id, req.Name, req.Description); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	project, err := s.projectService.GetProject(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, project)
+}
+
+// handleDeleteProject handles DELETE /api/v1/projects/{id}
+func (s *Server) handleDeleteProject(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	if err := s.projectService.DeleteProject(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleRestoreProject handles POST /api/v1/projects/{id}/restore
+func (s *Server) handleRestoreProject(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	if err := s.projectService.RestoreProject(r.Context(), id); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleSetProjectGitHubRepo handles PUT /api/v1/projects/{id}/github
+func (s *Server) handleSetProjectGitHubRepo(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	var req projectGitHubRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.projectService.SetGitHubRepo(r.Context(), id, req.Repo); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	project, err := s.projectService.GetProject(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, project)
+}
+
+// handleSetProjectJiraProject handles PUT /api/v1/projects/{id}/jira
+func (s *Server) handleSetProjectJiraProject(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	var req projectJiraRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.projectService.SetJiraProject(r.Context(), id, req.ProjectKey); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	project, err := s.projectService.GetProject(r.Context(), id)
+	if err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, project)
+}
+
+// handleSetProjectSlackWebhook handles PUT /api/v1/projects/{id}/slack
+func (s *Server) handleSetProjectSlackWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	var req projectSlackRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.projectService.SetSlackWebhook(r.Context(), id, req.WebhookURL); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleSetProjectInboundEmail handles PUT /api/v1/projects/{id}/email
+func (s *Server) handleSetProjectInboundEmail(w http.ResponseWriter, r *http.Request) {
+	id, err := pathUUID(r, "id")
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid project ID")
+		return
+	}
+	if !s.authorizeProject(w, r, id) {
+		return
+	}
+
+	var req projectEmailRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := s.projectService.SetInboundEmail(r.Context(), id, req.Address); err != nil {
+		s.writeServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
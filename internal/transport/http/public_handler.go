@@ -0,0 +1,114 @@
+package http
+
+import (
+	"embed"
+	"errors"
+	"html/template"
+	"net/http"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"go.uber.org/zap"
+)
+
+//go:embed templates/public_issue.html
+var templateFS embed.FS
+
+var publicIssueTemplate = template.Must(template.ParseFS(templateFS, "templates/public_issue.html"))
+
+// publicIssueView is the read-only projection of an issue shown to customers.
+// It deliberately omits internal identifiers such as Discord IDs and thread IDs.
+type publicIssueView struct {
+	Title            string              `json:"title"`
+	Project          string              `json:"project,omitempty"`
+	Status           domain.Status       `json:"status"`
+	StatusName       string              `json:"status_name"`
+	StatusColor      string              `json:"-"`
+	Priority         domain.Priority     `json:"priority"`
+	ResolutionCause  string              `json:"resolution_cause,omitempty"`
+	ResolutionAction string              `json:"resolution_action,omitempty"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+	ClosedAt         *time.Time          `json:"closed_at,omitempty"`
+	History          []publicStatusEntry `json:"history"`
+}
+
+// publicStatusEntry is a single status change shown on the public page
+type publicStatusEntry struct {
+	Status     domain.Status `json:"status"`
+	StatusName string        `json:"status_name"`
+	ChangedAt  time.Time     `json:"changed_at"`
+}
+
+// newPublicIssueView builds the public projection of an issue
+func newPublicIssueView(issue *domain.Issue) publicIssueView {
+	view := publicIssueView{
+		Title:            issue.Title,
+		Project:          issue.Project.Name,
+		Status:           issue.Status,
+		StatusName:       issue.GetStatusDisplayName(),
+		StatusColor:      issue.GetStatusColor(),
+		Priority:         issue.Priority,
+		ResolutionCause:  issue.ResolutionCause,
+		ResolutionAction: issue.ResolutionAction,
+		CreatedAt:        issue.CreatedAt,
+		UpdatedAt:        issue.UpdatedAt,
+		ClosedAt:         issue.ClosedAt,
+		History:          []publicStatusEntry{},
+	}
+
+	for _, log := range issue.StatusLogs {
+		if log.IsEdit() {
+			continue
+		}
+		view.History = append(view.History, publicStatusEntry{
+			Status:     log.NewStatus,
+			StatusName: domain.GetStatusDisplayName(log.NewStatus),
+			ChangedAt:  log.ChangedAt,
+		})
+	}
+
+	return view
+}
+
+// getPublicIssue looks up an issue by the hash path parameter and writes an error response if it fails
+func (s *Server) getPublicIssue(w http.ResponseWriter, r *http.Request) (*domain.Issue, bool) {
+	issue, err := s.issueService.GetIssueByPublicHash(r.Context(), r.PathValue("hash"))
+	if err != nil {
+		if errors.Is(err, domain.ErrIssueNotFound) {
+			http.NotFound(w, r)
+			return nil, false
+		}
+		s.logger.Error("Failed to load public issue", zap.Error(err))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return issue, true
+}
+
+// handlePublicIssuePage handles GET /public/issues/{hash}
+func (s *Server) handlePublicIssuePage(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.getPublicIssue(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := publicIssueTemplate.Execute(w, newPublicIssueView(issue)); err != nil {
+		s.logger.Error("Failed to render public issue page", zap.Error(err))
+	}
+}
+
+// handlePublicIssueJSON handles GET /api/v1/public/issues/{hash}
+func (s *Server) handlePublicIssueJSON(w http.ResponseWriter, r *http.Request) {
+	issue, ok := s.getPublicIssue(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	s.writeJSON(w, http.StatusOK, newPublicIssueView(issue))
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// errorResponse is the JSON body returned for failed requests
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// writeJSON writes a JSON response with the given status code
+func (s *Server) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body == nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		s.logger.Error("Failed to encode HTTP response", zap.Error(err))
+	}
+}
+
+// writeError writes a JSON error response with the given status code
+func (s *Server) writeError(w http.ResponseWriter, status int, message string) {
+	s.writeJSON(w, status, errorResponse{Error: message})
+}
+
+// serviceErrorStatus is the HTTP status code of each kind of service error
+var serviceErrorStatus = map[domain.ErrorKind]int{
+	domain.KindInvalid:     http.StatusBadRequest,
+	domain.KindNotFound:    http.StatusNotFound,
+	domain.KindConflict:    http.StatusConflict,
+	domain.KindForbidden:   http.StatusForbidden,
+	domain.KindRateLimited: http.StatusTooManyRequests,
+}
+
+// writeServiceError maps a service error to an HTTP status code by its kind. Errors
+// that are not the client's fault are logged and hidden.
+func (s *Server) writeServiceError(w http.ResponseWriter, err error) {
+	status, ok := serviceErrorStatus[domain.KindOf(err)]
+	if !ok {
+		s.logger.Error("HTTP request failed", zap.Error(err))
+		s.writeError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	s.logger.Debug("HTTP request rejected", zap.Error(err), zap.Int("status", status))
+	s.writeError(w, status, err.Error())
+}
+
+// decodeJSON decodes the request body into dst
+func decodeJSON(r *http.Request, dst interface{}) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(dst)
+}
+
+// pathUUID parses a UUID path parameter
+func pathUUID(r *http.Request, name string) (uuid.UUID, error) {
+	return uuid.Parse(r.PathValue(name))
+}
+
+// pagination parses offset and limit query parameters
+func pagination(r *http.Request) (offset, limit int) {
+	limit = defaultPageLimit
+
+	if v, err := strconv.Atoi(r.URL.Query().Get("offset")); err == nil && v > 0 {
+		offset = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	return offset, limit
+}
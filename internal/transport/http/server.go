@@ -0,0 +1,202 @@
+package http
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+
+	"github.com/graphql-go/graphql"
+	"go.uber.org/zap"
+)
+
+// Server exposes the REST API used by web clients to file and query issues
+type Server struct {
+	config               *config.HTTPConfig
+	server               *http.Server
+	mux                  *http.ServeMux
+	issueService         domain.IssueService
+	projectService       domain.ProjectService
+	customerService      domain.CustomerService
+	apiKeyService        domain.APIKeyService
+	issueAssigneeService domain.IssueAssigneeService
+	statusLogService     domain.IssueStatusLogService
+	userService          domain.UserService
+	graphQLSchema        *graphql.Schema
+	events               *eventStream
+	readinessChecks      []namedCheck
+	dbStats              func() sql.DBStats
+	channelCacheStats    func() CacheStats
+	discordPanics        func() uint64
+	logger               *zap.Logger
+}
+
+// NewServer creates a new HTTP server
+func NewServer(
+	cfg *config.HTTPConfig,
+	issueService domain.IssueService,
+	projectService domain.ProjectService,
+	customerService domain.CustomerService,
+	apiKeyService domain.APIKeyService,
+	issueAssigneeService domain.IssueAssigneeService,
+	statusLogService domain.IssueStatusLogService,
+	userService domain.UserService,
+	logger *zap.Logger,
+) *Server {
+	s := &Server{
+		config:               cfg,
+		issueService:         issueService,
+		projectService:       projectService,
+		customerService:      customerService,
+		apiKeyService:        apiKeyService,
+		issueAssigneeService: issueAssigneeService,
+		statusLogService:     statusLogService,
+		userService:          userService,
+		events:               newEventStream(),
+		logger:               logger,
+	}
+
+	schema, err := s.newGraphQLSchema()
+	if err != nil {
+		panic(fmt.Sprintf("invalid GraphQL schema: %v", err))
+	}
+	s.graphQLSchema = schema
+
+	s.mux = http.NewServeMux()
+	s.server = &http.Server{
+		Addr:         cfg.Address,
+		Handler:      s.routes(),
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+	}
+	s.server.RegisterOnShutdown(s.events.close)
+
+	return s
+}
+
+// routes registers all REST endpoints. The /api/v1 endpoints require an API key granting
+// their scope, which GraphQL checks per field; health probes and public status pages are open.
+func (s *Server) routes() http.Handler {
+	mux := s.mux
+
+	// Health probes
+	mux.HandleFunc("GET /healthz", s.handleHealthz)
+	mux.HandleFunc("GET /readyz", s.handleReadyz)
+	mux.HandleFunc("GET /metrics", s.handleMetrics)
+
+	// Issues
+	mux.HandleFunc("GET /api/v1/issues", s.withScope(domain.ScopeIssuesRead, s.handleListIssues))
+	mux.HandleFunc("POST /api/v1/issues", s.withScope(domain.ScopeIssuesWrite, s.handleCreateIssue))
+	mux.HandleFunc("GET /api/v1/issues/{id}", s.withScope(domain.ScopeIssuesRead, s.handleGetIssue))
+	mux.HandleFunc("PATCH /api/v1/issues/{id}", s.withScope(domain.ScopeIssuesWrite, s.handleUpdateIssue))
+	mux.HandleFunc("DELETE /api/v1/issues/{id}", s.withScope(domain.ScopeIssuesWrite, s.handleDeleteIssue))
+	mux.HandleFunc("POST /api/v1/issues/{id}/restore", s.withScope(domain.ScopeIssuesWrite, s.handleRestoreIssue))
+	mux.HandleFunc("GET /api/v1/issues/{id}/comments", s.withScope(domain.ScopeIssuesRead, s.handleListIssueComments))
+
+	// Projects
+	mux.HandleFunc("GET /api/v1/projects", s.withScope(domain.ScopeProjectsRead, s.handleListProjects))
+	mux.HandleFunc("POST /api/v1/projects", s.withScope(domain.ScopeProjectsWrite, s.handleCreateProject))
+	mux.HandleFunc("GET /api/v1/projects/{id}", s.withScope(domain.ScopeProjectsRead, s.handleGetProject))
+	mux.HandleFunc("PUT /api/v1/projects/{id}", s.withScope(domain.ScopeProjectsWrite, s.handleUpdateProject))
+	mux.HandleFunc("DELETE /api/v1/projects/{id}", s.withScope(domain.ScopeProjectsWrite, s.handleDeleteProject))
+	mux.HandleFunc("POST /api/v1/projects/{id}/restore", s.withScope(domain.ScopeProjectsWrite, s.handleRestoreProject))
+	mux.HandleFunc("PUT /api/v1/projects/{id}/github", s.withScope(domain.ScopeProjectsWrite, s.handleSetProjectGitHubRepo))
+	mux.HandleFunc("PUT /api/v1/projects/{id}/jira", s.withScope(domain.ScopeProjectsWrite, s.handleSetProjectJiraProject))
+	mux.HandleFunc("PUT /api/v1/projects/{id}/slack", s.withScope(domain.ScopeProjectsWrite, s.handleSetProjectSlackWebhook))
+	mux.HandleFunc("PUT /api/v1/projects/{id}/email", s.withScope(domain.ScopeProjectsWrite, s.handleSetProjectInboundEmail))
+	mux.HandleFunc("GET /api/v1/projects/{id}/events", s.withScope(domain.ScopeIssuesRead, s.handleProjectEvents))
+
+	// Customers
+	mux.HandleFunc("GET /api/v1/customers", s.withScope(domain.ScopeCustomersRead, s.handleListCustomers))
+	mux.HandleFunc("POST /api/v1/customers", s.withScope(domain.ScopeCustomersWrite, s.handleCreateCustomer))
+	mux.HandleFunc("GET /api/v1/customers/{id}", s.withScope(domain.ScopeCustomersRead, s.handleGetCustomer))
+	mux.HandleFunc("PUT /api/v1/customers/{id}", s.withScope(domain.ScopeCustomersWrite, s.handleUpdateCustomer))
+	mux.HandleFunc("DELETE /api/v1/customers/{id}", s.withScope(domain.ScopeCustomersWrite, s.handleDeleteCustomer))
+	mux.HandleFunc("POST /api/v1/customers/{id}/restore", s.withScope(domain.ScopeCustomersWrite, s.handleRestoreCustomer))
+	mux.HandleFunc("POST /api/v1/customers/{id}/merge", s.withScope(domain.ScopeCustomersWrite, s.handleMergeCustomer))
+	mux.HandleFunc("PUT /api/v1/customers/{id}/email", s.withScope(domain.ScopeCustomersWrite, s.handleSetCustomerEmailOptOut))
+	mux.HandleFunc("GET /api/v1/customers/{id}/projects", s.withScope(domain.ScopeCustomersRead, s.handleListCustomerProjects))
+
+	// GraphQL, which checks the scope of each type it resolves
+	mux.HandleFunc("GET /api/v1/graphql", s.withAPIKey(s.handleGraphQL))
+	mux.HandleFunc("POST /api/v1/graphql", s.withAPIKey(s.handleGraphQL))
+
+	// Public read-only status pages
+	mux.HandleFunc("GET /public/issues/{hash}", s.handlePublicIssuePage)
+	mux.HandleFunc("GET /api/v1/public/issues/{hash}", s.handlePublicIssueJSON)
+
+	return s.logRequests(mux)
+}
+
+// Handle mounts an additional handler, e.g. an integration webhook receiver.
+// It must be called before Start.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Start starts listening for HTTP requests. It blocks until the server stops.
+func (s *Server) Start() error {
+	s.logger.Info("Starting HTTP server", zap.String("address", s.config.Address))
+
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("failed to start HTTP server: %w", err)
+	}
+
+	return nil
+}
+
+// Shutdown gracefully stops the HTTP server
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Info("Shutting down HTTP server")
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.ShutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shutdown HTTP server: %w", err)
+	}
+
+	return nil
+}
+
+// logRequests logs every HTTP request with its status and duration
+func (s *Server) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rec, r)
+
+		log := s.logger.Info
+		if isProbe(r) && rec.status == http.StatusOK {
+			log = s.logger.Debug
+		}
+		log("Handled HTTP request",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.Int("status", rec.status),
+			zap.Duration("duration", time.Since(start)),
+		)
+	})
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code before writing it
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to flush streams
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
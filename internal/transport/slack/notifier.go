@@ -0,0 +1,218 @@
+// Package slack posts issue notifications to the Slack incoming webhook
+// configured for each project.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"fix-track-bot/internal/domain"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// notifyTimeout bounds loading an issue and posting one message to Slack
+const notifyTimeout = 30 * time.Second
+
+// Notifier posts issue creation and status changes to Slack
+type Notifier struct {
+	issueRepo  domain.IssueRepository
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+// NewNotifier creates a new Slack notifier
+func NewNotifier(issueRepo domain.IssueRepository, logger *zap.Logger) *Notifier {
+	return &Notifier{
+		issueRepo: issueRepo,
+		httpClient: &http.Client{
+			Timeout: 15 * time.Second,
+		},
+		logger: logger,
+	}
+}
+
+// message is the body of a Slack incoming webhook request
+type message struct {
+	Text        string       `json:"text"`
+	Attachments []attachment `json:"attachments,omitempty"`
+}
+
+// attachment is a colored block shown under a Slack message
+type attachment struct {
+	Color  string  `json:"color"`
+	Fields []field `json:"fields"`
+}
+
+// field is a short name/value pair inside an attachment
+type field struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// Subscribe registers the notifier for the events posted to Slack
+func (n *Notifier) Subscribe(bus domain.EventBus) {
+	bus.Subscribe(n.onIssueCreated, domain.EventIssueCreated)
+	bus.Subscribe(n.onIssueStatusChanged, domain.EventIssueStatusChanged)
+}
+
+// onIssueCreated announces a new issue
+func (n *Notifier) onIssueCreated(_ context.Context, event domain.Event) {
+	n.async(event.Issue.ID, "created", func(issue *domain.Issue) *message {
+		return &message{
+			Text: fmt.Sprintf(":new: New issue in *%s*: *%s*", escape(issue.Project.Name), escape(issue.Title)),
+			Attachments: []attachment{{
+				Color: priorityColor(issue.Priority),
+				Fields: []field{
+					{Title: "Priority", Value: string(issue.Priority), Short: true},
+					{Title: "Source", Value: issue.Source, Short: true},
+					{Title: "Description", Value: escape(truncate(issue.Description, 500))},
+					{Title: "Issue", Value: issueRef(issue)},
+				},
+			}},
+		}
+	})
+}
+
+// onIssueStatusChanged announces a status transition
+func (n *Notifier) onIssueStatusChanged(_ context.Context, event domain.Event) {
+	oldStatus, newStatus := event.OldStatus, event.Issue.Status
+	n.async(event.Issue.ID, "status", func(issue *domain.Issue) *message {
+		fields := []field{
+			{Title: "From", Value: domain.GetStatusDisplayName(oldStatus), Short: true},
+			{Title: "To", Value: domain.GetStatusDisplayName(newStatus), Short: true},
+		}
+		if newStatus == domain.StatusResolved && issue.ResolutionCause != "" {
+			fields = append(fields,
+				field{Title: "Root cause", Value: escape(truncate(issue.ResolutionCause, 500))},
+				field{Title: "Corrective action", Value: escape(truncate(issue.ResolutionAction, 500))},
+			)
+		}
+		fields = append(fields, field{Title: "Issue", Value: issueRef(issue)})
+
+		return &message{
+			Text: fmt.Sprintf(":arrows_counterclockwise: *%s* in *%s* is now *%s*",
+				escape(issue.Title), escape(issue.Project.Name), domain.GetStatusDisplayName(newStatus)),
+			Attachments: []attachment{{
+				Color:  statusColor(newStatus),
+				Fields: fields,
+			}},
+		}
+	})
+}
+
+// async reloads the issue and posts the message built by build in the background so
+// issue changes are never delayed by Slack. Projects without a Slack webhook are skipped.
+func (n *Notifier) async(issueID uuid.UUID, action string, build func(issue *domain.Issue) *message) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+
+		issue, err := n.issueRepo.GetByID(ctx, issueID)
+		if err != nil {
+			n.logger.Error("Failed to load issue for Slack notification",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("action", action),
+			)
+			return
+		}
+
+		if !issue.Project.HasSlackWebhook() {
+			return
+		}
+
+		if err := n.post(ctx, issue.Project.SlackWebhookURL, build(issue)); err != nil {
+			n.logger.Error("Slack notification failed",
+				zap.Error(err),
+				zap.String("issue_id", issueID.String()),
+				zap.String("action", action),
+			)
+		}
+	}()
+}
+
+// post sends a message to a Slack incoming webhook
+func (n *Notifier) post(ctx context.Context, webhookURL string, msg *message) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
+
+// issueRef identifies an issue by key and ID
+func issueRef(issue *domain.Issue) string {
+	if issue.Key == "" {
+		return issue.ID.String()
+	}
+	return fmt.Sprintf("%s (%s)", issue.Key, issue.ID)
+}
+
+// escape escapes the characters Slack treats as control sequences in message text
+func escape(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// truncate shortens s to at most max runes, adding an ellipsis when cut
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
+
+// priorityColor returns the attachment color for a priority
+func priorityColor(priority domain.Priority) string {
+	switch priority {
+	case domain.PriorityHigh:
+		return "#e74c3c"
+	case domain.PriorityMedium:
+		return "#f1c40f"
+	default:
+		return "#2ecc71"
+	}
+}
+
+// statusColor returns the attachment color for a status
+func statusColor(status domain.Status) string {
+	switch status {
+	case domain.StatusResolved, domain.StatusVerified:
+		return "#2ecc71"
+	case domain.StatusClosed:
+		return "#9b59b6"
+	case domain.StatusRejected:
+		return "#e74c3c"
+	case domain.StatusInProgress:
+		return "#3498db"
+	default:
+		return "#95a5a6"
+	}
+}
@@ -1,5 +1,5 @@
-// Package main provides a simple entry point that delegates to the main application.
-// The actual application logic is in cmd/bot/main.go following Go project conventions.
+// Package main provides the fix-track-bot binary. Its subcommands, defined in cli.go,
+// run the bot and let operators administer it.
 package main
 
 import (
@@ -7,58 +7,70 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"reflect"
 	"syscall"
+	"time"
 
 	"fix-track-bot/internal/config"
+	"fix-track-bot/internal/domain"
+	"fix-track-bot/internal/event"
+	"fix-track-bot/internal/integration/github"
+	"fix-track-bot/internal/integration/jira"
+	"fix-track-bot/internal/integration/webhook"
 	"fix-track-bot/internal/repository"
+	"fix-track-bot/internal/scheduler"
+	"fix-track-bot/internal/secrets"
 	"fix-track-bot/internal/service"
 	"fix-track-bot/internal/transport/discord"
+	"fix-track-bot/internal/transport/email"
+	grpctransport "fix-track-bot/internal/transport/grpc"
+	httptransport "fix-track-bot/internal/transport/http"
+	"fix-track-bot/internal/transport/slack"
 	"fix-track-bot/pkg/logger"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
+// handlerShutdownTimeout bounds waiting for in-flight Discord handlers on shutdown
+const handlerShutdownTimeout = 5 * time.Second
+
+// redisConnectTimeout bounds checking the Redis server on startup
+const redisConnectTimeout = 5 * time.Second
+
+// eventClaimCleanupInterval is how often expired claims of handled Discord events are deleted
+const eventClaimCleanupInterval = 15 * time.Minute
+
+// outboxInterval is how often Discord card and thread changes still to be done are retried
+const outboxInterval = time.Minute
+
 // App represents the main application
 type App struct {
-	config    *config.Config
-	logger    *zap.Logger
-	dbManager *repository.DatabaseManager
-	session   *discordgo.Session
-	handler   *discord.Handler
-	cmdMgr    *discord.CommandManager
+	config     *config.Config
+	logger     *zap.Logger
+	dbManager  *repository.DatabaseManager
+	shards     *discord.ShardManager
+	handler    *discord.Handler
+	cmdMgr     *discord.CommandManager
+	httpServer *httptransport.Server
+	grpcServer *grpctransport.Server
+	scheduler  *scheduler.Scheduler
+	leader     *repository.LeaderLock // Decides which replica runs the scheduled jobs; nil outside cluster mode
+	redis      *redis.Client          // Holds the state replicas share; nil without Redis
+	secrets    *secrets.Watcher       // Applies rotated secrets; nil unless they are refreshed
+
+	// Applied when the config file is reloaded
+	live           *config.Config    // Last configuration loaded, which reloads are compared with
+	slaService     domain.SLAService // nil when SLA tracking was disabled at startup
+	issueLimiter   domain.RateLimiter
+	featureService domain.FeatureService
 }
 
 func main() {
-	// Load configuration
-	cfg, err := config.Load()
-	if err != nil {
-		fmt.Printf("Failed to load configuration: %v\n", err)
-		os.Exit(1)
-	}
-
-	// Initialize logger
-	log, err := logger.NewLogger(cfg.Logger)
-	if err != nil {
-		fmt.Printf("Failed to initialize logger: %v\n", err)
+	if err := newRootCommand().Execute(); err != nil {
 		os.Exit(1)
 	}
-	defer log.Sync()
-
-	log.Info("Starting Fix Track Bot",
-		zap.String("version", cfg.App.Version),
-		zap.String("environment", cfg.App.Environment),
-	)
-
-	// Create and run application
-	app, err := NewApp(cfg, log)
-	if err != nil {
-		log.Fatal("Failed to create application", zap.Error(err))
-	}
-
-	if err := app.Run(); err != nil {
-		log.Fatal("Application failed to run", zap.Error(err))
-	}
 }
 
 // NewApp creates a new application instance
@@ -74,17 +86,21 @@ func NewApp(cfg *config.Config, logger *zap.Logger) (*App, error) {
 		return nil, fmt.Errorf("failed to run database migrations: %w", err)
 	}
 
-	// Initialize Discord session
-	session, err := discordgo.New("Bot " + cfg.Discord.Token)
+	// Set Discord intents
+	intents, err := discord.ParseIntents(cfg.Discord.Intents)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create Discord session: %w", err)
+		return nil, fmt.Errorf("invalid discord intents: %w", err)
+	}
+	if cfg.OnCall.Enabled && cfg.OnCall.PresenceAware {
+		intents |= discordgo.IntentsGuildPresences
 	}
 
-	// Set Discord intents
-	// session.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages
-	session.Identify.Intents = discordgo.IntentsGuilds |
-		discordgo.IntentsGuildMessages |
-		discordgo.IntentsMessageContent
+	// Initialize a Discord session per gateway shard; REST calls use the primary session
+	shards, err := discord.NewShardManager(cfg.Discord.Token, intents, cfg.Discord.ShardCount, cfg.Discord.ShardIDs, logger)
+	if err != nil {
+		return nil, err
+	}
+	session := shards.Primary()
 
 	// Initialize repository layer
 	customerRepo := repository.NewCustomerRepository(dbManager.GetDB(), logger)
@@ -93,63 +109,411 @@ func NewApp(cfg *config.Config, logger *zap.Logger) (*App, error) {
 	issueRepo := repository.NewIssueRepository(dbManager.GetDB(), logger)
 	channelRepo := repository.NewChannelRepository(dbManager.GetDB(), logger)
 	issueAssigneeRepo := repository.NewIssueAssigneeRepository(dbManager.GetDB(), logger)
+	issueStatusLogRepo := repository.NewIssueStatusLogRepository(dbManager.GetDB(), logger)
+	slaAlertRepo := repository.NewSLAAlertRepository(dbManager.GetDB(), logger)
+	issueAttachmentRepo := repository.NewIssueAttachmentRepository(dbManager.GetDB(), logger)
+	issueCommentRepo := repository.NewIssueCommentRepository(dbManager.GetDB(), logger)
+	labelRepo := repository.NewLabelRepository(dbManager.GetDB(), logger)
+	issueLinkRepo := repository.NewIssueLinkRepository(dbManager.GetDB(), logger)
+	reportRepo := repository.NewReportRepository(dbManager.GetDB(), logger)
+	projectWebhookRepo := repository.NewProjectWebhookRepository(dbManager.GetDB(), logger)
+	workflowRepo := repository.NewWorkflowRepository(dbManager.GetDB(), logger)
+	guildSettingsRepo := repository.NewGuildSettingsRepository(dbManager.GetDB(), logger)
+	onCallRepo := repository.NewOnCallRepository(dbManager.GetDB(), logger)
+	teamRepo := repository.NewTeamRepository(dbManager.GetDB(), logger)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(dbManager.GetDB(), logger)
+	auditLogRepo := repository.NewAuditLogRepository(dbManager.GetDB(), logger)
+	customFieldRepo := repository.NewCustomFieldRepository(dbManager.GetDB(), logger)
+	recurringIssueRepo := repository.NewRecurringIssueRepository(dbManager.GetDB(), logger)
+	worklogRepo := repository.NewIssueWorklogRepository(dbManager.GetDB(), logger)
+	milestoneRepo := repository.NewMilestoneRepository(dbManager.GetDB(), logger)
+	componentRepo := repository.NewComponentRepository(dbManager.GetDB(), logger)
+	boardRepo := repository.NewBoardRepository(dbManager.GetDB(), logger)
+	escalationRepo := repository.NewEscalationRepository(dbManager.GetDB(), logger)
+	incidentRepo := repository.NewIncidentRepository(dbManager.GetDB(), logger)
+	feedbackRepo := repository.NewIssueFeedbackRepository(dbManager.GetDB(), logger)
+	issueEmailRepo := repository.NewIssueEmailRepository(dbManager.GetDB(), logger)
+	apiKeyRepo := repository.NewAPIKeyRepository(dbManager.GetDB(), logger)
+	watcherRepo := repository.NewIssueWatcherRepository(dbManager.GetDB(), logger)
+	outboxRepo := repository.NewOutboxRepository(dbManager.GetDB(), logger)
+	uow := repository.NewUnitOfWork(dbManager.GetDB(), logger)
+
+	// Redis, when configured, holds the state that would otherwise be kept by each process
+	var redisClient *redis.Client
+	var stateStore domain.StateStore
+	if cfg.Redis.Enabled {
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     cfg.Redis.Address,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+		pingCtx, cancel := context.WithTimeout(context.Background(), redisConnectTimeout)
+		err := redisClient.Ping(pingCtx).Err()
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+		}
+		logger.Info("Connected to Redis", zap.String("address", cfg.Redis.Address))
+		stateStore = repository.NewRedisStateStore(redisClient, cfg.Redis.KeyPrefix, logger)
+	}
+
+	// Replicas share handled Discord events and elect one of them to run the scheduled jobs
+	var eventClaimRepo domain.EventClaimRepository
+	var schedulerLock *repository.LeaderLock
+	switch {
+	case redisClient != nil:
+		eventClaimRepo = repository.NewRedisEventClaimRepository(redisClient, cfg.Redis.KeyPrefix, logger)
+	case cfg.Cluster.Enabled:
+		eventClaimRepo = repository.NewEventClaimRepository(dbManager.GetDB(), logger)
+	}
+	if cfg.Cluster.Enabled {
+		schedulerLock = repository.NewSchedulerLock(dbManager.GetDB(), logger)
+	}
+
+	// Channel registrations are looked up on nearly every interaction, so keep them in
+	// memory, or in Redis for replicas to share
+	var channelCacheStats func() repository.ChannelCacheStats
+	switch {
+	case cfg.Database.ChannelCacheTTL <= 0:
+	case redisClient != nil:
+		channelCache := repository.NewRedisChannelCache(redisClient, cfg.Redis.KeyPrefix, cfg.Database.ChannelCacheTTL, logger)
+		channelRepo = channelCache.Channels(channelRepo)
+		projectRepo = channelCache.Projects(projectRepo)
+		customerRepo = channelCache.Customers(customerRepo)
+		channelCacheStats = channelCache.Stats
+	default:
+		channelCache := repository.NewChannelCache(cfg.Database.ChannelCacheTTL, logger)
+		channelRepo = channelCache.Channels(channelRepo)
+		projectRepo = channelCache.Projects(projectRepo)
+		customerRepo = channelCache.Customers(customerRepo)
+		channelCacheStats = channelCache.Stats
+	}
+
+	// Reporters may create at most rate_limit.max_issues issues per channel and window. The
+	// limiter exists without a limit too, so a configuration reload can set one.
+	var issueLimiter domain.RateLimiter
+	switch {
+	case redisClient != nil:
+		issueLimiter = repository.NewRedisRateLimiter(redisClient, cfg.Redis.KeyPrefix, cfg.RateLimit.MaxIssues, cfg.RateLimit.IssueWindow, logger)
+	default:
+		issueLimiter = service.NewRateLimiter(cfg.RateLimit.MaxIssues, cfg.RateLimit.IssueWindow)
+	}
+
+	// Initialize issue integrations. Services publish issue events to the bus and
+	// notifiers subscribe to the events they handle.
+	eventBus := event.NewBus(uow, logger)
+	webhook.NewDispatcher(issueRepo, projectWebhookRepo, cfg.Webhooks.Timeout, cfg.Webhooks.MaxAttempts, cfg.Webhooks.RetryBackoff, logger).Subscribe(eventBus)
+	if cfg.GitHub.Enabled {
+		githubClient := github.NewClient(cfg.GitHub.APIBaseURL, cfg.GitHub.Token, logger)
+		github.NewSyncer(githubClient, issueRepo, logger).Subscribe(eventBus)
+	}
+	var jiraClient *jira.Client
+	if cfg.Jira.Enabled {
+		jiraClient = jira.NewClient(cfg.Jira.BaseURL, cfg.Jira.Email, cfg.Jira.APIToken, logger)
+		jira.NewSyncer(jiraClient, issueRepo, cfg.Jira.IssueType, logger).Subscribe(eventBus)
+	}
+	if cfg.Slack.Enabled {
+		slack.NewNotifier(issueRepo, logger).Subscribe(eventBus)
+	}
+	if cfg.Email.Enabled {
+		email.NewNotifier(issueRepo, cfg.Email, logger).Subscribe(eventBus)
+	}
 
 	// Initialize service layer
-	issueService := service.NewIssueService(issueRepo, channelRepo, userRepo, logger)
-	channelService := service.NewChannelService(channelRepo, customerRepo, projectRepo, userRepo, logger)
-	issueAssigneeService := service.NewIssueAssigneeService(issueAssigneeRepo, userRepo, logger)
+	auditService := service.NewAuditService(auditLogRepo, uow, logger)
+	issueStatusLogService := service.NewIssueStatusLogService(issueStatusLogRepo, issueRepo, logger)
+	issueService := service.NewIssueService(issueRepo, channelRepo, projectRepo, userRepo, issueStatusLogService, issueCommentRepo, uow, eventBus, auditService, issueLimiter, logger)
+	channelService := service.NewChannelService(channelRepo, customerRepo, projectRepo, userRepo, uow, auditService, logger)
+	issueAssigneeService := service.NewIssueAssigneeService(issueAssigneeRepo, userRepo, issueRepo, eventBus, logger)
+	customerService := service.NewCustomerService(customerRepo, projectRepo, uow, auditService, logger)
+	projectService := service.NewProjectService(projectRepo, customerRepo, auditService, logger)
+	issueAttachmentService := service.NewIssueAttachmentService(issueAttachmentRepo, userRepo, logger)
+	labelService := service.NewLabelService(labelRepo, issueRepo, logger)
+	bulkService := service.NewBulkService(channelRepo, issueService, issueAssigneeService, labelService, uow, logger)
+	issueLinkService := service.NewIssueLinkService(issueLinkRepo, issueRepo, logger)
+	statsService := service.NewStatsService(channelRepo, reportRepo, guildSettingsRepo, componentRepo, logger)
+	exportService := service.NewExportService(channelRepo, projectRepo, issueRepo, customFieldRepo, milestoneRepo, guildSettingsRepo, auditService, logger)
+	releaseNotesService := service.NewReleaseNotesService(channelRepo, issueRepo, milestoneRepo, guildSettingsRepo, logger)
+	permissionService := service.NewPermissionService(userRepo, guildSettingsRepo, roleMappings(&cfg.Permissions), logger)
+	webhookService := service.NewWebhookService(channelRepo, projectWebhookRepo, logger)
+	apiKeyService := service.NewAPIKeyService(channelRepo, customerRepo, apiKeyRepo, auditService, logger)
+	workflowService := service.NewWorkflowService(channelRepo, workflowRepo, issueRepo, logger)
+	customFieldService := service.NewCustomFieldService(channelRepo, customFieldRepo, issueRepo, uow, logger)
+	recurringLocation, err := time.LoadLocation(cfg.Recurring.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load recurring timezone: %w", err)
+	}
+	recurringIssueService := service.NewRecurringIssueService(channelRepo, recurringIssueRepo, issueService, recurringLocation, logger)
+	staleIssueService := service.NewStaleIssueService(issueRepo, channelRepo, projectRepo, issueService, discord.NewStaleIssueNotifier(session, logger), domain.StalePolicy{
+		After:      cfg.Stale.After,
+		CloseAfter: cfg.Stale.CloseAfter,
+	}, logger)
+	worklogService := service.NewWorklogService(issueRepo, userRepo, worklogRepo, logger)
+	dueDateLocation, err := time.LoadLocation(cfg.DueDates.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load due date timezone: %w", err)
+	}
+	dueDateService := service.NewDueDateService(issueRepo, userRepo, issueStatusLogService, discord.NewDueDateNotifier(session, logger), eventBus, dueDateLocation, cfg.DueDates.RemindBefore, logger)
+	milestoneService := service.NewMilestoneService(channelRepo, issueRepo, userRepo, milestoneRepo, issueStatusLogService, eventBus, logger)
+	componentService := service.NewComponentService(channelRepo, issueRepo, userRepo, componentRepo, teamRepo, issueStatusLogService, eventBus, logger)
+	boardService := service.NewBoardService(boardRepo, issueRepo, logger)
+	escalationService := service.NewEscalationService(channelRepo, issueRepo, escalationRepo, issueService, discord.NewEscalationNotifier(session, logger), eventBus, logger)
+	incidentService := service.NewIncidentService(userRepo, incidentRepo, issueService, issueStatusLogService, uow, discord.NewIncidentNotifier(session, logger), cfg.Incidents.UpdateInterval, logger)
+	eventBus.Subscribe(incidentService.HandleIssueEvent, domain.EventIssueStatusChanged)
+	guildSettingsService := service.NewGuildSettingsService(guildSettingsRepo, logger)
+	featureService := service.NewFeatureService(guildSettingsService, featureDefaults(&cfg.Features), logger)
+	notificationService := service.NewNotificationService(userRepo, notificationPreferenceRepo, issueRepo, watcherRepo, discord.NewDMNotifier(session, logger), logger)
+	watcherService := service.NewWatcherService(watcherRepo, issueRepo, userRepo, logger)
+	eventBus.Subscribe(notificationService.HandleIssueEvent, domain.EventIssueStatusChanged, domain.EventAssigneeAdded, domain.EventIssueCommented, domain.EventSLABreached)
+	feedbackService := service.NewFeedbackService(issueRepo, userRepo, feedbackRepo, discord.NewFeedbackSurveyor(session, logger), logger)
+	eventBus.Subscribe(feedbackService.HandleIssueEvent, domain.EventIssueStatusChanged)
+	onCallNotifier := discord.NewOnCallNotifier(shards, logger)
+	var presence domain.PresenceChecker
+	if cfg.OnCall.PresenceAware {
+		presence = onCallNotifier
+	}
+	onCallService := service.NewOnCallService(channelRepo, onCallRepo, userRepo, issueRepo, issueAssigneeService, onCallNotifier, presence, eventBus, cfg.OnCall.RotationPeriod, logger)
+	if cfg.OnCall.Enabled {
+		eventBus.Subscribe(onCallService.HandleIssueEvent, domain.EventIssueCreated, domain.EventIssuePriorityChanged)
+	}
+	teamService := service.NewTeamService(teamRepo, userRepo, issueRepo, issueAssigneeService, featureService, eventBus, logger)
+	eventBus.Subscribe(teamService.HandleIssueEvent, domain.EventIssueCreated, domain.EventIssueStatusChanged, domain.EventIssueComponentChanged)
 
 	// Initialize transport layer
-	handler := discord.NewHandler(session, issueService, channelService, issueAssigneeService, logger)
-	cmdMgr := discord.NewCommandManager(session, logger)
+	discordAPI := discord.NewAPICaller(cfg.Discord.APIMaxAttempts, cfg.Discord.APIRetryBackoff, cfg.Discord.APICircuitThreshold, cfg.Discord.APICircuitCooldown, logger)
+	handler := discord.NewHandler(shards, discordAPI, issueService, channelService, customerService, projectService, issueAssigneeService, issueAttachmentService, labelService, issueLinkService, statsService, exportService, releaseNotesService, permissionService, webhookService, workflowService, customFieldService, recurringIssueService, staleIssueService, worklogService, dueDateService, milestoneService, componentService, boardService, escalationService, incidentService, feedbackService, apiKeyService, guildSettingsService, featureService, onCallService, teamService, notificationService, auditService, bulkService, issueStatusLogService, watcherService, eventClaimRepo, outboxRepo, stateStore, logger)
+	handler.Subscribe(eventBus)
+	cmdMgr := discord.NewCommandManager(session, cfg.Discord.CommandScope, logger)
+
+	userService := service.NewUserService(userRepo, customerRepo, logger)
+
+	var httpServer *httptransport.Server
+	if cfg.HTTP.Enabled {
+		httpServer = httptransport.NewServer(&cfg.HTTP, issueService, projectService, customerService, apiKeyService, issueAssigneeService, issueStatusLogService, userService, logger)
+		httpServer.Subscribe(eventBus)
+		httpServer.AddReadinessCheck("database", dbManager.Health)
+		httpServer.AddReadinessCheck("discord", discord.GatewayHealth(shards))
+		httpServer.SetDBStats(dbManager.Stats)
+		httpServer.SetDiscordPanics(handler.Panics)
+		if redisClient != nil {
+			httpServer.AddReadinessCheck("redis", func(ctx context.Context) error {
+				return redisClient.Ping(ctx).Err()
+			})
+		}
+		if channelCacheStats != nil {
+			httpServer.SetChannelCacheStats(func() httptransport.CacheStats {
+				stats := channelCacheStats()
+				return httptransport.CacheStats{Hits: stats.Hits, Misses: stats.Misses, Entries: stats.Entries}
+			})
+		}
+
+		if cfg.GitHub.Enabled {
+			httpServer.Handle("POST /webhooks/github", github.NewWebhookHandler(cfg.GitHub.WebhookSecret, issueRepo, issueService, session, logger))
+		}
+		if cfg.InboundMail.Enabled {
+			inboundEmailService := service.NewInboundEmailService(projectRepo, channelRepo, userRepo, issueRepo, issueEmailRepo, issueService, logger)
+			httpServer.Handle("POST /webhooks/email", email.NewInboundHandler(cfg.InboundMail.Secret, inboundEmailService, cfg.Email.From, logger))
+		}
+	}
+
+	var grpcServer *grpctransport.Server
+	if cfg.GRPC.Enabled {
+		grpcServer = grpctransport.NewServer(&cfg.GRPC, issueService, channelService, projectService, apiKeyService, userService, logger)
+		grpcServer.Subscribe(eventBus)
+	}
+
+	// Initialize background jobs
+	var elector scheduler.Elector
+	if schedulerLock != nil {
+		elector = schedulerLock
+	}
+	jobs := scheduler.New(elector, logger)
+	jobs.Add("discord-outbox", outboxInterval, handler.ProcessOutbox)
+	var slaService domain.SLAService
+	if cfg.SLA.Enabled {
+		slaNotifier := discord.NewSLANotifier(session, cfg.SLA.EscalationChannelID, guildSettingsService, logger)
+		slaService = service.NewSLAService(issueRepo, slaAlertRepo, slaNotifier, guildSettingsRepo, eventBus, slaPolicies(&cfg.SLA), slaSeverityPolicies(&cfg.SLA), cfg.SLA.WarningThreshold, logger)
+		jobs.Add("sla-check", cfg.SLA.CheckInterval, slaService.CheckSLAs)
+	}
+	if cfg.Digest.Enabled {
+		location, err := time.LoadLocation(cfg.Digest.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load digest timezone: %w", err)
+		}
+		schedule, err := scheduler.ParseCron(cfg.Digest.Schedule, location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse digest schedule: %w", err)
+		}
+		digestNotifier := discord.NewDigestNotifier(session, logger)
+		digestService := service.NewDigestService(channelRepo, issueRepo, reportRepo, guildSettingsRepo, digestNotifier, cfg.Digest.Period, cfg.Digest.StaleAfter, location, logger)
+		jobs.AddCron("digest", schedule, digestService.SendDigests)
+	}
+	if cfg.OnCall.Enabled {
+		jobs.Add("oncall-rotation", cfg.OnCall.CheckInterval, onCallService.AdvanceRotations)
+	}
+	if cfg.Recurring.Enabled {
+		jobs.Add("recurring-issues", cfg.Recurring.CheckInterval, recurringIssueService.FileDueIssues)
+	}
+	if cfg.Stale.Enabled {
+		jobs.Add("stale-issues", cfg.Stale.CheckInterval, staleIssueService.CheckStaleIssues)
+	}
+	if cfg.DueDates.Enabled {
+		jobs.Add("due-dates", cfg.DueDates.CheckInterval, dueDateService.CheckDueDates)
+	}
+	if cfg.Escalation.Enabled {
+		jobs.Add("escalations", cfg.Escalation.CheckInterval, escalationService.CheckEscalations)
+	}
+	if cfg.Incidents.Enabled {
+		jobs.Add("incident-updates", cfg.Incidents.CheckInterval, incidentService.CheckUpdates)
+	}
+	if cfg.Jira.Enabled {
+		jiraPoller := jira.NewPoller(jiraClient, issueRepo, issueService, session, cfg.Jira.ResolvedStatuses, cfg.Jira.ClosedStatuses, logger)
+		jobs.Add("jira-sync", cfg.Jira.PollInterval, jiraPoller.Poll)
+	}
+	if cfg.Cluster.Enabled && redisClient == nil {
+		jobs.Add("event-claims", eventClaimCleanupInterval, func(ctx context.Context) error {
+			_, err := eventClaimRepo.DeleteExpired(ctx, time.Now())
+			return err
+		})
+	}
+
+	// Secrets rotated in the store are applied while the bot runs
+	var secretsWatcher *secrets.Watcher
+	if cfg.Secrets.RefreshInterval > 0 {
+		provider, err := cfg.Secrets.NewProvider()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create secrets provider: %w", err)
+		}
+		if provider != nil {
+			secretsWatcher = secrets.NewWatcher(provider, cfg.SecretValues(), cfg.Secrets.RefreshInterval, logger)
+		}
+	}
 
 	return &App{
-		config:    cfg,
-		logger:    logger,
-		dbManager: dbManager,
-		session:   session,
-		handler:   handler,
-		cmdMgr:    cmdMgr,
+		config:     cfg,
+		logger:     logger,
+		dbManager:  dbManager,
+		shards:     shards,
+		handler:    handler,
+		cmdMgr:     cmdMgr,
+		httpServer: httpServer,
+		grpcServer: grpcServer,
+		scheduler:  jobs,
+		leader:     schedulerLock,
+		redis:      redisClient,
+		secrets:    secretsWatcher,
+
+		live:           cfg,
+		slaService:     slaService,
+		issueLimiter:   issueLimiter,
+		featureService: featureService,
 	}, nil
 }
 
+// roleMappings converts the configured Discord role mappings into user roles
+func roleMappings(cfg *config.PermissionsConfig) map[string]domain.UserRole {
+	mappings := make(map[string]domain.UserRole, len(cfg.RoleMappings))
+	for discordRole, role := range cfg.RoleMappings {
+		mappings[discordRole] = domain.UserRole(role)
+	}
+	return mappings
+}
+
+// featureDefaults converts the configured feature flags into the defaults of every guild
+func featureDefaults(cfg *config.FeaturesConfig) map[domain.Feature]bool {
+	return map[domain.Feature]bool{
+		domain.FeatureBoard:        cfg.Board,
+		domain.FeatureAutocomplete: cfg.Autocomplete,
+		domain.FeatureForumMode:    cfg.ForumMode,
+		domain.FeatureAutoAssign:   cfg.AutoAssign,
+	}
+}
+
+// slaPolicies converts the configured SLA targets into per-priority policies
+func slaPolicies(cfg *config.SLAConfig) map[domain.Priority]domain.SLAPolicy {
+	policies := make(map[domain.Priority]domain.SLAPolicy, len(cfg.Targets))
+	for priority, target := range cfg.Targets {
+		policies[domain.Priority(priority)] = domain.SLAPolicy{
+			Response:   target.Response,
+			Resolution: target.Resolution,
+		}
+	}
+	return policies
+}
+
+// slaSeverityPolicies converts the configured SLA targets of incidents into per-severity policies
+func slaSeverityPolicies(cfg *config.SLAConfig) map[domain.Severity]domain.SLAPolicy {
+	policies := make(map[domain.Severity]domain.SLAPolicy, len(cfg.SeverityTargets))
+	for severity, target := range cfg.SeverityTargets {
+		policies[domain.Severity(severity)] = domain.SLAPolicy{
+			Response:   target.Response,
+			Resolution: target.Resolution,
+		}
+	}
+	return policies
+}
+
 // Run starts the application
 func (a *App) Run() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	// Register Discord handlers
-	a.handler.RegisterHandlers()
+	// Register Discord handlers; their in-flight work is cancelled with ctx on shutdown
+	a.handler.RegisterHandlers(ctx)
+	// Reconcile the commands of each guild as its shard connects or the bot joins it
+	a.shards.AddHandler(a.cmdMgr.HandleGuildCreate)
 
 	a.logger.Info("Opening Discord connection")
 
 	// Open Discord connection
-	if err := a.session.Open(); err != nil {
+	if err := a.shards.Open(); err != nil {
 		return fmt.Errorf("failed to open Discord connection: %w", err)
 	}
-	defer a.session.Close()
+	defer a.shards.Close()
 
 	a.logger.Info("Discord connection established")
+	a.handler.LogDegradedFeatures()
 
-	// Register slash commands
-	if err := a.cmdMgr.RegisterCommands(); err != nil {
-		a.logger.Error("Failed to register commands", zap.Error(err))
-		// Continue without commands for now
+	// Global commands are shared by all shards, so only the process running shard 0 manages them
+	if a.shards.HasShard(0) {
+		if err := a.cmdMgr.SyncGlobalCommands(); err != nil {
+			a.logger.Error("Failed to sync global commands", zap.Error(err))
+			// Continue with the commands already registered
+		}
 	}
 
-	// Register commands in all guilds
-	for _, guild := range a.session.State.Guilds {
-		a.logger.Info("Registering commands in guild",
-			zap.String("guild_name", guild.Name),
-			zap.String("guild_id", guild.ID),
-		)
+	// Start the REST API server alongside the Discord session
+	if a.httpServer != nil {
+		go func() {
+			if err := a.httpServer.Start(); err != nil {
+				a.logger.Error("HTTP server stopped unexpectedly", zap.Error(err))
+				cancel()
+			}
+		}()
+	}
 
-		if err := a.cmdMgr.RegisterGuildCommands(guild.ID); err != nil {
-			a.logger.Error("Failed to register commands in guild",
-				zap.Error(err),
-				zap.String("guild_id", guild.ID),
-			)
-		}
+	// Start the gRPC API server for internal tooling
+	if a.grpcServer != nil {
+		go func() {
+			if err := a.grpcServer.Start(); err != nil {
+				a.logger.Error("gRPC server stopped unexpectedly", zap.Error(err))
+				cancel()
+			}
+		}()
+	}
+
+	// Start background jobs; they stop when ctx is cancelled
+	a.scheduler.Start(ctx)
+
+	if a.secrets != nil {
+		go a.secrets.Run(ctx, a.applySecret)
+	}
+
+	// Changes to the config file are applied as far as they can be while the bot runs
+	if config.Watch(a.reloadConfig, func(err error) {
+		a.logger.Error("Ignoring changed configuration", zap.Error(err))
+	}) {
+		a.logger.Info("Watching the config file for changes")
 	}
 
 	a.logger.Info("Bot is now running. Press CTRL-C to exit.")
@@ -165,23 +529,152 @@ func (a *App) Run() error {
 		a.logger.Info("Context cancelled")
 	}
 
+	// Stop background jobs and cancel in-flight handler work before tearing down their dependencies
+	cancel()
+	a.scheduler.Wait()
+
 	return a.Shutdown(ctx)
 }
 
+// applySecret applies a secret rotated in the secrets store. The Discord token and the
+// database password take effect at once; other secrets need a restart.
+func (a *App) applySecret(key, value string) {
+	switch key {
+	case "discord.token":
+		if err := a.shards.Rotate(value); err != nil {
+			a.logger.Error("Failed to reconnect with the rotated Discord token", zap.Error(err))
+		}
+	case "database.password":
+		a.dbManager.SetPassword(value)
+	default:
+		a.logger.Warn("Secret changed; restart the bot to apply it", zap.String("key", key))
+	}
+}
+
+// reloadConfig applies a reloaded configuration: the log level, the SLA targets and
+// warning threshold, the issue rate limit, the feature flag defaults, and turning
+// scheduled jobs that ran at startup off and on. Other changes are logged and need a restart.
+func (a *App) reloadConfig(next *config.Config) {
+	prev := a.live
+	a.live = next
+
+	if next.Logger.Level != prev.Logger.Level {
+		if err := logger.SetLevel(next.Logger.Level); err != nil {
+			a.logger.Error("Failed to change log level", zap.String("level", next.Logger.Level), zap.Error(err))
+		} else {
+			a.logger.Info("Log level changed", zap.String("from", prev.Logger.Level), zap.String("to", next.Logger.Level))
+		}
+	}
+
+	if !reflect.DeepEqual(next.SLA.Targets, prev.SLA.Targets) || !reflect.DeepEqual(next.SLA.SeverityTargets, prev.SLA.SeverityTargets) ||
+		next.SLA.WarningThreshold != prev.SLA.WarningThreshold {
+		if a.slaService != nil {
+			a.slaService.SetPolicies(slaPolicies(&next.SLA), slaSeverityPolicies(&next.SLA), next.SLA.WarningThreshold)
+			a.logger.Info("SLA targets changed", zap.Float64("warning_threshold", next.SLA.WarningThreshold))
+		}
+	}
+
+	if next.RateLimit != prev.RateLimit {
+		a.issueLimiter.SetLimit(next.RateLimit.MaxIssues, next.RateLimit.IssueWindow)
+		a.logger.Info("Issue rate limit changed",
+			zap.Int("max_issues", next.RateLimit.MaxIssues),
+			zap.Duration("issue_window", next.RateLimit.IssueWindow),
+		)
+	}
+
+	if next.Features != prev.Features {
+		a.featureService.SetDefaults(featureDefaults(&next.Features))
+		a.logger.Info("Feature flag defaults changed",
+			zap.Bool("board", next.Features.Board),
+			zap.Bool("autocomplete", next.Features.Autocomplete),
+			zap.Bool("forum_mode", next.Features.ForumMode),
+		)
+	}
+
+	features := []struct {
+		job        string
+		prev, next bool
+	}{
+		{"sla-check", prev.SLA.Enabled, next.SLA.Enabled},
+		{"digest", prev.Digest.Enabled, next.Digest.Enabled},
+		{"oncall-rotation", prev.OnCall.Enabled, next.OnCall.Enabled},
+		{"recurring-issues", prev.Recurring.Enabled, next.Recurring.Enabled},
+		{"stale-issues", prev.Stale.Enabled, next.Stale.Enabled},
+		{"due-dates", prev.DueDates.Enabled, next.DueDates.Enabled},
+		{"escalations", prev.Escalation.Enabled, next.Escalation.Enabled},
+		{"incident-updates", prev.Incidents.Enabled, next.Incidents.Enabled},
+	}
+	for _, f := range features {
+		if f.prev == f.next {
+			continue
+		}
+		if !a.scheduler.SetEnabled(f.job, f.next) {
+			a.logger.Warn("Feature was disabled at startup; restart the bot to enable it", zap.String("job", f.job))
+			continue
+		}
+		a.logger.Info("Scheduled job toggled", zap.String("job", f.job), zap.Bool("enabled", f.next))
+	}
+
+	// Compare what is left without the settings applied above to find the ones needing a restart
+	applied := func(c config.Config) *config.Config {
+		c.Logger.Level = ""
+		c.SLA.Enabled, c.SLA.Targets, c.SLA.SeverityTargets, c.SLA.WarningThreshold = false, nil, nil, 0
+		c.RateLimit = config.RateLimitConfig{}
+		c.Features = config.FeaturesConfig{}
+		c.Digest.Enabled, c.OnCall.Enabled, c.Recurring.Enabled = false, false, false
+		c.Stale.Enabled, c.DueDates.Enabled, c.Escalation.Enabled = false, false, false
+		c.Incidents.Enabled = false
+		return &c
+	}
+	for _, section := range config.ChangedSections(applied(*prev), applied(*next)) {
+		a.logger.Warn("Configuration changed; restart the bot to apply it", zap.String("section", section))
+	}
+}
+
 // Shutdown gracefully shuts down the application
 func (a *App) Shutdown(ctx context.Context) error {
 	a.logger.Info("Shutting down application")
 
-	// Cleanup Discord commands
-	if err := a.cmdMgr.CleanupCommands(); err != nil {
-		a.logger.Error("Failed to cleanup Discord commands", zap.Error(err))
+	// Stop accepting HTTP requests
+	if a.httpServer != nil {
+		if err := a.httpServer.Shutdown(context.Background()); err != nil {
+			a.logger.Error("Failed to shutdown HTTP server", zap.Error(err))
+		}
+	}
+
+	// Stop accepting gRPC calls and end the status change streams
+	if a.grpcServer != nil {
+		if err := a.grpcServer.Shutdown(context.Background()); err != nil {
+			a.logger.Error("Failed to shutdown gRPC server", zap.Error(err))
+		}
 	}
 
-	// Close Discord session
-	if err := a.session.Close(); err != nil {
+	// Close Discord sessions
+	if err := a.shards.Close(); err != nil {
 		a.logger.Error("Failed to close Discord session", zap.Error(err))
 	}
 
+	// Let cancelled handlers return before closing the database they use
+	waitCtx, cancel := context.WithTimeout(context.Background(), handlerShutdownTimeout)
+	defer cancel()
+	if err := a.handler.Wait(waitCtx); err != nil {
+		a.logger.Warn("Discord handlers did not finish before shutdown", zap.Error(err))
+	}
+
+	// Hand the scheduled jobs over to another replica
+	if a.leader != nil {
+		if err := a.leader.Release(); err != nil {
+			a.logger.Error("Failed to release the scheduler lock", zap.Error(err))
+		}
+	}
+
+	// Close Redis connections
+	if a.redis != nil {
+		if err := a.redis.Close(); err != nil {
+			a.logger.Error("Failed to close Redis connections", zap.Error(err))
+		}
+	}
+
 	// Close database connection
 	if err := a.dbManager.Close(); err != nil {
 		a.logger.Error("Failed to close database connection", zap.Error(err))
@@ -14,6 +14,9 @@ type Config struct {
 	OutputPaths []string `mapstructure:"output_paths"` // stdout, stderr, or file paths
 }
 
+// atomicLevel is the level of the loggers created by NewLogger, so it can be changed while they run
+var atomicLevel = zap.NewAtomicLevel()
+
 // NewLogger creates a new structured logger with the given configuration
 func NewLogger(config Config) (*zap.Logger, error) {
 	var zapConfig zap.Config
@@ -26,11 +29,10 @@ func NewLogger(config Config) (*zap.Logger, error) {
 	}
 
 	// Set log level
-	level, err := zap.ParseAtomicLevel(config.Level)
-	if err != nil {
-		level = zap.NewAtomicLevelAt(zap.InfoLevel) // Default to info if invalid level
+	if err := SetLevel(config.Level); err != nil {
+		atomicLevel.SetLevel(zap.InfoLevel) // Default to info if invalid level
 	}
-	zapConfig.Level = level
+	zapConfig.Level = atomicLevel
 
 	// Set output paths
 	if len(config.OutputPaths) > 0 {
@@ -66,6 +68,17 @@ func NewLogger(config Config) (*zap.Logger, error) {
 	return logger, nil
 }
 
+// SetLevel changes the level of the loggers created by NewLogger, e.g. when the
+// configuration is reloaded
+func SetLevel(name string) error {
+	parsed, err := zapcore.ParseLevel(name)
+	if err != nil {
+		return err
+	}
+	atomicLevel.SetLevel(parsed)
+	return nil
+}
+
 // NewDefaultLogger creates a logger with sensible defaults
 func NewDefaultLogger() (*zap.Logger, error) {
 	env := os.Getenv("ENVIRONMENT")